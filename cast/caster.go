@@ -32,3 +32,29 @@ func (c CasterErrFunc[A, B]) CastErr(v A) (B, error) {
 func CasterErrFn[A any, B any](fn func(A) (B, error)) CasterErr[A, B] {
 	return CasterErrFunc[A, B](fn)
 }
+
+// FieldError — ошибка CasterErr с путём поля, на котором она произошла
+type FieldError struct {
+	// Path — путь поля в protobuf сообщении (например "created_by.id")
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return "cast " + e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// TryCast вызывает CasterErr и запоминает первую ошибку в errp.
+// Используется сгенерированными IntoPlainE/IntoPbE, чтобы вызов кастера
+// оставался выражением.
+func TryCast[A any, B any](c CasterErr[A, B], v A, path string, errp *error) B {
+	res, err := c.CastErr(v)
+	if err != nil && *errp == nil {
+		*errp = &FieldError{Path: path, Err: err}
+	}
+	return res
+}
@@ -0,0 +1,100 @@
+// Package enumjx decodes protobuf enums from JSON for generated UnmarshalJX methods
+// and converts enum_as_string values back to enums in generated IntoPbE methods.
+//
+// Like protojson, both the enum value name ("STATUS_ACTIVE") and its number (1)
+// are accepted.
+package enumjx
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/go-faster/jx"
+	"github.com/yaroher/protoc-gen-go-plain/cast"
+)
+
+// UnknownValueError is returned when a JSON value does not match any value of the enum.
+type UnknownValueError struct {
+	// Enum is the full protobuf name of the enum (e.g. "pkg.Status")
+	Enum string
+	// Value is the offending JSON value (name or number)
+	Value string
+}
+
+func (e *UnknownValueError) Error() string {
+	return fmt.Sprintf("unknown value %q for enum %s", e.Value, e.Enum)
+}
+
+// Decode reads an enum value by name or number and returns its number.
+// values is the generated <Enum>_value map. Unknown numbers are kept as is,
+// since proto3 enums are open.
+func Decode(d *jx.Decoder, enum string, values map[string]int32) (int32, error) {
+	switch d.Next() {
+	case jx.String:
+		s, err := d.Str()
+		if err != nil {
+			return 0, err
+		}
+		v, ok := values[s]
+		if !ok {
+			return 0, &UnknownValueError{Enum: enum, Value: s}
+		}
+		return v, nil
+	case jx.Number:
+		return d.Int32()
+	default:
+		return 0, fmt.Errorf("enum %s: unexpected json %s", enum, d.Next())
+	}
+}
+
+// DecodeName reads an enum value by name or number and returns its name.
+// It is used for enum_as_string fields, where the plain struct holds the name.
+// names is the generated <Enum>_name map. Unknown numbers are kept as decimal
+// strings, the way String() of a generated enum prints them.
+func DecodeName(d *jx.Decoder, enum string, values map[string]int32, names map[int32]string) (string, error) {
+	if d.Next() == jx.Number {
+		v, err := d.Int32()
+		if err != nil {
+			return "", err
+		}
+		if s, ok := names[v]; ok {
+			return s, nil
+		}
+		return strconv.Itoa(int(v)), nil
+	}
+
+	if d.Next() != jx.String {
+		return "", fmt.Errorf("enum %s: unexpected json %s", enum, d.Next())
+	}
+	s, err := d.Str()
+	if err != nil {
+		return "", err
+	}
+	if _, ok := values[s]; !ok {
+		return "", &UnknownValueError{Enum: enum, Value: s}
+	}
+	return s, nil
+}
+
+// ParseName returns the number of an enum_as_string value: a value name or
+// the decimal number String() prints for unknown values. An empty string is
+// the zero value of the plain field and gives 0.
+func ParseName(enum, s string, values map[string]int32) (int32, error) {
+	if v, ok := values[s]; ok || s == "" {
+		return v, nil
+	}
+	if v, err := strconv.ParseInt(s, 10, 32); err == nil {
+		return int32(v), nil
+	}
+	return 0, &UnknownValueError{Enum: enum, Value: s}
+}
+
+// TryName converts an enum_as_string value to E and keeps the first error in
+// errp as *cast.FieldError, like cast.TryCast, so the conversion stays an expression.
+func TryName[E ~int32](s, enum string, values map[string]int32, path string, errp *error) E {
+	v, err := ParseName(enum, s, values)
+	if err != nil && *errp == nil {
+		*errp = &cast.FieldError{Path: path, Err: err}
+	}
+	return E(v)
+}
@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/yaroher/protoc-gen-go-plain/goplain"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// NewExistingCaster converts goplain.ExistingCaster option into ExistingCaster.
+func NewExistingCaster(c *goplain.ExistingCaster) (*ExistingCaster, error) {
+	if c == nil {
+		return nil, errors.New("empty caster")
+	}
+	if c.GetSource().GetName() == "" || c.GetTarget().GetName() == "" {
+		return nil, fmt.Errorf("caster %q: source and target types are required", c.GetCaster().GetName())
+	}
+	if c.GetCaster().GetName() == "" {
+		return nil, fmt.Errorf("caster for %s -> %s: caster name is required", c.GetSource().GetName(), c.GetTarget().GetName())
+	}
+	return &ExistingCaster{
+		SourceType: GoType{Name: c.GetSource().GetName(), ImportPath: c.GetSource().GetImportPath()},
+		TargetType: GoType{Name: c.GetTarget().GetName(), ImportPath: c.GetTarget().GetImportPath()},
+		CasterIdent: GoIdent{
+			Name:       c.GetCaster().GetName(),
+			ImportPath: c.GetCaster().GetImportPath(),
+		},
+		IsFunc: c.GetIsFunc(),
+		IsErr:  c.GetCasterErr(),
+	}, nil
+}
+
+// LoadExistingCasters reads goplain.CasterRegistry in protojson format from path.
+// Used by the casters_file plugin parameter.
+func LoadExistingCasters(path string) ([]*ExistingCaster, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read casters file: %w", err)
+	}
+	registry := &goplain.CasterRegistry{}
+	if err := protojson.Unmarshal(data, registry); err != nil {
+		return nil, fmt.Errorf("parse casters file %s: %w", path, err)
+	}
+	casters := make([]*ExistingCaster, 0, len(registry.Casters))
+	for i, c := range registry.Casters {
+		caster, err := NewExistingCaster(c)
+		if err != nil {
+			return nil, fmt.Errorf("casters file %s: casters[%d]: %w", path, i, err)
+		}
+		casters = append(casters, caster)
+	}
+	return casters, nil
+}
+
+// findConflictingCaster returns the caster in casters declared for the same
+// source and target types as c but with another caster, or nil.
+func findConflictingCaster(casters []*ExistingCaster, c *ExistingCaster) *ExistingCaster {
+	for _, prev := range casters {
+		if prev.SourceIdent() == c.SourceIdent() && prev.TargetIdent() == c.TargetIdent() &&
+			(prev.CasterIdent != c.CasterIdent || prev.IsFunc != c.IsFunc || prev.IsErr != c.IsErr) {
+			return prev
+		}
+	}
+	return nil
+}
+
+// SourceIdent returns the source type of the caster
+func (c *ExistingCaster) SourceIdent() GoIdent {
+	return GoIdent{Name: c.SourceType.Name, ImportPath: c.SourceType.ImportPath}
+}
+
+// TargetIdent returns the target type of the caster
+func (c *ExistingCaster) TargetIdent() GoIdent {
+	return GoIdent{Name: c.TargetType.Name, ImportPath: c.TargetType.ImportPath}
+}
+
+// String returns the identifier qualified with its import path, as in "time".Duration
+func (i GoIdent) String() string {
+	if i.ImportPath == "" {
+		return i.Name
+	}
+	return fmt.Sprintf("%q.%s", i.ImportPath, i.Name)
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadExistingCasters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "casters.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"casters": [{
+			"source": {"name": "int64"},
+			"target": {"name": "Duration", "importPath": "time"},
+			"caster": {"name": "MillisToDuration", "importPath": "example.com/casters"},
+			"isFunc": true
+		}, {
+			"source": {"name": "string"},
+			"target": {"name": "Addr", "importPath": "net/netip"},
+			"caster": {"name": "ParseAddr", "importPath": "example.com/casters"},
+			"casterErr": true
+		}]
+	}`), 0o600))
+
+	casters, err := LoadExistingCasters(path)
+	require.NoError(t, err)
+	require.Len(t, casters, 2)
+	assert.Equal(t, &ExistingCaster{
+		SourceType:  GoType{Name: "int64"},
+		TargetType:  GoType{Name: "Duration", ImportPath: "time"},
+		CasterIdent: GoIdent{Name: "MillisToDuration", ImportPath: "example.com/casters"},
+		IsFunc:      true,
+	}, casters[0])
+	assert.True(t, casters[1].IsErr)
+	assert.False(t, casters[1].IsFunc)
+}
+
+func TestLoadExistingCasters_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "casters.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"casters": [{"source": {"name": "int64"}}]}`), 0o600))
+
+	_, err := LoadExistingCasters(path)
+	assert.ErrorContains(t, err, "casters[0]")
+
+	_, err = LoadExistingCasters(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestFindExistingCaster_FileCastersFirst(t *testing.T) {
+	global := &ExistingCaster{
+		SourceType:  GoType{Name: "int64"},
+		TargetType:  GoType{Name: "Duration", ImportPath: "time"},
+		CasterIdent: GoIdent{Name: "Global"},
+	}
+	local := &ExistingCaster{
+		SourceType:  GoType{Name: "int64"},
+		TargetType:  GoType{Name: "Duration", ImportPath: "time"},
+		CasterIdent: GoIdent{Name: "Local"},
+	}
+	g := &Generator{existingCasters: []*ExistingCaster{global}}
+
+	assert.Same(t, global, g.FindExistingCaster(GoType{Name: "int64"}, GoType{Name: "Duration", ImportPath: "time"}))
+	assert.Nil(t, g.FindExistingCaster(GoType{Name: "Duration", ImportPath: "time"}, GoType{Name: "int64"}))
+
+	g.fileCasters = []*ExistingCaster{local}
+	assert.Same(t, local, g.FindExistingCaster(GoType{Name: "int64"}, GoType{Name: "Duration", ImportPath: "time"}))
+}
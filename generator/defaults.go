@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"math"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// defaultLiteral returns the Go expression of the [default = ...] value of a
+// proto2/editions field in its Plain type. Enums kept as enums use the
+// generated constant, enum_as_string the value name, enum_as_int the number.
+func (g *Generator) defaultLiteral(gf *protogen.GeneratedFile, field *IRField, f *protogen.File) string {
+	v := field.DefaultValue
+	switch field.Source.Desc.Kind() {
+	case protoreflect.EnumKind:
+		num := v.Enum()
+		if field.EnumAsString {
+			name := ""
+			if ev := field.Source.Enum.Desc.Values().ByNumber(num); ev != nil {
+				name = string(ev.Name())
+			}
+			return strconv.Quote(name)
+		}
+		if !field.EnumAsInt {
+			for _, ev := range field.Source.Enum.Values {
+				if ev.Desc.Number() == num {
+					return gf.QualifiedGoIdent(ev.GoIdent)
+				}
+			}
+			return g.qualifyType(gf, field.GoType, f) + "(" + strconv.Itoa(int(num)) + ")"
+		}
+		return strconv.Itoa(int(num))
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool())
+	case protoreflect.StringKind:
+		return strconv.Quote(v.String())
+	case protoreflect.BytesKind:
+		return "[]byte(" + strconv.Quote(string(v.Bytes())) + ")"
+	case protoreflect.FloatKind:
+		return floatLiteral(gf, v.Float(), 32)
+	case protoreflect.DoubleKind:
+		return floatLiteral(gf, v.Float(), 64)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	default:
+		return strconv.FormatUint(v.Uint(), 10)
+	}
+}
+
+// floatLiteral formats a float default, using math for infinities and NaN
+func floatLiteral(gf *protogen.GeneratedFile, v float64, bitSize int) string {
+	var lit string
+	switch {
+	case math.IsNaN(v):
+		lit = gf.QualifiedGoIdent(mathPkg.Ident("NaN")) + "()"
+	case math.IsInf(v, 1):
+		lit = gf.QualifiedGoIdent(mathPkg.Ident("Inf")) + "(1)"
+	case math.IsInf(v, -1):
+		lit = gf.QualifiedGoIdent(mathPkg.Ident("Inf")) + "(-1)"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, bitSize)
+	}
+	if bitSize == 32 {
+		return "float32(" + lit + ")"
+	}
+	return lit
+}
+
+// defaultCheck returns the condition that the Plain value at access differs
+// from the field's [default = ...] value
+func (g *Generator) defaultCheck(gf *protogen.GeneratedFile, field *IRField, access string, f *protogen.File) string {
+	switch field.Source.Desc.Kind() {
+	case protoreflect.BoolKind:
+		if field.DefaultValue.Bool() {
+			return "!" + access
+		}
+		return access
+	case protoreflect.BytesKind:
+		return "string(" + access + ") != " + strconv.Quote(string(field.DefaultValue.Bytes()))
+	}
+	return access + " != " + g.defaultLiteral(gf, field, f)
+}
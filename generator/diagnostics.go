@@ -0,0 +1,189 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DiagnosticCode identifies the kind of a diagnostic. Codes are stable and
+// can be matched on by tools.
+type DiagnosticCode string
+
+const (
+	// CodeCollision - two fields of a plain message get the same name
+	CodeCollision DiagnosticCode = "GP001"
+	// CodeInvalidEmbed - embed on a field that can't be embedded
+	CodeInvalidEmbed DiagnosticCode = "GP002"
+	// CodeMissingAliasField - type_alias message without its value field
+	CodeMissingAliasField DiagnosticCode = "GP003"
+	// CodeCasterMismatch - existing caster that is incomplete or conflicts with another one
+	CodeCasterMismatch DiagnosticCode = "GP004"
+	// CodeInvalidOption - goplain option that can't be applied
+	CodeInvalidOption DiagnosticCode = "GP005"
+)
+
+// Position is a location in a proto source file. Line and Column are 1-based,
+// zero when the file has no source info.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	if p.Line == 0 {
+		return p.File
+	}
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// Diagnostic is a generator error located in the proto source
+type Diagnostic struct {
+	Pos  Position
+	Code DiagnosticCode
+	// Err describes the problem, e.g. a Collision for CodeCollision
+	Err error
+}
+
+// Error formats the diagnostic like protoc does: file:line:col: message
+func (d Diagnostic) Error() string {
+	return fmt.Sprintf("%s: %s: %v", d.Pos, d.Code, d.Err)
+}
+
+func (d Diagnostic) Unwrap() error {
+	return d.Err
+}
+
+// Diagnostics is the error returned by Generator.Generate when the input has
+// problems. It holds every diagnostic of the run, ordered by position.
+type Diagnostics []Diagnostic
+
+// Error lists the diagnostics one per line
+func (ds Diagnostics) Error() string {
+	lines := make([]string, len(ds))
+	for i, d := range ds {
+		lines[i] = d.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (ds Diagnostics) Unwrap() []error {
+	errs := make([]error, len(ds))
+	for i, d := range ds {
+		errs[i] = d
+	}
+	return errs
+}
+
+// normalize orders the diagnostics by position and drops duplicates, e.g. a
+// broken type_alias message reported for each field using it
+func (ds Diagnostics) normalize() Diagnostics {
+	sort.SliceStable(ds, func(i, j int) bool {
+		a, b := ds[i].Pos, ds[j].Pos
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	seen := make(map[string]bool, len(ds))
+	out := make(Diagnostics, 0, len(ds))
+	for _, d := range ds {
+		if msg := d.Error(); !seen[msg] {
+			seen[msg] = true
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// Source paths of goplain options below a descriptor, see descriptor.proto
+// and goplain.proto
+const (
+	fileOptionsPath    = 8 // FileDescriptorProto.options
+	messageOptionsPath = 7 // DescriptorProto.options
+	fieldOptionsPath   = 8 // FieldDescriptorProto.options
+	goplainOptionsPath = 60000
+
+	fileExistingCastersPath = 3  // goplain.FileOptions.existing_casters
+	messageTypeAliasPath    = 3  // goplain.MessageOptions.type_alias_field
+	messageVirtualFieldPath = 4  // goplain.MessageOptions.virtual_fields
+	messageExcludePathsPath = 5  // goplain.MessageOptions.exclude_paths
+	messagePrimaryKeyPath   = 8  // goplain.MessageOptions.primary_key
+	messageIndexesPath      = 9  // goplain.MessageOptions.indexes
+	fieldEmbedPath          = 4  // goplain.FieldOptions.embed
+	fieldJSONNamePath       = 10 // goplain.FieldOptions.json_name
+	fieldGoNamePath         = 11 // goplain.FieldOptions.go_name
+	fieldIgnorePath         = 12 // goplain.FieldOptions.ignore
+)
+
+// newDiagnostic creates a diagnostic located at desc, or at its option
+// under desc at subPath when the source info has it
+func newDiagnostic(desc protoreflect.Descriptor, subPath []int32, code DiagnosticCode, format string, args ...any) Diagnostic {
+	return Diagnostic{
+		Pos:  descriptorPosition(desc, subPath...),
+		Code: code,
+		Err:  fmt.Errorf(format, args...),
+	}
+}
+
+// descriptorPosition returns the position of the element at subPath below
+// desc, falling back to the closest enclosing element with source info
+func descriptorPosition(desc protoreflect.Descriptor, subPath ...int32) Position {
+	file := desc.ParentFile()
+	pos := Position{File: file.Path()}
+	locs := file.SourceLocations()
+
+	var base protoreflect.SourcePath
+	if _, ok := desc.(protoreflect.FileDescriptor); !ok {
+		base = locs.ByDescriptor(desc).Path
+		if base == nil {
+			return pos
+		}
+	}
+	path := append(append(protoreflect.SourcePath{}, base...), subPath...)
+	for n := len(path); n >= len(base); n-- {
+		loc := locs.ByPath(path[:n])
+		if loc.Path == nil {
+			continue
+		}
+		pos.Line, pos.Column = loc.StartLine+1, loc.StartColumn+1
+		return pos
+	}
+	return pos
+}
+
+// collisionDiagnostic locates a collision at the top-level field of the
+// message that brought the new field in, e.g. the embedded message field
+func collisionDiagnostic(c Collision) Diagnostic {
+	d := Diagnostic{Code: CodeCollision, Err: c}
+	msg := c.Message.Source
+	if msg != nil && len(c.NewField.PathNumbers) > 0 {
+		if fd := msg.Desc.Fields().ByNumber(protoreflect.FieldNumber(c.NewField.PathNumbers[0])); fd != nil {
+			d.Pos = descriptorPosition(fd)
+			return d
+		}
+	}
+	switch {
+	case c.NewField.Source != nil:
+		d.Pos = descriptorPosition(c.NewField.Source.Desc)
+	case msg != nil && c.NewField.Origin == OriginVirtual:
+		d.Pos = descriptorPosition(msg.Desc, messageOptionsPath, goplainOptionsPath, messageVirtualFieldPath)
+	case msg != nil:
+		d.Pos = descriptorPosition(msg.Desc)
+	}
+	return d
+}
+
+// asDiagnostic reports whether err is a Diagnostic and returns it
+func asDiagnostic(err error) (Diagnostic, bool) {
+	var d Diagnostic
+	ok := errors.As(err, &d)
+	return d, ok
+}
@@ -0,0 +1,28 @@
+package generator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnostics_normalize(t *testing.T) {
+	alias := Diagnostic{Pos: Position{File: "b.proto", Line: 3, Column: 1}, Code: CodeMissingAliasField, Err: errors.New("alias")}
+	diags := Diagnostics{
+		alias,
+		{Pos: Position{File: "a.proto", Line: 7, Column: 5}, Code: CodeInvalidEmbed, Err: errors.New("embed")},
+		{Pos: Position{File: "b.proto", Line: 1, Column: 9}, Code: CodeInvalidOption, Err: errors.New("option")},
+		alias,
+	}.normalize()
+
+	assert.Equal(t, "a.proto:7:5: GP002: embed\n"+
+		"b.proto:1:9: GP005: option\n"+
+		"b.proto:3:1: GP003: alias", diags.Error())
+}
+
+func TestPosition_String(t *testing.T) {
+	assert.Equal(t, "a.proto:2:3", Position{File: "a.proto", Line: 2, Column: 3}.String())
+	// без source info только имя файла
+	assert.Equal(t, "a.proto", Position{File: "a.proto"}.String())
+}
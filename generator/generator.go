@@ -2,12 +2,16 @@ package generator
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/yaroher/protoc-gen-go-plain/goplain"
 	"github.com/yaroher/protoc-gen-go-plain/logger"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 // ExistingCaster describes a pre-defined caster that can be imported and used directly
@@ -23,6 +27,8 @@ type ExistingCaster struct {
 	// If true: result = CasterIdent(value)
 	// If false: result = CasterIdent.Cast(value)
 	IsFunc bool
+	// IsErr indicates the caster may fail: a cast.CasterErr, or a func(A) (B, error) if IsFunc
+	IsErr bool
 }
 
 // GoIdent represents a Go identifier with import path
@@ -41,6 +47,9 @@ type Generator struct {
 	// existingCasters contains pre-defined casters that will be imported and used directly
 	existingCasters []*ExistingCaster
 
+	// fileCasters - existing casters declared in the options of the current file
+	fileCasters []*ExistingCaster
+
 	// forceEnumAsString forces all enum fields to be generated as string type
 	forceEnumAsString bool
 
@@ -49,6 +58,20 @@ type Generator struct {
 
 	// irFiles stores built IR files keyed by proto file path
 	irFiles map[string]*IRFile
+
+	// jxMessages contains protobuf messages getting jx_pb methods in this plugin run
+	jxMessages map[protoreflect.FullName]bool
+	// protojsonFallbacks counts message fields encoded with protojson instead of jx
+	protojsonFallbacks int
+
+	// wireMessages caches whether wire methods are generated for a message (wire=true)
+	wireMessages map[*IRMessage]bool
+
+	// casterNames contains caster names of repeated embed row fields, see collectCasterFields
+	casterNames map[*IRField]string
+
+	// reflectFile is the synthetic descriptor of the current file (reflect=true)
+	reflectFile *reflectFile
 }
 
 type Option func(*Generator) error
@@ -67,8 +90,8 @@ func WithTypeOverrides(overrides []*goplain.TypeOverride) Option {
 	}
 }
 
-// WithForceEnumAsString forces all enum fields to be generated as string type in Scanner structs.
-// Useful when enums are stored as TEXT in the database.
+// WithForceEnumAsString forces all enum fields to be generated as string type.
+// Useful with sql=true when enums are stored as TEXT in the database.
 func WithForceEnumAsString() Option {
 	return func(g *Generator) error {
 		g.forceEnumAsString = true
@@ -91,25 +114,60 @@ func (g *Generator) AddExistingCaster(caster *ExistingCaster) {
 }
 
 // FindExistingCaster finds a caster for the given source and target types.
+// Casters declared in the current file options take precedence over the global ones.
 // Returns nil if no matching caster is found.
 func (g *Generator) FindExistingCaster(sourceType, targetType GoType) *ExistingCaster {
-	for _, c := range g.existingCasters {
-		if c.SourceType.Name == sourceType.Name &&
-			c.SourceType.ImportPath == sourceType.ImportPath &&
-			c.TargetType.Name == targetType.Name &&
-			c.TargetType.ImportPath == targetType.ImportPath {
-			return c
+	for _, casters := range [][]*ExistingCaster{g.fileCasters, g.existingCasters} {
+		for _, c := range casters {
+			if c.SourceType.Name == sourceType.Name &&
+				c.SourceType.ImportPath == sourceType.ImportPath &&
+				c.TargetType.Name == targetType.Name &&
+				c.TargetType.ImportPath == targetType.ImportPath {
+				return c
+			}
 		}
 	}
 	return nil
 }
 
+// Run generates plain code for a plugin request: it declares the supported
+// features, reads the plugin parameters and runs the Generator. It is the
+// body of protoc-gen-go-plain and of the in-process test harness.
+func Run(p *protogen.Plugin) error {
+	p.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL |
+		pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+	// proto2, proto3 and editions up to 2023
+	p.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+	p.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
+
+	settings, err := NewPluginSettingsFromPlugin(p)
+	if err != nil {
+		return err
+	}
+	var opts []Option
+	if settings.CastersFile != "" {
+		casters, err := LoadExistingCasters(settings.CastersFile)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, WithExistingCasters(casters))
+	}
+	g, err := NewGenerator(p, settings, opts...)
+	if err != nil {
+		return err
+	}
+	return g.Generate()
+}
+
 func NewGenerator(p *protogen.Plugin, settings *PluginSettings, opts ...Option) (*Generator, error) {
 	g := &Generator{
 		Settings: settings,
 		Plugin:   p,
 		suffix:   "Plain",
 		irFiles:  make(map[string]*IRFile),
+
+		wireMessages: make(map[*IRMessage]bool),
+		casterNames:  make(map[*IRField]string),
 	}
 	for _, opt := range opts {
 		if opt == nil {
@@ -119,6 +177,10 @@ func NewGenerator(p *protogen.Plugin, settings *PluginSettings, opts ...Option)
 			return nil, err
 		}
 	}
+	// Built-in casters go last, so user casters for the same types take precedence
+	if settings.NativeWKT {
+		g.existingCasters = append(g.existingCasters, wktCasters()...)
+	}
 	return g, nil
 }
 
@@ -130,9 +192,13 @@ func (g *Generator) AddOverride(override *goplain.TypeOverride) {
 	g.overrides = append(g.overrides, override)
 }
 
+// Generate writes plain code for every file to generate. Problems in the
+// input protos are returned as Diagnostics covering all files.
 func (g *Generator) Generate() error {
 	logger.Info("generate start", zap.Int("files", len(g.Plugin.Files)))
 
+	var diags Diagnostics
+
 	for _, f := range g.Plugin.Files {
 		if !f.Generate {
 			continue
@@ -151,6 +217,7 @@ func (g *Generator) Generate() error {
 		builder := NewIRBuilder(g.suffix)
 		builder.GlobalOverrides = g.overrides
 		builder.ForceEnumAsString = g.forceEnumAsString
+		builder.NativeWKT = g.Settings.NativeWKT
 
 		irFile, err := builder.BuildFile(f)
 		if err != nil {
@@ -159,27 +226,33 @@ func (g *Generator) Generate() error {
 		}
 
 		g.irFiles[f.Desc.Path()] = irFile
+		g.fileCasters = irFile.ExistingCasters
 
-		// Skip files without plain messages
-		if len(irFile.Messages) == 0 {
-			logger.Debug("no plain messages to generate", zap.String("file", f.Desc.Path()))
-			continue
+		for _, w := range builder.Warnings {
+			logger.Warn(w.Error())
 		}
 
-		// Fail on collisions
-		if len(builder.Collisions) > 0 {
-			for _, collision := range builder.Collisions {
-				logger.Error("field collision detected",
-					zap.String("message", collision.Message.Name),
-					zap.String("field", collision.FieldName),
-					zap.String("existing_origin", collision.ExistingField.Origin.String()),
-					zap.String("existing_empath", collision.ExistingField.EmPath),
-					zap.String("new_origin", collision.NewField.Origin.String()),
-					zap.String("new_empath", collision.NewField.EmPath),
+		// Problems in the input are collected and reported together after all files
+		fileDiags := builder.Diagnostics
+		for _, collision := range builder.Collisions {
+			fileDiags = append(fileDiags, collisionDiagnostic(collision))
+		}
+		if len(fileDiags) > 0 {
+			for _, d := range fileDiags {
+				logger.Error("diagnostic",
+					zap.Stringer("pos", d.Pos),
+					zap.String("code", string(d.Code)),
+					zap.Error(d.Err),
 				)
 			}
-			return fmt.Errorf("field collisions detected in %s: %d collision(s), first: %s",
-				f.Desc.Path(), len(builder.Collisions), builder.Collisions[0].Error())
+			diags = append(diags, fileDiags...)
+			continue
+		}
+
+		// Skip files without plain messages
+		if len(irFile.Messages) == 0 {
+			logger.Debug("no plain messages to generate", zap.String("file", f.Desc.Path()))
+			continue
 		}
 
 		// Log IR for debugging
@@ -189,8 +262,36 @@ func (g *Generator) Generate() error {
 		if err := g.generateFile(f, irFile); err != nil {
 			return fmt.Errorf("failed to generate %s: %w", f.Desc.Path(), err)
 		}
+
+		// Generate CREATE TABLE statements for messages with a table
+		if hasTables(irFile.Messages) {
+			g.generateDDLFile(f, irFile)
+		}
+
+		if g.Settings.JSONSchema {
+			if err := g.generateJSONSchemaFiles(f, irFile); err != nil {
+				return fmt.Errorf("failed to generate json schema for %s: %w", f.Desc.Path(), err)
+			}
+		}
+
+		if g.Settings.TypeScript {
+			g.generateTSFile(f, irFile)
+		}
+	}
+
+	if len(diags) > 0 {
+		return diags.normalize()
 	}
 
+	if g.Settings.OpenAPI != "" {
+		if err := g.generateOpenAPIFile(g.Settings.OpenAPI); err != nil {
+			return fmt.Errorf("failed to generate openapi document %s: %w", g.Settings.OpenAPI, err)
+		}
+	}
+
+	if g.protojsonFallbacks > 0 {
+		logger.Debug("protojson fallbacks", zap.Int("count", g.protojsonFallbacks))
+	}
 	logger.Info("generate complete")
 	return nil
 }
@@ -249,11 +350,22 @@ func (g *Generator) generateFile(f *protogen.File, irFile *IRFile) error {
 	gf.P("package ", f.GoPackageName)
 	gf.P()
 
+	if g.Settings.Reflect {
+		g.reflectFile = g.buildReflectFile(f, irFile)
+	}
+
 	// Generate structs (imports will be added automatically by protogen)
 	for _, msg := range irFile.Messages {
 		g.generateMessage(gf, msg, f, irFile)
 	}
 
+	// Generate the synthetic descriptor of Plain messages
+	if g.Settings.Reflect {
+		if err := g.generateReflectFile(gf, f, g.reflectFile); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -275,14 +387,21 @@ func (g *Generator) generateMessage(gf *protogen.GeneratedFile, msg *IRMessage,
 	// Generate oneof case fields
 	for _, eo := range msg.EmbeddedOneofs {
 		gf.P("\t// ", eo.CaseFieldName, " indicates which variant of ", eo.Name, " oneof is set")
-		gf.P("\t", eo.CaseFieldName, " string `json:\"", eo.JSONName, ",omitempty\"`")
+		gf.P("\t", eo.CaseFieldName, " ", eo.CaseTypeName, " `json:\"", eo.JSONName, ",omitempty\"`")
 	}
 
 	gf.P("}")
 	gf.P()
 
+	for _, eo := range msg.EmbeddedOneofs {
+		g.generateOneofCaseType(gf, eo)
+	}
+
 	// Generate conversion methods
-	g.generateConversionMethods(gf, msg, f, irFile)
+	// Repeated embed rows are converted inline by the parent's IntoPlain/IntoPb
+	if !msg.IsEmbedItem {
+		g.generateConversionMethods(gf, msg, f, irFile)
+	}
 
 	// Generate With* setters for virtual fields
 	g.generateVirtualFieldSetters(gf, msg, f)
@@ -292,9 +411,40 @@ func (g *Generator) generateMessage(gf *protogen.GeneratedFile, msg *IRMessage,
 		g.generateJSONMethods(gf, msg, f)
 	}
 
+	// Generate protobuf wire methods
+	if g.Settings.Wire {
+		g.generateWireMethods(gf, msg, f)
+	}
+
+	// Generate protoreflect support
+	if g.Settings.Reflect {
+		g.generateReflectMethods(gf, msg, f, g.reflectFile)
+	}
+
+	// Generate deep copy methods
+	if g.Settings.Clone {
+		g.generateCloneMethods(gf, msg, f)
+	}
+
+	// Generate Equal
+	if g.Settings.Equal {
+		g.generateEqualMethod(gf, msg)
+	}
+
+	// Generate database/sql row mapping
+	// Repeated embed rows are stored as JSON by the parent
+	if g.Settings.SQL && !msg.IsEmbedItem {
+		g.generateSQLMethods(gf, msg)
+	}
+
 	// Generate Pool methods
+	// Repeated embed rows live inside the parent's slice, so they only need Reset
 	if g.Settings.GeneratePool {
-		g.generatePoolMethods(gf, msg)
+		if msg.IsEmbedItem {
+			g.generateResetMethod(gf, msg, f)
+		} else {
+			g.generatePoolMethods(gf, msg, f)
+		}
 	}
 
 	// Generate nested messages
@@ -303,6 +453,38 @@ func (g *Generator) generateMessage(gf *protogen.GeneratedFile, msg *IRMessage,
 	}
 }
 
+// generateOneofCaseType generates the named type of a oneof case field
+// with a constant per variant
+func (g *Generator) generateOneofCaseType(gf *protogen.GeneratedFile, eo *EmbeddedOneof) {
+	gf.P("// ", eo.CaseTypeName, " identifies the set variant of ", string(eo.Source.Desc.FullName()), " oneof, empty when none is set")
+	gf.P("type ", eo.CaseTypeName, " string")
+	gf.P()
+	gf.P("const (")
+	for _, variant := range eo.Variants {
+		gf.P("\t", variant.CaseConst, " ", eo.CaseTypeName, " = ", strconv.Quote(variant.Name))
+	}
+	gf.P(")")
+	gf.P()
+	gf.P("// Valid reports whether c is one of the ", eo.CaseTypeName, " variants")
+	gf.P("func (c ", eo.CaseTypeName, ") Valid() bool {")
+	gf.P("\tswitch c {")
+	consts := make([]string, 0, len(eo.Variants))
+	for _, variant := range eo.Variants {
+		consts = append(consts, variant.CaseConst)
+	}
+	gf.P("\tcase ", strings.Join(consts, ", "), ":")
+	gf.P("\t\treturn true")
+	gf.P("\t}")
+	gf.P("\treturn false")
+	gf.P("}")
+	gf.P()
+	gf.P("// String returns the proto name of the variant")
+	gf.P("func (c ", eo.CaseTypeName, ") String() string {")
+	gf.P("\treturn string(c)")
+	gf.P("}")
+	gf.P()
+}
+
 func (g *Generator) generateField(gf *protogen.GeneratedFile, field *IRField, f *protogen.File) {
 	// Build type string using QualifiedGoIdent for proper import handling
 	typeStr := g.buildTypeString(gf, field, f)
@@ -340,7 +522,7 @@ func (g *Generator) buildTypeString(gf *protogen.GeneratedFile, field *IRField,
 	// Add pointer if:
 	// 1. GoType.IsPointer = true, OR
 	// 2. Field is optional AND type is not already a slice/repeated (for nullable fields like optional Timestamp -> *time.Time)
-	if field.GoType.IsPointer || (field.IsOptional && !field.GoType.IsSlice && !field.IsRepeated) {
+	if g.plainIsPointer(field) {
 		sb.WriteString("*")
 	}
 
@@ -361,6 +543,12 @@ func (g *Generator) buildTypeString(gf *protogen.GeneratedFile, field *IRField,
 	return sb.String()
 }
 
+// plainIsPointer reports whether the Plain field is stored as a pointer:
+// either its Go type is a pointer or it has explicit presence
+func (g *Generator) plainIsPointer(field *IRField) bool {
+	return field.GoType.IsPointer || (field.IsOptional && !field.GoType.IsSlice && !field.IsRepeated)
+}
+
 // qualifyType returns the qualified type name, using protogen's import system
 func (g *Generator) qualifyType(gf *protogen.GeneratedFile, goType GoType, f *protogen.File) string {
 	// If no import path or same package, return just the name
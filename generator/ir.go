@@ -3,6 +3,7 @@ package generator
 import (
 	"fmt"
 
+	"github.com/yaroher/protoc-gen-go-plain/goplain"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -80,6 +81,8 @@ type IRFile struct {
 	Messages []*IRMessage
 	// Imports — необходимые импорты для Go
 	Imports []GoImport
+	// ExistingCasters — кастеры из (goplain.file).existing_casters, действуют только в этом файле
+	ExistingCasters []*ExistingCaster
 }
 
 // GoImport представляет Go-импорт
@@ -111,14 +114,46 @@ type IRMessage struct {
 	// Используется для навигации по protobuf-структуре
 	PathTable []int32
 
-	// EmbeddedOneofs — информация о embedded oneof для генерации Case полей
+	// EmbeddedOneofs — информация о oneof для генерации Case полей
+	// (embedded и non-embedded, см. EmbeddedOneof.Embed)
 	EmbeddedOneofs []*EmbeddedOneof
 
 	// IsVirtual — виртуальный тип (не имеет Source protobuf сообщения)
 	IsVirtual bool
+	// IsEmbedItem — row-структура для repeated embed. Source — сообщение элемента,
+	// конвертация генерируется inline в IntoPlain/IntoPb родителя
+	IsEmbedItem bool
+
+	// ResolvedCollisions — коллизии, разрешённые collision_strategy.
+	// Поля в Fields уже переименованы, отброшенных полей там нет
+	ResolvedCollisions []ResolvedCollision
+
+	// Table — SQL-таблица из (goplain.message).table, nil если не задана
+	Table *IRTable
+}
+
+// IRTable описывает SQL-таблицу plain-сообщения. Колонки — поля сообщения
+// (по Name) и case-поля oneof (по JSONName), как в Columns() при sql=true
+type IRTable struct {
+	// Name — имя таблицы
+	Name string
+	// PrimaryKey — колонки первичного ключа
+	PrimaryKey []string
+	// Indexes — индексы таблицы
+	Indexes []*IRIndex
 }
 
-// EmbeddedOneof хранит информацию о oneof с embed=true
+// IRIndex описывает индекс таблицы
+type IRIndex struct {
+	// Name — имя индекса (<table>_<columns>_idx, если не задано в опции)
+	Name string
+	// Columns — индексируемые колонки
+	Columns []string
+	// Unique — уникальный индекс
+	Unique bool
+}
+
+// EmbeddedOneof хранит информацию о oneof, представленном в plain struct полем Case
 type EmbeddedOneof struct {
 	// Name — имя oneof в proto
 	Name string
@@ -126,6 +161,8 @@ type EmbeddedOneof struct {
 	GoName string
 	// CaseFieldName — имя поля для хранения выбранного варианта (e.g., "PlatformEventCase")
 	CaseFieldName string
+	// CaseTypeName — имя типа поля Case (e.g., "EventPlatformEventCase")
+	CaseTypeName string
 	// JSONName — имя для JSON сериализации
 	JSONName string
 	// Variants — список вариантов oneof
@@ -135,6 +172,9 @@ type EmbeddedOneof struct {
 	// AccessPath — путь доступа к oneof через getter'ы (e.g., "GetData()" для pb.GetData().GetPlatformEvent())
 	// Пустой для oneof в корневом сообщении
 	AccessPath string
+	// Embed — oneof с embed=true: варианты-сообщения разворачиваются через
+	// field embed. Без embed каждый вариант остаётся одним полем
+	Embed bool
 }
 
 // OneofVariant представляет один вариант oneof
@@ -145,6 +185,8 @@ type OneofVariant struct {
 	GoName string
 	// FieldNumber — номер поля в proto
 	FieldNumber int32
+	// CaseConst — константа варианта типа Case (e.g., "EventPlatformEventCaseHeartbeat")
+	CaseConst string
 }
 
 // OneofAlternative представляет альтернативный путь к полю из другого варианта oneof
@@ -202,6 +244,8 @@ type IRField struct {
 	OneofGoName string
 	// OneofVariant — имя варианта oneof (e.g., "heartbeat")
 	OneofVariant string
+	// OneofCaseConst — константа варианта для сравнения с полем Case
+	OneofCaseConst string
 	// OneofAlternatives — альтернативные пути для того же поля из других вариантов oneof
 	// (заполняется когда несколько вариантов имеют поле с одинаковым именем)
 	OneofAlternatives []*OneofAlternative
@@ -211,7 +255,8 @@ type IRField struct {
 
 	// IsRepeated — repeated поле
 	IsRepeated bool
-	// IsOptional — optional поле (proto3 optional)
+	// IsOptional — поле с явным присутствием (proto3 optional, proto2 optional/required,
+	// editions field_presence=EXPLICIT), в plain-структуре хранится указателем
 	IsOptional bool
 	// IsMap — map поле
 	IsMap bool
@@ -221,10 +266,16 @@ type IRField struct {
 	// MapValue — тип значения для map
 	MapValue *IRField
 
+	// EmbedItem — row-структура для repeated embed (поле — слайс этих структур)
+	EmbedItem *IRMessage
+
 	// EnumAsString — сериализовать enum как строку
 	EnumAsString bool
 	// EnumAsInt — сериализовать enum как int
 	EnumAsInt bool
+	// DefaultValue — значение по умолчанию из proto2/editions ([default = ...]).
+	// Такое поле хранится значением, а не указателем; невалидно, если default не задан
+	DefaultValue protoreflect.Value
 	// WriteDefault — писать поле в JSON даже если значение = default (0, "", false, nil)
 	WriteDefault bool
 
@@ -234,6 +285,14 @@ type IRField struct {
 	// SourceGoType — оригинальный Go тип из protobuf (до override)
 	// Используется для генерации cast.Caster[SourceGoType, GoType]
 	SourceGoType GoType
+	// CasterErr — кастер может вернуть ошибку (cast.CasterErr вместо cast.Caster),
+	// для сообщения генерируются IntoPlainE/IntoPbE
+	CasterErr bool
+	// NativeWKT — полное имя well-known типа, заменённого нативным Go-типом (wkt=native),
+	// например "google.protobuf.Timestamp" -> time.Time
+	NativeWKT string
+	// SQLType — SQL-тип колонки из (goplain.field).sql_type, пустой — по типу поля
+	SQLType string
 
 	// Comment — комментарий к полю
 	Comment string
@@ -306,6 +365,17 @@ func (c Collision) Error() string {
 	)
 }
 
+// ResolvedCollision — коллизия, разрешённая collision_strategy
+type ResolvedCollision struct {
+	Collision
+	// Strategy — применённая стратегия
+	Strategy goplain.CollisionStrategy
+	// Renamed — поля, получившие префикс embed-пути
+	Renamed []*IRField
+	// Dropped — поле, оставленное вне plain-структуры (first_wins)
+	Dropped *IRField
+}
+
 // ValidationError представляет ошибку валидации IR
 type ValidationError struct {
 	Message string
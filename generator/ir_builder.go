@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/typepb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // IRBuilder строит IR из protogen
@@ -22,17 +24,39 @@ type IRBuilder struct {
 	GlobalOverrides []*goplain.TypeOverride
 	// Collisions — найденные коллизии
 	Collisions []Collision
-	// Errors — ошибки валидации
-	Errors []error
+	// Diagnostics — ошибки во входных proto с позицией в исходнике;
+	// поле или опция с ошибкой пропускаются, построение продолжается
+	Diagnostics []Diagnostic
+	// Warnings — предупреждения, не останавливающие генерацию
+	// (поля, отброшенные collision_strategy first_wins)
+	Warnings []Diagnostic
 
 	// ForceEnumAsString forces all enum fields to be generated as string type
 	ForceEnumAsString bool
+	// NativeWKT — маппинг well-known типов на нативные Go-типы (wkt=native)
+	NativeWKT bool
 	// nextFieldNumber — счётчик для нумерации полей
 	nextFieldNumber int32
 	// fieldNames — имена полей текущего сообщения (для проверки коллизий)
 	fieldNames map[string]*IRField
+	// embedItemStack — сообщения, для которых сейчас строится row-структура
+	// repeated embed (защита от бесконечной рекурсии)
+	embedItemStack map[string]bool
+	// excludePaths — exclude_paths текущего сообщения; значение true,
+	// если путь совпал с полем
+	excludePaths map[string]bool
+	// pathBase — путь repeated embed поля, для которого строится row-структура
+	// (префикс путей exclude_paths для полей row)
+	pathBase string
+	// fileCollisionStrategy — collision_strategy файла
+	fileCollisionStrategy goplain.CollisionStrategy
+	// collisionStrategy — стратегия текущего сообщения
+	collisionStrategy goplain.CollisionStrategy
 }
 
+// errRecursiveEmbed — repeated embed сообщения внутри его же row-структуры
+var errRecursiveEmbed = errors.New("recursive repeated embed")
+
 // NewIRBuilder создаёт новый IRBuilder
 func NewIRBuilder(suffix string) *IRBuilder {
 	if suffix == "" {
@@ -54,9 +78,29 @@ func (b *IRBuilder) BuildFile(f *protogen.File) (*IRFile, error) {
 
 	// Получаем file-level опции
 	fileOpts := b.getFileOptions(f)
+	b.fileCollisionStrategy = fileOpts.GetCollisionStrategy()
+	b.collisionStrategy = b.fileCollisionStrategy
 	if fileOpts != nil {
 		b.GlobalOverrides = append(b.GlobalOverrides, fileOpts.GoTypesOverrides...)
 
+		// Обрабатываем existing_casters
+		for i, ec := range fileOpts.ExistingCasters {
+			casterPath := []int32{fileOptionsPath, goplainOptionsPath, fileExistingCastersPath, int32(i)}
+			caster, err := NewExistingCaster(ec)
+			if err != nil {
+				b.Diagnostics = append(b.Diagnostics, newDiagnostic(f.Desc, casterPath, CodeCasterMismatch,
+					"existing_casters[%d]: %v", i, err))
+				continue
+			}
+			if prev := findConflictingCaster(irFile.ExistingCasters, caster); prev != nil {
+				b.Diagnostics = append(b.Diagnostics, newDiagnostic(f.Desc, casterPath, CodeCasterMismatch,
+					"existing_casters[%d]: caster %s for %s -> %s conflicts with %s declared earlier",
+					i, caster.CasterIdent, caster.SourceIdent(), caster.TargetIdent(), prev.CasterIdent))
+				continue
+			}
+			irFile.ExistingCasters = append(irFile.ExistingCasters, caster)
+		}
+
 		// Обрабатываем virtual_types
 		for _, vt := range fileOpts.VirtualTypes {
 			irMsg := b.BuildVirtualType(vt, f)
@@ -80,6 +124,16 @@ func (b *IRBuilder) BuildFile(f *protogen.File) (*IRFile, error) {
 	return irFile, nil
 }
 
+// report сохраняет err в Diagnostics, если это Diagnostic, и сообщает,
+// что построение можно продолжить без поля. Прочие ошибки прерывают построение
+func (b *IRBuilder) report(err error) bool {
+	d, ok := asDiagnostic(err)
+	if ok {
+		b.Diagnostics = append(b.Diagnostics, d)
+	}
+	return ok
+}
+
 // BuildMessage строит IRMessage из protogen.Message
 func (b *IRBuilder) BuildMessage(msg *protogen.Message, parentEmPath string) (*IRMessage, error) {
 	// Проверяем, нужно ли генерировать это сообщение
@@ -92,6 +146,14 @@ func (b *IRBuilder) BuildMessage(msg *protogen.Message, parentEmPath string) (*I
 	// Сбрасываем состояние для нового сообщения
 	b.nextFieldNumber = 1
 	b.fieldNames = make(map[string]*IRField)
+	b.excludePaths = make(map[string]bool, len(msgOpts.GetExcludePaths()))
+	for _, path := range msgOpts.GetExcludePaths() {
+		b.excludePaths[path] = false
+	}
+	b.collisionStrategy = b.fileCollisionStrategy
+	if strategy := msgOpts.GetCollisionStrategy(); strategy != goplain.CollisionStrategy_COLLISION_STRATEGY_UNSPECIFIED {
+		b.collisionStrategy = strategy
+	}
 
 	irMsg := &IRMessage{
 		Source:         msg,
@@ -111,6 +173,45 @@ func (b *IRBuilder) BuildMessage(msg *protogen.Message, parentEmPath string) (*I
 		return nil, nil
 	}
 
+	if err := b.buildMessageFields(msg, irMsg); err != nil {
+		return nil, err
+	}
+	for i, path := range msgOpts.GetExcludePaths() {
+		if !b.excludePaths[path] {
+			b.Diagnostics = append(b.Diagnostics, newDiagnostic(msg.Desc,
+				[]int32{messageOptionsPath, goplainOptionsPath, messageExcludePathsPath, int32(i)}, CodeInvalidOption,
+				"message %s: exclude_paths %q does not match any field", msg.Desc.Name(), path))
+		}
+	}
+
+	// Обрабатываем virtual_fields
+	if msgOpts != nil {
+		for _, vf := range msgOpts.VirtualFields {
+			irField := b.buildVirtualField(vf, irMsg)
+			b.addField(irMsg, irField)
+		}
+	}
+
+	if msgOpts.GetTable() != "" {
+		irMsg.Table = b.buildTable(msg, irMsg, msgOpts)
+	}
+
+	// Обрабатываем вложенные сообщения
+	for _, nested := range msg.Messages {
+		nestedIR, err := b.BuildMessage(nested, irMsg.EmPath)
+		if err != nil {
+			return nil, err
+		}
+		if nestedIR != nil {
+			irMsg.Nested = append(irMsg.Nested, nestedIR)
+		}
+	}
+
+	return irMsg, nil
+}
+
+// buildMessageFields раскладывает поля и oneof сообщения msg в irMsg
+func (b *IRBuilder) buildMessageFields(msg *protogen.Message, irMsg *IRMessage) error {
 	// Обрабатываем обычные поля (не в oneof)
 	for _, field := range msg.Fields {
 		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
@@ -120,7 +221,10 @@ func (b *IRBuilder) BuildMessage(msg *protogen.Message, parentEmPath string) (*I
 
 		irFields, err := b.processField(field, irMsg, "", nil)
 		if err != nil {
-			return nil, err
+			if b.report(err) {
+				continue
+			}
+			return err
 		}
 		for _, f := range irFields {
 			b.addField(irMsg, f)
@@ -135,98 +239,134 @@ func (b *IRBuilder) BuildMessage(msg *protogen.Message, parentEmPath string) (*I
 		}
 
 		oneofOpts := b.getOneofOptions(oneof)
-		if oneofOpts != nil && oneofOpts.Embed {
-			// Создаём информацию о embedded oneof
-			embeddedOneof := &EmbeddedOneof{
-				Name:          string(oneof.Desc.Name()),
-				GoName:        oneof.GoName,
-				CaseFieldName: oneof.GoName + "Case",
-				JSONName:      string(oneof.Desc.Name()) + "_case",
-				Variants:      make([]*OneofVariant, 0, len(oneof.Fields)),
-				Source:        oneof,
-			}
+		embed := oneofOpts != nil && oneofOpts.Embed
+		// Создаём информацию о oneof. Non-embedded oneof тоже получает поле Case,
+		// но его варианты не разворачиваются: одно поле на вариант
+		embeddedOneof := &EmbeddedOneof{
+			Name:          string(oneof.Desc.Name()),
+			GoName:        oneof.GoName,
+			CaseFieldName: oneof.GoName + "Case",
+			CaseTypeName:  b.buildCaseTypeName(irMsg, oneof),
+			JSONName:      string(oneof.Desc.Name()) + "_case",
+			Variants:      make([]*OneofVariant, 0, len(oneof.Fields)),
+			Source:        oneof,
+			Embed:         embed,
+		}
 
-			// Определяем базовый prefix для oneof
-			// Если embed_with_prefix=true, используем имя oneof как prefix
-			// Если embed_with_prefix=false, prefix пустой (поля вставляются без prefix)
-			usePrefix := oneofOpts.EmbedWithPrefix
-			basePrefix := ""
-			if usePrefix {
-				basePrefix = string(oneof.Desc.Name())
+		// Определяем базовый prefix для oneof
+		// Если embed_with_prefix=true, используем имя oneof как prefix
+		// Если embed_with_prefix=false, prefix пустой (поля вставляются без prefix)
+		usePrefix := embed && oneofOpts.EmbedWithPrefix
+		basePrefix := ""
+		if usePrefix {
+			basePrefix = string(oneof.Desc.Name())
+		}
+
+		// Разворачиваем все варианты
+		for _, field := range oneof.Fields {
+			// Добавляем вариант в список
+			variant := &OneofVariant{
+				Name:        string(field.Desc.Name()),
+				GoName:      field.GoName,
+				FieldNumber: int32(field.Desc.Number()),
+				CaseConst:   embeddedOneof.CaseTypeName + field.GoName,
+			}
+			embeddedOneof.Variants = append(embeddedOneof.Variants, variant)
+
+			// Prefix для Go структуры:
+			// - Если usePrefix=true (oneof.embed_with_prefix): oneof_name + variant_name
+			// - Встроенное сообщение-вариант: variant_name (поля разных вариантов
+			//   часто совпадают, например process_id)
+			// - Иначе: oneof_name (disambiguates multi-oneof hosts, avoids doubling)
+			var variantPrefix string
+			switch {
+			case usePrefix:
+				variantPrefix = basePrefix + "_" + string(field.Desc.Name())
+			case embed && b.isEmbeddedMessageVariant(field):
+				variantPrefix = string(field.Desc.Name())
+			default:
+				variantPrefix = string(oneof.Desc.Name())
 			}
 
-			// Embed oneof — разворачиваем все варианты
-			for _, field := range oneof.Fields {
-				// Добавляем вариант в список
-				embeddedOneof.Variants = append(embeddedOneof.Variants, &OneofVariant{
-					Name:        string(field.Desc.Name()),
-					GoName:      field.GoName,
-					FieldNumber: int32(field.Desc.Number()),
-				})
-
-				// Prefix для Go структуры:
-				// - Если usePrefix=true (oneof.embed_with_prefix): oneof_name + variant_name
-				// - Иначе: oneof_name (disambiguates multi-oneof hosts, avoids doubling)
-				var variantPrefix string
-				if usePrefix {
-					variantPrefix = basePrefix + "_" + string(field.Desc.Name())
-				} else {
-					variantPrefix = string(oneof.Desc.Name())
+			irFields, err := b.processOneofField(field, irMsg, embed, variantPrefix, nil)
+			if err != nil {
+				if b.report(err) {
+					continue
 				}
-
-				irFields, err := b.processField(field, irMsg, variantPrefix, nil)
-				if err != nil {
-					return nil, err
+				return err
+			}
+			for _, f := range irFields {
+				// Добавляем префикс oneof если нужно
+				if f.Origin == OriginEmbed {
+					f.Origin = OriginOneofEmbed
 				}
-				for _, f := range irFields {
-					// Добавляем префикс oneof если нужно
-					if f.Origin == OriginEmbed {
-						f.Origin = OriginOneofEmbed
-					}
-					// Сохраняем имя oneof для использования в IntoPb
-					f.OneofName = embeddedOneof.Name
-					f.OneofGoName = embeddedOneof.GoName
-					f.OneofVariant = string(field.Desc.Name())
-					// Сохраняем оригинальное JSON имя (без prefix варианта)
-					// для унифицированной JSON сериализации
-					if f.Source != nil {
-						f.OneofJSONName = string(f.Source.Desc.JSONName())
-					}
-					b.addField(irMsg, f)
+				// Сохраняем имя oneof для использования в IntoPb
+				f.OneofName = embeddedOneof.Name
+				f.OneofGoName = embeddedOneof.GoName
+				f.OneofVariant = variant.Name
+				f.OneofCaseConst = variant.CaseConst
+				// Сохраняем оригинальное JSON имя (без prefix варианта)
+				// для унифицированной JSON сериализации
+				if f.Source != nil {
+					f.OneofJSONName = b.fieldJSONName(f.Source)
 				}
+				b.addField(irMsg, f)
 			}
-
-			irMsg.EmbeddedOneofs = append(irMsg.EmbeddedOneofs, embeddedOneof)
-		} else {
-			// Non-embedded oneof — пропускаем, поля не добавляются в plain struct
-			// Это позволяет иметь смешанные сообщения с embedded и non-embedded oneofs
-			logger.Debug("skipping non-embedded oneof",
-				zap.String("oneof", string(oneof.Desc.Name())),
-				zap.String("message", string(msg.Desc.Name())),
-			)
 		}
+
+		irMsg.EmbeddedOneofs = append(irMsg.EmbeddedOneofs, embeddedOneof)
 	}
 
-	// Обрабатываем virtual_fields
-	if msgOpts != nil {
-		for _, vf := range msgOpts.VirtualFields {
-			irField := b.buildVirtualField(vf, irMsg)
-			b.addField(irMsg, irField)
+	return nil
+}
+
+// buildTable строит SQL-таблицу сообщения. Колонки primary_key и indexes,
+// которых нет в plain-структуре, попадают в Diagnostics
+func (b *IRBuilder) buildTable(msg *protogen.Message, irMsg *IRMessage, msgOpts *goplain.MessageOptions) *IRTable {
+	columns := make(map[string]bool, len(irMsg.Fields)+len(irMsg.EmbeddedOneofs))
+	for _, f := range irMsg.Fields {
+		columns[f.Name] = true
+	}
+	for _, eo := range irMsg.EmbeddedOneofs {
+		columns[eo.JSONName] = true
+	}
+	// checkColumns проверяет колонки опции; optionPath(i) — путь i-й колонки в исходнике
+	checkColumns := func(names []string, optionPath func(i int) []int32, option string) bool {
+		ok := true
+		for i, name := range names {
+			if !columns[name] {
+				b.Diagnostics = append(b.Diagnostics, newDiagnostic(msg.Desc,
+					append([]int32{messageOptionsPath, goplainOptionsPath}, optionPath(i)...), CodeInvalidOption,
+					"message %s: %s column %q is not a field of %s", msg.Desc.Name(), option, name, irMsg.Name))
+				ok = false
+			}
 		}
+		return ok
 	}
 
-	// Обрабатываем вложенные сообщения
-	for _, nested := range msg.Messages {
-		nestedIR, err := b.BuildMessage(nested, irMsg.EmPath)
-		if err != nil {
-			return nil, err
+	table := &IRTable{Name: msgOpts.GetTable()}
+	primaryKeyPath := func(i int) []int32 { return []int32{messagePrimaryKeyPath, int32(i)} }
+	if checkColumns(msgOpts.GetPrimaryKey(), primaryKeyPath, "primary_key") {
+		table.PrimaryKey = msgOpts.GetPrimaryKey()
+	}
+	for i, idx := range msgOpts.GetIndexes() {
+		indexPath := []int32{messageIndexesPath, int32(i)}
+		if len(idx.GetColumns()) == 0 {
+			b.Diagnostics = append(b.Diagnostics, newDiagnostic(msg.Desc,
+				append([]int32{messageOptionsPath, goplainOptionsPath}, indexPath...), CodeInvalidOption,
+				"message %s: indexes[%d] has no columns", msg.Desc.Name(), i))
+			continue
 		}
-		if nestedIR != nil {
-			irMsg.Nested = append(irMsg.Nested, nestedIR)
+		if !checkColumns(idx.GetColumns(), func(int) []int32 { return indexPath }, "index") {
+			continue
+		}
+		name := idx.GetName()
+		if name == "" {
+			name = table.Name + "_" + strings.Join(idx.GetColumns(), "_") + "_idx"
 		}
+		table.Indexes = append(table.Indexes, &IRIndex{Name: name, Columns: idx.GetColumns(), Unique: idx.GetUnique()})
 	}
-
-	return irMsg, nil
+	return table
 }
 
 // BuildVirtualType строит IRMessage из google.protobuf.Type (virtual type)
@@ -238,6 +378,7 @@ func (b *IRBuilder) BuildVirtualType(vt *typepb.Type, f *protogen.File) *IRMessa
 	// Сбрасываем состояние для нового сообщения
 	b.nextFieldNumber = 1
 	b.fieldNames = make(map[string]*IRField)
+	b.collisionStrategy = b.fileCollisionStrategy
 
 	// Имя типа: если содержит точку, берём последнюю часть
 	typeName := vt.Name
@@ -299,12 +440,28 @@ func (b *IRBuilder) processField(field *protogen.Field, irMsg *IRMessage, oneofP
 	// Добавляем номер текущего поля к пути
 	currentPath := append(pathNumbers, int32(field.Desc.Number()))
 
+	// ignore и exclude_paths — поля нет в plain-структуре
+	if skip, err := b.isIgnored(field, irMsg, currentPath); skip || err != nil {
+		return nil, err
+	}
+
 	// Проверяем взаимоисключающие опции
 	if fieldOpts != nil {
 		if fieldOpts.Embed && fieldOpts.Serialize {
-			return nil, fmt.Errorf(
+			return nil, newDiagnostic(field.Desc, []int32{fieldOptionsPath, goplainOptionsPath, fieldEmbedPath}, CodeInvalidEmbed,
 				"field %s.%s: embed and serialize are mutually exclusive",
-				irMsg.Source.Desc.Name(), field.Desc.Name(),
+				field.Parent.Desc.Name(), field.Desc.Name(),
+			)
+		}
+		// Развёрнутое сообщение не является полем — имена задаются на вложенных полях
+		if fieldOpts.Embed && !field.Desc.IsList() && (fieldOpts.JsonName != "" || fieldOpts.GoName != "") {
+			namePath := int32(fieldJSONNamePath)
+			if fieldOpts.JsonName == "" {
+				namePath = fieldGoNamePath
+			}
+			return nil, newDiagnostic(field.Desc, []int32{fieldOptionsPath, goplainOptionsPath, namePath}, CodeInvalidEmbed,
+				"field %s.%s: json_name and go_name cannot be set on an embedded message, set them on its fields",
+				field.Parent.Desc.Name(), field.Desc.Name(),
 			)
 		}
 	}
@@ -332,6 +489,34 @@ func (b *IRBuilder) processField(field *protogen.Field, irMsg *IRMessage, oneofP
 	return []*IRField{b.buildDirectField(field, oneofPrefix, currentPath)}, nil
 }
 
+// processOneofField обрабатывает вариант oneof. В non-embedded oneof вариант
+// остаётся одним полем: embed на поле варианта игнорируется
+func (b *IRBuilder) processOneofField(
+	field *protogen.Field,
+	irMsg *IRMessage,
+	embed bool,
+	oneofPrefix string,
+	pathNumbers []int32,
+) ([]*IRField, error) {
+	if _, err := b.isIgnored(field, irMsg, append(pathNumbers, int32(field.Desc.Number()))); err != nil {
+		return nil, err
+	}
+	if fieldOpts := b.getFieldOptions(field); !embed && fieldOpts != nil && fieldOpts.Embed {
+		logger.Debug("ignoring embed on a variant of non-embedded oneof",
+			zap.String("field", string(field.Desc.FullName())),
+		)
+		currentPath := append(pathNumbers, int32(field.Desc.Number()))
+		return []*IRField{b.buildDirectField(field, oneofPrefix, currentPath)}, nil
+	}
+	return b.processField(field, irMsg, oneofPrefix, pathNumbers)
+}
+
+// isEmbeddedMessageVariant сообщает, разворачивается ли вариант embedded oneof
+// как встроенное сообщение (embed=true на поле-сообщении)
+func (b *IRBuilder) isEmbeddedMessageVariant(field *protogen.Field) bool {
+	return field.Message != nil && b.getFieldOptions(field).GetEmbed()
+}
+
 // processTypeAliasField обрабатывает поле с type_alias сообщением
 func (b *IRBuilder) processTypeAliasField(
 	field *protogen.Field,
@@ -355,7 +540,8 @@ func (b *IRBuilder) processTypeAliasField(
 	}
 
 	if aliasField == nil {
-		return nil, fmt.Errorf(
+		return nil, newDiagnostic(field.Message.Desc, []int32{messageOptionsPath, goplainOptionsPath, messageTypeAliasPath},
+			CodeMissingAliasField,
 			"type_alias message %s does not have field %q",
 			field.Message.Desc.Name(), aliasFieldName,
 		)
@@ -380,6 +566,7 @@ func (b *IRBuilder) processTypeAliasField(
 		PathNumbers:    copyPath(fullPath),
 		IsRepeated:     field.Desc.IsList(),
 		IsOptional:     field.Desc.HasOptionalKeyword(),
+		SQLType:        b.getFieldOptions(field).GetSqlType(),
 		Comment:        string(field.Comments.Leading),
 	}
 
@@ -409,6 +596,7 @@ func (b *IRBuilder) processSerializedField(
 		PathNumbers:    copyPath(pathNumbers),
 		IsRepeated:     field.Desc.IsList(),
 		IsOptional:     field.Desc.HasOptionalKeyword(),
+		SQLType:        b.getFieldOptions(field).GetSqlType(),
 		Comment:        string(field.Comments.Leading),
 	}
 
@@ -426,17 +614,14 @@ func (b *IRBuilder) processEmbedField(
 	withPrefix bool,
 ) ([]*IRField, error) {
 	if field.Message == nil {
-		return nil, fmt.Errorf(
+		return nil, newDiagnostic(field.Desc, []int32{fieldOptionsPath, goplainOptionsPath, fieldEmbedPath}, CodeInvalidEmbed,
 			"field %s.%s: embed is only valid for message fields",
-			irMsg.Source.Desc.Name(), field.Desc.Name(),
+			field.Parent.Desc.Name(), field.Desc.Name(),
 		)
 	}
 
 	if field.Desc.IsList() {
-		return nil, fmt.Errorf(
-			"field %s.%s: embed is not supported for repeated fields",
-			irMsg.Source.Desc.Name(), field.Desc.Name(),
-		)
+		return b.processRepeatedEmbedField(field, irMsg, oneofPrefix, pathNumbers)
 	}
 
 	var result []*IRField
@@ -464,6 +649,9 @@ func (b *IRBuilder) processEmbedField(
 		// Передаём текущий путь — он уже содержит номер этого поля
 		nestedFields, err := b.processField(nestedField, irMsg, prefix, pathNumbers)
 		if err != nil {
+			if b.report(err) {
+				continue
+			}
 			return nil, err
 		}
 
@@ -484,78 +672,176 @@ func (b *IRBuilder) processEmbedField(
 		}
 
 		oneofOpts := b.getOneofOptions(oneof)
-		if oneofOpts != nil && oneofOpts.Embed {
-			// Embedded oneof - process all variants
-			useOneofPrefix := oneofOpts.EmbedWithPrefix
-			var oneofBasePrefix string
-			if useOneofPrefix {
-				if prefix != "" {
-					oneofBasePrefix = prefix + "_" + string(oneof.Desc.Name())
-				} else {
-					oneofBasePrefix = string(oneof.Desc.Name())
-				}
-			} else if prefix != "" {
-				oneofBasePrefix = prefix
+		embed := oneofOpts != nil && oneofOpts.Embed
+		// Process all variants, non-embedded oneofs keep one field per variant
+		useOneofPrefix := embed && oneofOpts.EmbedWithPrefix
+		var oneofBasePrefix string
+		if useOneofPrefix {
+			if prefix != "" {
+				oneofBasePrefix = prefix + "_" + string(oneof.Desc.Name())
+			} else {
+				oneofBasePrefix = string(oneof.Desc.Name())
 			}
+		} else if prefix != "" {
+			oneofBasePrefix = prefix
+		}
 
-			// Создаём информацию о embedded oneof
-			// AccessPath содержит путь доступа к родительскому сообщению (через которое embed)
-			accessPath := "Get" + field.GoName + "()"
-			embeddedOneof := &EmbeddedOneof{
-				Name:          string(oneof.Desc.Name()),
-				GoName:        oneof.GoName,
-				CaseFieldName: oneof.GoName + "Case",
-				JSONName:      string(oneof.Desc.Name()) + "_case",
-				Variants:      make([]*OneofVariant, 0, len(oneof.Fields)),
-				Source:        oneof,
-				AccessPath:    accessPath,
+		// Создаём информацию о embedded oneof
+		// AccessPath содержит путь доступа к родительскому сообщению (через которое embed)
+		accessPath := "Get" + field.GoName + "()"
+		embeddedOneof := &EmbeddedOneof{
+			Name:          string(oneof.Desc.Name()),
+			GoName:        oneof.GoName,
+			CaseFieldName: oneof.GoName + "Case",
+			CaseTypeName:  b.buildCaseTypeName(irMsg, oneof),
+			JSONName:      string(oneof.Desc.Name()) + "_case",
+			Variants:      make([]*OneofVariant, 0, len(oneof.Fields)),
+			Source:        oneof,
+			AccessPath:    accessPath,
+			Embed:         embed,
+		}
+
+		for _, oneofField := range oneof.Fields {
+			variant := &OneofVariant{
+				Name:        string(oneofField.Desc.Name()),
+				GoName:      oneofField.GoName,
+				FieldNumber: int32(oneofField.Desc.Number()),
+				CaseConst:   embeddedOneof.CaseTypeName + oneofField.GoName,
 			}
+			embeddedOneof.Variants = append(embeddedOneof.Variants, variant)
 
-			for _, oneofField := range oneof.Fields {
-				embeddedOneof.Variants = append(embeddedOneof.Variants, &OneofVariant{
-					Name:        string(oneofField.Desc.Name()),
-					GoName:      oneofField.GoName,
-					FieldNumber: int32(oneofField.Desc.Number()),
-				})
-
-				// Build variant prefix: <embed_path>_<oneof_name> (no doubling with variant name)
-				var variantPrefix string
-				if useOneofPrefix {
-					variantPrefix = oneofBasePrefix + "_" + string(oneofField.Desc.Name())
-				} else if oneofBasePrefix != "" {
-					variantPrefix = oneofBasePrefix + "_" + string(oneof.Desc.Name())
-				} else {
-					variantPrefix = string(oneof.Desc.Name())
-				}
+			// Build variant prefix: <embed_path>_<oneof_name> (no doubling with variant name),
+			// embedded message variants use the variant name instead of the oneof name
+			name := string(oneof.Desc.Name())
+			if embed && b.isEmbeddedMessageVariant(oneofField) {
+				name = string(oneofField.Desc.Name())
+			}
+			var variantPrefix string
+			if useOneofPrefix {
+				variantPrefix = oneofBasePrefix + "_" + string(oneofField.Desc.Name())
+			} else if oneofBasePrefix != "" {
+				variantPrefix = oneofBasePrefix + "_" + name
+			} else {
+				variantPrefix = name
+			}
 
-				oneofFields, err := b.processField(oneofField, irMsg, variantPrefix, pathNumbers)
-				if err != nil {
-					return nil, err
+			oneofFields, err := b.processOneofField(oneofField, irMsg, embed, variantPrefix, pathNumbers)
+			if err != nil {
+				if b.report(err) {
+					continue
 				}
+				return nil, err
+			}
 
-				for _, of := range oneofFields {
-					if of.Origin != OriginSerialized {
-						of.Origin = OriginOneofEmbed
-					}
-					of.OneofName = embeddedOneof.Name
-					of.OneofGoName = embeddedOneof.GoName
-					of.OneofVariant = string(oneofField.Desc.Name())
-					// Сохраняем оригинальное JSON имя (без prefix варианта)
-					if of.Source != nil {
-						of.OneofJSONName = string(of.Source.Desc.JSONName())
-					}
-					result = append(result, of)
+			for _, of := range oneofFields {
+				if of.Origin != OriginSerialized {
+					of.Origin = OriginOneofEmbed
+				}
+				of.OneofName = embeddedOneof.Name
+				of.OneofGoName = embeddedOneof.GoName
+				of.OneofVariant = variant.Name
+				of.OneofCaseConst = variant.CaseConst
+				// Сохраняем оригинальное JSON имя (без prefix варианта)
+				if of.Source != nil {
+					of.OneofJSONName = b.fieldJSONName(of.Source)
 				}
+				result = append(result, of)
 			}
-
-			irMsg.EmbeddedOneofs = append(irMsg.EmbeddedOneofs, embeddedOneof)
 		}
-		// Non-embedded oneofs are skipped when embedding parent message
+
+		irMsg.EmbeddedOneofs = append(irMsg.EmbeddedOneofs, embeddedOneof)
 	}
 
 	return result, nil
 }
 
+// processRepeatedEmbedField разворачивает repeated-сообщение в row-структуру.
+// Поля элемента раскладываются в <Parent><Field>Item<Suffix>, а в родителе
+// остаётся слайс этих структур. Префикс embed_with_prefix к полям row не
+// применяется — они и так лежат в отдельной структуре.
+func (b *IRBuilder) processRepeatedEmbedField(
+	field *protogen.Field,
+	irMsg *IRMessage,
+	oneofPrefix string,
+	pathNumbers []int32,
+) ([]*IRField, error) {
+	irField := &IRField{
+		Source:         field,
+		Name:           b.buildFieldName(field, oneofPrefix),
+		GoName:         b.buildGoFieldName(field, oneofPrefix),
+		JSONName:       b.buildJSONName(field, oneofPrefix),
+		Number:         b.nextFieldNumber,
+		OriginalNumber: int32(field.Desc.Number()),
+		Kind:           KindMessage,
+		ProtoType:      string(field.Message.Desc.FullName()),
+		Origin:         OriginEmbed,
+		EmPath:         b.buildEmPath(irMsg.EmPath, field, oneofPrefix),
+		PathNumbers:    copyPath(pathNumbers),
+		IsRepeated:     true,
+		Comment:        string(field.Comments.Leading),
+	}
+	b.nextFieldNumber++
+
+	itemName := strings.TrimSuffix(irMsg.GoName, b.Suffix) + irField.GoName + "Item" + b.Suffix
+	savedBase := b.pathBase
+	b.pathBase = b.fieldPath(irMsg, pathNumbers) + "."
+	item, err := b.buildEmbedItem(field.Message, itemName, irField.EmPath)
+	b.pathBase = savedBase
+	if err != nil {
+		if errors.Is(err, errRecursiveEmbed) {
+			return nil, newDiagnostic(field.Desc, []int32{fieldOptionsPath, goplainOptionsPath, fieldEmbedPath}, CodeInvalidEmbed,
+				"field %s.%s: %v", field.Parent.Desc.Name(), field.Desc.Name(), err)
+		}
+		return nil, err
+	}
+
+	irField.GoType = GoType{Name: item.GoName}
+	irField.EmbedItem = item
+	irMsg.Nested = append(irMsg.Nested, item)
+
+	return []*IRField{irField}, nil
+}
+
+// buildEmbedItem строит row-структуру для элемента repeated embed.
+// Состояние builder'а (счётчик номеров, имена полей) сохраняется и
+// восстанавливается — row проверяется на коллизии отдельно от родителя.
+func (b *IRBuilder) buildEmbedItem(msg *protogen.Message, goName string, emPath string) (*IRMessage, error) {
+	fullName := string(msg.Desc.FullName())
+	if b.embedItemStack[fullName] {
+		return nil, fmt.Errorf("%w of %s is not supported", errRecursiveEmbed, fullName)
+	}
+	if b.embedItemStack == nil {
+		b.embedItemStack = make(map[string]bool)
+	}
+	b.embedItemStack[fullName] = true
+	defer delete(b.embedItemStack, fullName)
+
+	savedNumber, savedNames := b.nextFieldNumber, b.fieldNames
+	b.nextFieldNumber = 1
+	b.fieldNames = make(map[string]*IRField)
+	defer func() {
+		b.nextFieldNumber, b.fieldNames = savedNumber, savedNames
+	}()
+
+	item := &IRMessage{
+		Source:         msg,
+		Name:           goName,
+		GoName:         goName,
+		Fields:         make([]*IRField, 0),
+		OriginalFields: msg.Fields,
+		Nested:         make([]*IRMessage, 0),
+		Comment:        goName + " holds flattened fields of " + fullName + " for repeated embed " + emPath,
+		EmPath:         emPath,
+		IsEmbedItem:    true,
+	}
+
+	if err := b.buildMessageFields(msg, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
 // buildDirectField создаёт IRField из обычного protogen.Field
 func (b *IRBuilder) buildDirectField(field *protogen.Field, prefix string, pathNumbers []int32) *IRField {
 	fieldOpts := b.getFieldOptions(field)
@@ -573,7 +859,7 @@ func (b *IRBuilder) buildDirectField(field *protogen.Field, prefix string, pathN
 		EmPath:         "", // Direct поля не имеют EmPath
 		PathNumbers:    copyPath(pathNumbers),
 		IsRepeated:     field.Desc.IsList(),
-		IsOptional:     field.Desc.HasOptionalKeyword(),
+		IsOptional:     b.isOptionalField(field),
 		IsMap:          field.Desc.IsMap(),
 		Comment:        string(field.Comments.Leading),
 	}
@@ -588,19 +874,32 @@ func (b *IRBuilder) buildDirectField(field *protogen.Field, prefix string, pathN
 		irField.EnumAsString = fieldOpts.EnumAsString
 		irField.EnumAsInt = fieldOpts.EnumAsInt
 		irField.WriteDefault = fieldOpts.WriteDefault
+		irField.SQLType = fieldOpts.SqlType
 	}
 
 	// Обрабатываем override_type (field-level)
 	if fieldOpts != nil && fieldOpts.OverrideType != nil {
+		sourceGoType := irField.GoType
 		irField.GoType = GoType{
 			Name:       fieldOpts.OverrideType.Name,
 			ImportPath: fieldOpts.OverrideType.ImportPath,
 		}
+		// caster_err — явный запрос кастера с ошибкой
+		if fieldOpts.OverrideType.CasterErr {
+			irField.SourceGoType = sourceGoType
+			irField.NeedsCaster = true
+			irField.CasterErr = true
+		}
 	}
 
 	// Применяем GlobalOverrides (file-level)
 	b.applyGlobalOverrides(field, irField)
 
+	// wkt=native — только если тип не переопределён явно
+	if b.NativeWKT && !irField.NeedsCaster && irField.GoType == b.goTypeFromField(field) {
+		b.applyNativeWKT(field, irField)
+	}
+
 	// Map поля
 	if field.Desc.IsMap() {
 		irField.Kind = KindMap
@@ -616,6 +915,11 @@ func (b *IRBuilder) buildDirectField(field *protogen.Field, prefix string, pathN
 			GoType:     b.goTypeFromField(valueField),
 			Source:     valueField, // Set Source for proper type checking in JSON generation
 		}
+		// enum_as_string для map — значения остаются enum, в JSON пишутся именами
+		if valueField.Enum != nil && !irField.EnumAsInt && (irField.EnumAsString || b.ForceEnumAsString) {
+			irField.MapValue.EnumAsString = true
+		}
+		irField.EnumAsString = false
 	}
 
 	// Message поля
@@ -640,19 +944,55 @@ func (b *IRBuilder) buildDirectField(field *protogen.Field, prefix string, pathN
 		}
 	}
 
+	// Значение по умолчанию — только для скаляров, хранящихся значением
+	if b.hasFieldDefault(field) && !irField.NeedsCaster && irField.NativeWKT == "" {
+		irField.DefaultValue = field.Desc.Default()
+	}
+
 	b.nextFieldNumber++
 	return irField
 }
 
+// isOptionalField сообщает, хранится ли поле указателем: явное присутствие
+// (proto3 optional, proto2 optional/required, editions field_presence=EXPLICIT).
+// Сообщения и поля oneof имеют присутствие сами по себе, bytes в proto2/editions
+// как и в protobuf-go отличают отсутствие через nil, а поля с default хранятся
+// значением (см. hasFieldDefault)
+func (b *IRBuilder) isOptionalField(field *protogen.Field) bool {
+	if field.Desc.Syntax() == protoreflect.Proto3 {
+		return field.Desc.HasOptionalKeyword()
+	}
+	if b.hasFieldDefault(field) {
+		return false
+	}
+	return field.Desc.HasPresence() && field.Message == nil && field.Oneof == nil &&
+		field.Desc.Kind() != protoreflect.BytesKind
+}
+
+// hasFieldDefault сообщает, задан ли для скалярного поля [default = ...] (proto2/editions)
+func (b *IRBuilder) hasFieldDefault(field *protogen.Field) bool {
+	return field.Desc.HasDefault() && !field.Desc.IsList() && field.Message == nil &&
+		(field.Oneof == nil || field.Oneof.Desc.IsSynthetic())
+}
+
 // buildVirtualField создаёт IRField из виртуального поля
 func (b *IRBuilder) buildVirtualField(vf *typepb.Field, irMsg *IRMessage) *IRField {
 	goType := b.goTypeFromProtoKind(protoreflect.Kind(vf.Kind))
 
+	goName := strcase.ToCamel(vf.Name)
+	if name := virtualGoName(vf); name != "" {
+		goName = name
+	}
+	jsonName := strcase.ToLowerCamel(vf.Name)
+	if vf.JsonName != "" {
+		jsonName = vf.JsonName
+	}
+
 	irField := &IRField{
 		Source:         nil,
 		Name:           vf.Name,
-		GoName:         strcase.ToCamel(vf.Name),
-		JSONName:       strcase.ToLowerCamel(vf.Name),
+		GoName:         goName,
+		JSONName:       jsonName,
 		Number:         b.nextFieldNumber,
 		OriginalNumber: 0, // Виртуальные поля не имеют оригинального номера
 		Kind:           b.kindFromProtoKind(protoreflect.Kind(vf.Kind)),
@@ -668,27 +1008,201 @@ func (b *IRBuilder) buildVirtualField(vf *typepb.Field, irMsg *IRMessage) *IRFie
 	return irField
 }
 
+// isIgnored сообщает, что поле исключено из plain-структуры опцией ignore
+// или exclude_paths сообщения. Варианты oneof исключать нельзя: case-поле
+// и IntoPb рассчитывают на все варианты
+func (b *IRBuilder) isIgnored(field *protogen.Field, irMsg *IRMessage, pathNumbers []int32) (bool, error) {
+	ignored := b.getFieldOptions(field).GetIgnore()
+	path := b.fieldPath(irMsg, pathNumbers)
+	if _, ok := b.excludePaths[path]; ok {
+		b.excludePaths[path] = true
+		ignored = true
+	}
+	if ignored && field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+		return false, newDiagnostic(field.Desc, []int32{fieldOptionsPath, goplainOptionsPath, fieldIgnorePath}, CodeInvalidOption,
+			"field %s: ignore and exclude_paths are not supported on oneof variants", path)
+	}
+	return ignored, nil
+}
+
+// fieldPath возвращает путь поля из имён proto полей через точку
+// (address.geo.internal_id) по номерам полей от корня irMsg
+func (b *IRBuilder) fieldPath(irMsg *IRMessage, pathNumbers []int32) string {
+	names := make([]string, 0, len(pathNumbers))
+	desc := irMsg.Source.Desc
+	for _, num := range pathNumbers {
+		fd := desc.Fields().ByNumber(protoreflect.FieldNumber(num))
+		if fd == nil {
+			break
+		}
+		names = append(names, string(fd.Name()))
+		desc = fd.Message()
+	}
+	return b.pathBase + strings.Join(names, ".")
+}
+
+// virtualGoName возвращает go_name виртуального поля — опцию
+// {name: "go_name", value: google.protobuf.StringValue}
+func virtualGoName(vf *typepb.Field) string {
+	for _, opt := range vf.Options {
+		if opt.GetName() != "go_name" || opt.GetValue() == nil {
+			continue
+		}
+		var value wrapperspb.StringValue
+		if err := opt.GetValue().UnmarshalTo(&value); err == nil {
+			return value.GetValue()
+		}
+	}
+	return ""
+}
+
 // addField добавляет поле в сообщение с проверкой коллизий
-// При коллизии поле не добавляется, коллизия записывается в b.Collisions
+// При коллизии поле не добавляется, коллизия записывается в b.Collisions.
+// Проверяются proto имя, Go имя и JSON имя — после go_name/json_name
+// разные поля могут совпасть только по одному из них
 func (b *IRBuilder) addField(irMsg *IRMessage, field *IRField) {
-	if existing, ok := b.fieldNames[field.Name]; ok {
-		collision := Collision{
-			FieldName:     field.Name,
-			ExistingField: existing,
-			NewField:      field,
-			Message:       irMsg,
+	keys := fieldKeys(field)
+	for _, key := range keys {
+		if existing, ok := b.fieldNames[key]; ok {
+			collision := Collision{
+				FieldName:     strings.TrimPrefix(strings.TrimPrefix(key, "go:"), "json:"),
+				ExistingField: existing,
+				NewField:      field,
+				Message:       irMsg,
+			}
+			if !b.resolveCollision(irMsg, collision) {
+				b.Collisions = append(b.Collisions, collision)
+			}
+			return
 		}
-		b.Collisions = append(b.Collisions, collision)
-		return
 	}
 
 	// Назначаем индекс поля для _src
 	field.Index = uint16(len(irMsg.Fields))
 
-	b.fieldNames[field.Name] = field
+	for _, key := range keys {
+		b.fieldNames[key] = field
+	}
 	irMsg.Fields = append(irMsg.Fields, field)
 }
 
+// fieldKeys возвращает ключи fieldNames поля: имя, Go имя и JSON имя
+func fieldKeys(field *IRField) []string {
+	keys := []string{field.Name}
+	if field.GoName != "" {
+		keys = append(keys, "go:"+field.GoName)
+	}
+	if field.JSONName != "" {
+		keys = append(keys, "json:"+field.JSONName)
+	}
+	return keys
+}
+
+// resolveCollision применяет collision_strategy сообщения к коллизии c и
+// добавляет новое поле, если стратегия его оставляет. false — коллизия
+// не разрешена и остаётся ошибкой
+func (b *IRBuilder) resolveCollision(irMsg *IRMessage, c Collision) bool {
+	resolved := ResolvedCollision{Collision: c, Strategy: b.collisionStrategy}
+	switch b.collisionStrategy {
+	case goplain.CollisionStrategy_COLLISION_STRATEGY_PREFIX_LATER:
+		switch {
+		case b.prefixField(irMsg, c.NewField):
+			resolved.Renamed = []*IRField{c.NewField}
+		case b.prefixAddedField(irMsg, c.ExistingField):
+			resolved.Renamed = []*IRField{c.ExistingField}
+		default:
+			return false
+		}
+	case goplain.CollisionStrategy_COLLISION_STRATEGY_PREFIX_BOTH:
+		if b.prefixAddedField(irMsg, c.ExistingField) {
+			resolved.Renamed = append(resolved.Renamed, c.ExistingField)
+		}
+		if b.prefixField(irMsg, c.NewField) {
+			resolved.Renamed = append(resolved.Renamed, c.NewField)
+		}
+		if len(resolved.Renamed) == 0 {
+			return false
+		}
+	case goplain.CollisionStrategy_COLLISION_STRATEGY_FIRST_WINS:
+		// Вариант oneof нельзя выбросить: case-поле и IntoPb рассчитывают на все варианты
+		if c.NewField.OneofVariant != "" {
+			return false
+		}
+		resolved.Dropped = c.NewField
+		warning := collisionDiagnostic(c)
+		warning.Err = fmt.Errorf("%w; the later field is left out by collision_strategy first_wins", c)
+		b.Warnings = append(b.Warnings, warning)
+	default:
+		return false
+	}
+
+	irMsg.ResolvedCollisions = append(irMsg.ResolvedCollisions, resolved)
+	if resolved.Dropped == nil {
+		// Переименованное поле может столкнуться с другим — проверяем заново
+		b.addField(irMsg, c.NewField)
+	}
+	return true
+}
+
+// prefixField добавляет к именам поля его embed-путь. false — поле не
+// embedded или его имя уже начинается с embed-пути (embed_with_prefix)
+func (b *IRBuilder) prefixField(irMsg *IRMessage, field *IRField) bool {
+	prefix := b.embedPrefix(irMsg, field)
+	if prefix == "" || strings.HasPrefix(field.Name, prefix+"_") {
+		return false
+	}
+	field.Name = prefix + "_" + field.Name
+	field.GoName = strcase.ToCamel(prefix) + field.GoName
+	if field.JSONName != "" {
+		field.JSONName = strcase.ToLowerCamel(prefix) + strings.ToUpper(field.JSONName[:1]) + field.JSONName[1:]
+	}
+	return true
+}
+
+// prefixAddedField переименовывает уже добавленное поле (prefixField) и
+// обновляет fieldNames. Если новое имя занято, поле не меняется
+func (b *IRBuilder) prefixAddedField(irMsg *IRMessage, field *IRField) bool {
+	oldKeys := fieldKeys(field)
+	name, goName, jsonName := field.Name, field.GoName, field.JSONName
+	if !b.prefixField(irMsg, field) {
+		return false
+	}
+	newKeys := fieldKeys(field)
+	for _, key := range newKeys {
+		if other, ok := b.fieldNames[key]; ok && other != field {
+			field.Name, field.GoName, field.JSONName = name, goName, jsonName
+			return false
+		}
+	}
+	for _, key := range oldKeys {
+		delete(b.fieldNames, key)
+	}
+	for _, key := range newKeys {
+		b.fieldNames[key] = field
+	}
+	return true
+}
+
+// embedPrefix возвращает embed-путь поля — имена proto полей, через которые
+// оно развёрнуто, через "_" (home_address для home.address.city).
+// Пусто для прямых и виртуальных полей
+func (b *IRBuilder) embedPrefix(irMsg *IRMessage, field *IRField) string {
+	if irMsg.Source == nil || field.Source == nil {
+		return ""
+	}
+	var names []string
+	desc := irMsg.Source.Desc
+	for _, num := range field.PathNumbers {
+		fd := desc.Fields().ByNumber(protoreflect.FieldNumber(num))
+		if fd == nil || fd == field.Source.Desc || fd.Message() == nil {
+			break
+		}
+		names = append(names, string(fd.Name()))
+		desc = fd.Message()
+	}
+	return strings.Join(names, "_")
+}
+
 // isSafeOneofCollision checks if two fields with the same name are from
 // different variants of the same oneof AND have the same type.
 // This is safe because only one variant can be set at a time in protobuf.
@@ -728,6 +1242,12 @@ func copyPath(path []int32) []int32 {
 	return result
 }
 
+// buildCaseTypeName возвращает имя типа поля Case: имя plain-структуры
+// без суффикса + Go имя oneof + "Case" (e.g., EventPayloadCase)
+func (b *IRBuilder) buildCaseTypeName(irMsg *IRMessage, oneof *protogen.Oneof) string {
+	return strings.TrimSuffix(irMsg.GoName, b.Suffix) + oneof.GoName + "Case"
+}
+
 func (b *IRBuilder) buildFieldName(field *protogen.Field, prefix string) string {
 	name := string(field.Desc.Name())
 	if prefix != "" {
@@ -736,7 +1256,12 @@ func (b *IRBuilder) buildFieldName(field *protogen.Field, prefix string) string
 	return name
 }
 
+// buildGoFieldName возвращает Go имя поля: go_name из опций заменяет имя
+// целиком, вместе с префиксами embed/oneof
 func (b *IRBuilder) buildGoFieldName(field *protogen.Field, prefix string) string {
+	if opts := b.getFieldOptions(field); opts.GetGoName() != "" {
+		return opts.GetGoName()
+	}
 	name := field.GoName
 	if prefix != "" {
 		return strcase.ToCamel(prefix) + name
@@ -744,12 +1269,22 @@ func (b *IRBuilder) buildGoFieldName(field *protogen.Field, prefix string) strin
 	return name
 }
 
+// buildJSONName возвращает JSON имя поля: json_name из опций заменяет имя
+// целиком, вместе с префиксами embed/oneof
 func (b *IRBuilder) buildJSONName(field *protogen.Field, prefix string) string {
-	name := string(field.Desc.JSONName())
-	if prefix != "" {
+	if prefix != "" && b.getFieldOptions(field).GetJsonName() == "" {
 		return strcase.ToLowerCamel(prefix + "_" + string(field.Desc.Name()))
 	}
-	return name
+	return b.fieldJSONName(field)
+}
+
+// fieldJSONName возвращает JSON имя поля без префиксов: json_name из опций
+// или стандартное protobuf JSON имя
+func (b *IRBuilder) fieldJSONName(field *protogen.Field) string {
+	if opts := b.getFieldOptions(field); opts.GetJsonName() != "" {
+		return opts.GetJsonName()
+	}
+	return string(field.Desc.JSONName())
 }
 
 func (b *IRBuilder) buildEmPath(parentPath string, field *protogen.Field, prefix string) string {
@@ -899,6 +1434,26 @@ func (b *IRBuilder) getOneofOptions(oneof *protogen.Oneof) *goplain.OneofOptions
 	return ext.(*goplain.OneofOptions)
 }
 
+// applyNativeWKT заменяет well-known тип нативным Go-типом (Timestamp -> time.Time и т.д.).
+// Конвертация идёт через функции пакета wkt, зарегистрированные как existing casters.
+// repeated и map поля остаются protobuf-типами.
+func (b *IRBuilder) applyNativeWKT(field *protogen.Field, irField *IRField) {
+	if field.Message == nil || field.Desc.IsList() || field.Desc.IsMap() {
+		return
+	}
+	w, ok := nativeWKTs[field.Message.Desc.FullName()]
+	if !ok {
+		return
+	}
+	irField.SourceGoType = irField.GoType
+	irField.GoType = w.GoType
+	irField.NeedsCaster = true
+	irField.NativeWKT = string(field.Message.Desc.FullName())
+	if w.Optional {
+		irField.IsOptional = true
+	}
+}
+
 // applyGlobalOverrides применяет глобальные переопределения типов к полю
 func (b *IRBuilder) applyGlobalOverrides(field *protogen.Field, irField *IRField) {
 	for _, override := range b.GlobalOverrides {
@@ -917,7 +1472,9 @@ func (b *IRBuilder) applyGlobalOverrides(field *protogen.Field, irField *IRField
 			}
 
 			// Проверяем нужен ли кастер (типы несовместимы)
-			irField.NeedsCaster = !b.typesCompatible(irField.SourceGoType, irField.GoType)
+			// caster_err требует кастер даже для совместимых типов
+			irField.CasterErr = override.TargetGoType.CasterErr
+			irField.NeedsCaster = irField.CasterErr || !b.typesCompatible(irField.SourceGoType, irField.GoType)
 
 			return // применяем только первый совпадающий override
 		}
@@ -1066,6 +1623,9 @@ func (irMsg *IRMessage) Dump(indent string) string {
 	for _, f := range irMsg.Fields {
 		sb.WriteString(f.Dump(indent + "    "))
 	}
+	for _, rc := range irMsg.ResolvedCollisions {
+		sb.WriteString(fmt.Sprintf("%s  Resolved collision %q: %s\n", indent, rc.FieldName, rc.Strategy))
+	}
 
 	for _, nested := range irMsg.Nested {
 		sb.WriteString(nested.Dump(indent + "  "))
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/goplain"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -239,6 +241,62 @@ func TestIRBuilder_addField_Collision(t *testing.T) {
 	assert.Equal(t, OriginEmbed, collision.NewField.Origin)
 }
 
+// Test addField collision detection on renamed fields
+func TestIRBuilder_addField_RenameCollision(t *testing.T) {
+	b := NewIRBuilder("Plain")
+	msg := &IRMessage{
+		Name:   "TestPlain",
+		Fields: make([]*IRField, 0),
+	}
+
+	b.addField(msg, &IRField{Name: "city", GoName: "City", JSONName: "city"})
+	b.addField(msg, &IRField{Name: "street", GoName: "Street", JSONName: "city"})
+	b.addField(msg, &IRField{Name: "zip", GoName: "City", JSONName: "zip"})
+
+	assert.Len(t, msg.Fields, 1)
+	require.Len(t, b.Collisions, 2)
+	assert.Equal(t, "city", b.Collisions[0].FieldName, "same JSON name")
+	assert.Equal(t, "City", b.Collisions[1].FieldName, "same Go name")
+}
+
+func TestIRBuilder_addField_FirstWins(t *testing.T) {
+	b := NewIRBuilder("Plain")
+	b.collisionStrategy = goplain.CollisionStrategy_COLLISION_STRATEGY_FIRST_WINS
+	msg := &IRMessage{
+		Name:   "TestPlain",
+		Fields: make([]*IRField, 0),
+	}
+
+	b.addField(msg, &IRField{Name: "city", GoName: "City", JSONName: "city"})
+	dropped := &IRField{Name: "city", GoName: "City", JSONName: "city", Origin: OriginEmbed}
+	b.addField(msg, dropped)
+	// вариант oneof не выбрасывается — коллизия остаётся ошибкой
+	b.addField(msg, &IRField{Name: "city", GoName: "City", JSONName: "city", OneofVariant: "city"})
+
+	assert.Len(t, msg.Fields, 1)
+	require.Len(t, msg.ResolvedCollisions, 1)
+	assert.Same(t, dropped, msg.ResolvedCollisions[0].Dropped)
+	assert.Len(t, b.Warnings, 1)
+	assert.Len(t, b.Collisions, 1)
+}
+
+func TestIRBuilder_addField_PrefixWithoutEmbedPath(t *testing.T) {
+	b := NewIRBuilder("Plain")
+	b.collisionStrategy = goplain.CollisionStrategy_COLLISION_STRATEGY_PREFIX_BOTH
+	msg := &IRMessage{
+		Name:   "TestPlain",
+		Fields: make([]*IRField, 0),
+	}
+
+	// у прямых и виртуальных полей нет embed-пути — переименовывать нечем
+	b.addField(msg, &IRField{Name: "status", GoName: "Status", Origin: OriginDirect})
+	b.addField(msg, &IRField{Name: "status", GoName: "Status", Origin: OriginVirtual})
+
+	assert.Len(t, msg.Fields, 1)
+	assert.Empty(t, msg.ResolvedCollisions)
+	assert.Len(t, b.Collisions, 1)
+}
+
 func TestIRBuilder_addField_NoCollision(t *testing.T) {
 	b := NewIRBuilder("Plain")
 	msg := &IRMessage{
@@ -0,0 +1,291 @@
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// valueKind tells how a single value of a field (an element for repeated and map fields)
+// is deep copied by Clone and compared by Equal
+type valueKind int
+
+const (
+	// valueScalar is copied by assignment and compared with ==: scalars, enums, time.Duration
+	valueScalar valueKind = iota
+	// valueBytes is a []byte
+	valueBytes
+	// valuePlain is a Plain struct with its own Clone/CopyTo/Equal
+	valuePlain
+	// valueProto is a protobuf message, handled with proto.Clone and proto.Equal
+	valueProto
+	// valueStruct, valueList and valueValue are native Struct, ListValue and Value (wkt=native)
+	valueStruct
+	valueList
+	valueValue
+	// valueTime is a native time.Time (wkt=native), compared with its Equal method
+	valueTime
+	// valueOverride is an overridden Go type, copied by assignment
+	valueOverride
+)
+
+// copiedByAssignment reports whether assignment copies the value without sharing anything mutable
+func (k valueKind) copiedByAssignment() bool {
+	return k == valueScalar || k == valueTime || k == valueOverride
+}
+
+// valueKindOf classifies a field, or the map value field, by its plain Go type
+func (g *Generator) valueKindOf(field *IRField) valueKind {
+	switch field.NativeWKT {
+	case "":
+	case "google.protobuf.Struct":
+		return valueStruct
+	case "google.protobuf.ListValue":
+		return valueList
+	case "google.protobuf.Value":
+		return valueValue
+	case "google.protobuf.BytesValue":
+		return valueBytes
+	case "google.protobuf.Timestamp":
+		return valueTime
+	default:
+		return valueScalar
+	}
+	if field.EmbedItem != nil {
+		return valuePlain
+	}
+	if field.GoType.Name == "[]byte" {
+		return valueBytes
+	}
+	if field.NeedsCaster || g.needsTypeCast(field) {
+		return valueOverride
+	}
+	if field.Kind != KindMessage || field.Source == nil || field.Source.Message == nil {
+		return valueScalar
+	}
+	ident := field.Source.Message.GoIdent
+	if field.GoType.Name == ident.GoName && field.GoType.ImportPath == string(ident.GoImportPath) {
+		return valueProto
+	}
+	if g.isPlainMessageType(field.Source.Message) {
+		return valuePlain
+	}
+	return valueOverride
+}
+
+// cloneExpr returns an expression holding a deep copy of the value expr.
+// isPointer tells whether a Plain or protobuf message value is a pointer.
+func (g *Generator) cloneExpr(gf *protogen.GeneratedFile, field *IRField, kind valueKind, expr string, isPointer bool) string {
+	switch kind {
+	case valueBytes:
+		return "append([]byte(nil), " + expr + "...)"
+	case valuePlain:
+		if isPointer {
+			return expr + ".Clone()"
+		}
+		return "*" + expr + ".Clone()"
+	case valueProto:
+		return gf.QualifiedGoIdent(protoPkg.Ident("Clone")) + "(" + expr + ").(*" + gf.QualifiedGoIdent(field.Source.Message.GoIdent) + ")"
+	case valueStruct:
+		return gf.QualifiedGoIdent(wktPkg.Ident("CloneStruct")) + "(" + expr + ")"
+	case valueList:
+		return gf.QualifiedGoIdent(wktPkg.Ident("CloneList")) + "(" + expr + ")"
+	case valueValue:
+		return gf.QualifiedGoIdent(wktPkg.Ident("CloneValue")) + "(" + expr + ")"
+	default:
+		return expr
+	}
+}
+
+// generateCloneMethods generates Clone and CopyTo deep copy methods for a Plain struct
+func (g *Generator) generateCloneMethods(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File) {
+	plainType := msg.GoName
+
+	gf.P("// Clone returns a deep copy of ", plainType)
+	gf.P("func (p *", plainType, ") Clone() *", plainType, " {")
+	gf.P("\tif p == nil {")
+	gf.P("\t\treturn nil")
+	gf.P("\t}")
+	gf.P("\tdst := &", plainType, "{}")
+	gf.P("\tp.CopyTo(dst)")
+	gf.P("\treturn dst")
+	gf.P("}")
+	gf.P()
+
+	gf.P("// CopyTo overwrites dst with a deep copy of ", plainType, ".")
+	gf.P("// Slices, maps and nested messages of dst are reused, so copying into a struct from")
+	gf.P("// Get", plainType, " doesn't allocate for them; dst must own them (IntoPlain shares them")
+	gf.P("// with the protobuf message).")
+	gf.P("// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.")
+	gf.P("func (p *", plainType, ") CopyTo(dst *", plainType, ") {")
+	gf.P("\tif p == nil || dst == nil || p == dst {")
+	gf.P("\t\treturn")
+	gf.P("\t}")
+
+	for _, eo := range msg.EmbeddedOneofs {
+		gf.P("\tdst.", eo.CaseFieldName, " = p.", eo.CaseFieldName)
+	}
+	for _, field := range msg.Fields {
+		g.generateFieldCopy(gf, field, f)
+	}
+
+	gf.P("}")
+	gf.P()
+}
+
+// generateFieldCopy generates the deep copy of a single field from p to dst
+func (g *Generator) generateFieldCopy(gf *protogen.GeneratedFile, field *IRField, f *protogen.File) {
+	src := "p." + field.GoName
+	dst := "dst." + field.GoName
+
+	switch {
+	case field.IsMap:
+		g.generateMapCopy(gf, field, f)
+	case field.IsRepeated || field.GoType.IsSlice:
+		g.generateSliceCopy(gf, field, f)
+	case g.plainIsPointer(field):
+		kind := g.valueKindOf(field)
+		if field.GoType.IsPointer && kind != valuePlain && kind != valueProto {
+			// Overridden pointer types are shared
+			gf.P("\t", dst, " = ", src)
+			return
+		}
+		// Messages and optional values are copied into the ones dst already holds
+		gf.P("\tif ", src, " == nil {")
+		gf.P("\t\t", dst, " = nil")
+		gf.P("\t} else {")
+		typ := strings.TrimPrefix(g.buildTypeString(gf, field, f), "*")
+		switch kind {
+		case valuePlain, valueProto:
+			g.generateMessageCopy(gf, kind, src, dst, typ, "\t\t")
+		case valueBytes:
+			gf.P("\t\tif ", dst, " == nil {")
+			gf.P("\t\t\t", dst, " = new(", typ, ")")
+			gf.P("\t\t}")
+			gf.P("\t\t*", dst, " = append((*", dst, ")[:0], *", src, "...)")
+		default:
+			gf.P("\t\tif ", dst, " == nil {")
+			gf.P("\t\t\t", dst, " = new(", typ, ")")
+			gf.P("\t\t}")
+			gf.P("\t\t*", dst, " = ", g.cloneExpr(gf, field, kind, "*"+src, false))
+		}
+		gf.P("\t}")
+	default:
+		kind := g.valueKindOf(field)
+		if kind == valueBytes {
+			gf.P("\t", dst, " = append(", dst, "[:0], ", src, "...)")
+			return
+		}
+		gf.P("\t", dst, " = ", g.cloneExpr(gf, field, kind, src, false))
+	}
+}
+
+// generateSliceCopy copies a repeated field into the slice of dst, keeping its capacity
+func (g *Generator) generateSliceCopy(gf *protogen.GeneratedFile, field *IRField, f *protogen.File) {
+	src := "p." + field.GoName
+	dst := "dst." + field.GoName
+	kind := g.valueKindOf(field)
+
+	if kind == valuePlain && !field.GoType.IsPointer {
+		// Plain elements are copied in place, reusing the slices they hold
+		gf.P("\tif cap(", dst, ") < len(", src, ") {")
+		gf.P("\t\t", dst, " = make([]", g.qualifyType(gf, field.GoType, f), ", len(", src, "))")
+		gf.P("\t} else {")
+		gf.P("\t\t", dst, " = ", dst, "[:len(", src, ")]")
+		gf.P("\t}")
+		gf.P("\tfor i := range ", src, " {")
+		gf.P("\t\t", src, "[i].CopyTo(&", dst, "[i])")
+		gf.P("\t}")
+		return
+	}
+
+	if kind == valuePlain || kind == valueProto {
+		// Messages are copied into the ones dst already holds, up to its capacity
+		typ := strings.TrimPrefix(g.qualifyType(gf, field.GoType, f), "*")
+		gf.P("\tif cap(", dst, ") < len(", src, ") {")
+		gf.P("\t\t", dst, " = append(", dst, "[:cap(", dst, ")], make([]*", typ, ", len(", src, ")-cap(", dst, "))...)")
+		gf.P("\t} else {")
+		gf.P("\t\t", dst, " = ", dst, "[:len(", src, ")]")
+		gf.P("\t}")
+		gf.P("\tfor i, v := range ", src, " {")
+		gf.P("\t\tif v == nil {")
+		gf.P("\t\t\t", dst, "[i] = nil")
+		gf.P("\t\t\tcontinue")
+		gf.P("\t\t}")
+		g.generateMessageCopy(gf, kind, "v", dst+"[i]", typ, "\t\t")
+		gf.P("\t}")
+		return
+	}
+
+	gf.P("\t", dst, " = append(", dst, "[:0], ", src, "...)")
+	if kind.copiedByAssignment() {
+		return
+	}
+	gf.P("\tfor i, v := range ", dst, " {")
+	gf.P("\t\t", dst, "[i] = ", g.cloneExpr(gf, field, kind, "v", true))
+	gf.P("\t}")
+}
+
+// generateMapCopy copies a map field into the map of dst, clearing it first
+func (g *Generator) generateMapCopy(gf *protogen.GeneratedFile, field *IRField, f *protogen.File) {
+	src := "p." + field.GoName
+	dst := "dst." + field.GoName
+
+	kind := valueScalar
+	if field.MapValue != nil {
+		kind = g.valueKindOf(field.MapValue)
+	}
+	if kind == valuePlain || kind == valueProto {
+		// Messages are copied into the ones dst already holds for the key
+		gf.P("\tfor k := range ", dst, " {")
+		gf.P("\t\tif _, ok := ", src, "[k]; !ok {")
+		gf.P("\t\t\tdelete(", dst, ", k)")
+		gf.P("\t\t}")
+		gf.P("\t}")
+	} else {
+		gf.P("\tfor k := range ", dst, " {")
+		gf.P("\t\tdelete(", dst, ", k)")
+		gf.P("\t}")
+	}
+	gf.P("\tif ", dst, " == nil && ", src, " != nil {")
+	gf.P("\t\t", dst, " = make(", g.buildTypeString(gf, field, f), ", len(", src, "))")
+	gf.P("\t}")
+
+	if kind == valuePlain || kind == valueProto {
+		typ := strings.TrimPrefix(g.qualifyType(gf, field.MapValue.GoType, f), "*")
+		gf.P("\tfor k, v := range ", src, " {")
+		gf.P("\t\tif v == nil {")
+		gf.P("\t\t\t", dst, "[k] = nil")
+		gf.P("\t\t\tcontinue")
+		gf.P("\t\t}")
+		gf.P("\t\td := ", dst, "[k]")
+		g.generateMessageCopy(gf, kind, "v", "d", typ, "\t\t")
+		gf.P("\t\t", dst, "[k] = d")
+		gf.P("\t}")
+		return
+	}
+
+	value := "v"
+	if field.MapValue != nil {
+		value = g.cloneExpr(gf, field.MapValue, kind, "v", field.MapValue.GoType.IsPointer)
+	}
+	gf.P("\tfor k, v := range ", src, " {")
+	gf.P("\t\t", dst, "[k] = ", value)
+	gf.P("\t}")
+}
+
+// generateMessageCopy copies the non-nil Plain or protobuf message src into dst,
+// allocating typ only when dst is nil
+func (g *Generator) generateMessageCopy(gf *protogen.GeneratedFile, kind valueKind, src, dst, typ, indent string) {
+	gf.P(indent, "if ", dst, " == nil {")
+	gf.P(indent, "\t", dst, " = new(", typ, ")")
+	if kind == valuePlain {
+		gf.P(indent, "}")
+		gf.P(indent, src, ".CopyTo(", dst, ")")
+		return
+	}
+	gf.P(indent, "} else {")
+	gf.P(indent, "\t", gf.QualifiedGoIdent(protoPkg.Ident("Reset")), "(", dst, ")")
+	gf.P(indent, "}")
+	gf.P(indent, gf.QualifiedGoIdent(protoPkg.Ident("Merge")), "(", dst, ", ", src, ")")
+}
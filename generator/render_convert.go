@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/yaroher/protoc-gen-go-plain/goplain"
@@ -10,6 +11,8 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+var plainmergePkg = protogen.GoImportPath("github.com/yaroher/protoc-gen-go-plain/plainmerge")
+
 // generateConversionMethods generates IntoPb() and IntoPlain() methods
 func (g *Generator) generateConversionMethods(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File, irFile *IRFile) {
 	// Check if message has fields requiring casters
@@ -23,9 +26,14 @@ func (g *Generator) generateConversionMethods(gf *protogen.GeneratedFile, msg *I
 
 	g.generateIntoPlain(gf, msg, f, casterFields, g.castersAsStruct)
 	g.generateIntoPb(gf, msg, f, casterFields, g.castersAsStruct)
+	g.generateIntoPbMerge(gf, msg, f, casterFields, g.castersAsStruct)
+
+	if g.Settings.FieldMask {
+		g.generateFieldMaskMethods(gf, msg, f, casterFields, g.castersAsStruct)
+	}
 
 	// Generate IntoPlainReuse for pool usage (only when pool is enabled and no casters)
-	if g.Settings.GeneratePool && !hasCasters {
+	if g.Settings.GeneratePool && !hasCasters && !g.hasCasterErr(msg) {
 		g.generateIntoPlainReuse(gf, msg, f)
 	}
 }
@@ -34,20 +42,42 @@ func (g *Generator) generateConversionMethods(gf *protogen.GeneratedFile, msg *I
 // Fields with existing casters (pre-defined and imported) are excluded.
 func (g *Generator) collectCasterFields(msg *IRMessage) []*IRField {
 	var result []*IRField
+	seen := make(map[string]bool)
+	g.collectCasterFieldsInto(msg, "", seen, &result)
+	return result
+}
+
+// collectCasterFieldsInto collects caster fields of msg. Repeated embed rows are
+// converted inline, so their casters are requested by the parent message (rows of
+// the same element share casters) and named after the embed field, as a row field
+// may share its name with a field of the parent.
+func (g *Generator) collectCasterFieldsInto(msg *IRMessage, prefix string, seen map[string]bool, result *[]*IRField) {
 	for _, field := range msg.Fields {
-		if field.NeedsCaster {
-			// Check if there's an existing caster for this field
-			// We need casters for both directions, so check both
-			toPlainCaster := g.FindExistingCaster(field.SourceGoType, field.GoType)
-			toPbCaster := g.FindExistingCaster(field.GoType, field.SourceGoType)
-
-			// Only include if at least one direction doesn't have existing caster
-			if toPlainCaster == nil || toPbCaster == nil {
-				result = append(result, field)
-			}
+		if field.EmbedItem != nil {
+			g.collectCasterFieldsInto(field.EmbedItem, prefix+field.GoName, seen, result)
+			continue
+		}
+		// Only include if at least one direction doesn't have existing caster
+		if !field.NeedsCaster || g.hasExistingCaster(field) {
+			continue
+		}
+		name := prefix + field.GoName
+		if prefix != "" {
+			g.casterNames[field] = name
+		}
+		if !seen[name] {
+			seen[name] = true
+			*result = append(*result, field)
 		}
 	}
-	return result
+}
+
+// casterName returns the name of the caster of a field, see collectCasterFieldsInto
+func (g *Generator) casterName(field *IRField) string {
+	if name, ok := g.casterNames[field]; ok {
+		return name
+	}
+	return field.GoName
 }
 
 // hasExistingCaster checks if field has pre-defined casters for both directions
@@ -77,10 +107,11 @@ func (g *Generator) generateCastersStruct(gf *protogen.GeneratedFile, msg *IRMes
 			dstType = "*" + dstType
 		}
 
+		casterIdent := gf.QualifiedGoIdent(castPkg.Ident(casterTypeName(field)))
 		// ToPlain caster: SourceGoType -> GoType
-		gf.P("\t", field.GoName, "ToPlain ", gf.QualifiedGoIdent(castPkg.Ident("Caster")), "[", srcType, ", ", dstType, "]")
+		gf.P("\t", g.casterName(field), "ToPlain ", casterIdent, "[", srcType, ", ", dstType, "]")
 		// ToPb caster: GoType -> SourceGoType
-		gf.P("\t", field.GoName, "ToPb ", gf.QualifiedGoIdent(castPkg.Ident("Caster")), "[", dstType, ", ", srcType, "]")
+		gf.P("\t", g.casterName(field), "ToPb ", casterIdent, "[", dstType, ", ", srcType, "]")
 	}
 
 	gf.P("}")
@@ -105,20 +136,106 @@ func (g *Generator) generateCasterArgs(gf *protogen.GeneratedFile, fields []*IRF
 
 		var argName, fromType, toType string
 		if toPlain {
-			argName = g.lowerFirst(field.GoName) + "Caster"
+			argName = g.lowerFirst(g.casterName(field)) + "Caster"
 			fromType = srcType
 			toType = dstType
 		} else {
-			argName = g.lowerFirst(field.GoName) + "Caster"
+			argName = g.lowerFirst(g.casterName(field)) + "Caster"
 			fromType = dstType
 			toType = srcType
 		}
 
 		// Always add trailing comma for multi-line Go function params
-		gf.P("\t", argName, " ", gf.QualifiedGoIdent(castPkg.Ident("Caster")), "[", fromType, ", ", toType, "],")
+		gf.P("\t", argName, " ", gf.QualifiedGoIdent(castPkg.Ident(casterTypeName(field))), "[", fromType, ", ", toType, "],")
 	}
 }
 
+// casterTypeName returns cast package interface used for the field caster
+func casterTypeName(field *IRField) string {
+	if field.CasterErr {
+		return "CasterErr"
+	}
+	return "Caster"
+}
+
+// hasCasterErr reports whether any caster of the message may fail,
+// either passed as a parameter or an existing one
+func (g *Generator) hasCasterErr(msg *IRMessage) bool {
+	for _, field := range msg.Fields {
+		if field.EmbedItem != nil {
+			if g.hasCasterErr(field.EmbedItem) {
+				return true
+			}
+			continue
+		}
+		if !field.NeedsCaster {
+			continue
+		}
+		if g.casterIsErr(field, true) || g.casterIsErr(field, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// intoPbErr reports whether IntoPb of the message may fail: a caster may fail
+// or an enum_as_string value may not name a value of the enum
+func (g *Generator) intoPbErr(msg *IRMessage) bool {
+	return g.hasCasterErr(msg) || hasEnumNames(msg)
+}
+
+// hasEnumNames reports whether the message holds enum_as_string fields
+func hasEnumNames(msg *IRMessage) bool {
+	for _, field := range msg.Fields {
+		if field.EnumAsString || (field.EmbedItem != nil && hasEnumNames(field.EmbedItem)) {
+			return true
+		}
+	}
+	return false
+}
+
+// casterIsErr reports whether the field caster for the given direction may fail
+func (g *Generator) casterIsErr(field *IRField, toPlain bool) bool {
+	if existingCaster := g.findFieldCaster(field, toPlain); existingCaster != nil {
+		return existingCaster.IsErr
+	}
+	return field.CasterErr
+}
+
+// findFieldCaster finds existing caster for the field in the given direction
+func (g *Generator) findFieldCaster(field *IRField, toPlain bool) *ExistingCaster {
+	if toPlain {
+		return g.FindExistingCaster(field.SourceGoType, field.GoType)
+	}
+	return g.FindExistingCaster(field.GoType, field.SourceGoType)
+}
+
+// casterErrPath returns field path reported in cast.FieldError
+func casterErrPath(field *IRField) string {
+	if field.EmPath != "" {
+		return field.EmPath
+	}
+	if field.Source != nil {
+		return string(field.Source.Desc.Name())
+	}
+	return field.Name
+}
+
+// generateCasterCallArgs generates argument list forwarding casters to the E variant
+func (g *Generator) generateCasterCallArgs(fields []*IRField, castersAsStruct bool) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	if castersAsStruct {
+		return "c"
+	}
+	args := make([]string, 0, len(fields))
+	for _, field := range fields {
+		args = append(args, g.lowerFirst(g.casterName(field))+"Caster")
+	}
+	return strings.Join(args, ", ")
+}
+
 // lowerFirst returns string with first letter lowercased
 func (g *Generator) lowerFirst(s string) string {
 	if s == "" {
@@ -129,21 +246,25 @@ func (g *Generator) lowerFirst(s string) string {
 
 // casterCallWithImport generates caster call and ensures import is added
 func (g *Generator) casterCallWithImport(gf *protogen.GeneratedFile, field *IRField, value string, toPlain bool) string {
-	// Check for existing caster first
-	var existingCaster *ExistingCaster
-	if toPlain {
-		existingCaster = g.FindExistingCaster(field.SourceGoType, field.GoType)
-	} else {
-		existingCaster = g.FindExistingCaster(field.GoType, field.SourceGoType)
-	}
+	castPkg := protogen.GoImportPath("github.com/yaroher/protoc-gen-go-plain/cast")
 
-	if existingCaster != nil && existingCaster.CasterIdent.ImportPath != "" {
-		// Use QualifiedGoIdent to ensure import is added
-		ident := protogen.GoIdent{
-			GoName:       existingCaster.CasterIdent.Name,
-			GoImportPath: protogen.GoImportPath(existingCaster.CasterIdent.ImportPath),
+	// Check for existing caster first
+	if existingCaster := g.findFieldCaster(field, toPlain); existingCaster != nil {
+		// Empty import path means the caster lives in the generated package
+		qualifiedName := existingCaster.CasterIdent.Name
+		if existingCaster.CasterIdent.ImportPath != "" {
+			// Use QualifiedGoIdent to ensure import is added
+			qualifiedName = gf.QualifiedGoIdent(protogen.GoIdent{
+				GoName:       existingCaster.CasterIdent.Name,
+				GoImportPath: protogen.GoImportPath(existingCaster.CasterIdent.ImportPath),
+			})
+		}
+		if existingCaster.IsErr {
+			if existingCaster.IsFunc {
+				qualifiedName = gf.QualifiedGoIdent(castPkg.Ident("CasterErrFn")) + "(" + qualifiedName + ")"
+			}
+			return gf.QualifiedGoIdent(castPkg.Ident("TryCast")) + "(" + qualifiedName + ", " + value + ", \"" + casterErrPath(field) + "\", &_err)"
 		}
-		qualifiedName := gf.QualifiedGoIdent(ident)
 		if existingCaster.IsFunc {
 			return qualifiedName + "(" + value + ")"
 		}
@@ -151,14 +272,23 @@ func (g *Generator) casterCallWithImport(gf *protogen.GeneratedFile, field *IRFi
 	}
 
 	// Fall back to parameter-based casters
+	var caster string
 	if g.castersAsStruct {
 		if toPlain {
-			return "c." + field.GoName + "ToPlain.Cast(" + value + ")"
+			caster = "c." + g.casterName(field) + "ToPlain"
+		} else {
+			caster = "c." + g.casterName(field) + "ToPb"
 		}
-		return "c." + field.GoName + "ToPb.Cast(" + value + ")"
+	} else {
+		// Separate arguments mode
+		caster = g.lowerFirst(g.casterName(field)) + "Caster"
+	}
+
+	// Error caster - remember the first error in _err (see IntoPlainE/IntoPbE)
+	if field.CasterErr {
+		return gf.QualifiedGoIdent(castPkg.Ident("TryCast")) + "(" + caster + ", " + value + ", \"" + casterErrPath(field) + "\", &_err)"
 	}
-	// Separate arguments mode
-	return g.lowerFirst(field.GoName) + "Caster.Cast(" + value + ")"
+	return caster + ".Cast(" + value + ")"
 }
 
 // generateIntoPlain generates method to convert protobuf message to plain struct
@@ -172,24 +302,37 @@ func (g *Generator) generateIntoPlain(gf *protogen.GeneratedFile, msg *IRMessage
 	pbType := msg.Source.GoIdent
 	plainType := msg.GoName
 	hasCasters := len(casterFields) > 0
-
-	gf.P("// IntoPlain converts protobuf message to plain struct")
+	withErr := g.hasCasterErr(msg)
+
+	// With error casters the body lives in IntoPlainE, IntoPlain wraps it
+	methodName, results, nilResult := "IntoPlain", "*"+plainType, "nil"
+	if withErr {
+		g.generateIntoPlainWrapper(gf, msg, f, casterFields, castersAsStruct)
+		methodName, results, nilResult = "IntoPlainE", "(*"+plainType+", error)", "nil, nil"
+		gf.P("// IntoPlainE converts protobuf message to plain struct.")
+		gf.P("// Returns *cast.FieldError if a caster fails.")
+	} else {
+		gf.P("// IntoPlain converts protobuf message to plain struct")
+	}
 	if hasCasters {
 		if castersAsStruct {
-			gf.P("func (pb *", gf.QualifiedGoIdent(pbType), ") IntoPlain(c *", msg.GoName, "Casters) *", plainType, " {")
+			gf.P("func (pb *", gf.QualifiedGoIdent(pbType), ") ", methodName, "(c *", msg.GoName, "Casters) ", results, " {")
 		} else {
 			// Generate separate arguments
-			gf.P("func (pb *", gf.QualifiedGoIdent(pbType), ") IntoPlain(")
+			gf.P("func (pb *", gf.QualifiedGoIdent(pbType), ") ", methodName, "(")
 			g.generateCasterArgs(gf, casterFields, f, true) // toPlain=true
-			gf.P(") *", plainType, " {")
+			gf.P(") ", results, " {")
 		}
 	} else {
-		gf.P("func (pb *", gf.QualifiedGoIdent(pbType), ") IntoPlain() *", plainType, " {")
+		gf.P("func (pb *", gf.QualifiedGoIdent(pbType), ") ", methodName, "() ", results, " {")
 	}
 	gf.P("\tif pb == nil {")
-	gf.P("\t\treturn nil")
+	gf.P("\t\treturn ", nilResult)
 	gf.P("\t}")
 	gf.P("\tp := &", plainType, "{}")
+	if withErr {
+		gf.P("\tvar _err error")
+	}
 	gf.P()
 
 	// Generate oneof case detection first
@@ -202,6 +345,37 @@ func (g *Generator) generateIntoPlain(gf *protogen.GeneratedFile, msg *IRMessage
 		g.generateIntoPlainField(gf, field, msg, f)
 	}
 
+	if withErr {
+		gf.P("\tif _err != nil {")
+		gf.P("\t\treturn nil, _err")
+		gf.P("\t}")
+		gf.P("\treturn p, nil")
+	} else {
+		gf.P("\treturn p")
+	}
+	gf.P("}")
+	gf.P()
+}
+
+// generateIntoPlainWrapper generates IntoPlain that panics if IntoPlainE fails
+func (g *Generator) generateIntoPlainWrapper(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File, casterFields []*IRField, castersAsStruct bool) {
+	pbType := gf.QualifiedGoIdent(msg.Source.GoIdent)
+
+	gf.P("// IntoPlain converts protobuf message to plain struct.")
+	gf.P("// Panics if a caster fails, use IntoPlainE to handle the error.")
+	if len(casterFields) == 0 {
+		gf.P("func (pb *", pbType, ") IntoPlain() *", msg.GoName, " {")
+	} else if castersAsStruct {
+		gf.P("func (pb *", pbType, ") IntoPlain(c *", msg.GoName, "Casters) *", msg.GoName, " {")
+	} else {
+		gf.P("func (pb *", pbType, ") IntoPlain(")
+		g.generateCasterArgs(gf, casterFields, f, true) // toPlain=true
+		gf.P(") *", msg.GoName, " {")
+	}
+	gf.P("\tp, err := pb.IntoPlainE(", g.generateCasterCallArgs(casterFields, castersAsStruct), ")")
+	gf.P("\tif err != nil {")
+	gf.P("\t\tpanic(err)")
+	gf.P("\t}")
 	gf.P("\treturn p")
 	gf.P("}")
 	gf.P()
@@ -259,7 +433,7 @@ func (g *Generator) generateOneofCaseDetection(gf *protogen.GeneratedFile, eo *E
 			GoImportPath: eo.Source.Parent.GoIdent.GoImportPath,
 		}
 		gf.P("\tcase *", gf.QualifiedGoIdent(wrapperIdent), ":")
-		gf.P("\t\tp.", eo.CaseFieldName, " = \"", variant.Name, "\"")
+		gf.P("\t\tp.", eo.CaseFieldName, " = ", variant.CaseConst)
 	}
 	gf.P("\t}")
 	gf.P()
@@ -296,7 +470,7 @@ func (g *Generator) generateIntoPlainDirectField(gf *protogen.GeneratedFile, fie
 	protoIsPointer := (field.Source.Desc.HasOptionalKeyword() || field.Source.Desc.HasPresence()) &&
 		field.Source.Desc.Kind() != protoreflect.BytesKind
 	// Plain is pointer if GoType.IsPointer OR field is optional (for nullable fields like optional Timestamp -> *time.Time)
-	plainIsPointer := field.GoType.IsPointer || (field.IsOptional && !field.GoType.IsSlice && !field.IsRepeated)
+	plainIsPointer := g.plainIsPointer(field)
 
 	// Check if types match or need conversion
 	if field.IsMap && field.MapValue != nil && field.MapValue.Kind == KindMessage {
@@ -361,6 +535,15 @@ func (g *Generator) generateIntoPlainDirectField(gf *protogen.GeneratedFile, fie
 			// Use original protobuf type
 			gf.P("\t", dstField, " = ", srcField)
 		}
+	} else if field.DefaultValue.IsValid() {
+		// proto2/editions [default = ...] - the getter returns the default for unset fields
+		gf.P("\t", dstField, " = ", g.plainValueExpr(gf, field, "pb.Get"+field.Source.GoName+"()", f))
+	} else if protoIsPointer && plainIsPointer && !field.NeedsCaster && g.plainValueExpr(gf, field, "v", f) != "v" {
+		// Both are pointers, but the plain value is converted (enum_as_string, type override)
+		gf.P("\tif ", srcField, " != nil {")
+		gf.P("\t\t_tmp := ", g.plainValueExpr(gf, field, "(*"+srcField+")", f))
+		gf.P("\t\t", dstField, " = &_tmp")
+		gf.P("\t}")
 	} else if protoIsPointer && !plainIsPointer {
 		// Proto has optional (pointer), plain has value - dereference with nil check
 		gf.P("\tif ", srcField, " != nil {")
@@ -437,6 +620,11 @@ func (g *Generator) generateIntoPlainEmbedField(gf *protogen.GeneratedFile, fiel
 	nilCheck := pathInfo.BuildNilCheck("pb")
 
 	gf.P("\t// ", field.GoName, " from ", field.EmPath)
+	if field.DefaultValue.IsValid() && len(field.OneofAlternatives) == 0 {
+		// proto2/editions [default = ...] - getters return the default along the whole path
+		gf.P("\t", dstField, " = ", g.plainValueExpr(gf, field, getterChain, f))
+		return
+	}
 	gf.P("\tif ", nilCheck, " {")
 	g.generateEmbedFieldAssignment(gf, field, pathInfo, dstField, getterChain, f)
 	gf.P("\t}")
@@ -462,10 +650,31 @@ func (g *Generator) generateIntoPlainEmbedField(gf *protogen.GeneratedFile, fiel
 func (g *Generator) generateEmbedFieldAssignment(gf *protogen.GeneratedFile, field *IRField, pathInfo *PathInfo, dstField, getterChain string, f *protogen.File) {
 	leafField := pathInfo.LeafField
 	// Plain is pointer if GoType.IsPointer OR field is optional (for nullable fields like optional Timestamp -> *time.Time)
-	plainIsPointer := field.GoType.IsPointer || (field.IsOptional && !field.GoType.IsSlice && !field.IsRepeated)
+	plainIsPointer := g.plainIsPointer(field)
+
+	// Repeated embed - fill the row slice
+	if field.EmbedItem != nil {
+		g.generateIntoPlainEmbedItems(gf, field, getterChain, dstField, f)
+		return
+	}
 
 	// Handle different field types
-	if field.Kind == KindMessage {
+	if field.IsMap && field.MapValue != nil && field.MapValue.Kind == KindMessage && g.getMessageOptionsFromField(field.MapValue).GetGenerate() {
+		// Map with generate=true message values - convert each value via IntoPlain()
+		keyType := "string"
+		if field.MapKey != nil {
+			keyType = field.MapKey.GoType.Name
+		}
+		valueType := g.buildTypeStringPlain(field.MapValue, f)
+		gf.P("\t\tif len(", getterChain, ") > 0 {")
+		gf.P("\t\t\t", dstField, " = make(map[", keyType, "]", valueType, ", len(", getterChain, "))")
+		gf.P("\t\t\tfor k, v := range ", getterChain, " {")
+		gf.P("\t\t\t\tif v != nil {")
+		gf.P("\t\t\t\t\t", dstField, "[k] = v.IntoPlain()")
+		gf.P("\t\t\t\t}")
+		gf.P("\t\t\t}")
+		gf.P("\t\t}")
+	} else if field.Kind == KindMessage {
 		msgOpts := g.getMessageOptionsFromField(field)
 		if msgOpts != nil && msgOpts.Generate {
 			// Plain type - call IntoPlain()
@@ -473,8 +682,11 @@ func (g *Generator) generateEmbedFieldAssignment(gf *protogen.GeneratedFile, fie
 				// Repeated message with generate=true
 				plainType := g.buildTypeStringPlain(field, f)
 				gf.P("\t\tif len(", getterChain, ") > 0 {")
-				gf.P("\t\t\tfor _, v := range ", getterChain, " {")
-				gf.P("\t\t\t\t", dstField, " = append(", dstField, ", *v.IntoPlain())")
+				gf.P("\t\t\t", dstField, " = make([]", plainType, ", len(", getterChain, "))")
+				gf.P("\t\t\tfor i, v := range ", getterChain, " {")
+				gf.P("\t\t\t\tif v != nil {")
+				gf.P("\t\t\t\t\t", dstField, "[i] = *v.IntoPlain()")
+				gf.P("\t\t\t\t}")
 				gf.P("\t\t\t}")
 				gf.P("\t\t} else {")
 				gf.P("\t\t\t", dstField, " = []", plainType, "{}")
@@ -516,6 +728,13 @@ func (g *Generator) generateEmbedFieldAssignment(gf *protogen.GeneratedFile, fie
 	} else if field.NeedsCaster {
 		// Scalar with type override
 		gf.P("\t\t", dstField, " = ", g.casterCallWithImport(gf, field, getterChain, true))
+	} else if plainIsPointer && !field.IsRepeated && leafField != nil && pbFieldIsPointer(leafField) {
+		// Explicit presence - copy only a set value
+		parent := strings.TrimSuffix(getterChain, ".Get"+leafField.GoName+"()")
+		gf.P("\t\tif ", parent, ".", leafField.GoName, " != nil {")
+		gf.P("\t\t\t_tmp := ", g.plainValueExpr(gf, field, getterChain, f))
+		gf.P("\t\t\t", dstField, " = &_tmp")
+		gf.P("\t\t}")
 	} else if field.EnumAsString && field.IsRepeated {
 		// Repeated enum to []string conversion (embed path)
 		gf.P("\t\tif len(", getterChain, ") > 0 {")
@@ -529,6 +748,15 @@ func (g *Generator) generateEmbedFieldAssignment(gf *protogen.GeneratedFile, fie
 	} else if field.EnumAsString {
 		// Enum to string conversion (embed path)
 		gf.P("\t\t", dstField, " = ", getterChain, ".String()")
+	} else if field.EnumAsInt && field.IsRepeated {
+		// Repeated enum to []int32 conversion (embed path)
+		gf.P("\t\t", dstField, " = make([]int32, len(", getterChain, "))")
+		gf.P("\t\tfor i, v := range ", getterChain, " {")
+		gf.P("\t\t\t", dstField, "[i] = int32(v)")
+		gf.P("\t\t}")
+	} else if field.EnumAsInt {
+		// Enum to int32 conversion (embed path)
+		gf.P("\t\t", dstField, " = int32(", getterChain, ")")
 	} else {
 		// Scalar, enum, bytes - direct assignment
 		// Note: protobuf getters always return values (not pointers) for scalars
@@ -550,6 +778,30 @@ func (g *Generator) generateEmbedFieldAssignment(gf *protogen.GeneratedFile, fie
 
 }
 
+// generateIntoPlainEmbedItems converts a repeated embed into a slice of row structs.
+// Inside the loop p and pb are shadowed by the row and the source element,
+// so row fields are converted by the regular field generators.
+func (g *Generator) generateIntoPlainEmbedItems(gf *protogen.GeneratedFile, field *IRField, getterChain, dstField string, f *protogen.File) {
+	item := field.EmbedItem
+
+	gf.P("\t\t", dstField, " = make([]", item.GoName, ", len(", getterChain, "))")
+	if len(item.Fields) == 0 {
+		return
+	}
+	gf.P("\t\tfor i, _elem := range ", getterChain, " {")
+	gf.P("\t\t\tif _elem == nil {")
+	gf.P("\t\t\t\tcontinue")
+	gf.P("\t\t\t}")
+	gf.P("\t\t\tp, pb := &", dstField, "[i], _elem")
+	for _, eo := range item.EmbeddedOneofs {
+		g.generateOneofCaseDetection(gf, eo)
+	}
+	for _, rowField := range item.Fields {
+		g.generateIntoPlainField(gf, rowField, item, f)
+	}
+	gf.P("\t\t}")
+}
+
 // generateIntoPlainSerializedField handles serialized field (message -> JSON bytes)
 func (g *Generator) generateIntoPlainSerializedField(gf *protogen.GeneratedFile, field *IRField, msg *IRMessage, f *protogen.File) {
 	protoPkg := protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
@@ -669,24 +921,42 @@ func (g *Generator) generateIntoPb(gf *protogen.GeneratedFile, msg *IRMessage, f
 	pbType := msg.Source.GoIdent
 	plainType := msg.GoName
 	hasCasters := len(casterFields) > 0
-
-	gf.P("// IntoPb converts plain struct to protobuf message")
+	withErr := g.intoPbErr(msg)
+
+	// With error casters or enum names the body lives in IntoPbE, IntoPb wraps it
+	methodName, results, nilResult := "IntoPb", "*"+gf.QualifiedGoIdent(pbType), "nil"
+	if withErr {
+		g.generateIntoPbWrapper(gf, msg, f, casterFields, castersAsStruct)
+		methodName, results, nilResult = "IntoPbE", "(*"+gf.QualifiedGoIdent(pbType)+", error)", "nil, nil"
+		gf.P("// IntoPbE converts plain struct to protobuf message.")
+		if g.hasCasterErr(msg) {
+			gf.P("// Returns *cast.FieldError if a caster fails.")
+		}
+		if hasEnumNames(msg) {
+			gf.P("// Returns *cast.FieldError wrapping *enumjx.UnknownValueError for unknown enum names.")
+		}
+	} else {
+		gf.P("// IntoPb converts plain struct to protobuf message")
+	}
 	if hasCasters {
 		if castersAsStruct {
-			gf.P("func (p *", plainType, ") IntoPb(c *", msg.GoName, "Casters) *", gf.QualifiedGoIdent(pbType), " {")
+			gf.P("func (p *", plainType, ") ", methodName, "(c *", msg.GoName, "Casters) ", results, " {")
 		} else {
 			// Generate separate arguments
-			gf.P("func (p *", plainType, ") IntoPb(")
+			gf.P("func (p *", plainType, ") ", methodName, "(")
 			g.generateCasterArgs(gf, casterFields, f, false) // toPlain=false
-			gf.P(") *", gf.QualifiedGoIdent(pbType), " {")
+			gf.P(") ", results, " {")
 		}
 	} else {
-		gf.P("func (p *", plainType, ") IntoPb() *", gf.QualifiedGoIdent(pbType), " {")
+		gf.P("func (p *", plainType, ") ", methodName, "() ", results, " {")
 	}
 	gf.P("\tif p == nil {")
-	gf.P("\t\treturn nil")
+	gf.P("\t\treturn ", nilResult)
 	gf.P("\t}")
 	gf.P("\tpb := &", gf.QualifiedGoIdent(pbType), "{}")
+	if withErr {
+		gf.P("\tvar _err error")
+	}
 	gf.P()
 
 	// Generate field assignments
@@ -694,6 +964,148 @@ func (g *Generator) generateIntoPb(gf *protogen.GeneratedFile, msg *IRMessage, f
 		g.generateIntoPbField(gf, field, msg, f)
 	}
 
+	if withErr {
+		gf.P("\tif _err != nil {")
+		gf.P("\t\treturn nil, _err")
+		gf.P("\t}")
+		gf.P("\treturn pb, nil")
+	} else {
+		gf.P("\treturn pb")
+	}
+	gf.P("}")
+	gf.P()
+}
+
+// generateIntoPbMerge generates IntoPbMerge that writes the fields covered by
+// the plain struct into an existing protobuf message. Ignored and excluded
+// fields, unknown fields and anything else outside the plain struct are kept.
+func (g *Generator) generateIntoPbMerge(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File, casterFields []*IRField, castersAsStruct bool) {
+	if msg.Source == nil {
+		return
+	}
+
+	pbType := gf.QualifiedGoIdent(msg.Source.GoIdent)
+	pathsVar := g.lowerFirst(msg.GoName) + "MergePaths"
+	withErr := g.intoPbErr(msg)
+
+	gf.P("// ", pathsVar, " are the fields of ", msg.Source.GoIdent.GoName, " covered by ", msg.GoName)
+	gf.P("var ", pathsVar, " = [][]", gf.QualifiedGoIdent(protoreflectPkg.Ident("FieldNumber")), "{")
+	for _, path := range g.mergePaths(msg) {
+		nums := make([]string, len(path))
+		for i, num := range path {
+			nums[i] = strconv.Itoa(int(num))
+		}
+		gf.P("\t{", strings.Join(nums, ", "), "},")
+	}
+	gf.P("}")
+	gf.P()
+
+	results, ret := "", "return"
+	if withErr {
+		results, ret = " error", "return nil"
+	}
+	gf.P("// IntoPbMerge writes the fields of the plain struct into dst, keeping")
+	gf.P("// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)")
+	switch {
+	case len(casterFields) == 0:
+		gf.P("func (p *", msg.GoName, ") IntoPbMerge(dst *", pbType, ")", results, " {")
+	case castersAsStruct:
+		gf.P("func (p *", msg.GoName, ") IntoPbMerge(dst *", pbType, ", c *", msg.GoName, "Casters)", results, " {")
+	default:
+		gf.P("func (p *", msg.GoName, ") IntoPbMerge(")
+		gf.P("\tdst *", pbType, ",")
+		g.generateCasterArgs(gf, casterFields, f, false) // toPlain=false
+		gf.P(")", results, " {")
+	}
+	gf.P("\tif p == nil || dst == nil {")
+	gf.P("\t\t", ret)
+	gf.P("\t}")
+	args := g.generateCasterCallArgs(casterFields, castersAsStruct)
+	if withErr {
+		gf.P("\tsrc, err := p.IntoPbE(", args, ")")
+		gf.P("\tif err != nil {")
+		gf.P("\t\treturn err")
+		gf.P("\t}")
+	} else {
+		gf.P("\tsrc := p.IntoPb(", args, ")")
+	}
+	gf.P("\t", gf.QualifiedGoIdent(plainmergePkg.Ident("CopyPaths")), "(dst, src, ", pathsVar, "...)")
+	if withErr {
+		gf.P("\treturn nil")
+	}
+	gf.P("}")
+	gf.P()
+}
+
+// mergePaths returns field number paths of the pb message covered by the plain
+// struct. Type aliases and oneof variants are copied whole, paths of repeated
+// embed rows go on into the row message, so rows are merged element by element.
+func (g *Generator) mergePaths(msg *IRMessage) [][]int32 {
+	var paths [][]int32
+	seen := make(map[string]bool)
+	add := func(path []int32) {
+		key := fmt.Sprint(path)
+		if !seen[key] {
+			seen[key] = true
+			paths = append(paths, path)
+		}
+	}
+	for _, field := range msg.Fields {
+		if field.Source == nil || len(field.PathNumbers) == 0 {
+			continue
+		}
+		if field.EmbedItem != nil {
+			rows := g.mergePaths(field.EmbedItem)
+			if len(rows) == 0 {
+				add(field.PathNumbers)
+			}
+			for _, row := range rows {
+				add(append(copyPath(field.PathNumbers), row...))
+			}
+			continue
+		}
+		path := field.PathNumbers
+		if field.Origin == OriginTypeAlias {
+			path = path[:len(path)-1]
+		}
+		desc := msg.Source.Desc
+		for i, num := range path {
+			fd := desc.Fields().ByNumber(protoreflect.FieldNumber(num))
+			oneof := fd.ContainingOneof()
+			if fd.Message() == nil || fd.IsList() || fd.IsMap() || (oneof != nil && !oneof.IsSynthetic()) {
+				path = path[:i+1]
+				break
+			}
+			desc = fd.Message()
+		}
+		add(path)
+	}
+	return paths
+}
+
+// generateIntoPbWrapper generates IntoPb that panics if IntoPbE fails
+func (g *Generator) generateIntoPbWrapper(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File, casterFields []*IRField, castersAsStruct bool) {
+	pbType := gf.QualifiedGoIdent(msg.Source.GoIdent)
+
+	gf.P("// IntoPb converts plain struct to protobuf message.")
+	if hasEnumNames(msg) {
+		gf.P("// Panics if a caster fails or an enum name is unknown, use IntoPbE to handle the error.")
+	} else {
+		gf.P("// Panics if a caster fails, use IntoPbE to handle the error.")
+	}
+	if len(casterFields) == 0 {
+		gf.P("func (p *", msg.GoName, ") IntoPb() *", pbType, " {")
+	} else if castersAsStruct {
+		gf.P("func (p *", msg.GoName, ") IntoPb(c *", msg.GoName, "Casters) *", pbType, " {")
+	} else {
+		gf.P("func (p *", msg.GoName, ") IntoPb(")
+		g.generateCasterArgs(gf, casterFields, f, false) // toPlain=false
+		gf.P(") *", pbType, " {")
+	}
+	gf.P("\tpb, err := p.IntoPbE(", g.generateCasterCallArgs(casterFields, castersAsStruct), ")")
+	gf.P("\tif err != nil {")
+	gf.P("\t\tpanic(err)")
+	gf.P("\t}")
 	gf.P("\treturn pb")
 	gf.P("}")
 	gf.P()
@@ -730,7 +1142,7 @@ func (g *Generator) generateIntoPbDirectField(gf *protogen.GeneratedFile, field
 	protoIsPointer := (field.Source.Desc.HasOptionalKeyword() || field.Source.Desc.HasPresence()) &&
 		field.Source.Desc.Kind() != protoreflect.BytesKind
 	// Plain is pointer if GoType.IsPointer OR field is optional (for nullable fields like optional Timestamp -> *time.Time)
-	plainIsPointer := field.GoType.IsPointer || (field.IsOptional && !field.GoType.IsSlice && !field.IsRepeated)
+	plainIsPointer := g.plainIsPointer(field)
 
 	if field.IsMap && field.MapValue != nil && field.MapValue.Kind == KindMessage {
 		// Map with message value - check if value type has generate=true
@@ -783,6 +1195,28 @@ func (g *Generator) generateIntoPbDirectField(gf *protogen.GeneratedFile, field
 		} else {
 			gf.P("\t", dstField, " = ", srcField)
 		}
+	} else if field.DefaultValue.IsValid() {
+		// proto2/editions [default = ...] - set only when it differs from the default,
+		// required fields are always set
+		required := field.Source.Desc.Cardinality() == protoreflect.Required
+		indent := "\t"
+		if !required {
+			gf.P("\tif ", g.defaultCheck(gf, field, srcField, f), " {")
+			indent = "\t\t"
+		}
+		if protoIsPointer {
+			gf.P(indent, dstField, " = ", g.pbPointerExpr(gf, field, srcField, f))
+		} else {
+			gf.P(indent, dstField, " = ", g.pbValueExpr(gf, field, srcField, f))
+		}
+		if !required {
+			gf.P("\t}")
+		}
+	} else if protoIsPointer && plainIsPointer && !field.NeedsCaster && g.pbValueExpr(gf, field, "v", f) != "v" {
+		// Both are pointers, but the plain value is converted (enum_as_string, type override)
+		gf.P("\tif ", srcField, " != nil {")
+		gf.P("\t\t", dstField, " = ", g.pbPointerExpr(gf, field, "*"+srcField, f))
+		gf.P("\t}")
 	} else if protoIsPointer && !plainIsPointer {
 		// Proto wants pointer, plain has value - take address (with non-zero check for strings)
 		switch field.GoType.Name {
@@ -821,16 +1255,12 @@ func (g *Generator) generateIntoPbDirectField(gf *protogen.GeneratedFile, field
 		gf.P("\tif len(", srcField, ") > 0 {")
 		gf.P("\t\t", dstField, " = make([]", enumType, ", len(", srcField, "))")
 		gf.P("\t\tfor i, v := range ", srcField, " {")
-		gf.P("\t\t\t", dstField, "[i] = ", enumType, "(", enumType, "_value[v])")
+		gf.P("\t\t\t", dstField, "[i] = ", g.enumIntoPbExpr(gf, field, "v", f))
 		gf.P("\t\t}")
 		gf.P("\t}")
 	} else if field.EnumAsString {
 		// String back to enum conversion
-		enumType := g.qualifyType(gf, GoType{
-			Name:       field.Source.Enum.GoIdent.GoName,
-			ImportPath: string(field.Source.Enum.GoIdent.GoImportPath),
-		}, f)
-		gf.P("\t", dstField, " = ", enumType, "(", enumType, "_value[", srcField, "])")
+		gf.P("\t", dstField, " = ", g.enumIntoPbExpr(gf, field, srcField, f))
 	} else if field.EnumAsInt {
 		// Int32 back to enum conversion
 		enumType := g.qualifyType(gf, GoType{
@@ -880,17 +1310,56 @@ func (g *Generator) generateIntoPbEmbedField(gf *protogen.GeneratedFile, field *
 	caseCheck := ""
 	if field.OneofName != "" && field.OneofVariant != "" {
 		caseFieldName := field.OneofGoName + "Case"
-		caseCheck = fmt.Sprintf(" && p.%s == %q", caseFieldName, field.OneofVariant)
+		caseCheck = fmt.Sprintf(" && p.%s == %s", caseFieldName, field.OneofCaseConst)
 	}
 
 	gf.P("\t// ", field.GoName, " -> ", field.EmPath)
 
+	// Repeated embed - build the element slice from rows
+	if field.EmbedItem != nil {
+		g.generateIntoPbEmbedItems(gf, field, pathInfo, caseCheck, f)
+		return
+	}
+
 	// Check if proto field is optional (pointer) but plain is value
 	// For oneof scalar fields, proto does NOT use pointer (wrapper contains value directly)
 	isOneofScalar := leafField != nil && leafField.Oneof != nil && !leafField.Oneof.Desc.IsSynthetic() && leafField.Message == nil
 	protoIsPointer := leafField != nil && !isOneofScalar && (leafField.Desc.HasOptionalKeyword() || leafField.Desc.HasPresence())
 	// Plain is pointer if GoType.IsPointer OR field is optional (for nullable fields like optional Timestamp -> *time.Time)
-	plainIsPointer := field.GoType.IsPointer || (field.IsOptional && !field.GoType.IsSlice && !field.IsRepeated)
+	plainIsPointer := g.plainIsPointer(field)
+
+	if field.DefaultValue.IsValid() && leafField != nil {
+		g.generateIntoPbEmbedDefaultField(gf, field, pathInfo, caseCheck, protoIsPointer, f)
+		return
+	}
+
+	// Maps - set only a non-empty map, converting generate=true values via IntoPb()
+	if field.IsMap {
+		valueExpr := srcField
+		if field.MapValue != nil && field.MapValue.Kind == KindMessage && g.getMessageOptionsFromField(field.MapValue).GetGenerate() {
+			valueExpr = "_map"
+		}
+		initCode, assignCode := pathInfo.BuildSetterCode(gf, "pb", valueExpr, false)
+		gf.P("\tif len(", srcField, ") > 0", caseCheck, " {")
+		if valueExpr == "_map" {
+			keyType := "string"
+			if field.MapKey != nil {
+				keyType = field.MapKey.GoType.Name
+			}
+			gf.P("\t\t_map := make(map[", keyType, "]", g.buildPbMapValueType(gf, field, f), ", len(", srcField, "))")
+			gf.P("\t\tfor k, v := range ", srcField, " {")
+			gf.P("\t\t\tif v != nil {")
+			gf.P("\t\t\t\t_map[k] = v.IntoPb()")
+			gf.P("\t\t\t}")
+			gf.P("\t\t}")
+		}
+		if initCode != "" {
+			gf.P(initCode)
+		}
+		gf.P("\t\t", assignCode)
+		gf.P("\t}")
+		return
+	}
 
 	// Determine if value needs conversion
 	valueExpr := srcField
@@ -901,53 +1370,36 @@ func (g *Generator) generateIntoPbEmbedField(gf *protogen.GeneratedFile, field *
 		msgOpts := g.getMessageOptionsFromField(field)
 		if msgOpts != nil && msgOpts.Generate {
 			if field.IsRepeated {
-				// Repeated message - need to handle slice conversion
-				// This is complex for embedded fields, generate helper code
-				initCode, _ := pathInfo.BuildSetterCode(gf, "pb", "nil", true)
-
-				// Add case check for oneof fields
-				if caseCheck != "" {
-					gf.P("\tif p.", field.OneofGoName, "Case == \"", field.OneofVariant, "\" {")
-					if initCode != "" {
-						gf.P(initCode)
-					}
-					gf.P("\t\t// TODO: repeated message conversion for embedded field")
-					gf.P("\t}")
-				} else {
-					if initCode != "" {
-						gf.P(initCode)
-					}
-					gf.P("\t// TODO: repeated message conversion for embedded field")
+				// Repeated plain: []PlainType -> []*ProtoMessage via IntoPb()
+				initCode, assignCode := pathInfo.BuildSetterCode(gf, "pb", "_items", false)
+				gf.P("\tif len(", srcField, ") > 0", caseCheck, " {")
+				gf.P("\t\t_items := make(", g.buildPbSliceType(gf, field, f), ", len(", srcField, "))")
+				gf.P("\t\tfor i := range ", srcField, " {")
+				gf.P("\t\t\t_items[i] = (&", srcField, "[i]).IntoPb()")
+				gf.P("\t\t}")
+				if initCode != "" {
+					gf.P(initCode)
 				}
+				gf.P("\t\t", assignCode)
+				gf.P("\t}")
 				return
 			}
 			// Plain type - call IntoPb()
 			valueExpr = srcField + ".IntoPb()"
 			valueIsPointer = true // IntoPb returns pointer
-		} else if field.IsRepeated && !field.GoType.IsPointer && leafField != nil && leafField.Message != nil {
-			// Plain is []T, proto is []*T - need to convert
-			initCode, _ := pathInfo.BuildSetterCode(gf, "pb", "nil", true)
-			elemType := gf.QualifiedGoIdent(leafField.Message.GoIdent)
-			getterChain := pathInfo.BuildGetterChain("pb")
-
-			// Add case check for oneof fields
-			if caseCheck != "" {
-				gf.P("\tif p.", field.OneofGoName, "Case == \"", field.OneofVariant, "\" && len(", srcField, ") > 0 {")
-			} else {
-				gf.P("\tif len(", srcField, ") > 0 {")
-			}
+		} else if field.IsRepeated && !field.GoType.IsPointer && !field.NeedsCaster && leafField != nil && leafField.Message != nil {
+			// Plain is []T, proto is []*T - point to the plain elements
+			initCode, assignCode := pathInfo.BuildSetterCode(gf, "pb", "_items", false)
+			gf.P("\tif len(", srcField, ") > 0", caseCheck, " {")
+			gf.P("\t\t_items := make(", g.buildPbSliceType(gf, field, f), ", len(", srcField, "))")
+			gf.P("\t\tfor i := range ", srcField, " {")
+			gf.P("\t\t\t_items[i] = &", srcField, "[i]")
+			gf.P("\t\t}")
 			if initCode != "" {
 				gf.P(initCode)
 			}
-			gf.P("\t\tfor _, v := range ", srcField, " {")
-			gf.P("\t\t\tvCopy := v")
-			gf.P("\t\t\t_ = ", getterChain) // Ensure path is initialized
-			gf.P("\t\t\t// Append to slice")
-			gf.P("\t\t\t_ = &", elemType, "{} // ensure import")
-			gf.P("\t\t\t_ = vCopy")
-			gf.P("\t\t}")
+			gf.P("\t\t", assignCode)
 			gf.P("\t}")
-			gf.P("\t// TODO: complete repeated field assignment")
 			return
 		} else if field.NeedsCaster {
 			// Message with type override (e.g., time.Time -> Timestamp)
@@ -959,6 +1411,31 @@ func (g *Generator) generateIntoPbEmbedField(gf *protogen.GeneratedFile, field *
 			}
 			valueIsPointer = true // Caster returns pointer for Timestamp
 		}
+	} else if (field.EnumAsString || field.EnumAsInt) && field.IsRepeated {
+		// []string / []int32 back to repeated enum
+		enumType := g.qualifyType(gf, GoType{
+			Name:       field.Source.Enum.GoIdent.GoName,
+			ImportPath: string(field.Source.Enum.GoIdent.GoImportPath),
+		}, f)
+		initCode, assignCode := pathInfo.BuildSetterCode(gf, "pb", "_enums", false)
+		gf.P("\tif len(", srcField, ") > 0", caseCheck, " {")
+		gf.P("\t\t_enums := make([]", enumType, ", len(", srcField, "))")
+		gf.P("\t\tfor i, v := range ", srcField, " {")
+		gf.P("\t\t\t_enums[i] = ", g.enumIntoPbExpr(gf, field, "v", f))
+		gf.P("\t\t}")
+		if initCode != "" {
+			gf.P(initCode)
+		}
+		gf.P("\t\t", assignCode)
+		gf.P("\t}")
+		return
+	} else if (field.EnumAsString || field.EnumAsInt) && plainIsPointer && protoIsPointer {
+		// Optional string / int32 back to optional enum
+		valueExpr = g.pbPointerExpr(gf, field, "*"+srcField, f)
+		valueIsPointer = true
+	} else if field.EnumAsString || field.EnumAsInt {
+		// String / int32 back to enum
+		valueExpr = g.enumIntoPbExpr(gf, field, srcField, f)
 	} else if field.NeedsCaster {
 		// Scalar with type override
 		if plainIsPointer {
@@ -982,7 +1459,8 @@ func (g *Generator) generateIntoPbEmbedField(gf *protogen.GeneratedFile, field *
 
 	// Generate nil check for source value (with case check for oneof fields)
 	// For overridden types (e.g., time.Time from Timestamp), check nil if plainIsPointer
-	needsNilCheck := plainIsPointer || (field.Kind == KindMessage && !field.IsRepeated && !field.NeedsCaster)
+	// Slices are checked for length, an empty one doesn't create the parent messages
+	needsNilCheck := !field.IsRepeated && (plainIsPointer || (field.Kind == KindMessage && !field.NeedsCaster))
 	if needsNilCheck {
 		gf.P("\tif ", srcField, " != nil", caseCheck, " {")
 		if initCode != "" {
@@ -1000,7 +1478,7 @@ func (g *Generator) generateIntoPbEmbedField(gf *protogen.GeneratedFile, field *
 	} else if field.GoType.Name == "string" {
 		// Check for non-empty string (with case check for oneof)
 		if caseCheck != "" {
-			gf.P("\tif p.", field.OneofGoName, "Case == \"", field.OneofVariant, "\" {")
+			gf.P("\tif p.", field.OneofGoName, "Case == ", field.OneofCaseConst, " {")
 		} else {
 			gf.P("\tif ", srcField, " != \"\" {")
 		}
@@ -1011,7 +1489,7 @@ func (g *Generator) generateIntoPbEmbedField(gf *protogen.GeneratedFile, field *
 		gf.P("\t}")
 	} else if caseCheck != "" {
 		// Scalar with case check
-		gf.P("\tif p.", field.OneofGoName, "Case == \"", field.OneofVariant, "\" {")
+		gf.P("\tif p.", field.OneofGoName, "Case == ", field.OneofCaseConst, " {")
 		if initCode != "" {
 			gf.P(initCode)
 		}
@@ -1026,6 +1504,65 @@ func (g *Generator) generateIntoPbEmbedField(gf *protogen.GeneratedFile, field *
 	}
 }
 
+// generateIntoPbEmbedDefaultField assigns an embedded field with [default = ...]:
+// the path is created only when the value differs from the default, required
+// fields are always set
+func (g *Generator) generateIntoPbEmbedDefaultField(gf *protogen.GeneratedFile, field *IRField, pathInfo *PathInfo, caseCheck string, protoIsPointer bool, f *protogen.File) {
+	srcField := "p." + field.GoName
+	valueExpr := g.pbValueExpr(gf, field, srcField, f)
+	if protoIsPointer {
+		valueExpr = g.pbPointerExpr(gf, field, srcField, f)
+	}
+	initCode, assignCode := pathInfo.BuildSetterCode(gf, "pb", valueExpr, protoIsPointer)
+
+	var conds []string
+	if pathInfo.LeafField.Desc.Cardinality() != protoreflect.Required {
+		conds = append(conds, g.defaultCheck(gf, field, srcField, f))
+	}
+	if caseCheck != "" {
+		conds = append(conds, strings.TrimPrefix(caseCheck, " && "))
+	}
+	if len(conds) == 0 {
+		if initCode != "" {
+			gf.P(initCode)
+		}
+		gf.P("\t", assignCode)
+		return
+	}
+	gf.P("\tif ", strings.Join(conds, " && "), " {")
+	if initCode != "" {
+		gf.P(initCode)
+	}
+	gf.P("\t\t", assignCode)
+	gf.P("\t}")
+}
+
+// generateIntoPbEmbedItems converts a slice of repeated embed rows back into
+// protobuf elements. As in IntoPlain, p and pb are shadowed inside the loop.
+func (g *Generator) generateIntoPbEmbedItems(gf *protogen.GeneratedFile, field *IRField, pathInfo *PathInfo, caseCheck string, f *protogen.File) {
+	item := field.EmbedItem
+	srcField := "p." + field.GoName
+	elemType := gf.QualifiedGoIdent(field.Source.Message.GoIdent)
+	initCode, assignCode := pathInfo.BuildSetterCode(gf, "pb", "_items", false)
+
+	gf.P("\tif len(", srcField, ") > 0", caseCheck, " {")
+	gf.P("\t\t_items := make([]*", elemType, ", len(", srcField, "))")
+	gf.P("\t\tfor i := range ", srcField, " {")
+	gf.P("\t\t\t_items[i] = &", elemType, "{}")
+	if len(item.Fields) > 0 {
+		gf.P("\t\t\tp, pb := &", srcField, "[i], _items[i]")
+		for _, rowField := range item.Fields {
+			g.generateIntoPbField(gf, rowField, item, f)
+		}
+	}
+	gf.P("\t\t}")
+	if initCode != "" {
+		gf.P(initCode)
+	}
+	gf.P("\t\t", assignCode)
+	gf.P("\t}")
+}
+
 // generateIntoPbOneofField handles oneof field assignment (scalar or message)
 func (g *Generator) generateIntoPbOneofField(gf *protogen.GeneratedFile, field *IRField, msg *IRMessage, f *protogen.File) {
 	if field.Source == nil {
@@ -1054,13 +1591,13 @@ func (g *Generator) generateIntoPbOneofField(gf *protogen.GeneratedFile, field *
 	// Determine if field is message or scalar
 	if field.Source.Message != nil {
 		// Message field - check for nil
-		gf.P("\tif ", srcField, " != nil && p.", caseFieldName, " == \"", field.OneofVariant, "\" {")
+		gf.P("\tif ", srcField, " != nil && p.", caseFieldName, " == ", field.OneofCaseConst, " {")
 		gf.P("\t\tpb.", oneof.GoName, " = &", wrapperType, "{", field.Source.GoName, ": ", srcField, "}")
 		gf.P("\t}")
 	} else {
 		// Scalar field - check for case only
-		gf.P("\tif p.", caseFieldName, " == \"", field.OneofVariant, "\" {")
-		gf.P("\t\tpb.", oneof.GoName, " = &", wrapperType, "{", field.Source.GoName, ": ", srcField, "}")
+		gf.P("\tif p.", caseFieldName, " == ", field.OneofCaseConst, " {")
+		gf.P("\t\tpb.", oneof.GoName, " = &", wrapperType, "{", field.Source.GoName, ": ", g.enumIntoPbExpr(gf, field, srcField, f), "}")
 		gf.P("\t}")
 	}
 }
@@ -1146,7 +1683,7 @@ func (g *Generator) generateIntoPbTypeAliasField(gf *protogen.GeneratedFile, fie
 		oneofWrapperType := gf.QualifiedGoIdent(oneofWrapperIdent)
 		caseFieldName := field.OneofGoName + "Case"
 
-		gf.P("\tif p.", caseFieldName, " == \"", field.OneofVariant, "\" {")
+		gf.P("\tif p.", caseFieldName, " == ", field.OneofCaseConst, " {")
 		gf.P("\t\tpb.", oneof.GoName, " = &", oneofWrapperType, "{", field.Source.GoName, ": &", wrapperType, "{", aliasFieldName, ": ", srcField, "}}")
 		gf.P("\t}")
 		return
@@ -1199,6 +1736,77 @@ type AssignmentPath struct {
 	Path     string // e.g., "pb.PlatformEvent.(*Heartbeat).Agent"
 }
 
+// enumIntoPbExpr converts enum_as_string / enum_as_int plain value back to the enum type.
+// For other fields the expression is returned as is. Unknown names are
+// reported through _err of IntoPbE.
+func (g *Generator) enumIntoPbExpr(gf *protogen.GeneratedFile, field *IRField, expr string, f *protogen.File) string {
+	if field.Source == nil || field.Source.Enum == nil || !(field.EnumAsString || field.EnumAsInt) {
+		return expr
+	}
+	enumType := g.qualifyType(gf, GoType{
+		Name:       field.Source.Enum.GoIdent.GoName,
+		ImportPath: string(field.Source.Enum.GoIdent.GoImportPath),
+	}, f)
+	if field.EnumAsString {
+		return gf.QualifiedGoIdent(enumjxPkg.Ident("TryName")) + "[" + enumType + "](" + expr + ", \"" +
+			string(field.Source.Enum.Desc.FullName()) + "\", " + enumType + "_value, \"" + casterErrPath(field) + "\", &_err)"
+	}
+	return enumType + "(" + expr + ")"
+}
+
+// plainValueExpr converts a non-pointer protobuf scalar/enum value to the Plain field type
+func (g *Generator) plainValueExpr(gf *protogen.GeneratedFile, field *IRField, expr string, f *protogen.File) string {
+	switch {
+	case field.EnumAsString:
+		return expr + ".String()"
+	case field.EnumAsInt:
+		return "int32(" + expr + ")"
+	case g.needsTypeCast(field) && !field.GoType.IsSlice && field.Kind != KindBytes:
+		return g.qualifyType(gf, field.GoType, f) + "(" + expr + ")"
+	}
+	return expr
+}
+
+// pbValueExpr converts a non-pointer Plain scalar/enum value to the protobuf field type
+func (g *Generator) pbValueExpr(gf *protogen.GeneratedFile, field *IRField, expr string, f *protogen.File) string {
+	switch {
+	case field.EnumAsString || field.EnumAsInt:
+		return g.enumIntoPbExpr(gf, field, expr, f)
+	case g.needsTypeCast(field) && !field.GoType.IsSlice && field.Kind != KindBytes:
+		return g.getSourceTypeName(field) + "(" + expr + ")"
+	}
+	return expr
+}
+
+// pbPointerExpr converts a non-pointer Plain scalar/enum value to a pointer
+// for a protobuf field with explicit presence (proto.String, Enum.Enum() etc.)
+func (g *Generator) pbPointerExpr(gf *protogen.GeneratedFile, field *IRField, expr string, f *protogen.File) string {
+	value := g.pbValueExpr(gf, field, expr, f)
+	if field.Source.Desc.Kind() == protoreflect.EnumKind {
+		return value + ".Enum()"
+	}
+	var ident string
+	switch field.Source.Desc.Kind() {
+	case protoreflect.BoolKind:
+		ident = "Bool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		ident = "Int32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		ident = "Int64"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		ident = "Uint32"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		ident = "Uint64"
+	case protoreflect.FloatKind:
+		ident = "Float32"
+	case protoreflect.DoubleKind:
+		ident = "Float64"
+	default:
+		ident = "String"
+	}
+	return gf.QualifiedGoIdent(protoPkg.Ident(ident)) + "(" + value + ")"
+}
+
 // buildPbNavigationPath builds the getter chain for reading from protobuf
 func (g *Generator) buildPbNavigationPath(field *IRField, msg *IRMessage) NavigationPath {
 	if field.Source != nil {
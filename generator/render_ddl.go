@@ -0,0 +1,180 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/yaroher/protoc-gen-go-plain/logger"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SQL column types of the generated DDL (PostgreSQL), see sqlColumnType
+const (
+	sqlTypeJSON      = "JSONB"
+	sqlTypeText      = "TEXT"
+	sqlTypeTimestamp = "TIMESTAMPTZ"
+)
+
+// sqlKindTypes maps proto scalar kinds to column types. Unsigned types get
+// the next wider signed type, uint64 doesn't fit BIGINT.
+var sqlKindTypes = map[protoreflect.Kind]string{
+	protoreflect.BoolKind:     "BOOLEAN",
+	protoreflect.Int32Kind:    "INTEGER",
+	protoreflect.Sint32Kind:   "INTEGER",
+	protoreflect.Sfixed32Kind: "INTEGER",
+	protoreflect.Uint32Kind:   "BIGINT",
+	protoreflect.Fixed32Kind:  "BIGINT",
+	protoreflect.Int64Kind:    "BIGINT",
+	protoreflect.Sint64Kind:   "BIGINT",
+	protoreflect.Sfixed64Kind: "BIGINT",
+	protoreflect.Uint64Kind:   "NUMERIC(20)",
+	protoreflect.Fixed64Kind:  "NUMERIC(20)",
+	protoreflect.FloatKind:    "REAL",
+	protoreflect.DoubleKind:   "DOUBLE PRECISION",
+	protoreflect.StringKind:   sqlTypeText,
+	protoreflect.BytesKind:    "BYTEA",
+	protoreflect.EnumKind:     "INTEGER",
+}
+
+// hasTables reports whether a message of the file or a nested one has a table
+func hasTables(msgs []*IRMessage) bool {
+	for _, msg := range msgs {
+		if msg.Table != nil || hasTables(msg.Nested) {
+			return true
+		}
+	}
+	return false
+}
+
+// generateDDLFile writes CREATE TABLE statements for the messages with
+// (goplain.message).table to <file>_plain.sql
+func (g *Generator) generateDDLFile(f *protogen.File, irFile *IRFile) {
+	filename := f.GeneratedFilenamePrefix + "_plain.sql"
+	gf := g.Plugin.NewGeneratedFile(filename, "")
+
+	logger.Debug("generating ddl file", zap.String("filename", filename))
+
+	gf.P("-- Code generated by protoc-gen-go-plain. DO NOT EDIT.")
+	gf.P("-- source: ", f.Desc.Path())
+
+	var generate func(msgs []*IRMessage)
+	generate = func(msgs []*IRMessage) {
+		for _, msg := range msgs {
+			if msg.Table != nil {
+				gf.P()
+				g.generateTable(gf, msg)
+			}
+			generate(msg.Nested)
+		}
+	}
+	generate(irFile.Messages)
+}
+
+// generateTable writes CREATE TABLE and CREATE INDEX statements of a message.
+// Columns follow the order of Columns() generated with sql=true.
+func (g *Generator) generateTable(gf *protogen.GeneratedFile, msg *IRMessage) {
+	table := msg.Table
+	lines := make([]string, 0, len(msg.Fields)+len(msg.EmbeddedOneofs)+1)
+	for _, field := range msg.Fields {
+		typ, nullable := g.sqlColumnType(field)
+		lines = append(lines, sqlColumnDef(field.Name, typ, nullable))
+	}
+	for _, eo := range msg.EmbeddedOneofs {
+		// Case fields hold the proto name of the set variant, empty when none is set
+		lines = append(lines, sqlColumnDef(eo.JSONName, sqlTypeText, false))
+	}
+	if len(table.PrimaryKey) > 0 {
+		lines = append(lines, "PRIMARY KEY ("+sqlIdents(table.PrimaryKey)+")")
+	}
+
+	gf.P("-- ", table.Name, " stores ", msg.GoName)
+	gf.P("CREATE TABLE ", sqlIdent(table.Name), " (")
+	for i, line := range lines {
+		if i < len(lines)-1 {
+			line += ","
+		}
+		gf.P("    ", line)
+	}
+	gf.P(");")
+
+	for _, idx := range table.Indexes {
+		create := "CREATE INDEX "
+		if idx.Unique {
+			create = "CREATE UNIQUE INDEX "
+		}
+		gf.P()
+		gf.P(create, sqlIdent(idx.Name), " ON ", sqlIdent(table.Name), " (", sqlIdents(idx.Columns), ");")
+	}
+}
+
+func sqlColumnDef(name, typ string, nullable bool) string {
+	if nullable {
+		return sqlIdent(name) + " " + typ
+	}
+	return sqlIdent(name) + " " + typ + " NOT NULL"
+}
+
+// sqlIdent quotes an identifier, so that names like order or user can be columns
+func sqlIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// sqlIdents quotes a list of identifiers
+func sqlIdents(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = sqlIdent(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// sqlColumnType returns the column type of a field and whether it is nullable.
+// Columns match the values written by Values() with sql=true: JSON columns are
+// NULL for nil values, pointers are NULL when unset, drivers write nil []byte as NULL.
+func (g *Generator) sqlColumnType(field *IRField) (string, bool) {
+	nullable := field.IsOptional || g.plainIsPointer(field) || field.GoType.Name == "[]byte"
+	typ := g.sqlKindType(field)
+	if typ == sqlTypeJSON {
+		nullable = true
+	}
+	if field.SQLType != "" {
+		typ = field.SQLType
+	}
+	return typ, nullable
+}
+
+// sqlKindType derives the column type from the field kind
+func (g *Generator) sqlKindType(field *IRField) string {
+	switch {
+	case field.IsMap || field.IsRepeated || field.Origin == OriginSerialized:
+		return sqlTypeJSON
+	case field.NativeWKT == "google.protobuf.Timestamp":
+		return sqlTypeTimestamp
+	case field.NativeWKT == "google.protobuf.Duration":
+		// time.Duration goes to the driver as int64 nanoseconds
+		return sqlKindTypes[protoreflect.Int64Kind]
+	case nativeWKTs[protoreflect.FullName(field.NativeWKT)].PbType.ImportPath == wrapperspbPath:
+		// Wrappers are nullable values of the wrapped kind
+		return sqlKindTypes[field.Source.Message.Fields[0].Desc.Kind()]
+	case field.NativeWKT != "":
+		// Struct, Value, ListValue, Empty
+		return sqlTypeJSON
+	case field.Kind == KindMessage && g.valueKindOf(field) == valueOverride:
+		// Overridden message types go to the driver as is, their column type
+		// is only known from sql_type
+		return sqlTypeText
+	case field.Kind == KindMessage:
+		return sqlTypeJSON
+	case field.ScalarKind == protoreflect.EnumKind:
+		// enum_as_string and WithForceEnumAsString store enums by name
+		if field.GoType.Name == "string" {
+			return sqlTypeText
+		}
+		return sqlKindTypes[protoreflect.EnumKind]
+	}
+	if typ, ok := sqlKindTypes[field.ScalarKind]; ok {
+		return typ
+	}
+	return sqlTypeText
+}
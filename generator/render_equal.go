@@ -0,0 +1,148 @@
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+var (
+	bytesPkg      = protogen.GoImportPath("bytes")
+	slicesPkg     = protogen.GoImportPath("slices")
+	mapsPkg       = protogen.GoImportPath("maps")
+	plainequalPkg = protogen.GoImportPath("github.com/yaroher/protoc-gen-go-plain/plainequal")
+)
+
+// notEqualExpr returns a condition that is true when the values a and b differ.
+// isPointer tells whether Plain values are pointers.
+func (g *Generator) notEqualExpr(gf *protogen.GeneratedFile, kind valueKind, a, b string, isPointer bool) string {
+	switch kind {
+	case valueBytes:
+		return "!" + gf.QualifiedGoIdent(bytesPkg.Ident("Equal")) + "(" + a + ", " + b + ")"
+	case valuePlain:
+		if isPointer {
+			return "!" + a + ".Equal(" + b + ")"
+		}
+		return "!" + a + ".Equal(&" + b + ")"
+	case valueProto:
+		return "!" + gf.QualifiedGoIdent(protoPkg.Ident("Equal")) + "(" + a + ", " + b + ")"
+	case valueStruct:
+		return "!" + gf.QualifiedGoIdent(wktPkg.Ident("EqualStruct")) + "(" + a + ", " + b + ")"
+	case valueList:
+		return "!" + gf.QualifiedGoIdent(wktPkg.Ident("EqualList")) + "(" + a + ", " + b + ")"
+	case valueValue:
+		return "!" + gf.QualifiedGoIdent(wktPkg.Ident("EqualValue")) + "(" + a + ", " + b + ")"
+	case valueTime:
+		return "!" + a + ".Equal(" + b + ")"
+	case valueOverride:
+		return "!" + gf.QualifiedGoIdent(plainequalPkg.Ident("Equal")) + "(" + a + ", " + b + ")"
+	default:
+		return a + " != " + b
+	}
+}
+
+// generateEqualMethod generates an Equal method comparing Plain structs field by field
+func (g *Generator) generateEqualMethod(gf *protogen.GeneratedFile, msg *IRMessage) {
+	plainType := msg.GoName
+
+	gf.P("// Equal reports whether p and other hold the same values.")
+	gf.P("// Nil and empty slices and maps are equal, as in protobuf.")
+	gf.P("func (p *", plainType, ") Equal(other *", plainType, ") bool {")
+	gf.P("\tif p == nil || other == nil {")
+	gf.P("\t\treturn p == other")
+	gf.P("\t}")
+
+	for _, eo := range msg.EmbeddedOneofs {
+		gf.P("\tif p.", eo.CaseFieldName, " != other.", eo.CaseFieldName, " {")
+		gf.P("\t\treturn false")
+		gf.P("\t}")
+	}
+	for _, field := range msg.Fields {
+		g.generateFieldEqual(gf, field)
+	}
+
+	gf.P("\treturn true")
+	gf.P("}")
+	gf.P()
+}
+
+// generateFieldEqual generates the comparison of a single field of p and other
+func (g *Generator) generateFieldEqual(gf *protogen.GeneratedFile, field *IRField) {
+	a := "p." + field.GoName
+	b := "other." + field.GoName
+
+	switch {
+	case field.IsMap:
+		g.generateMapEqual(gf, field)
+		return
+	case field.IsRepeated || field.GoType.IsSlice:
+		g.generateSliceEqual(gf, field)
+		return
+	}
+
+	kind := g.valueKindOf(field)
+	var cond string
+	switch {
+	case kind == valuePlain || kind == valueProto:
+		cond = g.notEqualExpr(gf, kind, a, b, true)
+	case g.plainIsPointer(field) && !field.GoType.IsPointer:
+		// Optional values: both unset, or both set to equal values
+		deref := "*" + a
+		if kind == valueTime {
+			deref = "(*" + a + ")"
+		}
+		cond = "(" + a + " == nil) != (" + b + " == nil) || " + a + " != nil && " +
+			g.notEqualExpr(gf, kind, deref, "*"+b, false)
+	default:
+		cond = g.notEqualExpr(gf, kind, a, b, false)
+	}
+	gf.P("\tif ", cond, " {")
+	gf.P("\t\treturn false")
+	gf.P("\t}")
+}
+
+// generateSliceEqual compares a repeated field element by element
+func (g *Generator) generateSliceEqual(gf *protogen.GeneratedFile, field *IRField) {
+	a := "p." + field.GoName
+	b := "other." + field.GoName
+	kind := g.valueKindOf(field)
+
+	if kind == valueScalar {
+		gf.P("\tif !", gf.QualifiedGoIdent(slicesPkg.Ident("Equal")), "(", a, ", ", b, ") {")
+		gf.P("\t\treturn false")
+		gf.P("\t}")
+		return
+	}
+	gf.P("\tif len(", a, ") != len(", b, ") {")
+	gf.P("\t\treturn false")
+	gf.P("\t}")
+	gf.P("\tfor i := range ", a, " {")
+	gf.P("\t\tif ", g.notEqualExpr(gf, kind, a+"[i]", b+"[i]", field.GoType.IsPointer), " {")
+	gf.P("\t\t\treturn false")
+	gf.P("\t\t}")
+	gf.P("\t}")
+}
+
+// generateMapEqual compares a map field entry by entry
+func (g *Generator) generateMapEqual(gf *protogen.GeneratedFile, field *IRField) {
+	a := "p." + field.GoName
+	b := "other." + field.GoName
+	kind, isPointer := valueScalar, false
+	if field.MapValue != nil {
+		kind, isPointer = g.valueKindOf(field.MapValue), field.MapValue.GoType.IsPointer
+	}
+
+	if kind == valueScalar {
+		gf.P("\tif !", gf.QualifiedGoIdent(mapsPkg.Ident("Equal")), "(", a, ", ", b, ") {")
+		gf.P("\t\treturn false")
+		gf.P("\t}")
+		return
+	}
+	gf.P("\tif len(", a, ") != len(", b, ") {")
+	gf.P("\t\treturn false")
+	gf.P("\t}")
+	gf.P("\tfor k, v := range ", a, " {")
+	gf.P("\t\tw, ok := ", b, "[k]")
+	gf.P("\t\tif !ok || ", g.notEqualExpr(gf, kind, "v", "w", isPointer), " {")
+	gf.P("\t\t\treturn false")
+	gf.P("\t\t}")
+	gf.P("\t}")
+}
@@ -1,6 +1,8 @@
 package generator
 
 import (
+	"github.com/yaroher/protoc-gen-go-plain/logger"
+	"go.uber.org/zap"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -8,6 +10,7 @@ import (
 // Package paths for imports
 var jxPkg = protogen.GoImportPath("github.com/go-faster/jx")
 var fmtPkg = protogen.GoImportPath("fmt")
+var enumjxPkg = protogen.GoImportPath("github.com/yaroher/protoc-gen-go-plain/enumjx")
 
 // generateJSONMethods generates MarshalJX and UnmarshalJX methods
 func (g *Generator) generateJSONMethods(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File) {
@@ -34,7 +37,7 @@ func (g *Generator) generateMarshalJX(gf *protogen.GeneratedFile, msg *IRMessage
 	for _, eo := range msg.EmbeddedOneofs {
 		gf.P("\tif p.", eo.CaseFieldName, " != \"\" {")
 		gf.P("\t\te.FieldStart(\"", eo.JSONName, "\")")
-		gf.P("\t\te.Str(p.", eo.CaseFieldName, ")")
+		gf.P("\t\te.Str(p.", eo.CaseFieldName, ".String())")
 		gf.P("\t}")
 	}
 
@@ -68,6 +71,12 @@ func (g *Generator) generateMarshalJXField(gf *protogen.GeneratedFile, field *IR
 		jsonName = field.OneofJSONName
 	}
 
+	// Well-known types mapped to native Go types (wkt=native)
+	if field.NativeWKT != "" {
+		g.generateMarshalJXWKTField(gf, field, jsonName, fieldAccess)
+		return
+	}
+
 	// If WriteDefault is true, always write the field
 	if field.WriteDefault {
 		gf.P("\te.FieldStart(\"", jsonName, "\")")
@@ -75,8 +84,18 @@ func (g *Generator) generateMarshalJXField(gf *protogen.GeneratedFile, field *IR
 		return
 	}
 
+	// Fields with [default = ...] are omitted when equal to the default
+	if field.DefaultValue.IsValid() {
+		gf.P("\tif ", g.defaultCheck(gf, field, fieldAccess, f), " {")
+		gf.P("\t\te.FieldStart(\"", jsonName, "\")")
+		g.generateMarshalJXValue(gf, field, fieldAccess, f, "\t\t")
+		gf.P("\t}")
+		return
+	}
+
 	// Handle optional/pointer fields with omitempty
-	if field.GoType.IsPointer {
+	// Bytes with explicit presence are unset when nil, as in protobuf-go
+	if g.plainIsPointer(field) || (field.Source != nil && !field.IsRepeated && pbBytesHasPresence(field.Source)) {
 		gf.P("\tif ", fieldAccess, " != nil {")
 		gf.P("\t\te.FieldStart(\"", jsonName, "\")")
 		g.generateMarshalJXValue(gf, field, fieldAccess, f, "\t\t")
@@ -137,7 +156,7 @@ func (g *Generator) getScalarZeroCheck(field *IRField, access string) string {
 func (g *Generator) generateMarshalJXValue(gf *protogen.GeneratedFile, field *IRField, access string, f *protogen.File, indent string) {
 	// Handle pointer dereference - but NOT for message types (protojson needs pointer)
 	valueAccess := access
-	if field.GoType.IsPointer && !field.IsRepeated && field.Kind != KindMessage {
+	if g.plainIsPointer(field) && !field.IsRepeated && field.Kind != KindMessage {
 		valueAccess = "*" + access
 	}
 
@@ -172,21 +191,7 @@ func (g *Generator) generateMarshalJXSingleValue(gf *protogen.GeneratedFile, fie
 	case KindScalar:
 		g.generateMarshalJXScalar(gf, field, valueAccess, indent)
 	case KindMessage:
-		// Check if the message type has MarshalJX:
-		// 1. Plain type (generate=true)
-		// 2. Protobuf type when JXPB=true AND it's in the same Go import path
-		hasMarshalJX := false
-		if field.Source != nil && field.Source.Message != nil {
-			msgOpts := g.getMessageOptions(field.Source.Message)
-			isPlainType := msgOpts != nil && msgOpts.Generate
-			// Check if JXPB is enabled and message is in same Go package (has JX generated)
-			msgImportPath := string(field.Source.Message.GoIdent.GoImportPath)
-			fileImportPath := string(f.GoImportPath)
-			isLocalPb := g.Settings.JXPB && msgImportPath == fileImportPath
-			hasMarshalJX = isPlainType || isLocalPb
-		}
-
-		if hasMarshalJX {
+		if g.messageHasJX(field, f) {
 			// Has MarshalJX method
 			// If access is "v" from array iteration and type is not pointer, need &v
 			if access == "v" && !field.GoType.IsPointer {
@@ -195,7 +200,8 @@ func (g *Generator) generateMarshalJXSingleValue(gf *protogen.GeneratedFile, fie
 				gf.P(indent, access, ".MarshalJX(e)")
 			}
 		} else {
-			// Protobuf type - use protojson (needs pointer)
+			// Protobuf type without jx methods - use protojson (needs pointer)
+			g.logProtojsonFallback(field, f)
 			protojsonPkg := protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
 			// If access is "v" from array iteration and type is value (not pointer), need &v
 			marshalAccess := access
@@ -222,6 +228,61 @@ func (g *Generator) generateMarshalJXSingleValue(gf *protogen.GeneratedFile, fie
 	}
 }
 
+// messageHasJX reports whether the message field type has MarshalJX/UnmarshalJX:
+// 1. Plain type (generate=true) or repeated embed row
+// 2. Protobuf type with jx_pb methods (see pbHasJX)
+func (g *Generator) messageHasJX(field *IRField, f *protogen.File) bool {
+	if field.EmbedItem != nil {
+		return true
+	}
+	if field.Source == nil || field.Source.Message == nil {
+		return false
+	}
+	if msgOpts := g.getMessageOptions(field.Source.Message); msgOpts != nil && msgOpts.Generate {
+		return true
+	}
+	return g.pbHasJX(field.Source.Message, f)
+}
+
+// pbHasJX reports whether jx_pb methods exist for the protobuf message:
+// it is declared in a file generated in this plugin run (any Go package),
+// or in the same Go package as the current file
+func (g *Generator) pbHasJX(msg *protogen.Message, f *protogen.File) bool {
+	if !g.Settings.JXPB || g.isWellKnownType(msg) {
+		return false
+	}
+	if g.jxMessages == nil {
+		g.jxMessages = make(map[protoreflect.FullName]bool)
+		for _, file := range g.Plugin.Files {
+			if file.Generate {
+				collectJXMessages(file.Messages, g.jxMessages)
+			}
+		}
+	}
+	return g.jxMessages[msg.Desc.FullName()] || msg.GoIdent.GoImportPath == f.GoImportPath
+}
+
+// collectJXMessages adds messages that get jx_pb methods (all except map entries)
+func collectJXMessages(msgs []*protogen.Message, dst map[protoreflect.FullName]bool) {
+	for _, msg := range msgs {
+		if msg.Desc.IsMapEntry() {
+			continue
+		}
+		dst[msg.Desc.FullName()] = true
+		collectJXMessages(msg.Messages, dst)
+	}
+}
+
+// logProtojsonFallback records a message field encoded with protojson instead of jx
+func (g *Generator) logProtojsonFallback(field *IRField, f *protogen.File) {
+	g.protojsonFallbacks++
+	logger.Debug("protojson fallback",
+		zap.String("file", f.Desc.Path()),
+		zap.String("field", field.GoName),
+		zap.String("type", field.ProtoType),
+	)
+}
+
 // generateMarshalJXScalar generates encoding for scalar types
 func (g *Generator) generateMarshalJXScalar(gf *protogen.GeneratedFile, field *IRField, access string, indent string) {
 	// If field has type override, use ScalarKind (original proto type) for encoding
@@ -323,6 +384,12 @@ func (g *Generator) generateUnmarshalJX(gf *protogen.GeneratedFile, msg *IRMessa
 	gf.P("\t\treturn nil")
 	gf.P("\t}")
 	gf.P()
+	// Fields with [default = ...] missing in the input keep the default
+	for _, field := range msg.Fields {
+		if field.DefaultValue.IsValid() {
+			gf.P("\tp.", field.GoName, " = ", g.defaultLiteral(gf, field, f))
+		}
+	}
 	gf.P("\treturn d.Obj(func(d *", gf.QualifiedGoIdent(jxPkg.Ident("Decoder")), ", key string) error {")
 	gf.P("\t\tswitch key {")
 
@@ -331,7 +398,10 @@ func (g *Generator) generateUnmarshalJX(gf *protogen.GeneratedFile, msg *IRMessa
 		gf.P("\t\tcase \"", eo.JSONName, "\":")
 		gf.P("\t\t\tv, err := d.Str()")
 		gf.P("\t\t\tif err != nil { return err }")
-		gf.P("\t\t\tp.", eo.CaseFieldName, " = v")
+		gf.P("\t\t\tp.", eo.CaseFieldName, " = ", eo.CaseTypeName, "(v)")
+		gf.P("\t\t\tif v != \"\" && !p.", eo.CaseFieldName, ".Valid() {")
+		gf.P("\t\t\t\treturn ", gf.QualifiedGoIdent(fmtPkg.Ident("Errorf")), "(\"unknown variant %q of oneof ", string(eo.Source.Desc.FullName()), "\", v)")
+		gf.P("\t\t\t}")
 	}
 
 	// Group ALL fields by effective JSON name (OneofJSONName if set and unified_oneof_json enabled)
@@ -374,8 +444,8 @@ func (g *Generator) generateUnmarshalJX(gf *protogen.GeneratedFile, msg *IRMessa
 			} else {
 				gf.P("\t\t\tswitch p.", oneofCaseField, " {")
 				for _, field := range fields {
-					if field.OneofVariant != "" {
-						gf.P("\t\t\tcase \"", field.OneofVariant, "\":")
+					if field.OneofCaseConst != "" {
+						gf.P("\t\t\tcase ", field.OneofCaseConst, ":")
 					} else {
 						gf.P("\t\t\tcase \"\":")
 					}
@@ -409,6 +479,18 @@ func (g *Generator) generateUnmarshalJX(gf *protogen.GeneratedFile, msg *IRMessa
 
 // generateUnmarshalJXValue generates value decoding
 func (g *Generator) generateUnmarshalJXValue(gf *protogen.GeneratedFile, field *IRField, access string, f *protogen.File, indent string) {
+	if field.NativeWKT != "" {
+		g.generateUnmarshalJXWKT(gf, field, access, indent)
+		return
+	}
+	// null unsets fields with explicit presence, as in protojson
+	if field.IsOptional && !field.IsRepeated && field.Kind != KindMessage {
+		gf.P(indent, "if d.Next() == ", gf.QualifiedGoIdent(jxPkg.Ident("Null")), " {")
+		gf.P(indent, "\t", access, " = nil")
+		gf.P(indent, "\treturn d.Null()")
+		gf.P(indent, "}")
+	}
+
 	if field.IsRepeated && !field.IsMap {
 		// Array - use err pattern to allow Src_ append after
 		gf.P(indent, "if err := d.Arr(func(d *", gf.QualifiedGoIdent(jxPkg.Ident("Decoder")), ") error {")
@@ -434,21 +516,7 @@ func (g *Generator) generateUnmarshalJXSingleValue(gf *protogen.GeneratedFile, f
 	case KindScalar:
 		g.generateUnmarshalJXScalar(gf, field, access, f, indent, isArrayElem)
 	case KindMessage:
-		// Check if the message type has UnmarshalJX:
-		// 1. Plain type (generate=true)
-		// 2. Protobuf type when JXPB=true AND it's in the same Go import path
-		hasUnmarshalJX := false
-		if field.Source != nil && field.Source.Message != nil {
-			msgOpts := g.getMessageOptions(field.Source.Message)
-			isPlainType := msgOpts != nil && msgOpts.Generate
-			// Check if JXPB is enabled and message is in same Go package (has JX generated)
-			msgImportPath := string(field.Source.Message.GoIdent.GoImportPath)
-			fileImportPath := string(f.GoImportPath)
-			isLocalPb := g.Settings.JXPB && msgImportPath == fileImportPath
-			hasUnmarshalJX = isPlainType || isLocalPb
-		}
-
-		if hasUnmarshalJX {
+		if g.messageHasJX(field, f) {
 			// Has UnmarshalJX method
 			typeName := g.qualifyType(gf, field.GoType, f)
 			if isArrayElem {
@@ -472,7 +540,8 @@ func (g *Generator) generateUnmarshalJXSingleValue(gf *protogen.GeneratedFile, f
 				gf.P(indent, "}")
 			}
 		} else {
-			// Protobuf type - use protojson
+			// Protobuf type without jx methods - use protojson
+			g.logProtojsonFallback(field, f)
 			protojsonPkg := protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
 			gf.P(indent, "raw, err := d.Raw()")
 			gf.P(indent, "if err != nil { return err }")
@@ -496,26 +565,30 @@ func (g *Generator) generateUnmarshalJXSingleValue(gf *protogen.GeneratedFile, f
 			}
 		}
 	case KindEnum:
+		// Enum - accept both name and number, as protojson does
 		enumType := g.qualifyType(gf, field.GoType, f)
-		if field.EnumAsString {
-			gf.P(indent, "s, err := d.Str()")
-			gf.P(indent, "if err != nil { return err }")
-			gf.P(indent, "// TODO: parse enum from string")
-			gf.P(indent, "_ = s")
+		if field.Source != nil && field.Source.Enum != nil {
+			gf.P(indent, "v, err := ", g.enumDecodeCall(gf, field.Source.Enum, false))
 		} else {
 			gf.P(indent, "v, err := d.Int32()")
-			gf.P(indent, "if err != nil { return err }")
-			if isArrayElem {
-				gf.P(indent, access, " = append(", access, ", ", enumType, "(v))")
-			} else {
-				gf.P(indent, access, " = ", enumType, "(v)")
-			}
+		}
+		gf.P(indent, "if err != nil { return err }")
+		if isArrayElem {
+			gf.P(indent, access, " = append(", access, ", ", enumType, "(v))")
+		} else if g.plainIsPointer(field) {
+			gf.P(indent, "_ev := ", enumType, "(v)")
+			gf.P(indent, access, " = &_ev")
+		} else {
+			gf.P(indent, access, " = ", enumType, "(v)")
 		}
 	case KindBytes:
 		gf.P(indent, "v, err := d.Base64()")
 		gf.P(indent, "if err != nil { return err }")
 		if isArrayElem {
 			gf.P(indent, access, " = append(", access, ", v)")
+		} else if g.plainIsPointer(field) {
+			// proto3 optional bytes
+			gf.P(indent, access, " = &v")
 		} else {
 			gf.P(indent, access, " = v")
 		}
@@ -528,6 +601,20 @@ func (g *Generator) generateUnmarshalJXSingleValue(gf *protogen.GeneratedFile, f
 func (g *Generator) generateUnmarshalJXScalar(gf *protogen.GeneratedFile, field *IRField, access string, f *protogen.File, indent string, isArrayElem bool) {
 	var decodeCall, varType string
 
+	// enum_as_string - plain holds the enum name, validate it against the enum
+	if field.EnumAsString && field.Source != nil && field.Source.Enum != nil {
+		gf.P(indent, "v, err := ", g.enumDecodeCall(gf, field.Source.Enum, true))
+		gf.P(indent, "if err != nil { return err }")
+		if isArrayElem {
+			gf.P(indent, access, " = append(", access, ", v)")
+		} else if g.plainIsPointer(field) {
+			gf.P(indent, access, " = &v")
+		} else {
+			gf.P(indent, access, " = v")
+		}
+		return
+	}
+
 	// For fields with NeedsCaster, decode using original ScalarKind and cast to GoType
 	if field.NeedsCaster {
 		decodeCall, varType = g.getDecodeCallByKind(field.ScalarKind)
@@ -544,7 +631,7 @@ func (g *Generator) generateUnmarshalJXScalar(gf *protogen.GeneratedFile, field
 		castExpr := qualifiedType + "(v)"
 		if isArrayElem {
 			gf.P(indent, access, " = append(", access, ", ", castExpr, ")")
-		} else if field.GoType.IsPointer {
+		} else if g.plainIsPointer(field) {
 			gf.P(indent, "_tmp := ", castExpr)
 			gf.P(indent, access, " = &_tmp")
 		} else {
@@ -592,6 +679,9 @@ func (g *Generator) generateUnmarshalJXScalar(gf *protogen.GeneratedFile, field
 		gf.P(indent, "if err != nil { return err }")
 		if isArrayElem {
 			gf.P(indent, access, " = append(", access, ", v)")
+		} else if g.plainIsPointer(field) {
+			// proto3 optional bytes
+			gf.P(indent, access, " = &v")
 		} else {
 			gf.P(indent, access, " = v")
 		}
@@ -610,13 +700,25 @@ func (g *Generator) generateUnmarshalJXScalar(gf *protogen.GeneratedFile, field
 		} else {
 			gf.P(indent, access, " = append(", access, ", v)")
 		}
-	} else if field.GoType.IsPointer {
+	} else if g.plainIsPointer(field) {
 		gf.P(indent, access, " = &v")
 	} else {
 		gf.P(indent, access, " = v")
 	}
 }
 
+// enumDecodeCall returns enumjx call that decodes enum by name or number.
+// If asName is true, the call returns the enum value name instead of the number.
+func (g *Generator) enumDecodeCall(gf *protogen.GeneratedFile, enum *protogen.Enum, asName bool) string {
+	valuesMap := gf.QualifiedGoIdent(enum.GoIdent.GoImportPath.Ident(enum.GoIdent.GoName + "_value"))
+	fullName := string(enum.Desc.FullName())
+	if asName {
+		namesMap := gf.QualifiedGoIdent(enum.GoIdent.GoImportPath.Ident(enum.GoIdent.GoName + "_name"))
+		return gf.QualifiedGoIdent(enumjxPkg.Ident("DecodeName")) + "(d, \"" + fullName + "\", " + valuesMap + ", " + namesMap + ")"
+	}
+	return gf.QualifiedGoIdent(enumjxPkg.Ident("Decode")) + "(d, \"" + fullName + "\", " + valuesMap + ")"
+}
+
 // getDecodeCallByKind returns decoder call and variable type for protoreflect.Kind
 func (g *Generator) getDecodeCallByKind(kind protoreflect.Kind) (string, string) {
 	switch kind {
@@ -649,7 +751,7 @@ func (g *Generator) generateUnmarshalJXMap(gf *protogen.GeneratedFile, field *IR
 	}
 	valueType := "any"
 	if field.MapValue != nil {
-		valueType = field.MapValue.GoType.Name
+		valueType = g.qualifyType(gf, field.MapValue.GoType, f)
 		if field.MapValue.GoType.IsPointer {
 			valueType = "*" + valueType
 		}
@@ -658,7 +760,7 @@ func (g *Generator) generateUnmarshalJXMap(gf *protogen.GeneratedFile, field *IR
 	gf.P(indent, "if ", access, " == nil {")
 	gf.P(indent, "\t", access, " = make(map[", keyType, "]", valueType, ")")
 	gf.P(indent, "}")
-	gf.P(indent, "return d.Obj(func(d *", gf.QualifiedGoIdent(jxPkg.Ident("Decoder")), ", key string) error {")
+	gf.P(indent, "if err := d.Obj(func(d *", gf.QualifiedGoIdent(jxPkg.Ident("Decoder")), ", key string) error {")
 
 	// Convert key from string to map key type if needed
 	keyAccess := "key"
@@ -696,5 +798,7 @@ func (g *Generator) generateUnmarshalJXMap(gf *protogen.GeneratedFile, field *IR
 	}
 
 	gf.P(indent, "\treturn nil")
-	gf.P(indent, "})")
+	gf.P(indent, "}); err != nil {")
+	gf.P(indent, "\treturn err")
+	gf.P(indent, "}")
 }
@@ -78,7 +78,7 @@ func (g *Generator) generatePbMarshalJXField(gf *protogen.GeneratedFile, field *
 		gf.P("\t\te.FieldStart(\"", jsonName, "\")")
 		g.generatePbMarshalJXSingleValue(gf, field, fieldAccess, f, "\t\t")
 		gf.P("\t}")
-	} else if field.Desc.HasOptionalKeyword() {
+	} else if field.Desc.HasOptionalKeyword() || pbFieldIsPointer(field) {
 		// Optional scalar - check pointer field directly
 		// Note: bytes is never a pointer in proto3, even with optional
 		if field.Desc.Kind() == protoreflect.BytesKind {
@@ -321,10 +321,12 @@ func (g *Generator) generatePbUnmarshalJXField(gf *protogen.GeneratedFile, field
 	}
 
 	if field.Desc.IsList() {
-		gf.P("\t\t\treturn d.Arr(func(d *", gf.QualifiedGoIdent(jxPkg.Ident("Decoder")), ") error {")
+		gf.P("\t\t\tif err := d.Arr(func(d *", gf.QualifiedGoIdent(jxPkg.Ident("Decoder")), ") error {")
 		g.generatePbUnmarshalJXArrayElem(gf, field, fieldAccess, f, "\t\t\t\t")
 		gf.P("\t\t\t\treturn nil")
-		gf.P("\t\t\t})")
+		gf.P("\t\t\t}); err != nil {")
+		gf.P("\t\t\t\treturn err")
+		gf.P("\t\t\t}")
 		return
 	}
 
@@ -372,6 +374,12 @@ func (g *Generator) generatePbUnmarshalJXScalarOneof(gf *protogen.GeneratedFile,
 		gf.P(indent, "v, err := d.Float64()")
 	case protoreflect.StringKind:
 		gf.P(indent, "v, err := d.Str()")
+	case protoreflect.EnumKind:
+		enumType := gf.QualifiedGoIdent(field.Enum.GoIdent)
+		gf.P(indent, "ev, err := ", g.enumDecodeCall(gf, field.Enum, false))
+		gf.P(indent, "if err != nil { return err }")
+		gf.P(indent, "p.", oneof.GoName, " = &", wrapperType, "{", field.GoName, ": ", enumType, "(ev)}")
+		return
 	default:
 		gf.P(indent, "return d.Skip()")
 		return
@@ -408,11 +416,11 @@ func (g *Generator) generatePbUnmarshalJXSingleValue(gf *protogen.GeneratedFile,
 
 	if field.Enum != nil {
 		enumType := gf.QualifiedGoIdent(field.Enum.GoIdent)
-		gf.P(indent, "v, err := d.Int32()")
+		gf.P(indent, "v, err := ", g.enumDecodeCall(gf, field.Enum, false))
 		gf.P(indent, "if err != nil { return err }")
 		if isArrayElem {
 			gf.P(indent, access, " = append(", access, ", ", enumType, "(v))")
-		} else if field.Desc.HasOptionalKeyword() {
+		} else if pbFieldIsPointer(field) {
 			gf.P(indent, "_ev := ", enumType, "(v)")
 			gf.P(indent, access, " = &_ev")
 		} else {
@@ -428,7 +436,7 @@ func (g *Generator) generatePbUnmarshalJXSingleValue(gf *protogen.GeneratedFile,
 // generatePbUnmarshalJXScalarValue generates decoding for scalar field
 func (g *Generator) generatePbUnmarshalJXScalarValue(gf *protogen.GeneratedFile, field *protogen.Field, access string, indent string, isArrayElem bool) {
 	var decodeCall string
-	isOptional := field.Desc.HasOptionalKeyword()
+	isOptional := pbFieldIsPointer(field)
 
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
@@ -494,7 +502,7 @@ func (g *Generator) generatePbUnmarshalJXMap(gf *protogen.GeneratedFile, field *
 	gf.P(indent, "if ", access, " == nil {")
 	gf.P(indent, "\t", access, " = make(map[", keyType, "]", valueType, ")")
 	gf.P(indent, "}")
-	gf.P(indent, "return d.Obj(func(d *", gf.QualifiedGoIdent(jxPkg.Ident("Decoder")), ", key string) error {")
+	gf.P(indent, "if err := d.Obj(func(d *", gf.QualifiedGoIdent(jxPkg.Ident("Decoder")), ", key string) error {")
 
 	// Convert key if needed
 	keyAccess := "key"
@@ -535,7 +543,7 @@ func (g *Generator) generatePbUnmarshalJXMap(gf *protogen.GeneratedFile, field *
 	} else if valueField.Enum != nil {
 		// Decode enum value
 		enumType := gf.QualifiedGoIdent(valueField.Enum.GoIdent)
-		gf.P(indent, "\tv, err := d.Int32()")
+		gf.P(indent, "\tv, err := ", g.enumDecodeCall(gf, valueField.Enum, false))
 		gf.P(indent, "\tif err != nil { return err }")
 		gf.P(indent, "\t", access, "[", keyAccess, "] = ", enumType, "(v)")
 	} else {
@@ -544,7 +552,9 @@ func (g *Generator) generatePbUnmarshalJXMap(gf *protogen.GeneratedFile, field *
 	}
 
 	gf.P(indent, "\treturn nil")
-	gf.P(indent, "})")
+	gf.P(indent, "}); err != nil {")
+	gf.P(indent, "\treturn err")
+	gf.P(indent, "}")
 }
 
 // generatePbUnmarshalJXMapScalarValue generates scalar value decoding for map
@@ -690,3 +700,17 @@ func (g *Generator) pbGoTypeName(field *protogen.Field) string {
 		return "interface{}"
 	}
 }
+
+// pbFieldIsPointer reports whether the generated Go field of a singular scalar
+// or enum is a pointer: it has explicit presence (proto3 optional, proto2,
+// editions field_presence=EXPLICIT) and is not bytes or a oneof member
+func pbFieldIsPointer(field *protogen.Field) bool {
+	return field.Desc.HasPresence() && !field.Desc.IsList() && field.Message == nil &&
+		field.Desc.Kind() != protoreflect.BytesKind && (field.Oneof == nil || field.Oneof.Desc.IsSynthetic())
+}
+
+// pbBytesHasPresence reports whether a singular bytes field tracks presence with nil
+func pbBytesHasPresence(field *protogen.Field) bool {
+	return field.Desc.Kind() == protoreflect.BytesKind && field.Desc.HasPresence() && !field.Desc.IsList() &&
+		(field.Oneof == nil || field.Oneof.Desc.IsSynthetic())
+}
@@ -0,0 +1,150 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var fieldmaskpbPkg = protogen.GoImportPath("google.golang.org/protobuf/types/known/fieldmaskpb")
+
+// generateFieldMaskMethods generates ApplyToPb, IntoPlainMasked and the helpers
+// translating JSON names of the plain struct into field-mask paths and back.
+// Masks select the fields covered by the plain struct (see mergePaths).
+func (g *Generator) generateFieldMaskMethods(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File, casterFields []*IRField, castersAsStruct bool) {
+	if msg.Source == nil {
+		return
+	}
+
+	pbType := gf.QualifiedGoIdent(msg.Source.GoIdent)
+	maskType := gf.QualifiedGoIdent(fieldmaskpbPkg.Ident("FieldMask"))
+	mergePathsVar := g.lowerFirst(msg.GoName) + "MergePaths"
+	fieldPathsVar := g.lowerFirst(msg.GoName) + "FieldPaths"
+	maskPaths := gf.QualifiedGoIdent(plainmergePkg.Ident("MaskPaths"))
+	withErr := g.hasCasterErr(msg)
+	intoPbErr := g.intoPbErr(msg)
+	args := g.generateCasterCallArgs(casterFields, castersAsStruct)
+
+	gf.P("// ", fieldPathsVar, " link the JSON names of ", msg.GoName, " to field-mask paths of ", msg.Source.GoIdent.GoName)
+	gf.P("var ", fieldPathsVar, " = []", gf.QualifiedGoIdent(plainmergePkg.Ident("FieldPath")), "{")
+	for _, field := range msg.Fields {
+		if path := g.fieldMaskPath(msg, field); path != "" {
+			gf.P("\t{Name: ", strconv.Quote(field.JSONName), ", Path: ", strconv.Quote(path), "},")
+		}
+	}
+	gf.P("}")
+	gf.P()
+
+	gf.P("// ", msg.GoName, "FieldMask builds a field mask of ", msg.Source.GoIdent.GoName, " from JSON names of ", msg.GoName, ".")
+	gf.P("// A name may go on past a field with \".\", the rest is appended to the field path.")
+	gf.P("func ", msg.GoName, "FieldMask(names ...string) (*", maskType, ", error) {")
+	gf.P("\tpaths, err := ", gf.QualifiedGoIdent(plainmergePkg.Ident("PbPaths")), "(", fieldPathsVar, ", names)")
+	gf.P("\tif err != nil {")
+	gf.P("\t\treturn nil, err")
+	gf.P("\t}")
+	gf.P("\treturn &", maskType, "{Paths: paths}, nil")
+	gf.P("}")
+	gf.P()
+
+	gf.P("// ", msg.GoName, "FieldNames translates a field mask of ", msg.Source.GoIdent.GoName, " into JSON names of ", msg.GoName, ".")
+	gf.P("// A path to an embedded message yields all fields flattened from it.")
+	gf.P("func ", msg.GoName, "FieldNames(mask *", maskType, ") ([]string, error) {")
+	gf.P("\treturn ", gf.QualifiedGoIdent(plainmergePkg.Ident("PlainNames")), "(", fieldPathsVar, ", mask.GetPaths())")
+	gf.P("}")
+	gf.P()
+
+	// ApplyToPb
+	gf.P("// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.")
+	gf.P("// Mask paths use field names of ", msg.Source.GoIdent.GoName, ", a path to an embedded message selects")
+	gf.P("// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.")
+	switch {
+	case len(casterFields) == 0:
+		gf.P("func (p *", msg.GoName, ") ApplyToPb(pb *", pbType, ", mask *", maskType, ") error {")
+	case castersAsStruct:
+		gf.P("func (p *", msg.GoName, ") ApplyToPb(pb *", pbType, ", mask *", maskType, ", c *", msg.GoName, "Casters) error {")
+	default:
+		gf.P("func (p *", msg.GoName, ") ApplyToPb(")
+		gf.P("\tpb *", pbType, ",")
+		gf.P("\tmask *", maskType, ",")
+		g.generateCasterArgs(gf, casterFields, f, false) // toPlain=false
+		gf.P(") error {")
+	}
+	gf.P("\tif p == nil || pb == nil {")
+	gf.P("\t\treturn nil")
+	gf.P("\t}")
+	gf.P("\tpaths, err := ", maskPaths, "(pb.ProtoReflect().Descriptor(), mask.GetPaths(), ", mergePathsVar, ")")
+	gf.P("\tif err != nil {")
+	gf.P("\t\treturn err")
+	gf.P("\t}")
+	if intoPbErr {
+		gf.P("\tsrc, err := p.IntoPbE(", args, ")")
+		gf.P("\tif err != nil {")
+		gf.P("\t\treturn err")
+		gf.P("\t}")
+	} else {
+		gf.P("\tsrc := p.IntoPb(", args, ")")
+	}
+	gf.P("\t", gf.QualifiedGoIdent(plainmergePkg.Ident("CopyPaths")), "(pb, src, paths...)")
+	gf.P("\treturn nil")
+	gf.P("}")
+	gf.P()
+
+	// IntoPlainMasked
+	gf.P("// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.")
+	gf.P("// An empty mask selects all fields, like IntoPlain.")
+	switch {
+	case len(casterFields) == 0:
+		gf.P("func (pb *", pbType, ") IntoPlainMasked(mask *", maskType, ") (*", msg.GoName, ", error) {")
+	case castersAsStruct:
+		gf.P("func (pb *", pbType, ") IntoPlainMasked(mask *", maskType, ", c *", msg.GoName, "Casters) (*", msg.GoName, ", error) {")
+	default:
+		gf.P("func (pb *", pbType, ") IntoPlainMasked(")
+		gf.P("\tmask *", maskType, ",")
+		g.generateCasterArgs(gf, casterFields, f, true) // toPlain=true
+		gf.P(") (*", msg.GoName, ", error) {")
+	}
+	gf.P("\tif pb == nil {")
+	gf.P("\t\treturn nil, nil")
+	gf.P("\t}")
+	gf.P("\tpaths, err := ", maskPaths, "(pb.ProtoReflect().Descriptor(), mask.GetPaths(), ", mergePathsVar, ")")
+	gf.P("\tif err != nil {")
+	gf.P("\t\treturn nil, err")
+	gf.P("\t}")
+	gf.P("\tmasked := &", pbType, "{}")
+	gf.P("\t", gf.QualifiedGoIdent(plainmergePkg.Ident("CopyPaths")), "(masked, pb, paths...)")
+	if withErr {
+		gf.P("\treturn masked.IntoPlainE(", args, ")")
+	} else {
+		gf.P("\treturn masked.IntoPlain(", args, "), nil")
+	}
+	gf.P("}")
+	gf.P()
+}
+
+// fieldMaskPath returns the field-mask path (proto field names) of the pb field
+// a plain field comes from, empty for fields without one (virtual fields)
+func (g *Generator) fieldMaskPath(msg *IRMessage, field *IRField) string {
+	if field.Source == nil || len(field.PathNumbers) == 0 {
+		return ""
+	}
+	path := field.PathNumbers
+	if field.Origin == OriginTypeAlias {
+		path = path[:len(path)-1]
+	}
+	names := make([]string, 0, len(path))
+	desc := msg.Source.Desc
+	for _, num := range path {
+		if desc == nil {
+			return ""
+		}
+		fd := desc.Fields().ByNumber(protoreflect.FieldNumber(num))
+		if fd == nil {
+			return ""
+		}
+		names = append(names, string(fd.Name()))
+		desc = fd.Message()
+	}
+	return strings.Join(names, ".")
+}
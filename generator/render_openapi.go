@@ -0,0 +1,114 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yaroher/protoc-gen-go-plain/logger"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// openAPIVersion is the OpenAPI version of generated documents. 3.1 schemas are
+// JSON Schema 2020-12, so components are the schemas of json_schema=true.
+const openAPIVersion = "3.1.0"
+
+// openAPIDocument is an OpenAPI document without paths
+type openAPIDocument struct {
+	OpenAPI    string            `json:"openapi"`
+	Info       openAPIInfo       `json:"info"`
+	Components openAPIComponents `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIComponents struct {
+	Schemas *schemaProperties `json:"schemas"`
+}
+
+// generateOpenAPIFile writes the schemas of the Plain structs of all files of the run
+// to a single OpenAPI document. Components are named after the Plain structs and
+// refer to each other with #/components/schemas/<Plain>.
+func (g *Generator) generateOpenAPIFile(filename string) error {
+	logger.Debug("generating openapi document", zap.String("filename", filename))
+
+	var files []*protogen.File
+	for _, f := range g.Plugin.Files {
+		if irFile, ok := g.irFiles[f.Desc.Path()]; ok && f.Generate && len(irFile.Messages) > 0 {
+			files = append(files, f)
+		}
+	}
+	if err := g.checkOpenAPINames(files); err != nil {
+		return err
+	}
+
+	schemas := &schemaProperties{}
+	var packages []string
+	for _, f := range files {
+		if pkg := string(f.Desc.Package()); !slices.Contains(packages, pkg) {
+			packages = append(packages, pkg)
+		}
+		sc := &schemaScope{file: f, defs: schemas, refPrefix: "#/components/schemas/", named: true, openAPI: true}
+		var generate func(msgs []*IRMessage)
+		generate = func(msgs []*IRMessage) {
+			for _, msg := range msgs {
+				// Repeated embed rows are added when referenced
+				if !msg.IsEmbedItem {
+					schema := g.messageJSONSchema(sc, msg)
+					schema.Title = msg.GoName
+					schemas.set(msg.GoName, schema)
+				}
+				generate(msg.Nested)
+			}
+		}
+		generate(g.irFiles[f.Desc.Path()].Messages)
+	}
+
+	doc := &openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:   strings.Join(packages, ", "),
+			Version: "0.0.0",
+		},
+		Components: openAPIComponents{Schemas: schemas},
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	gf := g.Plugin.NewGeneratedFile(filename, "")
+	if _, err := gf.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkOpenAPINames reports Plain structs of different Go packages sharing a name,
+// since components are named after Plain structs
+func (g *Generator) checkOpenAPINames(files []*protogen.File) error {
+	owners := make(map[string]protogen.GoImportPath)
+	for _, f := range files {
+		var check func(msgs []*IRMessage) error
+		check = func(msgs []*IRMessage) error {
+			for _, msg := range msgs {
+				if owner, ok := owners[msg.GoName]; ok && owner != f.GoImportPath {
+					return fmt.Errorf("%s is generated in both %s and %s", msg.GoName, owner, f.GoImportPath)
+				}
+				owners[msg.GoName] = f.GoImportPath
+				if err := check(msg.Nested); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if err := check(g.irFiles[f.Desc.Path()].Messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,12 +2,13 @@ package generator
 
 import (
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 var syncPkg = protogen.GoImportPath("sync")
 
 // generatePoolMethods generates sync.Pool, Reset, Get, and Put methods for a Plain struct
-func (g *Generator) generatePoolMethods(gf *protogen.GeneratedFile, msg *IRMessage) {
+func (g *Generator) generatePoolMethods(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File) {
 	plainType := msg.GoName
 	poolVar := lowerFirst(plainType) + "Pool"
 
@@ -39,11 +40,11 @@ func (g *Generator) generatePoolMethods(gf *protogen.GeneratedFile, msg *IRMessa
 	gf.P()
 
 	// Generate Reset method
-	g.generateResetMethod(gf, msg)
+	g.generateResetMethod(gf, msg, f)
 }
 
 // generateResetMethod generates a Reset method that clears all fields
-func (g *Generator) generateResetMethod(gf *protogen.GeneratedFile, msg *IRMessage) {
+func (g *Generator) generateResetMethod(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File) {
 	plainType := msg.GoName
 
 	gf.P("// Reset clears all fields in ", plainType, " for reuse")
@@ -60,7 +61,7 @@ func (g *Generator) generateResetMethod(gf *protogen.GeneratedFile, msg *IRMessa
 
 	// Reset all fields to zero values
 	for _, field := range msg.Fields {
-		g.generateFieldReset(gf, field)
+		g.generateFieldReset(gf, field, f)
 	}
 
 	gf.P("}")
@@ -68,10 +69,18 @@ func (g *Generator) generateResetMethod(gf *protogen.GeneratedFile, msg *IRMessa
 }
 
 // generateFieldReset generates reset code for a single field
-func (g *Generator) generateFieldReset(gf *protogen.GeneratedFile, field *IRField) {
+func (g *Generator) generateFieldReset(gf *protogen.GeneratedFile, field *IRField, f *protogen.File) {
 	fieldAccess := "p." + field.GoName
 
-	if field.GoType.IsPointer {
+	if field.NativeWKT != "" {
+		g.generateWKTReset(gf, field)
+		return
+	}
+
+	if field.DefaultValue.IsValid() {
+		// proto2/editions [default = ...]
+		gf.P("\t", fieldAccess, " = ", g.defaultLiteral(gf, field, f))
+	} else if g.plainIsPointer(field) {
 		gf.P("\t", fieldAccess, " = nil")
 	} else if field.IsRepeated || field.GoType.IsSlice {
 		// Keep capacity for slices
@@ -103,6 +112,12 @@ func (g *Generator) generateFieldReset(gf *protogen.GeneratedFile, field *IRFiel
 				gf.P("\t", fieldAccess, " = 0")
 			} else if field.Kind == KindMessage {
 				gf.P("\t", fieldAccess, " = nil")
+			} else if field.NeedsCaster && field.ScalarKind == protoreflect.StringKind {
+				// Overridden string (e.g. type Email string) - untyped "" fits any string-based type
+				gf.P("\t", fieldAccess, " = \"\"")
+			} else if field.Kind == KindScalar && field.ScalarKind != protoreflect.StringKind && field.ScalarKind != protoreflect.BytesKind {
+				// Overridden number (e.g. time.Month) - untyped 0 fits any numeric type
+				gf.P("\t", fieldAccess, " = 0")
 			} else if field.GoType.ImportPath != "" {
 				// Custom types from external packages - use nil for slice-like types
 				// For others, use zero value declaration
@@ -0,0 +1,489 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yaroher/protoc-gen-go-plain/logger"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var (
+	plainreflectPkg = protogen.GoImportPath("github.com/yaroher/protoc-gen-go-plain/plainreflect")
+	protoreflectPkg = protogen.GoImportPath("google.golang.org/protobuf/reflect/protoreflect")
+)
+
+// reflectShape is the plainreflect constructor matching the Go shape of a Plain field
+type reflectShape string
+
+const (
+	shapeScalar       reflectShape = "Scalar"
+	shapeEnum         reflectShape = "Enum"
+	shapeCase         reflectShape = "Case"
+	shapeOptional     reflectShape = "Optional"
+	shapeOptionalEnum reflectShape = "OptionalEnum"
+	shapeMessage      reflectShape = "Message"
+	shapeList         reflectShape = "List"
+	shapeEnumList     reflectShape = "EnumList"
+	shapeMessageList  reflectShape = "MessageList"
+	shapeValueList    reflectShape = "ValueList"
+	shapeMap          reflectShape = "Map"
+	shapeEnumMap      reflectShape = "EnumMap"
+	shapeMessageMap   reflectShape = "MessageMap"
+)
+
+// reflectField is a Plain struct field described in the synthetic descriptor.
+// Either field or oneof (for case fields) is set.
+type reflectField struct {
+	field *IRField
+	oneof *EmbeddedOneof
+	shape reflectShape
+}
+
+// reflectFile is the synthetic proto file describing the Plain messages of a file
+type reflectFile struct {
+	desc *descriptorpb.FileDescriptorProto
+	// fields are the described fields of each message, in descriptor order
+	fields map[*IRMessage][]*reflectField
+}
+
+// reflectType is the proto type of a field or map value in the synthetic descriptor
+type reflectType struct {
+	typ      descriptorpb.FieldDescriptorProto_Type
+	typeName string
+	// dep is the file declaring typeName, empty for scalars and local messages
+	dep string
+	// goValue reports that a message is stored by value (Plain structs in slices)
+	goValue bool
+}
+
+// plainProtoPath returns the path of the synthetic proto file for a proto file
+func plainProtoPath(file protoreflect.FileDescriptor) string {
+	return strings.TrimSuffix(file.Path(), ".proto") + "_plain.proto"
+}
+
+// plainProtoPackage returns the package of the synthetic proto file for a proto file
+func plainProtoPackage(file protoreflect.FileDescriptor) string {
+	if file.Package() == "" {
+		return "plain"
+	}
+	return string(file.Package()) + ".plain"
+}
+
+// buildReflectFile builds the synthetic descriptor of all Plain messages of the file.
+// Plain messages are top-level messages named by their Go names; fields without
+// a protobuf representation (casters, native well-known types) are left out.
+// Files of proto3 sources are proto3, others are proto2 so that closed enums
+// can be referenced.
+func (g *Generator) buildReflectFile(f *protogen.File, irFile *IRFile) *reflectFile {
+	syntax := "proto2"
+	if f.Desc.Syntax() == protoreflect.Proto3 {
+		syntax = "proto3"
+	}
+	rf := &reflectFile{
+		desc: &descriptorpb.FileDescriptorProto{
+			Name:    proto.String(plainProtoPath(f.Desc)),
+			Package: proto.String(plainProtoPackage(f.Desc)),
+			Syntax:  proto.String(syntax),
+		},
+		fields: make(map[*IRMessage][]*reflectField),
+	}
+	deps := make(map[string]bool)
+
+	var walk func(msg *IRMessage)
+	walk = func(msg *IRMessage) {
+		rf.desc.MessageType = append(rf.desc.MessageType, g.buildReflectMessage(f, rf, msg, deps))
+		for _, nested := range msg.Nested {
+			walk(nested)
+		}
+	}
+	for _, msg := range irFile.Messages {
+		walk(msg)
+	}
+
+	for dep := range deps {
+		if dep != rf.desc.GetName() {
+			rf.desc.Dependency = append(rf.desc.Dependency, dep)
+		}
+	}
+	sort.Strings(rf.desc.Dependency)
+	return rf
+}
+
+func (g *Generator) buildReflectMessage(f *protogen.File, rf *reflectFile, msg *IRMessage, deps map[string]bool) *descriptorpb.DescriptorProto {
+	md := &descriptorpb.DescriptorProto{Name: proto.String(msg.GoName)}
+	maxNumber := int32(0)
+
+	for _, field := range msg.Fields {
+		maxNumber = max(maxNumber, field.Number)
+		fd, shape, err := g.buildReflectField(f, md, field, deps)
+		if err != nil {
+			logger.Debug("skipping field in reflect descriptor",
+				zap.String("message", msg.GoName),
+				zap.String("field", field.GoName),
+				zap.String("reason", err.Error()),
+			)
+			continue
+		}
+		md.Field = append(md.Field, fd)
+		rf.fields[msg] = append(rf.fields[msg], &reflectField{field: field, shape: shape})
+	}
+
+	// Oneof case fields are strings numbered after the regular fields
+	for _, eo := range msg.EmbeddedOneofs {
+		maxNumber++
+		md.Field = append(md.Field, &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(eo.JSONName),
+			JsonName: proto.String(eo.JSONName),
+			Number:   proto.Int32(maxNumber),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		})
+		rf.fields[msg] = append(rf.fields[msg], &reflectField{oneof: eo, shape: shapeCase})
+	}
+	return md
+}
+
+// buildReflectField describes a Plain field. Returns an error for fields
+// whose Go type has no protobuf representation.
+func (g *Generator) buildReflectField(
+	f *protogen.File,
+	md *descriptorpb.DescriptorProto,
+	field *IRField,
+	deps map[string]bool,
+) (*descriptorpb.FieldDescriptorProto, reflectShape, error) {
+	switch {
+	case field.NeedsCaster:
+		return nil, "", fmt.Errorf("type override with caster")
+	case field.NativeWKT != "":
+		return nil, "", fmt.Errorf("native well-known type")
+	case field.Origin == OriginTypeAlias && field.Kind == KindMessage:
+		return nil, "", fmt.Errorf("type alias of a message")
+	}
+
+	fd := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(field.Name),
+		JsonName: proto.String(field.JSONName),
+		Number:   proto.Int32(field.Number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+
+	if field.IsMap {
+		if field.MapKey == nil || field.MapValue == nil {
+			return nil, "", fmt.Errorf("map with type override")
+		}
+		key, err := g.reflectType(f, field.MapKey, false)
+		if err != nil {
+			return nil, "", fmt.Errorf("map key: %w", err)
+		}
+		value, err := g.reflectType(f, field.MapValue, false)
+		if err != nil {
+			return nil, "", fmt.Errorf("map value: %w", err)
+		}
+		entry := &descriptorpb.DescriptorProto{
+			Name: proto.String(mapEntryName(field.Name)),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				reflectEntryField("key", 1, key),
+				reflectEntryField("value", 2, value),
+			},
+			Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+		}
+		md.NestedType = append(md.NestedType, entry)
+		fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+		fd.TypeName = proto.String("." + plainProtoPackage(f.Desc) + "." + md.GetName() + "." + entry.GetName())
+		if value.dep != "" {
+			deps[value.dep] = true
+		}
+		switch value.typ {
+		case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+			return fd, shapeMessageMap, nil
+		case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+			return fd, shapeEnumMap, nil
+		}
+		return fd, shapeMap, nil
+	}
+
+	typ, err := g.reflectType(f, field, field.IsRepeated)
+	if err != nil {
+		return nil, "", err
+	}
+	fd.Type = typ.typ.Enum()
+	if typ.typeName != "" {
+		fd.TypeName = proto.String(typ.typeName)
+	}
+	if typ.dep != "" {
+		deps[typ.dep] = true
+	}
+	isMessage := typ.typ == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	isEnum := typ.typ == descriptorpb.FieldDescriptorProto_TYPE_ENUM
+
+	switch {
+	case field.IsRepeated:
+		fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		switch {
+		case isMessage && typ.goValue:
+			return fd, shapeValueList, nil
+		case isMessage:
+			return fd, shapeMessageList, nil
+		case isEnum:
+			return fd, shapeEnumList, nil
+		}
+		return fd, shapeList, nil
+	case isMessage:
+		return fd, shapeMessage, nil
+	case field.IsOptional:
+		// Presence tracked by the pointer; proto3 declares it with a synthetic oneof
+		if f.Desc.Syntax() == protoreflect.Proto3 {
+			fd.Proto3Optional = proto.Bool(true)
+			fd.OneofIndex = proto.Int32(int32(len(md.OneofDecl)))
+			md.OneofDecl = append(md.OneofDecl, &descriptorpb.OneofDescriptorProto{
+				Name: proto.String("_" + field.Name),
+			})
+		}
+		if isEnum {
+			return fd, shapeOptionalEnum, nil
+		}
+		return fd, shapeOptional, nil
+	case field.DefaultValue.IsValid():
+		fd.DefaultValue = proto.String(reflectDefault(field))
+	}
+	if isEnum {
+		return fd, shapeEnum, nil
+	}
+	return fd, shapeScalar, nil
+}
+
+// reflectDefault returns the default_value of a field with [default = ...]
+// in its Plain type: enum_as_string keeps the value name, enum_as_int the number
+func reflectDefault(field *IRField) string {
+	if field.EnumAsInt {
+		return strconv.Itoa(int(field.DefaultValue.Enum()))
+	}
+	return protodesc.ToFieldDescriptorProto(field.Source.Desc).GetDefaultValue()
+}
+
+// reflectType returns the proto type of a field or map value, checking that
+// its Go type is the one plainreflect expects for that type.
+func (g *Generator) reflectType(f *protogen.File, field *IRField, repeated bool) (reflectType, error) {
+	switch {
+	case field.EmbedItem != nil:
+		// Repeated embed rows are messages of the same synthetic file
+		return reflectType{
+			typ:      descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+			typeName: "." + plainProtoPackage(f.Desc) + "." + field.EmbedItem.GoName,
+			goValue:  true,
+		}, nil
+
+	case field.Kind == KindMessage:
+		if field.Source == nil || field.Source.Message == nil {
+			return reflectType{}, fmt.Errorf("message without source")
+		}
+		msg := field.Source.Message
+		file := msg.Desc.ParentFile()
+		if msgOpts := g.getMessageOptions(msg); msgOpts != nil && msgOpts.Generate {
+			goName := msg.GoIdent.GoName + g.suffix
+			if field.GoType.Name != goName || field.GoType.ImportPath != string(msg.GoIdent.GoImportPath) {
+				return reflectType{}, fmt.Errorf("type override of a message")
+			}
+			return reflectType{
+				typ:      descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+				typeName: "." + plainProtoPackage(file) + "." + goName,
+				dep:      plainProtoPath(file),
+				goValue:  repeated && !field.GoType.IsPointer,
+			}, nil
+		}
+		if field.GoType.Name != msg.GoIdent.GoName || field.GoType.ImportPath != string(msg.GoIdent.GoImportPath) {
+			return reflectType{}, fmt.Errorf("type override of a message")
+		}
+		if repeated && !field.GoType.IsPointer {
+			return reflectType{}, fmt.Errorf("repeated message stored by value")
+		}
+		return reflectType{
+			typ:      descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+			typeName: "." + string(msg.Desc.FullName()),
+			dep:      file.Path(),
+		}, nil
+
+	case field.Kind == KindEnum:
+		if field.Source == nil || field.Source.Enum == nil {
+			return reflectType{}, fmt.Errorf("enum without source")
+		}
+		enum := field.Source.Enum
+		if field.GoType.Name != enum.GoIdent.GoName || field.GoType.ImportPath != string(enum.GoIdent.GoImportPath) {
+			return reflectType{}, fmt.Errorf("type override of an enum")
+		}
+		return reflectType{
+			typ:      descriptorpb.FieldDescriptorProto_TYPE_ENUM,
+			typeName: "." + string(enum.Desc.FullName()),
+			dep:      enum.Desc.ParentFile().Path(),
+		}, nil
+
+	case field.Kind == KindBytes:
+		// serialize=true fields and bytes map values
+		if field.GoType.Name != "[]byte" || field.GoType.ImportPath != "" {
+			return reflectType{}, fmt.Errorf("type override of bytes")
+		}
+		return reflectType{typ: descriptorpb.FieldDescriptorProto_TYPE_BYTES}, nil
+	}
+
+	kind := field.ScalarKind
+	switch {
+	case kind == protoreflect.EnumKind && field.EnumAsString:
+		kind = protoreflect.StringKind
+	case kind == protoreflect.EnumKind && field.EnumAsInt:
+		kind = protoreflect.Int32Kind
+	}
+	goType := (&IRBuilder{Suffix: g.suffix}).goTypeFromProtoKind(kind)
+	if goType.Name == "interface{}" {
+		return reflectType{}, fmt.Errorf("unsupported kind %s", kind)
+	}
+	if field.GoType.Name != goType.Name || field.GoType.ImportPath != "" || field.GoType.IsPointer || field.GoType.IsSlice {
+		return reflectType{}, fmt.Errorf("type override %s of %s", field.GoType.Name, kind)
+	}
+	return reflectType{typ: descriptorpb.FieldDescriptorProto_Type(kind)}, nil
+}
+
+func reflectEntryField(name string, number int32, typ reflectType) *descriptorpb.FieldDescriptorProto {
+	fd := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.typ.Enum(),
+	}
+	if typ.typeName != "" {
+		fd.TypeName = proto.String(typ.typeName)
+	}
+	return fd
+}
+
+// mapEntryName returns the name of the map entry message for a field,
+// as protoc names it: "foo_bar" -> "FooBarEntry"
+func mapEntryName(name string) string {
+	var sb strings.Builder
+	upperNext := true
+	for _, c := range name {
+		switch {
+		case c == '_':
+			upperNext = true
+		case upperNext && 'a' <= c && c <= 'z':
+			sb.WriteRune(c - 'a' + 'A')
+			upperNext = false
+		default:
+			sb.WriteRune(c)
+			upperNext = false
+		}
+	}
+	return sb.String() + "Entry"
+}
+
+// reflectFileVar returns the name of the plainreflect.File variable of a file
+func reflectFileVar(f *protogen.File) string {
+	return "file_" + strings.TrimPrefix(f.GoDescriptorIdent.GoName, "File_") + "_plain"
+}
+
+// protoInitFunc returns the name of the function protoc-gen-go generates to
+// register a file descriptor
+func protoInitFunc(f *protogen.File) string {
+	return "file_" + strings.TrimPrefix(f.GoDescriptorIdent.GoName, "File_") + "_init"
+}
+
+// reflectInfoVar returns the name of the plainreflect.MessageInfo variable of a Plain struct
+func reflectInfoVar(msg *IRMessage) string {
+	return lowerFirst(msg.GoName) + "Reflect"
+}
+
+// generateReflectFile generates the serialized synthetic descriptor and the
+// plainreflect.File registering it
+func (g *Generator) generateReflectFile(gf *protogen.GeneratedFile, f *protogen.File, rf *reflectFile) error {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(rf.desc)
+	if err != nil {
+		return fmt.Errorf("marshal reflect descriptor of %s: %w", f.Desc.Path(), err)
+	}
+	fileVar := reflectFileVar(f)
+
+	gf.P("// ", fileVar, "_rawDesc is the serialized descriptor of ", rf.desc.GetName(), ",")
+	gf.P("// describing the Plain messages of ", f.Desc.Path(), " in package ", rf.desc.GetPackage())
+	gf.P("const ", fileVar, "_rawDesc = \"\" +")
+	const chunk = 64
+	for i := 0; i < len(raw); i += chunk {
+		end := min(i+chunk, len(raw))
+		line := "\t" + strconv.Quote(string(raw[i:end]))
+		if end < len(raw) {
+			line += " +"
+		}
+		gf.P(line)
+	}
+	gf.P()
+	gf.P("var ", fileVar, " = ", gf.QualifiedGoIdent(plainreflectPkg.Ident("NewFile")), "(", strconv.Quote(rf.desc.GetName()), ", ", fileVar, "_rawDesc)")
+	gf.P()
+
+	// Registered from init, as protoc-gen-go does, so the Plain types can be
+	// found by name before any value is used. Files of the same package may
+	// not be initialized yet, their init functions are called first.
+	gf.P("func init() { ", fileVar, "_init() }")
+	gf.P()
+	gf.P("func ", fileVar, "_init() {")
+	for _, dep := range rf.desc.GetDependency() {
+		if src, ok := g.Plugin.FilesByPath[dep]; ok && src.GoImportPath == f.GoImportPath {
+			gf.P("	", protoInitFunc(src), "()")
+		}
+		if src, ok := g.plainSourceFile(dep); ok && src.GoImportPath == f.GoImportPath {
+			gf.P("	", reflectFileVar(src), "_init()")
+		}
+	}
+	gf.P("	", fileVar, ".Init()")
+	gf.P("}")
+	gf.P()
+	return nil
+}
+
+// plainSourceFile returns the proto file a synthetic file was built from
+func (g *Generator) plainSourceFile(path string) (*protogen.File, bool) {
+	src, ok := strings.CutSuffix(path, "_plain.proto")
+	if !ok {
+		return nil, false
+	}
+	f, ok := g.Plugin.FilesByPath[src+".proto"]
+	return f, ok
+}
+
+// generateReflectMethods generates the MessageInfo of a Plain struct and its ProtoReflect method
+func (g *Generator) generateReflectMethods(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File, rf *reflectFile) {
+	infoVar := reflectInfoVar(msg)
+	plainType := msg.GoName
+
+	gf.P("// ", infoVar, " describes ", plainType, " as message ", rf.desc.GetPackage(), ".", plainType)
+	newInfo := gf.QualifiedGoIdent(plainreflectPkg.Ident("NewMessageInfo"))
+	if len(rf.fields[msg]) == 0 {
+		// Without fields the struct type can't be inferred
+		newInfo += "[" + plainType + "]"
+	}
+	gf.P("var ", infoVar, " = ", newInfo, "(", reflectFileVar(f), ", ", strconv.Quote(plainType), ",")
+	for _, rfield := range rf.fields[msg] {
+		var goName, typeStr string
+		if rfield.oneof != nil {
+			goName, typeStr = rfield.oneof.CaseFieldName, rfield.oneof.CaseTypeName
+		} else {
+			goName, typeStr = rfield.field.GoName, g.buildTypeString(gf, rfield.field, f)
+		}
+		gf.P("\t", gf.QualifiedGoIdent(plainreflectPkg.Ident(string(rfield.shape))),
+			"(func(p *", plainType, ") *", typeStr, " { return &p.", goName, " }),")
+	}
+	gf.P(")")
+	gf.P()
+
+	gf.P("// ProtoReflect returns the reflective view of ", plainType, " backed by the struct")
+	gf.P("func (p *", plainType, ") ProtoReflect() ", gf.QualifiedGoIdent(protoreflectPkg.Ident("Message")), " {")
+	gf.P("\treturn ", infoVar, ".MessageOf(p)")
+	gf.P("}")
+	gf.P()
+}
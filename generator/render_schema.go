@@ -0,0 +1,568 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/yaroher/protoc-gen-go-plain/logger"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// jsonSchemaDialect is the JSON Schema version of generated schemas
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema is the subset of JSON Schema used for Plain structs.
+// Fields are written in declaration order.
+type jsonSchema struct {
+	Schema               string            `json:"$schema,omitempty"`
+	ID                   string            `json:"$id,omitempty"`
+	Ref                  string            `json:"$ref,omitempty"`
+	Title                string            `json:"title,omitempty"`
+	Description          string            `json:"description,omitempty"`
+	Type                 any               `json:"type,omitempty"` // a type, or a list of types (nullable)
+	Format               string            `json:"format,omitempty"`
+	ContentEncoding      string            `json:"contentEncoding,omitempty"`
+	Pattern              string            `json:"pattern,omitempty"`
+	Minimum              *int              `json:"minimum,omitempty"`
+	Const                string            `json:"const,omitempty"`
+	Enum                 []any             `json:"enum,omitempty"`
+	Items                *jsonSchema       `json:"items,omitempty"`
+	Properties           *schemaProperties `json:"properties,omitempty"`
+	PropertyNames        *jsonSchema       `json:"propertyNames,omitempty"`
+	AdditionalProperties any               `json:"additionalProperties,omitempty"`
+	Required             []string          `json:"required,omitempty"`
+	Not                  *jsonSchema       `json:"not,omitempty"`
+	AnyOf                []*jsonSchema     `json:"anyOf,omitempty"`
+	OneOf                []*jsonSchema     `json:"oneOf,omitempty"`
+	AllOf                []*jsonSchema     `json:"allOf,omitempty"`
+	Defs                 *schemaProperties `json:"$defs,omitempty"`
+}
+
+// schemaProperties is a JSON object of schemas keeping the insertion order
+type schemaProperties struct {
+	names   []string
+	schemas map[string]*jsonSchema
+}
+
+func (p *schemaProperties) set(name string, s *jsonSchema) {
+	if p.schemas == nil {
+		p.schemas = make(map[string]*jsonSchema)
+	}
+	if _, ok := p.schemas[name]; !ok {
+		p.names = append(p.names, name)
+	}
+	p.schemas[name] = s
+}
+
+func (p *schemaProperties) get(name string) (*jsonSchema, bool) {
+	s, ok := p.schemas[name]
+	return s, ok
+}
+
+func (p *schemaProperties) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, name := range p.names {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(p.schemas[name])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+var zeroMinimum = 0
+
+// schemaScope is the document schemas of a file are written to: a schema file
+// per Plain struct (json_schema=true), the OpenAPI document (openapi=<file>)
+// or the TypeScript file of the proto file (ts=true)
+type schemaScope struct {
+	file *protogen.File
+	// defs collects the schemas of repeated embed rows, refPrefix refers to them
+	defs      *schemaProperties
+	refPrefix string
+	// named refers to Plain structs of all files as refPrefix + name instead of
+	// their schema files. Names from other files are added to imports, if set.
+	named   bool
+	imports map[*protogen.File][]string
+	// openAPI adds OpenAPI formats and makes fields with explicit presence nullable
+	openAPI bool
+}
+
+// generateJSONSchemaFiles writes <Plain>.schema.json for the Plain messages of the file,
+// next to the generated Go code
+func (g *Generator) generateJSONSchemaFiles(f *protogen.File, irFile *IRFile) error {
+	var generate func(msgs []*IRMessage) error
+	generate = func(msgs []*IRMessage) error {
+		for _, msg := range msgs {
+			// Repeated embed rows are described in $defs of the parent
+			if !msg.IsEmbedItem {
+				if err := g.generateJSONSchemaFile(f, msg); err != nil {
+					return err
+				}
+			}
+			if err := generate(msg.Nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return generate(irFile.Messages)
+}
+
+// generateJSONSchemaFile writes the schema of a Plain message.
+// Repeated embed rows are described in $defs of the message.
+func (g *Generator) generateJSONSchemaFile(f *protogen.File, msg *IRMessage) error {
+	filename := jsonSchemaFilename(f, msg.GoName)
+	logger.Debug("generating json schema", zap.String("filename", filename))
+
+	sc := &schemaScope{file: f, defs: &schemaProperties{}, refPrefix: "#/$defs/"}
+	schema := g.messageJSONSchema(sc, msg)
+	schema.Schema = jsonSchemaDialect
+	schema.ID = path.Base(filename)
+	schema.Title = msg.GoName
+	if len(sc.defs.names) > 0 {
+		schema.Defs = sc.defs
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	gf := g.Plugin.NewGeneratedFile(filename, "")
+	if _, err := gf.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return nil
+}
+
+// jsonSchemaFilename returns the schema file of a Plain struct declared in f
+func jsonSchemaFilename(f *protogen.File, goName string) string {
+	return path.Join(path.Dir(f.GeneratedFilenamePrefix), goName+".schema.json")
+}
+
+// messageJSONSchema describes the JSON written by MarshalJX of a Plain struct:
+// oneof case fields, then fields under their JSON names. Every property is
+// optional, since zero values are omitted. Embed rows are added to the defs of sc.
+func (g *Generator) messageJSONSchema(sc *schemaScope, msg *IRMessage) *jsonSchema {
+	props := &schemaProperties{}
+	for _, eo := range msg.EmbeddedOneofs {
+		names := make([]any, 0, len(eo.Variants))
+		for _, variant := range eo.Variants {
+			names = append(names, variant.Name)
+		}
+		props.set(eo.JSONName, &jsonSchema{
+			Description: "Set variant of the " + eo.Name + " oneof",
+			Type:        "string",
+			Enum:        names,
+		})
+	}
+	// With unified_oneof_json variants share a key, its value is any of their schemas
+	var keys []string
+	keySchemas := make(map[string][]*jsonSchema)
+	for _, field := range msg.Fields {
+		key := g.fieldJSONKey(field)
+		s := g.fieldJSONSchema(sc, field)
+		if sc.openAPI && nullableField(field) {
+			s = nullableJSONSchema(s)
+		}
+		if comment := strings.TrimSpace(field.Comment); comment != "" {
+			s.Description = comment
+		}
+		if _, ok := keySchemas[key]; !ok {
+			keys = append(keys, key)
+		}
+		if !slices.ContainsFunc(keySchemas[key], func(prev *jsonSchema) bool { return schemaEqual(prev, s) }) {
+			keySchemas[key] = append(keySchemas[key], s)
+		}
+	}
+	for _, key := range keys {
+		if schemas := keySchemas[key]; len(schemas) == 1 {
+			props.set(key, schemas[0])
+		} else {
+			props.set(key, &jsonSchema{AnyOf: schemas})
+		}
+	}
+
+	schema := &jsonSchema{
+		Description:          strings.TrimSpace(msg.Comment),
+		Type:                 "object",
+		Properties:           props,
+		AdditionalProperties: false,
+	}
+	var oneofs []*jsonSchema
+	for _, eo := range msg.EmbeddedOneofs {
+		oneofs = append(oneofs, g.oneofJSONSchema(msg, eo))
+	}
+	switch len(oneofs) {
+	case 0:
+	case 1:
+		schema.OneOf = oneofs[0].OneOf
+	default:
+		schema.AllOf = oneofs
+	}
+	return schema
+}
+
+// fieldJSONKey returns the JSON key of a field, see generateMarshalJXField
+func (g *Generator) fieldJSONKey(field *IRField) string {
+	if g.Settings.UnifiedOneofJSON && field.OneofJSONName != "" {
+		return field.OneofJSONName
+	}
+	return field.JSONName
+}
+
+// oneofJSONSchema requires the keys of a oneof to agree with its case field:
+// a branch per variant where the case is the variant and the keys of other
+// variants are absent, and a branch where neither the case nor any variant is set.
+// Variants written even when another one is set are left out (see writtenWhenUnset).
+func (g *Generator) oneofJSONSchema(msg *IRMessage, eo *EmbeddedOneof) *jsonSchema {
+	variantKeys := make(map[string][]string, len(eo.Variants))
+	var allKeys []string
+	for _, field := range msg.Fields {
+		if field.OneofName != eo.Name || writtenWhenUnset(field) {
+			continue
+		}
+		key := g.fieldJSONKey(field)
+		if !slices.Contains(variantKeys[field.OneofVariant], key) {
+			variantKeys[field.OneofVariant] = append(variantKeys[field.OneofVariant], key)
+		}
+		if !slices.Contains(allKeys, key) {
+			allKeys = append(allKeys, key)
+		}
+	}
+
+	oneof := &jsonSchema{}
+	for _, variant := range eo.Variants {
+		branch := &jsonSchema{
+			Properties: &schemaProperties{},
+			Required:   []string{eo.JSONName},
+		}
+		branch.Properties.set(eo.JSONName, &jsonSchema{Const: variant.Name})
+		var others []string
+		for _, key := range allKeys {
+			if !slices.Contains(variantKeys[variant.Name], key) {
+				others = append(others, key)
+			}
+		}
+		branch.Not = requiredAnyOf(others)
+		oneof.OneOf = append(oneof.OneOf, branch)
+	}
+	oneof.OneOf = append(oneof.OneOf, &jsonSchema{Not: requiredAnyOf(append([]string{eo.JSONName}, allKeys...))})
+	return oneof
+}
+
+// writtenWhenUnset reports whether a oneof variant is in the JSON of a Plain struct
+// converted from a message with another variant set: write_default variants, and
+// enum_as_string variants holding the name of the zero value
+func writtenWhenUnset(field *IRField) bool {
+	return field.WriteDefault || (field.ScalarKind == protoreflect.EnumKind && field.GoType.Name == "string")
+}
+
+// nullableField reports whether UnmarshalJX resets a field on null: fields with
+// explicit presence, which MarshalJX omits when unset
+func nullableField(field *IRField) bool {
+	return field.IsOptional && !field.IsRepeated && (field.Kind != KindMessage || field.NativeWKT != "")
+}
+
+// nullableJSONSchema also accepts null: OpenAPI 3.1 has no nullable keyword,
+// null is added to the types
+func nullableJSONSchema(s *jsonSchema) *jsonSchema {
+	switch typ := s.Type.(type) {
+	case string:
+		s.Type = []string{typ, "null"}
+		if s.Enum != nil {
+			s.Enum = append(s.Enum, nil)
+		}
+		return s
+	case nil:
+		if s.Ref != "" {
+			return &jsonSchema{AnyOf: []*jsonSchema{s, {Type: "null"}}}
+		}
+	}
+	// Any JSON value
+	return s
+}
+
+// requiredAnyOf matches objects having any of keys, nil for no keys
+func requiredAnyOf(keys []string) *jsonSchema {
+	switch len(keys) {
+	case 0:
+		return nil
+	case 1:
+		return &jsonSchema{Required: keys}
+	}
+	s := &jsonSchema{}
+	for _, key := range keys {
+		s.AnyOf = append(s.AnyOf, &jsonSchema{Required: []string{key}})
+	}
+	return s
+}
+
+// fieldJSONSchema describes the JSON value of a field
+func (g *Generator) fieldJSONSchema(sc *schemaScope, field *IRField) *jsonSchema {
+	if field.NativeWKT != "" {
+		return g.nativeWKTJSONSchema(sc, field)
+	}
+	if field.IsMap {
+		s := &jsonSchema{Type: "object"}
+		if field.MapKey != nil {
+			s.PropertyNames = mapKeyJSONSchema(field.MapKey.ScalarKind)
+		}
+		if field.MapValue != nil {
+			s.AdditionalProperties = g.valueJSONSchema(sc, field.MapValue)
+		}
+		return s
+	}
+	if field.IsRepeated {
+		return &jsonSchema{Type: "array", Items: g.valueJSONSchema(sc, field)}
+	}
+	return g.valueJSONSchema(sc, field)
+}
+
+// valueJSONSchema describes a single value of a field (an element of repeated fields)
+func (g *Generator) valueJSONSchema(sc *schemaScope, field *IRField) *jsonSchema {
+	switch {
+	case field.EmbedItem != nil:
+		item := field.EmbedItem
+		if _, ok := sc.defs.get(item.GoName); !ok {
+			sc.defs.set(item.GoName, g.messageJSONSchema(sc, item))
+		}
+		return &jsonSchema{Ref: sc.refPrefix + item.GoName}
+	case field.Kind == KindBytes:
+		// serialize=true: JSON of the message as base64 bytes
+		return sc.scalar(protoreflect.BytesKind)
+	case field.Kind == KindMessage:
+		return g.messageValueJSONSchema(sc, field)
+	case field.ScalarKind == protoreflect.EnumKind && field.Source != nil && field.Source.Enum != nil:
+		return enumJSONSchema(field.Source.Enum, field.EnumAsString || field.GoType.Name == "string")
+	case field.NeedsCaster:
+		// Overridden types are written as the proto type
+		return sc.scalar(field.ScalarKind)
+	}
+	if kind, ok := goScalarKinds[field.GoType.Name]; ok {
+		return sc.scalar(kind)
+	}
+	return sc.scalar(field.ScalarKind)
+}
+
+// goScalarKinds maps Go types of scalar plain fields to the proto kind they are written as
+var goScalarKinds = map[string]protoreflect.Kind{
+	"string":  protoreflect.StringKind,
+	"bool":    protoreflect.BoolKind,
+	"int32":   protoreflect.Int32Kind,
+	"int64":   protoreflect.Int64Kind,
+	"uint32":  protoreflect.Uint32Kind,
+	"uint64":  protoreflect.Uint64Kind,
+	"float32": protoreflect.FloatKind,
+	"float64": protoreflect.DoubleKind,
+	"[]byte":  protoreflect.BytesKind,
+}
+
+// messageValueJSONSchema describes a message value: a reference to the schema of
+// a Plain struct, or the protobuf JSON of the message
+func (g *Generator) messageValueJSONSchema(sc *schemaScope, field *IRField) *jsonSchema {
+	if field.Source == nil || field.Source.Message == nil {
+		return &jsonSchema{}
+	}
+	msg := field.Source.Message
+	switch g.valueKindOf(field) {
+	case valuePlain:
+		return g.plainSchemaRef(sc, msg)
+	case valueProto:
+		if s, ok := sc.wktJSONSchema(msg.Desc.FullName()); ok {
+			return s
+		}
+		return &jsonSchema{Type: "object", Description: "Protobuf JSON of " + string(msg.Desc.FullName())}
+	}
+	// Overridden message types. Timestamps are expected to be overridden
+	// with time.Time or a type encoding like it.
+	if msg.Desc.FullName() == "google.protobuf.Timestamp" {
+		return &jsonSchema{Type: "string", Format: "date-time"}
+	}
+	return &jsonSchema{}
+}
+
+// plainSchemaRef refers to the schema of the Plain struct of msg: by name in
+// named scopes, or its schema file relative to the schemas of the scope
+func (g *Generator) plainSchemaRef(sc *schemaScope, msg *protogen.Message) *jsonSchema {
+	name := msg.GoIdent.GoName + g.suffix
+	if sc.named {
+		// Only Plain structs of files of the run are declared
+		target, ok := g.Plugin.FilesByPath[msg.Desc.ParentFile().Path()]
+		if !ok || !target.Generate {
+			return &jsonSchema{Type: "object", Description: "JSON of " + name}
+		}
+		if sc.imports != nil && target != sc.file && !slices.Contains(sc.imports[target], name) {
+			sc.imports[target] = append(sc.imports[target], name)
+		}
+		return &jsonSchema{Ref: sc.refPrefix + name}
+	}
+	return &jsonSchema{Ref: g.plainSchemaFile(sc.file, name, msg)}
+}
+
+// plainSchemaFile returns the schema file of the Plain struct name of msg relative to the schemas of f
+func (g *Generator) plainSchemaFile(f *protogen.File, name string, msg *protogen.Message) string {
+	target, ok := g.Plugin.FilesByPath[msg.Desc.ParentFile().Path()]
+	if !ok {
+		return name + ".schema.json"
+	}
+	rel, err := filepath.Rel(filepath.FromSlash(path.Dir(f.GeneratedFilenamePrefix)), filepath.FromSlash(jsonSchemaFilename(target, name)))
+	if err != nil {
+		return name + ".schema.json"
+	}
+	return filepath.ToSlash(rel)
+}
+
+// nativeWKTJSONSchema describes well-known types mapped to native Go types (wkt=native)
+func (g *Generator) nativeWKTJSONSchema(sc *schemaScope, field *IRField) *jsonSchema {
+	if kind, ok := wktWrappedKind(field); ok {
+		return sc.scalar(kind)
+	}
+	switch field.NativeWKT {
+	case "google.protobuf.Timestamp":
+		return &jsonSchema{Type: "string", Format: "date-time"}
+	case "google.protobuf.Duration":
+		return durationJSONSchema()
+	case "google.protobuf.Struct", "google.protobuf.Empty":
+		return &jsonSchema{Type: "object"}
+	case "google.protobuf.ListValue":
+		return &jsonSchema{Type: "array"}
+	}
+	// google.protobuf.Value: any JSON value
+	return &jsonSchema{}
+}
+
+// wktJSONSchema describes the protojson form of well-known types
+func (sc *schemaScope) wktJSONSchema(name protoreflect.FullName) (*jsonSchema, bool) {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return &jsonSchema{Type: "string", Format: "date-time"}, true
+	case "google.protobuf.Duration":
+		return durationJSONSchema(), true
+	case "google.protobuf.FieldMask":
+		return &jsonSchema{Type: "string"}, true
+	case "google.protobuf.Struct", "google.protobuf.Empty":
+		return &jsonSchema{Type: "object"}, true
+	case "google.protobuf.Any":
+		return &jsonSchema{Type: "object", Required: []string{"@type"}}, true
+	case "google.protobuf.ListValue":
+		return &jsonSchema{Type: "array"}, true
+	case "google.protobuf.Value":
+		return &jsonSchema{}, true
+	case "google.protobuf.Int64Value":
+		s := &jsonSchema{Type: "string", Pattern: "^-?[0-9]+$"}
+		if sc.openAPI {
+			s.Format = openAPIFormats[protoreflect.Int64Kind]
+		}
+		return s, true
+	case "google.protobuf.UInt64Value":
+		return &jsonSchema{Type: "string", Pattern: "^[0-9]+$"}, true
+	}
+	if wkt, ok := nativeWKTs[name]; ok && wkt.PbType.ImportPath == wrapperspbPath {
+		return sc.scalar(goScalarKinds[wkt.GoType.Name]), true
+	}
+	return nil, false
+}
+
+func durationJSONSchema() *jsonSchema {
+	return &jsonSchema{Type: "string", Pattern: `^-?[0-9]+(\.[0-9]+)?s$`}
+}
+
+// scalarJSONSchema describes a scalar written by jx: 64-bit integers are numbers, bytes are base64
+func scalarJSONSchema(kind protoreflect.Kind) *jsonSchema {
+	switch kind {
+	case protoreflect.StringKind:
+		return &jsonSchema{Type: "string"}
+	case protoreflect.BoolKind:
+		return &jsonSchema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return &jsonSchema{Type: "integer"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &jsonSchema{Type: "integer", Minimum: &zeroMinimum}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return &jsonSchema{Type: "number"}
+	case protoreflect.BytesKind:
+		return &jsonSchema{Type: "string", ContentEncoding: "base64"}
+	}
+	return &jsonSchema{}
+}
+
+// openAPIFormats are the OpenAPI formats of scalar kinds
+var openAPIFormats = map[protoreflect.Kind]string{
+	protoreflect.Int32Kind:    "int32",
+	protoreflect.Sint32Kind:   "int32",
+	protoreflect.Sfixed32Kind: "int32",
+	protoreflect.Int64Kind:    "int64",
+	protoreflect.Sint64Kind:   "int64",
+	protoreflect.Sfixed64Kind: "int64",
+	protoreflect.FloatKind:    "float",
+	protoreflect.DoubleKind:   "double",
+	protoreflect.BytesKind:    "byte",
+}
+
+// scalar describes a scalar written by jx, with its OpenAPI format in OpenAPI documents
+func (sc *schemaScope) scalar(kind protoreflect.Kind) *jsonSchema {
+	s := scalarJSONSchema(kind)
+	if sc.openAPI {
+		s.Format = openAPIFormats[kind]
+	}
+	return s
+}
+
+// enumJSONSchema lists the values of an enum: names or numbers
+func enumJSONSchema(enum *protogen.Enum, asName bool) *jsonSchema {
+	s := &jsonSchema{Type: "integer"}
+	if asName {
+		s.Type = "string"
+	}
+	for _, v := range enum.Values {
+		var value any = int32(v.Desc.Number())
+		if asName {
+			value = string(v.Desc.Name())
+		}
+		// allow_alias gives several names to a number
+		if !slices.Contains(s.Enum, value) {
+			s.Enum = append(s.Enum, value)
+		}
+	}
+	return s
+}
+
+// mapKeyJSONSchema restricts object keys of maps with non-string keys,
+// written with fmt.Sprint
+func mapKeyJSONSchema(kind protoreflect.Kind) *jsonSchema {
+	switch kind {
+	case protoreflect.StringKind:
+		return nil
+	case protoreflect.BoolKind:
+		return &jsonSchema{Pattern: "^(true|false)$"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &jsonSchema{Pattern: "^[0-9]+$"}
+	}
+	return &jsonSchema{Pattern: "^-?[0-9]+$"}
+}
+
+func schemaEqual(a, b *jsonSchema) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ja, jb)
+}
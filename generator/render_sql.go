@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var plainsqlPkg = protogen.GoImportPath("github.com/yaroher/protoc-gen-go-plain/plainsql")
+
+// sqlColumn is a column of the row of a Plain struct
+type sqlColumn struct {
+	// Name is the column name: the flattened field name
+	Name string
+	// GoName is the struct field
+	GoName string
+	// JSON stores the field as JSON through plainsql.JSON
+	JSON bool
+	// Uint64 writes the field through plainsql.Uint64
+	Uint64 bool
+	// Pointer tells whether the field is a pointer
+	Pointer bool
+}
+
+// sqlColumns returns the columns of a Plain struct in field order, oneof case fields last
+func (g *Generator) sqlColumns(msg *IRMessage) []sqlColumn {
+	columns := make([]sqlColumn, 0, len(msg.Fields)+len(msg.EmbeddedOneofs))
+	for _, field := range msg.Fields {
+		columns = append(columns, sqlColumn{
+			Name:    field.Name,
+			GoName:  field.GoName,
+			JSON:    g.sqlAsJSON(field),
+			Uint64:  g.sqlAsUint64(field),
+			Pointer: g.plainIsPointer(field),
+		})
+	}
+	for _, eo := range msg.EmbeddedOneofs {
+		columns = append(columns, sqlColumn{Name: eo.JSONName, GoName: eo.CaseFieldName})
+	}
+	return columns
+}
+
+// sqlAsJSON reports whether a field has no database/sql representation of its own
+// and is stored as JSON: messages, maps, repeated fields and native Struct/Value/Empty.
+// Overridden types are passed as is, they may implement sql.Scanner and driver.Valuer.
+func (g *Generator) sqlAsJSON(field *IRField) bool {
+	kind := g.valueKindOf(field)
+	switch {
+	case kind == valueOverride:
+		return false
+	case field.IsMap || field.IsRepeated:
+		return true
+	case field.NativeWKT == "google.protobuf.Empty":
+		return true
+	}
+	switch kind {
+	case valuePlain, valueProto, valueStruct, valueList, valueValue:
+		return true
+	}
+	return false
+}
+
+// sqlAsUint64 reports whether a field is a uint64, which database/sql only accepts
+// up to math.MaxInt64: uint64 and fixed64 fields and native UInt64Value
+func (g *Generator) sqlAsUint64(field *IRField) bool {
+	if field.IsMap || field.IsRepeated || g.valueKindOf(field) != valueScalar {
+		return false
+	}
+	if field.NativeWKT == "google.protobuf.UInt64Value" {
+		return true
+	}
+	return field.NativeWKT == "" && (field.ScalarKind == protoreflect.Uint64Kind || field.ScalarKind == protoreflect.Fixed64Kind)
+}
+
+// generateSQLMethods generates Columns, ScanRow and Values mapping a Plain struct
+// to a database row with a column per field
+func (g *Generator) generateSQLMethods(gf *protogen.GeneratedFile, msg *IRMessage) {
+	plainType := msg.GoName
+	columns := g.sqlColumns(msg)
+
+	gf.P("// Columns returns the column names of ", plainType, " rows, in the order of ScanRow and Values")
+	gf.P("func (p *", plainType, ") Columns() []string {")
+	gf.P("\treturn []string{")
+	for _, col := range columns {
+		gf.P("\t\t", strconv.Quote(col.Name), ",")
+	}
+	gf.P("\t}")
+	gf.P("}")
+	gf.P()
+
+	gf.P("// ScanRow scans a row with the columns of Columns into ", plainType, ".")
+	gf.P("// Messages, maps and repeated fields are read from JSON columns.")
+	gf.P("func (p *", plainType, ") ScanRow(rows interface{ Scan(...any) error }) error {")
+	gf.P("\treturn rows.Scan(")
+	for _, col := range columns {
+		if col.JSON {
+			gf.P("\t\t", gf.QualifiedGoIdent(plainsqlPkg.Ident("JSON")), "(&p.", col.GoName, "),")
+		} else {
+			gf.P("\t\t&p.", col.GoName, ",")
+		}
+	}
+	gf.P("\t)")
+	gf.P("}")
+	gf.P()
+
+	gf.P("// Values returns the column values of ", plainType, " in the order of Columns.")
+	gf.P("// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.")
+	gf.P("func (p *", plainType, ") Values() []any {")
+	gf.P("\treturn []any{")
+	for _, col := range columns {
+		switch {
+		case col.JSON:
+			gf.P("\t\t", gf.QualifiedGoIdent(plainsqlPkg.Ident("JSON")), "(&p.", col.GoName, "),")
+		case col.Uint64 && col.Pointer:
+			gf.P("\t\t", gf.QualifiedGoIdent(plainsqlPkg.Ident("Uint64")), "(p.", col.GoName, "),")
+		case col.Uint64:
+			gf.P("\t\t", gf.QualifiedGoIdent(plainsqlPkg.Ident("Uint64")), "(&p.", col.GoName, "),")
+		default:
+			gf.P("\t\tp.", col.GoName, ",")
+		}
+	}
+	gf.P("\t}")
+	gf.P("}")
+	gf.P()
+}
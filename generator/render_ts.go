@@ -0,0 +1,283 @@
+package generator
+
+import (
+	"encoding/json"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yaroher/protoc-gen-go-plain/logger"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// tsIdentifier matches object keys written without quotes
+var tsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// tsProperty is a key of the JSON object of a Plain struct
+type tsProperty struct {
+	key      string
+	types    []string
+	required bool
+	comment  string
+}
+
+// generateTSFile writes TypeScript types of the JSON written by MarshalJX of the
+// Plain structs of the file to <file>_plain.ts. Field types are derived from
+// their JSON Schema, see messageJSONSchema.
+func (g *Generator) generateTSFile(f *protogen.File, irFile *IRFile) {
+	filename := f.GeneratedFilenamePrefix + "_plain.ts"
+	logger.Debug("generating typescript file", zap.String("filename", filename))
+
+	sc := &schemaScope{
+		file:    f,
+		defs:    &schemaProperties{},
+		named:   true,
+		imports: make(map[*protogen.File][]string),
+	}
+	var body []string
+	var generate func(msgs []*IRMessage)
+	generate = func(msgs []*IRMessage) {
+		for _, msg := range msgs {
+			body = append(body, g.tsMessage(sc, msg)...)
+			generate(msg.Nested)
+		}
+	}
+	generate(irFile.Messages)
+
+	gf := g.Plugin.NewGeneratedFile(filename, "")
+	gf.P("// Code generated by protoc-gen-go-plain. DO NOT EDIT.")
+	gf.P("// source: ", f.Desc.Path())
+
+	imported := make([]*protogen.File, 0, len(sc.imports))
+	for target := range sc.imports {
+		imported = append(imported, target)
+	}
+	slices.SortFunc(imported, func(a, b *protogen.File) int { return strings.Compare(a.Desc.Path(), b.Desc.Path()) })
+	if len(imported) > 0 {
+		gf.P()
+	}
+	for _, target := range imported {
+		names := slices.Sorted(slices.Values(sc.imports[target]))
+		gf.P("import type { ", strings.Join(names, ", "), " } from ", strconv.Quote(tsModulePath(f, target)), ";")
+	}
+	for _, line := range body {
+		gf.P(line)
+	}
+}
+
+// tsModulePath returns the module of the TypeScript file of target relative to the one of f
+func tsModulePath(f, target *protogen.File) string {
+	dir := strings.Split(path.Dir(f.GeneratedFilenamePrefix), "/")
+	if dir[0] == "." {
+		dir = nil
+	}
+	module := strings.Split(target.GeneratedFilenamePrefix+"_plain", "/")
+	common := 0
+	for common < len(dir) && common < len(module)-1 && dir[common] == module[common] {
+		common++
+	}
+	parts := make([]string, 0, len(dir)-common+len(module)-common)
+	for range dir[common:] {
+		parts = append(parts, "..")
+	}
+	parts = append(parts, module[common:]...)
+	if parts[0] != ".." {
+		parts = append([]string{"."}, parts...)
+	}
+	return strings.Join(parts, "/")
+}
+
+// tsMessage declares the type of a Plain struct. Without oneofs it is an interface,
+// otherwise an interface of the fields shared by all variants (<Plain>Fields) joined
+// with a discriminated union per oneof (<Plain><Oneof>) keyed by the case field.
+func (g *Generator) tsMessage(sc *schemaScope, msg *IRMessage) []string {
+	var common []*tsProperty
+	variants := make(map[string][]*tsProperty)
+	// Keys written whatever variant is set are shared, like keys of unified_oneof_json
+	// variants that are also written outside of the oneof
+	shared := make(map[string]bool)
+	for _, field := range msg.Fields {
+		if field.OneofName == "" || writtenWhenUnset(field) {
+			shared[g.fieldJSONKey(field)] = true
+		}
+	}
+	for _, field := range msg.Fields {
+		key := g.fieldJSONKey(field)
+		typ := g.tsFieldType(sc, field)
+		if shared[key] {
+			common = tsAddProperty(common, field, key, typ)
+		} else {
+			variant := field.OneofName + "." + field.OneofVariant
+			variants[variant] = tsAddProperty(variants[variant], field, key, typ)
+		}
+	}
+
+	var lines []string
+	name := msg.GoName
+	var unions []string
+	if len(msg.EmbeddedOneofs) > 0 {
+		name = msg.GoName + "Fields"
+		for _, eo := range msg.EmbeddedOneofs {
+			unions = append(unions, msg.GoName+eo.GoName)
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, tsComment("", msg.Comment)...)
+	if len(msg.EmbeddedOneofs) > 0 {
+		lines = append(lines, "/** Fields of "+msg.GoName+" outside of oneofs */")
+	}
+	if len(common) == 0 {
+		lines = append(lines, "export interface "+name+" {}")
+	} else {
+		lines = append(lines, "export interface "+name+" {")
+		for _, prop := range common {
+			lines = append(lines, prop.lines("  ")...)
+		}
+		lines = append(lines, "}")
+	}
+
+	for i, eo := range msg.EmbeddedOneofs {
+		caseKey := tsKey(eo.JSONName)
+		lines = append(lines, "", "/** "+eo.Name+" oneof of "+msg.GoName+", by "+eo.JSONName+" */")
+		lines = append(lines, "export type "+unions[i]+" =")
+		for _, variant := range eo.Variants {
+			props := variants[eo.Name+"."+variant.Name]
+			if len(props) == 0 {
+				lines = append(lines, "  | { "+caseKey+": "+strconv.Quote(variant.Name)+" }")
+				continue
+			}
+			lines = append(lines, "  | {")
+			lines = append(lines, "      "+caseKey+": "+strconv.Quote(variant.Name)+";")
+			for _, prop := range props {
+				lines = append(lines, prop.lines("      ")...)
+			}
+			lines = append(lines, "    }")
+		}
+		lines = append(lines, "  | { "+caseKey+"?: undefined };")
+	}
+	if len(unions) > 0 {
+		lines = append(lines, "", "export type "+msg.GoName+" = "+name+" & "+strings.Join(unions, " & ")+";")
+	}
+	return lines
+}
+
+// tsAddProperty adds the type of a field to its key, unified_oneof_json variants share keys
+func tsAddProperty(props []*tsProperty, field *IRField, key, typ string) []*tsProperty {
+	i := slices.IndexFunc(props, func(p *tsProperty) bool { return p.key == key })
+	if i < 0 {
+		return append(props, &tsProperty{
+			key:      key,
+			types:    []string{typ},
+			required: field.WriteDefault,
+			comment:  field.Comment,
+		})
+	}
+	prop := props[i]
+	if !slices.Contains(prop.types, typ) {
+		prop.types = append(prop.types, typ)
+	}
+	prop.required = prop.required && field.WriteDefault
+	return props
+}
+
+// lines declares the property. Keys are optional unless written with write_default,
+// since MarshalJX omits zero values.
+func (p *tsProperty) lines(indent string) []string {
+	lines := tsComment(indent, p.comment)
+	optional := "?"
+	if p.required {
+		optional = ""
+	}
+	return append(lines, indent+tsKey(p.key)+optional+": "+strings.Join(p.types, " | ")+";")
+}
+
+// tsFieldType returns the TypeScript type of the JSON value of a field
+func (g *Generator) tsFieldType(sc *schemaScope, field *IRField) string {
+	typ := tsType(g.fieldJSONSchema(sc, field))
+	// write_default writes null for unset message fields
+	if field.WriteDefault && field.Kind == KindMessage && field.NativeWKT == "" && !field.IsRepeated {
+		typ += " | null"
+	}
+	return typ
+}
+
+// tsType converts a field schema of messageJSONSchema to a TypeScript type
+func tsType(s *jsonSchema) string {
+	switch {
+	case s.Ref != "":
+		return s.Ref
+	case s.Const != "":
+		return strconv.Quote(s.Const)
+	case len(s.Enum) > 0:
+		literals := make([]string, 0, len(s.Enum))
+		for _, v := range s.Enum {
+			literal, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			literals = append(literals, string(literal))
+		}
+		return strings.Join(literals, " | ")
+	case len(s.AnyOf) > 0:
+		types := make([]string, 0, len(s.AnyOf))
+		for _, sub := range s.AnyOf {
+			if typ := tsType(sub); !slices.Contains(types, typ) {
+				types = append(types, typ)
+			}
+		}
+		return strings.Join(types, " | ")
+	}
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		if s.Items == nil {
+			return "unknown[]"
+		}
+		item := tsType(s.Items)
+		if strings.Contains(item, " ") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	case "object":
+		if value, ok := s.AdditionalProperties.(*jsonSchema); ok {
+			return "Record<string, " + tsType(value) + ">"
+		}
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+// tsKey quotes object keys that aren't identifiers
+func tsKey(key string) string {
+	if tsIdentifier.MatchString(key) {
+		return key
+	}
+	return strconv.Quote(key)
+}
+
+// tsComment turns a proto comment into a JSDoc comment
+func tsComment(indent, comment string) []string {
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return nil
+	}
+	text := strings.Split(comment, "\n")
+	if len(text) == 1 {
+		return []string{indent + "/** " + strings.ReplaceAll(text[0], "*/", "*\\/") + " */"}
+	}
+	lines := []string{indent + "/**"}
+	for _, line := range text {
+		line = strings.TrimRight(" * "+strings.ReplaceAll(strings.TrimSpace(line), "*/", "*\\/"), " ")
+		lines = append(lines, indent+line)
+	}
+	return append(lines, indent+" */")
+}
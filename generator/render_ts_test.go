@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func TestTSModulePath(t *testing.T) {
+	file := func(prefix string) *protogen.File {
+		return &protogen.File{GeneratedFilenamePrefix: prefix}
+	}
+	assert.Equal(t, "./user_plain", tsModulePath(file("api/order"), file("api/user")))
+	assert.Equal(t, "./common/money_plain", tsModulePath(file("api/order"), file("api/common/money")))
+	assert.Equal(t, "../user_plain", tsModulePath(file("api/v1/order"), file("api/user")))
+	assert.Equal(t, "../../shared/id_plain", tsModulePath(file("api/v1/order"), file("shared/id")))
+	// файлы в корне вывода
+	assert.Equal(t, "./user_plain", tsModulePath(file("order"), file("user")))
+	assert.Equal(t, "../user_plain", tsModulePath(file("api/order"), file("user")))
+}
+
+func TestTSType(t *testing.T) {
+	tests := map[string]*jsonSchema{
+		"string":                      {Type: "string"},
+		"number":                      {Type: "integer", Minimum: &zeroMinimum},
+		"UserPlain":                   {Ref: "UserPlain"},
+		`"A" | "B"`:                   {Type: "string", Enum: []any{"A", "B"}},
+		"0 | 1":                       {Type: "integer", Enum: []any{int32(0), int32(1)}},
+		`("A" | "B")[]`:               {Type: "array", Items: &jsonSchema{Type: "string", Enum: []any{"A", "B"}}},
+		"Record<string, number>":      {Type: "object", AdditionalProperties: &jsonSchema{Type: "number"}},
+		"Record<string, unknown>":     {Type: "object"},
+		"string | number":             {AnyOf: []*jsonSchema{{Type: "string"}, {Type: "integer"}, {Type: "number"}}},
+		"unknown":                     {},
+		"Record<string, UserPlain[]>": {Type: "object", AdditionalProperties: &jsonSchema{Type: "array", Items: &jsonSchema{Ref: "UserPlain"}}},
+		`"email"`:                     {Const: "email"},
+	}
+	for expected, s := range tests {
+		assert.Equal(t, expected, tsType(s))
+	}
+}
+
+func TestTSComment(t *testing.T) {
+	assert.Nil(t, tsComment("", " "))
+	assert.Equal(t, []string{"  /** Id of the user */"}, tsComment("  ", " Id of the user\n"))
+	assert.Equal(t, []string{"/**", " * First", " *", " * a *\\/ b", " */"}, tsComment("", "First\n\n a */ b"))
+}
@@ -0,0 +1,1004 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yaroher/protoc-gen-go-plain/logger"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	protowirePkg = protogen.GoImportPath("google.golang.org/protobuf/encoding/protowire")
+	plainwirePkg = protogen.GoImportPath("github.com/yaroher/protoc-gen-go-plain/plainwire")
+	protoPkg     = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	mathPkg      = protogen.GoImportPath("math")
+)
+
+// wireNode is one protobuf message level of a Plain struct: the fields of the
+// original message that hold Plain fields, directly or through embedded messages.
+type wireNode struct {
+	msg     *protogen.Message
+	entries []*wireEntry
+}
+
+// wireEntry is a protobuf field of a wireNode. Either plain is set (the field
+// value is stored in a Plain field) or nested (the message is flattened into
+// the Plain struct).
+type wireEntry struct {
+	pb     *protogen.Field
+	plain  *IRField
+	nested *wireNode
+	// caseField is the Plain oneof case field selecting this variant, if any,
+	// and caseConst is the case value of the variant
+	caseField string
+	caseConst string
+}
+
+// entry returns the entry for pb field, creating it if needed
+func (n *wireNode) entry(pb *protogen.Field) *wireEntry {
+	for _, e := range n.entries {
+		if e.pb == pb {
+			return e
+		}
+	}
+	e := &wireEntry{pb: pb}
+	n.entries = append(n.entries, e)
+	return e
+}
+
+// setCases fills case fields of oneof variants from the oneofs of the Plain message
+func (n *wireNode) setCases(oneofs []*EmbeddedOneof) error {
+	for _, e := range n.entries {
+		if oneof := e.pb.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			for _, eo := range oneofs {
+				if eo.Source != oneof {
+					continue
+				}
+				for _, variant := range eo.Variants {
+					if variant.FieldNumber == int32(e.pb.Desc.Number()) {
+						e.caseField, e.caseConst = eo.CaseFieldName, variant.CaseConst
+					}
+				}
+			}
+			if e.caseField == "" {
+				return fmt.Errorf("no case field for oneof %s", oneof.Desc.FullName())
+			}
+		}
+		if e.nested != nil {
+			if err := e.nested.setCases(oneofs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sort orders entries as proto.Marshal does: regular fields by number,
+// then oneof fields grouped by oneof declaration order
+func (n *wireNode) sort() {
+	sort.Slice(n.entries, func(i, j int) bool {
+		return wireFieldLess(n.entries[i].pb.Desc, n.entries[j].pb.Desc)
+	})
+	for _, e := range n.entries {
+		if e.nested != nil {
+			e.nested.sort()
+		}
+	}
+}
+
+// wireFieldLess is the field order of proto.Marshal (LegacyFieldOrder in protobuf-go)
+func wireFieldLess(x, y protoreflect.FieldDescriptor) bool {
+	inOneof := func(od protoreflect.OneofDescriptor) bool {
+		return od != nil && !od.IsSynthetic()
+	}
+	ox, oy := x.ContainingOneof(), y.ContainingOneof()
+	if inOneof(ox) != inOneof(oy) {
+		return !inOneof(ox)
+	}
+	if inOneof(ox) && ox != oy {
+		return ox.Index() < oy.Index()
+	}
+	return x.Number() < y.Number()
+}
+
+// buildWireTree maps Plain fields back onto the protobuf message tree using PathNumbers.
+// Returns an error for field shapes that can't be written directly.
+func (g *Generator) buildWireTree(msg *IRMessage) (*wireNode, error) {
+	root := &wireNode{msg: msg.Source}
+	for _, field := range msg.Fields {
+		if field.Origin == OriginVirtual {
+			continue
+		}
+		if err := g.checkWireField(field); err != nil {
+			return nil, fmt.Errorf("%s: %w", field.GoName, err)
+		}
+		paths := [][]int32{field.PathNumbers}
+		for _, alt := range field.OneofAlternatives {
+			paths = append(paths, alt.PathNumbers)
+		}
+		for _, path := range paths {
+			// The type alias message itself is written by the leaf
+			if field.Origin == OriginTypeAlias && len(path) > 0 {
+				path = path[:len(path)-1]
+			}
+			if err := root.add(field, path); err != nil {
+				return nil, fmt.Errorf("%s: %w", field.GoName, err)
+			}
+		}
+	}
+	if err := root.setCases(msg.EmbeddedOneofs); err != nil {
+		return nil, err
+	}
+	root.sort()
+	return root, nil
+}
+
+// add places field at path below the node
+func (n *wireNode) add(field *IRField, path []int32) error {
+	if len(path) == 0 {
+		return fmt.Errorf("no path information")
+	}
+	node := n
+	for i, num := range path {
+		pb := findFieldByNumber(node.msg, num)
+		if pb == nil {
+			return fmt.Errorf("field %d not found in %s", num, node.msg.Desc.FullName())
+		}
+		if pb.Desc.Kind() == protoreflect.GroupKind {
+			return fmt.Errorf("group encoding of %s", pb.Desc.FullName())
+		}
+		e := node.entry(pb)
+		if i == len(path)-1 {
+			if e.plain != nil || e.nested != nil {
+				return fmt.Errorf("field %s is written twice", pb.Desc.FullName())
+			}
+			e.plain = field
+			return nil
+		}
+		if pb.Message == nil || pb.Desc.IsList() || pb.Desc.IsMap() || e.plain != nil {
+			return fmt.Errorf("can't embed through %s", pb.Desc.FullName())
+		}
+		if e.nested == nil {
+			e.nested = &wireNode{msg: pb.Message}
+		}
+		node = e.nested
+	}
+	return nil
+}
+
+// checkWireField reports Plain field shapes without a direct wire mapping
+func (g *Generator) checkWireField(field *IRField) error {
+	switch {
+	case field.Origin == OriginSerialized && field.IsRepeated:
+		return fmt.Errorf("repeated serialized field")
+	case field.Origin == OriginTypeAlias && field.Kind == KindMessage:
+		return fmt.Errorf("type alias of a message")
+	case field.IsMap && (field.MapKey == nil || field.MapValue == nil || field.NeedsCaster):
+		return fmt.Errorf("map with type override")
+	case field.Kind == KindMessage && field.IsRepeated && field.EmbedItem == nil && !field.GoType.IsPointer && !g.isPlainMessage(field):
+		return fmt.Errorf("repeated message stored by value")
+	case field.Kind == KindMessage && field.IsRepeated && field.NeedsCaster:
+		return fmt.Errorf("repeated message with type override")
+	}
+	return nil
+}
+
+// isPlainMessage reports whether the message field is stored as a Plain struct (generate=true)
+func (g *Generator) isPlainMessage(field *IRField) bool {
+	msgOpts := g.getMessageOptionsFromField(field)
+	return msgOpts != nil && msgOpts.Generate
+}
+
+// wireSupported reports whether wire methods can be generated for the message.
+// Messages with parameter casters are skipped (wire methods take no arguments),
+// as are messages referencing such Plain types.
+func (g *Generator) wireSupported(msg *IRMessage) bool {
+	if msg == nil {
+		return true
+	}
+	if supported, ok := g.wireMessages[msg]; ok {
+		return supported
+	}
+	// Recursive messages are assumed supported while being checked
+	g.wireMessages[msg] = true
+
+	supported, reason := g.checkWireMessage(msg)
+	if !supported {
+		logger.Debug("skipping wire methods",
+			zap.String("message", msg.GoName),
+			zap.String("reason", reason),
+		)
+	}
+	g.wireMessages[msg] = supported
+	return supported
+}
+
+func (g *Generator) checkWireMessage(msg *IRMessage) (bool, string) {
+	if msg.Source == nil {
+		return false, "virtual type"
+	}
+	if len(g.collectCasterFields(msg)) > 0 {
+		return false, "caster parameters"
+	}
+	if _, err := g.buildWireTree(msg); err != nil {
+		return false, err.Error()
+	}
+	for _, field := range msg.Fields {
+		if field.EmbedItem != nil && !g.wireSupported(field.EmbedItem) {
+			return false, field.GoName + ": row type without wire methods"
+		}
+		var nested *protogen.Message
+		if field.IsMap && field.MapValue != nil && field.MapValue.Source != nil {
+			nested = field.MapValue.Source.Message
+		} else if field.Kind == KindMessage && field.Source != nil {
+			nested = field.Source.Message
+		}
+		if nested == nil || !g.isPlainMessageType(nested) {
+			continue
+		}
+		if !g.wireSupported(g.GetIRMessage(nested)) {
+			return false, field.GoName + ": " + nested.GoIdent.GoName + " has no wire methods"
+		}
+	}
+	return true, ""
+}
+
+// isPlainMessageType reports whether the message is generated as a Plain struct
+func (g *Generator) isPlainMessageType(msg *protogen.Message) bool {
+	msgOpts := g.getMessageOptions(msg)
+	return msgOpts != nil && msgOpts.Generate
+}
+
+// generateWireMethods generates MarshalProto, AppendProto and UnmarshalProto
+// writing the Plain struct directly in the wire format of the original message
+func (g *Generator) generateWireMethods(gf *protogen.GeneratedFile, msg *IRMessage, f *protogen.File) {
+	if !g.wireSupported(msg) {
+		return
+	}
+	tree, err := g.buildWireTree(msg)
+	if err != nil {
+		return
+	}
+	withErr := g.intoPbErr(msg)
+
+	gf.P("// MarshalProto encodes ", msg.GoName, " in the protobuf wire format of ", msg.Source.GoIdent.GoName)
+	gf.P("func (p *", msg.GoName, ") MarshalProto() ([]byte, error) {")
+	gf.P("\treturn p.AppendProto(nil)")
+	gf.P("}")
+	gf.P()
+
+	gf.P("// AppendProto appends ", msg.GoName, " encoded in the protobuf wire format of ", msg.Source.GoIdent.GoName, " to b.")
+	gf.P("// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.")
+	gf.P("func (p *", msg.GoName, ") AppendProto(b []byte) ([]byte, error) {")
+	gf.P("\tif p == nil {")
+	gf.P("\t\treturn b, nil")
+	gf.P("\t}")
+	gf.P("\tvar err error")
+	if withErr {
+		gf.P("\tvar _err error")
+	}
+	g.generateAppendWireNode(gf, tree, f)
+	if withErr {
+		gf.P("\tif _err != nil {")
+		gf.P("\t\treturn nil, _err")
+		gf.P("\t}")
+	}
+	gf.P("\treturn b, err")
+	gf.P("}")
+	gf.P()
+
+	gf.P("// UnmarshalProto decodes ", msg.GoName, " from the protobuf wire format of ", msg.Source.GoIdent.GoName, ".")
+	gf.P("// Unknown fields are skipped.")
+	gf.P("func (p *", msg.GoName, ") UnmarshalProto(b []byte) error {")
+	gf.P("\t*p = ", msg.GoName, "{}")
+	for _, field := range msg.Fields {
+		if field.DefaultValue.IsValid() {
+			gf.P("\tp.", field.GoName, " = ", g.defaultLiteral(gf, field, f))
+		}
+	}
+	if withErr {
+		gf.P("\tvar _err error")
+	}
+	g.generateUnmarshalWireNode(gf, tree, f)
+	if withErr {
+		gf.P("\treturn _err")
+	} else {
+		gf.P("\treturn nil")
+	}
+	gf.P("}")
+	gf.P()
+}
+
+// ============================================================================
+// AppendProto
+// ============================================================================
+
+func (g *Generator) generateAppendWireNode(gf *protogen.GeneratedFile, node *wireNode, f *protogen.File) {
+	for _, e := range node.entries {
+		if e.nested != nil {
+			g.generateAppendWireNested(gf, e, f)
+			continue
+		}
+		g.generateAppendWireLeaf(gf, e, f)
+	}
+}
+
+// generateAppendWireNested writes an embedded message in place.
+// A selected oneof variant is written even if empty.
+func (g *Generator) generateAppendWireNested(gf *protogen.GeneratedFile, e *wireEntry, f *protogen.File) {
+	gf.P("\t// ", e.pb.Desc.Name(), " (embedded)")
+	if e.caseField != "" {
+		gf.P("\tif p.", e.caseField, " == ", e.caseConst, " {")
+		g.appendTag(gf, e.pb)
+		gf.P("\t\t_start := len(b)")
+		g.generateAppendWireNode(gf, e.nested, f)
+		gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishLen")), "(b, _start)")
+		gf.P("\t}")
+		return
+	}
+	gf.P("\t{")
+	gf.P("\t\t_tag := len(b)")
+	g.appendTag(gf, e.pb)
+	gf.P("\t\t_start := len(b)")
+	g.generateAppendWireNode(gf, e.nested, f)
+	gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishEmbed")), "(b, _tag, _start)")
+	gf.P("\t}")
+}
+
+func (g *Generator) generateAppendWireLeaf(gf *protogen.GeneratedFile, e *wireEntry, f *protogen.File) {
+	field := e.plain
+	src := "p." + field.GoName
+	plainIsPointer := g.plainIsPointer(field)
+
+	// Oneof variants are written only when selected
+	if e.caseField != "" {
+		gf.P("\tif p.", e.caseField, " == ", e.caseConst, " {")
+		defer gf.P("\t}")
+	}
+
+	switch {
+	case field.EmbedItem != nil:
+		gf.P("\tfor i := range ", src, " {")
+		g.appendTag(gf, e.pb)
+		g.appendLen(gf, "b, err = "+src+"[i].AppendProto(b)")
+		gf.P("\t}")
+
+	case field.Origin == OriginTypeAlias:
+		g.generateAppendWireTypeAlias(gf, e, src, plainIsPointer, f)
+
+	case field.Origin == OriginSerialized:
+		protojsonPkg := protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
+		gf.P("\tif len(", src, ") > 0 {")
+		gf.P("\t\tm := &", gf.QualifiedGoIdent(e.pb.Message.GoIdent), "{}")
+		gf.P("\t\tif err := ", gf.QualifiedGoIdent(protojsonPkg.Ident("Unmarshal")), "(", src, ", m); err != nil {")
+		gf.P("\t\t\treturn nil, err")
+		gf.P("\t\t}")
+		g.appendTag(gf, e.pb)
+		g.appendLen(gf, "b, err = "+g.marshalAppend(gf, "m"))
+		gf.P("\t}")
+
+	case field.IsMap:
+		g.generateAppendWireMap(gf, e, src, f)
+
+	case field.Kind == KindMessage:
+		g.generateAppendWireMessage(gf, e, src, plainIsPointer)
+
+	case field.IsRepeated:
+		g.generateAppendWireRepeated(gf, e, src, f)
+
+	default:
+		g.generateAppendWireScalar(gf, e, src, plainIsPointer, f)
+	}
+}
+
+// generateAppendWireMessage writes a message field: Plain structs via AppendProto,
+// protobuf messages via proto.MarshalOptions.MarshalAppend
+func (g *Generator) generateAppendWireMessage(gf *protogen.GeneratedFile, e *wireEntry, src string, plainIsPointer bool) {
+	field := e.plain
+	switch {
+	case g.isPlainMessage(field) && field.IsRepeated:
+		gf.P("\tfor i := range ", src, " {")
+		g.appendTag(gf, e.pb)
+		g.appendLen(gf, "b, err = "+src+"[i].AppendProto(b)")
+		gf.P("\t}")
+	case g.isPlainMessage(field):
+		gf.P("\tif ", src, " != nil {")
+		g.appendTag(gf, e.pb)
+		g.appendLen(gf, "b, err = "+src+".AppendProto(b)")
+		gf.P("\t}")
+	case field.NeedsCaster:
+		value := src
+		if plainIsPointer {
+			gf.P("\tif ", src, " != nil {")
+			value = "*" + src
+		}
+		gf.P("\tif m := ", g.casterCallWithImport(gf, field, value, false), "; m != nil {")
+		g.appendTag(gf, e.pb)
+		g.appendLen(gf, "b, err = "+g.marshalAppend(gf, "m"))
+		gf.P("\t}")
+		if plainIsPointer {
+			gf.P("\t}")
+		}
+	case field.IsRepeated:
+		gf.P("\tfor _, m := range ", src, " {")
+		g.appendTag(gf, e.pb)
+		g.appendLen(gf, "b, err = "+g.marshalAppend(gf, "m"))
+		gf.P("\t}")
+	default:
+		gf.P("\tif ", src, " != nil {")
+		g.appendTag(gf, e.pb)
+		g.appendLen(gf, "b, err = "+g.marshalAppend(gf, src))
+		gf.P("\t}")
+	}
+}
+
+// generateAppendWireTypeAlias wraps the value into the type alias message
+func (g *Generator) generateAppendWireTypeAlias(gf *protogen.GeneratedFile, e *wireEntry, src string, plainIsPointer bool, f *protogen.File) {
+	field := e.plain
+	inner := findFieldByNumber(e.pb.Message, field.PathNumbers[len(field.PathNumbers)-1])
+	elem := g.wireElemField(field)
+
+	switch {
+	case field.IsRepeated:
+		gf.P("\tfor _, v := range ", src, " {")
+		g.appendTag(gf, e.pb)
+		gf.P("\t\t_start := len(b)")
+		g.appendScalar(gf, inner, elem, "v", false, f)
+		gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishLen")), "(b, _start)")
+		gf.P("\t}")
+	case plainIsPointer:
+		gf.P("\tif ", src, " != nil {")
+		g.appendTag(gf, e.pb)
+		gf.P("\t\t_start := len(b)")
+		g.appendScalar(gf, inner, elem, "*"+src, false, f)
+		gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishLen")), "(b, _start)")
+		gf.P("\t}")
+	case e.caseField != "":
+		// The selected variant is written even if the value is zero
+		g.appendTag(gf, e.pb)
+		gf.P("\t\t_start := len(b)")
+		g.appendScalar(gf, inner, elem, src, false, f)
+		gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishLen")), "(b, _start)")
+	default:
+		gf.P("\t{")
+		gf.P("\t\t_tag := len(b)")
+		g.appendTag(gf, e.pb)
+		gf.P("\t\t_start := len(b)")
+		g.appendScalar(gf, inner, elem, src, false, f)
+		gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishEmbed")), "(b, _tag, _start)")
+		gf.P("\t}")
+	}
+}
+
+// generateAppendWireMap writes map entries with sorted keys, so the output is deterministic
+func (g *Generator) generateAppendWireMap(gf *protogen.GeneratedFile, e *wireEntry, src string, f *protogen.File) {
+	field := e.plain
+	keyField, valueField := e.pb.Message.Fields[0], e.pb.Message.Fields[1]
+
+	sorted := "SortedKeys"
+	if keyField.Desc.Kind() == protoreflect.BoolKind {
+		sorted = "SortedBoolKeys"
+	}
+	gf.P("\tfor _, k := range ", gf.QualifiedGoIdent(plainwirePkg.Ident(sorted)), "(", src, ") {")
+	gf.P("\t\tv := ", src, "[k]")
+	g.appendTag(gf, e.pb)
+	gf.P("\t\t_start := len(b)")
+	g.appendScalar(gf, keyField, wireMapField(field.MapKey), "k", true, f)
+	if valueField.Message != nil {
+		stmt := "b, err = " + g.marshalAppend(gf, "v")
+		if g.isPlainMessageType(valueField.Message) {
+			stmt = "b, err = v.AppendProto(b)"
+		}
+		g.appendTag(gf, valueField)
+		gf.P("\t\t_value := len(b)")
+		gf.P("\t\tif ", stmt, "; err != nil {")
+		gf.P("\t\t\treturn nil, err")
+		gf.P("\t\t}")
+		gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishLen")), "(b, _value)")
+	} else {
+		g.appendScalar(gf, valueField, wireMapField(field.MapValue), "v", true, f)
+	}
+	gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishLen")), "(b, _start)")
+	gf.P("\t}")
+}
+
+// generateAppendWireRepeated writes repeated scalars, packed if the field is packed
+func (g *Generator) generateAppendWireRepeated(gf *protogen.GeneratedFile, e *wireEntry, src string, f *protogen.File) {
+	elem := g.wireElemField(e.plain)
+	if e.pb.Desc.IsPacked() {
+		gf.P("\tif len(", src, ") > 0 {")
+		gf.P("\t\tb = ", g.protowire(gf, "AppendTag"), "(b, ", e.pb.Desc.Number(), ", ", g.protowire(gf, "BytesType"), ")")
+		gf.P("\t\t_start := len(b)")
+		gf.P("\t\tfor _, v := range ", src, " {")
+		gf.P("\t\t\t", g.appendValue(gf, e.pb, g.wirePbValue(gf, elem, "v", f)))
+		gf.P("\t\t}")
+		gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishLen")), "(b, _start)")
+		gf.P("\t}")
+		return
+	}
+	gf.P("\tfor _, v := range ", src, " {")
+	g.appendScalar(gf, e.pb, elem, "v", true, f)
+	gf.P("\t}")
+}
+
+// generateAppendWireScalar writes a singular scalar or enum.
+// Implicit presence fields are skipped when zero, as proto.Marshal does.
+func (g *Generator) generateAppendWireScalar(gf *protogen.GeneratedFile, e *wireEntry, src string, plainIsPointer bool, f *protogen.File) {
+	field := e.plain
+	if plainIsPointer {
+		gf.P("\tif ", src, " != nil {")
+		g.appendScalar(gf, e.pb, field, "*"+src, true, f)
+		gf.P("\t}")
+		return
+	}
+	// Fields with [default = ...] are written when they differ from the default,
+	// required ones always
+	if field.DefaultValue.IsValid() && e.pb.Desc.Cardinality() != protoreflect.Required {
+		gf.P("\tif ", g.defaultCheck(gf, field, src, f), " {")
+		g.appendScalar(gf, e.pb, field, src, true, f)
+		gf.P("\t}")
+		return
+	}
+	// Bytes with explicit presence are unset when nil, as in protobuf-go
+	if e.caseField == "" && pbBytesHasPresence(e.pb) {
+		gf.P("\tif ", src, " != nil {")
+		g.appendScalar(gf, e.pb, field, src, true, f)
+		gf.P("\t}")
+		return
+	}
+	// Oneof variants and fields with explicit presence are always written
+	always := e.caseField != "" || e.pb.Desc.HasPresence()
+	g.appendScalar(gf, e.pb, field, src, always, f)
+}
+
+// appendScalar writes tag and value of a scalar field.
+// Unless always is set, zero values are skipped.
+func (g *Generator) appendScalar(gf *protogen.GeneratedFile, pb *protogen.Field, field *IRField, value string, always bool, f *protogen.File) {
+	pbValue := g.wirePbValue(gf, field, value, f)
+	if always {
+		g.appendTag(gf, pb)
+		gf.P("\t", g.appendValue(gf, pb, pbValue))
+		return
+	}
+	if pbValue == "v" {
+		gf.P("\tif ", g.wireNonZero(gf, pb, "v"), " {")
+	} else {
+		gf.P("\tif v := ", pbValue, "; ", g.wireNonZero(gf, pb, "v"), " {")
+	}
+	g.appendTag(gf, pb)
+	gf.P("\t\t", g.appendValue(gf, pb, "v"))
+	gf.P("\t}")
+}
+
+// appendLen appends a length-delimited value produced by stmt (b, err = ...)
+func (g *Generator) appendLen(gf *protogen.GeneratedFile, stmt string) {
+	gf.P("\t\t_start := len(b)")
+	gf.P("\t\tif ", stmt, "; err != nil {")
+	gf.P("\t\t\treturn nil, err")
+	gf.P("\t\t}")
+	gf.P("\t\tb = ", gf.QualifiedGoIdent(plainwirePkg.Ident("FinishLen")), "(b, _start)")
+}
+
+func (g *Generator) appendTag(gf *protogen.GeneratedFile, pb *protogen.Field) {
+	gf.P("\t\tb = ", g.protowire(gf, "AppendTag"), "(b, ", pb.Desc.Number(), ", ", g.protowire(gf, wireTypeName(pb.Desc.Kind())), ")")
+}
+
+func (g *Generator) marshalAppend(gf *protogen.GeneratedFile, value string) string {
+	return "(" + gf.QualifiedGoIdent(protoPkg.Ident("MarshalOptions")) + "{}).MarshalAppend(b, " + value + ")"
+}
+
+func (g *Generator) protowire(gf *protogen.GeneratedFile, name string) string {
+	return gf.QualifiedGoIdent(protowirePkg.Ident(name))
+}
+
+// wireElemField returns a field describing one element of a repeated Plain field
+func (g *Generator) wireElemField(field *IRField) *IRField {
+	elem := *field
+	elem.IsRepeated = false
+	elem.IsOptional = false
+	return &elem
+}
+
+// wireMapField returns a map key or value field for wire conversions.
+// Map values keep the enum type even with enum_as_string, which only affects JSON.
+func wireMapField(field *IRField) *IRField {
+	kv := *field
+	kv.EnumAsString = false
+	kv.EnumAsInt = false
+	return &kv
+}
+
+// wirePbValue converts a Plain value to the Go type of the protobuf field
+func (g *Generator) wirePbValue(gf *protogen.GeneratedFile, field *IRField, value string, f *protogen.File) string {
+	switch {
+	case field.NeedsCaster:
+		return g.casterCallWithImport(gf, field, value, false)
+	case field.EnumAsString || field.EnumAsInt:
+		return g.enumIntoPbExpr(gf, field, value, f)
+	case g.needsTypeCast(field) && field.Kind != KindBytes && field.Origin != OriginTypeAlias:
+		return g.getSourceTypeName(field) + "(" + value + ")"
+	}
+	return value
+}
+
+// wireNonZero returns the condition proto.Marshal uses to write an implicit presence value
+func (g *Generator) wireNonZero(gf *protogen.GeneratedFile, pb *protogen.Field, v string) string {
+	switch pb.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return v
+	case protoreflect.StringKind:
+		return v + ` != ""`
+	case protoreflect.BytesKind:
+		return "len(" + v + ") > 0"
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		// -0.0 is written too
+		return v + " != 0 || " + gf.QualifiedGoIdent(mathPkg.Ident("Signbit")) + "(float64(" + v + "))"
+	default:
+		return v + " != 0"
+	}
+}
+
+// appendValue returns the statement appending a scalar value without the tag
+func (g *Generator) appendValue(gf *protogen.GeneratedFile, pb *protogen.Field, v string) string {
+	pw := func(name string) string { return g.protowire(gf, name) }
+	switch pb.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "b = " + pw("AppendVarint") + "(b, " + pw("EncodeBool") + "(" + v + "))"
+	case protoreflect.EnumKind, protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return "b = " + pw("AppendVarint") + "(b, uint64(" + v + "))"
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return "b = " + pw("AppendVarint") + "(b, " + pw("EncodeZigZag") + "(int64(" + v + ")))"
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
+		return "b = " + pw("AppendFixed32") + "(b, uint32(" + v + "))"
+	case protoreflect.FloatKind:
+		return "b = " + pw("AppendFixed32") + "(b, " + gf.QualifiedGoIdent(mathPkg.Ident("Float32bits")) + "(float32(" + v + ")))"
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
+		return "b = " + pw("AppendFixed64") + "(b, uint64(" + v + "))"
+	case protoreflect.DoubleKind:
+		return "b = " + pw("AppendFixed64") + "(b, " + gf.QualifiedGoIdent(mathPkg.Ident("Float64bits")) + "(float64(" + v + ")))"
+	case protoreflect.StringKind:
+		return "b = " + pw("AppendString") + "(b, " + v + ")"
+	default:
+		return "b = " + pw("AppendBytes") + "(b, " + v + ")"
+	}
+}
+
+// wireTypeName returns the protowire wire type constant for a field kind
+func wireTypeName(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.BoolKind, protoreflect.EnumKind, protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return "VarintType"
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return "Fixed32Type"
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return "Fixed64Type"
+	default:
+		return "BytesType"
+	}
+}
+
+// ============================================================================
+// UnmarshalProto
+// ============================================================================
+
+// generateUnmarshalWireNode generates the decoding loop over b.
+// Nested loops shadow b, num, typ and n, so every level looks the same.
+func (g *Generator) generateUnmarshalWireNode(gf *protogen.GeneratedFile, node *wireNode, f *protogen.File) {
+	g.wireDecodeLoop(gf, "b", func() {
+		for _, e := range node.entries {
+			g.generateUnmarshalWireEntry(gf, e, f)
+		}
+	})
+}
+
+// wireDecodeLoop generates a loop over fields in buf, cases are generated by body
+func (g *Generator) wireDecodeLoop(gf *protogen.GeneratedFile, buf string, body func()) {
+	if buf == "b" {
+		gf.P("\tfor len(b) > 0 {")
+	} else {
+		gf.P("\tfor b := ", buf, "; len(b) > 0; {")
+	}
+	gf.P("\t\tnum, typ, n := ", g.protowire(gf, "ConsumeTag"), "(b)")
+	gf.P("\t\tif n < 0 {")
+	gf.P("\t\t\treturn ", g.protowire(gf, "ParseError"), "(n)")
+	gf.P("\t\t}")
+	gf.P("\t\tb = b[n:]")
+	gf.P("\t\tswitch {")
+	body()
+	gf.P("\t\tdefault:")
+	gf.P("\t\t\tn = ", g.protowire(gf, "ConsumeFieldValue"), "(num, typ, b)")
+	gf.P("\t\t}")
+	gf.P("\t\tif n < 0 {")
+	gf.P("\t\t\treturn ", g.protowire(gf, "ParseError"), "(n)")
+	gf.P("\t\t}")
+	gf.P("\t\tb = b[n:]")
+	gf.P("\t}")
+}
+
+// wireCase opens a switch case for field number and wire type
+func (g *Generator) wireCase(gf *protogen.GeneratedFile, pb *protogen.Field, wireType string) {
+	gf.P("\t\tcase num == ", pb.Desc.Number(), " && typ == ", g.protowire(gf, wireType), ":")
+}
+
+// consumeBytes generates reading a length-delimited value into v
+func (g *Generator) consumeBytes(gf *protogen.GeneratedFile) {
+	gf.P("\t\t\tvar v []byte")
+	gf.P("\t\t\tv, n = ", g.protowire(gf, "ConsumeBytes"), "(b)")
+}
+
+func (g *Generator) generateUnmarshalWireEntry(gf *protogen.GeneratedFile, e *wireEntry, f *protogen.File) {
+	setCase := func() {
+		if e.caseField != "" {
+			gf.P("\t\t\tp.", e.caseField, " = ", e.caseConst)
+		}
+	}
+
+	if e.nested != nil {
+		g.wireCase(gf, e.pb, "BytesType")
+		g.consumeBytes(gf)
+		setCase()
+		g.wireDecodeLoop(gf, "v", func() {
+			for _, ne := range e.nested.entries {
+				g.generateUnmarshalWireEntry(gf, ne, f)
+			}
+		})
+		return
+	}
+
+	field := e.plain
+	dst := "p." + field.GoName
+	plainIsPointer := g.plainIsPointer(field)
+
+	switch {
+	case field.EmbedItem != nil:
+		g.wireCase(gf, e.pb, "BytesType")
+		g.consumeBytes(gf)
+		gf.P("\t\t\tvar e ", field.EmbedItem.GoName)
+		g.unmarshalInto(gf, "e", "v")
+		gf.P("\t\t\t", dst, " = append(", dst, ", e)")
+
+	case field.Origin == OriginTypeAlias:
+		inner := findFieldByNumber(e.pb.Message, field.PathNumbers[len(field.PathNumbers)-1])
+		elem := g.wireElemField(field)
+		g.wireCase(gf, e.pb, "BytesType")
+		g.consumeBytes(gf)
+		setCase()
+		gf.P("\t\t\tvar e ", g.qualifyType(gf, field.GoType, f))
+		g.wireDecodeLoop(gf, "v", func() {
+			g.generateUnmarshalWireScalar(gf, inner, elem, "e", f)
+		})
+		g.assignWireValue(gf, field, dst, "e", plainIsPointer)
+
+	case field.Origin == OriginSerialized:
+		protojsonPkg := protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
+		g.wireCase(gf, e.pb, "BytesType")
+		g.consumeBytes(gf)
+		setCase()
+		gf.P("\t\t\tm := &", gf.QualifiedGoIdent(e.pb.Message.GoIdent), "{}")
+		gf.P("\t\t\tif err := ", gf.QualifiedGoIdent(protoPkg.Ident("Unmarshal")), "(v, m); err != nil {")
+		gf.P("\t\t\t\treturn err")
+		gf.P("\t\t\t}")
+		gf.P("\t\t\tdata, err := ", gf.QualifiedGoIdent(protojsonPkg.Ident("Marshal")), "(m)")
+		gf.P("\t\t\tif err != nil {")
+		gf.P("\t\t\t\treturn err")
+		gf.P("\t\t\t}")
+		gf.P("\t\t\t", dst, " = data")
+
+	case field.IsMap:
+		g.generateUnmarshalWireMap(gf, e, dst, f)
+
+	case field.Kind == KindMessage:
+		g.wireCase(gf, e.pb, "BytesType")
+		g.consumeBytes(gf)
+		setCase()
+		g.generateUnmarshalWireMessage(gf, e, dst, plainIsPointer, f)
+
+	case field.IsRepeated:
+		elem := g.wireElemField(field)
+		// Packed and unpacked encodings are both accepted
+		if wireTypeName(e.pb.Desc.Kind()) != "BytesType" {
+			g.wireCase(gf, e.pb, "BytesType")
+			g.consumeBytes(gf)
+			gf.P("\t\t\tfor len(v) > 0 {")
+			consume, _ := wireConsumeFunc(e.pb.Desc.Kind())
+			gf.P("\t\t\t\tx, m := ", g.protowire(gf, consume), "(v)")
+			gf.P("\t\t\t\tif m < 0 {")
+			gf.P("\t\t\t\t\treturn ", g.protowire(gf, "ParseError"), "(m)")
+			gf.P("\t\t\t\t}")
+			gf.P("\t\t\t\tv = v[m:]")
+			gf.P("\t\t\t\t", dst, " = append(", dst, ", ", g.wirePlainValue(gf, elem, g.wireDecodedValue(gf, e.pb, "x"), f), ")")
+			gf.P("\t\t\t}")
+		}
+		g.generateUnmarshalWireScalar(gf, e.pb, field, dst, f)
+
+	default:
+		g.generateUnmarshalWireScalarCase(gf, e, dst, plainIsPointer, f)
+	}
+}
+
+// generateUnmarshalWireScalarCase decodes a singular scalar, setting the oneof case if needed
+func (g *Generator) generateUnmarshalWireScalarCase(gf *protogen.GeneratedFile, e *wireEntry, dst string, plainIsPointer bool, f *protogen.File) {
+	field := e.plain
+	consume, varType := wireConsumeFunc(e.pb.Desc.Kind())
+	g.wireCase(gf, e.pb, wireTypeName(e.pb.Desc.Kind()))
+	gf.P("\t\t\tvar v ", varType)
+	gf.P("\t\t\tv, n = ", g.protowire(gf, consume), "(b)")
+	if e.caseField != "" {
+		gf.P("\t\t\tp.", e.caseField, " = ", e.caseConst)
+	}
+	g.assignWireValue(gf, field, dst, g.wirePlainValue(gf, field, g.wireDecodedValue(gf, e.pb, "v"), f), plainIsPointer)
+}
+
+// generateUnmarshalWireScalar generates a case decoding one scalar value into dst
+// (appending if the field is repeated)
+func (g *Generator) generateUnmarshalWireScalar(gf *protogen.GeneratedFile, pb *protogen.Field, field *IRField, dst string, f *protogen.File) {
+	consume, varType := wireConsumeFunc(pb.Desc.Kind())
+	g.wireCase(gf, pb, wireTypeName(pb.Desc.Kind()))
+	gf.P("\t\t\tvar v ", varType)
+	gf.P("\t\t\tv, n = ", g.protowire(gf, consume), "(b)")
+	elem := field
+	if field.IsRepeated {
+		elem = g.wireElemField(field)
+	}
+	value := g.wirePlainValue(gf, elem, g.wireDecodedValue(gf, pb, "v"), f)
+	if field.IsRepeated {
+		gf.P("\t\t\t", dst, " = append(", dst, ", ", value, ")")
+		return
+	}
+	gf.P("\t\t\t", dst, " = ", value)
+}
+
+// generateUnmarshalWireMessage decodes v into a message field
+func (g *Generator) generateUnmarshalWireMessage(gf *protogen.GeneratedFile, e *wireEntry, dst string, plainIsPointer bool, f *protogen.File) {
+	field := e.plain
+	switch {
+	case g.isPlainMessage(field) && field.IsRepeated:
+		gf.P("\t\t\tvar e ", g.qualifyType(gf, field.GoType, f))
+		g.unmarshalInto(gf, "e", "v")
+		gf.P("\t\t\t", dst, " = append(", dst, ", e)")
+	case g.isPlainMessage(field):
+		gf.P("\t\t\t", dst, " = &", g.qualifyType(gf, field.GoType, f), "{}")
+		g.unmarshalInto(gf, dst, "v")
+	default:
+		gf.P("\t\t\tm := &", gf.QualifiedGoIdent(e.pb.Message.GoIdent), "{}")
+		gf.P("\t\t\tif err := ", gf.QualifiedGoIdent(protoPkg.Ident("Unmarshal")), "(v, m); err != nil {")
+		gf.P("\t\t\t\treturn err")
+		gf.P("\t\t\t}")
+		switch {
+		case field.NeedsCaster:
+			g.assignWireValue(gf, field, dst, g.casterCallWithImport(gf, field, "m", true), plainIsPointer)
+		case field.IsRepeated:
+			gf.P("\t\t\t", dst, " = append(", dst, ", m)")
+		default:
+			gf.P("\t\t\t", dst, " = m")
+		}
+	}
+}
+
+// generateUnmarshalWireMap decodes a map entry and stores it in dst
+func (g *Generator) generateUnmarshalWireMap(gf *protogen.GeneratedFile, e *wireEntry, dst string, f *protogen.File) {
+	field := e.plain
+	keyField, valueField := e.pb.Message.Fields[0], e.pb.Message.Fields[1]
+
+	keyType := g.qualifyType(gf, field.MapKey.GoType, f)
+	valueType := g.qualifyType(gf, field.MapValue.GoType, f)
+
+	g.wireCase(gf, e.pb, "BytesType")
+	g.consumeBytes(gf)
+	gf.P("\t\t\tvar mk ", keyType)
+	if valueField.Message != nil {
+		gf.P("\t\t\tmv := &", valueType, "{}")
+	} else {
+		gf.P("\t\t\tvar mv ", valueType)
+	}
+	g.wireDecodeLoop(gf, "v", func() {
+		g.generateUnmarshalWireScalar(gf, keyField, wireMapField(field.MapKey), "mk", f)
+		if valueField.Message == nil {
+			g.generateUnmarshalWireScalar(gf, valueField, wireMapField(field.MapValue), "mv", f)
+			return
+		}
+		g.wireCase(gf, valueField, "BytesType")
+		g.consumeBytes(gf)
+		if g.isPlainMessageType(valueField.Message) {
+			g.unmarshalInto(gf, "mv", "v")
+		} else {
+			gf.P("\t\t\tif err := ", gf.QualifiedGoIdent(protoPkg.Ident("Unmarshal")), "(v, mv); err != nil {")
+			gf.P("\t\t\t\treturn err")
+			gf.P("\t\t\t}")
+		}
+	})
+	gf.P("\t\t\tif ", dst, " == nil {")
+	pointer := ""
+	if valueField.Message != nil {
+		pointer = "*"
+	}
+	gf.P("\t\t\t\t", dst, " = make(map[", keyType, "]", pointer, valueType, ")")
+	gf.P("\t\t\t}")
+	gf.P("\t\t\t", dst, "[mk] = mv")
+}
+
+// unmarshalInto calls UnmarshalProto of a Plain struct
+func (g *Generator) unmarshalInto(gf *protogen.GeneratedFile, dst, buf string) {
+	gf.P("\t\t\tif err := ", dst, ".UnmarshalProto(", buf, "); err != nil {")
+	gf.P("\t\t\t\treturn err")
+	gf.P("\t\t\t}")
+}
+
+// assignWireValue stores a decoded value into a Plain field
+func (g *Generator) assignWireValue(gf *protogen.GeneratedFile, field *IRField, dst, value string, plainIsPointer bool) {
+	switch {
+	case field.IsRepeated:
+		gf.P("\t\t\t", dst, " = append(", dst, ", ", value, ")")
+	case plainIsPointer:
+		gf.P("\t\t\t_tmp := ", value)
+		gf.P("\t\t\t", dst, " = &_tmp")
+	default:
+		gf.P("\t\t\t", dst, " = ", value)
+	}
+}
+
+// wirePlainValue converts a decoded protobuf value to the Plain field type
+func (g *Generator) wirePlainValue(gf *protogen.GeneratedFile, field *IRField, value string, f *protogen.File) string {
+	switch {
+	case field.NeedsCaster:
+		return g.casterCallWithImport(gf, field, value, true)
+	case field.EnumAsString:
+		return value + ".String()"
+	case field.EnumAsInt:
+		return "int32(" + value + ")"
+	case g.needsTypeCast(field) && field.Kind != KindBytes && field.Origin != OriginTypeAlias:
+		return g.qualifyType(gf, field.GoType, f) + "(" + value + ")"
+	}
+	return value
+}
+
+// wireDecodedValue converts a consumed wire value v to the Go type of the protobuf field
+func (g *Generator) wireDecodedValue(gf *protogen.GeneratedFile, pb *protogen.Field, v string) string {
+	pw := func(name string) string { return g.protowire(gf, name) }
+	switch pb.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return pw("DecodeBool") + "(" + v + ")"
+	case protoreflect.EnumKind:
+		return gf.QualifiedGoIdent(pb.Enum.GoIdent) + "(" + v + ")"
+	case protoreflect.Int32Kind, protoreflect.Sfixed32Kind:
+		return "int32(" + v + ")"
+	case protoreflect.Int64Kind, protoreflect.Sfixed64Kind:
+		return "int64(" + v + ")"
+	case protoreflect.Uint32Kind:
+		return "uint32(" + v + ")"
+	case protoreflect.Sint32Kind:
+		return "int32(" + pw("DecodeZigZag") + "(" + v + " & " + gf.QualifiedGoIdent(mathPkg.Ident("MaxUint32")) + "))"
+	case protoreflect.Sint64Kind:
+		return pw("DecodeZigZag") + "(" + v + ")"
+	case protoreflect.FloatKind:
+		return gf.QualifiedGoIdent(mathPkg.Ident("Float32frombits")) + "(" + v + ")"
+	case protoreflect.DoubleKind:
+		return gf.QualifiedGoIdent(mathPkg.Ident("Float64frombits")) + "(" + v + ")"
+	case protoreflect.BytesKind:
+		return "append([]byte{}, " + v + "...)"
+	default:
+		// uint64, fixed32, fixed64, string
+		return v
+	}
+}
+
+// wireConsumeFunc returns the protowire function reading a value of the kind and its result type
+func wireConsumeFunc(kind protoreflect.Kind) (string, string) {
+	switch wireTypeName(kind) {
+	case "VarintType":
+		return "ConsumeVarint", "uint64"
+	case "Fixed32Type":
+		return "ConsumeFixed32", "uint32"
+	case "Fixed64Type":
+		return "ConsumeFixed64", "uint64"
+	}
+	if kind == protoreflect.StringKind {
+		return "ConsumeString", "string"
+	}
+	return "ConsumeBytes", "[]byte"
+}
@@ -25,6 +25,42 @@ type PluginSettings struct {
 	//         Unmarshal uses oneof case field to dispatch to correct Go field
 	// - false (default): Go field name is used in JSON (with variant prefix)
 	UnifiedOneofJSON bool
+	// CastersFile is a path to goplain.CasterRegistry (protojson) with existing casters,
+	// which are imported and called directly instead of being passed to IntoPlain/IntoPb.
+	CastersFile string
+	// NativeWKT (wkt=native) maps well-known types to native Go types in Plain structs:
+	// Timestamp -> time.Time, Duration -> time.Duration, wrappers -> pointers,
+	// Struct/Value/ListValue -> map[string]any/any/[]any, Empty -> *struct{}.
+	NativeWKT bool
+	// Wire (wire=true) generates MarshalProto/AppendProto/UnmarshalProto for Plain structs,
+	// encoding them with protowire in the wire format of the original message
+	// without building the protobuf message.
+	Wire bool
+	// Reflect (reflect=true) generates ProtoReflect for Plain structs, backed by a synthetic
+	// descriptor of the Plain messages registered in the "<package>.plain" package,
+	// so Plain structs are proto.Message values usable by reflection based tooling.
+	Reflect bool
+	// Clone (clone=true) generates Clone and CopyTo deep copy methods for Plain structs.
+	// CopyTo reuses the slices and maps of the target, so it pairs with pool=true.
+	Clone bool
+	// Equal (equal=true) generates Equal methods comparing Plain structs field by field.
+	Equal bool
+	// FieldMask (field_mask=true) generates ApplyToPb and IntoPlainMasked taking
+	// a google.protobuf.FieldMask of the original message, and helpers translating
+	// JSON names of Plain structs into field-mask paths and back.
+	FieldMask bool
+	// SQL (sql=true) generates Columns, ScanRow and Values mapping Plain structs
+	// to database/sql rows, with messages, maps and repeated fields in JSON columns.
+	SQL bool
+	// JSONSchema (json_schema=true) writes <Plain>.schema.json per Plain struct,
+	// describing the JSON of MarshalJX: flattened fields, oneof case fields and enum values.
+	JSONSchema bool
+	// OpenAPI (openapi=<file>) writes an OpenAPI 3.1 document with the schemas of all
+	// Plain structs of the run as components to <file>, relative to the output directory.
+	OpenAPI string
+	// TypeScript (ts=true) writes <file>_plain.ts with TypeScript types of the JSON
+	// of MarshalJX: an interface per Plain struct, discriminated unions for oneofs.
+	TypeScript bool
 }
 
 func mapGetOrDefault(paramsMap map[string]string, key string, defaultValue string) string {
@@ -53,6 +89,17 @@ func NewPluginSettingsFromPlugin(p *protogen.Plugin) (*PluginSettings, error) {
 		GeneratePool:     mapGetOrDefault(paramsMap, "pool", "false") == "true",
 		CastersAsStruct:  mapGetOrDefault(paramsMap, "casters_as_struct", "true") == "true", // default true
 		UnifiedOneofJSON: mapGetOrDefault(paramsMap, "unified_oneof_json", "false") == "true",
+		CastersFile:      mapGetOrDefault(paramsMap, "casters_file", ""),
+		NativeWKT:        mapGetOrDefault(paramsMap, "wkt", "") == "native",
+		Wire:             mapGetOrDefault(paramsMap, "wire", "false") == "true",
+		Reflect:          mapGetOrDefault(paramsMap, "reflect", "false") == "true",
+		Clone:            mapGetOrDefault(paramsMap, "clone", "false") == "true",
+		Equal:            mapGetOrDefault(paramsMap, "equal", "false") == "true",
+		FieldMask:        mapGetOrDefault(paramsMap, "field_mask", "false") == "true",
+		SQL:              mapGetOrDefault(paramsMap, "sql", "false") == "true",
+		JSONSchema:       mapGetOrDefault(paramsMap, "json_schema", "false") == "true",
+		OpenAPI:          mapGetOrDefault(paramsMap, "openapi", ""),
+		TypeScript:       mapGetOrDefault(paramsMap, "ts", "false") == "true",
 	}
 	return settings, nil
 }
@@ -0,0 +1,56 @@
+package generatortest
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// repoRoot is both the proto import path and the golden files root:
+// fixtures are generated with paths=source_relative next to their protos.
+const repoRoot = "../.."
+
+func TestGolden(t *testing.T) {
+	cases := []struct {
+		name      string
+		parameter string
+		patterns  []string
+	}{
+		{
+			name:      "full",
+			parameter: "paths=source_relative,json_jx=true,jx_pb=true,pool=true,wire=true,reflect=true,clone=true,equal=true,field_mask=true,sql=true,json_schema=true,ts=true,openapi=test/full/openapi.json",
+			patterns:  []string{"test/full/*.proto", "test/full/common/*.proto"},
+		},
+		{
+			name:      "wkt",
+			parameter: "paths=source_relative,json_jx=true,jx_pb=true,pool=true,wkt=native,wire=true,reflect=true,clone=true,equal=true,field_mask=true,sql=true,json_schema=true,ts=true,openapi=test/wkt/openapi.json",
+			patterns:  []string{"test/wkt/*.proto"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			files := protoFiles(t, tc.patterns)
+			out, err := Generate([]string{repoRoot}, tc.parameter, files...)
+			require.NoError(t, err)
+			require.NotEmpty(t, out)
+			AssertGolden(t, repoRoot, out)
+		})
+	}
+}
+
+func protoFiles(t *testing.T, patterns []string) []string {
+	t.Helper()
+	var files []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(repoRoot, pattern))
+		require.NoError(t, err)
+		for _, m := range matches {
+			files = append(files, filepath.ToSlash(strings.TrimPrefix(m, repoRoot+string(filepath.Separator))))
+		}
+	}
+	require.NotEmpty(t, files)
+	return files
+}
@@ -0,0 +1,241 @@
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	wktPkg  = protogen.GoImportPath("github.com/yaroher/protoc-gen-go-plain/wkt")
+	timePkg = protogen.GoImportPath("time")
+)
+
+// nativeWKT describes how a well-known type is mapped with wkt=native
+type nativeWKT struct {
+	// PbType is the protobuf Go type (e.g. timestamppb.Timestamp)
+	PbType GoType
+	// GoType is the native Go type of the plain field
+	GoType GoType
+	// Optional makes the plain field a pointer, so unset messages stay nil (wrappers, Empty)
+	Optional bool
+	// FromPb / ToPb are conversion functions from the wkt package
+	FromPb string
+	ToPb   string
+	// ToPbErr is set when ToPb returns an error (Struct, Value, ListValue)
+	ToPbErr bool
+}
+
+const (
+	timestamppbPath = "google.golang.org/protobuf/types/known/timestamppb"
+	durationpbPath  = "google.golang.org/protobuf/types/known/durationpb"
+	wrapperspbPath  = "google.golang.org/protobuf/types/known/wrapperspb"
+	structpbPath    = "google.golang.org/protobuf/types/known/structpb"
+	emptypbPath     = "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// nativeWKTs maps well-known type full names to native Go types
+var nativeWKTs = map[protoreflect.FullName]nativeWKT{
+	"google.protobuf.Timestamp": {
+		PbType: GoType{Name: "Timestamp", ImportPath: timestamppbPath},
+		GoType: GoType{Name: "Time", ImportPath: "time"},
+		FromPb: "TimeFromPb", ToPb: "TimeToPb",
+	},
+	"google.protobuf.Duration": {
+		PbType: GoType{Name: "Duration", ImportPath: durationpbPath},
+		GoType: GoType{Name: "Duration", ImportPath: "time"},
+		FromPb: "DurationFromPb", ToPb: "DurationToPb",
+	},
+	"google.protobuf.Struct": {
+		PbType: GoType{Name: "Struct", ImportPath: structpbPath},
+		GoType: GoType{Name: "map[string]any"},
+		FromPb: "StructFromPb", ToPb: "StructToPb", ToPbErr: true,
+	},
+	"google.protobuf.Value": {
+		PbType: GoType{Name: "Value", ImportPath: structpbPath},
+		GoType: GoType{Name: "any"},
+		FromPb: "ValueFromPb", ToPb: "ValueToPb", ToPbErr: true,
+	},
+	"google.protobuf.ListValue": {
+		PbType: GoType{Name: "ListValue", ImportPath: structpbPath},
+		GoType: GoType{Name: "[]any"},
+		FromPb: "ListFromPb", ToPb: "ListToPb", ToPbErr: true,
+	},
+	"google.protobuf.Empty": {
+		PbType:   GoType{Name: "Empty", ImportPath: emptypbPath},
+		GoType:   GoType{Name: "struct{}"},
+		Optional: true,
+		FromPb:   "EmptyFromPb", ToPb: "EmptyToPb",
+	},
+	"google.protobuf.DoubleValue": wrapperWKT("DoubleValue", "float64", "Double"),
+	"google.protobuf.FloatValue":  wrapperWKT("FloatValue", "float32", "Float"),
+	"google.protobuf.Int64Value":  wrapperWKT("Int64Value", "int64", "Int64"),
+	"google.protobuf.UInt64Value": wrapperWKT("UInt64Value", "uint64", "UInt64"),
+	"google.protobuf.Int32Value":  wrapperWKT("Int32Value", "int32", "Int32"),
+	"google.protobuf.UInt32Value": wrapperWKT("UInt32Value", "uint32", "UInt32"),
+	"google.protobuf.BoolValue":   wrapperWKT("BoolValue", "bool", "Bool"),
+	"google.protobuf.StringValue": wrapperWKT("StringValue", "string", "String"),
+	"google.protobuf.BytesValue":  wrapperWKT("BytesValue", "[]byte", "Bytes"),
+}
+
+func wrapperWKT(pbName, goName, funcPrefix string) nativeWKT {
+	return nativeWKT{
+		PbType:   GoType{Name: pbName, ImportPath: wrapperspbPath},
+		GoType:   GoType{Name: goName},
+		Optional: true,
+		FromPb:   funcPrefix + "FromPb",
+		ToPb:     funcPrefix + "ToPb",
+	}
+}
+
+// wktCasters returns existing casters converting well-known types with the wkt package
+func wktCasters() []*ExistingCaster {
+	casters := make([]*ExistingCaster, 0, len(nativeWKTs)*2)
+	for _, w := range nativeWKTs {
+		casters = append(casters,
+			&ExistingCaster{
+				SourceType:  w.PbType,
+				TargetType:  w.GoType,
+				CasterIdent: GoIdent{Name: w.FromPb, ImportPath: string(wktPkg)},
+				IsFunc:      true,
+			},
+			&ExistingCaster{
+				SourceType:  w.GoType,
+				TargetType:  w.PbType,
+				CasterIdent: GoIdent{Name: w.ToPb, ImportPath: string(wktPkg)},
+				IsFunc:      true,
+				IsErr:       w.ToPbErr,
+			},
+		)
+	}
+	return casters
+}
+
+// wktWrappedKind returns the value kind of a wrapper type (google.protobuf.*Value)
+func wktWrappedKind(field *IRField) (protoreflect.Kind, bool) {
+	if field.Source == nil || field.Source.Message == nil {
+		return 0, false
+	}
+	if nativeWKTs[protoreflect.FullName(field.NativeWKT)].PbType.ImportPath != wrapperspbPath {
+		return 0, false
+	}
+	return field.Source.Message.Fields[0].Desc.Kind(), true
+}
+
+// wktIsPointer reports whether the native plain field is a pointer
+func wktIsPointer(field *IRField) bool {
+	return field.IsOptional && !field.IsRepeated
+}
+
+// generateMarshalJXWKTField generates encoding of a field mapped to a native Go type (wkt=native)
+func (g *Generator) generateMarshalJXWKTField(gf *protogen.GeneratedFile, field *IRField, jsonName, access string) {
+	valueAccess := access
+	var check string
+	switch {
+	case wktIsPointer(field):
+		check = access + " != nil"
+		valueAccess = "*" + access
+	case field.NativeWKT == "google.protobuf.Timestamp":
+		check = "!" + access + ".IsZero()"
+	case field.NativeWKT == "google.protobuf.Duration":
+		check = access + " != 0"
+	default:
+		check = access + " != nil"
+	}
+
+	indent := "\t\t"
+	if field.WriteDefault {
+		indent = "\t"
+	} else {
+		gf.P("\tif ", check, " {")
+	}
+	gf.P(indent, "e.FieldStart(\"", jsonName, "\")")
+	if kind, ok := wktWrappedKind(field); ok {
+		g.generateMarshalJXScalarByKind(gf, kind, valueAccess, indent)
+	} else {
+		switch field.NativeWKT {
+		case "google.protobuf.Timestamp":
+			gf.P(indent, gf.QualifiedGoIdent(wktPkg.Ident("EncodeTime")), "(e, ", valueAccess, ")")
+		case "google.protobuf.Duration":
+			gf.P(indent, gf.QualifiedGoIdent(wktPkg.Ident("EncodeDuration")), "(e, ", valueAccess, ")")
+		case "google.protobuf.Struct":
+			gf.P(indent, gf.QualifiedGoIdent(wktPkg.Ident("EncodeStruct")), "(e, ", valueAccess, ")")
+		case "google.protobuf.ListValue":
+			gf.P(indent, gf.QualifiedGoIdent(wktPkg.Ident("EncodeList")), "(e, ", valueAccess, ")")
+		case "google.protobuf.Value":
+			gf.P(indent, gf.QualifiedGoIdent(wktPkg.Ident("EncodeValue")), "(e, ", valueAccess, ")")
+		case "google.protobuf.Empty":
+			gf.P(indent, "e.ObjStart()")
+			gf.P(indent, "e.ObjEnd()")
+		}
+	}
+	if !field.WriteDefault {
+		gf.P("\t}")
+	}
+}
+
+// generateUnmarshalJXWKT generates decoding of a field mapped to a native Go type (wkt=native)
+func (g *Generator) generateUnmarshalJXWKT(gf *protogen.GeneratedFile, field *IRField, access string, indent string) {
+	// null resets the field
+	zero := "nil"
+	if !wktIsPointer(field) {
+		switch field.NativeWKT {
+		case "google.protobuf.Timestamp":
+			zero = gf.QualifiedGoIdent(timePkg.Ident("Time")) + "{}"
+		case "google.protobuf.Duration":
+			zero = "0"
+		}
+	}
+	gf.P(indent, "if d.Next() == ", gf.QualifiedGoIdent(jxPkg.Ident("Null")), " {")
+	gf.P(indent, "\t", access, " = ", zero)
+	gf.P(indent, "\treturn d.Null()")
+	gf.P(indent, "}")
+
+	var decodeCall string
+	if kind, ok := wktWrappedKind(field); ok {
+		if kind == protoreflect.BytesKind {
+			decodeCall = "d.Base64()"
+		} else {
+			decodeCall, _ = g.getDecodeCallByKind(kind)
+		}
+	} else {
+		switch field.NativeWKT {
+		case "google.protobuf.Timestamp":
+			decodeCall = gf.QualifiedGoIdent(wktPkg.Ident("DecodeTime")) + "(d)"
+		case "google.protobuf.Duration":
+			decodeCall = gf.QualifiedGoIdent(wktPkg.Ident("DecodeDuration")) + "(d)"
+		case "google.protobuf.Struct":
+			decodeCall = gf.QualifiedGoIdent(wktPkg.Ident("DecodeStruct")) + "(d)"
+		case "google.protobuf.ListValue":
+			decodeCall = gf.QualifiedGoIdent(wktPkg.Ident("DecodeList")) + "(d)"
+		case "google.protobuf.Value":
+			decodeCall = gf.QualifiedGoIdent(wktPkg.Ident("DecodeValue")) + "(d)"
+		case "google.protobuf.Empty":
+			gf.P(indent, "if err := d.Skip(); err != nil { return err }")
+			gf.P(indent, access, " = &struct{}{}")
+			return
+		}
+	}
+
+	gf.P(indent, "v, err := ", decodeCall)
+	gf.P(indent, "if err != nil { return err }")
+	if wktIsPointer(field) {
+		gf.P(indent, access, " = &v")
+	} else {
+		gf.P(indent, access, " = v")
+	}
+}
+
+// generateWKTReset generates reset of a field mapped to a native Go type (wkt=native)
+func (g *Generator) generateWKTReset(gf *protogen.GeneratedFile, field *IRField) {
+	fieldAccess := "p." + field.GoName
+	switch {
+	case wktIsPointer(field):
+		gf.P("\t", fieldAccess, " = nil")
+	case field.NativeWKT == "google.protobuf.Timestamp":
+		gf.P("\t", fieldAccess, " = ", gf.QualifiedGoIdent(timePkg.Ident("Time")), "{}")
+	case field.NativeWKT == "google.protobuf.Duration":
+		gf.P("\t", fieldAccess, " = 0")
+	default:
+		gf.P("\t", fieldAccess, " = nil")
+	}
+}
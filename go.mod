@@ -3,6 +3,7 @@ module github.com/yaroher/protoc-gen-go-plain
 go 1.24
 
 require (
+	github.com/bufbuild/protocompile v0.14.1
 	github.com/go-faster/jx v1.2.0
 	github.com/iancoleman/strcase v0.3.0
 	github.com/stretchr/testify v1.11.1
@@ -16,6 +17,7 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/segmentio/asm v1.2.1 // indirect
 	go.uber.org/multierr v1.10.0 // indirect
+	golang.org/x/sync v0.8.0 // indirect
 	golang.org/x/sys v0.1.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
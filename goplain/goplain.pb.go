@@ -23,10 +23,80 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How field name collisions caused by embedding are resolved.
+// The embed path of a field is the chain of proto field names it was
+// embedded through, joined with "_" (home_address for home.address.city).
+type CollisionStrategy int32
+
+const (
+	// message: use the file option, file: ERROR
+	CollisionStrategy_COLLISION_STRATEGY_UNSPECIFIED CollisionStrategy = 0
+	// fail generation
+	CollisionStrategy_COLLISION_STRATEGY_ERROR CollisionStrategy = 1
+	// prefix the later field with its embed path,
+	// or the earlier one if the later field is not embedded
+	CollisionStrategy_COLLISION_STRATEGY_PREFIX_LATER CollisionStrategy = 2
+	// prefix both fields with their embed paths
+	CollisionStrategy_COLLISION_STRATEGY_PREFIX_BOTH CollisionStrategy = 3
+	// keep the earlier field and leave the later one out of the plain
+	// message with a warning, IntoPb leaves it unset.
+	// Not applied to oneof variants
+	CollisionStrategy_COLLISION_STRATEGY_FIRST_WINS CollisionStrategy = 4
+)
+
+// Enum value maps for CollisionStrategy.
+var (
+	CollisionStrategy_name = map[int32]string{
+		0: "COLLISION_STRATEGY_UNSPECIFIED",
+		1: "COLLISION_STRATEGY_ERROR",
+		2: "COLLISION_STRATEGY_PREFIX_LATER",
+		3: "COLLISION_STRATEGY_PREFIX_BOTH",
+		4: "COLLISION_STRATEGY_FIRST_WINS",
+	}
+	CollisionStrategy_value = map[string]int32{
+		"COLLISION_STRATEGY_UNSPECIFIED":  0,
+		"COLLISION_STRATEGY_ERROR":        1,
+		"COLLISION_STRATEGY_PREFIX_LATER": 2,
+		"COLLISION_STRATEGY_PREFIX_BOTH":  3,
+		"COLLISION_STRATEGY_FIRST_WINS":   4,
+	}
+)
+
+func (x CollisionStrategy) Enum() *CollisionStrategy {
+	p := new(CollisionStrategy)
+	*p = x
+	return p
+}
+
+func (x CollisionStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollisionStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_goplain_proto_enumTypes[0].Descriptor()
+}
+
+func (CollisionStrategy) Type() protoreflect.EnumType {
+	return &file_goplain_proto_enumTypes[0]
+}
+
+func (x CollisionStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollisionStrategy.Descriptor instead.
+func (CollisionStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_goplain_proto_rawDescGZIP(), []int{0}
+}
+
 type GoIdent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ImportPath    string                 `protobuf:"bytes,2,opt,name=import_path,json=importPath,proto3" json:"import_path,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ImportPath string                 `protobuf:"bytes,2,opt,name=import_path,json=importPath,proto3" json:"import_path,omitempty"`
+	// Casters for this type may fail (cast.CasterErr[A,B] instead of cast.Caster[A,B]).
+	// Messages with such fields get IntoPlainE/IntoPbE methods returning
+	// the caster error wrapped with the failing field path.
+	CasterErr     bool `protobuf:"varint,3,opt,name=caster_err,json=casterErr,proto3" json:"caster_err,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,6 +145,13 @@ func (x *GoIdent) GetImportPath() string {
 	return ""
 }
 
+func (x *GoIdent) GetCasterErr() bool {
+	if x != nil {
+		return x.CasterErr
+	}
+	return false
+}
+
 type OverrideSelector struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	TargetFullPath *string                `protobuf:"bytes,1,opt,name=target_full_path,json=targetFullPath,proto3,oneof" json:"target_full_path,omitempty"`
@@ -233,6 +310,34 @@ type MessageOptions struct {
 	// string password_hash = 2;
 	// }
 	VirtualFields []*typepb.Field `protobuf:"bytes,4,rep,name=virtual_fields,json=virtualFields,proto3" json:"virtual_fields,omitempty"`
+	// Fields to leave out of the plain message, as dot-separated paths of proto
+	// field names from this message. Paths go through embedded messages.
+	// IntoPb leaves excluded fields unset, IntoPbMerge keeps them on the target.
+	// Example:
+	// message User {
+	// option (goplain.message).exclude_paths = "address.geo.internal_id";
+	// string name = 1;
+	// Address address = 2 [(goplain.field).embed = true];
+	// }
+	ExcludePaths []string `protobuf:"bytes,5,rep,name=exclude_paths,json=excludePaths,proto3" json:"exclude_paths,omitempty"`
+	// Overrides FileOptions.collision_strategy for this message
+	CollisionStrategy CollisionStrategy `protobuf:"varint,6,opt,name=collision_strategy,json=collisionStrategy,proto3,enum=goplain.CollisionStrategy" json:"collision_strategy,omitempty"`
+	// SQL table of the plain message. When set, CREATE TABLE with a column
+	// per plain field is written to <file>_plain.sql.
+	// Columns are named after the flattened fields, as Columns() with sql=true.
+	// Example:
+	// message User {
+	// option (goplain.message).table = "users";
+	// option (goplain.message).primary_key = "id";
+	// option (goplain.message).indexes = {columns: ["email"], unique: true};
+	// string id = 1;
+	// string email = 2;
+	// Address address = 3 [(goplain.field).embed = true];
+	// }
+	Table string `protobuf:"bytes,7,opt,name=table,proto3" json:"table,omitempty"`
+	// Primary key columns of the table
+	PrimaryKey    []string      `protobuf:"bytes,8,rep,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	Indexes       []*TableIndex `protobuf:"bytes,9,rep,name=indexes,proto3" json:"indexes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -295,17 +400,251 @@ func (x *MessageOptions) GetVirtualFields() []*typepb.Field {
 	return nil
 }
 
+func (x *MessageOptions) GetExcludePaths() []string {
+	if x != nil {
+		return x.ExcludePaths
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetCollisionStrategy() CollisionStrategy {
+	if x != nil {
+		return x.CollisionStrategy
+	}
+	return CollisionStrategy_COLLISION_STRATEGY_UNSPECIFIED
+}
+
+func (x *MessageOptions) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *MessageOptions) GetPrimaryKey() []string {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetIndexes() []*TableIndex {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+// Index of the table of a plain message
+type TableIndex struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Index name, <table>_<columns>_idx if empty
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Indexed columns: flattened field names of the plain message
+	Columns       []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Unique        bool     `protobuf:"varint,3,opt,name=unique,proto3" json:"unique,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableIndex) Reset() {
+	*x = TableIndex{}
+	mi := &file_goplain_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableIndex) ProtoMessage() {}
+
+func (x *TableIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_goplain_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableIndex.ProtoReflect.Descriptor instead.
+func (*TableIndex) Descriptor() ([]byte, []int) {
+	return file_goplain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TableIndex) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableIndex) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TableIndex) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+// Pre-defined caster that is imported and called directly,
+// so IntoPlain/IntoPb don't request it as a parameter.
+// Example (int64 -> time.Duration):
+// option (goplain.file).existing_casters = {
+// source: { name: "int64" }
+// target: { name: "Duration", import_path: "time" }
+// caster: { name: "Int64ToDuration", import_path: "example.com/casters" }
+// is_func: true
+// };
+// Casters for both directions are needed to drop the parameter entirely.
+type ExistingCaster struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Source *GoIdent               `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target *GoIdent               `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// variable implementing cast.Caster/cast.CasterErr, or a function if is_func
+	Caster *GoIdent `protobuf:"bytes,3,opt,name=caster,proto3" json:"caster,omitempty"`
+	IsFunc bool     `protobuf:"varint,4,opt,name=is_func,json=isFunc,proto3" json:"is_func,omitempty"`
+	// the caster returns an error (cast.CasterErr or func(A) (B, error))
+	CasterErr     bool `protobuf:"varint,5,opt,name=caster_err,json=casterErr,proto3" json:"caster_err,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExistingCaster) Reset() {
+	*x = ExistingCaster{}
+	mi := &file_goplain_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExistingCaster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistingCaster) ProtoMessage() {}
+
+func (x *ExistingCaster) ProtoReflect() protoreflect.Message {
+	mi := &file_goplain_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistingCaster.ProtoReflect.Descriptor instead.
+func (*ExistingCaster) Descriptor() ([]byte, []int) {
+	return file_goplain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExistingCaster) GetSource() *GoIdent {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ExistingCaster) GetTarget() *GoIdent {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ExistingCaster) GetCaster() *GoIdent {
+	if x != nil {
+		return x.Caster
+	}
+	return nil
+}
+
+func (x *ExistingCaster) GetIsFunc() bool {
+	if x != nil {
+		return x.IsFunc
+	}
+	return false
+}
+
+func (x *ExistingCaster) GetCasterErr() bool {
+	if x != nil {
+		return x.CasterErr
+	}
+	return false
+}
+
+// Registry file format for the casters_file plugin parameter (protojson)
+type CasterRegistry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Casters       []*ExistingCaster      `protobuf:"bytes,1,rep,name=casters,proto3" json:"casters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CasterRegistry) Reset() {
+	*x = CasterRegistry{}
+	mi := &file_goplain_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CasterRegistry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CasterRegistry) ProtoMessage() {}
+
+func (x *CasterRegistry) ProtoReflect() protoreflect.Message {
+	mi := &file_goplain_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CasterRegistry.ProtoReflect.Descriptor instead.
+func (*CasterRegistry) Descriptor() ([]byte, []int) {
+	return file_goplain_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CasterRegistry) GetCasters() []*ExistingCaster {
+	if x != nil {
+		return x.Casters
+	}
+	return nil
+}
+
 type FileOptions struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	GoTypesOverrides []*TypeOverride        `protobuf:"bytes,1,rep,name=go_types_overrides,json=goTypesOverrides,proto3" json:"go_types_overrides,omitempty"`
 	VirtualTypes     []*typepb.Type         `protobuf:"bytes,2,rep,name=virtual_types,json=virtualTypes,proto3" json:"virtual_types,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	ExistingCasters  []*ExistingCaster      `protobuf:"bytes,3,rep,name=existing_casters,json=existingCasters,proto3" json:"existing_casters,omitempty"`
+	// Collision strategy for all messages of the file
+	CollisionStrategy CollisionStrategy `protobuf:"varint,4,opt,name=collision_strategy,json=collisionStrategy,proto3,enum=goplain.CollisionStrategy" json:"collision_strategy,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *FileOptions) Reset() {
 	*x = FileOptions{}
-	mi := &file_goplain_proto_msgTypes[4]
+	mi := &file_goplain_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +656,7 @@ func (x *FileOptions) String() string {
 func (*FileOptions) ProtoMessage() {}
 
 func (x *FileOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_goplain_proto_msgTypes[4]
+	mi := &file_goplain_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +669,7 @@ func (x *FileOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileOptions.ProtoReflect.Descriptor instead.
 func (*FileOptions) Descriptor() ([]byte, []int) {
-	return file_goplain_proto_rawDescGZIP(), []int{4}
+	return file_goplain_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FileOptions) GetGoTypesOverrides() []*TypeOverride {
@@ -347,6 +686,20 @@ func (x *FileOptions) GetVirtualTypes() []*typepb.Type {
 	return nil
 }
 
+func (x *FileOptions) GetExistingCasters() []*ExistingCaster {
+	if x != nil {
+		return x.ExistingCasters
+	}
+	return nil
+}
+
+func (x *FileOptions) GetCollisionStrategy() CollisionStrategy {
+	if x != nil {
+		return x.CollisionStrategy
+	}
+	return CollisionStrategy_COLLISION_STRATEGY_UNSPECIFIED
+}
+
 type FieldOptions struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	OverrideType *GoIdent               `protobuf:"bytes,1,opt,name=override_type,json=overrideType,proto3" json:"override_type,omitempty"`
@@ -396,14 +749,44 @@ type FieldOptions struct {
 	EnumAsInt       bool `protobuf:"varint,8,opt,name=enum_as_int,json=enumAsInt,proto3" json:"enum_as_int,omitempty"`
 	// If true, write field to JSON even if it has default/zero value
 	// Default behavior (false): omit fields with default values from JSON
-	WriteDefault  bool `protobuf:"varint,9,opt,name=write_default,json=writeDefault,proto3" json:"write_default,omitempty"`
+	WriteDefault bool `protobuf:"varint,9,opt,name=write_default,json=writeDefault,proto3" json:"write_default,omitempty"`
+	// Override the JSON name of the field in the plain message.
+	// Replaces the whole name, including the embed_with_prefix and oneof prefixes,
+	// and applies to the field wherever it ends up after embedding.
+	//
+	// Example:
+	// message Address {
+	// string street = 1 [(goplain.field).json_name = "street_line"];
+	// }
+	// message User {
+	// Address address = 1 [(goplain.field).embed = true, (goplain.field).embed_with_prefix = true];
+	// }
+	//
+	// Becomes:
+	// type UserPlain struct {
+	// AddressStreet string `json:"street_line"`
+	// }
+	JsonName string `protobuf:"bytes,10,opt,name=json_name,json=jsonName,proto3" json:"json_name,omitempty"`
+	// Override the Go name of the field in the plain struct.
+	// Replaces the whole name, including the embed_with_prefix and oneof prefixes.
+	GoName string `protobuf:"bytes,11,opt,name=go_name,json=goName,proto3" json:"go_name,omitempty"`
+	// Leave the field out of the plain message.
+	// IntoPb leaves it unset, IntoPbMerge keeps its value on the target.
+	// Not supported on oneof variants.
+	Ignore bool `protobuf:"varint,12,opt,name=ignore,proto3" json:"ignore,omitempty"`
+	// SQL type of the column in the table of the plain message (see MessageOptions.table),
+	// replaces the type derived from the field kind. Applies to the field wherever
+	// it ends up after embedding.
+	// Example:
+	// string price = 1 [(goplain.field).sql_type = "NUMERIC(12, 2)"];
+	SqlType       string `protobuf:"bytes,13,opt,name=sql_type,json=sqlType,proto3" json:"sql_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FieldOptions) Reset() {
 	*x = FieldOptions{}
-	mi := &file_goplain_proto_msgTypes[5]
+	mi := &file_goplain_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -415,7 +798,7 @@ func (x *FieldOptions) String() string {
 func (*FieldOptions) ProtoMessage() {}
 
 func (x *FieldOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_goplain_proto_msgTypes[5]
+	mi := &file_goplain_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,7 +811,7 @@ func (x *FieldOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldOptions.ProtoReflect.Descriptor instead.
 func (*FieldOptions) Descriptor() ([]byte, []int) {
-	return file_goplain_proto_rawDescGZIP(), []int{5}
+	return file_goplain_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FieldOptions) GetOverrideType() *GoIdent {
@@ -480,6 +863,34 @@ func (x *FieldOptions) GetWriteDefault() bool {
 	return false
 }
 
+func (x *FieldOptions) GetJsonName() string {
+	if x != nil {
+		return x.JsonName
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetGoName() string {
+	if x != nil {
+		return x.GoName
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetIgnore() bool {
+	if x != nil {
+		return x.Ignore
+	}
+	return false
+}
+
+func (x *FieldOptions) GetSqlType() string {
+	if x != nil {
+		return x.SqlType
+	}
+	return ""
+}
+
 type OneofOptions struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Embed oneof into parent message
@@ -514,7 +925,7 @@ type OneofOptions struct {
 
 func (x *OneofOptions) Reset() {
 	*x = OneofOptions{}
-	mi := &file_goplain_proto_msgTypes[6]
+	mi := &file_goplain_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +937,7 @@ func (x *OneofOptions) String() string {
 func (*OneofOptions) ProtoMessage() {}
 
 func (x *OneofOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_goplain_proto_msgTypes[6]
+	mi := &file_goplain_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,7 +950,7 @@ func (x *OneofOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OneofOptions.ProtoReflect.Descriptor instead.
 func (*OneofOptions) Descriptor() ([]byte, []int) {
-	return file_goplain_proto_rawDescGZIP(), []int{6}
+	return file_goplain_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OneofOptions) GetEmbed() bool {
@@ -619,11 +1030,13 @@ var File_goplain_proto protoreflect.FileDescriptor
 
 const file_goplain_proto_rawDesc = "" +
 	"\n" +
-	"\rgoplain.proto\x12\agoplain\x1a google/protobuf/descriptor.proto\x1a\x1agoogle/protobuf/type.proto\">\n" +
+	"\rgoplain.proto\x12\agoplain\x1a google/protobuf/descriptor.proto\x1a\x1agoogle/protobuf/type.proto\"]\n" +
 	"\aGoIdent\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
 	"\vimport_path\x18\x02 \x01(\tR\n" +
-	"importPath\"\xd0\x02\n" +
+	"importPath\x12\x1d\n" +
+	"\n" +
+	"caster_err\x18\x03 \x01(\bR\tcasterErr\"\xd0\x02\n" +
 	"\x10OverrideSelector\x12-\n" +
 	"\x10target_full_path\x18\x01 \x01(\tH\x00R\x0etargetFullPath\x88\x01\x01\x12?\n" +
 	"\n" +
@@ -636,16 +1049,38 @@ const file_goplain_proto_rawDesc = "" +
 	"\x0f_field_type_url\"}\n" +
 	"\fTypeOverride\x125\n" +
 	"\bselector\x18\x01 \x01(\v2\x19.goplain.OverrideSelectorR\bselector\x126\n" +
-	"\x0etarget_go_type\x18\x02 \x01(\v2\x10.goplain.GoIdentR\ftargetGoType\"\xb4\x01\n" +
+	"\x0etarget_go_type\x18\x02 \x01(\v2\x10.goplain.GoIdentR\ftargetGoType\"\x8a\x03\n" +
 	"\x0eMessageOptions\x12\x1a\n" +
 	"\bgenerate\x18\x01 \x01(\bR\bgenerate\x12\x1d\n" +
 	"\n" +
 	"type_alias\x18\x02 \x01(\bR\ttypeAlias\x12(\n" +
 	"\x10type_alias_field\x18\x03 \x01(\tR\x0etypeAliasField\x12=\n" +
-	"\x0evirtual_fields\x18\x04 \x03(\v2\x16.google.protobuf.FieldR\rvirtualFields\"\x8e\x01\n" +
+	"\x0evirtual_fields\x18\x04 \x03(\v2\x16.google.protobuf.FieldR\rvirtualFields\x12#\n" +
+	"\rexclude_paths\x18\x05 \x03(\tR\fexcludePaths\x12I\n" +
+	"\x12collision_strategy\x18\x06 \x01(\x0e2\x1a.goplain.CollisionStrategyR\x11collisionStrategy\x12\x14\n" +
+	"\x05table\x18\a \x01(\tR\x05table\x12\x1f\n" +
+	"\vprimary_key\x18\b \x03(\tR\n" +
+	"primaryKey\x12-\n" +
+	"\aindexes\x18\t \x03(\v2\x13.goplain.TableIndexR\aindexes\"R\n" +
+	"\n" +
+	"TableIndex\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\x12\x16\n" +
+	"\x06unique\x18\x03 \x01(\bR\x06unique\"\xc6\x01\n" +
+	"\x0eExistingCaster\x12(\n" +
+	"\x06source\x18\x01 \x01(\v2\x10.goplain.GoIdentR\x06source\x12(\n" +
+	"\x06target\x18\x02 \x01(\v2\x10.goplain.GoIdentR\x06target\x12(\n" +
+	"\x06caster\x18\x03 \x01(\v2\x10.goplain.GoIdentR\x06caster\x12\x17\n" +
+	"\ais_func\x18\x04 \x01(\bR\x06isFunc\x12\x1d\n" +
+	"\n" +
+	"caster_err\x18\x05 \x01(\bR\tcasterErr\"C\n" +
+	"\x0eCasterRegistry\x121\n" +
+	"\acasters\x18\x01 \x03(\v2\x17.goplain.ExistingCasterR\acasters\"\x9d\x02\n" +
 	"\vFileOptions\x12C\n" +
 	"\x12go_types_overrides\x18\x01 \x03(\v2\x15.goplain.TypeOverrideR\x10goTypesOverrides\x12:\n" +
-	"\rvirtual_types\x18\x02 \x03(\v2\x15.google.protobuf.TypeR\fvirtualTypes\"\x90\x02\n" +
+	"\rvirtual_types\x18\x02 \x03(\v2\x15.google.protobuf.TypeR\fvirtualTypes\x12B\n" +
+	"\x10existing_casters\x18\x03 \x03(\v2\x17.goplain.ExistingCasterR\x0fexistingCasters\x12I\n" +
+	"\x12collision_strategy\x18\x04 \x01(\x0e2\x1a.goplain.CollisionStrategyR\x11collisionStrategy\"\xf9\x02\n" +
 	"\fFieldOptions\x125\n" +
 	"\roverride_type\x18\x01 \x01(\v2\x10.goplain.GoIdentR\foverrideType\x12\x1c\n" +
 	"\tserialize\x18\x02 \x01(\bR\tserialize\x12\x14\n" +
@@ -653,10 +1088,21 @@ const file_goplain_proto_rawDesc = "" +
 	"\x11embed_with_prefix\x18\x05 \x01(\bR\x0fembedWithPrefix\x12$\n" +
 	"\x0eenum_as_string\x18\a \x01(\bR\fenumAsString\x12\x1e\n" +
 	"\venum_as_int\x18\b \x01(\bR\tenumAsInt\x12#\n" +
-	"\rwrite_default\x18\t \x01(\bR\fwriteDefault\"P\n" +
+	"\rwrite_default\x18\t \x01(\bR\fwriteDefault\x12\x1b\n" +
+	"\tjson_name\x18\n" +
+	" \x01(\tR\bjsonName\x12\x17\n" +
+	"\ago_name\x18\v \x01(\tR\x06goName\x12\x16\n" +
+	"\x06ignore\x18\f \x01(\bR\x06ignore\x12\x19\n" +
+	"\bsql_type\x18\r \x01(\tR\asqlType\"P\n" +
 	"\fOneofOptions\x12\x14\n" +
 	"\x05embed\x18\x01 \x01(\bR\x05embed\x12*\n" +
-	"\x11embed_with_prefix\x18\x02 \x01(\bR\x0fembedWithPrefix:H\n" +
+	"\x11embed_with_prefix\x18\x02 \x01(\bR\x0fembedWithPrefix*\xc1\x01\n" +
+	"\x11CollisionStrategy\x12\"\n" +
+	"\x1eCOLLISION_STRATEGY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18COLLISION_STRATEGY_ERROR\x10\x01\x12#\n" +
+	"\x1fCOLLISION_STRATEGY_PREFIX_LATER\x10\x02\x12\"\n" +
+	"\x1eCOLLISION_STRATEGY_PREFIX_BOTH\x10\x03\x12!\n" +
+	"\x1dCOLLISION_STRATEGY_FIRST_WINS\x10\x04:H\n" +
 	"\x04file\x12\x1c.google.protobuf.FileOptions\x18\xe0\xd4\x03 \x01(\v2\x14.goplain.FileOptionsR\x04file:T\n" +
 	"\amessage\x12\x1f.google.protobuf.MessageOptions\x18\xe0\xd4\x03 \x01(\v2\x17.goplain.MessageOptionsR\amessage:L\n" +
 	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18\xe0\xd4\x03 \x01(\v2\x15.goplain.FieldOptionsR\x05field:L\n" +
@@ -674,46 +1120,59 @@ func file_goplain_proto_rawDescGZIP() []byte {
 	return file_goplain_proto_rawDescData
 }
 
-var file_goplain_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_goplain_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_goplain_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_goplain_proto_goTypes = []any{
-	(*GoIdent)(nil),                     // 0: goplain.GoIdent
-	(*OverrideSelector)(nil),            // 1: goplain.OverrideSelector
-	(*TypeOverride)(nil),                // 2: goplain.TypeOverride
-	(*MessageOptions)(nil),              // 3: goplain.MessageOptions
-	(*FileOptions)(nil),                 // 4: goplain.FileOptions
-	(*FieldOptions)(nil),                // 5: goplain.FieldOptions
-	(*OneofOptions)(nil),                // 6: goplain.OneofOptions
-	(typepb.Field_Kind)(0),              // 7: google.protobuf.Field.Kind
-	(typepb.Field_Cardinality)(0),       // 8: google.protobuf.Field.Cardinality
-	(*typepb.Field)(nil),                // 9: google.protobuf.Field
-	(*typepb.Type)(nil),                 // 10: google.protobuf.Type
-	(*descriptorpb.FileOptions)(nil),    // 11: google.protobuf.FileOptions
-	(*descriptorpb.MessageOptions)(nil), // 12: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),   // 13: google.protobuf.FieldOptions
-	(*descriptorpb.OneofOptions)(nil),   // 14: google.protobuf.OneofOptions
+	(CollisionStrategy)(0),              // 0: goplain.CollisionStrategy
+	(*GoIdent)(nil),                     // 1: goplain.GoIdent
+	(*OverrideSelector)(nil),            // 2: goplain.OverrideSelector
+	(*TypeOverride)(nil),                // 3: goplain.TypeOverride
+	(*MessageOptions)(nil),              // 4: goplain.MessageOptions
+	(*TableIndex)(nil),                  // 5: goplain.TableIndex
+	(*ExistingCaster)(nil),              // 6: goplain.ExistingCaster
+	(*CasterRegistry)(nil),              // 7: goplain.CasterRegistry
+	(*FileOptions)(nil),                 // 8: goplain.FileOptions
+	(*FieldOptions)(nil),                // 9: goplain.FieldOptions
+	(*OneofOptions)(nil),                // 10: goplain.OneofOptions
+	(typepb.Field_Kind)(0),              // 11: google.protobuf.Field.Kind
+	(typepb.Field_Cardinality)(0),       // 12: google.protobuf.Field.Cardinality
+	(*typepb.Field)(nil),                // 13: google.protobuf.Field
+	(*typepb.Type)(nil),                 // 14: google.protobuf.Type
+	(*descriptorpb.FileOptions)(nil),    // 15: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil), // 16: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 17: google.protobuf.FieldOptions
+	(*descriptorpb.OneofOptions)(nil),   // 18: google.protobuf.OneofOptions
 }
 var file_goplain_proto_depIdxs = []int32{
-	7,  // 0: goplain.OverrideSelector.field_kind:type_name -> google.protobuf.Field.Kind
-	8,  // 1: goplain.OverrideSelector.field_cardinality:type_name -> google.protobuf.Field.Cardinality
-	1,  // 2: goplain.TypeOverride.selector:type_name -> goplain.OverrideSelector
-	0,  // 3: goplain.TypeOverride.target_go_type:type_name -> goplain.GoIdent
-	9,  // 4: goplain.MessageOptions.virtual_fields:type_name -> google.protobuf.Field
-	2,  // 5: goplain.FileOptions.go_types_overrides:type_name -> goplain.TypeOverride
-	10, // 6: goplain.FileOptions.virtual_types:type_name -> google.protobuf.Type
-	0,  // 7: goplain.FieldOptions.override_type:type_name -> goplain.GoIdent
-	11, // 8: goplain.file:extendee -> google.protobuf.FileOptions
-	12, // 9: goplain.message:extendee -> google.protobuf.MessageOptions
-	13, // 10: goplain.field:extendee -> google.protobuf.FieldOptions
-	14, // 11: goplain.oneof:extendee -> google.protobuf.OneofOptions
-	4,  // 12: goplain.file:type_name -> goplain.FileOptions
-	3,  // 13: goplain.message:type_name -> goplain.MessageOptions
-	5,  // 14: goplain.field:type_name -> goplain.FieldOptions
-	6,  // 15: goplain.oneof:type_name -> goplain.OneofOptions
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	12, // [12:16] is the sub-list for extension type_name
-	8,  // [8:12] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	11, // 0: goplain.OverrideSelector.field_kind:type_name -> google.protobuf.Field.Kind
+	12, // 1: goplain.OverrideSelector.field_cardinality:type_name -> google.protobuf.Field.Cardinality
+	2,  // 2: goplain.TypeOverride.selector:type_name -> goplain.OverrideSelector
+	1,  // 3: goplain.TypeOverride.target_go_type:type_name -> goplain.GoIdent
+	13, // 4: goplain.MessageOptions.virtual_fields:type_name -> google.protobuf.Field
+	0,  // 5: goplain.MessageOptions.collision_strategy:type_name -> goplain.CollisionStrategy
+	5,  // 6: goplain.MessageOptions.indexes:type_name -> goplain.TableIndex
+	1,  // 7: goplain.ExistingCaster.source:type_name -> goplain.GoIdent
+	1,  // 8: goplain.ExistingCaster.target:type_name -> goplain.GoIdent
+	1,  // 9: goplain.ExistingCaster.caster:type_name -> goplain.GoIdent
+	6,  // 10: goplain.CasterRegistry.casters:type_name -> goplain.ExistingCaster
+	3,  // 11: goplain.FileOptions.go_types_overrides:type_name -> goplain.TypeOverride
+	14, // 12: goplain.FileOptions.virtual_types:type_name -> google.protobuf.Type
+	6,  // 13: goplain.FileOptions.existing_casters:type_name -> goplain.ExistingCaster
+	0,  // 14: goplain.FileOptions.collision_strategy:type_name -> goplain.CollisionStrategy
+	1,  // 15: goplain.FieldOptions.override_type:type_name -> goplain.GoIdent
+	15, // 16: goplain.file:extendee -> google.protobuf.FileOptions
+	16, // 17: goplain.message:extendee -> google.protobuf.MessageOptions
+	17, // 18: goplain.field:extendee -> google.protobuf.FieldOptions
+	18, // 19: goplain.oneof:extendee -> google.protobuf.OneofOptions
+	8,  // 20: goplain.file:type_name -> goplain.FileOptions
+	4,  // 21: goplain.message:type_name -> goplain.MessageOptions
+	9,  // 22: goplain.field:type_name -> goplain.FieldOptions
+	10, // 23: goplain.oneof:type_name -> goplain.OneofOptions
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	20, // [20:24] is the sub-list for extension type_name
+	16, // [16:20] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_goplain_proto_init() }
@@ -727,13 +1186,14 @@ func file_goplain_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goplain_proto_rawDesc), len(file_goplain_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 4,
 			NumServices:   0,
 		},
 		GoTypes:           file_goplain_proto_goTypes,
 		DependencyIndexes: file_goplain_proto_depIdxs,
+		EnumInfos:         file_goplain_proto_enumTypes,
 		MessageInfos:      file_goplain_proto_msgTypes,
 		ExtensionInfos:    file_goplain_proto_extTypes,
 	}.Build()
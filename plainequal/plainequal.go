@@ -0,0 +1,18 @@
+// Package plainequal contains the helper used by generated Equal methods
+// to compare fields with overridden Go types.
+package plainequal
+
+import "reflect"
+
+// Equal reports whether a and b are equal. It uses the Equal method when T
+// has one (time.Time and most decimal or ID types), compares comparable
+// values with == and falls back to reflect.DeepEqual otherwise.
+func Equal[T any](a, b T) bool {
+	if e, ok := any(a).(interface{ Equal(T) bool }); ok {
+		return e.Equal(b)
+	}
+	if reflect.ValueOf(a).Comparable() && reflect.ValueOf(b).Comparable() {
+		return any(a) == any(b)
+	}
+	return reflect.DeepEqual(a, b)
+}
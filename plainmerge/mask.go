@@ -0,0 +1,138 @@
+package plainmerge
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MaskPaths resolves field-mask paths of md (proto field names joined with ".")
+// to the field number paths CopyPaths copies. A mask path selects the covered
+// paths below it, so a path to an embedded message selects every field
+// flattened from it; a path inside a covered field is copied as is.
+// An empty mask selects all covered paths.
+func MaskPaths(md protoreflect.MessageDescriptor, mask []string, covered [][]protoreflect.FieldNumber) ([][]protoreflect.FieldNumber, error) {
+	if len(mask) == 0 {
+		return covered, nil
+	}
+	var paths [][]protoreflect.FieldNumber
+	add := func(path []protoreflect.FieldNumber) {
+		for _, p := range paths {
+			if slices.Equal(p, path) {
+				return
+			}
+		}
+		paths = append(paths, path)
+	}
+	for _, m := range mask {
+		path, err := resolvePath(md, m)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, c := range covered {
+			switch {
+			case hasPrefix(c, path):
+				add(c)
+			case hasPrefix(path, c):
+				add(path)
+			default:
+				continue
+			}
+			found = true
+		}
+		if !found {
+			return nil, fmt.Errorf("field mask path %q: not covered by the plain struct", m)
+		}
+	}
+	return paths, nil
+}
+
+// resolvePath converts a field-mask path into field numbers. Every element but
+// the last must be a singular message field.
+func resolvePath(md protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldNumber, error) {
+	names := strings.Split(path, ".")
+	nums := make([]protoreflect.FieldNumber, len(names))
+	for i, name := range names {
+		if md == nil {
+			return nil, fmt.Errorf("field mask path %q: %s is not a message", path, strings.Join(names[:i], "."))
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("field mask path %q: no field %q in %s", path, name, md.FullName())
+		}
+		nums[i] = fd.Number()
+		md = nil
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			md = fd.Message()
+		}
+	}
+	return nums, nil
+}
+
+func hasPrefix(path, prefix []protoreflect.FieldNumber) bool {
+	return len(path) >= len(prefix) && slices.Equal(path[:len(prefix)], prefix)
+}
+
+// FieldPath links a field of a Plain struct to the protobuf field it comes from
+type FieldPath struct {
+	// Name is the JSON name of the Plain field
+	Name string
+	// Path is the field-mask path of the protobuf field
+	Path string
+}
+
+// PbPaths translates JSON names of a Plain struct into field-mask paths.
+// A name may go on past a field with "."; the rest is appended to its path.
+func PbPaths(fields []FieldPath, names []string) ([]string, error) {
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		path, ok := "", false
+		for _, f := range fields {
+			if name == f.Name {
+				path, ok = f.Path, true
+				break
+			}
+			if rest, cut := strings.CutPrefix(name, f.Name+"."); cut {
+				path, ok = f.Path+"."+rest, true
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("unknown plain field %q", name)
+		}
+		if !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
+// PlainNames translates field-mask paths into JSON names of a Plain struct.
+// A path to an embedded message yields the names of all fields flattened from it,
+// a path inside a field yields the field name followed by the rest of the path.
+func PlainNames(fields []FieldPath, paths []string) ([]string, error) {
+	var names []string
+	add := func(name string) {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	for _, path := range paths {
+		found := false
+		for _, f := range fields {
+			if f.Path == path || strings.HasPrefix(f.Path, path+".") {
+				add(f.Name)
+				found = true
+			} else if rest, cut := strings.CutPrefix(path, f.Path+"."); cut {
+				add(f.Name + "." + rest)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("field mask path %q: not covered by the plain struct", path)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,58 @@
+// Package plainmerge contains the helpers used by generated IntoPbMerge methods
+// to write the fields covered by a Plain struct into an existing protobuf
+// message, leaving ignored and excluded fields of that message untouched,
+// and by the field-mask methods (field_mask=true) to select those fields.
+package plainmerge
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CopyPaths copies the fields at paths from src into dst. A path is a chain
+// of field numbers from the message root; every element but the last is a
+// message field. Fields unset in src are cleared in dst, and intermediate
+// messages are created in dst only when src has a value below them.
+// A repeated message field in the middle of a path is merged row by row:
+// dst gets as many rows as src, and the rest of the path is copied into each.
+func CopyPaths(dst, src proto.Message, paths ...[]protoreflect.FieldNumber) {
+	d, s := dst.ProtoReflect(), src.ProtoReflect()
+	for _, path := range paths {
+		copyPath(d, s, path)
+	}
+}
+
+func copyPath(dst, src protoreflect.Message, path []protoreflect.FieldNumber) {
+	for i, num := range path[:len(path)-1] {
+		fd := dst.Descriptor().Fields().ByNumber(num)
+		if !src.Has(fd) && !dst.Has(fd) {
+			return
+		}
+		if fd.IsList() {
+			copyRows(dst.Mutable(fd).List(), src.Get(fd).List(), path[i+1:])
+			return
+		}
+		src = src.Get(fd).Message()
+		dst = dst.Mutable(fd).Message()
+	}
+	fd := dst.Descriptor().Fields().ByNumber(path[len(path)-1])
+	if src.Has(fd) {
+		dst.Set(fd, src.Get(fd))
+	} else {
+		dst.Clear(fd)
+	}
+}
+
+// copyRows resizes dst to the length of src, keeping the rows dst already has,
+// and copies path into every row
+func copyRows(dst, src protoreflect.List, path []protoreflect.FieldNumber) {
+	if dst.Len() > src.Len() {
+		dst.Truncate(src.Len())
+	}
+	for dst.Len() < src.Len() {
+		dst.Append(dst.NewElement())
+	}
+	for i := 0; i < src.Len(); i++ {
+		copyPath(dst.Get(i).Message(), src.Get(i).Message(), path)
+	}
+}
@@ -0,0 +1,404 @@
+package plainreflect
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Field holds the accessors of one Plain struct field. Fields are created
+// by the constructors below, one per Go shape of the field.
+type Field[T any] struct {
+	has   func(p *T) bool
+	get   func(p *T) protoreflect.Value
+	set   func(p *T, v protoreflect.Value)
+	clear func(p *T)
+	// mutable and newField are set for message, list and map fields
+	mutable  func(p *T) protoreflect.Value
+	newField func() protoreflect.Value
+}
+
+// withDefault makes a scalar field with [default = ...] unset while it holds
+// the default, and restores the default on Clear
+func (fi *Field[T]) withDefault(def protoreflect.Value) {
+	get, set := fi.get, fi.set
+	fi.has = func(p *T) bool { return !get(p).Equal(def) }
+	fi.clear = func(p *T) { set(p, def) }
+}
+
+// ScalarType is a Go type stored in protoreflect.Value as is
+type ScalarType interface {
+	bool | int32 | int64 | uint32 | uint64 | float32 | float64 | string | []byte
+}
+
+// KeyType is a Go type of map keys
+type KeyType interface {
+	bool | int32 | int64 | uint32 | uint64 | string
+}
+
+// MessagePtr is a pointer to a protobuf or Plain message struct
+type MessagePtr[E any] interface {
+	*E
+	protoreflect.ProtoMessage
+}
+
+// converter maps Go values of type V to protoreflect values and back
+type converter[V any] struct {
+	toValue   func(v V) protoreflect.Value
+	fromValue func(v protoreflect.Value) V
+	// newValue creates a new mutable message, nil for other kinds
+	newValue func() protoreflect.Value
+}
+
+func scalarConverter[V ScalarType]() converter[V] {
+	return converter[V]{
+		toValue:   func(v V) protoreflect.Value { return protoreflect.ValueOf(v) },
+		fromValue: func(v protoreflect.Value) V { return v.Interface().(V) },
+	}
+}
+
+func enumConverter[E ~int32]() converter[E] {
+	return converter[E]{
+		toValue:   func(v E) protoreflect.Value { return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)) },
+		fromValue: func(v protoreflect.Value) E { return E(v.Enum()) },
+	}
+}
+
+func messageConverter[E any, M MessagePtr[E]]() converter[M] {
+	return converter[M]{
+		toValue:   func(v M) protoreflect.Value { return protoreflect.ValueOfMessage(v.ProtoReflect()) },
+		fromValue: func(v protoreflect.Value) M { return v.Message().Interface().(M) },
+		newValue:  func() protoreflect.Value { return protoreflect.ValueOfMessage(M(new(E)).ProtoReflect()) },
+	}
+}
+
+// isZero reports whether a scalar has its zero value, i.e. is unset with implicit presence
+func isZero(v protoreflect.Value) bool {
+	switch x := v.Interface().(type) {
+	case bool:
+		return !x
+	case int32:
+		return x == 0
+	case int64:
+		return x == 0
+	case uint32:
+		return x == 0
+	case uint64:
+		return x == 0
+	case float32:
+		return math.Float32bits(x) == 0
+	case float64:
+		return math.Float64bits(x) == 0
+	case string:
+		return x == ""
+	case []byte:
+		return len(x) == 0
+	case protoreflect.EnumNumber:
+		return x == 0
+	}
+	panic(fmt.Sprintf("plainreflect: unexpected scalar %T", v.Interface()))
+}
+
+func value[T, V any](ptr func(p *T) *V, conv converter[V]) Field[T] {
+	return Field[T]{
+		has:   func(p *T) bool { return !isZero(conv.toValue(*ptr(p))) },
+		get:   func(p *T) protoreflect.Value { return conv.toValue(*ptr(p)) },
+		set:   func(p *T, v protoreflect.Value) { *ptr(p) = conv.fromValue(v) },
+		clear: func(p *T) { var zero V; *ptr(p) = zero },
+	}
+}
+
+func optional[T, V any](ptr func(p *T) **V, conv converter[V]) Field[T] {
+	return Field[T]{
+		has: func(p *T) bool { return *ptr(p) != nil },
+		get: func(p *T) protoreflect.Value {
+			if v := *ptr(p); v != nil {
+				return conv.toValue(*v)
+			}
+			var zero V
+			return conv.toValue(zero)
+		},
+		set: func(p *T, v protoreflect.Value) {
+			x := conv.fromValue(v)
+			*ptr(p) = &x
+		},
+		clear: func(p *T) { *ptr(p) = nil },
+	}
+}
+
+// Scalar is a scalar field with implicit presence
+func Scalar[T any, V ScalarType](ptr func(p *T) *V) Field[T] {
+	return value(ptr, scalarConverter[V]())
+}
+
+// Enum is an enum field with implicit presence
+func Enum[T any, E ~int32](ptr func(p *T) *E) Field[T] {
+	return value(ptr, enumConverter[E]())
+}
+
+// Case is a oneof case field of a named string type, described as a string field
+func Case[T any, S ~string](ptr func(p *T) *S) Field[T] {
+	return value(ptr, converter[S]{
+		toValue:   func(v S) protoreflect.Value { return protoreflect.ValueOfString(string(v)) },
+		fromValue: func(v protoreflect.Value) S { return S(v.String()) },
+	})
+}
+
+// Optional is a scalar field stored as a pointer (proto3 optional)
+func Optional[T any, V ScalarType](ptr func(p *T) **V) Field[T] {
+	return optional(ptr, scalarConverter[V]())
+}
+
+// OptionalEnum is an enum field stored as a pointer (proto3 optional)
+func OptionalEnum[T any, E ~int32](ptr func(p *T) **E) Field[T] {
+	return optional(ptr, enumConverter[E]())
+}
+
+// Message is a message field stored as a pointer to a protobuf or Plain struct
+func Message[T any, E any, M MessagePtr[E]](ptr func(p *T) *M) Field[T] {
+	conv := messageConverter[E, M]()
+	return Field[T]{
+		has: func(p *T) bool { return *ptr(p) != nil },
+		get: func(p *T) protoreflect.Value {
+			// A nil message pointer gives an invalid read-only message
+			return conv.toValue(*ptr(p))
+		},
+		set:   func(p *T, v protoreflect.Value) { *ptr(p) = conv.fromValue(v) },
+		clear: func(p *T) { *ptr(p) = nil },
+		mutable: func(p *T) protoreflect.Value {
+			if *ptr(p) == nil {
+				*ptr(p) = M(new(E))
+			}
+			return conv.toValue(*ptr(p))
+		},
+		newField: conv.newValue,
+	}
+}
+
+func listField[T, V any](ptr func(p *T) *[]V, conv converter[V]) Field[T] {
+	return Field[T]{
+		has: func(p *T) bool { return len(*ptr(p)) > 0 },
+		get: func(p *T) protoreflect.Value { return protoreflect.ValueOfList(&list[V]{s: ptr(p), conv: conv}) },
+		set: func(p *T, v protoreflect.Value) {
+			if l, ok := v.List().(*list[V]); ok {
+				*ptr(p) = *l.s
+				return
+			}
+			s := make([]V, 0, v.List().Len())
+			for i := 0; i < v.List().Len(); i++ {
+				s = append(s, conv.fromValue(v.List().Get(i)))
+			}
+			*ptr(p) = s
+		},
+		clear:    func(p *T) { *ptr(p) = nil },
+		mutable:  func(p *T) protoreflect.Value { return protoreflect.ValueOfList(&list[V]{s: ptr(p), conv: conv}) },
+		newField: func() protoreflect.Value { return protoreflect.ValueOfList(&list[V]{s: new([]V), conv: conv}) },
+	}
+}
+
+// List is a repeated scalar field
+func List[T any, V ScalarType](ptr func(p *T) *[]V) Field[T] {
+	return listField(ptr, scalarConverter[V]())
+}
+
+// EnumList is a repeated enum field
+func EnumList[T any, E ~int32](ptr func(p *T) *[]E) Field[T] {
+	return listField(ptr, enumConverter[E]())
+}
+
+// MessageList is a repeated message field stored as a slice of pointers
+func MessageList[T any, E any, M MessagePtr[E]](ptr func(p *T) *[]M) Field[T] {
+	return listField(ptr, messageConverter[E, M]())
+}
+
+// ValueList is a repeated message field stored as a slice of Plain structs
+// (repeated Plain messages and repeated embed rows). *E must implement ProtoReflect.
+func ValueList[T, E any](ptr func(p *T) *[]E) Field[T] {
+	conv := converter[E]{
+		toValue: func(v E) protoreflect.Value {
+			return protoreflect.ValueOfMessage(any(&v).(protoreflect.ProtoMessage).ProtoReflect())
+		},
+		fromValue: func(v protoreflect.Value) E { return *any(v.Message().Interface()).(*E) },
+		newValue: func() protoreflect.Value {
+			return protoreflect.ValueOfMessage(any(new(E)).(protoreflect.ProtoMessage).ProtoReflect())
+		},
+	}
+	return Field[T]{
+		has: func(p *T) bool { return len(*ptr(p)) > 0 },
+		get: func(p *T) protoreflect.Value {
+			return protoreflect.ValueOfList(&valueList[E]{s: ptr(p), conv: conv})
+		},
+		set: func(p *T, v protoreflect.Value) {
+			if l, ok := v.List().(*valueList[E]); ok {
+				*ptr(p) = *l.s
+				return
+			}
+			s := make([]E, 0, v.List().Len())
+			for i := 0; i < v.List().Len(); i++ {
+				s = append(s, conv.fromValue(v.List().Get(i)))
+			}
+			*ptr(p) = s
+		},
+		clear:    func(p *T) { *ptr(p) = nil },
+		mutable:  func(p *T) protoreflect.Value { return protoreflect.ValueOfList(&valueList[E]{s: ptr(p), conv: conv}) },
+		newField: func() protoreflect.Value { return protoreflect.ValueOfList(&valueList[E]{s: new([]E), conv: conv}) },
+	}
+}
+
+func mapField[T any, K KeyType, V any](ptr func(p *T) *map[K]V, conv converter[V]) Field[T] {
+	return Field[T]{
+		has: func(p *T) bool { return len(*ptr(p)) > 0 },
+		get: func(p *T) protoreflect.Value { return protoreflect.ValueOfMap(&mapValue[K, V]{m: ptr(p), conv: conv}) },
+		set: func(p *T, v protoreflect.Value) {
+			if m, ok := v.Map().(*mapValue[K, V]); ok {
+				*ptr(p) = *m.m
+				return
+			}
+			m := make(map[K]V, v.Map().Len())
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				m[k.Interface().(K)] = conv.fromValue(v)
+				return true
+			})
+			*ptr(p) = m
+		},
+		clear: func(p *T) { *ptr(p) = nil },
+		mutable: func(p *T) protoreflect.Value {
+			if *ptr(p) == nil {
+				*ptr(p) = make(map[K]V)
+			}
+			return protoreflect.ValueOfMap(&mapValue[K, V]{m: ptr(p), conv: conv})
+		},
+		newField: func() protoreflect.Value {
+			m := make(map[K]V)
+			return protoreflect.ValueOfMap(&mapValue[K, V]{m: &m, conv: conv})
+		},
+	}
+}
+
+// Map is a map field with scalar values
+func Map[T any, K KeyType, V ScalarType](ptr func(p *T) *map[K]V) Field[T] {
+	return mapField(ptr, scalarConverter[V]())
+}
+
+// EnumMap is a map field with enum values
+func EnumMap[T any, K KeyType, E ~int32](ptr func(p *T) *map[K]E) Field[T] {
+	return mapField(ptr, enumConverter[E]())
+}
+
+// MessageMap is a map field with message values stored as pointers
+func MessageMap[T any, K KeyType, E any, M MessagePtr[E]](ptr func(p *T) *map[K]M) Field[T] {
+	return mapField(ptr, messageConverter[E, M]())
+}
+
+// list implements protoreflect.List over a slice field
+type list[V any] struct {
+	s    *[]V
+	conv converter[V]
+}
+
+func (l *list[V]) Len() int                        { return len(*l.s) }
+func (l *list[V]) Get(i int) protoreflect.Value    { return l.conv.toValue((*l.s)[i]) }
+func (l *list[V]) Set(i int, v protoreflect.Value) { (*l.s)[i] = l.conv.fromValue(v) }
+func (l *list[V]) Append(v protoreflect.Value)     { *l.s = append(*l.s, l.conv.fromValue(v)) }
+func (l *list[V]) Truncate(n int)                  { *l.s = (*l.s)[:n] }
+func (l *list[V]) IsValid() bool                   { return l.s != nil }
+func (l *list[V]) AppendMutable() protoreflect.Value {
+	if l.conv.newValue == nil {
+		panic("plainreflect: AppendMutable on a list of scalars")
+	}
+	v := l.conv.newValue()
+	l.Append(v)
+	return v
+}
+
+func (l *list[V]) NewElement() protoreflect.Value {
+	if l.conv.newValue != nil {
+		return l.conv.newValue()
+	}
+	var zero V
+	return l.conv.toValue(zero)
+}
+
+// valueList implements protoreflect.List over a slice of Plain structs,
+// elements are accessed in place
+type valueList[E any] struct {
+	s    *[]E
+	conv converter[E]
+}
+
+func (l *valueList[E]) Len() int { return len(*l.s) }
+func (l *valueList[E]) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage(any(&(*l.s)[i]).(protoreflect.ProtoMessage).ProtoReflect())
+}
+func (l *valueList[E]) Set(i int, v protoreflect.Value) { (*l.s)[i] = l.conv.fromValue(v) }
+func (l *valueList[E]) Append(v protoreflect.Value)     { *l.s = append(*l.s, l.conv.fromValue(v)) }
+func (l *valueList[E]) Truncate(n int)                  { *l.s = (*l.s)[:n] }
+func (l *valueList[E]) IsValid() bool                   { return l.s != nil }
+func (l *valueList[E]) NewElement() protoreflect.Value  { return l.conv.newValue() }
+func (l *valueList[E]) AppendMutable() protoreflect.Value {
+	var zero E
+	*l.s = append(*l.s, zero)
+	return l.Get(len(*l.s) - 1)
+}
+
+// mapValue implements protoreflect.Map over a map field
+type mapValue[K KeyType, V any] struct {
+	m    *map[K]V
+	conv converter[V]
+}
+
+func (m *mapValue[K, V]) Len() int      { return len(*m.m) }
+func (m *mapValue[K, V]) IsValid() bool { return *m.m != nil }
+
+func (m *mapValue[K, V]) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	for k, v := range *m.m {
+		if !f(protoreflect.ValueOf(k).MapKey(), m.conv.toValue(v)) {
+			return
+		}
+	}
+}
+
+func (m *mapValue[K, V]) Has(k protoreflect.MapKey) bool {
+	_, ok := (*m.m)[k.Interface().(K)]
+	return ok
+}
+
+func (m *mapValue[K, V]) Clear(k protoreflect.MapKey) {
+	delete(*m.m, k.Interface().(K))
+}
+
+func (m *mapValue[K, V]) Get(k protoreflect.MapKey) protoreflect.Value {
+	v, ok := (*m.m)[k.Interface().(K)]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return m.conv.toValue(v)
+}
+
+func (m *mapValue[K, V]) Set(k protoreflect.MapKey, v protoreflect.Value) {
+	if *m.m == nil {
+		*m.m = make(map[K]V)
+	}
+	(*m.m)[k.Interface().(K)] = m.conv.fromValue(v)
+}
+
+func (m *mapValue[K, V]) Mutable(k protoreflect.MapKey) protoreflect.Value {
+	if m.conv.newValue == nil {
+		panic("plainreflect: Mutable on a map of scalars")
+	}
+	if v, ok := (*m.m)[k.Interface().(K)]; ok {
+		return m.conv.toValue(v)
+	}
+	v := m.conv.newValue()
+	m.Set(k, v)
+	return v
+}
+
+func (m *mapValue[K, V]) NewValue() protoreflect.Value {
+	if m.conv.newValue != nil {
+		return m.conv.newValue()
+	}
+	var zero V
+	return m.conv.toValue(zero)
+}
@@ -0,0 +1,279 @@
+// Package plainreflect implements protoreflect for Plain structs (reflect=true).
+//
+// Generated code describes the Plain messages of each proto file with a
+// synthetic proto file in the "<package>.plain" package, and each Plain struct
+// with a MessageInfo holding accessors for its fields. ProtoReflect on a Plain
+// struct returns a protoreflect.Message backed by the struct itself, so Plain
+// structs work with proto.Equal, proto.Clone, protojson and other reflection
+// based tooling. Fields without a protobuf counterpart (type overrides with
+// casters, native well-known types) are not part of the descriptor.
+//
+// Descriptors are built and registered in protoregistry.GlobalFiles and
+// protoregistry.GlobalTypes from the init of the generated file, after the
+// original proto files they refer to, so Plain types can be found by name
+// before any value is used.
+package plainreflect
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// File is a synthetic proto file describing the Plain messages of one generated file
+type File struct {
+	path     string
+	rawDesc  string
+	messages []messageType
+
+	once sync.Once
+	desc protoreflect.FileDescriptor
+}
+
+// messageType is a MessageInfo of any Plain struct
+type messageType interface {
+	protoreflect.MessageType
+	bind(fd protoreflect.FileDescriptor)
+}
+
+var (
+	filesMu sync.Mutex
+	files   = map[string]*File{}
+)
+
+// NewFile registers a synthetic proto file. rawDesc is the serialized
+// descriptorpb.FileDescriptorProto.
+func NewFile(path, rawDesc string) *File {
+	f := &File{path: path, rawDesc: rawDesc}
+	filesMu.Lock()
+	defer filesMu.Unlock()
+	if _, ok := files[path]; ok {
+		panic(fmt.Sprintf("plainreflect: file %s is already registered", path))
+	}
+	files[path] = f
+	return f
+}
+
+func lookupFile(path string) *File {
+	filesMu.Lock()
+	defer filesMu.Unlock()
+	return files[path]
+}
+
+// Path returns the path of the synthetic proto file
+func (f *File) Path() string {
+	return f.path
+}
+
+// Init builds the file descriptor and registers it with its messages.
+// Generated code calls it from init, later calls do nothing.
+func (f *File) Init() {
+	f.once.Do(f.build)
+}
+
+// Descriptor returns the file descriptor, building and registering it if Init hasn't run yet
+func (f *File) Descriptor() protoreflect.FileDescriptor {
+	f.once.Do(f.build)
+	return f.desc
+}
+
+func (f *File) build() {
+	fdp := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal([]byte(f.rawDesc), fdp); err != nil {
+		panic(fmt.Sprintf("plainreflect: %s: %v", f.path, err))
+	}
+	fd, err := protodesc.NewFile(fdp, resolver{})
+	if err != nil {
+		panic(fmt.Sprintf("plainreflect: %s: %v", f.path, err))
+	}
+	for _, mt := range f.messages {
+		mt.bind(fd)
+	}
+	f.desc = fd
+
+	if err := protoregistry.GlobalFiles.RegisterFile(fd); err != nil {
+		panic(fmt.Sprintf("plainreflect: %s: %v", f.path, err))
+	}
+	for _, mt := range f.messages {
+		if err := protoregistry.GlobalTypes.RegisterMessage(mt); err != nil {
+			panic(fmt.Sprintf("plainreflect: %s: %v", f.path, err))
+		}
+	}
+}
+
+// resolver finds imported synthetic files before they are registered globally
+type resolver struct{}
+
+func (resolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if f := lookupFile(path); f != nil {
+		return f.Descriptor(), nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (resolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+// MessageInfo describes the Plain struct T. Fields are in the order of the
+// message fields in the synthetic descriptor.
+type MessageInfo[T any] struct {
+	file   *File
+	name   protoreflect.Name
+	fields []Field[T]
+	desc   atomic.Value // protoreflect.MessageDescriptor
+}
+
+// NewMessageInfo creates the MessageInfo of the message name in file
+func NewMessageInfo[T any](file *File, name string, fields ...Field[T]) *MessageInfo[T] {
+	mi := &MessageInfo[T]{file: file, name: protoreflect.Name(name), fields: fields}
+	file.messages = append(file.messages, mi)
+	return mi
+}
+
+func (mi *MessageInfo[T]) bind(fd protoreflect.FileDescriptor) {
+	md := fd.Messages().ByName(mi.name)
+	if md == nil {
+		panic(fmt.Sprintf("plainreflect: message %s not found in %s", mi.name, fd.Path()))
+	}
+	if md.Fields().Len() != len(mi.fields) {
+		panic(fmt.Sprintf("plainreflect: %s has %d fields, descriptor has %d", md.FullName(), len(mi.fields), md.Fields().Len()))
+	}
+	for i := range mi.fields {
+		if fd := md.Fields().Get(i); fd.HasDefault() {
+			mi.fields[i].withDefault(fd.Default())
+		}
+	}
+	mi.desc.Store(md)
+}
+
+// MessageOf returns the reflective view of p. p may be nil.
+func (mi *MessageInfo[T]) MessageOf(p *T) protoreflect.Message {
+	return &message[T]{p: p, mi: mi}
+}
+
+// New returns a new empty message
+func (mi *MessageInfo[T]) New() protoreflect.Message {
+	return mi.MessageOf(new(T))
+}
+
+// Zero returns an invalid read-only message
+func (mi *MessageInfo[T]) Zero() protoreflect.Message {
+	return mi.MessageOf(nil)
+}
+
+// Descriptor returns the message descriptor
+func (mi *MessageInfo[T]) Descriptor() protoreflect.MessageDescriptor {
+	if md, ok := mi.desc.Load().(protoreflect.MessageDescriptor); ok {
+		return md
+	}
+	mi.file.Descriptor()
+	return mi.desc.Load().(protoreflect.MessageDescriptor)
+}
+
+// message implements protoreflect.Message over a Plain struct
+type message[T any] struct {
+	p  *T
+	mi *MessageInfo[T]
+}
+
+func (m *message[T]) field(fd protoreflect.FieldDescriptor) *Field[T] {
+	md := m.Descriptor()
+	if fd.ContainingMessage() != md || fd.Index() >= len(m.mi.fields) {
+		panic(fmt.Sprintf("plainreflect: invalid field %s for message %s", fd.FullName(), md.FullName()))
+	}
+	return &m.mi.fields[fd.Index()]
+}
+
+func (m *message[T]) mutableTarget(fd protoreflect.FieldDescriptor) {
+	if m.p == nil {
+		panic(fmt.Sprintf("plainreflect: field %s of invalid message", fd.FullName()))
+	}
+}
+
+func (m *message[T]) Descriptor() protoreflect.MessageDescriptor { return m.mi.Descriptor() }
+func (m *message[T]) Type() protoreflect.MessageType             { return m.mi }
+func (m *message[T]) New() protoreflect.Message                  { return m.mi.New() }
+func (m *message[T]) IsValid() bool                              { return m.p != nil }
+func (m *message[T]) ProtoMethods() *protoiface.Methods          { return nil }
+
+// Plain structs don't keep unknown fields
+func (m *message[T]) GetUnknown() protoreflect.RawFields { return nil }
+func (m *message[T]) SetUnknown(protoreflect.RawFields)  {}
+
+func (m *message[T]) Interface() protoreflect.ProtoMessage {
+	return any(m.p).(protoreflect.ProtoMessage)
+}
+
+func (m *message[T]) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if m.p == nil {
+		return
+	}
+	fds := m.Descriptor().Fields()
+	for i := range m.mi.fields {
+		fi := &m.mi.fields[i]
+		if fi.has(m.p) && !f(fds.Get(i), fi.get(m.p)) {
+			return
+		}
+	}
+}
+
+func (m *message[T]) Has(fd protoreflect.FieldDescriptor) bool {
+	fi := m.field(fd)
+	return m.p != nil && fi.has(m.p)
+}
+
+func (m *message[T]) Clear(fd protoreflect.FieldDescriptor) {
+	fi := m.field(fd)
+	m.mutableTarget(fd)
+	fi.clear(m.p)
+}
+
+func (m *message[T]) Get(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	fi := m.field(fd)
+	if m.p == nil {
+		return fi.get(new(T))
+	}
+	return fi.get(m.p)
+}
+
+func (m *message[T]) Set(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	fi := m.field(fd)
+	m.mutableTarget(fd)
+	fi.set(m.p, v)
+}
+
+func (m *message[T]) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	fi := m.field(fd)
+	m.mutableTarget(fd)
+	if fi.mutable == nil {
+		panic(fmt.Sprintf("plainreflect: field %s is not a message, list or map", fd.FullName()))
+	}
+	return fi.mutable(m.p)
+}
+
+func (m *message[T]) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	fi := m.field(fd)
+	if fi.newField == nil {
+		return fd.Default()
+	}
+	return fi.newField()
+}
+
+// WhichOneof handles the synthetic oneofs of optional fields, the only oneofs of Plain messages
+func (m *message[T]) WhichOneof(od protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	fds := od.Fields()
+	for i := 0; i < fds.Len(); i++ {
+		if m.Has(fds.Get(i)) {
+			return fds.Get(i)
+		}
+	}
+	return nil
+}
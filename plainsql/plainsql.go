@@ -0,0 +1,99 @@
+// Package plainsql contains the helpers used by generated ScanRow and Values
+// methods (sql=true) to store nested messages, maps and repeated fields of
+// Plain structs in JSON columns, and to write uint64 fields.
+package plainsql
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// JSONColumn is a sql.Scanner and driver.Valuer storing the value a field
+// pointer points to as JSON. Protobuf messages are encoded with protojson,
+// everything else with encoding/json. Nil values are stored as NULL and
+// NULL scans into the zero value.
+type JSONColumn struct {
+	ptr any
+}
+
+// JSON wraps ptr, a pointer to a field stored as JSON
+func JSON(ptr any) JSONColumn {
+	return JSONColumn{ptr: ptr}
+}
+
+// Value encodes the field as a JSON string
+func (c JSONColumn) Value() (driver.Value, error) {
+	v := reflect.ValueOf(c.ptr).Elem()
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+	}
+	var (
+		data []byte
+		err  error
+	)
+	if m, ok := v.Interface().(proto.Message); ok {
+		data, err = protojson.Marshal(m)
+	} else {
+		data, err = json.Marshal(v.Interface())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan decodes a JSON column into the field
+func (c JSONColumn) Scan(src any) error {
+	v := reflect.ValueOf(c.ptr).Elem()
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+		v.SetZero()
+		return nil
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	default:
+		return fmt.Errorf("plainsql: cannot scan %T into %s", src, v.Type())
+	}
+	if _, ok := v.Interface().(proto.Message); ok && v.Kind() == reflect.Pointer {
+		m := reflect.New(v.Type().Elem())
+		if err := protojson.Unmarshal(data, m.Interface().(proto.Message)); err != nil {
+			return err
+		}
+		v.Set(m)
+		return nil
+	}
+	v.SetZero()
+	return json.Unmarshal(data, c.ptr)
+}
+
+// Uint64Column is a driver.Valuer writing a uint64 field as a decimal string:
+// database/sql rejects uint64 values with the high bit set. A nil pointer is
+// written as NULL. Columns scan back into uint64 fields as is.
+type Uint64Column struct {
+	ptr *uint64
+}
+
+// Uint64 wraps ptr, a uint64 field or an optional one
+func Uint64(ptr *uint64) Uint64Column {
+	return Uint64Column{ptr: ptr}
+}
+
+// Value formats the field as a decimal string
+func (c Uint64Column) Value() (driver.Value, error) {
+	if c.ptr == nil {
+		return nil, nil
+	}
+	return strconv.FormatUint(*c.ptr, 10), nil
+}
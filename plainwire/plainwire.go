@@ -0,0 +1,53 @@
+// Package plainwire contains helpers used by generated AppendProto/UnmarshalProto
+// methods to write Plain structs directly in the protobuf wire format.
+package plainwire
+
+import (
+	"cmp"
+	"slices"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// FinishLen turns b[start:] into a length-delimited value by inserting
+// its varint length at start. Nested messages are written in place and
+// prefixed afterwards, so their size doesn't have to be computed up front.
+func FinishLen(b []byte, start int) []byte {
+	n := len(b) - start
+	size := protowire.SizeVarint(uint64(n))
+	b = append(b, make([]byte, size)...)
+	copy(b[start+size:], b[start:start+n])
+	protowire.AppendVarint(b[start:start], uint64(n))
+	return b
+}
+
+// FinishEmbed is FinishLen for messages flattened into a Plain struct:
+// an empty message is dropped together with its tag written at tagStart,
+// since the Plain struct can't tell an empty message from an unset one.
+func FinishEmbed(b []byte, tagStart, start int) []byte {
+	if len(b) == start {
+		return b[:tagStart]
+	}
+	return FinishLen(b, start)
+}
+
+// SortedKeys returns map keys in ascending order, matching deterministic proto.Marshal
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// SortedBoolKeys returns bool map keys in ascending order (false first)
+func SortedBoolKeys[V any](m map[bool]V) []bool {
+	keys := make([]bool, 0, 2)
+	for _, k := range []bool{false, true} {
+		if _, ok := m[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,56 @@
+package diagnostics_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/generator"
+	generatortest "github.com/yaroher/protoc-gen-go-plain/generator/testing"
+)
+
+// TestDiagnostics verifies that every problem of the file is reported in one
+// run, located at the offending declaration and tagged with a stable code.
+func TestDiagnostics(t *testing.T) {
+	const file = "test/diagnostics/diagnostics.proto"
+	_, err := generatortest.Generate([]string{"../.."}, "paths=source_relative,json_jx=true", file)
+	require.Error(t, err)
+
+	var diags generator.Diagnostics
+	require.True(t, errors.As(err, &diags), "expected generator.Diagnostics, got %v", err)
+
+	type located struct {
+		Line, Column int
+		Code         generator.DiagnosticCode
+	}
+	got := make([]located, 0, len(diags))
+	for _, d := range diags {
+		assert.Equal(t, file, d.Pos.File)
+		got = append(got, located{d.Pos.Line, d.Pos.Column, d.Code})
+	}
+	assert.Equal(t, []located{
+		{18, 1, generator.CodeCasterMismatch},    // conflicting existing caster
+		{25, 1, generator.CodeCasterMismatch},    // existing caster without target
+		{32, 3, generator.CodeMissingAliasField}, // type_alias option of Money
+		{43, 3, generator.CodeInvalidOption},     // exclude_paths entry
+		{45, 18, generator.CodeInvalidEmbed},     // embed on a string field
+		{46, 54, generator.CodeInvalidEmbed},     // json_name on an embedded message
+		{49, 24, generator.CodeInvalidOption},    // ignore on a oneof variant
+		{53, 3, generator.CodeCollision},         // home.city vs city
+		{60, 3, generator.CodeInvalidOption},     // primary_key with a proto path
+		{61, 3, generator.CodeInvalidOption},     // index on an unknown column
+		{62, 3, generator.CodeInvalidOption},     // index without columns
+	}, got)
+
+	// protoc format, one diagnostic per line
+	assert.Equal(t,
+		file+`:45:18: GP002: field Order.id: embed is only valid for message fields`,
+		diags[4].Error())
+	assert.Equal(t,
+		file+`:60:3: GP005: message Shipment: primary_key column "address.city" is not a field of ShipmentPlain`,
+		diags[8].Error())
+	var collision generator.Collision
+	require.True(t, errors.As(err, &collision))
+	assert.Equal(t, "city", collision.FieldName)
+}
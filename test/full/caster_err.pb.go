@@ -0,0 +1,330 @@
+// Error-returning casters: IntoPlainE / IntoPbE
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/caster_err.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscriberAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriberAccount) Reset() {
+	*x = SubscriberAccount{}
+	mi := &file_test_full_caster_err_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriberAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriberAccount) ProtoMessage() {}
+
+func (x *SubscriberAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_caster_err_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriberAccount.ProtoReflect.Descriptor instead.
+func (*SubscriberAccount) Descriptor() ([]byte, []int) {
+	return file_test_full_caster_err_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscriberAccount) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SubscriberAccount) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timeout       int64                  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	RetryAfter    int64                  `protobuf:"varint,3,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	Owner         *SubscriberAccount     `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_test_full_caster_err_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_caster_err_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_test_full_caster_err_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *Subscription) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+func (x *Subscription) GetOwner() *SubscriberAccount {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// Row field sharing its name with a field of the parent, casters of rows are
+// named after the embed field
+type ReminderSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	When          int64                  `protobuf:"varint,1,opt,name=when,proto3" json:"when,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReminderSlot) Reset() {
+	*x = ReminderSlot{}
+	mi := &file_test_full_caster_err_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReminderSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReminderSlot) ProtoMessage() {}
+
+func (x *ReminderSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_caster_err_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReminderSlot.ProtoReflect.Descriptor instead.
+func (*ReminderSlot) Descriptor() ([]byte, []int) {
+	return file_test_full_caster_err_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReminderSlot) GetWhen() int64 {
+	if x != nil {
+		return x.When
+	}
+	return 0
+}
+
+type Reminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*ReminderSlot        `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	When          int32                  `protobuf:"varint,2,opt,name=when,proto3" json:"when,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_test_full_caster_err_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_caster_err_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_test_full_caster_err_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Reminder) GetSlots() []*ReminderSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Reminder) GetWhen() int32 {
+	if x != nil {
+		return x.When
+	}
+	return 0
+}
+
+var File_test_full_caster_err_proto protoreflect.FileDescriptor
+
+const file_test_full_caster_err_proto_rawDesc = "" +
+	"\n" +
+	"\x1atest/full/caster_err.proto\x12\x04full\x1a\x15goplain/goplain.proto\"\x8f\x01\n" +
+	"\x11SubscriberAccount\x12W\n" +
+	"\x05email\x18\x01 \x01(\tBA\x82\xa6\x1d=\n" +
+	";\n" +
+	"\x05Email\x120github.com/yaroher/protoc-gen-go-plain/test/full\x18\x01R\x05email\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"\x9a\x01\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\x03R\atimeout\x12\x1f\n" +
+	"\vretry_after\x18\x03 \x01(\x03R\n" +
+	"retryAfter\x127\n" +
+	"\x05owner\x18\x04 \x01(\v2\x17.full.SubscriberAccountB\b\x82\xa6\x1d\x04 \x01(\x01R\x05owner:\x06\x82\xa6\x1d\x02\b\x01\"<\n" +
+	"\fReminderSlot\x12,\n" +
+	"\x04when\x18\x01 \x01(\x03B\x18\x82\xa6\x1d\x14\n" +
+	"\x12\n" +
+	"\bDuration\x12\x04time\x18\x01R\x04when\"o\n" +
+	"\bReminder\x120\n" +
+	"\x05slots\x18\x01 \x03(\v2\x12.full.ReminderSlotB\x06\x82\xa6\x1d\x02 \x01R\x05slots\x12)\n" +
+	"\x04when\x18\x02 \x01(\x05B\x15\x82\xa6\x1d\x11\n" +
+	"\x0f\n" +
+	"\x05Month\x12\x04time\x18\x01R\x04when:\x06\x82\xa6\x1d\x02\b\x01B\x9e\x01\x82\xa6\x1dh\n" +
+	"1\n" +
+	"\x1b\n" +
+	"\x19full.Subscription.timeout\x12\x12\n" +
+	"\bDuration\x12\x04time\x18\x01\n" +
+	"3\n" +
+	"\x1f\n" +
+	"\x1dfull.Subscription.retry_after\x12\x10\n" +
+	"\bDuration\x12\x04timeZ0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_caster_err_proto_rawDescOnce sync.Once
+	file_test_full_caster_err_proto_rawDescData []byte
+)
+
+func file_test_full_caster_err_proto_rawDescGZIP() []byte {
+	file_test_full_caster_err_proto_rawDescOnce.Do(func() {
+		file_test_full_caster_err_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_caster_err_proto_rawDesc), len(file_test_full_caster_err_proto_rawDesc)))
+	})
+	return file_test_full_caster_err_proto_rawDescData
+}
+
+var file_test_full_caster_err_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_test_full_caster_err_proto_goTypes = []any{
+	(*SubscriberAccount)(nil), // 0: full.SubscriberAccount
+	(*Subscription)(nil),      // 1: full.Subscription
+	(*ReminderSlot)(nil),      // 2: full.ReminderSlot
+	(*Reminder)(nil),          // 3: full.Reminder
+}
+var file_test_full_caster_err_proto_depIdxs = []int32{
+	0, // 0: full.Subscription.owner:type_name -> full.SubscriberAccount
+	2, // 1: full.Reminder.slots:type_name -> full.ReminderSlot
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_test_full_caster_err_proto_init() }
+func file_test_full_caster_err_proto_init() {
+	if File_test_full_caster_err_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_caster_err_proto_rawDesc), len(file_test_full_caster_err_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_caster_err_proto_goTypes,
+		DependencyIndexes: file_test_full_caster_err_proto_depIdxs,
+		MessageInfos:      file_test_full_caster_err_proto_msgTypes,
+	}.Build()
+	File_test_full_caster_err_proto = out.File
+	file_test_full_caster_err_proto_goTypes = nil
+	file_test_full_caster_err_proto_depIdxs = nil
+}
@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/caster_err.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+)
+
+// MarshalJX encodes SubscriberAccount to JSON using jx.Encoder
+func (p *SubscriberAccount) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetEmail() != "" {
+		e.FieldStart("email")
+		e.Str(p.GetEmail())
+	}
+	if p.GetDisplayName() != "" {
+		e.FieldStart("displayName")
+		e.Str(p.GetDisplayName())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes SubscriberAccount from JSON using jx.Decoder
+func (p *SubscriberAccount) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "email":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Email = v
+		case "displayName":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.DisplayName = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes Subscription to JSON using jx.Encoder
+func (p *Subscription) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetTimeout() != 0 {
+		e.FieldStart("timeout")
+		e.Int64(p.GetTimeout())
+	}
+	if p.GetRetryAfter() != 0 {
+		e.FieldStart("retryAfter")
+		e.Int64(p.GetRetryAfter())
+	}
+	if p.GetOwner() != nil {
+		e.FieldStart("owner")
+		p.GetOwner().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes Subscription from JSON using jx.Decoder
+func (p *Subscription) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "timeout":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Timeout = v
+		case "retryAfter":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.RetryAfter = v
+		case "owner":
+			p.Owner = &SubscriberAccount{}
+			if err := p.Owner.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes ReminderSlot to JSON using jx.Encoder
+func (p *ReminderSlot) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetWhen() != 0 {
+		e.FieldStart("when")
+		e.Int64(p.GetWhen())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ReminderSlot from JSON using jx.Decoder
+func (p *ReminderSlot) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "when":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.When = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes Reminder to JSON using jx.Encoder
+func (p *Reminder) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if len(p.GetSlots()) > 0 {
+		e.FieldStart("slots")
+		e.ArrStart()
+		for _, v := range p.GetSlots() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if p.GetWhen() != 0 {
+		e.FieldStart("when")
+		e.Int32(p.GetWhen())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes Reminder from JSON using jx.Decoder
+func (p *Reminder) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "slots":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &ReminderSlot{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Slots = append(p.Slots, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "when":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.When = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,876 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/caster_err.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	cast "github.com/yaroher/protoc-gen-go-plain/cast"
+	plainequal "github.com/yaroher/protoc-gen-go-plain/plainequal"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	sync "sync"
+	time "time"
+)
+
+type SubscriptionPlain struct {
+	Id               string        `json:"id"`
+	Timeout          time.Duration `json:"timeout"`
+	RetryAfter       time.Duration `json:"retryAfter"`
+	OwnerEmail       Email         `json:"ownerEmail"`       // origin: embed, empath: owner.email
+	OwnerDisplayName string        `json:"ownerDisplayName"` // origin: embed, empath: owner.display_name
+}
+
+// SubscriptionPlainCasters contains type casters for SubscriptionPlain
+type SubscriptionPlainCasters struct {
+	TimeoutToPlain    cast.CasterErr[int64, time.Duration]
+	TimeoutToPb       cast.CasterErr[time.Duration, int64]
+	RetryAfterToPlain cast.Caster[int64, time.Duration]
+	RetryAfterToPb    cast.Caster[time.Duration, int64]
+	OwnerEmailToPlain cast.CasterErr[string, Email]
+	OwnerEmailToPb    cast.CasterErr[Email, string]
+}
+
+// IntoPlain converts protobuf message to plain struct.
+// Panics if a caster fails, use IntoPlainE to handle the error.
+func (pb *Subscription) IntoPlain(c *SubscriptionPlainCasters) *SubscriptionPlain {
+	p, err := pb.IntoPlainE(c)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// IntoPlainE converts protobuf message to plain struct.
+// Returns *cast.FieldError if a caster fails.
+func (pb *Subscription) IntoPlainE(c *SubscriptionPlainCasters) (*SubscriptionPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	p := &SubscriptionPlain{}
+	var _err error
+
+	p.Id = pb.Id
+	p.Timeout = cast.TryCast(c.TimeoutToPlain, pb.Timeout, "timeout", &_err)
+	p.RetryAfter = c.RetryAfterToPlain.Cast(pb.RetryAfter)
+	// OwnerEmail from owner.email
+	if pb.GetOwner() != nil {
+		p.OwnerEmail = cast.TryCast(c.OwnerEmailToPlain, pb.GetOwner().GetEmail(), "owner.email", &_err)
+	}
+	// OwnerDisplayName from owner.display_name
+	if pb.GetOwner() != nil {
+		p.OwnerDisplayName = pb.GetOwner().GetDisplayName()
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return p, nil
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails, use IntoPbE to handle the error.
+func (p *SubscriptionPlain) IntoPb(c *SubscriptionPlainCasters) *Subscription {
+	pb, err := p.IntoPbE(c)
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError if a caster fails.
+func (p *SubscriptionPlain) IntoPbE(c *SubscriptionPlainCasters) (*Subscription, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &Subscription{}
+	var _err error
+
+	pb.Id = p.Id
+	pb.Timeout = cast.TryCast(c.TimeoutToPb, p.Timeout, "timeout", &_err)
+	pb.RetryAfter = c.RetryAfterToPb.Cast(p.RetryAfter)
+	// OwnerEmail -> owner.email
+	if pb.Owner == nil {
+		pb.Owner = &SubscriberAccount{}
+	}
+	pb.Owner.Email = cast.TryCast(c.OwnerEmailToPb, p.OwnerEmail, "owner.email", &_err)
+	// OwnerDisplayName -> owner.display_name
+	if p.OwnerDisplayName != "" {
+		if pb.Owner == nil {
+			pb.Owner = &SubscriberAccount{}
+		}
+		pb.Owner.DisplayName = p.OwnerDisplayName
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// subscriptionPlainMergePaths are the fields of Subscription covered by SubscriptionPlain
+var subscriptionPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4, 1},
+	{4, 2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *SubscriptionPlain) IntoPbMerge(dst *Subscription, c *SubscriptionPlainCasters) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE(c)
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, subscriptionPlainMergePaths...)
+	return nil
+}
+
+// subscriptionPlainFieldPaths link the JSON names of SubscriptionPlain to field-mask paths of Subscription
+var subscriptionPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "timeout", Path: "timeout"},
+	{Name: "retryAfter", Path: "retry_after"},
+	{Name: "ownerEmail", Path: "owner.email"},
+	{Name: "ownerDisplayName", Path: "owner.display_name"},
+}
+
+// SubscriptionPlainFieldMask builds a field mask of Subscription from JSON names of SubscriptionPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func SubscriptionPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(subscriptionPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// SubscriptionPlainFieldNames translates a field mask of Subscription into JSON names of SubscriptionPlain.
+// A path to an embedded message yields all fields flattened from it.
+func SubscriptionPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(subscriptionPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Subscription, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *SubscriptionPlain) ApplyToPb(pb *Subscription, mask *fieldmaskpb.FieldMask, c *SubscriptionPlainCasters) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), subscriptionPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE(c)
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Subscription) IntoPlainMasked(mask *fieldmaskpb.FieldMask, c *SubscriptionPlainCasters) (*SubscriptionPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), subscriptionPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Subscription{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlainE(c)
+}
+
+// MarshalJX encodes SubscriptionPlain to JSON using jx.Encoder
+func (p *SubscriptionPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	e.FieldStart("timeout")
+	e.Int64(int64(p.Timeout))
+	e.FieldStart("retryAfter")
+	e.Int64(int64(p.RetryAfter))
+	e.FieldStart("ownerEmail")
+	e.Str(string(p.OwnerEmail))
+	if p.OwnerDisplayName != "" {
+		e.FieldStart("ownerDisplayName")
+		e.Str(p.OwnerDisplayName)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *SubscriptionPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes SubscriptionPlain from JSON using jx.Decoder
+func (p *SubscriptionPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "timeout":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Timeout = time.Duration(v)
+		case "retryAfter":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.RetryAfter = time.Duration(v)
+		case "ownerEmail":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.OwnerEmail = Email(v)
+		case "ownerDisplayName":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.OwnerDisplayName = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *SubscriptionPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// subscriptionPlainReflect describes SubscriptionPlain as message full.plain.SubscriptionPlain
+var subscriptionPlainReflect = plainreflect.NewMessageInfo(file_test_full_caster_err_proto_plain, "SubscriptionPlain",
+	plainreflect.Scalar(func(p *SubscriptionPlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *SubscriptionPlain) *string { return &p.OwnerDisplayName }),
+)
+
+// ProtoReflect returns the reflective view of SubscriptionPlain backed by the struct
+func (p *SubscriptionPlain) ProtoReflect() protoreflect.Message {
+	return subscriptionPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of SubscriptionPlain
+func (p *SubscriptionPlain) Clone() *SubscriptionPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &SubscriptionPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of SubscriptionPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetSubscriptionPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *SubscriptionPlain) CopyTo(dst *SubscriptionPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Id = p.Id
+	dst.Timeout = p.Timeout
+	dst.RetryAfter = p.RetryAfter
+	dst.OwnerEmail = p.OwnerEmail
+	dst.OwnerDisplayName = p.OwnerDisplayName
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *SubscriptionPlain) Equal(other *SubscriptionPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if !plainequal.Equal(p.Timeout, other.Timeout) {
+		return false
+	}
+	if !plainequal.Equal(p.RetryAfter, other.RetryAfter) {
+		return false
+	}
+	if !plainequal.Equal(p.OwnerEmail, other.OwnerEmail) {
+		return false
+	}
+	if p.OwnerDisplayName != other.OwnerDisplayName {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of SubscriptionPlain rows, in the order of ScanRow and Values
+func (p *SubscriptionPlain) Columns() []string {
+	return []string{
+		"id",
+		"timeout",
+		"retry_after",
+		"owner_email",
+		"owner_display_name",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into SubscriptionPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *SubscriptionPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Timeout,
+		&p.RetryAfter,
+		&p.OwnerEmail,
+		&p.OwnerDisplayName,
+	)
+}
+
+// Values returns the column values of SubscriptionPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *SubscriptionPlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Timeout,
+		p.RetryAfter,
+		p.OwnerEmail,
+		p.OwnerDisplayName,
+	}
+}
+
+// subscriptionPlainPool is a sync.Pool for SubscriptionPlain objects
+var subscriptionPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &SubscriptionPlain{}
+	},
+}
+
+// GetSubscriptionPlain returns a SubscriptionPlain from the pool
+func GetSubscriptionPlain() *SubscriptionPlain {
+	return subscriptionPlainPool.Get().(*SubscriptionPlain)
+}
+
+// PutSubscriptionPlain returns a SubscriptionPlain to the pool after resetting it
+func PutSubscriptionPlain(p *SubscriptionPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	subscriptionPlainPool.Put(p)
+}
+
+// Reset clears all fields in SubscriptionPlain for reuse
+func (p *SubscriptionPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Id = ""
+	p.Timeout = 0
+	p.RetryAfter = 0
+	p.OwnerEmail = ""
+	p.OwnerDisplayName = ""
+}
+
+type ReminderPlain struct {
+	Slots []ReminderSlotsItemPlain `json:"slots"` // origin: embed, empath: slots
+	When  time.Month               `json:"when"`
+}
+
+// ReminderPlainCasters contains type casters for ReminderPlain
+type ReminderPlainCasters struct {
+	SlotsWhenToPlain cast.CasterErr[int64, time.Duration]
+	SlotsWhenToPb    cast.CasterErr[time.Duration, int64]
+	WhenToPlain      cast.CasterErr[int32, time.Month]
+	WhenToPb         cast.CasterErr[time.Month, int32]
+}
+
+// IntoPlain converts protobuf message to plain struct.
+// Panics if a caster fails, use IntoPlainE to handle the error.
+func (pb *Reminder) IntoPlain(c *ReminderPlainCasters) *ReminderPlain {
+	p, err := pb.IntoPlainE(c)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// IntoPlainE converts protobuf message to plain struct.
+// Returns *cast.FieldError if a caster fails.
+func (pb *Reminder) IntoPlainE(c *ReminderPlainCasters) (*ReminderPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	p := &ReminderPlain{}
+	var _err error
+
+	// Slots from slots
+	if pb.GetSlots() != nil {
+		p.Slots = make([]ReminderSlotsItemPlain, len(pb.GetSlots()))
+		for i, _elem := range pb.GetSlots() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.Slots[i], _elem
+			p.When = cast.TryCast(c.SlotsWhenToPlain, pb.When, "when", &_err)
+		}
+	}
+	p.When = cast.TryCast(c.WhenToPlain, pb.When, "when", &_err)
+	if _err != nil {
+		return nil, _err
+	}
+	return p, nil
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails, use IntoPbE to handle the error.
+func (p *ReminderPlain) IntoPb(c *ReminderPlainCasters) *Reminder {
+	pb, err := p.IntoPbE(c)
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError if a caster fails.
+func (p *ReminderPlain) IntoPbE(c *ReminderPlainCasters) (*Reminder, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &Reminder{}
+	var _err error
+
+	// Slots -> slots
+	if len(p.Slots) > 0 {
+		_items := make([]*ReminderSlot, len(p.Slots))
+		for i := range p.Slots {
+			_items[i] = &ReminderSlot{}
+			p, pb := &p.Slots[i], _items[i]
+			pb.When = cast.TryCast(c.SlotsWhenToPb, p.When, "when", &_err)
+		}
+		pb.Slots = _items
+	}
+	pb.When = cast.TryCast(c.WhenToPb, p.When, "when", &_err)
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// reminderPlainMergePaths are the fields of Reminder covered by ReminderPlain
+var reminderPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1, 1},
+	{2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *ReminderPlain) IntoPbMerge(dst *Reminder, c *ReminderPlainCasters) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE(c)
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, reminderPlainMergePaths...)
+	return nil
+}
+
+// reminderPlainFieldPaths link the JSON names of ReminderPlain to field-mask paths of Reminder
+var reminderPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "slots", Path: "slots"},
+	{Name: "when", Path: "when"},
+}
+
+// ReminderPlainFieldMask builds a field mask of Reminder from JSON names of ReminderPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func ReminderPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(reminderPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// ReminderPlainFieldNames translates a field mask of Reminder into JSON names of ReminderPlain.
+// A path to an embedded message yields all fields flattened from it.
+func ReminderPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(reminderPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Reminder, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *ReminderPlain) ApplyToPb(pb *Reminder, mask *fieldmaskpb.FieldMask, c *ReminderPlainCasters) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), reminderPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE(c)
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Reminder) IntoPlainMasked(mask *fieldmaskpb.FieldMask, c *ReminderPlainCasters) (*ReminderPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), reminderPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Reminder{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlainE(c)
+}
+
+// MarshalJX encodes ReminderPlain to JSON using jx.Encoder
+func (p *ReminderPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if len(p.Slots) > 0 {
+		e.FieldStart("slots")
+		e.ArrStart()
+		for _, v := range p.Slots {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("when")
+	e.Int32(int32(p.When))
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *ReminderPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes ReminderPlain from JSON using jx.Decoder
+func (p *ReminderPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "slots":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v ReminderSlotsItemPlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Slots = append(p.Slots, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "when":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.When = time.Month(v)
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *ReminderPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// reminderPlainReflect describes ReminderPlain as message full.plain.ReminderPlain
+var reminderPlainReflect = plainreflect.NewMessageInfo(file_test_full_caster_err_proto_plain, "ReminderPlain",
+	plainreflect.ValueList(func(p *ReminderPlain) *[]ReminderSlotsItemPlain { return &p.Slots }),
+)
+
+// ProtoReflect returns the reflective view of ReminderPlain backed by the struct
+func (p *ReminderPlain) ProtoReflect() protoreflect.Message {
+	return reminderPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of ReminderPlain
+func (p *ReminderPlain) Clone() *ReminderPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &ReminderPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of ReminderPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetReminderPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *ReminderPlain) CopyTo(dst *ReminderPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	if cap(dst.Slots) < len(p.Slots) {
+		dst.Slots = make([]ReminderSlotsItemPlain, len(p.Slots))
+	} else {
+		dst.Slots = dst.Slots[:len(p.Slots)]
+	}
+	for i := range p.Slots {
+		p.Slots[i].CopyTo(&dst.Slots[i])
+	}
+	dst.When = p.When
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *ReminderPlain) Equal(other *ReminderPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if len(p.Slots) != len(other.Slots) {
+		return false
+	}
+	for i := range p.Slots {
+		if !p.Slots[i].Equal(&other.Slots[i]) {
+			return false
+		}
+	}
+	if !plainequal.Equal(p.When, other.When) {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of ReminderPlain rows, in the order of ScanRow and Values
+func (p *ReminderPlain) Columns() []string {
+	return []string{
+		"slots",
+		"when",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into ReminderPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *ReminderPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		plainsql.JSON(&p.Slots),
+		&p.When,
+	)
+}
+
+// Values returns the column values of ReminderPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *ReminderPlain) Values() []any {
+	return []any{
+		plainsql.JSON(&p.Slots),
+		p.When,
+	}
+}
+
+// reminderPlainPool is a sync.Pool for ReminderPlain objects
+var reminderPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &ReminderPlain{}
+	},
+}
+
+// GetReminderPlain returns a ReminderPlain from the pool
+func GetReminderPlain() *ReminderPlain {
+	return reminderPlainPool.Get().(*ReminderPlain)
+}
+
+// PutReminderPlain returns a ReminderPlain to the pool after resetting it
+func PutReminderPlain(p *ReminderPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	reminderPlainPool.Put(p)
+}
+
+// Reset clears all fields in ReminderPlain for reuse
+func (p *ReminderPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Slots = p.Slots[:0]
+	p.When = 0
+}
+
+// ReminderSlotsItemPlain holds flattened fields of full.ReminderSlot for repeated embed slots
+type ReminderSlotsItemPlain struct {
+	When time.Duration `json:"when"`
+}
+
+// MarshalJX encodes ReminderSlotsItemPlain to JSON using jx.Encoder
+func (p *ReminderSlotsItemPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	e.FieldStart("when")
+	e.Int64(int64(p.When))
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *ReminderSlotsItemPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes ReminderSlotsItemPlain from JSON using jx.Decoder
+func (p *ReminderSlotsItemPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "when":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.When = time.Duration(v)
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *ReminderSlotsItemPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// reminderSlotsItemPlainReflect describes ReminderSlotsItemPlain as message full.plain.ReminderSlotsItemPlain
+var reminderSlotsItemPlainReflect = plainreflect.NewMessageInfo[ReminderSlotsItemPlain](file_test_full_caster_err_proto_plain, "ReminderSlotsItemPlain")
+
+// ProtoReflect returns the reflective view of ReminderSlotsItemPlain backed by the struct
+func (p *ReminderSlotsItemPlain) ProtoReflect() protoreflect.Message {
+	return reminderSlotsItemPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of ReminderSlotsItemPlain
+func (p *ReminderSlotsItemPlain) Clone() *ReminderSlotsItemPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &ReminderSlotsItemPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of ReminderSlotsItemPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetReminderSlotsItemPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *ReminderSlotsItemPlain) CopyTo(dst *ReminderSlotsItemPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.When = p.When
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *ReminderSlotsItemPlain) Equal(other *ReminderSlotsItemPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if !plainequal.Equal(p.When, other.When) {
+		return false
+	}
+	return true
+}
+
+// Reset clears all fields in ReminderSlotsItemPlain for reuse
+func (p *ReminderSlotsItemPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.When = 0
+}
+
+// file_test_full_caster_err_proto_plain_rawDesc is the serialized descriptor of test/full/caster_err_plain.proto,
+// describing the Plain messages of test/full/caster_err.proto in package full.plain
+const file_test_full_caster_err_proto_plain_rawDesc = "" +
+	"\n test/full/caster_err_plain.proto\x12\nfull.plain\"Q\n\x11SubscriptionPl" +
+	"ain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12,\n\x12owner_display_name\x18\x05 \x01(\tR\x10ownerDisplayNam" +
+	"e\"I\n\rReminderPlain\x128\n\x05slots\x18\x01 \x03(\v2\".full.plain.ReminderSlotsItem" +
+	"PlainR\x05slots\"\x18\n\x16ReminderSlotsItemPlainb\x06proto3"
+
+var file_test_full_caster_err_proto_plain = plainreflect.NewFile("test/full/caster_err_plain.proto", file_test_full_caster_err_proto_plain_rawDesc)
+
+func init() { file_test_full_caster_err_proto_plain_init() }
+
+func file_test_full_caster_err_proto_plain_init() {
+	file_test_full_caster_err_proto_plain.Init()
+}
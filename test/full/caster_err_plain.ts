@@ -0,0 +1,20 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/caster_err.proto
+
+export interface SubscriptionPlain {
+  id?: string;
+  timeout?: number;
+  retryAfter?: number;
+  ownerEmail?: string;
+  ownerDisplayName?: string;
+}
+
+export interface ReminderPlain {
+  slots?: ReminderSlotsItemPlain[];
+  when?: number;
+}
+
+/** ReminderSlotsItemPlain holds flattened fields of full.ReminderSlot for repeated embed slots */
+export interface ReminderSlotsItemPlain {
+  when?: number;
+}
@@ -0,0 +1,118 @@
+package full_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/cast"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+)
+
+var errNegativeTimeout = errors.New("negative timeout")
+
+func subscriptionCasters() *full.SubscriptionPlainCasters {
+	return &full.SubscriptionPlainCasters{
+		TimeoutToPlain: cast.CasterErrFn(func(ms int64) (time.Duration, error) {
+			if ms < 0 {
+				return 0, errNegativeTimeout
+			}
+			return time.Duration(ms) * time.Millisecond, nil
+		}),
+		TimeoutToPb: cast.CasterErrFn(func(d time.Duration) (int64, error) {
+			return d.Milliseconds(), nil
+		}),
+		RetryAfterToPlain: cast.CasterFn(func(s int64) time.Duration { return time.Duration(s) * time.Second }),
+		RetryAfterToPb:    cast.CasterFn(func(d time.Duration) int64 { return int64(d / time.Second) }),
+		OwnerEmailToPlain: cast.CasterErrFn(func(s string) (full.Email, error) {
+			if !strings.Contains(s, "@") {
+				return "", fmt.Errorf("invalid email %q", s)
+			}
+			return full.Email(s), nil
+		}),
+		OwnerEmailToPb: cast.CasterErrFn(func(e full.Email) (string, error) { return string(e), nil }),
+	}
+}
+
+func TestCasterErr_Roundtrip(t *testing.T) {
+	original := &full.Subscription{
+		Id:         "sub-1",
+		Timeout:    1500,
+		RetryAfter: 30,
+		Owner:      &full.SubscriberAccount{Email: "jane@example.com", DisplayName: "Jane"},
+	}
+	c := subscriptionCasters()
+
+	plain, err := original.IntoPlainE(c)
+	require.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, plain.Timeout)
+	assert.Equal(t, 30*time.Second, plain.RetryAfter)
+	assert.Equal(t, full.Email("jane@example.com"), plain.OwnerEmail)
+
+	restored, err := plain.IntoPbE(c)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(original, restored), "Subscription roundtrip failed")
+}
+
+func TestCasterErr_FieldPath(t *testing.T) {
+	c := subscriptionCasters()
+
+	_, err := (&full.Subscription{Timeout: -1, Owner: &full.SubscriberAccount{Email: "a@b"}}).IntoPlainE(c)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errNegativeTimeout)
+
+	var fieldErr *cast.FieldError
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "timeout", fieldErr.Path)
+
+	_, err = (&full.Subscription{Owner: &full.SubscriberAccount{Email: "broken"}}).IntoPlainE(c)
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "owner.email", fieldErr.Path)
+	assert.Contains(t, err.Error(), "owner.email")
+}
+
+func TestCasterErr_IntoPlainPanics(t *testing.T) {
+	c := subscriptionCasters()
+	assert.Panics(t, func() {
+		(&full.Subscription{Timeout: -1}).IntoPlain(c)
+	})
+}
+
+func TestCasterErr_RowFieldSharingParentName(t *testing.T) {
+	c := &full.ReminderPlainCasters{
+		SlotsWhenToPlain: cast.CasterErrFn(func(s int64) (time.Duration, error) { return time.Duration(s) * time.Second, nil }),
+		SlotsWhenToPb:    cast.CasterErrFn(func(d time.Duration) (int64, error) { return int64(d / time.Second), nil }),
+		WhenToPlain: cast.CasterErrFn(func(m int32) (time.Month, error) {
+			if m < 1 || m > 12 {
+				return 0, fmt.Errorf("invalid month %d", m)
+			}
+			return time.Month(m), nil
+		}),
+		WhenToPb: cast.CasterErrFn(func(m time.Month) (int32, error) { return int32(m), nil }),
+	}
+	original := &full.Reminder{
+		Slots: []*full.ReminderSlot{{When: 90}, {When: 3600}},
+		When:  3,
+	}
+
+	plain, err := original.IntoPlainE(c)
+	require.NoError(t, err)
+	assert.Equal(t, time.March, plain.When)
+	require.Len(t, plain.Slots, 2)
+	assert.Equal(t, 90*time.Second, plain.Slots[0].When)
+	assert.Equal(t, time.Hour, plain.Slots[1].When)
+
+	restored, err := plain.IntoPbE(c)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(original, restored), "Reminder roundtrip failed")
+
+	_, err = (&full.Reminder{When: 13}).IntoPlainE(c)
+	var fieldErr *cast.FieldError
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "when", fieldErr.Path)
+}
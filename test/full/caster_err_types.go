@@ -0,0 +1,11 @@
+package full
+
+import "strings"
+
+// Email is a validated e-mail address, used by caster_err.proto overrides
+type Email string
+
+// Equal compares addresses ignoring case, generated Equal methods use it
+func (e Email) Equal(other Email) bool {
+	return strings.EqualFold(string(e), string(other))
+}
@@ -0,0 +1,696 @@
+// Non-embedded oneofs: a case field plus one field per variant
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/choice.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChoiceLevel int32
+
+const (
+	ChoiceLevel_CHOICE_LEVEL_UNSPECIFIED ChoiceLevel = 0
+	ChoiceLevel_CHOICE_LEVEL_LOW         ChoiceLevel = 1
+	ChoiceLevel_CHOICE_LEVEL_HIGH        ChoiceLevel = 2
+)
+
+// Enum value maps for ChoiceLevel.
+var (
+	ChoiceLevel_name = map[int32]string{
+		0: "CHOICE_LEVEL_UNSPECIFIED",
+		1: "CHOICE_LEVEL_LOW",
+		2: "CHOICE_LEVEL_HIGH",
+	}
+	ChoiceLevel_value = map[string]int32{
+		"CHOICE_LEVEL_UNSPECIFIED": 0,
+		"CHOICE_LEVEL_LOW":         1,
+		"CHOICE_LEVEL_HIGH":        2,
+	}
+)
+
+func (x ChoiceLevel) Enum() *ChoiceLevel {
+	p := new(ChoiceLevel)
+	*p = x
+	return p
+}
+
+func (x ChoiceLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChoiceLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_test_full_choice_proto_enumTypes[0].Descriptor()
+}
+
+func (ChoiceLevel) Type() protoreflect.EnumType {
+	return &file_test_full_choice_proto_enumTypes[0]
+}
+
+func (x ChoiceLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChoiceLevel.Descriptor instead.
+func (ChoiceLevel) EnumDescriptor() ([]byte, []int) {
+	return file_test_full_choice_proto_rawDescGZIP(), []int{0}
+}
+
+type ChoiceTag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChoiceTag) Reset() {
+	*x = ChoiceTag{}
+	mi := &file_test_full_choice_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoiceTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoiceTag) ProtoMessage() {}
+
+func (x *ChoiceTag) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_choice_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoiceTag.ProtoReflect.Descriptor instead.
+func (*ChoiceTag) Descriptor() ([]byte, []int) {
+	return file_test_full_choice_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChoiceTag) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ChoiceFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChoiceFile) Reset() {
+	*x = ChoiceFile{}
+	mi := &file_test_full_choice_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoiceFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoiceFile) ProtoMessage() {}
+
+func (x *ChoiceFile) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_choice_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoiceFile.ProtoReflect.Descriptor instead.
+func (*ChoiceFile) Descriptor() ([]byte, []int) {
+	return file_test_full_choice_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChoiceFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ChoiceFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ChoiceRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int32                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChoiceRange) Reset() {
+	*x = ChoiceRange{}
+	mi := &file_test_full_choice_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoiceRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoiceRange) ProtoMessage() {}
+
+func (x *ChoiceRange) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_choice_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoiceRange.ProtoReflect.Descriptor instead.
+func (*ChoiceRange) Descriptor() ([]byte, []int) {
+	return file_test_full_choice_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChoiceRange) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ChoiceRange) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type ChoiceSettings struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Kept as-is when ChoiceSettings is embedded
+	//
+	// Types that are valid to be assigned to Limit:
+	//
+	//	*ChoiceSettings_MaxItems
+	//	*ChoiceSettings_Range
+	Limit         isChoiceSettings_Limit `protobuf_oneof:"limit"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChoiceSettings) Reset() {
+	*x = ChoiceSettings{}
+	mi := &file_test_full_choice_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoiceSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoiceSettings) ProtoMessage() {}
+
+func (x *ChoiceSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_choice_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoiceSettings.ProtoReflect.Descriptor instead.
+func (*ChoiceSettings) Descriptor() ([]byte, []int) {
+	return file_test_full_choice_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChoiceSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ChoiceSettings) GetLimit() isChoiceSettings_Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *ChoiceSettings) GetMaxItems() int32 {
+	if x != nil {
+		if x, ok := x.Limit.(*ChoiceSettings_MaxItems); ok {
+			return x.MaxItems
+		}
+	}
+	return 0
+}
+
+func (x *ChoiceSettings) GetRange() *ChoiceRange {
+	if x != nil {
+		if x, ok := x.Limit.(*ChoiceSettings_Range); ok {
+			return x.Range
+		}
+	}
+	return nil
+}
+
+type isChoiceSettings_Limit interface {
+	isChoiceSettings_Limit()
+}
+
+type ChoiceSettings_MaxItems struct {
+	MaxItems int32 `protobuf:"varint,2,opt,name=max_items,json=maxItems,proto3,oneof"`
+}
+
+type ChoiceSettings_Range struct {
+	Range *ChoiceRange `protobuf:"bytes,3,opt,name=range,proto3,oneof"`
+}
+
+func (*ChoiceSettings_MaxItems) isChoiceSettings_Limit() {}
+
+func (*ChoiceSettings_Range) isChoiceSettings_Limit() {}
+
+type ChoiceDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*ChoiceDocument_Url
+	//	*ChoiceDocument_RawData
+	//	*ChoiceDocument_Offset
+	//	*ChoiceDocument_Level
+	//	*ChoiceDocument_File
+	//	*ChoiceDocument_Price
+	//	*ChoiceDocument_Tag
+	//	*ChoiceDocument_Window
+	//	*ChoiceDocument_Range
+	Source isChoiceDocument_Source `protobuf_oneof:"source"`
+	// Types that are valid to be assigned to Status:
+	//
+	//	*ChoiceDocument_StatusLevel
+	//	*ChoiceDocument_StatusText
+	Status        isChoiceDocument_Status `protobuf_oneof:"status"`
+	Settings      *ChoiceSettings         `protobuf:"bytes,30,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChoiceDocument) Reset() {
+	*x = ChoiceDocument{}
+	mi := &file_test_full_choice_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChoiceDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoiceDocument) ProtoMessage() {}
+
+func (x *ChoiceDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_choice_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoiceDocument.ProtoReflect.Descriptor instead.
+func (*ChoiceDocument) Descriptor() ([]byte, []int) {
+	return file_test_full_choice_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChoiceDocument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChoiceDocument) GetSource() isChoiceDocument_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ChoiceDocument) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Source.(*ChoiceDocument_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *ChoiceDocument) GetRawData() []byte {
+	if x != nil {
+		if x, ok := x.Source.(*ChoiceDocument_RawData); ok {
+			return x.RawData
+		}
+	}
+	return nil
+}
+
+func (x *ChoiceDocument) GetOffset() int64 {
+	if x != nil {
+		if x, ok := x.Source.(*ChoiceDocument_Offset); ok {
+			return x.Offset
+		}
+	}
+	return 0
+}
+
+func (x *ChoiceDocument) GetLevel() ChoiceLevel {
+	if x != nil {
+		if x, ok := x.Source.(*ChoiceDocument_Level); ok {
+			return x.Level
+		}
+	}
+	return ChoiceLevel_CHOICE_LEVEL_UNSPECIFIED
+}
+
+func (x *ChoiceDocument) GetFile() *ChoiceFile {
+	if x != nil {
+		if x, ok := x.Source.(*ChoiceDocument_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *ChoiceDocument) GetPrice() *common.Money {
+	if x != nil {
+		if x, ok := x.Source.(*ChoiceDocument_Price); ok {
+			return x.Price
+		}
+	}
+	return nil
+}
+
+func (x *ChoiceDocument) GetTag() *ChoiceTag {
+	if x != nil {
+		if x, ok := x.Source.(*ChoiceDocument_Tag); ok {
+			return x.Tag
+		}
+	}
+	return nil
+}
+
+func (x *ChoiceDocument) GetWindow() *ChoiceRange {
+	if x != nil {
+		if x, ok := x.Source.(*ChoiceDocument_Window); ok {
+			return x.Window
+		}
+	}
+	return nil
+}
+
+func (x *ChoiceDocument) GetRange() *ChoiceRange {
+	if x != nil {
+		if x, ok := x.Source.(*ChoiceDocument_Range); ok {
+			return x.Range
+		}
+	}
+	return nil
+}
+
+func (x *ChoiceDocument) GetStatus() isChoiceDocument_Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ChoiceDocument) GetStatusLevel() ChoiceLevel {
+	if x != nil {
+		if x, ok := x.Status.(*ChoiceDocument_StatusLevel); ok {
+			return x.StatusLevel
+		}
+	}
+	return ChoiceLevel_CHOICE_LEVEL_UNSPECIFIED
+}
+
+func (x *ChoiceDocument) GetStatusText() string {
+	if x != nil {
+		if x, ok := x.Status.(*ChoiceDocument_StatusText); ok {
+			return x.StatusText
+		}
+	}
+	return ""
+}
+
+func (x *ChoiceDocument) GetSettings() *ChoiceSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type isChoiceDocument_Source interface {
+	isChoiceDocument_Source()
+}
+
+type ChoiceDocument_Url struct {
+	Url string `protobuf:"bytes,10,opt,name=url,proto3,oneof"`
+}
+
+type ChoiceDocument_RawData struct {
+	RawData []byte `protobuf:"bytes,11,opt,name=raw_data,json=rawData,proto3,oneof"`
+}
+
+type ChoiceDocument_Offset struct {
+	Offset int64 `protobuf:"varint,12,opt,name=offset,proto3,oneof"`
+}
+
+type ChoiceDocument_Level struct {
+	Level ChoiceLevel `protobuf:"varint,13,opt,name=level,proto3,enum=full.ChoiceLevel,oneof"`
+}
+
+type ChoiceDocument_File struct {
+	File *ChoiceFile `protobuf:"bytes,14,opt,name=file,proto3,oneof"`
+}
+
+type ChoiceDocument_Price struct {
+	Price *common.Money `protobuf:"bytes,15,opt,name=price,proto3,oneof"`
+}
+
+type ChoiceDocument_Tag struct {
+	Tag *ChoiceTag `protobuf:"bytes,16,opt,name=tag,proto3,oneof"`
+}
+
+type ChoiceDocument_Window struct {
+	Window *ChoiceRange `protobuf:"bytes,17,opt,name=window,proto3,oneof"`
+}
+
+type ChoiceDocument_Range struct {
+	// embed is ignored for variants of a non-embedded oneof
+	Range *ChoiceRange `protobuf:"bytes,18,opt,name=range,proto3,oneof"`
+}
+
+func (*ChoiceDocument_Url) isChoiceDocument_Source() {}
+
+func (*ChoiceDocument_RawData) isChoiceDocument_Source() {}
+
+func (*ChoiceDocument_Offset) isChoiceDocument_Source() {}
+
+func (*ChoiceDocument_Level) isChoiceDocument_Source() {}
+
+func (*ChoiceDocument_File) isChoiceDocument_Source() {}
+
+func (*ChoiceDocument_Price) isChoiceDocument_Source() {}
+
+func (*ChoiceDocument_Tag) isChoiceDocument_Source() {}
+
+func (*ChoiceDocument_Window) isChoiceDocument_Source() {}
+
+func (*ChoiceDocument_Range) isChoiceDocument_Source() {}
+
+type isChoiceDocument_Status interface {
+	isChoiceDocument_Status()
+}
+
+type ChoiceDocument_StatusLevel struct {
+	StatusLevel ChoiceLevel `protobuf:"varint,20,opt,name=status_level,json=statusLevel,proto3,enum=full.ChoiceLevel,oneof"`
+}
+
+type ChoiceDocument_StatusText struct {
+	StatusText string `protobuf:"bytes,21,opt,name=status_text,json=statusText,proto3,oneof"`
+}
+
+func (*ChoiceDocument_StatusLevel) isChoiceDocument_Status() {}
+
+func (*ChoiceDocument_StatusText) isChoiceDocument_Status() {}
+
+var File_test_full_choice_proto protoreflect.FileDescriptor
+
+const file_test_full_choice_proto_rawDesc = "" +
+	"\n" +
+	"\x16test/full/choice.proto\x12\x04full\x1a\x15goplain/goplain.proto\x1a\x1ctest/full/common/money.proto\")\n" +
+	"\tChoiceTag\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value:\x06\x82\xa6\x1d\x02\x10\x01\"<\n" +
+	"\n" +
+	"ChoiceFile\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size:\x06\x82\xa6\x1d\x02\b\x01\"1\n" +
+	"\vChoiceRange\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x05R\x02to\"}\n" +
+	"\x0eChoiceSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1d\n" +
+	"\tmax_items\x18\x02 \x01(\x05H\x00R\bmaxItems\x12)\n" +
+	"\x05range\x18\x03 \x01(\v2\x11.full.ChoiceRangeH\x00R\x05rangeB\a\n" +
+	"\x05limit\"\xb0\x04\n" +
+	"\x0eChoiceDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x03url\x18\n" +
+	" \x01(\tH\x00R\x03url\x12\x1b\n" +
+	"\braw_data\x18\v \x01(\fH\x00R\arawData\x12\x18\n" +
+	"\x06offset\x18\f \x01(\x03H\x00R\x06offset\x12)\n" +
+	"\x05level\x18\r \x01(\x0e2\x11.full.ChoiceLevelH\x00R\x05level\x12&\n" +
+	"\x04file\x18\x0e \x01(\v2\x10.full.ChoiceFileH\x00R\x04file\x12*\n" +
+	"\x05price\x18\x0f \x01(\v2\x12.full.common.MoneyH\x00R\x05price\x12#\n" +
+	"\x03tag\x18\x10 \x01(\v2\x0f.full.ChoiceTagH\x00R\x03tag\x123\n" +
+	"\x06window\x18\x11 \x01(\v2\x11.full.ChoiceRangeB\x06\x82\xa6\x1d\x02\x10\x01H\x00R\x06window\x121\n" +
+	"\x05range\x18\x12 \x01(\v2\x11.full.ChoiceRangeB\x06\x82\xa6\x1d\x02 \x01H\x00R\x05range\x12>\n" +
+	"\fstatus_level\x18\x14 \x01(\x0e2\x11.full.ChoiceLevelB\x06\x82\xa6\x1d\x028\x01H\x01R\vstatusLevel\x12!\n" +
+	"\vstatus_text\x18\x15 \x01(\tH\x01R\n" +
+	"statusText\x128\n" +
+	"\bsettings\x18\x1e \x01(\v2\x14.full.ChoiceSettingsB\x06\x82\xa6\x1d\x02 \x01R\bsettings:\x06\x82\xa6\x1d\x02\b\x01B\b\n" +
+	"\x06sourceB\b\n" +
+	"\x06status*X\n" +
+	"\vChoiceLevel\x12\x1c\n" +
+	"\x18CHOICE_LEVEL_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10CHOICE_LEVEL_LOW\x10\x01\x12\x15\n" +
+	"\x11CHOICE_LEVEL_HIGH\x10\x02B2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_choice_proto_rawDescOnce sync.Once
+	file_test_full_choice_proto_rawDescData []byte
+)
+
+func file_test_full_choice_proto_rawDescGZIP() []byte {
+	file_test_full_choice_proto_rawDescOnce.Do(func() {
+		file_test_full_choice_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_choice_proto_rawDesc), len(file_test_full_choice_proto_rawDesc)))
+	})
+	return file_test_full_choice_proto_rawDescData
+}
+
+var file_test_full_choice_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_test_full_choice_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_test_full_choice_proto_goTypes = []any{
+	(ChoiceLevel)(0),       // 0: full.ChoiceLevel
+	(*ChoiceTag)(nil),      // 1: full.ChoiceTag
+	(*ChoiceFile)(nil),     // 2: full.ChoiceFile
+	(*ChoiceRange)(nil),    // 3: full.ChoiceRange
+	(*ChoiceSettings)(nil), // 4: full.ChoiceSettings
+	(*ChoiceDocument)(nil), // 5: full.ChoiceDocument
+	(*common.Money)(nil),   // 6: full.common.Money
+}
+var file_test_full_choice_proto_depIdxs = []int32{
+	3, // 0: full.ChoiceSettings.range:type_name -> full.ChoiceRange
+	0, // 1: full.ChoiceDocument.level:type_name -> full.ChoiceLevel
+	2, // 2: full.ChoiceDocument.file:type_name -> full.ChoiceFile
+	6, // 3: full.ChoiceDocument.price:type_name -> full.common.Money
+	1, // 4: full.ChoiceDocument.tag:type_name -> full.ChoiceTag
+	3, // 5: full.ChoiceDocument.window:type_name -> full.ChoiceRange
+	3, // 6: full.ChoiceDocument.range:type_name -> full.ChoiceRange
+	0, // 7: full.ChoiceDocument.status_level:type_name -> full.ChoiceLevel
+	4, // 8: full.ChoiceDocument.settings:type_name -> full.ChoiceSettings
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_test_full_choice_proto_init() }
+func file_test_full_choice_proto_init() {
+	if File_test_full_choice_proto != nil {
+		return
+	}
+	file_test_full_choice_proto_msgTypes[3].OneofWrappers = []any{
+		(*ChoiceSettings_MaxItems)(nil),
+		(*ChoiceSettings_Range)(nil),
+	}
+	file_test_full_choice_proto_msgTypes[4].OneofWrappers = []any{
+		(*ChoiceDocument_Url)(nil),
+		(*ChoiceDocument_RawData)(nil),
+		(*ChoiceDocument_Offset)(nil),
+		(*ChoiceDocument_Level)(nil),
+		(*ChoiceDocument_File)(nil),
+		(*ChoiceDocument_Price)(nil),
+		(*ChoiceDocument_Tag)(nil),
+		(*ChoiceDocument_Window)(nil),
+		(*ChoiceDocument_Range)(nil),
+		(*ChoiceDocument_StatusLevel)(nil),
+		(*ChoiceDocument_StatusText)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_choice_proto_rawDesc), len(file_test_full_choice_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_choice_proto_goTypes,
+		DependencyIndexes: file_test_full_choice_proto_depIdxs,
+		EnumInfos:         file_test_full_choice_proto_enumTypes,
+		MessageInfos:      file_test_full_choice_proto_msgTypes,
+	}.Build()
+	File_test_full_choice_proto = out.File
+	file_test_full_choice_proto_goTypes = nil
+	file_test_full_choice_proto_depIdxs = nil
+}
@@ -0,0 +1,338 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/choice.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+)
+
+// MarshalJX encodes ChoiceTag to JSON using jx.Encoder
+func (p *ChoiceTag) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetValue() != "" {
+		e.FieldStart("value")
+		e.Str(p.GetValue())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ChoiceTag from JSON using jx.Decoder
+func (p *ChoiceTag) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "value":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Value = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes ChoiceFile to JSON using jx.Encoder
+func (p *ChoiceFile) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetPath() != "" {
+		e.FieldStart("path")
+		e.Str(p.GetPath())
+	}
+	if p.GetSize() != 0 {
+		e.FieldStart("size")
+		e.Int64(p.GetSize())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ChoiceFile from JSON using jx.Decoder
+func (p *ChoiceFile) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "path":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Path = v
+		case "size":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Size = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes ChoiceRange to JSON using jx.Encoder
+func (p *ChoiceRange) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetFrom() != 0 {
+		e.FieldStart("from")
+		e.Int32(p.GetFrom())
+	}
+	if p.GetTo() != 0 {
+		e.FieldStart("to")
+		e.Int32(p.GetTo())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ChoiceRange from JSON using jx.Decoder
+func (p *ChoiceRange) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "from":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.From = v
+		case "to":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.To = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes ChoiceSettings to JSON using jx.Encoder
+func (p *ChoiceSettings) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetEnabled() {
+		e.FieldStart("enabled")
+		e.Bool(p.GetEnabled())
+	}
+	switch v := p.GetLimit().(type) {
+	case *ChoiceSettings_MaxItems:
+		e.FieldStart("maxItems")
+		e.Int32(v.MaxItems)
+	case *ChoiceSettings_Range:
+		e.FieldStart("range")
+		v.Range.MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ChoiceSettings from JSON using jx.Decoder
+func (p *ChoiceSettings) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "enabled":
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.Enabled = v
+		case "maxItems":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Limit = &ChoiceSettings_MaxItems{MaxItems: v}
+		case "range":
+			v := &ChoiceRange{}
+			if err := v.UnmarshalJX(d); err != nil {
+				return err
+			}
+			p.Limit = &ChoiceSettings_Range{Range: v}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes ChoiceDocument to JSON using jx.Encoder
+func (p *ChoiceDocument) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetSettings() != nil {
+		e.FieldStart("settings")
+		p.GetSettings().MarshalJX(e)
+	}
+	switch v := p.GetSource().(type) {
+	case *ChoiceDocument_Url:
+		e.FieldStart("url")
+		e.Str(v.Url)
+	case *ChoiceDocument_RawData:
+		e.FieldStart("rawData")
+		e.Base64(v.RawData)
+	case *ChoiceDocument_Offset:
+		e.FieldStart("offset")
+		e.Int64(v.Offset)
+	case *ChoiceDocument_Level:
+		e.FieldStart("level")
+		e.Int32(int32(v.Level))
+	case *ChoiceDocument_File:
+		e.FieldStart("file")
+		v.File.MarshalJX(e)
+	case *ChoiceDocument_Price:
+		e.FieldStart("price")
+		v.Price.MarshalJX(e)
+	case *ChoiceDocument_Tag:
+		e.FieldStart("tag")
+		v.Tag.MarshalJX(e)
+	case *ChoiceDocument_Window:
+		e.FieldStart("window")
+		v.Window.MarshalJX(e)
+	case *ChoiceDocument_Range:
+		e.FieldStart("range")
+		v.Range.MarshalJX(e)
+	}
+	switch v := p.GetStatus().(type) {
+	case *ChoiceDocument_StatusLevel:
+		e.FieldStart("statusLevel")
+		e.Int32(int32(v.StatusLevel))
+	case *ChoiceDocument_StatusText:
+		e.FieldStart("statusText")
+		e.Str(v.StatusText)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ChoiceDocument from JSON using jx.Decoder
+func (p *ChoiceDocument) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "settings":
+			p.Settings = &ChoiceSettings{}
+			if err := p.Settings.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "url":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Source = &ChoiceDocument_Url{Url: v}
+		case "rawData":
+			return d.Skip()
+		case "offset":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Source = &ChoiceDocument_Offset{Offset: v}
+		case "level":
+			ev, err := enumjx.Decode(d, "full.ChoiceLevel", ChoiceLevel_value)
+			if err != nil {
+				return err
+			}
+			p.Source = &ChoiceDocument_Level{Level: ChoiceLevel(ev)}
+		case "file":
+			v := &ChoiceFile{}
+			if err := v.UnmarshalJX(d); err != nil {
+				return err
+			}
+			p.Source = &ChoiceDocument_File{File: v}
+		case "price":
+			v := &common.Money{}
+			if err := v.UnmarshalJX(d); err != nil {
+				return err
+			}
+			p.Source = &ChoiceDocument_Price{Price: v}
+		case "tag":
+			v := &ChoiceTag{}
+			if err := v.UnmarshalJX(d); err != nil {
+				return err
+			}
+			p.Source = &ChoiceDocument_Tag{Tag: v}
+		case "window":
+			v := &ChoiceRange{}
+			if err := v.UnmarshalJX(d); err != nil {
+				return err
+			}
+			p.Source = &ChoiceDocument_Window{Window: v}
+		case "range":
+			v := &ChoiceRange{}
+			if err := v.UnmarshalJX(d); err != nil {
+				return err
+			}
+			p.Source = &ChoiceDocument_Range{Range: v}
+		case "statusLevel":
+			ev, err := enumjx.Decode(d, "full.ChoiceLevel", ChoiceLevel_value)
+			if err != nil {
+				return err
+			}
+			p.Status = &ChoiceDocument_StatusLevel{StatusLevel: ChoiceLevel(ev)}
+		case "statusText":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Status = &ChoiceDocument_StatusText{StatusText: v}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,1681 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/choice.proto
+
+package full
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	sync "sync"
+)
+
+type ChoiceFilePlain struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *ChoiceFile) IntoPlain() *ChoiceFilePlain {
+	if pb == nil {
+		return nil
+	}
+	p := &ChoiceFilePlain{}
+
+	p.Path = pb.Path
+	p.Size = pb.Size
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *ChoiceFilePlain) IntoPb() *ChoiceFile {
+	if p == nil {
+		return nil
+	}
+	pb := &ChoiceFile{}
+
+	pb.Path = p.Path
+	pb.Size = p.Size
+	return pb
+}
+
+// choiceFilePlainMergePaths are the fields of ChoiceFile covered by ChoiceFilePlain
+var choiceFilePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *ChoiceFilePlain) IntoPbMerge(dst *ChoiceFile) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, choiceFilePlainMergePaths...)
+}
+
+// choiceFilePlainFieldPaths link the JSON names of ChoiceFilePlain to field-mask paths of ChoiceFile
+var choiceFilePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "path", Path: "path"},
+	{Name: "size", Path: "size"},
+}
+
+// ChoiceFilePlainFieldMask builds a field mask of ChoiceFile from JSON names of ChoiceFilePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func ChoiceFilePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(choiceFilePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// ChoiceFilePlainFieldNames translates a field mask of ChoiceFile into JSON names of ChoiceFilePlain.
+// A path to an embedded message yields all fields flattened from it.
+func ChoiceFilePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(choiceFilePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of ChoiceFile, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *ChoiceFilePlain) ApplyToPb(pb *ChoiceFile, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), choiceFilePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *ChoiceFile) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*ChoiceFilePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), choiceFilePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &ChoiceFile{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *ChoiceFile) IntoPlainReuse(p *ChoiceFilePlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.Path = pb.Path
+	p.Size = pb.Size
+}
+
+// MarshalJX encodes ChoiceFilePlain to JSON using jx.Encoder
+func (p *ChoiceFilePlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Path != "" {
+		e.FieldStart("path")
+		e.Str(p.Path)
+	}
+	if p.Size != 0 {
+		e.FieldStart("size")
+		e.Int64(p.Size)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *ChoiceFilePlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes ChoiceFilePlain from JSON using jx.Decoder
+func (p *ChoiceFilePlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "path":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Path = v
+		case "size":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Size = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *ChoiceFilePlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes ChoiceFilePlain in the protobuf wire format of ChoiceFile
+func (p *ChoiceFilePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends ChoiceFilePlain encoded in the protobuf wire format of ChoiceFile to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *ChoiceFilePlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Path; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Size; v != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes ChoiceFilePlain from the protobuf wire format of ChoiceFile.
+// Unknown fields are skipped.
+func (p *ChoiceFilePlain) UnmarshalProto(b []byte) error {
+	*p = ChoiceFilePlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Path = v
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Size = int64(v)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// choiceFilePlainReflect describes ChoiceFilePlain as message full.plain.ChoiceFilePlain
+var choiceFilePlainReflect = plainreflect.NewMessageInfo(file_test_full_choice_proto_plain, "ChoiceFilePlain",
+	plainreflect.Scalar(func(p *ChoiceFilePlain) *string { return &p.Path }),
+	plainreflect.Scalar(func(p *ChoiceFilePlain) *int64 { return &p.Size }),
+)
+
+// ProtoReflect returns the reflective view of ChoiceFilePlain backed by the struct
+func (p *ChoiceFilePlain) ProtoReflect() protoreflect.Message {
+	return choiceFilePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of ChoiceFilePlain
+func (p *ChoiceFilePlain) Clone() *ChoiceFilePlain {
+	if p == nil {
+		return nil
+	}
+	dst := &ChoiceFilePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of ChoiceFilePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetChoiceFilePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *ChoiceFilePlain) CopyTo(dst *ChoiceFilePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Path = p.Path
+	dst.Size = p.Size
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *ChoiceFilePlain) Equal(other *ChoiceFilePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Path != other.Path {
+		return false
+	}
+	if p.Size != other.Size {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of ChoiceFilePlain rows, in the order of ScanRow and Values
+func (p *ChoiceFilePlain) Columns() []string {
+	return []string{
+		"path",
+		"size",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into ChoiceFilePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *ChoiceFilePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Path,
+		&p.Size,
+	)
+}
+
+// Values returns the column values of ChoiceFilePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *ChoiceFilePlain) Values() []any {
+	return []any{
+		p.Path,
+		p.Size,
+	}
+}
+
+// choiceFilePlainPool is a sync.Pool for ChoiceFilePlain objects
+var choiceFilePlainPool = sync.Pool{
+	New: func() interface{} {
+		return &ChoiceFilePlain{}
+	},
+}
+
+// GetChoiceFilePlain returns a ChoiceFilePlain from the pool
+func GetChoiceFilePlain() *ChoiceFilePlain {
+	return choiceFilePlainPool.Get().(*ChoiceFilePlain)
+}
+
+// PutChoiceFilePlain returns a ChoiceFilePlain to the pool after resetting it
+func PutChoiceFilePlain(p *ChoiceFilePlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	choiceFilePlainPool.Put(p)
+}
+
+// Reset clears all fields in ChoiceFilePlain for reuse
+func (p *ChoiceFilePlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Path = ""
+	p.Size = 0
+}
+
+type ChoiceDocumentPlain struct {
+	Id                string           `json:"id"`
+	Enabled           bool             `json:"enabled"`
+	LimitMaxItems     int32            `json:"limitMaxItems"`     // origin: oneof_embed, empath: limit.max_items
+	LimitRange        *ChoiceRange     `json:"limitRange"`        // origin: oneof_embed, empath: limit.range
+	SourceUrl         string           `json:"sourceUrl"`         // origin: oneof_embed, empath: source.url
+	SourceRawData     []byte           `json:"sourceRawData"`     // origin: oneof_embed, empath: source.raw_data
+	SourceOffset      int64            `json:"sourceOffset"`      // origin: oneof_embed, empath: source.offset
+	SourceLevel       ChoiceLevel      `json:"sourceLevel"`       // origin: oneof_embed, empath: source.level
+	SourceFile        *ChoiceFilePlain `json:"sourceFile"`        // origin: oneof_embed, empath: source.file
+	SourcePrice       *common.Money    `json:"sourcePrice"`       // origin: oneof_embed, empath: source.price
+	SourceTag         string           `json:"sourceTag"`         // origin: type_alias, empath: source.tag
+	SourceWindow      []byte           `json:"sourceWindow"`      // origin: serialized, empath: source.window
+	SourceRange       *ChoiceRange     `json:"sourceRange"`       // origin: oneof_embed, empath: source.range
+	StatusStatusLevel string           `json:"statusStatusLevel"` // origin: oneof_embed, empath: status.status_level
+	StatusStatusText  string           `json:"statusStatusText"`  // origin: oneof_embed, empath: status.status_text
+	// LimitCase indicates which variant of limit oneof is set
+	LimitCase ChoiceDocumentLimitCase `json:"limit_case,omitempty"`
+	// SourceCase indicates which variant of source oneof is set
+	SourceCase ChoiceDocumentSourceCase `json:"source_case,omitempty"`
+	// StatusCase indicates which variant of status oneof is set
+	StatusCase ChoiceDocumentStatusCase `json:"status_case,omitempty"`
+}
+
+// ChoiceDocumentLimitCase identifies the set variant of full.ChoiceSettings.limit oneof, empty when none is set
+type ChoiceDocumentLimitCase string
+
+const (
+	ChoiceDocumentLimitCaseMaxItems ChoiceDocumentLimitCase = "max_items"
+	ChoiceDocumentLimitCaseRange    ChoiceDocumentLimitCase = "range"
+)
+
+// Valid reports whether c is one of the ChoiceDocumentLimitCase variants
+func (c ChoiceDocumentLimitCase) Valid() bool {
+	switch c {
+	case ChoiceDocumentLimitCaseMaxItems, ChoiceDocumentLimitCaseRange:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c ChoiceDocumentLimitCase) String() string {
+	return string(c)
+}
+
+// ChoiceDocumentSourceCase identifies the set variant of full.ChoiceDocument.source oneof, empty when none is set
+type ChoiceDocumentSourceCase string
+
+const (
+	ChoiceDocumentSourceCaseUrl     ChoiceDocumentSourceCase = "url"
+	ChoiceDocumentSourceCaseRawData ChoiceDocumentSourceCase = "raw_data"
+	ChoiceDocumentSourceCaseOffset  ChoiceDocumentSourceCase = "offset"
+	ChoiceDocumentSourceCaseLevel   ChoiceDocumentSourceCase = "level"
+	ChoiceDocumentSourceCaseFile    ChoiceDocumentSourceCase = "file"
+	ChoiceDocumentSourceCasePrice   ChoiceDocumentSourceCase = "price"
+	ChoiceDocumentSourceCaseTag     ChoiceDocumentSourceCase = "tag"
+	ChoiceDocumentSourceCaseWindow  ChoiceDocumentSourceCase = "window"
+	ChoiceDocumentSourceCaseRange   ChoiceDocumentSourceCase = "range"
+)
+
+// Valid reports whether c is one of the ChoiceDocumentSourceCase variants
+func (c ChoiceDocumentSourceCase) Valid() bool {
+	switch c {
+	case ChoiceDocumentSourceCaseUrl, ChoiceDocumentSourceCaseRawData, ChoiceDocumentSourceCaseOffset, ChoiceDocumentSourceCaseLevel, ChoiceDocumentSourceCaseFile, ChoiceDocumentSourceCasePrice, ChoiceDocumentSourceCaseTag, ChoiceDocumentSourceCaseWindow, ChoiceDocumentSourceCaseRange:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c ChoiceDocumentSourceCase) String() string {
+	return string(c)
+}
+
+// ChoiceDocumentStatusCase identifies the set variant of full.ChoiceDocument.status oneof, empty when none is set
+type ChoiceDocumentStatusCase string
+
+const (
+	ChoiceDocumentStatusCaseStatusLevel ChoiceDocumentStatusCase = "status_level"
+	ChoiceDocumentStatusCaseStatusText  ChoiceDocumentStatusCase = "status_text"
+)
+
+// Valid reports whether c is one of the ChoiceDocumentStatusCase variants
+func (c ChoiceDocumentStatusCase) Valid() bool {
+	switch c {
+	case ChoiceDocumentStatusCaseStatusLevel, ChoiceDocumentStatusCaseStatusText:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c ChoiceDocumentStatusCase) String() string {
+	return string(c)
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *ChoiceDocument) IntoPlain() *ChoiceDocumentPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &ChoiceDocumentPlain{}
+
+	// Detect limit oneof case
+	switch pb.GetSettings().GetLimit().(type) {
+	case *ChoiceSettings_MaxItems:
+		p.LimitCase = ChoiceDocumentLimitCaseMaxItems
+	case *ChoiceSettings_Range:
+		p.LimitCase = ChoiceDocumentLimitCaseRange
+	}
+
+	// Detect source oneof case
+	switch pb.Source.(type) {
+	case *ChoiceDocument_Url:
+		p.SourceCase = ChoiceDocumentSourceCaseUrl
+	case *ChoiceDocument_RawData:
+		p.SourceCase = ChoiceDocumentSourceCaseRawData
+	case *ChoiceDocument_Offset:
+		p.SourceCase = ChoiceDocumentSourceCaseOffset
+	case *ChoiceDocument_Level:
+		p.SourceCase = ChoiceDocumentSourceCaseLevel
+	case *ChoiceDocument_File:
+		p.SourceCase = ChoiceDocumentSourceCaseFile
+	case *ChoiceDocument_Price:
+		p.SourceCase = ChoiceDocumentSourceCasePrice
+	case *ChoiceDocument_Tag:
+		p.SourceCase = ChoiceDocumentSourceCaseTag
+	case *ChoiceDocument_Window:
+		p.SourceCase = ChoiceDocumentSourceCaseWindow
+	case *ChoiceDocument_Range:
+		p.SourceCase = ChoiceDocumentSourceCaseRange
+	}
+
+	// Detect status oneof case
+	switch pb.Status.(type) {
+	case *ChoiceDocument_StatusLevel:
+		p.StatusCase = ChoiceDocumentStatusCaseStatusLevel
+	case *ChoiceDocument_StatusText:
+		p.StatusCase = ChoiceDocumentStatusCaseStatusText
+	}
+
+	p.Id = pb.Id
+	// Enabled from
+	if pb.GetSettings() != nil {
+		p.Enabled = pb.GetSettings().GetEnabled()
+	}
+	// LimitMaxItems from limit.max_items
+	if pb.GetSettings() != nil {
+		p.LimitMaxItems = pb.GetSettings().GetMaxItems()
+	}
+	// LimitRange from limit.range
+	if pb.GetSettings() != nil && pb.GetSettings().GetRange() != nil {
+		p.LimitRange = pb.GetSettings().GetRange()
+	}
+	// SourceUrl from source.url
+	if pb != nil {
+		p.SourceUrl = pb.GetUrl()
+	}
+	// SourceRawData from source.raw_data
+	if pb != nil {
+		p.SourceRawData = pb.GetRawData()
+	}
+	// SourceOffset from source.offset
+	if pb != nil {
+		p.SourceOffset = pb.GetOffset()
+	}
+	// SourceLevel from source.level
+	if pb != nil {
+		p.SourceLevel = pb.GetLevel()
+	}
+	// SourceFile from source.file
+	if pb.GetFile() != nil {
+		p.SourceFile = pb.GetFile().IntoPlain()
+	}
+	// SourcePrice from source.price
+	if pb.GetPrice() != nil {
+		p.SourcePrice = pb.GetPrice()
+	}
+	// SourceTag type alias from source.tag
+	if pb.GetTag() != nil {
+		p.SourceTag = pb.GetTag().GetValue()
+	}
+	// SourceWindow serialized from source.window
+	if pb.GetWindow() != nil {
+		if data, err := protojson.Marshal(pb.GetWindow()); err == nil {
+			p.SourceWindow = data
+		}
+	} else {
+		p.SourceWindow = []byte{}
+	}
+	// SourceRange from source.range
+	if pb.GetRange() != nil {
+		p.SourceRange = pb.GetRange()
+	}
+	// StatusStatusLevel from status.status_level
+	if pb != nil {
+		p.StatusStatusLevel = pb.GetStatusLevel().String()
+	}
+	// StatusStatusText from status.status_text
+	if pb != nil {
+		p.StatusStatusText = pb.GetStatusText()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails or an enum name is unknown, use IntoPbE to handle the error.
+func (p *ChoiceDocumentPlain) IntoPb() *ChoiceDocument {
+	pb, err := p.IntoPbE()
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError wrapping *enumjx.UnknownValueError for unknown enum names.
+func (p *ChoiceDocumentPlain) IntoPbE() (*ChoiceDocument, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &ChoiceDocument{}
+	var _err error
+
+	pb.Id = p.Id
+	// Enabled ->
+	if pb.Settings == nil {
+		pb.Settings = &ChoiceSettings{}
+	}
+	pb.Settings.Enabled = p.Enabled
+	// LimitMaxItems -> limit.max_items
+	if p.LimitCase == ChoiceDocumentLimitCaseMaxItems {
+		if pb.Settings == nil {
+			pb.Settings = &ChoiceSettings{}
+		}
+		pb.Settings.Limit = &ChoiceSettings_MaxItems{MaxItems: p.LimitMaxItems}
+	}
+	// LimitRange -> limit.range
+	if p.LimitRange != nil && p.LimitCase == ChoiceDocumentLimitCaseRange {
+		if pb.Settings == nil {
+			pb.Settings = &ChoiceSettings{}
+		}
+		pb.Settings.Limit = &ChoiceSettings_Range{Range: p.LimitRange}
+	}
+	// SourceUrl -> source.url
+	if p.SourceCase == ChoiceDocumentSourceCaseUrl {
+		pb.Source = &ChoiceDocument_Url{Url: p.SourceUrl}
+	}
+	// SourceRawData -> source.raw_data
+	if p.SourceCase == ChoiceDocumentSourceCaseRawData {
+		pb.Source = &ChoiceDocument_RawData{RawData: p.SourceRawData}
+	}
+	// SourceOffset -> source.offset
+	if p.SourceCase == ChoiceDocumentSourceCaseOffset {
+		pb.Source = &ChoiceDocument_Offset{Offset: p.SourceOffset}
+	}
+	// SourceLevel -> source.level
+	if p.SourceCase == ChoiceDocumentSourceCaseLevel {
+		pb.Source = &ChoiceDocument_Level{Level: p.SourceLevel}
+	}
+	// SourceFile -> source.file
+	if p.SourceFile != nil && p.SourceCase == ChoiceDocumentSourceCaseFile {
+		pb.Source = &ChoiceDocument_File{File: p.SourceFile.IntoPb()}
+	}
+	// SourcePrice -> source.price
+	if p.SourcePrice != nil && p.SourceCase == ChoiceDocumentSourceCasePrice {
+		pb.Source = &ChoiceDocument_Price{Price: p.SourcePrice}
+	}
+	// SourceTag type alias -> source.tag
+	if p.SourceCase == ChoiceDocumentSourceCaseTag {
+		pb.Source = &ChoiceDocument_Tag{Tag: &ChoiceTag{Value: p.SourceTag}}
+	}
+	// SourceWindow deserialize -> source.window
+	if len(p.SourceWindow) > 0 {
+		var msg ChoiceRange
+		if err := protojson.Unmarshal(p.SourceWindow, &msg); err == nil {
+			pb.Source = &ChoiceDocument_Window{Window: &msg}
+		}
+	}
+	// SourceRange -> source.range
+	if p.SourceRange != nil && p.SourceCase == ChoiceDocumentSourceCaseRange {
+		pb.Source = &ChoiceDocument_Range{Range: p.SourceRange}
+	}
+	// StatusStatusLevel -> status.status_level
+	if p.StatusCase == ChoiceDocumentStatusCaseStatusLevel {
+		pb.Status = &ChoiceDocument_StatusLevel{StatusLevel: enumjx.TryName[ChoiceLevel](p.StatusStatusLevel, "full.ChoiceLevel", ChoiceLevel_value, "status.status_level", &_err)}
+	}
+	// StatusStatusText -> status.status_text
+	if p.StatusCase == ChoiceDocumentStatusCaseStatusText {
+		pb.Status = &ChoiceDocument_StatusText{StatusText: p.StatusStatusText}
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// choiceDocumentPlainMergePaths are the fields of ChoiceDocument covered by ChoiceDocumentPlain
+var choiceDocumentPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{30, 1},
+	{30, 2},
+	{30, 3},
+	{10},
+	{11},
+	{12},
+	{13},
+	{14},
+	{15},
+	{16},
+	{17},
+	{18},
+	{20},
+	{21},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *ChoiceDocumentPlain) IntoPbMerge(dst *ChoiceDocument) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, choiceDocumentPlainMergePaths...)
+	return nil
+}
+
+// choiceDocumentPlainFieldPaths link the JSON names of ChoiceDocumentPlain to field-mask paths of ChoiceDocument
+var choiceDocumentPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "enabled", Path: "settings.enabled"},
+	{Name: "limitMaxItems", Path: "settings.max_items"},
+	{Name: "limitRange", Path: "settings.range"},
+	{Name: "sourceUrl", Path: "url"},
+	{Name: "sourceRawData", Path: "raw_data"},
+	{Name: "sourceOffset", Path: "offset"},
+	{Name: "sourceLevel", Path: "level"},
+	{Name: "sourceFile", Path: "file"},
+	{Name: "sourcePrice", Path: "price"},
+	{Name: "sourceTag", Path: "tag"},
+	{Name: "sourceWindow", Path: "window"},
+	{Name: "sourceRange", Path: "range"},
+	{Name: "statusStatusLevel", Path: "status_level"},
+	{Name: "statusStatusText", Path: "status_text"},
+}
+
+// ChoiceDocumentPlainFieldMask builds a field mask of ChoiceDocument from JSON names of ChoiceDocumentPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func ChoiceDocumentPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(choiceDocumentPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// ChoiceDocumentPlainFieldNames translates a field mask of ChoiceDocument into JSON names of ChoiceDocumentPlain.
+// A path to an embedded message yields all fields flattened from it.
+func ChoiceDocumentPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(choiceDocumentPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of ChoiceDocument, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *ChoiceDocumentPlain) ApplyToPb(pb *ChoiceDocument, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), choiceDocumentPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *ChoiceDocument) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*ChoiceDocumentPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), choiceDocumentPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &ChoiceDocument{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *ChoiceDocument) IntoPlainReuse(p *ChoiceDocumentPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// Detect limit oneof case
+	switch pb.GetSettings().GetLimit().(type) {
+	case *ChoiceSettings_MaxItems:
+		p.LimitCase = ChoiceDocumentLimitCaseMaxItems
+	case *ChoiceSettings_Range:
+		p.LimitCase = ChoiceDocumentLimitCaseRange
+	}
+
+	// Detect source oneof case
+	switch pb.Source.(type) {
+	case *ChoiceDocument_Url:
+		p.SourceCase = ChoiceDocumentSourceCaseUrl
+	case *ChoiceDocument_RawData:
+		p.SourceCase = ChoiceDocumentSourceCaseRawData
+	case *ChoiceDocument_Offset:
+		p.SourceCase = ChoiceDocumentSourceCaseOffset
+	case *ChoiceDocument_Level:
+		p.SourceCase = ChoiceDocumentSourceCaseLevel
+	case *ChoiceDocument_File:
+		p.SourceCase = ChoiceDocumentSourceCaseFile
+	case *ChoiceDocument_Price:
+		p.SourceCase = ChoiceDocumentSourceCasePrice
+	case *ChoiceDocument_Tag:
+		p.SourceCase = ChoiceDocumentSourceCaseTag
+	case *ChoiceDocument_Window:
+		p.SourceCase = ChoiceDocumentSourceCaseWindow
+	case *ChoiceDocument_Range:
+		p.SourceCase = ChoiceDocumentSourceCaseRange
+	}
+
+	// Detect status oneof case
+	switch pb.Status.(type) {
+	case *ChoiceDocument_StatusLevel:
+		p.StatusCase = ChoiceDocumentStatusCaseStatusLevel
+	case *ChoiceDocument_StatusText:
+		p.StatusCase = ChoiceDocumentStatusCaseStatusText
+	}
+
+	p.Id = pb.Id
+	// Enabled from
+	if pb.GetSettings() != nil {
+		p.Enabled = pb.GetSettings().GetEnabled()
+	}
+	// LimitMaxItems from limit.max_items
+	if pb.GetSettings() != nil {
+		p.LimitMaxItems = pb.GetSettings().GetMaxItems()
+	}
+	// LimitRange from limit.range
+	if pb.GetSettings() != nil && pb.GetSettings().GetRange() != nil {
+		p.LimitRange = pb.GetSettings().GetRange()
+	}
+	// SourceUrl from source.url
+	if pb != nil {
+		p.SourceUrl = pb.GetUrl()
+	}
+	// SourceRawData from source.raw_data
+	if pb != nil {
+		p.SourceRawData = pb.GetRawData()
+	}
+	// SourceOffset from source.offset
+	if pb != nil {
+		p.SourceOffset = pb.GetOffset()
+	}
+	// SourceLevel from source.level
+	if pb != nil {
+		p.SourceLevel = pb.GetLevel()
+	}
+	// SourceFile from source.file
+	if pb.GetFile() != nil {
+		p.SourceFile = pb.GetFile().IntoPlain()
+	}
+	// SourcePrice from source.price
+	if pb.GetPrice() != nil {
+		p.SourcePrice = pb.GetPrice()
+	}
+	// SourceTag type alias from source.tag
+	if pb.GetTag() != nil {
+		p.SourceTag = pb.GetTag().GetValue()
+	}
+	// SourceWindow serialized from source.window
+	if pb.GetWindow() != nil {
+		if data, err := protojson.Marshal(pb.GetWindow()); err == nil {
+			p.SourceWindow = data
+		}
+	} else {
+		p.SourceWindow = []byte{}
+	}
+	// SourceRange from source.range
+	if pb.GetRange() != nil {
+		p.SourceRange = pb.GetRange()
+	}
+	// StatusStatusLevel from status.status_level
+	if pb != nil {
+		p.StatusStatusLevel = pb.GetStatusLevel().String()
+	}
+	// StatusStatusText from status.status_text
+	if pb != nil {
+		p.StatusStatusText = pb.GetStatusText()
+	}
+}
+
+// MarshalJX encodes ChoiceDocumentPlain to JSON using jx.Encoder
+func (p *ChoiceDocumentPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.LimitCase != "" {
+		e.FieldStart("limit_case")
+		e.Str(p.LimitCase.String())
+	}
+	if p.SourceCase != "" {
+		e.FieldStart("source_case")
+		e.Str(p.SourceCase.String())
+	}
+	if p.StatusCase != "" {
+		e.FieldStart("status_case")
+		e.Str(p.StatusCase.String())
+	}
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	if p.Enabled {
+		e.FieldStart("enabled")
+		e.Bool(p.Enabled)
+	}
+	if p.LimitMaxItems != 0 {
+		e.FieldStart("limitMaxItems")
+		e.Int32(p.LimitMaxItems)
+	}
+	if p.LimitRange != nil {
+		e.FieldStart("limitRange")
+		p.LimitRange.MarshalJX(e)
+	}
+	if p.SourceUrl != "" {
+		e.FieldStart("sourceUrl")
+		e.Str(p.SourceUrl)
+	}
+	if len(p.SourceRawData) > 0 {
+		e.FieldStart("sourceRawData")
+		e.Base64(p.SourceRawData)
+	}
+	if p.SourceOffset != 0 {
+		e.FieldStart("sourceOffset")
+		e.Int64(p.SourceOffset)
+	}
+	if p.SourceLevel != 0 {
+		e.FieldStart("sourceLevel")
+		e.Int32(int32(p.SourceLevel))
+	}
+	if p.SourceFile != nil {
+		e.FieldStart("sourceFile")
+		p.SourceFile.MarshalJX(e)
+	}
+	if p.SourcePrice != nil {
+		e.FieldStart("sourcePrice")
+		p.SourcePrice.MarshalJX(e)
+	}
+	if p.SourceTag != "" {
+		e.FieldStart("sourceTag")
+		e.Str(p.SourceTag)
+	}
+	if len(p.SourceWindow) > 0 {
+		e.FieldStart("sourceWindow")
+		e.Base64(p.SourceWindow)
+	}
+	if p.SourceRange != nil {
+		e.FieldStart("sourceRange")
+		p.SourceRange.MarshalJX(e)
+	}
+	if p.StatusStatusLevel != "" {
+		e.FieldStart("statusStatusLevel")
+		e.Str(p.StatusStatusLevel)
+	}
+	if p.StatusStatusText != "" {
+		e.FieldStart("statusStatusText")
+		e.Str(p.StatusStatusText)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *ChoiceDocumentPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes ChoiceDocumentPlain from JSON using jx.Decoder
+func (p *ChoiceDocumentPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "limit_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.LimitCase = ChoiceDocumentLimitCase(v)
+			if v != "" && !p.LimitCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.ChoiceSettings.limit", v)
+			}
+		case "source_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.SourceCase = ChoiceDocumentSourceCase(v)
+			if v != "" && !p.SourceCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.ChoiceDocument.source", v)
+			}
+		case "status_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.StatusCase = ChoiceDocumentStatusCase(v)
+			if v != "" && !p.StatusCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.ChoiceDocument.status", v)
+			}
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "enabled":
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.Enabled = v
+		case "limitMaxItems":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.LimitMaxItems = v
+		case "limitRange":
+			p.LimitRange = &ChoiceRange{}
+			if err := p.LimitRange.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "sourceUrl":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.SourceUrl = v
+		case "sourceRawData":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.SourceRawData = v
+		case "sourceOffset":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.SourceOffset = v
+		case "sourceLevel":
+			v, err := enumjx.Decode(d, "full.ChoiceLevel", ChoiceLevel_value)
+			if err != nil {
+				return err
+			}
+			p.SourceLevel = ChoiceLevel(v)
+		case "sourceFile":
+			p.SourceFile = &ChoiceFilePlain{}
+			if err := p.SourceFile.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "sourcePrice":
+			p.SourcePrice = &common.Money{}
+			if err := p.SourcePrice.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "sourceTag":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.SourceTag = v
+		case "sourceWindow":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.SourceWindow = v
+		case "sourceRange":
+			p.SourceRange = &ChoiceRange{}
+			if err := p.SourceRange.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "statusStatusLevel":
+			v, err := enumjx.DecodeName(d, "full.ChoiceLevel", ChoiceLevel_value, ChoiceLevel_name)
+			if err != nil {
+				return err
+			}
+			p.StatusStatusLevel = v
+		case "statusStatusText":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.StatusStatusText = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *ChoiceDocumentPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes ChoiceDocumentPlain in the protobuf wire format of ChoiceDocument
+func (p *ChoiceDocumentPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends ChoiceDocumentPlain encoded in the protobuf wire format of ChoiceDocument to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *ChoiceDocumentPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	// settings (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 30, protowire.BytesType)
+		_start := len(b)
+		if v := p.Enabled; v {
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+		}
+		if p.LimitCase == ChoiceDocumentLimitCaseMaxItems {
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(p.LimitMaxItems))
+		}
+		if p.LimitCase == ChoiceDocumentLimitCaseRange {
+			if p.LimitRange != nil {
+				b = protowire.AppendTag(b, 3, protowire.BytesType)
+				_start := len(b)
+				if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.LimitRange); err != nil {
+					return nil, err
+				}
+				b = plainwire.FinishLen(b, _start)
+			}
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	if p.SourceCase == ChoiceDocumentSourceCaseUrl {
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		b = protowire.AppendString(b, p.SourceUrl)
+	}
+	if p.SourceCase == ChoiceDocumentSourceCaseRawData {
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		b = protowire.AppendBytes(b, p.SourceRawData)
+	}
+	if p.SourceCase == ChoiceDocumentSourceCaseOffset {
+		b = protowire.AppendTag(b, 12, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.SourceOffset))
+	}
+	if p.SourceCase == ChoiceDocumentSourceCaseLevel {
+		b = protowire.AppendTag(b, 13, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.SourceLevel))
+	}
+	if p.SourceCase == ChoiceDocumentSourceCaseFile {
+		if p.SourceFile != nil {
+			b = protowire.AppendTag(b, 14, protowire.BytesType)
+			_start := len(b)
+			if b, err = p.SourceFile.AppendProto(b); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.SourceCase == ChoiceDocumentSourceCasePrice {
+		if p.SourcePrice != nil {
+			b = protowire.AppendTag(b, 15, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.SourcePrice); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.SourceCase == ChoiceDocumentSourceCaseTag {
+		b = protowire.AppendTag(b, 16, protowire.BytesType)
+		_start := len(b)
+		if v := p.SourceTag; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.SourceCase == ChoiceDocumentSourceCaseWindow {
+		if len(p.SourceWindow) > 0 {
+			m := &ChoiceRange{}
+			if err := protojson.Unmarshal(p.SourceWindow, m); err != nil {
+				return nil, err
+			}
+			b = protowire.AppendTag(b, 17, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.SourceCase == ChoiceDocumentSourceCaseRange {
+		if p.SourceRange != nil {
+			b = protowire.AppendTag(b, 18, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.SourceRange); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.StatusCase == ChoiceDocumentStatusCaseStatusLevel {
+		b = protowire.AppendTag(b, 20, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(enumjx.TryName[ChoiceLevel](p.StatusStatusLevel, "full.ChoiceLevel", ChoiceLevel_value, "status.status_level", &_err)))
+	}
+	if p.StatusCase == ChoiceDocumentStatusCaseStatusText {
+		b = protowire.AppendTag(b, 21, protowire.BytesType)
+		b = protowire.AppendString(b, p.StatusStatusText)
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes ChoiceDocumentPlain from the protobuf wire format of ChoiceDocument.
+// Unknown fields are skipped.
+func (p *ChoiceDocumentPlain) UnmarshalProto(b []byte) error {
+	*p = ChoiceDocumentPlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 30 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.Enabled = protowire.DecodeBool(v)
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.LimitCase = ChoiceDocumentLimitCaseMaxItems
+					p.LimitMaxItems = int32(v)
+				case num == 3 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					p.LimitCase = ChoiceDocumentLimitCaseRange
+					m := &ChoiceRange{}
+					if err := proto.Unmarshal(v, m); err != nil {
+						return err
+					}
+					p.LimitRange = m
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 10 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.SourceCase = ChoiceDocumentSourceCaseUrl
+			p.SourceUrl = v
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.SourceCase = ChoiceDocumentSourceCaseRawData
+			p.SourceRawData = append([]byte{}, v...)
+		case num == 12 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.SourceCase = ChoiceDocumentSourceCaseOffset
+			p.SourceOffset = int64(v)
+		case num == 13 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.SourceCase = ChoiceDocumentSourceCaseLevel
+			p.SourceLevel = ChoiceLevel(v)
+		case num == 14 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.SourceCase = ChoiceDocumentSourceCaseFile
+			p.SourceFile = &ChoiceFilePlain{}
+			if err := p.SourceFile.UnmarshalProto(v); err != nil {
+				return err
+			}
+		case num == 15 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.SourceCase = ChoiceDocumentSourceCasePrice
+			m := &common.Money{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.SourcePrice = m
+		case num == 16 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.SourceCase = ChoiceDocumentSourceCaseTag
+			var e string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					e = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			p.SourceTag = e
+		case num == 17 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.SourceCase = ChoiceDocumentSourceCaseWindow
+			m := &ChoiceRange{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			data, err := protojson.Marshal(m)
+			if err != nil {
+				return err
+			}
+			p.SourceWindow = data
+		case num == 18 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.SourceCase = ChoiceDocumentSourceCaseRange
+			m := &ChoiceRange{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.SourceRange = m
+		case num == 20 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.StatusCase = ChoiceDocumentStatusCaseStatusLevel
+			p.StatusStatusLevel = ChoiceLevel(v).String()
+		case num == 21 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.StatusCase = ChoiceDocumentStatusCaseStatusText
+			p.StatusStatusText = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// choiceDocumentPlainReflect describes ChoiceDocumentPlain as message full.plain.ChoiceDocumentPlain
+var choiceDocumentPlainReflect = plainreflect.NewMessageInfo(file_test_full_choice_proto_plain, "ChoiceDocumentPlain",
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *bool { return &p.Enabled }),
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *int32 { return &p.LimitMaxItems }),
+	plainreflect.Message(func(p *ChoiceDocumentPlain) **ChoiceRange { return &p.LimitRange }),
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *string { return &p.SourceUrl }),
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *[]byte { return &p.SourceRawData }),
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *int64 { return &p.SourceOffset }),
+	plainreflect.Enum(func(p *ChoiceDocumentPlain) *ChoiceLevel { return &p.SourceLevel }),
+	plainreflect.Message(func(p *ChoiceDocumentPlain) **ChoiceFilePlain { return &p.SourceFile }),
+	plainreflect.Message(func(p *ChoiceDocumentPlain) **common.Money { return &p.SourcePrice }),
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *string { return &p.SourceTag }),
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *[]byte { return &p.SourceWindow }),
+	plainreflect.Message(func(p *ChoiceDocumentPlain) **ChoiceRange { return &p.SourceRange }),
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *string { return &p.StatusStatusLevel }),
+	plainreflect.Scalar(func(p *ChoiceDocumentPlain) *string { return &p.StatusStatusText }),
+	plainreflect.Case(func(p *ChoiceDocumentPlain) *ChoiceDocumentLimitCase { return &p.LimitCase }),
+	plainreflect.Case(func(p *ChoiceDocumentPlain) *ChoiceDocumentSourceCase { return &p.SourceCase }),
+	plainreflect.Case(func(p *ChoiceDocumentPlain) *ChoiceDocumentStatusCase { return &p.StatusCase }),
+)
+
+// ProtoReflect returns the reflective view of ChoiceDocumentPlain backed by the struct
+func (p *ChoiceDocumentPlain) ProtoReflect() protoreflect.Message {
+	return choiceDocumentPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of ChoiceDocumentPlain
+func (p *ChoiceDocumentPlain) Clone() *ChoiceDocumentPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &ChoiceDocumentPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of ChoiceDocumentPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetChoiceDocumentPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *ChoiceDocumentPlain) CopyTo(dst *ChoiceDocumentPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.LimitCase = p.LimitCase
+	dst.SourceCase = p.SourceCase
+	dst.StatusCase = p.StatusCase
+	dst.Id = p.Id
+	dst.Enabled = p.Enabled
+	dst.LimitMaxItems = p.LimitMaxItems
+	if p.LimitRange == nil {
+		dst.LimitRange = nil
+	} else {
+		if dst.LimitRange == nil {
+			dst.LimitRange = new(ChoiceRange)
+		} else {
+			proto.Reset(dst.LimitRange)
+		}
+		proto.Merge(dst.LimitRange, p.LimitRange)
+	}
+	dst.SourceUrl = p.SourceUrl
+	dst.SourceRawData = append(dst.SourceRawData[:0], p.SourceRawData...)
+	dst.SourceOffset = p.SourceOffset
+	dst.SourceLevel = p.SourceLevel
+	if p.SourceFile == nil {
+		dst.SourceFile = nil
+	} else {
+		if dst.SourceFile == nil {
+			dst.SourceFile = new(ChoiceFilePlain)
+		}
+		p.SourceFile.CopyTo(dst.SourceFile)
+	}
+	if p.SourcePrice == nil {
+		dst.SourcePrice = nil
+	} else {
+		if dst.SourcePrice == nil {
+			dst.SourcePrice = new(common.Money)
+		} else {
+			proto.Reset(dst.SourcePrice)
+		}
+		proto.Merge(dst.SourcePrice, p.SourcePrice)
+	}
+	dst.SourceTag = p.SourceTag
+	dst.SourceWindow = append(dst.SourceWindow[:0], p.SourceWindow...)
+	if p.SourceRange == nil {
+		dst.SourceRange = nil
+	} else {
+		if dst.SourceRange == nil {
+			dst.SourceRange = new(ChoiceRange)
+		} else {
+			proto.Reset(dst.SourceRange)
+		}
+		proto.Merge(dst.SourceRange, p.SourceRange)
+	}
+	dst.StatusStatusLevel = p.StatusStatusLevel
+	dst.StatusStatusText = p.StatusStatusText
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *ChoiceDocumentPlain) Equal(other *ChoiceDocumentPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.LimitCase != other.LimitCase {
+		return false
+	}
+	if p.SourceCase != other.SourceCase {
+		return false
+	}
+	if p.StatusCase != other.StatusCase {
+		return false
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if p.Enabled != other.Enabled {
+		return false
+	}
+	if p.LimitMaxItems != other.LimitMaxItems {
+		return false
+	}
+	if !proto.Equal(p.LimitRange, other.LimitRange) {
+		return false
+	}
+	if p.SourceUrl != other.SourceUrl {
+		return false
+	}
+	if !bytes.Equal(p.SourceRawData, other.SourceRawData) {
+		return false
+	}
+	if p.SourceOffset != other.SourceOffset {
+		return false
+	}
+	if p.SourceLevel != other.SourceLevel {
+		return false
+	}
+	if !p.SourceFile.Equal(other.SourceFile) {
+		return false
+	}
+	if !proto.Equal(p.SourcePrice, other.SourcePrice) {
+		return false
+	}
+	if p.SourceTag != other.SourceTag {
+		return false
+	}
+	if !bytes.Equal(p.SourceWindow, other.SourceWindow) {
+		return false
+	}
+	if !proto.Equal(p.SourceRange, other.SourceRange) {
+		return false
+	}
+	if p.StatusStatusLevel != other.StatusStatusLevel {
+		return false
+	}
+	if p.StatusStatusText != other.StatusStatusText {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of ChoiceDocumentPlain rows, in the order of ScanRow and Values
+func (p *ChoiceDocumentPlain) Columns() []string {
+	return []string{
+		"id",
+		"enabled",
+		"limit_max_items",
+		"limit_range",
+		"source_url",
+		"source_raw_data",
+		"source_offset",
+		"source_level",
+		"source_file",
+		"source_price",
+		"source_tag",
+		"source_window",
+		"source_range",
+		"status_status_level",
+		"status_status_text",
+		"limit_case",
+		"source_case",
+		"status_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into ChoiceDocumentPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *ChoiceDocumentPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Enabled,
+		&p.LimitMaxItems,
+		plainsql.JSON(&p.LimitRange),
+		&p.SourceUrl,
+		&p.SourceRawData,
+		&p.SourceOffset,
+		&p.SourceLevel,
+		plainsql.JSON(&p.SourceFile),
+		plainsql.JSON(&p.SourcePrice),
+		&p.SourceTag,
+		&p.SourceWindow,
+		plainsql.JSON(&p.SourceRange),
+		&p.StatusStatusLevel,
+		&p.StatusStatusText,
+		&p.LimitCase,
+		&p.SourceCase,
+		&p.StatusCase,
+	)
+}
+
+// Values returns the column values of ChoiceDocumentPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *ChoiceDocumentPlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Enabled,
+		p.LimitMaxItems,
+		plainsql.JSON(&p.LimitRange),
+		p.SourceUrl,
+		p.SourceRawData,
+		p.SourceOffset,
+		p.SourceLevel,
+		plainsql.JSON(&p.SourceFile),
+		plainsql.JSON(&p.SourcePrice),
+		p.SourceTag,
+		p.SourceWindow,
+		plainsql.JSON(&p.SourceRange),
+		p.StatusStatusLevel,
+		p.StatusStatusText,
+		p.LimitCase,
+		p.SourceCase,
+		p.StatusCase,
+	}
+}
+
+// choiceDocumentPlainPool is a sync.Pool for ChoiceDocumentPlain objects
+var choiceDocumentPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &ChoiceDocumentPlain{}
+	},
+}
+
+// GetChoiceDocumentPlain returns a ChoiceDocumentPlain from the pool
+func GetChoiceDocumentPlain() *ChoiceDocumentPlain {
+	return choiceDocumentPlainPool.Get().(*ChoiceDocumentPlain)
+}
+
+// PutChoiceDocumentPlain returns a ChoiceDocumentPlain to the pool after resetting it
+func PutChoiceDocumentPlain(p *ChoiceDocumentPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	choiceDocumentPlainPool.Put(p)
+}
+
+// Reset clears all fields in ChoiceDocumentPlain for reuse
+func (p *ChoiceDocumentPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.LimitCase = ""
+	p.SourceCase = ""
+	p.StatusCase = ""
+	p.Id = ""
+	p.Enabled = false
+	p.LimitMaxItems = 0
+	p.LimitRange = nil
+	p.SourceUrl = ""
+	p.SourceRawData = nil
+	p.SourceOffset = 0
+	p.SourceLevel = 0
+	p.SourceFile = nil
+	p.SourcePrice = nil
+	p.SourceTag = ""
+	p.SourceWindow = nil
+	p.SourceRange = nil
+	p.StatusStatusLevel = ""
+	p.StatusStatusText = ""
+}
+
+// file_test_full_choice_proto_plain_rawDesc is the serialized descriptor of test/full/choice_plain.proto,
+// describing the Plain messages of test/full/choice.proto in package full.plain
+const file_test_full_choice_proto_plain_rawDesc = "" +
+	"\n\x1ctest/full/choice_plain.proto\x12\nfull.plain\x1a\x16test/full/choice.pro" +
+	"to\x1a\x1ctest/full/common/money.proto\"9\n\x0fChoiceFilePlain\x12\x12\n\x04path\x18\x01 \x01(" +
+	"\tR\x04path\x12\x12\n\x04size\x18\x02 \x01(\x03R\x04size\"\xee\x05\n\x13ChoiceDocumentPlain\x12\x0e\n\x02id\x18\x01 \x01(\tR" +
+	"\x02id\x12\x18\n\aenabled\x18\x02 \x01(\bR\aenabled\x12&\n\x0flimit_max_items\x18\x03 \x01(\x05R\rlimitMax" +
+	"Items\x122\n\vlimit_range\x18\x04 \x01(\v2\x11.full.ChoiceRangeR\nlimitRange\x12\x1d\n\nsou" +
+	"rce_url\x18\x05 \x01(\tR\tsourceUrl\x12&\n\x0fsource_raw_data\x18\x06 \x01(\fR\rsourceRawData" +
+	"\x12#\n\rsource_offset\x18\a \x01(\x03R\fsourceOffset\x124\n\fsource_level\x18\b \x01(\x0e2\x11.fu" +
+	"ll.ChoiceLevelR\vsourceLevel\x12<\n\vsource_file\x18\t \x01(\v2\x1b.full.plain.Ch" +
+	"oiceFilePlainR\nsourceFile\x125\n\fsource_price\x18\n \x01(\v2\x12.full.common.Mo" +
+	"neyR\vsourcePrice\x12\x1d\n\nsource_tag\x18\v \x01(\tR\tsourceTag\x12#\n\rsource_window" +
+	"\x18\f \x01(\fR\fsourceWindow\x124\n\fsource_range\x18\r \x01(\v2\x11.full.ChoiceRangeR\vs" +
+	"ourceRange\x12.\n\x13status_status_level\x18\x0e \x01(\tR\x11statusStatusLevel\x12,\n\x12st" +
+	"atus_status_text\x18\x0f \x01(\tR\x10statusStatusText\x12\x1e\n\nlimit_case\x18\x10 \x01(\tR\nli" +
+	"mit_case\x12 \n\vsource_case\x18\x11 \x01(\tR\vsource_case\x12 \n\vstatus_case\x18\x12 \x01(\tR" +
+	"\vstatus_caseb\x06proto3"
+
+var file_test_full_choice_proto_plain = plainreflect.NewFile("test/full/choice_plain.proto", file_test_full_choice_proto_plain_rawDesc)
+
+func init() { file_test_full_choice_proto_plain_init() }
+
+func file_test_full_choice_proto_plain_init() {
+	file_test_full_choice_proto_init()
+	file_test_full_choice_proto_plain.Init()
+}
@@ -0,0 +1,78 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/choice.proto
+
+export interface ChoiceFilePlain {
+  path?: string;
+  size?: number;
+}
+
+/** Fields of ChoiceDocumentPlain outside of oneofs */
+export interface ChoiceDocumentPlainFields {
+  id?: string;
+  enabled?: boolean;
+  statusStatusLevel?: "CHOICE_LEVEL_UNSPECIFIED" | "CHOICE_LEVEL_LOW" | "CHOICE_LEVEL_HIGH";
+}
+
+/** limit oneof of ChoiceDocumentPlain, by limit_case */
+export type ChoiceDocumentPlainLimit =
+  | {
+      limit_case: "max_items";
+      limitMaxItems?: number;
+    }
+  | {
+      limit_case: "range";
+      limitRange?: Record<string, unknown>;
+    }
+  | { limit_case?: undefined };
+
+/** source oneof of ChoiceDocumentPlain, by source_case */
+export type ChoiceDocumentPlainSource =
+  | {
+      source_case: "url";
+      sourceUrl?: string;
+    }
+  | {
+      source_case: "raw_data";
+      sourceRawData?: string;
+    }
+  | {
+      source_case: "offset";
+      sourceOffset?: number;
+    }
+  | {
+      source_case: "level";
+      sourceLevel?: 0 | 1 | 2;
+    }
+  | {
+      source_case: "file";
+      sourceFile?: ChoiceFilePlain;
+    }
+  | {
+      source_case: "price";
+      sourcePrice?: Record<string, unknown>;
+    }
+  | {
+      source_case: "tag";
+      sourceTag?: string;
+    }
+  | {
+      source_case: "window";
+      sourceWindow?: string;
+    }
+  | {
+      source_case: "range";
+      /** embed is ignored for variants of a non-embedded oneof */
+      sourceRange?: Record<string, unknown>;
+    }
+  | { source_case?: undefined };
+
+/** status oneof of ChoiceDocumentPlain, by status_case */
+export type ChoiceDocumentPlainStatus =
+  | { status_case: "status_level" }
+  | {
+      status_case: "status_text";
+      statusStatusText?: string;
+    }
+  | { status_case?: undefined };
+
+export type ChoiceDocumentPlain = ChoiceDocumentPlainFields & ChoiceDocumentPlainLimit & ChoiceDocumentPlainSource & ChoiceDocumentPlainStatus;
@@ -0,0 +1,105 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func choiceDocuments() map[string]*full.ChoiceDocument {
+	return map[string]*full.ChoiceDocument{
+		"url":       {Id: "d", Source: &full.ChoiceDocument_Url{Url: "https://example.com"}},
+		"empty url": {Source: &full.ChoiceDocument_Url{}},
+		"raw data":  {Source: &full.ChoiceDocument_RawData{RawData: []byte{1, 2}}},
+		"offset":    {Source: &full.ChoiceDocument_Offset{Offset: -5}},
+		"level":     {Source: &full.ChoiceDocument_Level{Level: full.ChoiceLevel_CHOICE_LEVEL_HIGH}},
+		"file":      {Source: &full.ChoiceDocument_File{File: &full.ChoiceFile{Path: "/a", Size: 3}}},
+		"price":     {Source: &full.ChoiceDocument_Price{Price: &common.Money{Currency: "USD", Units: 7}}},
+		"tag":       {Source: &full.ChoiceDocument_Tag{Tag: &full.ChoiceTag{Value: "t"}}},
+		"window":    {Source: &full.ChoiceDocument_Window{Window: &full.ChoiceRange{From: 1, To: 2}}},
+		"range":     {Source: &full.ChoiceDocument_Range{Range: &full.ChoiceRange{From: 3}}},
+		"status":    {Status: &full.ChoiceDocument_StatusLevel{StatusLevel: full.ChoiceLevel_CHOICE_LEVEL_LOW}},
+		"nested oneof": {
+			Settings: &full.ChoiceSettings{Enabled: true, Limit: &full.ChoiceSettings_Range{Range: &full.ChoiceRange{To: 9}}},
+			Status:   &full.ChoiceDocument_StatusText{StatusText: "ok"},
+		},
+	}
+}
+
+func TestRoundtrip_NonEmbeddedOneof(t *testing.T) {
+	for name, original := range choiceDocuments() {
+		t.Run(name, func(t *testing.T) {
+			plain := original.IntoPlain()
+			// IntoPb always builds embedded messages, so compare against the normalized original
+			normalized := plain.IntoPb()
+			assert.Equal(t, original.GetSource(), normalized.GetSource())
+			assert.Equal(t, original.GetStatus(), normalized.GetStatus())
+			assert.True(t, proto.Equal(original.GetSettings().GetRange(), normalized.GetSettings().GetRange()))
+
+			jsonData, err := plain.MarshalJSON()
+			require.NoError(t, err)
+			fromJSON := &full.ChoiceDocumentPlain{}
+			require.NoError(t, fromJSON.UnmarshalJSON(jsonData))
+			require.True(t, proto.Equal(normalized, fromJSON.IntoPb()), "JSON roundtrip failed: %s", jsonData)
+
+			expected, err := proto.Marshal(original)
+			require.NoError(t, err)
+			data, err := plain.MarshalProto()
+			require.NoError(t, err)
+			assert.Equal(t, expected, data)
+			fromWire := &full.ChoiceDocumentPlain{}
+			require.NoError(t, fromWire.UnmarshalProto(data))
+			require.True(t, proto.Equal(normalized, fromWire.IntoPb()), "wire roundtrip failed")
+		})
+	}
+}
+
+func TestNonEmbeddedOneof_Fields(t *testing.T) {
+	plain := (&full.ChoiceDocument{
+		Source:   &full.ChoiceDocument_File{File: &full.ChoiceFile{Path: "/a"}},
+		Status:   &full.ChoiceDocument_StatusLevel{StatusLevel: full.ChoiceLevel_CHOICE_LEVEL_HIGH},
+		Settings: &full.ChoiceSettings{Limit: &full.ChoiceSettings_MaxItems{MaxItems: 0}},
+	}).IntoPlain()
+
+	assert.Equal(t, full.ChoiceDocumentSourceCaseFile, plain.SourceCase)
+	assert.Equal(t, "/a", plain.SourceFile.Path)
+	assert.Equal(t, full.ChoiceDocumentStatusCaseStatusLevel, plain.StatusCase)
+	assert.Equal(t, "CHOICE_LEVEL_HIGH", plain.StatusStatusLevel)
+	// A zero-valued variant is still set
+	assert.Equal(t, full.ChoiceDocumentLimitCaseMaxItems, plain.LimitCase)
+
+	pb := plain.IntoPb()
+	_, ok := pb.GetSettings().GetLimit().(*full.ChoiceSettings_MaxItems)
+	assert.True(t, ok)
+}
+
+func TestNonEmbeddedOneof_Reset(t *testing.T) {
+	plain := full.GetChoiceDocumentPlain()
+	(&full.ChoiceDocument{Source: &full.ChoiceDocument_Url{Url: "u"}}).IntoPlainReuse(plain)
+	require.Equal(t, full.ChoiceDocumentSourceCaseUrl, plain.SourceCase)
+
+	full.PutChoiceDocumentPlain(plain)
+	assert.Empty(t, plain.SourceCase)
+	assert.Empty(t, plain.SourceUrl)
+	assert.Nil(t, plain.IntoPb().GetSource())
+}
+
+func TestOneofCaseType(t *testing.T) {
+	assert.True(t, full.ChoiceDocumentSourceCaseFile.Valid())
+	assert.False(t, full.ChoiceDocumentSourceCase("").Valid())
+	assert.False(t, full.ChoiceDocumentSourceCase("fil").Valid())
+	assert.Equal(t, "file", full.ChoiceDocumentSourceCaseFile.String())
+
+	plain := &full.ChoiceDocumentPlain{}
+	require.NoError(t, plain.UnmarshalJSON([]byte(`{"source_case": "offset", "sourceOffset": 3}`)))
+	assert.Equal(t, full.ChoiceDocumentSourceCaseOffset, plain.SourceCase)
+	assert.Equal(t, int64(3), plain.IntoPb().GetOffset())
+
+	err := (&full.ChoiceDocumentPlain{}).UnmarshalJSON([]byte(`{"source_case": "fil"}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "full.ChoiceDocument.source")
+}
@@ -0,0 +1,104 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClone_Collection(t *testing.T) {
+	src := collectionShelf().IntoPlain()
+	clone := src.Clone()
+	require.True(t, proto.Equal(src.IntoPb(), clone.IntoPb()))
+
+	clone.Tags[0] = "changed"
+	clone.Blobs[0][0] = 9
+	clone.Labels[0].Key = "changed"
+	clone.Attributes["color"] = "blue"
+	clone.Budgets["q1"].Units = 99
+	clone.Prices[0].Units = 99
+	clone.LabelsById[7].Key = "changed"
+
+	assert.True(t, proto.Equal(collectionShelf(), src.IntoPb()), "the clone shares nothing with the source")
+}
+
+func TestClone_Oneof(t *testing.T) {
+	for name, original := range choiceDocuments() {
+		t.Run(name, func(t *testing.T) {
+			src := original.IntoPlain()
+			assert.True(t, proto.Equal(src.IntoPb(), src.Clone().IntoPb()))
+		})
+	}
+
+	src := (&full.ChoiceDocument{Source: &full.ChoiceDocument_File{File: &full.ChoiceFile{Path: "/a"}}}).IntoPlain()
+	clone := src.Clone()
+	clone.SourceFile.Path = "/b"
+	assert.Equal(t, "/a", src.SourceFile.Path, "nested Plain structs are cloned")
+	assert.Equal(t, full.ChoiceDocumentSourceCaseFile, clone.SourceCase)
+}
+
+func TestClone_Nil(t *testing.T) {
+	var src *full.CustomerPlain
+	assert.Nil(t, src.Clone())
+
+	dst := &full.CustomerPlain{Id: "keep"}
+	src.CopyTo(dst)
+	assert.Equal(t, "keep", dst.Id, "copying nil leaves dst untouched")
+}
+
+func TestCopyTo_Overwrites(t *testing.T) {
+	dst := newCustomer().IntoPlain().Clone()
+	src := &full.CustomerPlain{Id: "cust-2", Addresses: []full.CustomerAddressesItemPlain{{City: "Ogdenville"}}}
+
+	src.CopyTo(dst)
+
+	assert.Equal(t, "cust-2", dst.Id)
+	assert.Empty(t, dst.Name)
+	require.Len(t, dst.Addresses, 1)
+	assert.Equal(t, "Ogdenville", dst.Addresses[0].City)
+	assert.Empty(t, dst.Addresses[0].Tags, "reused rows are overwritten")
+	assert.Empty(t, dst.ShippingAddresses)
+}
+
+func TestCopyTo_PoolNoAllocs(t *testing.T) {
+	src := newCustomer().IntoPlain()
+	dst := full.GetCustomerPlain()
+	defer full.PutCustomerPlain(dst)
+
+	src.CopyTo(dst)
+	dst.Reset()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		src.CopyTo(dst)
+		dst.Reset()
+	})
+	assert.Zero(t, allocs, "CopyTo reuses the slices kept by Reset")
+}
+
+func TestCopyTo_NestedMessagesNoAllocs(t *testing.T) {
+	src := wireEnvelope().IntoPlain().Clone()
+	require.NotNil(t, src.Cover)
+	require.NotNil(t, src.Price)
+	dst := src.Clone()
+	cover, price := dst.Cover, dst.Price
+
+	allocs := testing.AllocsPerRun(100, func() {
+		src.CopyTo(dst)
+	})
+	assert.Zero(t, allocs, "CopyTo copies into the nested Plain and protobuf messages of dst")
+	assert.Same(t, cover, dst.Cover)
+	assert.Same(t, price, dst.Price)
+	assert.True(t, proto.Equal(src.IntoPb(), dst.IntoPb()))
+
+	src.Cover.Name = "changed"
+	src.Price.Units = 1
+	assert.Equal(t, "cover", dst.Cover.Name)
+	assert.Equal(t, int64(150), dst.Price.Units)
+
+	(&full.WireEnvelopePlain{}).CopyTo(dst)
+	assert.Nil(t, dst.Cover)
+	assert.Nil(t, dst.Price)
+}
@@ -0,0 +1,480 @@
+// Repeated and map fields flattened from embedded messages at any depth
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/collection.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CollectionKind int32
+
+const (
+	CollectionKind_COLLECTION_KIND_UNSPECIFIED CollectionKind = 0
+	CollectionKind_COLLECTION_KIND_BOOK        CollectionKind = 1
+	CollectionKind_COLLECTION_KIND_FILM        CollectionKind = 2
+)
+
+// Enum value maps for CollectionKind.
+var (
+	CollectionKind_name = map[int32]string{
+		0: "COLLECTION_KIND_UNSPECIFIED",
+		1: "COLLECTION_KIND_BOOK",
+		2: "COLLECTION_KIND_FILM",
+	}
+	CollectionKind_value = map[string]int32{
+		"COLLECTION_KIND_UNSPECIFIED": 0,
+		"COLLECTION_KIND_BOOK":        1,
+		"COLLECTION_KIND_FILM":        2,
+	}
+)
+
+func (x CollectionKind) Enum() *CollectionKind {
+	p := new(CollectionKind)
+	*p = x
+	return p
+}
+
+func (x CollectionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_test_full_collection_proto_enumTypes[0].Descriptor()
+}
+
+func (CollectionKind) Type() protoreflect.EnumType {
+	return &file_test_full_collection_proto_enumTypes[0]
+}
+
+func (x CollectionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionKind.Descriptor instead.
+func (CollectionKind) EnumDescriptor() ([]byte, []int) {
+	return file_test_full_collection_proto_rawDescGZIP(), []int{0}
+}
+
+type CollectionLabel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionLabel) Reset() {
+	*x = CollectionLabel{}
+	mi := &file_test_full_collection_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionLabel) ProtoMessage() {}
+
+func (x *CollectionLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_collection_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionLabel.ProtoReflect.Descriptor instead.
+func (*CollectionLabel) Descriptor() ([]byte, []int) {
+	return file_test_full_collection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CollectionLabel) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CollectionLabel) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CollectionStats struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Counters      []int64                    `protobuf:"varint,1,rep,packed,name=counters,proto3" json:"counters,omitempty"`
+	Ratios        map[string]float64         `protobuf:"bytes,2,rep,name=ratios,proto3" json:"ratios,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Prices        []*common.Money            `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`
+	LabelsById    map[int32]*CollectionLabel `protobuf:"bytes,4,rep,name=labels_by_id,json=labelsById,proto3" json:"labels_by_id,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionStats) Reset() {
+	*x = CollectionStats{}
+	mi := &file_test_full_collection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionStats) ProtoMessage() {}
+
+func (x *CollectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_collection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionStats.ProtoReflect.Descriptor instead.
+func (*CollectionStats) Descriptor() ([]byte, []int) {
+	return file_test_full_collection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CollectionStats) GetCounters() []int64 {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *CollectionStats) GetRatios() map[string]float64 {
+	if x != nil {
+		return x.Ratios
+	}
+	return nil
+}
+
+func (x *CollectionStats) GetPrices() []*common.Money {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *CollectionStats) GetLabelsById() map[int32]*CollectionLabel {
+	if x != nil {
+		return x.LabelsById
+	}
+	return nil
+}
+
+type CollectionDetails struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Tags          []string                  `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Blobs         [][]byte                  `protobuf:"bytes,2,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	Kinds         []CollectionKind          `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=full.CollectionKind" json:"kinds,omitempty"`
+	KindNames     []CollectionKind          `protobuf:"varint,4,rep,packed,name=kind_names,json=kindNames,proto3,enum=full.CollectionKind" json:"kind_names,omitempty"`
+	Labels        []*CollectionLabel        `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`
+	Attributes    map[string]string         `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Budgets       map[string]*common.Money  `protobuf:"bytes,7,rep,name=budgets,proto3" json:"budgets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	KindByName    map[string]CollectionKind `protobuf:"bytes,8,rep,name=kind_by_name,json=kindByName,proto3" json:"kind_by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=full.CollectionKind"`
+	Stats         *CollectionStats          `protobuf:"bytes,9,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionDetails) Reset() {
+	*x = CollectionDetails{}
+	mi := &file_test_full_collection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionDetails) ProtoMessage() {}
+
+func (x *CollectionDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_collection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionDetails.ProtoReflect.Descriptor instead.
+func (*CollectionDetails) Descriptor() ([]byte, []int) {
+	return file_test_full_collection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CollectionDetails) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CollectionDetails) GetBlobs() [][]byte {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *CollectionDetails) GetKinds() []CollectionKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *CollectionDetails) GetKindNames() []CollectionKind {
+	if x != nil {
+		return x.KindNames
+	}
+	return nil
+}
+
+func (x *CollectionDetails) GetLabels() []*CollectionLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CollectionDetails) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CollectionDetails) GetBudgets() map[string]*common.Money {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+func (x *CollectionDetails) GetKindByName() map[string]CollectionKind {
+	if x != nil {
+		return x.KindByName
+	}
+	return nil
+}
+
+func (x *CollectionDetails) GetStats() *CollectionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type CollectionShelf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Details       *CollectionDetails     `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionShelf) Reset() {
+	*x = CollectionShelf{}
+	mi := &file_test_full_collection_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionShelf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionShelf) ProtoMessage() {}
+
+func (x *CollectionShelf) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_collection_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionShelf.ProtoReflect.Descriptor instead.
+func (*CollectionShelf) Descriptor() ([]byte, []int) {
+	return file_test_full_collection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CollectionShelf) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CollectionShelf) GetDetails() *CollectionDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+var File_test_full_collection_proto protoreflect.FileDescriptor
+
+const file_test_full_collection_proto_rawDesc = "" +
+	"\n" +
+	"\x1atest/full/collection.proto\x12\x04full\x1a\x15goplain/goplain.proto\x1a\x1ctest/full/common/money.proto\"A\n" +
+	"\x0fCollectionLabel\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x06\x82\xa6\x1d\x02\b\x01\"\xee\x02\n" +
+	"\x0fCollectionStats\x12\x1a\n" +
+	"\bcounters\x18\x01 \x03(\x03R\bcounters\x129\n" +
+	"\x06ratios\x18\x02 \x03(\v2!.full.CollectionStats.RatiosEntryR\x06ratios\x12*\n" +
+	"\x06prices\x18\x03 \x03(\v2\x12.full.common.MoneyR\x06prices\x12G\n" +
+	"\flabels_by_id\x18\x04 \x03(\v2%.full.CollectionStats.LabelsByIdEntryR\n" +
+	"labelsById\x1a9\n" +
+	"\vRatiosEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1aT\n" +
+	"\x0fLabelsByIdEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.full.CollectionLabelR\x05value:\x028\x01\"\xc2\x05\n" +
+	"\x11CollectionDetails\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05blobs\x18\x02 \x03(\fR\x05blobs\x12*\n" +
+	"\x05kinds\x18\x03 \x03(\x0e2\x14.full.CollectionKindR\x05kinds\x12;\n" +
+	"\n" +
+	"kind_names\x18\x04 \x03(\x0e2\x14.full.CollectionKindB\x06\x82\xa6\x1d\x028\x01R\tkindNames\x12-\n" +
+	"\x06labels\x18\x05 \x03(\v2\x15.full.CollectionLabelR\x06labels\x12G\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\v2'.full.CollectionDetails.AttributesEntryR\n" +
+	"attributes\x12>\n" +
+	"\abudgets\x18\a \x03(\v2$.full.CollectionDetails.BudgetsEntryR\abudgets\x12I\n" +
+	"\fkind_by_name\x18\b \x03(\v2'.full.CollectionDetails.KindByNameEntryR\n" +
+	"kindByName\x123\n" +
+	"\x05stats\x18\t \x01(\v2\x15.full.CollectionStatsB\x06\x82\xa6\x1d\x02 \x01R\x05stats\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aN\n" +
+	"\fBudgetsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.full.common.MoneyR\x05value:\x028\x01\x1aS\n" +
+	"\x0fKindByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x14.full.CollectionKindR\x05value:\x028\x01\"d\n" +
+	"\x0fCollectionShelf\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\adetails\x18\x02 \x01(\v2\x17.full.CollectionDetailsB\x06\x82\xa6\x1d\x02 \x01R\adetails:\x06\x82\xa6\x1d\x02\b\x01*e\n" +
+	"\x0eCollectionKind\x12\x1f\n" +
+	"\x1bCOLLECTION_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14COLLECTION_KIND_BOOK\x10\x01\x12\x18\n" +
+	"\x14COLLECTION_KIND_FILM\x10\x02B2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_collection_proto_rawDescOnce sync.Once
+	file_test_full_collection_proto_rawDescData []byte
+)
+
+func file_test_full_collection_proto_rawDescGZIP() []byte {
+	file_test_full_collection_proto_rawDescOnce.Do(func() {
+		file_test_full_collection_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_collection_proto_rawDesc), len(file_test_full_collection_proto_rawDesc)))
+	})
+	return file_test_full_collection_proto_rawDescData
+}
+
+var file_test_full_collection_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_test_full_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_test_full_collection_proto_goTypes = []any{
+	(CollectionKind)(0),       // 0: full.CollectionKind
+	(*CollectionLabel)(nil),   // 1: full.CollectionLabel
+	(*CollectionStats)(nil),   // 2: full.CollectionStats
+	(*CollectionDetails)(nil), // 3: full.CollectionDetails
+	(*CollectionShelf)(nil),   // 4: full.CollectionShelf
+	nil,                       // 5: full.CollectionStats.RatiosEntry
+	nil,                       // 6: full.CollectionStats.LabelsByIdEntry
+	nil,                       // 7: full.CollectionDetails.AttributesEntry
+	nil,                       // 8: full.CollectionDetails.BudgetsEntry
+	nil,                       // 9: full.CollectionDetails.KindByNameEntry
+	(*common.Money)(nil),      // 10: full.common.Money
+}
+var file_test_full_collection_proto_depIdxs = []int32{
+	5,  // 0: full.CollectionStats.ratios:type_name -> full.CollectionStats.RatiosEntry
+	10, // 1: full.CollectionStats.prices:type_name -> full.common.Money
+	6,  // 2: full.CollectionStats.labels_by_id:type_name -> full.CollectionStats.LabelsByIdEntry
+	0,  // 3: full.CollectionDetails.kinds:type_name -> full.CollectionKind
+	0,  // 4: full.CollectionDetails.kind_names:type_name -> full.CollectionKind
+	1,  // 5: full.CollectionDetails.labels:type_name -> full.CollectionLabel
+	7,  // 6: full.CollectionDetails.attributes:type_name -> full.CollectionDetails.AttributesEntry
+	8,  // 7: full.CollectionDetails.budgets:type_name -> full.CollectionDetails.BudgetsEntry
+	9,  // 8: full.CollectionDetails.kind_by_name:type_name -> full.CollectionDetails.KindByNameEntry
+	2,  // 9: full.CollectionDetails.stats:type_name -> full.CollectionStats
+	3,  // 10: full.CollectionShelf.details:type_name -> full.CollectionDetails
+	1,  // 11: full.CollectionStats.LabelsByIdEntry.value:type_name -> full.CollectionLabel
+	10, // 12: full.CollectionDetails.BudgetsEntry.value:type_name -> full.common.Money
+	0,  // 13: full.CollectionDetails.KindByNameEntry.value:type_name -> full.CollectionKind
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_test_full_collection_proto_init() }
+func file_test_full_collection_proto_init() {
+	if File_test_full_collection_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_collection_proto_rawDesc), len(file_test_full_collection_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_collection_proto_goTypes,
+		DependencyIndexes: file_test_full_collection_proto_depIdxs,
+		EnumInfos:         file_test_full_collection_proto_enumTypes,
+		MessageInfos:      file_test_full_collection_proto_msgTypes,
+	}.Build()
+	File_test_full_collection_proto = out.File
+	file_test_full_collection_proto_goTypes = nil
+	file_test_full_collection_proto_depIdxs = nil
+}
@@ -0,0 +1,416 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/collection.proto
+
+package full
+
+import (
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	strconv "strconv"
+)
+
+// MarshalJX encodes CollectionLabel to JSON using jx.Encoder
+func (p *CollectionLabel) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetKey() != "" {
+		e.FieldStart("key")
+		e.Str(p.GetKey())
+	}
+	if p.GetValue() != "" {
+		e.FieldStart("value")
+		e.Str(p.GetValue())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes CollectionLabel from JSON using jx.Decoder
+func (p *CollectionLabel) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "key":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Key = v
+		case "value":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Value = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes CollectionStats to JSON using jx.Encoder
+func (p *CollectionStats) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if len(p.GetCounters()) > 0 {
+		e.FieldStart("counters")
+		e.ArrStart()
+		for _, v := range p.GetCounters() {
+			e.Int64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetRatios()) > 0 {
+		e.FieldStart("ratios")
+		e.ObjStart()
+		for k, v := range p.GetRatios() {
+			e.FieldStart(k)
+			e.Float64(v)
+		}
+		e.ObjEnd()
+	}
+	if len(p.GetPrices()) > 0 {
+		e.FieldStart("prices")
+		e.ArrStart()
+		for _, v := range p.GetPrices() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetLabelsById()) > 0 {
+		e.FieldStart("labelsById")
+		e.ObjStart()
+		for k, v := range p.GetLabelsById() {
+			e.FieldStart(fmt.Sprint(k))
+			v.MarshalJX(e)
+		}
+		e.ObjEnd()
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes CollectionStats from JSON using jx.Decoder
+func (p *CollectionStats) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "counters":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int64()
+				if err != nil {
+					return err
+				}
+				p.Counters = append(p.Counters, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "ratios":
+			if p.Ratios == nil {
+				p.Ratios = make(map[string]float64)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Float64()
+				if err != nil {
+					return err
+				}
+				p.Ratios[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "prices":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &common.Money{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Prices = append(p.Prices, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "labelsById":
+			if p.LabelsById == nil {
+				p.LabelsById = make(map[int32]*CollectionLabel)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				keyInt, err := strconv.ParseInt(key, 10, 32)
+				if err != nil {
+					return err
+				}
+				v := &CollectionLabel{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.LabelsById[int32(keyInt)] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes CollectionDetails to JSON using jx.Encoder
+func (p *CollectionDetails) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if len(p.GetTags()) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.GetTags() {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetBlobs()) > 0 {
+		e.FieldStart("blobs")
+		e.ArrStart()
+		for _, v := range p.GetBlobs() {
+			e.Base64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetKinds()) > 0 {
+		e.FieldStart("kinds")
+		e.ArrStart()
+		for _, v := range p.GetKinds() {
+			e.Int32(int32(v))
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetKindNames()) > 0 {
+		e.FieldStart("kindNames")
+		e.ArrStart()
+		for _, v := range p.GetKindNames() {
+			e.Int32(int32(v))
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetLabels()) > 0 {
+		e.FieldStart("labels")
+		e.ArrStart()
+		for _, v := range p.GetLabels() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetAttributes()) > 0 {
+		e.FieldStart("attributes")
+		e.ObjStart()
+		for k, v := range p.GetAttributes() {
+			e.FieldStart(k)
+			e.Str(v)
+		}
+		e.ObjEnd()
+	}
+	if len(p.GetBudgets()) > 0 {
+		e.FieldStart("budgets")
+		e.ObjStart()
+		for k, v := range p.GetBudgets() {
+			e.FieldStart(k)
+			v.MarshalJX(e)
+		}
+		e.ObjEnd()
+	}
+	if len(p.GetKindByName()) > 0 {
+		e.FieldStart("kindByName")
+		e.ObjStart()
+		for k, v := range p.GetKindByName() {
+			e.FieldStart(k)
+			e.Int32(int32(v))
+		}
+		e.ObjEnd()
+	}
+	if p.GetStats() != nil {
+		e.FieldStart("stats")
+		p.GetStats().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes CollectionDetails from JSON using jx.Decoder
+func (p *CollectionDetails) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "blobs":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Base64()
+				if err != nil {
+					return err
+				}
+				p.Blobs = append(p.Blobs, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "kinds":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.CollectionKind", CollectionKind_value)
+				if err != nil {
+					return err
+				}
+				p.Kinds = append(p.Kinds, CollectionKind(v))
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "kindNames":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.CollectionKind", CollectionKind_value)
+				if err != nil {
+					return err
+				}
+				p.KindNames = append(p.KindNames, CollectionKind(v))
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "labels":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &CollectionLabel{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Labels = append(p.Labels, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "attributes":
+			if p.Attributes == nil {
+				p.Attributes = make(map[string]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Attributes[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "budgets":
+			if p.Budgets == nil {
+				p.Budgets = make(map[string]*common.Money)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v := &common.Money{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Budgets[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "kindByName":
+			if p.KindByName == nil {
+				p.KindByName = make(map[string]CollectionKind)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := enumjx.Decode(d, "full.CollectionKind", CollectionKind_value)
+				if err != nil {
+					return err
+				}
+				p.KindByName[key] = CollectionKind(v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "stats":
+			p.Stats = &CollectionStats{}
+			if err := p.Stats.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes CollectionShelf to JSON using jx.Encoder
+func (p *CollectionShelf) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetDetails() != nil {
+		e.FieldStart("details")
+		p.GetDetails().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes CollectionShelf from JSON using jx.Decoder
+func (p *CollectionShelf) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "details":
+			p.Details = &CollectionDetails{}
+			if err := p.Details.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,1905 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/collection.proto
+
+package full
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	maps "maps"
+	math "math"
+	slices "slices"
+	strconv "strconv"
+	sync "sync"
+)
+
+type CollectionLabelPlain struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *CollectionLabel) IntoPlain() *CollectionLabelPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &CollectionLabelPlain{}
+
+	p.Key = pb.Key
+	p.Value = pb.Value
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *CollectionLabelPlain) IntoPb() *CollectionLabel {
+	if p == nil {
+		return nil
+	}
+	pb := &CollectionLabel{}
+
+	pb.Key = p.Key
+	pb.Value = p.Value
+	return pb
+}
+
+// collectionLabelPlainMergePaths are the fields of CollectionLabel covered by CollectionLabelPlain
+var collectionLabelPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *CollectionLabelPlain) IntoPbMerge(dst *CollectionLabel) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, collectionLabelPlainMergePaths...)
+}
+
+// collectionLabelPlainFieldPaths link the JSON names of CollectionLabelPlain to field-mask paths of CollectionLabel
+var collectionLabelPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "key", Path: "key"},
+	{Name: "value", Path: "value"},
+}
+
+// CollectionLabelPlainFieldMask builds a field mask of CollectionLabel from JSON names of CollectionLabelPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func CollectionLabelPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(collectionLabelPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// CollectionLabelPlainFieldNames translates a field mask of CollectionLabel into JSON names of CollectionLabelPlain.
+// A path to an embedded message yields all fields flattened from it.
+func CollectionLabelPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(collectionLabelPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of CollectionLabel, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *CollectionLabelPlain) ApplyToPb(pb *CollectionLabel, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), collectionLabelPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *CollectionLabel) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*CollectionLabelPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), collectionLabelPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &CollectionLabel{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *CollectionLabel) IntoPlainReuse(p *CollectionLabelPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.Key = pb.Key
+	p.Value = pb.Value
+}
+
+// MarshalJX encodes CollectionLabelPlain to JSON using jx.Encoder
+func (p *CollectionLabelPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Key != "" {
+		e.FieldStart("key")
+		e.Str(p.Key)
+	}
+	if p.Value != "" {
+		e.FieldStart("value")
+		e.Str(p.Value)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *CollectionLabelPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes CollectionLabelPlain from JSON using jx.Decoder
+func (p *CollectionLabelPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "key":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Key = v
+		case "value":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Value = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *CollectionLabelPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes CollectionLabelPlain in the protobuf wire format of CollectionLabel
+func (p *CollectionLabelPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends CollectionLabelPlain encoded in the protobuf wire format of CollectionLabel to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *CollectionLabelPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Key; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Value; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes CollectionLabelPlain from the protobuf wire format of CollectionLabel.
+// Unknown fields are skipped.
+func (p *CollectionLabelPlain) UnmarshalProto(b []byte) error {
+	*p = CollectionLabelPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Key = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Value = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// collectionLabelPlainReflect describes CollectionLabelPlain as message full.plain.CollectionLabelPlain
+var collectionLabelPlainReflect = plainreflect.NewMessageInfo(file_test_full_collection_proto_plain, "CollectionLabelPlain",
+	plainreflect.Scalar(func(p *CollectionLabelPlain) *string { return &p.Key }),
+	plainreflect.Scalar(func(p *CollectionLabelPlain) *string { return &p.Value }),
+)
+
+// ProtoReflect returns the reflective view of CollectionLabelPlain backed by the struct
+func (p *CollectionLabelPlain) ProtoReflect() protoreflect.Message {
+	return collectionLabelPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of CollectionLabelPlain
+func (p *CollectionLabelPlain) Clone() *CollectionLabelPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &CollectionLabelPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of CollectionLabelPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetCollectionLabelPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *CollectionLabelPlain) CopyTo(dst *CollectionLabelPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Key = p.Key
+	dst.Value = p.Value
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *CollectionLabelPlain) Equal(other *CollectionLabelPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Key != other.Key {
+		return false
+	}
+	if p.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of CollectionLabelPlain rows, in the order of ScanRow and Values
+func (p *CollectionLabelPlain) Columns() []string {
+	return []string{
+		"key",
+		"value",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into CollectionLabelPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *CollectionLabelPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Key,
+		&p.Value,
+	)
+}
+
+// Values returns the column values of CollectionLabelPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *CollectionLabelPlain) Values() []any {
+	return []any{
+		p.Key,
+		p.Value,
+	}
+}
+
+// collectionLabelPlainPool is a sync.Pool for CollectionLabelPlain objects
+var collectionLabelPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &CollectionLabelPlain{}
+	},
+}
+
+// GetCollectionLabelPlain returns a CollectionLabelPlain from the pool
+func GetCollectionLabelPlain() *CollectionLabelPlain {
+	return collectionLabelPlainPool.Get().(*CollectionLabelPlain)
+}
+
+// PutCollectionLabelPlain returns a CollectionLabelPlain to the pool after resetting it
+func PutCollectionLabelPlain(p *CollectionLabelPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	collectionLabelPlainPool.Put(p)
+}
+
+// Reset clears all fields in CollectionLabelPlain for reuse
+func (p *CollectionLabelPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Key = ""
+	p.Value = ""
+}
+
+type CollectionShelfPlain struct {
+	Id         string                          `json:"id"`
+	Tags       []string                        `json:"tags"`
+	Blobs      [][]byte                        `json:"blobs"`
+	Kinds      []CollectionKind                `json:"kinds"`
+	KindNames  []string                        `json:"kindNames"`
+	Labels     []CollectionLabelPlain          `json:"labels"`
+	Attributes map[string]string               `json:"attributes"`
+	Budgets    map[string]*common.Money        `json:"budgets"`
+	KindByName map[string]CollectionKind       `json:"kindByName"`
+	Counters   []int64                         `json:"counters"`
+	Ratios     map[string]float64              `json:"ratios"`
+	Prices     []*common.Money                 `json:"prices"`
+	LabelsById map[int32]*CollectionLabelPlain `json:"labelsById"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *CollectionShelf) IntoPlain() *CollectionShelfPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &CollectionShelfPlain{}
+
+	p.Id = pb.Id
+	// Tags from
+	if pb.GetDetails() != nil {
+		if len(pb.GetDetails().GetTags()) > 0 {
+			p.Tags = pb.GetDetails().GetTags()
+		} else {
+			p.Tags = []string{}
+		}
+	}
+	// Blobs from
+	if pb.GetDetails() != nil {
+		if len(pb.GetDetails().GetBlobs()) > 0 {
+			p.Blobs = pb.GetDetails().GetBlobs()
+		} else {
+			p.Blobs = [][]byte{}
+		}
+	}
+	// Kinds from
+	if pb.GetDetails() != nil {
+		if len(pb.GetDetails().GetKinds()) > 0 {
+			p.Kinds = pb.GetDetails().GetKinds()
+		} else {
+			p.Kinds = []CollectionKind{}
+		}
+	}
+	// KindNames from
+	if pb.GetDetails() != nil {
+		if len(pb.GetDetails().GetKindNames()) > 0 {
+			p.KindNames = make([]string, len(pb.GetDetails().GetKindNames()))
+			for i, v := range pb.GetDetails().GetKindNames() {
+				p.KindNames[i] = v.String()
+			}
+		} else {
+			p.KindNames = []string{}
+		}
+	}
+	// Labels from
+	if pb.GetDetails() != nil && pb.GetDetails().GetLabels() != nil {
+		if len(pb.GetDetails().GetLabels()) > 0 {
+			p.Labels = make([]CollectionLabelPlain, len(pb.GetDetails().GetLabels()))
+			for i, v := range pb.GetDetails().GetLabels() {
+				if v != nil {
+					p.Labels[i] = *v.IntoPlain()
+				}
+			}
+		} else {
+			p.Labels = []CollectionLabelPlain{}
+		}
+	}
+	// Attributes from
+	if pb.GetDetails() != nil && pb.GetDetails().GetAttributes() != nil {
+		p.Attributes = pb.GetDetails().GetAttributes()
+	}
+	// Budgets from
+	if pb.GetDetails() != nil && pb.GetDetails().GetBudgets() != nil {
+		p.Budgets = pb.GetDetails().GetBudgets()
+	}
+	// KindByName from
+	if pb.GetDetails() != nil && pb.GetDetails().GetKindByName() != nil {
+		p.KindByName = pb.GetDetails().GetKindByName()
+	}
+	// Counters from
+	if pb.GetDetails() != nil && pb.GetDetails().GetStats() != nil {
+		if len(pb.GetDetails().GetStats().GetCounters()) > 0 {
+			p.Counters = pb.GetDetails().GetStats().GetCounters()
+		} else {
+			p.Counters = []int64{}
+		}
+	}
+	// Ratios from
+	if pb.GetDetails() != nil && pb.GetDetails().GetStats() != nil && pb.GetDetails().GetStats().GetRatios() != nil {
+		p.Ratios = pb.GetDetails().GetStats().GetRatios()
+	}
+	// Prices from
+	if pb.GetDetails() != nil && pb.GetDetails().GetStats() != nil && pb.GetDetails().GetStats().GetPrices() != nil {
+		if len(pb.GetDetails().GetStats().GetPrices()) > 0 {
+			p.Prices = pb.GetDetails().GetStats().GetPrices()
+		} else {
+			p.Prices = []*common.Money{}
+		}
+	}
+	// LabelsById from
+	if pb.GetDetails() != nil && pb.GetDetails().GetStats() != nil && pb.GetDetails().GetStats().GetLabelsById() != nil {
+		if len(pb.GetDetails().GetStats().GetLabelsById()) > 0 {
+			p.LabelsById = make(map[int32]*CollectionLabelPlain, len(pb.GetDetails().GetStats().GetLabelsById()))
+			for k, v := range pb.GetDetails().GetStats().GetLabelsById() {
+				if v != nil {
+					p.LabelsById[k] = v.IntoPlain()
+				}
+			}
+		}
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails or an enum name is unknown, use IntoPbE to handle the error.
+func (p *CollectionShelfPlain) IntoPb() *CollectionShelf {
+	pb, err := p.IntoPbE()
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError wrapping *enumjx.UnknownValueError for unknown enum names.
+func (p *CollectionShelfPlain) IntoPbE() (*CollectionShelf, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &CollectionShelf{}
+	var _err error
+
+	pb.Id = p.Id
+	// Tags ->
+	if len(p.Tags) > 0 {
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		pb.Details.Tags = p.Tags
+	}
+	// Blobs ->
+	if len(p.Blobs) > 0 {
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		pb.Details.Blobs = p.Blobs
+	}
+	// Kinds ->
+	if len(p.Kinds) > 0 {
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		pb.Details.Kinds = p.Kinds
+	}
+	// KindNames ->
+	if len(p.KindNames) > 0 {
+		_enums := make([]CollectionKind, len(p.KindNames))
+		for i, v := range p.KindNames {
+			_enums[i] = enumjx.TryName[CollectionKind](v, "full.CollectionKind", CollectionKind_value, "kind_names", &_err)
+		}
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		pb.Details.KindNames = _enums
+	}
+	// Labels ->
+	if len(p.Labels) > 0 {
+		_items := make([]*CollectionLabel, len(p.Labels))
+		for i := range p.Labels {
+			_items[i] = (&p.Labels[i]).IntoPb()
+		}
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		pb.Details.Labels = _items
+	}
+	// Attributes ->
+	if len(p.Attributes) > 0 {
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		pb.Details.Attributes = p.Attributes
+	}
+	// Budgets ->
+	if len(p.Budgets) > 0 {
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		pb.Details.Budgets = p.Budgets
+	}
+	// KindByName ->
+	if len(p.KindByName) > 0 {
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		pb.Details.KindByName = p.KindByName
+	}
+	// Counters ->
+	if len(p.Counters) > 0 {
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		if pb.Details.Stats == nil {
+			pb.Details.Stats = &CollectionStats{}
+		}
+		pb.Details.Stats.Counters = p.Counters
+	}
+	// Ratios ->
+	if len(p.Ratios) > 0 {
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		if pb.Details.Stats == nil {
+			pb.Details.Stats = &CollectionStats{}
+		}
+		pb.Details.Stats.Ratios = p.Ratios
+	}
+	// Prices ->
+	if len(p.Prices) > 0 {
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		if pb.Details.Stats == nil {
+			pb.Details.Stats = &CollectionStats{}
+		}
+		pb.Details.Stats.Prices = p.Prices
+	}
+	// LabelsById ->
+	if len(p.LabelsById) > 0 {
+		_map := make(map[int32]*CollectionLabel, len(p.LabelsById))
+		for k, v := range p.LabelsById {
+			if v != nil {
+				_map[k] = v.IntoPb()
+			}
+		}
+		if pb.Details == nil {
+			pb.Details = &CollectionDetails{}
+		}
+		if pb.Details.Stats == nil {
+			pb.Details.Stats = &CollectionStats{}
+		}
+		pb.Details.Stats.LabelsById = _map
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// collectionShelfPlainMergePaths are the fields of CollectionShelf covered by CollectionShelfPlain
+var collectionShelfPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2, 1},
+	{2, 2},
+	{2, 3},
+	{2, 4},
+	{2, 5},
+	{2, 6},
+	{2, 7},
+	{2, 8},
+	{2, 9, 1},
+	{2, 9, 2},
+	{2, 9, 3},
+	{2, 9, 4},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *CollectionShelfPlain) IntoPbMerge(dst *CollectionShelf) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, collectionShelfPlainMergePaths...)
+	return nil
+}
+
+// collectionShelfPlainFieldPaths link the JSON names of CollectionShelfPlain to field-mask paths of CollectionShelf
+var collectionShelfPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "tags", Path: "details.tags"},
+	{Name: "blobs", Path: "details.blobs"},
+	{Name: "kinds", Path: "details.kinds"},
+	{Name: "kindNames", Path: "details.kind_names"},
+	{Name: "labels", Path: "details.labels"},
+	{Name: "attributes", Path: "details.attributes"},
+	{Name: "budgets", Path: "details.budgets"},
+	{Name: "kindByName", Path: "details.kind_by_name"},
+	{Name: "counters", Path: "details.stats.counters"},
+	{Name: "ratios", Path: "details.stats.ratios"},
+	{Name: "prices", Path: "details.stats.prices"},
+	{Name: "labelsById", Path: "details.stats.labels_by_id"},
+}
+
+// CollectionShelfPlainFieldMask builds a field mask of CollectionShelf from JSON names of CollectionShelfPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func CollectionShelfPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(collectionShelfPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// CollectionShelfPlainFieldNames translates a field mask of CollectionShelf into JSON names of CollectionShelfPlain.
+// A path to an embedded message yields all fields flattened from it.
+func CollectionShelfPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(collectionShelfPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of CollectionShelf, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *CollectionShelfPlain) ApplyToPb(pb *CollectionShelf, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), collectionShelfPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *CollectionShelf) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*CollectionShelfPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), collectionShelfPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &CollectionShelf{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *CollectionShelf) IntoPlainReuse(p *CollectionShelfPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.Id = pb.Id
+	// Tags from
+	if pb.GetDetails() != nil {
+		if len(pb.GetDetails().GetTags()) > 0 {
+			p.Tags = pb.GetDetails().GetTags()
+		} else {
+			p.Tags = []string{}
+		}
+	}
+	// Blobs from
+	if pb.GetDetails() != nil {
+		if len(pb.GetDetails().GetBlobs()) > 0 {
+			p.Blobs = pb.GetDetails().GetBlobs()
+		} else {
+			p.Blobs = [][]byte{}
+		}
+	}
+	// Kinds from
+	if pb.GetDetails() != nil {
+		if len(pb.GetDetails().GetKinds()) > 0 {
+			p.Kinds = pb.GetDetails().GetKinds()
+		} else {
+			p.Kinds = []CollectionKind{}
+		}
+	}
+	// KindNames from
+	if pb.GetDetails() != nil {
+		if len(pb.GetDetails().GetKindNames()) > 0 {
+			p.KindNames = make([]string, len(pb.GetDetails().GetKindNames()))
+			for i, v := range pb.GetDetails().GetKindNames() {
+				p.KindNames[i] = v.String()
+			}
+		} else {
+			p.KindNames = []string{}
+		}
+	}
+	// Labels from
+	if pb.GetDetails() != nil && pb.GetDetails().GetLabels() != nil {
+		if len(pb.GetDetails().GetLabels()) > 0 {
+			p.Labels = make([]CollectionLabelPlain, len(pb.GetDetails().GetLabels()))
+			for i, v := range pb.GetDetails().GetLabels() {
+				if v != nil {
+					p.Labels[i] = *v.IntoPlain()
+				}
+			}
+		} else {
+			p.Labels = []CollectionLabelPlain{}
+		}
+	}
+	// Attributes from
+	if pb.GetDetails() != nil && pb.GetDetails().GetAttributes() != nil {
+		p.Attributes = pb.GetDetails().GetAttributes()
+	}
+	// Budgets from
+	if pb.GetDetails() != nil && pb.GetDetails().GetBudgets() != nil {
+		p.Budgets = pb.GetDetails().GetBudgets()
+	}
+	// KindByName from
+	if pb.GetDetails() != nil && pb.GetDetails().GetKindByName() != nil {
+		p.KindByName = pb.GetDetails().GetKindByName()
+	}
+	// Counters from
+	if pb.GetDetails() != nil && pb.GetDetails().GetStats() != nil {
+		if len(pb.GetDetails().GetStats().GetCounters()) > 0 {
+			p.Counters = pb.GetDetails().GetStats().GetCounters()
+		} else {
+			p.Counters = []int64{}
+		}
+	}
+	// Ratios from
+	if pb.GetDetails() != nil && pb.GetDetails().GetStats() != nil && pb.GetDetails().GetStats().GetRatios() != nil {
+		p.Ratios = pb.GetDetails().GetStats().GetRatios()
+	}
+	// Prices from
+	if pb.GetDetails() != nil && pb.GetDetails().GetStats() != nil && pb.GetDetails().GetStats().GetPrices() != nil {
+		if len(pb.GetDetails().GetStats().GetPrices()) > 0 {
+			p.Prices = pb.GetDetails().GetStats().GetPrices()
+		} else {
+			p.Prices = []*common.Money{}
+		}
+	}
+	// LabelsById from
+	if pb.GetDetails() != nil && pb.GetDetails().GetStats() != nil && pb.GetDetails().GetStats().GetLabelsById() != nil {
+		if len(pb.GetDetails().GetStats().GetLabelsById()) > 0 {
+			p.LabelsById = make(map[int32]*CollectionLabelPlain, len(pb.GetDetails().GetStats().GetLabelsById()))
+			for k, v := range pb.GetDetails().GetStats().GetLabelsById() {
+				if v != nil {
+					p.LabelsById[k] = v.IntoPlain()
+				}
+			}
+		}
+	}
+}
+
+// MarshalJX encodes CollectionShelfPlain to JSON using jx.Encoder
+func (p *CollectionShelfPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	if len(p.Tags) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.Tags {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.Blobs) > 0 {
+		e.FieldStart("blobs")
+		e.ArrStart()
+		for _, v := range p.Blobs {
+			e.Base64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.Kinds) > 0 {
+		e.FieldStart("kinds")
+		e.ArrStart()
+		for _, v := range p.Kinds {
+			e.Int32(int32(v))
+		}
+		e.ArrEnd()
+	}
+	if len(p.KindNames) > 0 {
+		e.FieldStart("kindNames")
+		e.ArrStart()
+		for _, v := range p.KindNames {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.Labels) > 0 {
+		e.FieldStart("labels")
+		e.ArrStart()
+		for _, v := range p.Labels {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("attributes")
+	e.ObjStart()
+	for k, v := range p.Attributes {
+		e.FieldStart(k)
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("budgets")
+	e.ObjStart()
+	for k, v := range p.Budgets {
+		e.FieldStart(k)
+		v.MarshalJX(e)
+	}
+	e.ObjEnd()
+	e.FieldStart("kindByName")
+	e.ObjStart()
+	for k, v := range p.KindByName {
+		e.FieldStart(k)
+		e.Int32(int32(v))
+	}
+	e.ObjEnd()
+	if len(p.Counters) > 0 {
+		e.FieldStart("counters")
+		e.ArrStart()
+		for _, v := range p.Counters {
+			e.Int64(v)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("ratios")
+	e.ObjStart()
+	for k, v := range p.Ratios {
+		e.FieldStart(k)
+		e.Float64(v)
+	}
+	e.ObjEnd()
+	if p.Prices != nil {
+		e.FieldStart("prices")
+		e.ArrStart()
+		for _, v := range p.Prices {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("labelsById")
+	e.ObjStart()
+	for k, v := range p.LabelsById {
+		e.FieldStart(fmt.Sprint(k))
+		v.MarshalJX(e)
+	}
+	e.ObjEnd()
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *CollectionShelfPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes CollectionShelfPlain from JSON using jx.Decoder
+func (p *CollectionShelfPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "blobs":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Base64()
+				if err != nil {
+					return err
+				}
+				p.Blobs = append(p.Blobs, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "kinds":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.CollectionKind", CollectionKind_value)
+				if err != nil {
+					return err
+				}
+				p.Kinds = append(p.Kinds, CollectionKind(v))
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "kindNames":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.DecodeName(d, "full.CollectionKind", CollectionKind_value, CollectionKind_name)
+				if err != nil {
+					return err
+				}
+				p.KindNames = append(p.KindNames, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "labels":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v CollectionLabelPlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Labels = append(p.Labels, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "attributes":
+			if p.Attributes == nil {
+				p.Attributes = make(map[string]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Attributes[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "budgets":
+			if p.Budgets == nil {
+				p.Budgets = make(map[string]*common.Money)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				p.Budgets[key] = &common.Money{}
+				if err := p.Budgets[key].UnmarshalJX(d); err != nil {
+					return err
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "kindByName":
+			if p.KindByName == nil {
+				p.KindByName = make(map[string]CollectionKind)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := enumjx.Decode(d, "full.CollectionKind", CollectionKind_value)
+				if err != nil {
+					return err
+				}
+				p.KindByName[key] = CollectionKind(v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "counters":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int64()
+				if err != nil {
+					return err
+				}
+				p.Counters = append(p.Counters, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "ratios":
+			if p.Ratios == nil {
+				p.Ratios = make(map[string]float64)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Float64()
+				if err != nil {
+					return err
+				}
+				p.Ratios[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "prices":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v common.Money
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Prices = append(p.Prices, &v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "labelsById":
+			if p.LabelsById == nil {
+				p.LabelsById = make(map[int32]*CollectionLabelPlain)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_k, err := strconv.ParseInt(key, 10, 32)
+				if err != nil {
+					return err
+				}
+				_mapKey := int32(_k)
+				p.LabelsById[_mapKey] = &CollectionLabelPlain{}
+				if err := p.LabelsById[_mapKey].UnmarshalJX(d); err != nil {
+					return err
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *CollectionShelfPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes CollectionShelfPlain in the protobuf wire format of CollectionShelf
+func (p *CollectionShelfPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends CollectionShelfPlain encoded in the protobuf wire format of CollectionShelf to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *CollectionShelfPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	// details (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.Tags {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		for _, v := range p.Blobs {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendBytes(b, v)
+		}
+		if len(p.Kinds) > 0 {
+			b = protowire.AppendTag(b, 3, protowire.BytesType)
+			_start := len(b)
+			for _, v := range p.Kinds {
+				b = protowire.AppendVarint(b, uint64(v))
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+		if len(p.KindNames) > 0 {
+			b = protowire.AppendTag(b, 4, protowire.BytesType)
+			_start := len(b)
+			for _, v := range p.KindNames {
+				b = protowire.AppendVarint(b, uint64(enumjx.TryName[CollectionKind](v, "full.CollectionKind", CollectionKind_value, "kind_names", &_err)))
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+		for i := range p.Labels {
+			b = protowire.AppendTag(b, 5, protowire.BytesType)
+			_start := len(b)
+			if b, err = p.Labels[i].AppendProto(b); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+		for _, k := range plainwire.SortedKeys(p.Attributes) {
+			v := p.Attributes[k]
+			b = protowire.AppendTag(b, 6, protowire.BytesType)
+			_start := len(b)
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, k)
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+			b = plainwire.FinishLen(b, _start)
+		}
+		for _, k := range plainwire.SortedKeys(p.Budgets) {
+			v := p.Budgets[k]
+			b = protowire.AppendTag(b, 7, protowire.BytesType)
+			_start := len(b)
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, k)
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			_value := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, v); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _value)
+			b = plainwire.FinishLen(b, _start)
+		}
+		for _, k := range plainwire.SortedKeys(p.KindByName) {
+			v := p.KindByName[k]
+			b = protowire.AppendTag(b, 8, protowire.BytesType)
+			_start := len(b)
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, k)
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+			b = plainwire.FinishLen(b, _start)
+		}
+		// stats (embedded)
+		{
+			_tag := len(b)
+			b = protowire.AppendTag(b, 9, protowire.BytesType)
+			_start := len(b)
+			if len(p.Counters) > 0 {
+				b = protowire.AppendTag(b, 1, protowire.BytesType)
+				_start := len(b)
+				for _, v := range p.Counters {
+					b = protowire.AppendVarint(b, uint64(v))
+				}
+				b = plainwire.FinishLen(b, _start)
+			}
+			for _, k := range plainwire.SortedKeys(p.Ratios) {
+				v := p.Ratios[k]
+				b = protowire.AppendTag(b, 2, protowire.BytesType)
+				_start := len(b)
+				b = protowire.AppendTag(b, 1, protowire.BytesType)
+				b = protowire.AppendString(b, k)
+				b = protowire.AppendTag(b, 2, protowire.Fixed64Type)
+				b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+				b = plainwire.FinishLen(b, _start)
+			}
+			for _, m := range p.Prices {
+				b = protowire.AppendTag(b, 3, protowire.BytesType)
+				_start := len(b)
+				if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+					return nil, err
+				}
+				b = plainwire.FinishLen(b, _start)
+			}
+			for _, k := range plainwire.SortedKeys(p.LabelsById) {
+				v := p.LabelsById[k]
+				b = protowire.AppendTag(b, 4, protowire.BytesType)
+				_start := len(b)
+				b = protowire.AppendTag(b, 1, protowire.VarintType)
+				b = protowire.AppendVarint(b, uint64(k))
+				b = protowire.AppendTag(b, 2, protowire.BytesType)
+				_value := len(b)
+				if b, err = v.AppendProto(b); err != nil {
+					return nil, err
+				}
+				b = plainwire.FinishLen(b, _value)
+				b = plainwire.FinishLen(b, _start)
+			}
+			b = plainwire.FinishEmbed(b, _tag, _start)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes CollectionShelfPlain from the protobuf wire format of CollectionShelf.
+// Unknown fields are skipped.
+func (p *CollectionShelfPlain) UnmarshalProto(b []byte) error {
+	*p = CollectionShelfPlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.Tags = append(p.Tags, v)
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					p.Blobs = append(p.Blobs, append([]byte{}, v...))
+				case num == 3 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					for len(v) > 0 {
+						x, m := protowire.ConsumeVarint(v)
+						if m < 0 {
+							return protowire.ParseError(m)
+						}
+						v = v[m:]
+						p.Kinds = append(p.Kinds, CollectionKind(x))
+					}
+				case num == 3 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.Kinds = append(p.Kinds, CollectionKind(v))
+				case num == 4 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					for len(v) > 0 {
+						x, m := protowire.ConsumeVarint(v)
+						if m < 0 {
+							return protowire.ParseError(m)
+						}
+						v = v[m:]
+						p.KindNames = append(p.KindNames, CollectionKind(x).String())
+					}
+				case num == 4 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.KindNames = append(p.KindNames, CollectionKind(v).String())
+				case num == 5 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					var e CollectionLabelPlain
+					if err := e.UnmarshalProto(v); err != nil {
+						return err
+					}
+					p.Labels = append(p.Labels, e)
+				case num == 6 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					var mk string
+					var mv string
+					for b := v; len(b) > 0; {
+						num, typ, n := protowire.ConsumeTag(b)
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+						switch {
+						case num == 1 && typ == protowire.BytesType:
+							var v string
+							v, n = protowire.ConsumeString(b)
+							mk = v
+						case num == 2 && typ == protowire.BytesType:
+							var v string
+							v, n = protowire.ConsumeString(b)
+							mv = v
+						default:
+							n = protowire.ConsumeFieldValue(num, typ, b)
+						}
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+					}
+					if p.Attributes == nil {
+						p.Attributes = make(map[string]string)
+					}
+					p.Attributes[mk] = mv
+				case num == 7 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					var mk string
+					mv := &common.Money{}
+					for b := v; len(b) > 0; {
+						num, typ, n := protowire.ConsumeTag(b)
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+						switch {
+						case num == 1 && typ == protowire.BytesType:
+							var v string
+							v, n = protowire.ConsumeString(b)
+							mk = v
+						case num == 2 && typ == protowire.BytesType:
+							var v []byte
+							v, n = protowire.ConsumeBytes(b)
+							if err := proto.Unmarshal(v, mv); err != nil {
+								return err
+							}
+						default:
+							n = protowire.ConsumeFieldValue(num, typ, b)
+						}
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+					}
+					if p.Budgets == nil {
+						p.Budgets = make(map[string]*common.Money)
+					}
+					p.Budgets[mk] = mv
+				case num == 8 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					var mk string
+					var mv CollectionKind
+					for b := v; len(b) > 0; {
+						num, typ, n := protowire.ConsumeTag(b)
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+						switch {
+						case num == 1 && typ == protowire.BytesType:
+							var v string
+							v, n = protowire.ConsumeString(b)
+							mk = v
+						case num == 2 && typ == protowire.VarintType:
+							var v uint64
+							v, n = protowire.ConsumeVarint(b)
+							mv = CollectionKind(v)
+						default:
+							n = protowire.ConsumeFieldValue(num, typ, b)
+						}
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+					}
+					if p.KindByName == nil {
+						p.KindByName = make(map[string]CollectionKind)
+					}
+					p.KindByName[mk] = mv
+				case num == 9 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					for b := v; len(b) > 0; {
+						num, typ, n := protowire.ConsumeTag(b)
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+						switch {
+						case num == 1 && typ == protowire.BytesType:
+							var v []byte
+							v, n = protowire.ConsumeBytes(b)
+							for len(v) > 0 {
+								x, m := protowire.ConsumeVarint(v)
+								if m < 0 {
+									return protowire.ParseError(m)
+								}
+								v = v[m:]
+								p.Counters = append(p.Counters, int64(x))
+							}
+						case num == 1 && typ == protowire.VarintType:
+							var v uint64
+							v, n = protowire.ConsumeVarint(b)
+							p.Counters = append(p.Counters, int64(v))
+						case num == 2 && typ == protowire.BytesType:
+							var v []byte
+							v, n = protowire.ConsumeBytes(b)
+							var mk string
+							var mv float64
+							for b := v; len(b) > 0; {
+								num, typ, n := protowire.ConsumeTag(b)
+								if n < 0 {
+									return protowire.ParseError(n)
+								}
+								b = b[n:]
+								switch {
+								case num == 1 && typ == protowire.BytesType:
+									var v string
+									v, n = protowire.ConsumeString(b)
+									mk = v
+								case num == 2 && typ == protowire.Fixed64Type:
+									var v uint64
+									v, n = protowire.ConsumeFixed64(b)
+									mv = math.Float64frombits(v)
+								default:
+									n = protowire.ConsumeFieldValue(num, typ, b)
+								}
+								if n < 0 {
+									return protowire.ParseError(n)
+								}
+								b = b[n:]
+							}
+							if p.Ratios == nil {
+								p.Ratios = make(map[string]float64)
+							}
+							p.Ratios[mk] = mv
+						case num == 3 && typ == protowire.BytesType:
+							var v []byte
+							v, n = protowire.ConsumeBytes(b)
+							m := &common.Money{}
+							if err := proto.Unmarshal(v, m); err != nil {
+								return err
+							}
+							p.Prices = append(p.Prices, m)
+						case num == 4 && typ == protowire.BytesType:
+							var v []byte
+							v, n = protowire.ConsumeBytes(b)
+							var mk int32
+							mv := &CollectionLabelPlain{}
+							for b := v; len(b) > 0; {
+								num, typ, n := protowire.ConsumeTag(b)
+								if n < 0 {
+									return protowire.ParseError(n)
+								}
+								b = b[n:]
+								switch {
+								case num == 1 && typ == protowire.VarintType:
+									var v uint64
+									v, n = protowire.ConsumeVarint(b)
+									mk = int32(v)
+								case num == 2 && typ == protowire.BytesType:
+									var v []byte
+									v, n = protowire.ConsumeBytes(b)
+									if err := mv.UnmarshalProto(v); err != nil {
+										return err
+									}
+								default:
+									n = protowire.ConsumeFieldValue(num, typ, b)
+								}
+								if n < 0 {
+									return protowire.ParseError(n)
+								}
+								b = b[n:]
+							}
+							if p.LabelsById == nil {
+								p.LabelsById = make(map[int32]*CollectionLabelPlain)
+							}
+							p.LabelsById[mk] = mv
+						default:
+							n = protowire.ConsumeFieldValue(num, typ, b)
+						}
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// collectionShelfPlainReflect describes CollectionShelfPlain as message full.plain.CollectionShelfPlain
+var collectionShelfPlainReflect = plainreflect.NewMessageInfo(file_test_full_collection_proto_plain, "CollectionShelfPlain",
+	plainreflect.Scalar(func(p *CollectionShelfPlain) *string { return &p.Id }),
+	plainreflect.List(func(p *CollectionShelfPlain) *[]string { return &p.Tags }),
+	plainreflect.List(func(p *CollectionShelfPlain) *[][]byte { return &p.Blobs }),
+	plainreflect.EnumList(func(p *CollectionShelfPlain) *[]CollectionKind { return &p.Kinds }),
+	plainreflect.List(func(p *CollectionShelfPlain) *[]string { return &p.KindNames }),
+	plainreflect.ValueList(func(p *CollectionShelfPlain) *[]CollectionLabelPlain { return &p.Labels }),
+	plainreflect.Map(func(p *CollectionShelfPlain) *map[string]string { return &p.Attributes }),
+	plainreflect.MessageMap(func(p *CollectionShelfPlain) *map[string]*common.Money { return &p.Budgets }),
+	plainreflect.EnumMap(func(p *CollectionShelfPlain) *map[string]CollectionKind { return &p.KindByName }),
+	plainreflect.List(func(p *CollectionShelfPlain) *[]int64 { return &p.Counters }),
+	plainreflect.Map(func(p *CollectionShelfPlain) *map[string]float64 { return &p.Ratios }),
+	plainreflect.MessageList(func(p *CollectionShelfPlain) *[]*common.Money { return &p.Prices }),
+	plainreflect.MessageMap(func(p *CollectionShelfPlain) *map[int32]*CollectionLabelPlain { return &p.LabelsById }),
+)
+
+// ProtoReflect returns the reflective view of CollectionShelfPlain backed by the struct
+func (p *CollectionShelfPlain) ProtoReflect() protoreflect.Message {
+	return collectionShelfPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of CollectionShelfPlain
+func (p *CollectionShelfPlain) Clone() *CollectionShelfPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &CollectionShelfPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of CollectionShelfPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetCollectionShelfPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *CollectionShelfPlain) CopyTo(dst *CollectionShelfPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Id = p.Id
+	dst.Tags = append(dst.Tags[:0], p.Tags...)
+	dst.Blobs = append(dst.Blobs[:0], p.Blobs...)
+	for i, v := range dst.Blobs {
+		dst.Blobs[i] = append([]byte(nil), v...)
+	}
+	dst.Kinds = append(dst.Kinds[:0], p.Kinds...)
+	dst.KindNames = append(dst.KindNames[:0], p.KindNames...)
+	if cap(dst.Labels) < len(p.Labels) {
+		dst.Labels = make([]CollectionLabelPlain, len(p.Labels))
+	} else {
+		dst.Labels = dst.Labels[:len(p.Labels)]
+	}
+	for i := range p.Labels {
+		p.Labels[i].CopyTo(&dst.Labels[i])
+	}
+	for k := range dst.Attributes {
+		delete(dst.Attributes, k)
+	}
+	if dst.Attributes == nil && p.Attributes != nil {
+		dst.Attributes = make(map[string]string, len(p.Attributes))
+	}
+	for k, v := range p.Attributes {
+		dst.Attributes[k] = v
+	}
+	for k := range dst.Budgets {
+		if _, ok := p.Budgets[k]; !ok {
+			delete(dst.Budgets, k)
+		}
+	}
+	if dst.Budgets == nil && p.Budgets != nil {
+		dst.Budgets = make(map[string]*common.Money, len(p.Budgets))
+	}
+	for k, v := range p.Budgets {
+		if v == nil {
+			dst.Budgets[k] = nil
+			continue
+		}
+		d := dst.Budgets[k]
+		if d == nil {
+			d = new(common.Money)
+		} else {
+			proto.Reset(d)
+		}
+		proto.Merge(d, v)
+		dst.Budgets[k] = d
+	}
+	for k := range dst.KindByName {
+		delete(dst.KindByName, k)
+	}
+	if dst.KindByName == nil && p.KindByName != nil {
+		dst.KindByName = make(map[string]CollectionKind, len(p.KindByName))
+	}
+	for k, v := range p.KindByName {
+		dst.KindByName[k] = v
+	}
+	dst.Counters = append(dst.Counters[:0], p.Counters...)
+	for k := range dst.Ratios {
+		delete(dst.Ratios, k)
+	}
+	if dst.Ratios == nil && p.Ratios != nil {
+		dst.Ratios = make(map[string]float64, len(p.Ratios))
+	}
+	for k, v := range p.Ratios {
+		dst.Ratios[k] = v
+	}
+	if cap(dst.Prices) < len(p.Prices) {
+		dst.Prices = append(dst.Prices[:cap(dst.Prices)], make([]*common.Money, len(p.Prices)-cap(dst.Prices))...)
+	} else {
+		dst.Prices = dst.Prices[:len(p.Prices)]
+	}
+	for i, v := range p.Prices {
+		if v == nil {
+			dst.Prices[i] = nil
+			continue
+		}
+		if dst.Prices[i] == nil {
+			dst.Prices[i] = new(common.Money)
+		} else {
+			proto.Reset(dst.Prices[i])
+		}
+		proto.Merge(dst.Prices[i], v)
+	}
+	for k := range dst.LabelsById {
+		if _, ok := p.LabelsById[k]; !ok {
+			delete(dst.LabelsById, k)
+		}
+	}
+	if dst.LabelsById == nil && p.LabelsById != nil {
+		dst.LabelsById = make(map[int32]*CollectionLabelPlain, len(p.LabelsById))
+	}
+	for k, v := range p.LabelsById {
+		if v == nil {
+			dst.LabelsById[k] = nil
+			continue
+		}
+		d := dst.LabelsById[k]
+		if d == nil {
+			d = new(CollectionLabelPlain)
+		}
+		v.CopyTo(d)
+		dst.LabelsById[k] = d
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *CollectionShelfPlain) Equal(other *CollectionShelfPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if !slices.Equal(p.Tags, other.Tags) {
+		return false
+	}
+	if len(p.Blobs) != len(other.Blobs) {
+		return false
+	}
+	for i := range p.Blobs {
+		if !bytes.Equal(p.Blobs[i], other.Blobs[i]) {
+			return false
+		}
+	}
+	if !slices.Equal(p.Kinds, other.Kinds) {
+		return false
+	}
+	if !slices.Equal(p.KindNames, other.KindNames) {
+		return false
+	}
+	if len(p.Labels) != len(other.Labels) {
+		return false
+	}
+	for i := range p.Labels {
+		if !p.Labels[i].Equal(&other.Labels[i]) {
+			return false
+		}
+	}
+	if !maps.Equal(p.Attributes, other.Attributes) {
+		return false
+	}
+	if len(p.Budgets) != len(other.Budgets) {
+		return false
+	}
+	for k, v := range p.Budgets {
+		w, ok := other.Budgets[k]
+		if !ok || !proto.Equal(v, w) {
+			return false
+		}
+	}
+	if !maps.Equal(p.KindByName, other.KindByName) {
+		return false
+	}
+	if !slices.Equal(p.Counters, other.Counters) {
+		return false
+	}
+	if !maps.Equal(p.Ratios, other.Ratios) {
+		return false
+	}
+	if len(p.Prices) != len(other.Prices) {
+		return false
+	}
+	for i := range p.Prices {
+		if !proto.Equal(p.Prices[i], other.Prices[i]) {
+			return false
+		}
+	}
+	if len(p.LabelsById) != len(other.LabelsById) {
+		return false
+	}
+	for k, v := range p.LabelsById {
+		w, ok := other.LabelsById[k]
+		if !ok || !v.Equal(w) {
+			return false
+		}
+	}
+	return true
+}
+
+// Columns returns the column names of CollectionShelfPlain rows, in the order of ScanRow and Values
+func (p *CollectionShelfPlain) Columns() []string {
+	return []string{
+		"id",
+		"tags",
+		"blobs",
+		"kinds",
+		"kind_names",
+		"labels",
+		"attributes",
+		"budgets",
+		"kind_by_name",
+		"counters",
+		"ratios",
+		"prices",
+		"labels_by_id",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into CollectionShelfPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *CollectionShelfPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		plainsql.JSON(&p.Tags),
+		plainsql.JSON(&p.Blobs),
+		plainsql.JSON(&p.Kinds),
+		plainsql.JSON(&p.KindNames),
+		plainsql.JSON(&p.Labels),
+		plainsql.JSON(&p.Attributes),
+		plainsql.JSON(&p.Budgets),
+		plainsql.JSON(&p.KindByName),
+		plainsql.JSON(&p.Counters),
+		plainsql.JSON(&p.Ratios),
+		plainsql.JSON(&p.Prices),
+		plainsql.JSON(&p.LabelsById),
+	)
+}
+
+// Values returns the column values of CollectionShelfPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *CollectionShelfPlain) Values() []any {
+	return []any{
+		p.Id,
+		plainsql.JSON(&p.Tags),
+		plainsql.JSON(&p.Blobs),
+		plainsql.JSON(&p.Kinds),
+		plainsql.JSON(&p.KindNames),
+		plainsql.JSON(&p.Labels),
+		plainsql.JSON(&p.Attributes),
+		plainsql.JSON(&p.Budgets),
+		plainsql.JSON(&p.KindByName),
+		plainsql.JSON(&p.Counters),
+		plainsql.JSON(&p.Ratios),
+		plainsql.JSON(&p.Prices),
+		plainsql.JSON(&p.LabelsById),
+	}
+}
+
+// collectionShelfPlainPool is a sync.Pool for CollectionShelfPlain objects
+var collectionShelfPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &CollectionShelfPlain{}
+	},
+}
+
+// GetCollectionShelfPlain returns a CollectionShelfPlain from the pool
+func GetCollectionShelfPlain() *CollectionShelfPlain {
+	return collectionShelfPlainPool.Get().(*CollectionShelfPlain)
+}
+
+// PutCollectionShelfPlain returns a CollectionShelfPlain to the pool after resetting it
+func PutCollectionShelfPlain(p *CollectionShelfPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	collectionShelfPlainPool.Put(p)
+}
+
+// Reset clears all fields in CollectionShelfPlain for reuse
+func (p *CollectionShelfPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Id = ""
+	p.Tags = p.Tags[:0]
+	p.Blobs = p.Blobs[:0]
+	p.Kinds = p.Kinds[:0]
+	p.KindNames = p.KindNames[:0]
+	p.Labels = p.Labels[:0]
+	for k := range p.Attributes {
+		delete(p.Attributes, k)
+	}
+	for k := range p.Budgets {
+		delete(p.Budgets, k)
+	}
+	for k := range p.KindByName {
+		delete(p.KindByName, k)
+	}
+	p.Counters = p.Counters[:0]
+	for k := range p.Ratios {
+		delete(p.Ratios, k)
+	}
+	p.Prices = nil
+	for k := range p.LabelsById {
+		delete(p.LabelsById, k)
+	}
+}
+
+// file_test_full_collection_proto_plain_rawDesc is the serialized descriptor of test/full/collection_plain.proto,
+// describing the Plain messages of test/full/collection.proto in package full.plain
+const file_test_full_collection_proto_plain_rawDesc = "" +
+	"\n test/full/collection_plain.proto\x12\nfull.plain\x1a\x1atest/full/collec" +
+	"tion.proto\x1a\x1ctest/full/common/money.proto\">\n\x14CollectionLabelPlain" +
+	"\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\"\xa6\b\n\x14CollectionShelfPlai" +
+	"n\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04tags\x18\x02 \x03(\tR\x04tags\x12\x14\n\x05blobs\x18\x03 \x03(\fR\x05blobs\x12*\n\x05k" +
+	"inds\x18\x04 \x03(\x0e2\x14.full.CollectionKindR\x05kinds\x12\x1d\n\nkind_names\x18\x05 \x03(\tR\tkin" +
+	"dNames\x128\n\x06labels\x18\x06 \x03(\v2 .full.plain.CollectionLabelPlainR\x06labels" +
+	"\x12P\n\nattributes\x18\a \x03(\v20.full.plain.CollectionShelfPlain.Attribute" +
+	"sEntryR\nattributes\x12G\n\abudgets\x18\b \x03(\v2-.full.plain.CollectionShelf" +
+	"Plain.BudgetsEntryR\abudgets\x12R\n\fkind_by_name\x18\t \x03(\v20.full.plain.C" +
+	"ollectionShelfPlain.KindByNameEntryR\nkindByName\x12\x1a\n\bcounters\x18\n \x03(" +
+	"\x03R\bcounters\x12D\n\x06ratios\x18\v \x03(\v2,.full.plain.CollectionShelfPlain.Ra" +
+	"tiosEntryR\x06ratios\x12*\n\x06prices\x18\f \x03(\v2\x12.full.common.MoneyR\x06prices\x12R\n" +
+	"\flabels_by_id\x18\r \x03(\v20.full.plain.CollectionShelfPlain.LabelsById" +
+	"EntryR\nlabelsById\x1a=\n\x0fAttributesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18" +
+	"\x02 \x01(\tR\x05value:\x028\x01\x1aN\n\fBudgetsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12(\n\x05value\x18\x02 \x01(" +
+	"\v2\x12.full.common.MoneyR\x05value:\x028\x01\x1aS\n\x0fKindByNameEntry\x12\x10\n\x03key\x18\x01 \x01(\t" +
+	"R\x03key\x12*\n\x05value\x18\x02 \x01(\x0e2\x14.full.CollectionKindR\x05value:\x028\x01\x1a9\n\vRatiosE" +
+	"ntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a_\n\x0fLabelsByIdEn" +
+	"try\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x126\n\x05value\x18\x02 \x01(\v2 .full.plain.CollectionLabe" +
+	"lPlainR\x05value:\x028\x01b\x06proto3"
+
+var file_test_full_collection_proto_plain = plainreflect.NewFile("test/full/collection_plain.proto", file_test_full_collection_proto_plain_rawDesc)
+
+func init() { file_test_full_collection_proto_plain_init() }
+
+func file_test_full_collection_proto_plain_init() {
+	file_test_full_collection_proto_init()
+	file_test_full_collection_proto_plain.Init()
+}
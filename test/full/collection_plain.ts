@@ -0,0 +1,23 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/collection.proto
+
+export interface CollectionLabelPlain {
+  key?: string;
+  value?: string;
+}
+
+export interface CollectionShelfPlain {
+  id?: string;
+  tags?: string[];
+  blobs?: string[];
+  kinds?: (0 | 1 | 2)[];
+  kindNames?: ("COLLECTION_KIND_UNSPECIFIED" | "COLLECTION_KIND_BOOK" | "COLLECTION_KIND_FILM")[];
+  labels?: CollectionLabelPlain[];
+  attributes?: Record<string, string>;
+  budgets?: Record<string, Record<string, unknown>>;
+  kindByName?: Record<string, 0 | 1 | 2>;
+  counters?: number[];
+  ratios?: Record<string, number>;
+  prices?: (Record<string, unknown>)[];
+  labelsById?: Record<string, CollectionLabelPlain>;
+}
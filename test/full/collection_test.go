@@ -0,0 +1,109 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func collectionShelf() *full.CollectionShelf {
+	return &full.CollectionShelf{
+		Id: "shelf-1",
+		Details: &full.CollectionDetails{
+			Tags:       []string{"a", "b"},
+			Blobs:      [][]byte{{1}, {2, 3}},
+			Kinds:      []full.CollectionKind{full.CollectionKind_COLLECTION_KIND_BOOK},
+			KindNames:  []full.CollectionKind{full.CollectionKind_COLLECTION_KIND_FILM, full.CollectionKind_COLLECTION_KIND_BOOK},
+			Labels:     []*full.CollectionLabel{{Key: "k1", Value: "v1"}, {Key: "k2"}},
+			Attributes: map[string]string{"color": "red"},
+			Budgets:    map[string]*common.Money{"q1": {Currency: "EUR", Units: 10}},
+			KindByName: map[string]full.CollectionKind{"film": full.CollectionKind_COLLECTION_KIND_FILM},
+			Stats: &full.CollectionStats{
+				Counters:   []int64{1, 2, 3},
+				Ratios:     map[string]float64{"hit": 0.75},
+				Prices:     []*common.Money{{Currency: "USD", Units: 5}},
+				LabelsById: map[int32]*full.CollectionLabel{7: {Key: "seven"}},
+			},
+		},
+	}
+}
+
+func TestCollection_IntoPlain(t *testing.T) {
+	plain := collectionShelf().IntoPlain()
+
+	assert.Equal(t, []string{"a", "b"}, plain.Tags)
+	assert.Equal(t, []string{"COLLECTION_KIND_FILM", "COLLECTION_KIND_BOOK"}, plain.KindNames)
+	assert.Equal(t, []full.CollectionLabelPlain{{Key: "k1", Value: "v1"}, {Key: "k2"}}, plain.Labels)
+	assert.Equal(t, "red", plain.Attributes["color"])
+	assert.Equal(t, []int64{1, 2, 3}, plain.Counters, "repeated scalar two levels deep")
+	assert.Equal(t, 0.75, plain.Ratios["hit"], "map two levels deep")
+	require.Contains(t, plain.LabelsById, int32(7))
+	assert.Equal(t, "seven", plain.LabelsById[7].Key, "generate=true map values are converted")
+}
+
+func TestCollection_Roundtrip(t *testing.T) {
+	original := collectionShelf()
+	assert.True(t, proto.Equal(original, original.IntoPlain().IntoPb()))
+}
+
+func TestCollection_IntoPbEmpty(t *testing.T) {
+	pb := (&full.CollectionShelfPlain{Counters: []int64{4}}).IntoPb()
+
+	assert.Equal(t, []int64{4}, pb.GetDetails().GetStats().GetCounters(), "parents are created on the way down")
+	assert.Nil(t, pb.GetDetails().GetAttributes(), "empty maps are not set")
+	assert.Nil(t, pb.GetDetails().GetStats().GetLabelsById())
+}
+
+func TestCollection_IntoPbEmptySlices(t *testing.T) {
+	pb := (&full.CollectionShelfPlain{
+		Prices:   []*common.Money{},
+		Counters: []int64{},
+		Labels:   []full.CollectionLabelPlain{},
+	}).IntoPb()
+
+	assert.Nil(t, pb.Details, "empty slices don't create parent messages")
+}
+
+func TestCollection_IntoPlainReuse(t *testing.T) {
+	plain := full.GetCollectionShelfPlain()
+	defer full.PutCollectionShelfPlain(plain)
+
+	collectionShelf().IntoPlainReuse(plain)
+	collectionShelf().IntoPlainReuse(plain)
+	assert.Len(t, plain.Labels, 2, "reuse does not append to old rows")
+	assert.True(t, proto.Equal(collectionShelf(), plain.IntoPb()))
+}
+
+func TestCollection_JSON(t *testing.T) {
+	plain := collectionShelf().IntoPlain()
+	data, err := plain.MarshalJSON()
+	require.NoError(t, err)
+
+	decoded := &full.CollectionShelfPlain{}
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	assert.True(t, proto.Equal(collectionShelf(), decoded.IntoPb()))
+}
+
+func TestCollection_Wire(t *testing.T) {
+	original := collectionShelf()
+	data, err := original.IntoPlain().MarshalProto()
+	require.NoError(t, err)
+
+	decoded := &full.CollectionShelfPlain{}
+	require.NoError(t, decoded.UnmarshalProto(data))
+	assert.True(t, proto.Equal(original, decoded.IntoPb()))
+}
+
+func TestCollection_Reflect(t *testing.T) {
+	plain := collectionShelf().IntoPlain()
+	data, err := proto.Marshal(plain)
+	require.NoError(t, err)
+
+	decoded := &full.CollectionShelfPlain{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	assert.True(t, proto.Equal(plain, decoded))
+}
@@ -0,0 +1,133 @@
+// Shared types from another Go package (no plain generation, jx_pb only)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/common/money.proto
+
+package common
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_test_full_common_money_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_common_money_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_test_full_common_money_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+var File_test_full_common_money_proto protoreflect.FileDescriptor
+
+const file_test_full_common_money_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctest/full/common/money.proto\x12\vfull.common\"9\n" +
+	"\x05Money\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05unitsB9Z7github.com/yaroher/protoc-gen-go-plain/test/full/commonb\x06proto3"
+
+var (
+	file_test_full_common_money_proto_rawDescOnce sync.Once
+	file_test_full_common_money_proto_rawDescData []byte
+)
+
+func file_test_full_common_money_proto_rawDescGZIP() []byte {
+	file_test_full_common_money_proto_rawDescOnce.Do(func() {
+		file_test_full_common_money_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_common_money_proto_rawDesc), len(file_test_full_common_money_proto_rawDesc)))
+	})
+	return file_test_full_common_money_proto_rawDescData
+}
+
+var file_test_full_common_money_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_test_full_common_money_proto_goTypes = []any{
+	(*Money)(nil), // 0: full.common.Money
+}
+var file_test_full_common_money_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_test_full_common_money_proto_init() }
+func file_test_full_common_money_proto_init() {
+	if File_test_full_common_money_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_common_money_proto_rawDesc), len(file_test_full_common_money_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_common_money_proto_goTypes,
+		DependencyIndexes: file_test_full_common_money_proto_depIdxs,
+		MessageInfos:      file_test_full_common_money_proto_msgTypes,
+	}.Build()
+	File_test_full_common_money_proto = out.File
+	file_test_full_common_money_proto_goTypes = nil
+	file_test_full_common_money_proto_depIdxs = nil
+}
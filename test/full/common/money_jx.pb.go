@@ -0,0 +1,54 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/common/money.proto
+
+package common
+
+import (
+	jx "github.com/go-faster/jx"
+)
+
+// MarshalJX encodes Money to JSON using jx.Encoder
+func (p *Money) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetCurrency() != "" {
+		e.FieldStart("currency")
+		e.Str(p.GetCurrency())
+	}
+	if p.GetUnits() != 0 {
+		e.FieldStart("units")
+		e.Int64(p.GetUnits())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes Money from JSON using jx.Decoder
+func (p *Money) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "currency":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Currency = v
+		case "units":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Units = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/edition.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EditionSize struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Width         *int32                 `protobuf:"varint,1,opt,name=width" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,2,opt,name=height" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditionSize) Reset() {
+	*x = EditionSize{}
+	mi := &file_test_full_edition_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditionSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditionSize) ProtoMessage() {}
+
+func (x *EditionSize) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_edition_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditionSize.ProtoReflect.Descriptor instead.
+func (*EditionSize) Descriptor() ([]byte, []int) {
+	return file_test_full_edition_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EditionSize) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *EditionSize) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type EditionItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	Limit         *int64                 `protobuf:"varint,3,opt,name=limit,def=100" json:"limit,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags" json:"tags,omitempty"`
+	Box           *EditionSize           `protobuf:"bytes,5,opt,name=box" json:"box,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for EditionItem fields.
+const (
+	Default_EditionItem_Limit = int64(100)
+)
+
+func (x *EditionItem) Reset() {
+	*x = EditionItem{}
+	mi := &file_test_full_edition_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditionItem) ProtoMessage() {}
+
+func (x *EditionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_edition_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditionItem.ProtoReflect.Descriptor instead.
+func (*EditionItem) Descriptor() ([]byte, []int) {
+	return file_test_full_edition_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EditionItem) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *EditionItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EditionItem) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return Default_EditionItem_Limit
+}
+
+func (x *EditionItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EditionItem) GetBox() *EditionSize {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+// EditionFrame has a delimited message field: it is a group on the wire, wire methods are not generated
+type EditionFrame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Size          *EditionSize           `protobuf:"group,2,opt,name=EditionSize,json=size" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditionFrame) Reset() {
+	*x = EditionFrame{}
+	mi := &file_test_full_edition_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditionFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditionFrame) ProtoMessage() {}
+
+func (x *EditionFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_edition_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditionFrame.ProtoReflect.Descriptor instead.
+func (*EditionFrame) Descriptor() ([]byte, []int) {
+	return file_test_full_edition_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EditionFrame) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *EditionFrame) GetSize() *EditionSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+var File_test_full_edition_proto protoreflect.FileDescriptor
+
+const file_test_full_edition_proto_rawDesc = "" +
+	"\n" +
+	"\x17test/full/edition.proto\x12\x04full\x1a\x15goplain/goplain.proto\"B\n" +
+	"\vEditionSize\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x05R\x05width\x12\x1d\n" +
+	"\x06height\x18\x02 \x01(\x05B\x05\xaa\x01\x02\b\x02R\x06height\"\xa2\x01\n" +
+	"\vEditionItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\x05count\x18\x02 \x01(\x05B\x05\xaa\x01\x02\b\x02R\x05count\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x03:\x03100R\x05limit\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12+\n" +
+	"\x03box\x18\x05 \x01(\v2\x11.full.EditionSizeB\x06\x82\xa6\x1d\x02 \x01R\x03box:\x06\x82\xa6\x1d\x02\b\x01\"X\n" +
+	"\fEditionFrame\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x04size\x18\x02 \x01(\v2\x11.full.EditionSizeB\x05\xaa\x01\x02(\x02R\x04size:\x06\x82\xa6\x1d\x02\b\x01B2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\beditionsp\xe8\a"
+
+var (
+	file_test_full_edition_proto_rawDescOnce sync.Once
+	file_test_full_edition_proto_rawDescData []byte
+)
+
+func file_test_full_edition_proto_rawDescGZIP() []byte {
+	file_test_full_edition_proto_rawDescOnce.Do(func() {
+		file_test_full_edition_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_edition_proto_rawDesc), len(file_test_full_edition_proto_rawDesc)))
+	})
+	return file_test_full_edition_proto_rawDescData
+}
+
+var file_test_full_edition_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_test_full_edition_proto_goTypes = []any{
+	(*EditionSize)(nil),  // 0: full.EditionSize
+	(*EditionItem)(nil),  // 1: full.EditionItem
+	(*EditionFrame)(nil), // 2: full.EditionFrame
+}
+var file_test_full_edition_proto_depIdxs = []int32{
+	0, // 0: full.EditionItem.box:type_name -> full.EditionSize
+	0, // 1: full.EditionFrame.size:type_name -> full.EditionSize
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_test_full_edition_proto_init() }
+func file_test_full_edition_proto_init() {
+	if File_test_full_edition_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_edition_proto_rawDesc), len(file_test_full_edition_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_edition_proto_goTypes,
+		DependencyIndexes: file_test_full_edition_proto_depIdxs,
+		MessageInfos:      file_test_full_edition_proto_msgTypes,
+	}.Build()
+	File_test_full_edition_proto = out.File
+	file_test_full_edition_proto_goTypes = nil
+	file_test_full_edition_proto_depIdxs = nil
+}
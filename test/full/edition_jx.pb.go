@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/edition.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+)
+
+// MarshalJX encodes EditionSize to JSON using jx.Encoder
+func (p *EditionSize) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.Width != nil {
+		e.FieldStart("width")
+		e.Int32(*p.Width)
+	}
+	if p.GetHeight() != 0 {
+		e.FieldStart("height")
+		e.Int32(p.GetHeight())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes EditionSize from JSON using jx.Decoder
+func (p *EditionSize) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "width":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Width = &v
+		case "height":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Height = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes EditionItem to JSON using jx.Encoder
+func (p *EditionItem) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.Name != nil {
+		e.FieldStart("name")
+		e.Str(*p.Name)
+	}
+	if p.GetCount() != 0 {
+		e.FieldStart("count")
+		e.Int32(p.GetCount())
+	}
+	if p.Limit != nil {
+		e.FieldStart("limit")
+		e.Int64(*p.Limit)
+	}
+	if len(p.GetTags()) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.GetTags() {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if p.GetBox() != nil {
+		e.FieldStart("box")
+		p.GetBox().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes EditionItem from JSON using jx.Decoder
+func (p *EditionItem) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = &v
+		case "count":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Count = v
+		case "limit":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Limit = &v
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "box":
+			p.Box = &EditionSize{}
+			if err := p.Box.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes EditionFrame to JSON using jx.Encoder
+func (p *EditionFrame) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.Name != nil {
+		e.FieldStart("name")
+		e.Str(*p.Name)
+	}
+	if p.GetSize() != nil {
+		e.FieldStart("size")
+		p.GetSize().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes EditionFrame from JSON using jx.Decoder
+func (p *EditionFrame) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = &v
+		case "size":
+			p.Size = &EditionSize{}
+			if err := p.Size.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,893 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/edition.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	slices "slices"
+	sync "sync"
+)
+
+type EditionItemPlain struct {
+	Name   *string  `json:"name,omitempty"`
+	Count  int32    `json:"count"`
+	Limit  int64    `json:"limit"`
+	Tags   []string `json:"tags"`
+	Width  *int32   `json:"width,omitempty"`
+	Height int32    `json:"height"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *EditionItem) IntoPlain() *EditionItemPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &EditionItemPlain{}
+
+	p.Name = pb.Name
+	p.Count = pb.Count
+	p.Limit = pb.GetLimit()
+	if len(pb.Tags) > 0 {
+		p.Tags = pb.Tags
+	} else {
+		p.Tags = []string{}
+	}
+	// Width from
+	if pb.GetBox() != nil {
+		if pb.GetBox().Width != nil {
+			_tmp := pb.GetBox().GetWidth()
+			p.Width = &_tmp
+		}
+	}
+	// Height from
+	if pb.GetBox() != nil {
+		p.Height = pb.GetBox().GetHeight()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *EditionItemPlain) IntoPb() *EditionItem {
+	if p == nil {
+		return nil
+	}
+	pb := &EditionItem{}
+
+	pb.Name = p.Name
+	pb.Count = p.Count
+	if p.Limit != 100 {
+		pb.Limit = proto.Int64(p.Limit)
+	}
+	pb.Tags = p.Tags
+	// Width ->
+	if p.Width != nil {
+		if pb.Box == nil {
+			pb.Box = &EditionSize{}
+		}
+		pb.Box.Width = p.Width
+	}
+	// Height ->
+	if pb.Box == nil {
+		pb.Box = &EditionSize{}
+	}
+	pb.Box.Height = p.Height
+	return pb
+}
+
+// editionItemPlainMergePaths are the fields of EditionItem covered by EditionItemPlain
+var editionItemPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5, 1},
+	{5, 2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *EditionItemPlain) IntoPbMerge(dst *EditionItem) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, editionItemPlainMergePaths...)
+}
+
+// editionItemPlainFieldPaths link the JSON names of EditionItemPlain to field-mask paths of EditionItem
+var editionItemPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "name", Path: "name"},
+	{Name: "count", Path: "count"},
+	{Name: "limit", Path: "limit"},
+	{Name: "tags", Path: "tags"},
+	{Name: "width", Path: "box.width"},
+	{Name: "height", Path: "box.height"},
+}
+
+// EditionItemPlainFieldMask builds a field mask of EditionItem from JSON names of EditionItemPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func EditionItemPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(editionItemPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// EditionItemPlainFieldNames translates a field mask of EditionItem into JSON names of EditionItemPlain.
+// A path to an embedded message yields all fields flattened from it.
+func EditionItemPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(editionItemPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of EditionItem, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *EditionItemPlain) ApplyToPb(pb *EditionItem, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), editionItemPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *EditionItem) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*EditionItemPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), editionItemPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &EditionItem{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *EditionItem) IntoPlainReuse(p *EditionItemPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.Name = pb.Name
+	p.Count = pb.Count
+	p.Limit = pb.GetLimit()
+	if len(pb.Tags) > 0 {
+		p.Tags = pb.Tags
+	} else {
+		p.Tags = []string{}
+	}
+	// Width from
+	if pb.GetBox() != nil {
+		if pb.GetBox().Width != nil {
+			_tmp := pb.GetBox().GetWidth()
+			p.Width = &_tmp
+		}
+	}
+	// Height from
+	if pb.GetBox() != nil {
+		p.Height = pb.GetBox().GetHeight()
+	}
+}
+
+// MarshalJX encodes EditionItemPlain to JSON using jx.Encoder
+func (p *EditionItemPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Name != nil {
+		e.FieldStart("name")
+		e.Str(*p.Name)
+	}
+	if p.Count != 0 {
+		e.FieldStart("count")
+		e.Int32(p.Count)
+	}
+	if p.Limit != 100 {
+		e.FieldStart("limit")
+		e.Int64(p.Limit)
+	}
+	if len(p.Tags) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.Tags {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if p.Width != nil {
+		e.FieldStart("width")
+		e.Int32(*p.Width)
+	}
+	if p.Height != 0 {
+		e.FieldStart("height")
+		e.Int32(p.Height)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *EditionItemPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes EditionItemPlain from JSON using jx.Decoder
+func (p *EditionItemPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	p.Limit = 100
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			if d.Next() == jx.Null {
+				p.Name = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = &v
+		case "count":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Count = v
+		case "limit":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Limit = v
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "width":
+			if d.Next() == jx.Null {
+				p.Width = nil
+				return d.Null()
+			}
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Width = &v
+		case "height":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Height = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *EditionItemPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes EditionItemPlain in the protobuf wire format of EditionItem
+func (p *EditionItemPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends EditionItemPlain encoded in the protobuf wire format of EditionItem to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *EditionItemPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if p.Name != nil {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, *p.Name)
+	}
+	if v := p.Count; v != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if p.Limit != 100 {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.Limit))
+	}
+	for _, v := range p.Tags {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	// box (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		_start := len(b)
+		if p.Width != nil {
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(*p.Width))
+		}
+		if v := p.Height; v != 0 {
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes EditionItemPlain from the protobuf wire format of EditionItem.
+// Unknown fields are skipped.
+func (p *EditionItemPlain) UnmarshalProto(b []byte) error {
+	*p = EditionItemPlain{}
+	p.Limit = 100
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			_tmp := v
+			p.Name = &_tmp
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Count = int32(v)
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Limit = int64(v)
+		case num == 4 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Tags = append(p.Tags, v)
+		case num == 5 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					_tmp := int32(v)
+					p.Width = &_tmp
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.Height = int32(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// editionItemPlainReflect describes EditionItemPlain as message full.plain.EditionItemPlain
+var editionItemPlainReflect = plainreflect.NewMessageInfo(file_test_full_edition_proto_plain, "EditionItemPlain",
+	plainreflect.Optional(func(p *EditionItemPlain) **string { return &p.Name }),
+	plainreflect.Scalar(func(p *EditionItemPlain) *int32 { return &p.Count }),
+	plainreflect.Scalar(func(p *EditionItemPlain) *int64 { return &p.Limit }),
+	plainreflect.List(func(p *EditionItemPlain) *[]string { return &p.Tags }),
+	plainreflect.Optional(func(p *EditionItemPlain) **int32 { return &p.Width }),
+	plainreflect.Scalar(func(p *EditionItemPlain) *int32 { return &p.Height }),
+)
+
+// ProtoReflect returns the reflective view of EditionItemPlain backed by the struct
+func (p *EditionItemPlain) ProtoReflect() protoreflect.Message {
+	return editionItemPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of EditionItemPlain
+func (p *EditionItemPlain) Clone() *EditionItemPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &EditionItemPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of EditionItemPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetEditionItemPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *EditionItemPlain) CopyTo(dst *EditionItemPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	if p.Name == nil {
+		dst.Name = nil
+	} else {
+		if dst.Name == nil {
+			dst.Name = new(string)
+		}
+		*dst.Name = *p.Name
+	}
+	dst.Count = p.Count
+	dst.Limit = p.Limit
+	dst.Tags = append(dst.Tags[:0], p.Tags...)
+	if p.Width == nil {
+		dst.Width = nil
+	} else {
+		if dst.Width == nil {
+			dst.Width = new(int32)
+		}
+		*dst.Width = *p.Width
+	}
+	dst.Height = p.Height
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *EditionItemPlain) Equal(other *EditionItemPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if p.Count != other.Count {
+		return false
+	}
+	if p.Limit != other.Limit {
+		return false
+	}
+	if !slices.Equal(p.Tags, other.Tags) {
+		return false
+	}
+	if (p.Width == nil) != (other.Width == nil) || p.Width != nil && *p.Width != *other.Width {
+		return false
+	}
+	if p.Height != other.Height {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of EditionItemPlain rows, in the order of ScanRow and Values
+func (p *EditionItemPlain) Columns() []string {
+	return []string{
+		"name",
+		"count",
+		"limit",
+		"tags",
+		"width",
+		"height",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into EditionItemPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *EditionItemPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Name,
+		&p.Count,
+		&p.Limit,
+		plainsql.JSON(&p.Tags),
+		&p.Width,
+		&p.Height,
+	)
+}
+
+// Values returns the column values of EditionItemPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *EditionItemPlain) Values() []any {
+	return []any{
+		p.Name,
+		p.Count,
+		p.Limit,
+		plainsql.JSON(&p.Tags),
+		p.Width,
+		p.Height,
+	}
+}
+
+// editionItemPlainPool is a sync.Pool for EditionItemPlain objects
+var editionItemPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &EditionItemPlain{}
+	},
+}
+
+// GetEditionItemPlain returns a EditionItemPlain from the pool
+func GetEditionItemPlain() *EditionItemPlain {
+	return editionItemPlainPool.Get().(*EditionItemPlain)
+}
+
+// PutEditionItemPlain returns a EditionItemPlain to the pool after resetting it
+func PutEditionItemPlain(p *EditionItemPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	editionItemPlainPool.Put(p)
+}
+
+// Reset clears all fields in EditionItemPlain for reuse
+func (p *EditionItemPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Name = nil
+	p.Count = 0
+	p.Limit = 100
+	p.Tags = p.Tags[:0]
+	p.Width = nil
+	p.Height = 0
+}
+
+// EditionFrame has a delimited message field: it is a group on the wire, wire methods are not generated
+type EditionFramePlain struct {
+	Name *string      `json:"name,omitempty"`
+	Size *EditionSize `json:"size"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *EditionFrame) IntoPlain() *EditionFramePlain {
+	if pb == nil {
+		return nil
+	}
+	p := &EditionFramePlain{}
+
+	p.Name = pb.Name
+	p.Size = pb.Size
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *EditionFramePlain) IntoPb() *EditionFrame {
+	if p == nil {
+		return nil
+	}
+	pb := &EditionFrame{}
+
+	pb.Name = p.Name
+	pb.Size = p.Size
+	return pb
+}
+
+// editionFramePlainMergePaths are the fields of EditionFrame covered by EditionFramePlain
+var editionFramePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *EditionFramePlain) IntoPbMerge(dst *EditionFrame) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, editionFramePlainMergePaths...)
+}
+
+// editionFramePlainFieldPaths link the JSON names of EditionFramePlain to field-mask paths of EditionFrame
+var editionFramePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "name", Path: "name"},
+	{Name: "size", Path: "size"},
+}
+
+// EditionFramePlainFieldMask builds a field mask of EditionFrame from JSON names of EditionFramePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func EditionFramePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(editionFramePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// EditionFramePlainFieldNames translates a field mask of EditionFrame into JSON names of EditionFramePlain.
+// A path to an embedded message yields all fields flattened from it.
+func EditionFramePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(editionFramePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of EditionFrame, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *EditionFramePlain) ApplyToPb(pb *EditionFrame, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), editionFramePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *EditionFrame) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*EditionFramePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), editionFramePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &EditionFrame{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *EditionFrame) IntoPlainReuse(p *EditionFramePlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.Name = pb.Name
+	p.Size = pb.Size
+}
+
+// MarshalJX encodes EditionFramePlain to JSON using jx.Encoder
+func (p *EditionFramePlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Name != nil {
+		e.FieldStart("name")
+		e.Str(*p.Name)
+	}
+	if p.Size != nil {
+		e.FieldStart("size")
+		p.Size.MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *EditionFramePlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes EditionFramePlain from JSON using jx.Decoder
+func (p *EditionFramePlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			if d.Next() == jx.Null {
+				p.Name = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = &v
+		case "size":
+			p.Size = &EditionSize{}
+			if err := p.Size.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *EditionFramePlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// editionFramePlainReflect describes EditionFramePlain as message full.plain.EditionFramePlain
+var editionFramePlainReflect = plainreflect.NewMessageInfo(file_test_full_edition_proto_plain, "EditionFramePlain",
+	plainreflect.Optional(func(p *EditionFramePlain) **string { return &p.Name }),
+	plainreflect.Message(func(p *EditionFramePlain) **EditionSize { return &p.Size }),
+)
+
+// ProtoReflect returns the reflective view of EditionFramePlain backed by the struct
+func (p *EditionFramePlain) ProtoReflect() protoreflect.Message {
+	return editionFramePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of EditionFramePlain
+func (p *EditionFramePlain) Clone() *EditionFramePlain {
+	if p == nil {
+		return nil
+	}
+	dst := &EditionFramePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of EditionFramePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetEditionFramePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *EditionFramePlain) CopyTo(dst *EditionFramePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	if p.Name == nil {
+		dst.Name = nil
+	} else {
+		if dst.Name == nil {
+			dst.Name = new(string)
+		}
+		*dst.Name = *p.Name
+	}
+	if p.Size == nil {
+		dst.Size = nil
+	} else {
+		if dst.Size == nil {
+			dst.Size = new(EditionSize)
+		} else {
+			proto.Reset(dst.Size)
+		}
+		proto.Merge(dst.Size, p.Size)
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *EditionFramePlain) Equal(other *EditionFramePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if !proto.Equal(p.Size, other.Size) {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of EditionFramePlain rows, in the order of ScanRow and Values
+func (p *EditionFramePlain) Columns() []string {
+	return []string{
+		"name",
+		"size",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into EditionFramePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *EditionFramePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Name,
+		plainsql.JSON(&p.Size),
+	)
+}
+
+// Values returns the column values of EditionFramePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *EditionFramePlain) Values() []any {
+	return []any{
+		p.Name,
+		plainsql.JSON(&p.Size),
+	}
+}
+
+// editionFramePlainPool is a sync.Pool for EditionFramePlain objects
+var editionFramePlainPool = sync.Pool{
+	New: func() interface{} {
+		return &EditionFramePlain{}
+	},
+}
+
+// GetEditionFramePlain returns a EditionFramePlain from the pool
+func GetEditionFramePlain() *EditionFramePlain {
+	return editionFramePlainPool.Get().(*EditionFramePlain)
+}
+
+// PutEditionFramePlain returns a EditionFramePlain to the pool after resetting it
+func PutEditionFramePlain(p *EditionFramePlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	editionFramePlainPool.Put(p)
+}
+
+// Reset clears all fields in EditionFramePlain for reuse
+func (p *EditionFramePlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Name = nil
+	p.Size = nil
+}
+
+// file_test_full_edition_proto_plain_rawDesc is the serialized descriptor of test/full/edition_plain.proto,
+// describing the Plain messages of test/full/edition.proto in package full.plain
+const file_test_full_edition_proto_plain_rawDesc = "" +
+	"\n\x1dtest/full/edition_plain.proto\x12\nfull.plain\x1a\x17test/full/edition.p" +
+	"roto\"\x99\x01\n\x10EditionItemPlain\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05count\x18\x02 \x01(\x05R\x05co" +
+	"unt\x12\x19\n\x05limit\x18\x03 \x01(\x03:\x03100R\x05limit\x12\x12\n\x04tags\x18\x04 \x03(\tR\x04tags\x12\x14\n\x05width\x18\x05 \x01(" +
+	"\x05R\x05width\x12\x16\n\x06height\x18\x06 \x01(\x05R\x06height\"N\n\x11EditionFramePlain\x12\x12\n\x04name\x18\x01 " +
+	"\x01(\tR\x04name\x12%\n\x04size\x18\x02 \x01(\v2\x11.full.EditionSizeR\x04sizeb\x06proto2"
+
+var file_test_full_edition_proto_plain = plainreflect.NewFile("test/full/edition_plain.proto", file_test_full_edition_proto_plain_rawDesc)
+
+func init() { file_test_full_edition_proto_plain_init() }
+
+func file_test_full_edition_proto_plain_init() {
+	file_test_full_edition_proto_init()
+	file_test_full_edition_proto_plain.Init()
+}
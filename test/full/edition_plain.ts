@@ -0,0 +1,17 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/edition.proto
+
+export interface EditionItemPlain {
+  name?: string;
+  count?: number;
+  limit?: number;
+  tags?: string[];
+  width?: number;
+  height?: number;
+}
+
+/** EditionFrame has a delimited message field: it is a group on the wire, wire methods are not generated */
+export interface EditionFramePlain {
+  name?: string;
+  size?: Record<string, unknown>;
+}
@@ -0,0 +1,73 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEdition_Presence(t *testing.T) {
+	original := &full.EditionItem{
+		Name:  proto.String(""),
+		Count: 0,
+		Tags:  []string{"a"},
+		Box:   &full.EditionSize{Width: proto.Int32(0), Height: 4},
+	}
+	plain := original.IntoPlain()
+
+	require.NotNil(t, plain.Name, "explicit presence by default")
+	require.NotNil(t, plain.Width, "embedded field keeps presence")
+	assert.Equal(t, int32(4), plain.Height)
+	assert.Equal(t, int64(100), plain.Limit)
+	assert.True(t, proto.Equal(original, plain.IntoPb()))
+
+	empty := (&full.EditionItem{}).IntoPlain()
+	assert.Nil(t, empty.Name)
+	assert.Nil(t, empty.Width)
+	assert.Equal(t, int64(100), empty.Limit)
+}
+
+func TestEdition_Wire(t *testing.T) {
+	original := &full.EditionItem{
+		Name:  proto.String("item"),
+		Count: 2,
+		Limit: proto.Int64(7),
+		Tags:  []string{"a", "b"},
+		Box:   &full.EditionSize{Width: proto.Int32(1), Height: 2},
+	}
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(original)
+	require.NoError(t, err)
+
+	data, err := original.IntoPlain().MarshalProto()
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	decoded := &full.EditionItemPlain{}
+	require.NoError(t, decoded.UnmarshalProto(data))
+	assert.True(t, proto.Equal(original, decoded.IntoPb()))
+}
+
+func TestEdition_JSON(t *testing.T) {
+	plain := (&full.EditionItem{Name: proto.String("")}).IntoPlain()
+	data, err := plain.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":""}`, string(data), "set empty field is written, default is not")
+
+	decoded := &full.EditionItemPlain{}
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	require.NotNil(t, decoded.Name)
+	assert.Equal(t, int64(100), decoded.Limit)
+	assert.Nil(t, decoded.Width)
+}
+
+func TestEdition_Delimited(t *testing.T) {
+	original := &full.EditionFrame{Name: proto.String("f"), Size: &full.EditionSize{Width: proto.Int32(3)}}
+	plain := original.IntoPlain()
+	assert.True(t, proto.Equal(original, plain.IntoPb()))
+
+	_, hasWire := any(plain).(interface{ MarshalProto() ([]byte, error) })
+	assert.False(t, hasWire, "delimited messages have no wire methods")
+}
@@ -0,0 +1,344 @@
+// Enum JSON decoding: names, numbers and enum_as_string fields
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/enum_json.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TicketState int32
+
+const (
+	TicketState_TICKET_STATE_UNSPECIFIED TicketState = 0
+	TicketState_TICKET_STATE_OPEN        TicketState = 1
+	TicketState_TICKET_STATE_CLOSED      TicketState = 2
+)
+
+// Enum value maps for TicketState.
+var (
+	TicketState_name = map[int32]string{
+		0: "TICKET_STATE_UNSPECIFIED",
+		1: "TICKET_STATE_OPEN",
+		2: "TICKET_STATE_CLOSED",
+	}
+	TicketState_value = map[string]int32{
+		"TICKET_STATE_UNSPECIFIED": 0,
+		"TICKET_STATE_OPEN":        1,
+		"TICKET_STATE_CLOSED":      2,
+	}
+)
+
+func (x TicketState) Enum() *TicketState {
+	p := new(TicketState)
+	*p = x
+	return p
+}
+
+func (x TicketState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketState) Descriptor() protoreflect.EnumDescriptor {
+	return file_test_full_enum_json_proto_enumTypes[0].Descriptor()
+}
+
+func (TicketState) Type() protoreflect.EnumType {
+	return &file_test_full_enum_json_proto_enumTypes[0]
+}
+
+func (x TicketState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketState.Descriptor instead.
+func (TicketState) EnumDescriptor() ([]byte, []int) {
+	return file_test_full_enum_json_proto_rawDescGZIP(), []int{0}
+}
+
+type TicketEscalation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EscalatedFrom TicketState            `protobuf:"varint,1,opt,name=escalated_from,json=escalatedFrom,proto3,enum=full.TicketState" json:"escalated_from,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketEscalation) Reset() {
+	*x = TicketEscalation{}
+	mi := &file_test_full_enum_json_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketEscalation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketEscalation) ProtoMessage() {}
+
+func (x *TicketEscalation) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_enum_json_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketEscalation.ProtoReflect.Descriptor instead.
+func (*TicketEscalation) Descriptor() ([]byte, []int) {
+	return file_test_full_enum_json_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TicketEscalation) GetEscalatedFrom() TicketState {
+	if x != nil {
+		return x.EscalatedFrom
+	}
+	return TicketState_TICKET_STATE_UNSPECIFIED
+}
+
+func (x *TicketEscalation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type Ticket struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State      TicketState            `protobuf:"varint,2,opt,name=state,proto3,enum=full.TicketState" json:"state,omitempty"`
+	History    []TicketState          `protobuf:"varint,3,rep,packed,name=history,proto3,enum=full.TicketState" json:"history,omitempty"`
+	RawState   TicketState            `protobuf:"varint,4,opt,name=raw_state,json=rawState,proto3,enum=full.TicketState" json:"raw_state,omitempty"`
+	ByAssignee map[string]TicketState `protobuf:"bytes,5,rep,name=by_assignee,json=byAssignee,proto3" json:"by_assignee,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=full.TicketState"`
+	// Types that are valid to be assigned to Resolution:
+	//
+	//	*Ticket_Escalation
+	//	*Ticket_ReopenedAs
+	Resolution    isTicket_Resolution `protobuf_oneof:"resolution"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_test_full_enum_json_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_enum_json_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_test_full_enum_json_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Ticket) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ticket) GetState() TicketState {
+	if x != nil {
+		return x.State
+	}
+	return TicketState_TICKET_STATE_UNSPECIFIED
+}
+
+func (x *Ticket) GetHistory() []TicketState {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Ticket) GetRawState() TicketState {
+	if x != nil {
+		return x.RawState
+	}
+	return TicketState_TICKET_STATE_UNSPECIFIED
+}
+
+func (x *Ticket) GetByAssignee() map[string]TicketState {
+	if x != nil {
+		return x.ByAssignee
+	}
+	return nil
+}
+
+func (x *Ticket) GetResolution() isTicket_Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return nil
+}
+
+func (x *Ticket) GetEscalation() *TicketEscalation {
+	if x != nil {
+		if x, ok := x.Resolution.(*Ticket_Escalation); ok {
+			return x.Escalation
+		}
+	}
+	return nil
+}
+
+func (x *Ticket) GetReopenedAs() TicketState {
+	if x != nil {
+		if x, ok := x.Resolution.(*Ticket_ReopenedAs); ok {
+			return x.ReopenedAs
+		}
+	}
+	return TicketState_TICKET_STATE_UNSPECIFIED
+}
+
+type isTicket_Resolution interface {
+	isTicket_Resolution()
+}
+
+type Ticket_Escalation struct {
+	Escalation *TicketEscalation `protobuf:"bytes,10,opt,name=escalation,proto3,oneof"`
+}
+
+type Ticket_ReopenedAs struct {
+	ReopenedAs TicketState `protobuf:"varint,11,opt,name=reopened_as,json=reopenedAs,proto3,enum=full.TicketState,oneof"`
+}
+
+func (*Ticket_Escalation) isTicket_Resolution() {}
+
+func (*Ticket_ReopenedAs) isTicket_Resolution() {}
+
+var File_test_full_enum_json_proto protoreflect.FileDescriptor
+
+const file_test_full_enum_json_proto_rawDesc = "" +
+	"\n" +
+	"\x19test/full/enum_json.proto\x12\x04full\x1a\x15goplain/goplain.proto\"l\n" +
+	"\x10TicketEscalation\x12@\n" +
+	"\x0eescalated_from\x18\x01 \x01(\x0e2\x11.full.TicketStateB\x06\x82\xa6\x1d\x028\x01R\rescalatedFrom\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xe5\x03\n" +
+	"\x06Ticket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x11.full.TicketStateB\x06\x82\xa6\x1d\x028\x01R\x05state\x123\n" +
+	"\ahistory\x18\x03 \x03(\x0e2\x11.full.TicketStateB\x06\x82\xa6\x1d\x028\x01R\ahistory\x12.\n" +
+	"\traw_state\x18\x04 \x01(\x0e2\x11.full.TicketStateR\brawState\x12E\n" +
+	"\vby_assignee\x18\x05 \x03(\v2\x1c.full.Ticket.ByAssigneeEntryB\x06\x82\xa6\x1d\x028\x01R\n" +
+	"byAssignee\x12@\n" +
+	"\n" +
+	"escalation\x18\n" +
+	" \x01(\v2\x16.full.TicketEscalationB\x06\x82\xa6\x1d\x02 \x01H\x00R\n" +
+	"escalation\x12<\n" +
+	"\vreopened_as\x18\v \x01(\x0e2\x11.full.TicketStateB\x06\x82\xa6\x1d\x028\x01H\x00R\n" +
+	"reopenedAs\x1aP\n" +
+	"\x0fByAssigneeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x11.full.TicketStateR\x05value:\x028\x01:\x06\x82\xa6\x1d\x02\b\x01B\x14\n" +
+	"\n" +
+	"resolution\x12\x06\x82\xb5\x18\x02\b\x01*[\n" +
+	"\vTicketState\x12\x1c\n" +
+	"\x18TICKET_STATE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TICKET_STATE_OPEN\x10\x01\x12\x17\n" +
+	"\x13TICKET_STATE_CLOSED\x10\x02B2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_enum_json_proto_rawDescOnce sync.Once
+	file_test_full_enum_json_proto_rawDescData []byte
+)
+
+func file_test_full_enum_json_proto_rawDescGZIP() []byte {
+	file_test_full_enum_json_proto_rawDescOnce.Do(func() {
+		file_test_full_enum_json_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_enum_json_proto_rawDesc), len(file_test_full_enum_json_proto_rawDesc)))
+	})
+	return file_test_full_enum_json_proto_rawDescData
+}
+
+var file_test_full_enum_json_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_test_full_enum_json_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_test_full_enum_json_proto_goTypes = []any{
+	(TicketState)(0),         // 0: full.TicketState
+	(*TicketEscalation)(nil), // 1: full.TicketEscalation
+	(*Ticket)(nil),           // 2: full.Ticket
+	nil,                      // 3: full.Ticket.ByAssigneeEntry
+}
+var file_test_full_enum_json_proto_depIdxs = []int32{
+	0, // 0: full.TicketEscalation.escalated_from:type_name -> full.TicketState
+	0, // 1: full.Ticket.state:type_name -> full.TicketState
+	0, // 2: full.Ticket.history:type_name -> full.TicketState
+	0, // 3: full.Ticket.raw_state:type_name -> full.TicketState
+	3, // 4: full.Ticket.by_assignee:type_name -> full.Ticket.ByAssigneeEntry
+	1, // 5: full.Ticket.escalation:type_name -> full.TicketEscalation
+	0, // 6: full.Ticket.reopened_as:type_name -> full.TicketState
+	0, // 7: full.Ticket.ByAssigneeEntry.value:type_name -> full.TicketState
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_test_full_enum_json_proto_init() }
+func file_test_full_enum_json_proto_init() {
+	if File_test_full_enum_json_proto != nil {
+		return
+	}
+	file_test_full_enum_json_proto_msgTypes[1].OneofWrappers = []any{
+		(*Ticket_Escalation)(nil),
+		(*Ticket_ReopenedAs)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_enum_json_proto_rawDesc), len(file_test_full_enum_json_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_enum_json_proto_goTypes,
+		DependencyIndexes: file_test_full_enum_json_proto_depIdxs,
+		EnumInfos:         file_test_full_enum_json_proto_enumTypes,
+		MessageInfos:      file_test_full_enum_json_proto_msgTypes,
+	}.Build()
+	File_test_full_enum_json_proto = out.File
+	file_test_full_enum_json_proto_goTypes = nil
+	file_test_full_enum_json_proto_depIdxs = nil
+}
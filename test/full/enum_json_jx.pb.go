@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/enum_json.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+)
+
+// MarshalJX encodes TicketEscalation to JSON using jx.Encoder
+func (p *TicketEscalation) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	e.FieldStart("escalatedFrom")
+	e.Int32(int32(p.GetEscalatedFrom()))
+	if p.GetReason() != "" {
+		e.FieldStart("reason")
+		e.Str(p.GetReason())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes TicketEscalation from JSON using jx.Decoder
+func (p *TicketEscalation) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "escalatedFrom":
+			v, err := enumjx.Decode(d, "full.TicketState", TicketState_value)
+			if err != nil {
+				return err
+			}
+			p.EscalatedFrom = TicketState(v)
+		case "reason":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Reason = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes Ticket to JSON using jx.Encoder
+func (p *Ticket) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	e.FieldStart("state")
+	e.Int32(int32(p.GetState()))
+	if len(p.GetHistory()) > 0 {
+		e.FieldStart("history")
+		e.ArrStart()
+		for _, v := range p.GetHistory() {
+			e.Int32(int32(v))
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("rawState")
+	e.Int32(int32(p.GetRawState()))
+	if len(p.GetByAssignee()) > 0 {
+		e.FieldStart("byAssignee")
+		e.ObjStart()
+		for k, v := range p.GetByAssignee() {
+			e.FieldStart(k)
+			e.Int32(int32(v))
+		}
+		e.ObjEnd()
+	}
+	switch v := p.GetResolution().(type) {
+	case *Ticket_Escalation:
+		e.FieldStart("escalation")
+		v.Escalation.MarshalJX(e)
+	case *Ticket_ReopenedAs:
+		e.FieldStart("reopenedAs")
+		e.Int32(int32(v.ReopenedAs))
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes Ticket from JSON using jx.Decoder
+func (p *Ticket) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "state":
+			v, err := enumjx.Decode(d, "full.TicketState", TicketState_value)
+			if err != nil {
+				return err
+			}
+			p.State = TicketState(v)
+		case "history":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.TicketState", TicketState_value)
+				if err != nil {
+					return err
+				}
+				p.History = append(p.History, TicketState(v))
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "rawState":
+			v, err := enumjx.Decode(d, "full.TicketState", TicketState_value)
+			if err != nil {
+				return err
+			}
+			p.RawState = TicketState(v)
+		case "byAssignee":
+			if p.ByAssignee == nil {
+				p.ByAssignee = make(map[string]TicketState)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := enumjx.Decode(d, "full.TicketState", TicketState_value)
+				if err != nil {
+					return err
+				}
+				p.ByAssignee[key] = TicketState(v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "escalation":
+			v := &TicketEscalation{}
+			if err := v.UnmarshalJX(d); err != nil {
+				return err
+			}
+			p.Resolution = &Ticket_Escalation{Escalation: v}
+		case "reopenedAs":
+			ev, err := enumjx.Decode(d, "full.TicketState", TicketState_value)
+			if err != nil {
+				return err
+			}
+			p.Resolution = &Ticket_ReopenedAs{ReopenedAs: TicketState(ev)}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
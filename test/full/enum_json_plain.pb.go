@@ -0,0 +1,815 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/enum_json.proto
+
+package full
+
+import (
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	maps "maps"
+	slices "slices"
+	sync "sync"
+)
+
+type TicketPlain struct {
+	Id                      string                 `json:"id"`
+	State                   string                 `json:"state"`
+	History                 []string               `json:"history"`
+	RawState                TicketState            `json:"rawState"`
+	ByAssignee              map[string]TicketState `json:"byAssignee"`
+	EscalationEscalatedFrom string                 `json:"escalationEscalatedFrom"` // origin: oneof_embed, empath: escalation.escalated_from
+	EscalationReason        string                 `json:"escalationReason"`        // origin: oneof_embed, empath: escalation.reason
+	ResolutionReopenedAs    string                 `json:"resolutionReopenedAs"`    // origin: oneof_embed, empath: resolution.reopened_as
+	// ResolutionCase indicates which variant of resolution oneof is set
+	ResolutionCase TicketResolutionCase `json:"resolution_case,omitempty"`
+}
+
+// TicketResolutionCase identifies the set variant of full.Ticket.resolution oneof, empty when none is set
+type TicketResolutionCase string
+
+const (
+	TicketResolutionCaseEscalation TicketResolutionCase = "escalation"
+	TicketResolutionCaseReopenedAs TicketResolutionCase = "reopened_as"
+)
+
+// Valid reports whether c is one of the TicketResolutionCase variants
+func (c TicketResolutionCase) Valid() bool {
+	switch c {
+	case TicketResolutionCaseEscalation, TicketResolutionCaseReopenedAs:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c TicketResolutionCase) String() string {
+	return string(c)
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *Ticket) IntoPlain() *TicketPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &TicketPlain{}
+
+	// Detect resolution oneof case
+	switch pb.Resolution.(type) {
+	case *Ticket_Escalation:
+		p.ResolutionCase = TicketResolutionCaseEscalation
+	case *Ticket_ReopenedAs:
+		p.ResolutionCase = TicketResolutionCaseReopenedAs
+	}
+
+	p.Id = pb.Id
+	p.State = pb.State.String()
+	if len(pb.History) > 0 {
+		p.History = make([]string, len(pb.History))
+		for i, v := range pb.History {
+			p.History[i] = v.String()
+		}
+	} else {
+		p.History = []string{}
+	}
+	p.RawState = pb.RawState
+	p.ByAssignee = pb.ByAssignee
+	// EscalationEscalatedFrom from escalation.escalated_from
+	if pb.GetEscalation() != nil {
+		p.EscalationEscalatedFrom = pb.GetEscalation().GetEscalatedFrom().String()
+	}
+	// EscalationReason from escalation.reason
+	if pb.GetEscalation() != nil {
+		p.EscalationReason = pb.GetEscalation().GetReason()
+	}
+	// ResolutionReopenedAs from resolution.reopened_as
+	if pb != nil {
+		p.ResolutionReopenedAs = pb.GetReopenedAs().String()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails or an enum name is unknown, use IntoPbE to handle the error.
+func (p *TicketPlain) IntoPb() *Ticket {
+	pb, err := p.IntoPbE()
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError wrapping *enumjx.UnknownValueError for unknown enum names.
+func (p *TicketPlain) IntoPbE() (*Ticket, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &Ticket{}
+	var _err error
+
+	pb.Id = p.Id
+	pb.State = enumjx.TryName[TicketState](p.State, "full.TicketState", TicketState_value, "state", &_err)
+	if len(p.History) > 0 {
+		pb.History = make([]TicketState, len(p.History))
+		for i, v := range p.History {
+			pb.History[i] = enumjx.TryName[TicketState](v, "full.TicketState", TicketState_value, "history", &_err)
+		}
+	}
+	pb.RawState = p.RawState
+	pb.ByAssignee = p.ByAssignee
+	// EscalationEscalatedFrom -> escalation.escalated_from
+	if p.ResolutionCase == TicketResolutionCaseEscalation {
+		if _, ok := pb.Resolution.(*Ticket_Escalation); !ok || pb.Resolution == nil {
+			pb.Resolution = &Ticket_Escalation{Escalation: &TicketEscalation{}}
+		}
+		pb.Resolution.(*Ticket_Escalation).Escalation.EscalatedFrom = enumjx.TryName[TicketState](p.EscalationEscalatedFrom, "full.TicketState", TicketState_value, "escalation.escalated_from", &_err)
+	}
+	// EscalationReason -> escalation.reason
+	if p.ResolutionCase == TicketResolutionCaseEscalation {
+		if _, ok := pb.Resolution.(*Ticket_Escalation); !ok || pb.Resolution == nil {
+			pb.Resolution = &Ticket_Escalation{Escalation: &TicketEscalation{}}
+		}
+		pb.Resolution.(*Ticket_Escalation).Escalation.Reason = p.EscalationReason
+	}
+	// ResolutionReopenedAs -> resolution.reopened_as
+	if p.ResolutionCase == TicketResolutionCaseReopenedAs {
+		pb.Resolution = &Ticket_ReopenedAs{ReopenedAs: enumjx.TryName[TicketState](p.ResolutionReopenedAs, "full.TicketState", TicketState_value, "resolution.reopened_as", &_err)}
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// ticketPlainMergePaths are the fields of Ticket covered by TicketPlain
+var ticketPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{10},
+	{11},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *TicketPlain) IntoPbMerge(dst *Ticket) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, ticketPlainMergePaths...)
+	return nil
+}
+
+// ticketPlainFieldPaths link the JSON names of TicketPlain to field-mask paths of Ticket
+var ticketPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "state", Path: "state"},
+	{Name: "history", Path: "history"},
+	{Name: "rawState", Path: "raw_state"},
+	{Name: "byAssignee", Path: "by_assignee"},
+	{Name: "escalationEscalatedFrom", Path: "escalation.escalated_from"},
+	{Name: "escalationReason", Path: "escalation.reason"},
+	{Name: "resolutionReopenedAs", Path: "reopened_as"},
+}
+
+// TicketPlainFieldMask builds a field mask of Ticket from JSON names of TicketPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func TicketPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(ticketPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// TicketPlainFieldNames translates a field mask of Ticket into JSON names of TicketPlain.
+// A path to an embedded message yields all fields flattened from it.
+func TicketPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(ticketPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Ticket, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *TicketPlain) ApplyToPb(pb *Ticket, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), ticketPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Ticket) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*TicketPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), ticketPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Ticket{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *Ticket) IntoPlainReuse(p *TicketPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// Detect resolution oneof case
+	switch pb.Resolution.(type) {
+	case *Ticket_Escalation:
+		p.ResolutionCase = TicketResolutionCaseEscalation
+	case *Ticket_ReopenedAs:
+		p.ResolutionCase = TicketResolutionCaseReopenedAs
+	}
+
+	p.Id = pb.Id
+	p.State = pb.State.String()
+	if len(pb.History) > 0 {
+		p.History = make([]string, len(pb.History))
+		for i, v := range pb.History {
+			p.History[i] = v.String()
+		}
+	} else {
+		p.History = []string{}
+	}
+	p.RawState = pb.RawState
+	p.ByAssignee = pb.ByAssignee
+	// EscalationEscalatedFrom from escalation.escalated_from
+	if pb.GetEscalation() != nil {
+		p.EscalationEscalatedFrom = pb.GetEscalation().GetEscalatedFrom().String()
+	}
+	// EscalationReason from escalation.reason
+	if pb.GetEscalation() != nil {
+		p.EscalationReason = pb.GetEscalation().GetReason()
+	}
+	// ResolutionReopenedAs from resolution.reopened_as
+	if pb != nil {
+		p.ResolutionReopenedAs = pb.GetReopenedAs().String()
+	}
+}
+
+// MarshalJX encodes TicketPlain to JSON using jx.Encoder
+func (p *TicketPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.ResolutionCase != "" {
+		e.FieldStart("resolution_case")
+		e.Str(p.ResolutionCase.String())
+	}
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	if p.State != "" {
+		e.FieldStart("state")
+		e.Str(p.State)
+	}
+	if len(p.History) > 0 {
+		e.FieldStart("history")
+		e.ArrStart()
+		for _, v := range p.History {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if p.RawState != 0 {
+		e.FieldStart("rawState")
+		e.Int32(int32(p.RawState))
+	}
+	e.FieldStart("byAssignee")
+	e.ObjStart()
+	for k, v := range p.ByAssignee {
+		e.FieldStart(k)
+		e.Str(v.String())
+	}
+	e.ObjEnd()
+	if p.EscalationEscalatedFrom != "" {
+		e.FieldStart("escalationEscalatedFrom")
+		e.Str(p.EscalationEscalatedFrom)
+	}
+	if p.EscalationReason != "" {
+		e.FieldStart("escalationReason")
+		e.Str(p.EscalationReason)
+	}
+	if p.ResolutionReopenedAs != "" {
+		e.FieldStart("resolutionReopenedAs")
+		e.Str(p.ResolutionReopenedAs)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *TicketPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes TicketPlain from JSON using jx.Decoder
+func (p *TicketPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "resolution_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ResolutionCase = TicketResolutionCase(v)
+			if v != "" && !p.ResolutionCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.Ticket.resolution", v)
+			}
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "state":
+			v, err := enumjx.DecodeName(d, "full.TicketState", TicketState_value, TicketState_name)
+			if err != nil {
+				return err
+			}
+			p.State = v
+		case "history":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.DecodeName(d, "full.TicketState", TicketState_value, TicketState_name)
+				if err != nil {
+					return err
+				}
+				p.History = append(p.History, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "rawState":
+			v, err := enumjx.Decode(d, "full.TicketState", TicketState_value)
+			if err != nil {
+				return err
+			}
+			p.RawState = TicketState(v)
+		case "byAssignee":
+			if p.ByAssignee == nil {
+				p.ByAssignee = make(map[string]TicketState)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := enumjx.Decode(d, "full.TicketState", TicketState_value)
+				if err != nil {
+					return err
+				}
+				p.ByAssignee[key] = TicketState(v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "escalationEscalatedFrom":
+			v, err := enumjx.DecodeName(d, "full.TicketState", TicketState_value, TicketState_name)
+			if err != nil {
+				return err
+			}
+			p.EscalationEscalatedFrom = v
+		case "escalationReason":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.EscalationReason = v
+		case "resolutionReopenedAs":
+			v, err := enumjx.DecodeName(d, "full.TicketState", TicketState_value, TicketState_name)
+			if err != nil {
+				return err
+			}
+			p.ResolutionReopenedAs = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *TicketPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes TicketPlain in the protobuf wire format of Ticket
+func (p *TicketPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends TicketPlain encoded in the protobuf wire format of Ticket to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *TicketPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := enumjx.TryName[TicketState](p.State, "full.TicketState", TicketState_value, "state", &_err); v != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if len(p.History) > 0 {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.History {
+			b = protowire.AppendVarint(b, uint64(enumjx.TryName[TicketState](v, "full.TicketState", TicketState_value, "history", &_err)))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if v := p.RawState; v != 0 {
+		b = protowire.AppendTag(b, 4, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	for _, k := range plainwire.SortedKeys(p.ByAssignee) {
+		v := p.ByAssignee[k]
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	// escalation (embedded)
+	if p.ResolutionCase == TicketResolutionCaseEscalation {
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		_start := len(b)
+		if v := enumjx.TryName[TicketState](p.EscalationEscalatedFrom, "full.TicketState", TicketState_value, "escalation.escalated_from", &_err); v != 0 {
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.EscalationReason; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.ResolutionCase == TicketResolutionCaseReopenedAs {
+		b = protowire.AppendTag(b, 11, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(enumjx.TryName[TicketState](p.ResolutionReopenedAs, "full.TicketState", TicketState_value, "resolution.reopened_as", &_err)))
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes TicketPlain from the protobuf wire format of Ticket.
+// Unknown fields are skipped.
+func (p *TicketPlain) UnmarshalProto(b []byte) error {
+	*p = TicketPlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.State = TicketState(v).String()
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.History = append(p.History, TicketState(x).String())
+			}
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.History = append(p.History, TicketState(v).String())
+		case num == 4 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.RawState = TicketState(v)
+		case num == 5 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv TicketState
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = TicketState(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.ByAssignee == nil {
+				p.ByAssignee = make(map[string]TicketState)
+			}
+			p.ByAssignee[mk] = mv
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ResolutionCase = TicketResolutionCaseEscalation
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.EscalationEscalatedFrom = TicketState(v).String()
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.EscalationReason = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 11 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.ResolutionCase = TicketResolutionCaseReopenedAs
+			p.ResolutionReopenedAs = TicketState(v).String()
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// ticketPlainReflect describes TicketPlain as message full.plain.TicketPlain
+var ticketPlainReflect = plainreflect.NewMessageInfo(file_test_full_enum_json_proto_plain, "TicketPlain",
+	plainreflect.Scalar(func(p *TicketPlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *TicketPlain) *string { return &p.State }),
+	plainreflect.List(func(p *TicketPlain) *[]string { return &p.History }),
+	plainreflect.Enum(func(p *TicketPlain) *TicketState { return &p.RawState }),
+	plainreflect.EnumMap(func(p *TicketPlain) *map[string]TicketState { return &p.ByAssignee }),
+	plainreflect.Scalar(func(p *TicketPlain) *string { return &p.EscalationEscalatedFrom }),
+	plainreflect.Scalar(func(p *TicketPlain) *string { return &p.EscalationReason }),
+	plainreflect.Scalar(func(p *TicketPlain) *string { return &p.ResolutionReopenedAs }),
+	plainreflect.Case(func(p *TicketPlain) *TicketResolutionCase { return &p.ResolutionCase }),
+)
+
+// ProtoReflect returns the reflective view of TicketPlain backed by the struct
+func (p *TicketPlain) ProtoReflect() protoreflect.Message {
+	return ticketPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of TicketPlain
+func (p *TicketPlain) Clone() *TicketPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &TicketPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of TicketPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetTicketPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *TicketPlain) CopyTo(dst *TicketPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.ResolutionCase = p.ResolutionCase
+	dst.Id = p.Id
+	dst.State = p.State
+	dst.History = append(dst.History[:0], p.History...)
+	dst.RawState = p.RawState
+	for k := range dst.ByAssignee {
+		delete(dst.ByAssignee, k)
+	}
+	if dst.ByAssignee == nil && p.ByAssignee != nil {
+		dst.ByAssignee = make(map[string]TicketState, len(p.ByAssignee))
+	}
+	for k, v := range p.ByAssignee {
+		dst.ByAssignee[k] = v
+	}
+	dst.EscalationEscalatedFrom = p.EscalationEscalatedFrom
+	dst.EscalationReason = p.EscalationReason
+	dst.ResolutionReopenedAs = p.ResolutionReopenedAs
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *TicketPlain) Equal(other *TicketPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.ResolutionCase != other.ResolutionCase {
+		return false
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if p.State != other.State {
+		return false
+	}
+	if !slices.Equal(p.History, other.History) {
+		return false
+	}
+	if p.RawState != other.RawState {
+		return false
+	}
+	if !maps.Equal(p.ByAssignee, other.ByAssignee) {
+		return false
+	}
+	if p.EscalationEscalatedFrom != other.EscalationEscalatedFrom {
+		return false
+	}
+	if p.EscalationReason != other.EscalationReason {
+		return false
+	}
+	if p.ResolutionReopenedAs != other.ResolutionReopenedAs {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of TicketPlain rows, in the order of ScanRow and Values
+func (p *TicketPlain) Columns() []string {
+	return []string{
+		"id",
+		"state",
+		"history",
+		"raw_state",
+		"by_assignee",
+		"escalation_escalated_from",
+		"escalation_reason",
+		"resolution_reopened_as",
+		"resolution_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into TicketPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *TicketPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.State,
+		plainsql.JSON(&p.History),
+		&p.RawState,
+		plainsql.JSON(&p.ByAssignee),
+		&p.EscalationEscalatedFrom,
+		&p.EscalationReason,
+		&p.ResolutionReopenedAs,
+		&p.ResolutionCase,
+	)
+}
+
+// Values returns the column values of TicketPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *TicketPlain) Values() []any {
+	return []any{
+		p.Id,
+		p.State,
+		plainsql.JSON(&p.History),
+		p.RawState,
+		plainsql.JSON(&p.ByAssignee),
+		p.EscalationEscalatedFrom,
+		p.EscalationReason,
+		p.ResolutionReopenedAs,
+		p.ResolutionCase,
+	}
+}
+
+// ticketPlainPool is a sync.Pool for TicketPlain objects
+var ticketPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &TicketPlain{}
+	},
+}
+
+// GetTicketPlain returns a TicketPlain from the pool
+func GetTicketPlain() *TicketPlain {
+	return ticketPlainPool.Get().(*TicketPlain)
+}
+
+// PutTicketPlain returns a TicketPlain to the pool after resetting it
+func PutTicketPlain(p *TicketPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	ticketPlainPool.Put(p)
+}
+
+// Reset clears all fields in TicketPlain for reuse
+func (p *TicketPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.ResolutionCase = ""
+	p.Id = ""
+	p.State = ""
+	p.History = p.History[:0]
+	p.RawState = 0
+	for k := range p.ByAssignee {
+		delete(p.ByAssignee, k)
+	}
+	p.EscalationEscalatedFrom = ""
+	p.EscalationReason = ""
+	p.ResolutionReopenedAs = ""
+}
+
+// file_test_full_enum_json_proto_plain_rawDesc is the serialized descriptor of test/full/enum_json_plain.proto,
+// describing the Plain messages of test/full/enum_json.proto in package full.plain
+const file_test_full_enum_json_proto_plain_rawDesc = "" +
+	"\n\x1ftest/full/enum_json_plain.proto\x12\nfull.plain\x1a\x19test/full/enum_js" +
+	"on.proto\"\xe2\x03\n\vTicketPlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n\x05state\x18\x02 \x01(\tR\x05state\x12\x18" +
+	"\n\ahistory\x18\x03 \x03(\tR\ahistory\x12.\n\traw_state\x18\x04 \x01(\x0e2\x11.full.TicketStateR\b" +
+	"rawState\x12H\n\vby_assignee\x18\x05 \x03(\v2'.full.plain.TicketPlain.ByAssigne" +
+	"eEntryR\nbyAssignee\x12:\n\x19escalation_escalated_from\x18\x06 \x01(\tR\x17escalatio" +
+	"nEscalatedFrom\x12+\n\x11escalation_reason\x18\a \x01(\tR\x10escalationReason\x124\n\x16r" +
+	"esolution_reopened_as\x18\b \x01(\tR\x14resolutionReopenedAs\x12(\n\x0fresolution_" +
+	"case\x18\t \x01(\tR\x0fresolution_case\x1aP\n\x0fByAssigneeEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key" +
+	"\x12'\n\x05value\x18\x02 \x01(\x0e2\x11.full.TicketStateR\x05value:\x028\x01b\x06proto3"
+
+var file_test_full_enum_json_proto_plain = plainreflect.NewFile("test/full/enum_json_plain.proto", file_test_full_enum_json_proto_plain_rawDesc)
+
+func init() { file_test_full_enum_json_proto_plain_init() }
+
+func file_test_full_enum_json_proto_plain_init() {
+	file_test_full_enum_json_proto_init()
+	file_test_full_enum_json_proto_plain.Init()
+}
@@ -0,0 +1,24 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/enum_json.proto
+
+/** Fields of TicketPlain outside of oneofs */
+export interface TicketPlainFields {
+  id?: string;
+  state?: "TICKET_STATE_UNSPECIFIED" | "TICKET_STATE_OPEN" | "TICKET_STATE_CLOSED";
+  history?: ("TICKET_STATE_UNSPECIFIED" | "TICKET_STATE_OPEN" | "TICKET_STATE_CLOSED")[];
+  rawState?: 0 | 1 | 2;
+  byAssignee?: Record<string, "TICKET_STATE_UNSPECIFIED" | "TICKET_STATE_OPEN" | "TICKET_STATE_CLOSED">;
+  escalationEscalatedFrom?: "TICKET_STATE_UNSPECIFIED" | "TICKET_STATE_OPEN" | "TICKET_STATE_CLOSED";
+  resolutionReopenedAs?: "TICKET_STATE_UNSPECIFIED" | "TICKET_STATE_OPEN" | "TICKET_STATE_CLOSED";
+}
+
+/** resolution oneof of TicketPlain, by resolution_case */
+export type TicketPlainResolution =
+  | {
+      resolution_case: "escalation";
+      escalationReason?: string;
+    }
+  | { resolution_case: "reopened_as" }
+  | { resolution_case?: undefined };
+
+export type TicketPlain = TicketPlainFields & TicketPlainResolution;
@@ -0,0 +1,119 @@
+package full_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/cast"
+	"github.com/yaroher/protoc-gen-go-plain/enumjx"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRoundtrip_EnumAsString(t *testing.T) {
+	original := &full.Ticket{
+		Id:         "t-1",
+		State:      full.TicketState_TICKET_STATE_OPEN,
+		History:    []full.TicketState{full.TicketState_TICKET_STATE_OPEN, full.TicketState_TICKET_STATE_CLOSED},
+		RawState:   full.TicketState_TICKET_STATE_CLOSED,
+		ByAssignee: map[string]full.TicketState{"alice": full.TicketState_TICKET_STATE_OPEN},
+		Resolution: &full.Ticket_Escalation{
+			Escalation: &full.TicketEscalation{EscalatedFrom: full.TicketState_TICKET_STATE_CLOSED, Reason: "sla"},
+		},
+	}
+
+	jsonData, err := original.IntoPlain().MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(jsonData), `"alice":"TICKET_STATE_OPEN"`)
+
+	plain := &full.TicketPlain{}
+	require.NoError(t, plain.UnmarshalJSON(jsonData))
+	assert.Equal(t, "TICKET_STATE_OPEN", plain.State)
+	assert.Equal(t, "TICKET_STATE_CLOSED", plain.EscalationEscalatedFrom)
+
+	require.True(t, proto.Equal(original, plain.IntoPb()), "Ticket roundtrip failed")
+}
+
+func TestUnmarshalJX_EnumNumbers(t *testing.T) {
+	plain := &full.TicketPlain{}
+	err := plain.UnmarshalJSON([]byte(`{
+		"state": 2,
+		"history": [1, "TICKET_STATE_CLOSED"],
+		"rawState": "TICKET_STATE_OPEN",
+		"byAssignee": {"bob": 2},
+		"resolution_case": "reopened_as",
+		"resolutionReopenedAs": 1
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "TICKET_STATE_CLOSED", plain.State)
+	assert.Equal(t, []string{"TICKET_STATE_OPEN", "TICKET_STATE_CLOSED"}, plain.History)
+	assert.Equal(t, full.TicketState_TICKET_STATE_OPEN, plain.RawState)
+	assert.Equal(t, full.TicketState_TICKET_STATE_CLOSED, plain.ByAssignee["bob"])
+
+	pb := plain.IntoPb()
+	assert.Equal(t, full.TicketState_TICKET_STATE_OPEN, pb.GetReopenedAs())
+}
+
+func TestUnmarshalJX_UnknownEnumName(t *testing.T) {
+	tests := []string{
+		`{"state": "TICKET_STATE_LOST"}`,
+		`{"history": ["TICKET_STATE_OPEN", "NOPE"]}`,
+		`{"rawState": "NOPE"}`,
+		`{"byAssignee": {"bob": "NOPE"}}`,
+		`{"escalationEscalatedFrom": "NOPE"}`,
+	}
+
+	for _, data := range tests {
+		t.Run(data, func(t *testing.T) {
+			err := (&full.TicketPlain{}).UnmarshalJSON([]byte(data))
+			require.Error(t, err)
+
+			var unknown *enumjx.UnknownValueError
+			require.True(t, errors.As(err, &unknown), "unexpected error: %v", err)
+			assert.Equal(t, "full.TicketState", unknown.Enum)
+		})
+	}
+}
+
+func TestUnmarshalJX_UnknownEnumNumber(t *testing.T) {
+	plain := &full.TicketPlain{}
+	require.NoError(t, plain.UnmarshalJSON([]byte(`{"state": 7, "history": [1, 8]}`)))
+	assert.Equal(t, "7", plain.State, "unknown numbers are kept as String() prints them")
+	assert.Equal(t, []string{"TICKET_STATE_OPEN", "8"}, plain.History)
+
+	pb := plain.IntoPb()
+	assert.Equal(t, full.TicketState(7), pb.State)
+	assert.Equal(t, []full.TicketState{full.TicketState_TICKET_STATE_OPEN, 8}, pb.History)
+	back := pb.IntoPlain()
+	assert.Equal(t, plain.State, back.State)
+	assert.Equal(t, plain.History, back.History)
+}
+
+func TestIntoPbE_UnknownEnumName(t *testing.T) {
+	plain := &full.TicketPlain{State: "TICKET_STATE_OPEN", History: []string{"TICKET_STATE_CLOSED", "NOPE"}}
+
+	_, err := plain.IntoPbE()
+	var fieldErr *cast.FieldError
+	require.True(t, errors.As(err, &fieldErr), "unexpected error: %v", err)
+	assert.Equal(t, "history", fieldErr.Path)
+	var unknown *enumjx.UnknownValueError
+	require.True(t, errors.As(err, &unknown))
+	assert.Equal(t, "NOPE", unknown.Value)
+	assert.Panics(t, func() { plain.IntoPb() })
+
+	pb, err := (&full.TicketPlain{}).IntoPbE()
+	require.NoError(t, err, "an empty name is the zero value")
+	assert.Equal(t, full.TicketState(0), pb.State)
+}
+
+func TestUnmarshalJX_PbEnumNames(t *testing.T) {
+	pb := &full.Ticket{}
+	d := jx.DecodeStr(`{"rawState": "TICKET_STATE_CLOSED", "reopenedAs": "TICKET_STATE_OPEN"}`)
+	require.NoError(t, pb.UnmarshalJX(d))
+	assert.Equal(t, full.TicketState_TICKET_STATE_CLOSED, pb.RawState)
+	assert.Equal(t, full.TicketState_TICKET_STATE_OPEN, pb.GetReopenedAs())
+}
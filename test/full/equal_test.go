@@ -0,0 +1,78 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"github.com/yaroher/protoc-gen-go-plain/test/full/common"
+)
+
+func TestEqual_Collection(t *testing.T) {
+	a := collectionShelf().IntoPlain()
+	b := collectionShelf().IntoPlain()
+	assert.True(t, a.Equal(b))
+	assert.True(t, a.Equal(a.Clone()))
+
+	changes := map[string]func(p *full.CollectionShelfPlain){
+		"scalar":             func(p *full.CollectionShelfPlain) { p.Id = "other" },
+		"repeated scalar":    func(p *full.CollectionShelfPlain) { p.Tags = append(p.Tags, "c") },
+		"repeated bytes":     func(p *full.CollectionShelfPlain) { p.Blobs[1] = []byte{2} },
+		"repeated plain":     func(p *full.CollectionShelfPlain) { p.Labels[1].Value = "v2" },
+		"map scalar":         func(p *full.CollectionShelfPlain) { p.Attributes["color"] = "blue" },
+		"map key":            func(p *full.CollectionShelfPlain) { p.Attributes = map[string]string{"size": "red"} },
+		"map pb message":     func(p *full.CollectionShelfPlain) { p.Budgets["q1"].Units = 11 },
+		"repeated pb":        func(p *full.CollectionShelfPlain) { p.Prices = append(p.Prices, &common.Money{}) },
+		"map plain":          func(p *full.CollectionShelfPlain) { p.LabelsById[7].Value = "7" },
+		"map plain nil":      func(p *full.CollectionShelfPlain) { p.LabelsById[7] = nil },
+		"repeated enum name": func(p *full.CollectionShelfPlain) { p.KindNames = p.KindNames[:1] },
+	}
+	for name, change := range changes {
+		t.Run(name, func(t *testing.T) {
+			b := collectionShelf().IntoPlain().Clone()
+			change(b)
+			assert.False(t, a.Equal(b))
+			assert.False(t, b.Equal(a))
+		})
+	}
+}
+
+func TestEqual_NilAndEmpty(t *testing.T) {
+	var nilShelf *full.CollectionShelfPlain
+	assert.True(t, nilShelf.Equal(nil))
+	assert.False(t, nilShelf.Equal(&full.CollectionShelfPlain{}))
+	assert.False(t, (&full.CollectionShelfPlain{}).Equal(nil))
+
+	empty := &full.CollectionShelfPlain{
+		Tags:       []string{},
+		Blobs:      [][]byte{},
+		Labels:     []full.CollectionLabelPlain{},
+		Attributes: map[string]string{},
+		Budgets:    map[string]*common.Money{},
+	}
+	assert.True(t, empty.Equal(&full.CollectionShelfPlain{}), "nil and empty collections are equal")
+}
+
+func TestEqual_Oneof(t *testing.T) {
+	docs := choiceDocuments()
+	for name, doc := range docs {
+		t.Run(name, func(t *testing.T) {
+			a := doc.IntoPlain()
+			assert.True(t, a.Equal(doc.IntoPlain()))
+			for other, otherDoc := range docs {
+				if other != name {
+					assert.False(t, a.Equal(otherDoc.IntoPlain()), "differs from %s", other)
+				}
+			}
+		})
+	}
+}
+
+func TestEqual_OverrideEqualMethod(t *testing.T) {
+	a := &full.LeasePlain{Id: "lease-1", Holder: "Node@Cluster"}
+	b := &full.LeasePlain{Id: "lease-1", Holder: "node@cluster"}
+	assert.True(t, a.Equal(b), "Email.Equal is used for overridden fields")
+
+	b.Holder = "other@cluster"
+	assert.False(t, a.Equal(b))
+}
@@ -0,0 +1,397 @@
+// Fields left out of Plain structs: ignore and exclude_paths
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/exclude.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExcludeGeo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	InternalId    string                 `protobuf:"bytes,3,opt,name=internal_id,json=internalId,proto3" json:"internal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcludeGeo) Reset() {
+	*x = ExcludeGeo{}
+	mi := &file_test_full_exclude_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcludeGeo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcludeGeo) ProtoMessage() {}
+
+func (x *ExcludeGeo) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_exclude_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcludeGeo.ProtoReflect.Descriptor instead.
+func (*ExcludeGeo) Descriptor() ([]byte, []int) {
+	return file_test_full_exclude_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExcludeGeo) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *ExcludeGeo) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+func (x *ExcludeGeo) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+type ExcludeAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	Geo           *ExcludeGeo            `protobuf:"bytes,2,opt,name=geo,proto3" json:"geo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcludeAddress) Reset() {
+	*x = ExcludeAddress{}
+	mi := &file_test_full_exclude_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcludeAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcludeAddress) ProtoMessage() {}
+
+func (x *ExcludeAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_exclude_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcludeAddress.ProtoReflect.Descriptor instead.
+func (*ExcludeAddress) Descriptor() ([]byte, []int) {
+	return file_test_full_exclude_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExcludeAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *ExcludeAddress) GetGeo() *ExcludeGeo {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+type ExcludeItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Cost          int64                  `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcludeItem) Reset() {
+	*x = ExcludeItem{}
+	mi := &file_test_full_exclude_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcludeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcludeItem) ProtoMessage() {}
+
+func (x *ExcludeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_exclude_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcludeItem.ProtoReflect.Descriptor instead.
+func (*ExcludeItem) Descriptor() ([]byte, []int) {
+	return file_test_full_exclude_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExcludeItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ExcludeItem) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type ExcludeAccount struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PasswordHash string                 `protobuf:"bytes,2,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	Address      *ExcludeAddress        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Audit        *ExcludeGeo            `protobuf:"bytes,4,opt,name=audit,proto3" json:"audit,omitempty"`
+	Items        []*ExcludeItem         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*ExcludeAccount_Email
+	//	*ExcludeAccount_Phone
+	Contact       isExcludeAccount_Contact `protobuf_oneof:"contact"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcludeAccount) Reset() {
+	*x = ExcludeAccount{}
+	mi := &file_test_full_exclude_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcludeAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcludeAccount) ProtoMessage() {}
+
+func (x *ExcludeAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_exclude_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcludeAccount.ProtoReflect.Descriptor instead.
+func (*ExcludeAccount) Descriptor() ([]byte, []int) {
+	return file_test_full_exclude_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExcludeAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExcludeAccount) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *ExcludeAccount) GetAddress() *ExcludeAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ExcludeAccount) GetAudit() *ExcludeGeo {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *ExcludeAccount) GetItems() []*ExcludeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ExcludeAccount) GetContact() isExcludeAccount_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *ExcludeAccount) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Contact.(*ExcludeAccount_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *ExcludeAccount) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*ExcludeAccount_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+type isExcludeAccount_Contact interface {
+	isExcludeAccount_Contact()
+}
+
+type ExcludeAccount_Email struct {
+	Email string `protobuf:"bytes,6,opt,name=email,proto3,oneof"`
+}
+
+type ExcludeAccount_Phone struct {
+	Phone string `protobuf:"bytes,7,opt,name=phone,proto3,oneof"`
+}
+
+func (*ExcludeAccount_Email) isExcludeAccount_Contact() {}
+
+func (*ExcludeAccount_Phone) isExcludeAccount_Contact() {}
+
+var File_test_full_exclude_proto protoreflect.FileDescriptor
+
+const file_test_full_exclude_proto_rawDesc = "" +
+	"\n" +
+	"\x17test/full/exclude.proto\x12\x04full\x1a\x15goplain/goplain.proto\"Q\n" +
+	"\n" +
+	"ExcludeGeo\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x02 \x01(\x01R\x03lng\x12\x1f\n" +
+	"\vinternal_id\x18\x03 \x01(\tR\n" +
+	"internalId\"T\n" +
+	"\x0eExcludeAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12*\n" +
+	"\x03geo\x18\x02 \x01(\v2\x10.full.ExcludeGeoB\x06\x82\xa6\x1d\x02 \x01R\x03geo\";\n" +
+	"\vExcludeItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\x04cost\x18\x02 \x01(\x03B\x06\x82\xa6\x1d\x02`\x01R\x04cost\"\xc1\x02\n" +
+	"\x0eExcludeAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\rpassword_hash\x18\x02 \x01(\tB\x06\x82\xa6\x1d\x02`\x01R\fpasswordHash\x126\n" +
+	"\aaddress\x18\x03 \x01(\v2\x14.full.ExcludeAddressB\x06\x82\xa6\x1d\x02 \x01R\aaddress\x12&\n" +
+	"\x05audit\x18\x04 \x01(\v2\x10.full.ExcludeGeoR\x05audit\x12/\n" +
+	"\x05items\x18\x05 \x03(\v2\x11.full.ExcludeItemB\x06\x82\xa6\x1d\x02 \x01R\x05items\x12\x16\n" +
+	"\x05email\x18\x06 \x01(\tH\x00R\x05email\x12\x16\n" +
+	"\x05phone\x18\a \x01(\tH\x00R\x05phone:&\x82\xa6\x1d\"\b\x01*\x17address.geo.internal_id*\x05auditB\t\n" +
+	"\acontactB2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_exclude_proto_rawDescOnce sync.Once
+	file_test_full_exclude_proto_rawDescData []byte
+)
+
+func file_test_full_exclude_proto_rawDescGZIP() []byte {
+	file_test_full_exclude_proto_rawDescOnce.Do(func() {
+		file_test_full_exclude_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_exclude_proto_rawDesc), len(file_test_full_exclude_proto_rawDesc)))
+	})
+	return file_test_full_exclude_proto_rawDescData
+}
+
+var file_test_full_exclude_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_test_full_exclude_proto_goTypes = []any{
+	(*ExcludeGeo)(nil),     // 0: full.ExcludeGeo
+	(*ExcludeAddress)(nil), // 1: full.ExcludeAddress
+	(*ExcludeItem)(nil),    // 2: full.ExcludeItem
+	(*ExcludeAccount)(nil), // 3: full.ExcludeAccount
+}
+var file_test_full_exclude_proto_depIdxs = []int32{
+	0, // 0: full.ExcludeAddress.geo:type_name -> full.ExcludeGeo
+	1, // 1: full.ExcludeAccount.address:type_name -> full.ExcludeAddress
+	0, // 2: full.ExcludeAccount.audit:type_name -> full.ExcludeGeo
+	2, // 3: full.ExcludeAccount.items:type_name -> full.ExcludeItem
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_test_full_exclude_proto_init() }
+func file_test_full_exclude_proto_init() {
+	if File_test_full_exclude_proto != nil {
+		return
+	}
+	file_test_full_exclude_proto_msgTypes[3].OneofWrappers = []any{
+		(*ExcludeAccount_Email)(nil),
+		(*ExcludeAccount_Phone)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_exclude_proto_rawDesc), len(file_test_full_exclude_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_exclude_proto_goTypes,
+		DependencyIndexes: file_test_full_exclude_proto_depIdxs,
+		MessageInfos:      file_test_full_exclude_proto_msgTypes,
+	}.Build()
+	File_test_full_exclude_proto = out.File
+	file_test_full_exclude_proto_goTypes = nil
+	file_test_full_exclude_proto_depIdxs = nil
+}
@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/exclude.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+)
+
+// MarshalJX encodes ExcludeGeo to JSON using jx.Encoder
+func (p *ExcludeGeo) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetLat() != 0 {
+		e.FieldStart("lat")
+		e.Float64(p.GetLat())
+	}
+	if p.GetLng() != 0 {
+		e.FieldStart("lng")
+		e.Float64(p.GetLng())
+	}
+	if p.GetInternalId() != "" {
+		e.FieldStart("internalId")
+		e.Str(p.GetInternalId())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ExcludeGeo from JSON using jx.Decoder
+func (p *ExcludeGeo) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "lat":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Lat = v
+		case "lng":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Lng = v
+		case "internalId":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.InternalId = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes ExcludeAddress to JSON using jx.Encoder
+func (p *ExcludeAddress) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetStreet() != "" {
+		e.FieldStart("street")
+		e.Str(p.GetStreet())
+	}
+	if p.GetGeo() != nil {
+		e.FieldStart("geo")
+		p.GetGeo().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ExcludeAddress from JSON using jx.Decoder
+func (p *ExcludeAddress) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "street":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Street = v
+		case "geo":
+			p.Geo = &ExcludeGeo{}
+			if err := p.Geo.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes ExcludeItem to JSON using jx.Encoder
+func (p *ExcludeItem) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetSku() != "" {
+		e.FieldStart("sku")
+		e.Str(p.GetSku())
+	}
+	if p.GetCost() != 0 {
+		e.FieldStart("cost")
+		e.Int64(p.GetCost())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ExcludeItem from JSON using jx.Decoder
+func (p *ExcludeItem) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "sku":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Sku = v
+		case "cost":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Cost = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes ExcludeAccount to JSON using jx.Encoder
+func (p *ExcludeAccount) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetPasswordHash() != "" {
+		e.FieldStart("passwordHash")
+		e.Str(p.GetPasswordHash())
+	}
+	if p.GetAddress() != nil {
+		e.FieldStart("address")
+		p.GetAddress().MarshalJX(e)
+	}
+	if p.GetAudit() != nil {
+		e.FieldStart("audit")
+		p.GetAudit().MarshalJX(e)
+	}
+	if len(p.GetItems()) > 0 {
+		e.FieldStart("items")
+		e.ArrStart()
+		for _, v := range p.GetItems() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	switch v := p.GetContact().(type) {
+	case *ExcludeAccount_Email:
+		e.FieldStart("email")
+		e.Str(v.Email)
+	case *ExcludeAccount_Phone:
+		e.FieldStart("phone")
+		e.Str(v.Phone)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ExcludeAccount from JSON using jx.Decoder
+func (p *ExcludeAccount) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "passwordHash":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.PasswordHash = v
+		case "address":
+			p.Address = &ExcludeAddress{}
+			if err := p.Address.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "audit":
+			p.Audit = &ExcludeGeo{}
+			if err := p.Audit.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "items":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &ExcludeItem{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Items = append(p.Items, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "email":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Contact = &ExcludeAccount_Email{Email: v}
+		case "phone":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Contact = &ExcludeAccount_Phone{Phone: v}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,913 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/exclude.proto
+
+package full
+
+import (
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	math "math"
+	sync "sync"
+)
+
+type ExcludeAccountPlain struct {
+	Id           string                         `json:"id"`
+	Street       string                         `json:"street"`
+	Lat          float64                        `json:"lat"`
+	Lng          float64                        `json:"lng"`
+	Items        []ExcludeAccountItemsItemPlain `json:"items"`        // origin: embed, empath: items
+	ContactEmail string                         `json:"contactEmail"` // origin: oneof_embed, empath: contact.email
+	ContactPhone string                         `json:"contactPhone"` // origin: oneof_embed, empath: contact.phone
+	// ContactCase indicates which variant of contact oneof is set
+	ContactCase ExcludeAccountContactCase `json:"contact_case,omitempty"`
+}
+
+// ExcludeAccountContactCase identifies the set variant of full.ExcludeAccount.contact oneof, empty when none is set
+type ExcludeAccountContactCase string
+
+const (
+	ExcludeAccountContactCaseEmail ExcludeAccountContactCase = "email"
+	ExcludeAccountContactCasePhone ExcludeAccountContactCase = "phone"
+)
+
+// Valid reports whether c is one of the ExcludeAccountContactCase variants
+func (c ExcludeAccountContactCase) Valid() bool {
+	switch c {
+	case ExcludeAccountContactCaseEmail, ExcludeAccountContactCasePhone:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c ExcludeAccountContactCase) String() string {
+	return string(c)
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *ExcludeAccount) IntoPlain() *ExcludeAccountPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &ExcludeAccountPlain{}
+
+	// Detect contact oneof case
+	switch pb.Contact.(type) {
+	case *ExcludeAccount_Email:
+		p.ContactCase = ExcludeAccountContactCaseEmail
+	case *ExcludeAccount_Phone:
+		p.ContactCase = ExcludeAccountContactCasePhone
+	}
+
+	p.Id = pb.Id
+	// Street from
+	if pb.GetAddress() != nil {
+		p.Street = pb.GetAddress().GetStreet()
+	}
+	// Lat from
+	if pb.GetAddress() != nil && pb.GetAddress().GetGeo() != nil {
+		p.Lat = pb.GetAddress().GetGeo().GetLat()
+	}
+	// Lng from
+	if pb.GetAddress() != nil && pb.GetAddress().GetGeo() != nil {
+		p.Lng = pb.GetAddress().GetGeo().GetLng()
+	}
+	// Items from items
+	if pb.GetItems() != nil {
+		p.Items = make([]ExcludeAccountItemsItemPlain, len(pb.GetItems()))
+		for i, _elem := range pb.GetItems() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.Items[i], _elem
+			p.Sku = pb.Sku
+		}
+	}
+	// ContactEmail from contact.email
+	if pb != nil {
+		p.ContactEmail = pb.GetEmail()
+	}
+	// ContactPhone from contact.phone
+	if pb != nil {
+		p.ContactPhone = pb.GetPhone()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *ExcludeAccountPlain) IntoPb() *ExcludeAccount {
+	if p == nil {
+		return nil
+	}
+	pb := &ExcludeAccount{}
+
+	pb.Id = p.Id
+	// Street ->
+	if p.Street != "" {
+		if pb.Address == nil {
+			pb.Address = &ExcludeAddress{}
+		}
+		pb.Address.Street = p.Street
+	}
+	// Lat ->
+	if pb.Address == nil {
+		pb.Address = &ExcludeAddress{}
+	}
+	if pb.Address.Geo == nil {
+		pb.Address.Geo = &ExcludeGeo{}
+	}
+	pb.Address.Geo.Lat = p.Lat
+	// Lng ->
+	if pb.Address == nil {
+		pb.Address = &ExcludeAddress{}
+	}
+	if pb.Address.Geo == nil {
+		pb.Address.Geo = &ExcludeGeo{}
+	}
+	pb.Address.Geo.Lng = p.Lng
+	// Items -> items
+	if len(p.Items) > 0 {
+		_items := make([]*ExcludeItem, len(p.Items))
+		for i := range p.Items {
+			_items[i] = &ExcludeItem{}
+			p, pb := &p.Items[i], _items[i]
+			pb.Sku = p.Sku
+		}
+		pb.Items = _items
+	}
+	// ContactEmail -> contact.email
+	if p.ContactCase == ExcludeAccountContactCaseEmail {
+		pb.Contact = &ExcludeAccount_Email{Email: p.ContactEmail}
+	}
+	// ContactPhone -> contact.phone
+	if p.ContactCase == ExcludeAccountContactCasePhone {
+		pb.Contact = &ExcludeAccount_Phone{Phone: p.ContactPhone}
+	}
+	return pb
+}
+
+// excludeAccountPlainMergePaths are the fields of ExcludeAccount covered by ExcludeAccountPlain
+var excludeAccountPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{3, 1},
+	{3, 2, 1},
+	{3, 2, 2},
+	{5, 1},
+	{6},
+	{7},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *ExcludeAccountPlain) IntoPbMerge(dst *ExcludeAccount) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, excludeAccountPlainMergePaths...)
+}
+
+// excludeAccountPlainFieldPaths link the JSON names of ExcludeAccountPlain to field-mask paths of ExcludeAccount
+var excludeAccountPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "street", Path: "address.street"},
+	{Name: "lat", Path: "address.geo.lat"},
+	{Name: "lng", Path: "address.geo.lng"},
+	{Name: "items", Path: "items"},
+	{Name: "contactEmail", Path: "email"},
+	{Name: "contactPhone", Path: "phone"},
+}
+
+// ExcludeAccountPlainFieldMask builds a field mask of ExcludeAccount from JSON names of ExcludeAccountPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func ExcludeAccountPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(excludeAccountPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// ExcludeAccountPlainFieldNames translates a field mask of ExcludeAccount into JSON names of ExcludeAccountPlain.
+// A path to an embedded message yields all fields flattened from it.
+func ExcludeAccountPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(excludeAccountPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of ExcludeAccount, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *ExcludeAccountPlain) ApplyToPb(pb *ExcludeAccount, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), excludeAccountPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *ExcludeAccount) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*ExcludeAccountPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), excludeAccountPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &ExcludeAccount{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *ExcludeAccount) IntoPlainReuse(p *ExcludeAccountPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// Detect contact oneof case
+	switch pb.Contact.(type) {
+	case *ExcludeAccount_Email:
+		p.ContactCase = ExcludeAccountContactCaseEmail
+	case *ExcludeAccount_Phone:
+		p.ContactCase = ExcludeAccountContactCasePhone
+	}
+
+	p.Id = pb.Id
+	// Street from
+	if pb.GetAddress() != nil {
+		p.Street = pb.GetAddress().GetStreet()
+	}
+	// Lat from
+	if pb.GetAddress() != nil && pb.GetAddress().GetGeo() != nil {
+		p.Lat = pb.GetAddress().GetGeo().GetLat()
+	}
+	// Lng from
+	if pb.GetAddress() != nil && pb.GetAddress().GetGeo() != nil {
+		p.Lng = pb.GetAddress().GetGeo().GetLng()
+	}
+	// Items from items
+	if pb.GetItems() != nil {
+		p.Items = make([]ExcludeAccountItemsItemPlain, len(pb.GetItems()))
+		for i, _elem := range pb.GetItems() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.Items[i], _elem
+			p.Sku = pb.Sku
+		}
+	}
+	// ContactEmail from contact.email
+	if pb != nil {
+		p.ContactEmail = pb.GetEmail()
+	}
+	// ContactPhone from contact.phone
+	if pb != nil {
+		p.ContactPhone = pb.GetPhone()
+	}
+}
+
+// MarshalJX encodes ExcludeAccountPlain to JSON using jx.Encoder
+func (p *ExcludeAccountPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.ContactCase != "" {
+		e.FieldStart("contact_case")
+		e.Str(p.ContactCase.String())
+	}
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	if p.Street != "" {
+		e.FieldStart("street")
+		e.Str(p.Street)
+	}
+	if p.Lat != 0 {
+		e.FieldStart("lat")
+		e.Float64(p.Lat)
+	}
+	if p.Lng != 0 {
+		e.FieldStart("lng")
+		e.Float64(p.Lng)
+	}
+	if len(p.Items) > 0 {
+		e.FieldStart("items")
+		e.ArrStart()
+		for _, v := range p.Items {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if p.ContactEmail != "" {
+		e.FieldStart("contactEmail")
+		e.Str(p.ContactEmail)
+	}
+	if p.ContactPhone != "" {
+		e.FieldStart("contactPhone")
+		e.Str(p.ContactPhone)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *ExcludeAccountPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes ExcludeAccountPlain from JSON using jx.Decoder
+func (p *ExcludeAccountPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "contact_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ContactCase = ExcludeAccountContactCase(v)
+			if v != "" && !p.ContactCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.ExcludeAccount.contact", v)
+			}
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "street":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Street = v
+		case "lat":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Lat = v
+		case "lng":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Lng = v
+		case "items":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v ExcludeAccountItemsItemPlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Items = append(p.Items, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "contactEmail":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ContactEmail = v
+		case "contactPhone":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ContactPhone = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *ExcludeAccountPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes ExcludeAccountPlain in the protobuf wire format of ExcludeAccount
+func (p *ExcludeAccountPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends ExcludeAccountPlain encoded in the protobuf wire format of ExcludeAccount to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *ExcludeAccountPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	// address (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if v := p.Street; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		// geo (embedded)
+		{
+			_tag := len(b)
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			_start := len(b)
+			if v := p.Lat; v != 0 || math.Signbit(float64(v)) {
+				b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+				b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+			}
+			if v := p.Lng; v != 0 || math.Signbit(float64(v)) {
+				b = protowire.AppendTag(b, 2, protowire.Fixed64Type)
+				b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+			}
+			b = plainwire.FinishEmbed(b, _tag, _start)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	for i := range p.Items {
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Items[i].AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.ContactCase == ExcludeAccountContactCaseEmail {
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		b = protowire.AppendString(b, p.ContactEmail)
+	}
+	if p.ContactCase == ExcludeAccountContactCasePhone {
+		b = protowire.AppendTag(b, 7, protowire.BytesType)
+		b = protowire.AppendString(b, p.ContactPhone)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes ExcludeAccountPlain from the protobuf wire format of ExcludeAccount.
+// Unknown fields are skipped.
+func (p *ExcludeAccountPlain) UnmarshalProto(b []byte) error {
+	*p = ExcludeAccountPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.Street = v
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					for b := v; len(b) > 0; {
+						num, typ, n := protowire.ConsumeTag(b)
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+						switch {
+						case num == 1 && typ == protowire.Fixed64Type:
+							var v uint64
+							v, n = protowire.ConsumeFixed64(b)
+							p.Lat = math.Float64frombits(v)
+						case num == 2 && typ == protowire.Fixed64Type:
+							var v uint64
+							v, n = protowire.ConsumeFixed64(b)
+							p.Lng = math.Float64frombits(v)
+						default:
+							n = protowire.ConsumeFieldValue(num, typ, b)
+						}
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 5 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e ExcludeAccountItemsItemPlain
+			if err := e.UnmarshalProto(v); err != nil {
+				return err
+			}
+			p.Items = append(p.Items, e)
+		case num == 6 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.ContactCase = ExcludeAccountContactCaseEmail
+			p.ContactEmail = v
+		case num == 7 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.ContactCase = ExcludeAccountContactCasePhone
+			p.ContactPhone = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// excludeAccountPlainReflect describes ExcludeAccountPlain as message full.plain.ExcludeAccountPlain
+var excludeAccountPlainReflect = plainreflect.NewMessageInfo(file_test_full_exclude_proto_plain, "ExcludeAccountPlain",
+	plainreflect.Scalar(func(p *ExcludeAccountPlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *ExcludeAccountPlain) *string { return &p.Street }),
+	plainreflect.Scalar(func(p *ExcludeAccountPlain) *float64 { return &p.Lat }),
+	plainreflect.Scalar(func(p *ExcludeAccountPlain) *float64 { return &p.Lng }),
+	plainreflect.ValueList(func(p *ExcludeAccountPlain) *[]ExcludeAccountItemsItemPlain { return &p.Items }),
+	plainreflect.Scalar(func(p *ExcludeAccountPlain) *string { return &p.ContactEmail }),
+	plainreflect.Scalar(func(p *ExcludeAccountPlain) *string { return &p.ContactPhone }),
+	plainreflect.Case(func(p *ExcludeAccountPlain) *ExcludeAccountContactCase { return &p.ContactCase }),
+)
+
+// ProtoReflect returns the reflective view of ExcludeAccountPlain backed by the struct
+func (p *ExcludeAccountPlain) ProtoReflect() protoreflect.Message {
+	return excludeAccountPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of ExcludeAccountPlain
+func (p *ExcludeAccountPlain) Clone() *ExcludeAccountPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &ExcludeAccountPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of ExcludeAccountPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetExcludeAccountPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *ExcludeAccountPlain) CopyTo(dst *ExcludeAccountPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.ContactCase = p.ContactCase
+	dst.Id = p.Id
+	dst.Street = p.Street
+	dst.Lat = p.Lat
+	dst.Lng = p.Lng
+	if cap(dst.Items) < len(p.Items) {
+		dst.Items = make([]ExcludeAccountItemsItemPlain, len(p.Items))
+	} else {
+		dst.Items = dst.Items[:len(p.Items)]
+	}
+	for i := range p.Items {
+		p.Items[i].CopyTo(&dst.Items[i])
+	}
+	dst.ContactEmail = p.ContactEmail
+	dst.ContactPhone = p.ContactPhone
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *ExcludeAccountPlain) Equal(other *ExcludeAccountPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.ContactCase != other.ContactCase {
+		return false
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if p.Street != other.Street {
+		return false
+	}
+	if p.Lat != other.Lat {
+		return false
+	}
+	if p.Lng != other.Lng {
+		return false
+	}
+	if len(p.Items) != len(other.Items) {
+		return false
+	}
+	for i := range p.Items {
+		if !p.Items[i].Equal(&other.Items[i]) {
+			return false
+		}
+	}
+	if p.ContactEmail != other.ContactEmail {
+		return false
+	}
+	if p.ContactPhone != other.ContactPhone {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of ExcludeAccountPlain rows, in the order of ScanRow and Values
+func (p *ExcludeAccountPlain) Columns() []string {
+	return []string{
+		"id",
+		"street",
+		"lat",
+		"lng",
+		"items",
+		"contact_email",
+		"contact_phone",
+		"contact_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into ExcludeAccountPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *ExcludeAccountPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Street,
+		&p.Lat,
+		&p.Lng,
+		plainsql.JSON(&p.Items),
+		&p.ContactEmail,
+		&p.ContactPhone,
+		&p.ContactCase,
+	)
+}
+
+// Values returns the column values of ExcludeAccountPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *ExcludeAccountPlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Street,
+		p.Lat,
+		p.Lng,
+		plainsql.JSON(&p.Items),
+		p.ContactEmail,
+		p.ContactPhone,
+		p.ContactCase,
+	}
+}
+
+// excludeAccountPlainPool is a sync.Pool for ExcludeAccountPlain objects
+var excludeAccountPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &ExcludeAccountPlain{}
+	},
+}
+
+// GetExcludeAccountPlain returns a ExcludeAccountPlain from the pool
+func GetExcludeAccountPlain() *ExcludeAccountPlain {
+	return excludeAccountPlainPool.Get().(*ExcludeAccountPlain)
+}
+
+// PutExcludeAccountPlain returns a ExcludeAccountPlain to the pool after resetting it
+func PutExcludeAccountPlain(p *ExcludeAccountPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	excludeAccountPlainPool.Put(p)
+}
+
+// Reset clears all fields in ExcludeAccountPlain for reuse
+func (p *ExcludeAccountPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.ContactCase = ""
+	p.Id = ""
+	p.Street = ""
+	p.Lat = 0
+	p.Lng = 0
+	p.Items = p.Items[:0]
+	p.ContactEmail = ""
+	p.ContactPhone = ""
+}
+
+// ExcludeAccountItemsItemPlain holds flattened fields of full.ExcludeItem for repeated embed items
+type ExcludeAccountItemsItemPlain struct {
+	Sku string `json:"sku"`
+}
+
+// MarshalJX encodes ExcludeAccountItemsItemPlain to JSON using jx.Encoder
+func (p *ExcludeAccountItemsItemPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Sku != "" {
+		e.FieldStart("sku")
+		e.Str(p.Sku)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *ExcludeAccountItemsItemPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes ExcludeAccountItemsItemPlain from JSON using jx.Decoder
+func (p *ExcludeAccountItemsItemPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "sku":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Sku = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *ExcludeAccountItemsItemPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes ExcludeAccountItemsItemPlain in the protobuf wire format of ExcludeItem
+func (p *ExcludeAccountItemsItemPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends ExcludeAccountItemsItemPlain encoded in the protobuf wire format of ExcludeItem to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *ExcludeAccountItemsItemPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Sku; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes ExcludeAccountItemsItemPlain from the protobuf wire format of ExcludeItem.
+// Unknown fields are skipped.
+func (p *ExcludeAccountItemsItemPlain) UnmarshalProto(b []byte) error {
+	*p = ExcludeAccountItemsItemPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Sku = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// excludeAccountItemsItemPlainReflect describes ExcludeAccountItemsItemPlain as message full.plain.ExcludeAccountItemsItemPlain
+var excludeAccountItemsItemPlainReflect = plainreflect.NewMessageInfo(file_test_full_exclude_proto_plain, "ExcludeAccountItemsItemPlain",
+	plainreflect.Scalar(func(p *ExcludeAccountItemsItemPlain) *string { return &p.Sku }),
+)
+
+// ProtoReflect returns the reflective view of ExcludeAccountItemsItemPlain backed by the struct
+func (p *ExcludeAccountItemsItemPlain) ProtoReflect() protoreflect.Message {
+	return excludeAccountItemsItemPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of ExcludeAccountItemsItemPlain
+func (p *ExcludeAccountItemsItemPlain) Clone() *ExcludeAccountItemsItemPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &ExcludeAccountItemsItemPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of ExcludeAccountItemsItemPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetExcludeAccountItemsItemPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *ExcludeAccountItemsItemPlain) CopyTo(dst *ExcludeAccountItemsItemPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Sku = p.Sku
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *ExcludeAccountItemsItemPlain) Equal(other *ExcludeAccountItemsItemPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Sku != other.Sku {
+		return false
+	}
+	return true
+}
+
+// Reset clears all fields in ExcludeAccountItemsItemPlain for reuse
+func (p *ExcludeAccountItemsItemPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Sku = ""
+}
+
+// file_test_full_exclude_proto_plain_rawDesc is the serialized descriptor of test/full/exclude_plain.proto,
+// describing the Plain messages of test/full/exclude.proto in package full.plain
+const file_test_full_exclude_proto_plain_rawDesc = "" +
+	"\n\x1dtest/full/exclude_plain.proto\x12\nfull.plain\"\x8f\x02\n\x13ExcludeAccountPl" +
+	"ain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n\x06street\x18\x02 \x01(\tR\x06street\x12\x10\n\x03lat\x18\x03 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x04 \x01(\x01R\x03lng\x12>\n\x05items\x18\x05 \x03(\v2(.full.plain.ExcludeAccountItemsI" +
+	"temPlainR\x05items\x12#\n\rcontact_email\x18\x06 \x01(\tR\fcontactEmail\x12#\n\rcontact_" +
+	"phone\x18\a \x01(\tR\fcontactPhone\x12\"\n\fcontact_case\x18\b \x01(\tR\fcontact_case\"0\n" +
+	"\x1cExcludeAccountItemsItemPlain\x12\x10\n\x03sku\x18\x01 \x01(\tR\x03skub\x06proto3"
+
+var file_test_full_exclude_proto_plain = plainreflect.NewFile("test/full/exclude_plain.proto", file_test_full_exclude_proto_plain_rawDesc)
+
+func init() { file_test_full_exclude_proto_plain_init() }
+
+func file_test_full_exclude_proto_plain_init() {
+	file_test_full_exclude_proto_plain.Init()
+}
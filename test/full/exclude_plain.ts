@@ -0,0 +1,30 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/exclude.proto
+
+/** Fields of ExcludeAccountPlain outside of oneofs */
+export interface ExcludeAccountPlainFields {
+  id?: string;
+  street?: string;
+  lat?: number;
+  lng?: number;
+  items?: ExcludeAccountItemsItemPlain[];
+}
+
+/** contact oneof of ExcludeAccountPlain, by contact_case */
+export type ExcludeAccountPlainContact =
+  | {
+      contact_case: "email";
+      contactEmail?: string;
+    }
+  | {
+      contact_case: "phone";
+      contactPhone?: string;
+    }
+  | { contact_case?: undefined };
+
+export type ExcludeAccountPlain = ExcludeAccountPlainFields & ExcludeAccountPlainContact;
+
+/** ExcludeAccountItemsItemPlain holds flattened fields of full.ExcludeItem for repeated embed items */
+export interface ExcludeAccountItemsItemPlain {
+  sku?: string;
+}
@@ -0,0 +1,111 @@
+package full_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+)
+
+func excludeAccount() *full.ExcludeAccount {
+	return &full.ExcludeAccount{
+		Id:           "acc-1",
+		PasswordHash: "secret",
+		Address: &full.ExcludeAddress{
+			Street: "Main st",
+			Geo:    &full.ExcludeGeo{Lat: 1.5, Lng: 2.5, InternalId: "geo-7"},
+		},
+		Audit:   &full.ExcludeGeo{InternalId: "audit"},
+		Items:   []*full.ExcludeItem{{Sku: "a", Cost: 10}},
+		Contact: &full.ExcludeAccount_Email{Email: "a@b.c"},
+	}
+}
+
+func TestExclude_FieldsLeftOut(t *testing.T) {
+	typ := reflect.TypeOf(full.ExcludeAccountPlain{})
+	for _, name := range []string{"PasswordHash", "InternalId", "Audit"} {
+		_, ok := typ.FieldByName(name)
+		assert.False(t, ok, name)
+	}
+	_, ok := reflect.TypeOf(full.ExcludeAccountItemsItemPlain{}).FieldByName("Cost")
+	assert.False(t, ok, "ignore applies to repeated embed rows")
+
+	plain := excludeAccount().IntoPlain()
+	assert.Equal(t, "Main st", plain.Street)
+	assert.Equal(t, 1.5, plain.Lat)
+
+	data, err := plain.MarshalJSON()
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+	assert.NotContains(t, string(data), "geo-7")
+}
+
+func TestExclude_IntoPbLeavesUnset(t *testing.T) {
+	pb := excludeAccount().IntoPlain().IntoPb()
+
+	assert.Empty(t, pb.PasswordHash)
+	assert.Nil(t, pb.Audit)
+	assert.Empty(t, pb.GetAddress().GetGeo().GetInternalId())
+	assert.Equal(t, 2.5, pb.GetAddress().GetGeo().GetLng())
+	assert.Zero(t, pb.Items[0].Cost)
+}
+
+func TestExclude_IntoPbMerge(t *testing.T) {
+	dst := excludeAccount()
+	plain := dst.IntoPlain()
+	plain.Id = "acc-2"
+	plain.Street = ""
+	plain.Lat = 9
+	plain.ContactCase = full.ExcludeAccountContactCasePhone
+	plain.ContactEmail = ""
+	plain.ContactPhone = "+1"
+
+	plain.IntoPbMerge(dst)
+
+	expected := &full.ExcludeAccount{
+		Id:           "acc-2",
+		PasswordHash: "secret",
+		Address: &full.ExcludeAddress{
+			Geo: &full.ExcludeGeo{Lat: 9, Lng: 2.5, InternalId: "geo-7"},
+		},
+		Audit:   &full.ExcludeGeo{InternalId: "audit"},
+		Items:   []*full.ExcludeItem{{Sku: "a", Cost: 10}},
+		Contact: &full.ExcludeAccount_Phone{Phone: "+1"},
+	}
+	assert.True(t, proto.Equal(expected, dst), "got %v", dst)
+}
+
+func TestExclude_IntoPbMergeRows(t *testing.T) {
+	dst := excludeAccount()
+	dst.Items = append(dst.Items, &full.ExcludeItem{Sku: "b", Cost: 20})
+	plain := dst.IntoPlain()
+	plain.Items[0].Sku = "a2"
+	plain.Items = plain.Items[:1]
+
+	plain.IntoPbMerge(dst)
+	assert.True(t, proto.Equal(&full.ExcludeItem{Sku: "a2", Cost: 10}, dst.Items[0]), "got %v", dst.Items)
+	assert.Len(t, dst.Items, 1, "rows missing from the plain struct are dropped")
+
+	plain.Items = append(plain.Items, full.ExcludeAccountItemsItemPlain{Sku: "c"})
+	plain.IntoPbMerge(dst)
+	require.Len(t, dst.Items, 2)
+	assert.Equal(t, int64(10), dst.Items[0].Cost)
+	assert.True(t, proto.Equal(&full.ExcludeItem{Sku: "c"}, dst.Items[1]), "new rows have ignored fields unset")
+}
+
+func TestExclude_IntoPbMergeEmpty(t *testing.T) {
+	dst := &full.ExcludeAccount{
+		PasswordHash: "secret",
+		Address:      &full.ExcludeAddress{Street: "x", Geo: &full.ExcludeGeo{InternalId: "geo-7"}},
+		Contact:      &full.ExcludeAccount_Email{Email: "a@b.c"},
+	}
+	(&full.ExcludeAccountPlain{}).IntoPbMerge(dst)
+
+	assert.Equal(t, "secret", dst.PasswordHash)
+	assert.Equal(t, "geo-7", dst.GetAddress().GetGeo().GetInternalId())
+	assert.Empty(t, dst.GetAddress().GetStreet(), "covered fields are cleared")
+	assert.Nil(t, dst.Contact, "covered oneof is cleared")
+}
@@ -0,0 +1,168 @@
+// Existing casters declared in file options: no caster parameters in IntoPlain/IntoPb
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/existing_caster.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Lease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TtlMs         int64                  `protobuf:"varint,2,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
+	Holder        string                 `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	mi := &file_test_full_existing_caster_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_existing_caster_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_test_full_existing_caster_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Lease) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Lease) GetTtlMs() int64 {
+	if x != nil {
+		return x.TtlMs
+	}
+	return 0
+}
+
+func (x *Lease) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+var File_test_full_existing_caster_proto protoreflect.FileDescriptor
+
+const file_test_full_existing_caster_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftest/full/existing_caster.proto\x12\x04full\x1a\x15goplain/goplain.proto\"N\n" +
+	"\x05Lease\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06ttl_ms\x18\x02 \x01(\x03R\x05ttlMs\x12\x16\n" +
+	"\x06holder\x18\x03 \x01(\tR\x06holder:\x06\x82\xa6\x1d\x02\b\x01B\x93\x05\x82\xa6\x1d\xdc\x04\n" +
+	"'\n" +
+	"\x13\n" +
+	"\x11full.Lease.ttl_ms\x12\x10\n" +
+	"\bDuration\x12\x04time\n" +
+	"P\n" +
+	"\x13\n" +
+	"\x11full.Lease.holder\x129\n" +
+	"\x05Email\x120github.com/yaroher/protoc-gen-go-plain/test/full\x1ac\n" +
+	"\a\n" +
+	"\x05int64\x12\x10\n" +
+	"\bDuration\x12\x04time\x1aD\n" +
+	"\x10MillisToDuration\x120github.com/yaroher/protoc-gen-go-plain/test/full \x01\x1ac\n" +
+	"\x10\n" +
+	"\bDuration\x12\x04time\x12\a\n" +
+	"\x05int64\x1aD\n" +
+	"\x10DurationToMillis\x120github.com/yaroher/protoc-gen-go-plain/test/full \x01\x1a\x89\x01\n" +
+	"\b\n" +
+	"\x06string\x129\n" +
+	"\x05Email\x120github.com/yaroher/protoc-gen-go-plain/test/full\x1a>\n" +
+	"\n" +
+	"ParseEmail\x120github.com/yaroher/protoc-gen-go-plain/test/full \x01(\x01\x1a\x88\x01\n" +
+	"9\n" +
+	"\x05Email\x120github.com/yaroher/protoc-gen-go-plain/test/full\x12\b\n" +
+	"\x06string\x1aA\n" +
+	"\rEmailToString\x120github.com/yaroher/protoc-gen-go-plain/test/fullZ0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_existing_caster_proto_rawDescOnce sync.Once
+	file_test_full_existing_caster_proto_rawDescData []byte
+)
+
+func file_test_full_existing_caster_proto_rawDescGZIP() []byte {
+	file_test_full_existing_caster_proto_rawDescOnce.Do(func() {
+		file_test_full_existing_caster_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_existing_caster_proto_rawDesc), len(file_test_full_existing_caster_proto_rawDesc)))
+	})
+	return file_test_full_existing_caster_proto_rawDescData
+}
+
+var file_test_full_existing_caster_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_test_full_existing_caster_proto_goTypes = []any{
+	(*Lease)(nil), // 0: full.Lease
+}
+var file_test_full_existing_caster_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_test_full_existing_caster_proto_init() }
+func file_test_full_existing_caster_proto_init() {
+	if File_test_full_existing_caster_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_existing_caster_proto_rawDesc), len(file_test_full_existing_caster_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_existing_caster_proto_goTypes,
+		DependencyIndexes: file_test_full_existing_caster_proto_depIdxs,
+		MessageInfos:      file_test_full_existing_caster_proto_msgTypes,
+	}.Build()
+	File_test_full_existing_caster_proto = out.File
+	file_test_full_existing_caster_proto_goTypes = nil
+	file_test_full_existing_caster_proto_depIdxs = nil
+}
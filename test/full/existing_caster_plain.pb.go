@@ -0,0 +1,417 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/existing_caster.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	cast "github.com/yaroher/protoc-gen-go-plain/cast"
+	plainequal "github.com/yaroher/protoc-gen-go-plain/plainequal"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	sync "sync"
+	time "time"
+)
+
+type LeasePlain struct {
+	Id     string        `json:"id"`
+	TtlMs  time.Duration `json:"ttlMs"`
+	Holder Email         `json:"holder"`
+}
+
+// IntoPlain converts protobuf message to plain struct.
+// Panics if a caster fails, use IntoPlainE to handle the error.
+func (pb *Lease) IntoPlain() *LeasePlain {
+	p, err := pb.IntoPlainE()
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// IntoPlainE converts protobuf message to plain struct.
+// Returns *cast.FieldError if a caster fails.
+func (pb *Lease) IntoPlainE() (*LeasePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	p := &LeasePlain{}
+	var _err error
+
+	p.Id = pb.Id
+	p.TtlMs = MillisToDuration(pb.TtlMs)
+	p.Holder = cast.TryCast(cast.CasterErrFn(ParseEmail), pb.Holder, "holder", &_err)
+	if _err != nil {
+		return nil, _err
+	}
+	return p, nil
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails, use IntoPbE to handle the error.
+func (p *LeasePlain) IntoPb() *Lease {
+	pb, err := p.IntoPbE()
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError if a caster fails.
+func (p *LeasePlain) IntoPbE() (*Lease, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &Lease{}
+	var _err error
+
+	pb.Id = p.Id
+	pb.TtlMs = DurationToMillis(p.TtlMs)
+	pb.Holder = EmailToString.Cast(p.Holder)
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// leasePlainMergePaths are the fields of Lease covered by LeasePlain
+var leasePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *LeasePlain) IntoPbMerge(dst *Lease) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, leasePlainMergePaths...)
+	return nil
+}
+
+// leasePlainFieldPaths link the JSON names of LeasePlain to field-mask paths of Lease
+var leasePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "ttlMs", Path: "ttl_ms"},
+	{Name: "holder", Path: "holder"},
+}
+
+// LeasePlainFieldMask builds a field mask of Lease from JSON names of LeasePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func LeasePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(leasePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// LeasePlainFieldNames translates a field mask of Lease into JSON names of LeasePlain.
+// A path to an embedded message yields all fields flattened from it.
+func LeasePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(leasePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Lease, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *LeasePlain) ApplyToPb(pb *Lease, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), leasePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Lease) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*LeasePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), leasePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Lease{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlainE()
+}
+
+// MarshalJX encodes LeasePlain to JSON using jx.Encoder
+func (p *LeasePlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	e.FieldStart("ttlMs")
+	e.Int64(int64(p.TtlMs))
+	e.FieldStart("holder")
+	e.Str(string(p.Holder))
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *LeasePlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes LeasePlain from JSON using jx.Decoder
+func (p *LeasePlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "ttlMs":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.TtlMs = time.Duration(v)
+		case "holder":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Holder = Email(v)
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *LeasePlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes LeasePlain in the protobuf wire format of Lease
+func (p *LeasePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends LeasePlain encoded in the protobuf wire format of Lease to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *LeasePlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := DurationToMillis(p.TtlMs); v != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := EmailToString.Cast(p.Holder); v != "" {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes LeasePlain from the protobuf wire format of Lease.
+// Unknown fields are skipped.
+func (p *LeasePlain) UnmarshalProto(b []byte) error {
+	*p = LeasePlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.TtlMs = MillisToDuration(int64(v))
+		case num == 3 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Holder = cast.TryCast(cast.CasterErrFn(ParseEmail), v, "holder", &_err)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// leasePlainReflect describes LeasePlain as message full.plain.LeasePlain
+var leasePlainReflect = plainreflect.NewMessageInfo(file_test_full_existing_caster_proto_plain, "LeasePlain",
+	plainreflect.Scalar(func(p *LeasePlain) *string { return &p.Id }),
+)
+
+// ProtoReflect returns the reflective view of LeasePlain backed by the struct
+func (p *LeasePlain) ProtoReflect() protoreflect.Message {
+	return leasePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of LeasePlain
+func (p *LeasePlain) Clone() *LeasePlain {
+	if p == nil {
+		return nil
+	}
+	dst := &LeasePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of LeasePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetLeasePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *LeasePlain) CopyTo(dst *LeasePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Id = p.Id
+	dst.TtlMs = p.TtlMs
+	dst.Holder = p.Holder
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *LeasePlain) Equal(other *LeasePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if !plainequal.Equal(p.TtlMs, other.TtlMs) {
+		return false
+	}
+	if !plainequal.Equal(p.Holder, other.Holder) {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of LeasePlain rows, in the order of ScanRow and Values
+func (p *LeasePlain) Columns() []string {
+	return []string{
+		"id",
+		"ttl_ms",
+		"holder",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into LeasePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *LeasePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.TtlMs,
+		&p.Holder,
+	)
+}
+
+// Values returns the column values of LeasePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *LeasePlain) Values() []any {
+	return []any{
+		p.Id,
+		p.TtlMs,
+		p.Holder,
+	}
+}
+
+// leasePlainPool is a sync.Pool for LeasePlain objects
+var leasePlainPool = sync.Pool{
+	New: func() interface{} {
+		return &LeasePlain{}
+	},
+}
+
+// GetLeasePlain returns a LeasePlain from the pool
+func GetLeasePlain() *LeasePlain {
+	return leasePlainPool.Get().(*LeasePlain)
+}
+
+// PutLeasePlain returns a LeasePlain to the pool after resetting it
+func PutLeasePlain(p *LeasePlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	leasePlainPool.Put(p)
+}
+
+// Reset clears all fields in LeasePlain for reuse
+func (p *LeasePlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Id = ""
+	p.TtlMs = 0
+	p.Holder = ""
+}
+
+// file_test_full_existing_caster_proto_plain_rawDesc is the serialized descriptor of test/full/existing_caster_plain.proto,
+// describing the Plain messages of test/full/existing_caster.proto in package full.plain
+const file_test_full_existing_caster_proto_plain_rawDesc = "" +
+	"\n%test/full/existing_caster_plain.proto\x12\nfull.plain\"\x1c\n\nLeasePlai" +
+	"n\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02idb\x06proto3"
+
+var file_test_full_existing_caster_proto_plain = plainreflect.NewFile("test/full/existing_caster_plain.proto", file_test_full_existing_caster_proto_plain_rawDesc)
+
+func init() { file_test_full_existing_caster_proto_plain_init() }
+
+func file_test_full_existing_caster_proto_plain_init() {
+	file_test_full_existing_caster_proto_plain.Init()
+}
@@ -0,0 +1,33 @@
+package full_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/cast"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExistingCaster_Roundtrip(t *testing.T) {
+	original := &full.Lease{Id: "lease-1", TtlMs: 2500, Holder: "node@cluster"}
+
+	// No caster arguments: existing casters are called directly
+	plain := original.IntoPlain()
+	assert.Equal(t, 2500*time.Millisecond, plain.TtlMs)
+	assert.Equal(t, full.Email("node@cluster"), plain.Holder)
+
+	require.True(t, proto.Equal(original, plain.IntoPb()), "Lease roundtrip failed")
+}
+
+func TestExistingCaster_Error(t *testing.T) {
+	_, err := (&full.Lease{Holder: "broken"}).IntoPlainE()
+	require.Error(t, err)
+
+	var fieldErr *cast.FieldError
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "holder", fieldErr.Path)
+}
@@ -0,0 +1,32 @@
+package full
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yaroher/protoc-gen-go-plain/cast"
+)
+
+// Existing casters referenced by existing_caster.proto
+
+// MillisToDuration converts milliseconds to time.Duration
+func MillisToDuration(ms int64) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+// DurationToMillis converts time.Duration to milliseconds
+func DurationToMillis(d time.Duration) int64 {
+	return d.Milliseconds()
+}
+
+// ParseEmail validates an e-mail address
+func ParseEmail(s string) (Email, error) {
+	if s != "" && !strings.Contains(s, "@") {
+		return "", fmt.Errorf("invalid email %q", s)
+	}
+	return Email(s), nil
+}
+
+// EmailToString is a cast.Caster converting Email back to string
+var EmailToString = cast.CasterFn(func(e Email) string { return string(e) })
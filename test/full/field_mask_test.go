@@ -0,0 +1,139 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func maskedAccount() *full.ExcludeAccount {
+	return &full.ExcludeAccount{
+		Id:           "acc-1",
+		PasswordHash: "secret",
+		Address: &full.ExcludeAddress{
+			Street: "Main st. 1",
+			Geo:    &full.ExcludeGeo{Lat: 1, Lng: 2, InternalId: "geo-1"},
+		},
+		Audit:   &full.ExcludeGeo{InternalId: "audit-1"},
+		Contact: &full.ExcludeAccount_Email{Email: "a@example.com"},
+	}
+}
+
+func TestApplyToPb(t *testing.T) {
+	update := &full.ExcludeAccountPlain{Id: "acc-2", Street: "Elm st. 2", Lat: 10, Lng: 20}
+
+	tests := map[string]struct {
+		paths  []string
+		expect func(pb *full.ExcludeAccount)
+	}{
+		"scalar": {
+			paths:  []string{"id"},
+			expect: func(pb *full.ExcludeAccount) { pb.Id = "acc-2" },
+		},
+		"embedded message selects flattened fields": {
+			paths: []string{"address"},
+			expect: func(pb *full.ExcludeAccount) {
+				pb.Address.Street = "Elm st. 2"
+				pb.Address.Geo.Lat = 10
+				pb.Address.Geo.Lng = 20
+			},
+		},
+		"nested path": {
+			paths:  []string{"address.geo.lat"},
+			expect: func(pb *full.ExcludeAccount) { pb.Address.Geo.Lat = 10 },
+		},
+		"unset oneof variant is cleared": {
+			paths:  []string{"email"},
+			expect: func(pb *full.ExcludeAccount) { pb.Contact = nil },
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			pb := maskedAccount()
+			require.NoError(t, update.ApplyToPb(pb, &fieldmaskpb.FieldMask{Paths: tt.paths}))
+
+			expected := maskedAccount()
+			tt.expect(expected)
+			assert.True(t, proto.Equal(expected, pb), "got %v", pb)
+		})
+	}
+}
+
+func TestApplyToPb_EmptyMask(t *testing.T) {
+	plain := maskedAccount().IntoPlain()
+	plain.Street = "Elm st. 2"
+
+	masked := maskedAccount()
+	require.NoError(t, plain.ApplyToPb(masked, nil))
+	merged := maskedAccount()
+	plain.IntoPbMerge(merged)
+
+	assert.True(t, proto.Equal(merged, masked), "an empty mask applies all fields")
+}
+
+func TestApplyToPb_InvalidPaths(t *testing.T) {
+	for _, path := range []string{"password_hash", "audit", "address.geo.internal_id", "unknown", "items.sku", "id.value"} {
+		t.Run(path, func(t *testing.T) {
+			pb := maskedAccount()
+			err := (&full.ExcludeAccountPlain{}).ApplyToPb(pb, &fieldmaskpb.FieldMask{Paths: []string{path}})
+			assert.Error(t, err)
+			assert.True(t, proto.Equal(maskedAccount(), pb), "pb is untouched on error")
+		})
+	}
+}
+
+func TestIntoPlainMasked(t *testing.T) {
+	plain, err := maskedAccount().IntoPlainMasked(&fieldmaskpb.FieldMask{Paths: []string{"id", "address.geo", "email"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, &full.ExcludeAccountPlain{
+		Id:           "acc-1",
+		Lat:          1,
+		Lng:          2,
+		ContactEmail: "a@example.com",
+		ContactCase:  full.ExcludeAccountContactCaseEmail,
+	}, plain)
+
+	_, err = maskedAccount().IntoPlainMasked(&fieldmaskpb.FieldMask{Paths: []string{"audit"}})
+	assert.Error(t, err)
+}
+
+func TestFieldMask_Names(t *testing.T) {
+	mask, err := full.ExcludeAccountPlainFieldMask("id", "lat", "contactEmail")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "address.geo.lat", "email"}, mask.GetPaths())
+
+	names, err := full.ExcludeAccountPlainFieldNames(&fieldmaskpb.FieldMask{Paths: []string{"address", "phone"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"street", "lat", "lng", "contactPhone"}, names, "embedded messages expand to flattened fields")
+
+	_, err = full.ExcludeAccountPlainFieldMask("passwordHash")
+	assert.Error(t, err)
+	_, err = full.ExcludeAccountPlainFieldNames(&fieldmaskpb.FieldMask{Paths: []string{"audit"}})
+	assert.Error(t, err)
+}
+
+func TestFieldMask_InsideField(t *testing.T) {
+	mask, err := full.ChoiceDocumentPlainFieldMask("sourceFile.path")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"file.path"}, mask.GetPaths())
+
+	names, err := full.ChoiceDocumentPlainFieldNames(mask)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sourceFile.path"}, names)
+
+	update := &full.ChoiceDocumentPlain{
+		SourceCase: full.ChoiceDocumentSourceCaseFile,
+		SourceFile: &full.ChoiceFilePlain{Path: "/b", Size: 9},
+	}
+	pb := &full.ChoiceDocument{Id: "d", Source: &full.ChoiceDocument_File{File: &full.ChoiceFile{Path: "/a", Size: 3}}}
+	require.NoError(t, update.ApplyToPb(pb, mask))
+
+	assert.Equal(t, "d", pb.GetId())
+	assert.Equal(t, "/b", pb.GetFile().GetPath())
+	assert.Equal(t, int64(3), pb.GetFile().GetSize(), "only the masked path inside the field is written")
+}
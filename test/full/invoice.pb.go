@@ -0,0 +1,147 @@
+// Cross-package message fields encoded with jx instead of protojson
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/invoice.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Invoice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Total         *common.Money          `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	Lines         []*common.Money        `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_test_full_invoice_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_invoice_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_test_full_invoice_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invoice) GetTotal() *common.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Invoice) GetLines() []*common.Money {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+var File_test_full_invoice_proto protoreflect.FileDescriptor
+
+const file_test_full_invoice_proto_rawDesc = "" +
+	"\n" +
+	"\x17test/full/invoice.proto\x12\x04full\x1a\x15goplain/goplain.proto\x1a\x1ctest/full/common/money.proto\"u\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x05total\x18\x02 \x01(\v2\x12.full.common.MoneyR\x05total\x12(\n" +
+	"\x05lines\x18\x03 \x03(\v2\x12.full.common.MoneyR\x05lines:\x06\x82\xa6\x1d\x02\b\x01B2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_invoice_proto_rawDescOnce sync.Once
+	file_test_full_invoice_proto_rawDescData []byte
+)
+
+func file_test_full_invoice_proto_rawDescGZIP() []byte {
+	file_test_full_invoice_proto_rawDescOnce.Do(func() {
+		file_test_full_invoice_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_invoice_proto_rawDesc), len(file_test_full_invoice_proto_rawDesc)))
+	})
+	return file_test_full_invoice_proto_rawDescData
+}
+
+var file_test_full_invoice_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_test_full_invoice_proto_goTypes = []any{
+	(*Invoice)(nil),      // 0: full.Invoice
+	(*common.Money)(nil), // 1: full.common.Money
+}
+var file_test_full_invoice_proto_depIdxs = []int32{
+	1, // 0: full.Invoice.total:type_name -> full.common.Money
+	1, // 1: full.Invoice.lines:type_name -> full.common.Money
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_test_full_invoice_proto_init() }
+func file_test_full_invoice_proto_init() {
+	if File_test_full_invoice_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_invoice_proto_rawDesc), len(file_test_full_invoice_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_invoice_proto_goTypes,
+		DependencyIndexes: file_test_full_invoice_proto_depIdxs,
+		MessageInfos:      file_test_full_invoice_proto_msgTypes,
+	}.Build()
+	File_test_full_invoice_proto = out.File
+	file_test_full_invoice_proto_goTypes = nil
+	file_test_full_invoice_proto_depIdxs = nil
+}
@@ -0,0 +1,73 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/invoice.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+)
+
+// MarshalJX encodes Invoice to JSON using jx.Encoder
+func (p *Invoice) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetTotal() != nil {
+		e.FieldStart("total")
+		p.GetTotal().MarshalJX(e)
+	}
+	if len(p.GetLines()) > 0 {
+		e.FieldStart("lines")
+		e.ArrStart()
+		for _, v := range p.GetLines() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes Invoice from JSON using jx.Decoder
+func (p *Invoice) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "total":
+			p.Total = &common.Money{}
+			if err := p.Total.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "lines":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &common.Money{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Lines = append(p.Lines, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
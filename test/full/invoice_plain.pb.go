@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/invoice.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	sync "sync"
+)
+
+type InvoicePlain struct {
+	Id    string          `json:"id"`
+	Total *common.Money   `json:"total"`
+	Lines []*common.Money `json:"lines"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *Invoice) IntoPlain() *InvoicePlain {
+	if pb == nil {
+		return nil
+	}
+	p := &InvoicePlain{}
+
+	p.Id = pb.Id
+	p.Total = pb.Total
+	p.Lines = pb.Lines
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *InvoicePlain) IntoPb() *Invoice {
+	if p == nil {
+		return nil
+	}
+	pb := &Invoice{}
+
+	pb.Id = p.Id
+	pb.Total = p.Total
+	pb.Lines = p.Lines
+	return pb
+}
+
+// invoicePlainMergePaths are the fields of Invoice covered by InvoicePlain
+var invoicePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *InvoicePlain) IntoPbMerge(dst *Invoice) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, invoicePlainMergePaths...)
+}
+
+// invoicePlainFieldPaths link the JSON names of InvoicePlain to field-mask paths of Invoice
+var invoicePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "total", Path: "total"},
+	{Name: "lines", Path: "lines"},
+}
+
+// InvoicePlainFieldMask builds a field mask of Invoice from JSON names of InvoicePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func InvoicePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(invoicePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// InvoicePlainFieldNames translates a field mask of Invoice into JSON names of InvoicePlain.
+// A path to an embedded message yields all fields flattened from it.
+func InvoicePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(invoicePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Invoice, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *InvoicePlain) ApplyToPb(pb *Invoice, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), invoicePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Invoice) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*InvoicePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), invoicePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Invoice{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *Invoice) IntoPlainReuse(p *InvoicePlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.Id = pb.Id
+	p.Total = pb.Total
+	p.Lines = pb.Lines
+}
+
+// MarshalJX encodes InvoicePlain to JSON using jx.Encoder
+func (p *InvoicePlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	if p.Total != nil {
+		e.FieldStart("total")
+		p.Total.MarshalJX(e)
+	}
+	if p.Lines != nil {
+		e.FieldStart("lines")
+		e.ArrStart()
+		for _, v := range p.Lines {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *InvoicePlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes InvoicePlain from JSON using jx.Decoder
+func (p *InvoicePlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "total":
+			p.Total = &common.Money{}
+			if err := p.Total.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "lines":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v common.Money
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Lines = append(p.Lines, &v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *InvoicePlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes InvoicePlain in the protobuf wire format of Invoice
+func (p *InvoicePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends InvoicePlain encoded in the protobuf wire format of Invoice to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *InvoicePlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if p.Total != nil {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Total); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, m := range p.Lines {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes InvoicePlain from the protobuf wire format of Invoice.
+// Unknown fields are skipped.
+func (p *InvoicePlain) UnmarshalProto(b []byte) error {
+	*p = InvoicePlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &common.Money{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Total = m
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &common.Money{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Lines = append(p.Lines, m)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// invoicePlainReflect describes InvoicePlain as message full.plain.InvoicePlain
+var invoicePlainReflect = plainreflect.NewMessageInfo(file_test_full_invoice_proto_plain, "InvoicePlain",
+	plainreflect.Scalar(func(p *InvoicePlain) *string { return &p.Id }),
+	plainreflect.Message(func(p *InvoicePlain) **common.Money { return &p.Total }),
+	plainreflect.MessageList(func(p *InvoicePlain) *[]*common.Money { return &p.Lines }),
+)
+
+// ProtoReflect returns the reflective view of InvoicePlain backed by the struct
+func (p *InvoicePlain) ProtoReflect() protoreflect.Message {
+	return invoicePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of InvoicePlain
+func (p *InvoicePlain) Clone() *InvoicePlain {
+	if p == nil {
+		return nil
+	}
+	dst := &InvoicePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of InvoicePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetInvoicePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *InvoicePlain) CopyTo(dst *InvoicePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Id = p.Id
+	if p.Total == nil {
+		dst.Total = nil
+	} else {
+		if dst.Total == nil {
+			dst.Total = new(common.Money)
+		} else {
+			proto.Reset(dst.Total)
+		}
+		proto.Merge(dst.Total, p.Total)
+	}
+	if cap(dst.Lines) < len(p.Lines) {
+		dst.Lines = append(dst.Lines[:cap(dst.Lines)], make([]*common.Money, len(p.Lines)-cap(dst.Lines))...)
+	} else {
+		dst.Lines = dst.Lines[:len(p.Lines)]
+	}
+	for i, v := range p.Lines {
+		if v == nil {
+			dst.Lines[i] = nil
+			continue
+		}
+		if dst.Lines[i] == nil {
+			dst.Lines[i] = new(common.Money)
+		} else {
+			proto.Reset(dst.Lines[i])
+		}
+		proto.Merge(dst.Lines[i], v)
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *InvoicePlain) Equal(other *InvoicePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if !proto.Equal(p.Total, other.Total) {
+		return false
+	}
+	if len(p.Lines) != len(other.Lines) {
+		return false
+	}
+	for i := range p.Lines {
+		if !proto.Equal(p.Lines[i], other.Lines[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Columns returns the column names of InvoicePlain rows, in the order of ScanRow and Values
+func (p *InvoicePlain) Columns() []string {
+	return []string{
+		"id",
+		"total",
+		"lines",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into InvoicePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *InvoicePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		plainsql.JSON(&p.Total),
+		plainsql.JSON(&p.Lines),
+	)
+}
+
+// Values returns the column values of InvoicePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *InvoicePlain) Values() []any {
+	return []any{
+		p.Id,
+		plainsql.JSON(&p.Total),
+		plainsql.JSON(&p.Lines),
+	}
+}
+
+// invoicePlainPool is a sync.Pool for InvoicePlain objects
+var invoicePlainPool = sync.Pool{
+	New: func() interface{} {
+		return &InvoicePlain{}
+	},
+}
+
+// GetInvoicePlain returns a InvoicePlain from the pool
+func GetInvoicePlain() *InvoicePlain {
+	return invoicePlainPool.Get().(*InvoicePlain)
+}
+
+// PutInvoicePlain returns a InvoicePlain to the pool after resetting it
+func PutInvoicePlain(p *InvoicePlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	invoicePlainPool.Put(p)
+}
+
+// Reset clears all fields in InvoicePlain for reuse
+func (p *InvoicePlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Id = ""
+	p.Total = nil
+	p.Lines = nil
+}
+
+// file_test_full_invoice_proto_plain_rawDesc is the serialized descriptor of test/full/invoice_plain.proto,
+// describing the Plain messages of test/full/invoice.proto in package full.plain
+const file_test_full_invoice_proto_plain_rawDesc = "" +
+	"\n\x1dtest/full/invoice_plain.proto\x12\nfull.plain\x1a\x1ctest/full/common/mo" +
+	"ney.proto\"r\n\fInvoicePlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12(\n\x05total\x18\x02 \x01(\v2\x12.full." +
+	"common.MoneyR\x05total\x12(\n\x05lines\x18\x03 \x03(\v2\x12.full.common.MoneyR\x05linesb\x06p" +
+	"roto3"
+
+var file_test_full_invoice_proto_plain = plainreflect.NewFile("test/full/invoice_plain.proto", file_test_full_invoice_proto_plain_rawDesc)
+
+func init() { file_test_full_invoice_proto_plain_init() }
+
+func file_test_full_invoice_proto_plain_init() {
+	file_test_full_invoice_proto_plain.Init()
+}
@@ -0,0 +1,8 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/invoice.proto
+
+export interface InvoicePlain {
+  id?: string;
+  total?: Record<string, unknown>;
+  lines?: (Record<string, unknown>)[];
+}
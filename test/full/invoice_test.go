@@ -0,0 +1,31 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCrossPackageJX_Roundtrip(t *testing.T) {
+	original := &full.Invoice{
+		Id:    "inv-1",
+		Total: &common.Money{Currency: "EUR", Units: 150},
+		Lines: []*common.Money{
+			{Currency: "EUR", Units: 100},
+			{Currency: "EUR", Units: 50},
+		},
+	}
+
+	data, err := original.IntoPlain().MarshalJSON()
+	require.NoError(t, err)
+	// jx_pb methods of common.Money write int64 as a number, protojson would quote it
+	assert.Contains(t, string(data), `"total":{"currency":"EUR","units":150}`)
+
+	plain := &full.InvoicePlain{}
+	require.NoError(t, plain.UnmarshalJSON(data))
+	require.True(t, proto.Equal(original, plain.IntoPb()), "Invoice roundtrip failed")
+}
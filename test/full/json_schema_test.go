@@ -0,0 +1,76 @@
+package full_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"github.com/yaroher/protoc-gen-go-plain/test/schematest"
+	"google.golang.org/protobuf/proto"
+)
+
+// schemaDocs returns Plain structs to marshal, keyed by Plain struct name and
+// a case name after a slash
+func schemaDocs() map[string]json.Marshaler {
+	docs := map[string]json.Marshaler{
+		"CollectionShelfPlain": collectionShelf().IntoPlain(),
+		"CustomerPlain":        newCustomer().IntoPlain(),
+		"ExcludeAccountPlain":  excludeAccount().IntoPlain(),
+		"LegacyRecordPlain":    legacyRecord().IntoPlain(),
+		"NamingContactPlain":   namingContact().IntoPlain(),
+		"StrategyLaterPlain":   strategyLater().IntoPlain(),
+		"WireEnvelopePlain":    wireEnvelope().IntoPlain(),
+		"TableUserPlain": &full.TableUserPlain{
+			Id:              "u-1",
+			Role:            full.TableRole_TABLE_ROLE_ADMIN,
+			FallbackRole:    "TABLE_ROLE_ADMIN",
+			Quota:           10,
+			Counters:        map[string]int64{"logins": 3},
+			LoginSsoSubject: "sub",
+			LoginCase:       full.TableUserLoginCaseSsoSubject,
+			Aliases:         []string{"a"},
+			Billing:         &full.TableAddress{City: "Paris"},
+			Tag:             []byte(`{"name":"vip"}`),
+			Nickname:        proto.String("nick"),
+		},
+	}
+	for name, doc := range choiceDocuments() {
+		docs["ChoiceDocumentPlain/"+name] = doc.IntoPlain()
+	}
+	return docs
+}
+
+func TestJSONSchema_MarshalOutput(t *testing.T) {
+	for name, doc := range schemaDocs() {
+		t.Run(name, func(t *testing.T) {
+			data, err := doc.MarshalJSON()
+			require.NoError(t, err)
+			schema, _, _ := strings.Cut(name, "/")
+			assert.NoError(t, schematest.Validate(schema+".schema.json", data), "%s", data)
+		})
+	}
+}
+
+func TestJSONSchema_Rejects(t *testing.T) {
+	tests := map[string]string{
+		"unknown key":                `{"id": "a", "password_hash": "x"}`,
+		"wrong type":                 `{"id": 1}`,
+		"variant without case":       `{"contactEmail": "a@example.com"}`,
+		"variant of another case":    `{"contact_case": "phone", "contactEmail": "a@example.com"}`,
+		"unknown case":               `{"contact_case": "fax"}`,
+		"embed row with unknown key": `{"items": [{"sku": "a", "cost": 1}]}`,
+	}
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, schematest.Validate("ExcludeAccountPlain.schema.json", []byte(doc)))
+		})
+	}
+
+	assert.NoError(t, schematest.Validate("ExcludeAccountPlain.schema.json",
+		[]byte(`{"contact_case": "email", "contactEmail": "a@example.com"}`)))
+	assert.Error(t, schematest.Validate("TableUserPlain.schema.json", []byte(`{"role": 7}`)), "enum numbers are listed")
+	assert.Error(t, schematest.Validate("TableUserPlain.schema.json", []byte(`{"fallbackRole": "TABLE_ROLE_OWNER"}`)), "enum names are listed")
+}
@@ -0,0 +1,581 @@
+// proto2: required fields, explicit defaults and groups
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/legacy.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LegacyState int32
+
+const (
+	LegacyState_LEGACY_STATE_UNKNOWN LegacyState = 0
+	LegacyState_LEGACY_STATE_ACTIVE  LegacyState = 1
+	LegacyState_LEGACY_STATE_RETIRED LegacyState = 2
+)
+
+// Enum value maps for LegacyState.
+var (
+	LegacyState_name = map[int32]string{
+		0: "LEGACY_STATE_UNKNOWN",
+		1: "LEGACY_STATE_ACTIVE",
+		2: "LEGACY_STATE_RETIRED",
+	}
+	LegacyState_value = map[string]int32{
+		"LEGACY_STATE_UNKNOWN": 0,
+		"LEGACY_STATE_ACTIVE":  1,
+		"LEGACY_STATE_RETIRED": 2,
+	}
+)
+
+func (x LegacyState) Enum() *LegacyState {
+	p := new(LegacyState)
+	*p = x
+	return p
+}
+
+func (x LegacyState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LegacyState) Descriptor() protoreflect.EnumDescriptor {
+	return file_test_full_legacy_proto_enumTypes[0].Descriptor()
+}
+
+func (LegacyState) Type() protoreflect.EnumType {
+	return &file_test_full_legacy_proto_enumTypes[0]
+}
+
+func (x LegacyState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *LegacyState) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = LegacyState(num)
+	return nil
+}
+
+// Deprecated: Use LegacyState.Descriptor instead.
+func (LegacyState) EnumDescriptor() ([]byte, []int) {
+	return file_test_full_legacy_proto_rawDescGZIP(), []int{0}
+}
+
+type LegacyQuota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxItems      *int32                 `protobuf:"varint,1,opt,name=max_items,json=maxItems,def=10" json:"max_items,omitempty"`
+	Unit          *string                `protobuf:"bytes,2,opt,name=unit" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for LegacyQuota fields.
+const (
+	Default_LegacyQuota_MaxItems = int32(10)
+)
+
+func (x *LegacyQuota) Reset() {
+	*x = LegacyQuota{}
+	mi := &file_test_full_legacy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegacyQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyQuota) ProtoMessage() {}
+
+func (x *LegacyQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_legacy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyQuota.ProtoReflect.Descriptor instead.
+func (*LegacyQuota) Descriptor() ([]byte, []int) {
+	return file_test_full_legacy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LegacyQuota) GetMaxItems() int32 {
+	if x != nil && x.MaxItems != nil {
+		return *x.MaxItems
+	}
+	return Default_LegacyQuota_MaxItems
+}
+
+func (x *LegacyQuota) GetUnit() string {
+	if x != nil && x.Unit != nil {
+		return *x.Unit
+	}
+	return ""
+}
+
+type LegacyRecord struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       *string                `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	Retries  *int32                 `protobuf:"varint,2,opt,name=retries,def=3" json:"retries,omitempty"`
+	Owner    *string                `protobuf:"bytes,3,opt,name=owner" json:"owner,omitempty"`
+	Enabled  *bool                  `protobuf:"varint,4,opt,name=enabled,def=1" json:"enabled,omitempty"`
+	State    *LegacyState           `protobuf:"varint,5,opt,name=state,enum=full.LegacyState,def=1" json:"state,omitempty"`
+	Status   *LegacyState           `protobuf:"varint,6,opt,name=status,enum=full.LegacyState" json:"status,omitempty"`
+	Ratio    *float64               `protobuf:"fixed64,7,opt,name=ratio,def=0.5" json:"ratio,omitempty"`
+	Region   *string                `protobuf:"bytes,8,opt,name=region,def=eu-west" json:"region,omitempty"`
+	Checksum []byte                 `protobuf:"bytes,9,opt,name=checksum" json:"checksum,omitempty"`
+	Codes    []int32                `protobuf:"varint,10,rep,name=codes" json:"codes,omitempty"`
+	Quota    *LegacyQuota           `protobuf:"bytes,11,opt,name=quota" json:"quota,omitempty"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*LegacyRecord_Seq
+	//	*LegacyRecord_Label
+	Choice        isLegacyRecord_Choice `protobuf_oneof:"choice"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for LegacyRecord fields.
+const (
+	Default_LegacyRecord_Retries = int32(3)
+	Default_LegacyRecord_Enabled = bool(true)
+	Default_LegacyRecord_State   = LegacyState_LEGACY_STATE_ACTIVE
+	Default_LegacyRecord_Ratio   = float64(0.5)
+	Default_LegacyRecord_Region  = string("eu-west")
+)
+
+func (x *LegacyRecord) Reset() {
+	*x = LegacyRecord{}
+	mi := &file_test_full_legacy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegacyRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyRecord) ProtoMessage() {}
+
+func (x *LegacyRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_legacy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyRecord.ProtoReflect.Descriptor instead.
+func (*LegacyRecord) Descriptor() ([]byte, []int) {
+	return file_test_full_legacy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LegacyRecord) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *LegacyRecord) GetRetries() int32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return Default_LegacyRecord_Retries
+}
+
+func (x *LegacyRecord) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *LegacyRecord) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return Default_LegacyRecord_Enabled
+}
+
+func (x *LegacyRecord) GetState() LegacyState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return Default_LegacyRecord_State
+}
+
+func (x *LegacyRecord) GetStatus() LegacyState {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return LegacyState_LEGACY_STATE_UNKNOWN
+}
+
+func (x *LegacyRecord) GetRatio() float64 {
+	if x != nil && x.Ratio != nil {
+		return *x.Ratio
+	}
+	return Default_LegacyRecord_Ratio
+}
+
+func (x *LegacyRecord) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return Default_LegacyRecord_Region
+}
+
+func (x *LegacyRecord) GetChecksum() []byte {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
+func (x *LegacyRecord) GetCodes() []int32 {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *LegacyRecord) GetQuota() *LegacyQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *LegacyRecord) GetChoice() isLegacyRecord_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *LegacyRecord) GetSeq() int64 {
+	if x != nil {
+		if x, ok := x.Choice.(*LegacyRecord_Seq); ok {
+			return x.Seq
+		}
+	}
+	return 0
+}
+
+func (x *LegacyRecord) GetLabel() string {
+	if x != nil {
+		if x, ok := x.Choice.(*LegacyRecord_Label); ok {
+			return x.Label
+		}
+	}
+	return ""
+}
+
+type isLegacyRecord_Choice interface {
+	isLegacyRecord_Choice()
+}
+
+type LegacyRecord_Seq struct {
+	Seq int64 `protobuf:"varint,12,opt,name=seq,oneof"`
+}
+
+type LegacyRecord_Label struct {
+	Label string `protobuf:"bytes,13,opt,name=label,oneof"`
+}
+
+func (*LegacyRecord_Seq) isLegacyRecord_Choice() {}
+
+func (*LegacyRecord_Label) isLegacyRecord_Choice() {}
+
+// LegacyEvent has group fields: they map to messages, wire methods are not generated
+type LegacyEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	Meta          *LegacyEvent_Meta      `protobuf:"group,2,opt,name=Meta,json=meta" json:"meta,omitempty"`
+	Entry         []*LegacyEvent_Entry   `protobuf:"group,5,rep,name=Entry,json=entry" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegacyEvent) Reset() {
+	*x = LegacyEvent{}
+	mi := &file_test_full_legacy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegacyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyEvent) ProtoMessage() {}
+
+func (x *LegacyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_legacy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyEvent.ProtoReflect.Descriptor instead.
+func (*LegacyEvent) Descriptor() ([]byte, []int) {
+	return file_test_full_legacy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LegacyEvent) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *LegacyEvent) GetMeta() *LegacyEvent_Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *LegacyEvent) GetEntry() []*LegacyEvent_Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type LegacyEvent_Meta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *string                `protobuf:"bytes,3,opt,name=note" json:"note,omitempty"`
+	Version       *int64                 `protobuf:"varint,4,opt,name=version" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegacyEvent_Meta) Reset() {
+	*x = LegacyEvent_Meta{}
+	mi := &file_test_full_legacy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegacyEvent_Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyEvent_Meta) ProtoMessage() {}
+
+func (x *LegacyEvent_Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_legacy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyEvent_Meta.ProtoReflect.Descriptor instead.
+func (*LegacyEvent_Meta) Descriptor() ([]byte, []int) {
+	return file_test_full_legacy_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *LegacyEvent_Meta) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *LegacyEvent_Meta) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type LegacyEvent_Entry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *string                `protobuf:"bytes,6,opt,name=key" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegacyEvent_Entry) Reset() {
+	*x = LegacyEvent_Entry{}
+	mi := &file_test_full_legacy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegacyEvent_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyEvent_Entry) ProtoMessage() {}
+
+func (x *LegacyEvent_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_legacy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyEvent_Entry.ProtoReflect.Descriptor instead.
+func (*LegacyEvent_Entry) Descriptor() ([]byte, []int) {
+	return file_test_full_legacy_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *LegacyEvent_Entry) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+var File_test_full_legacy_proto protoreflect.FileDescriptor
+
+const file_test_full_legacy_proto_rawDesc = "" +
+	"\n" +
+	"\x16test/full/legacy.proto\x12\x04full\x1a\x15goplain/goplain.proto\"B\n" +
+	"\vLegacyQuota\x12\x1f\n" +
+	"\tmax_items\x18\x01 \x01(\x05:\x0210R\bmaxItems\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\"\xbf\x03\n" +
+	"\fLegacyRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\tR\x02id\x12\x1b\n" +
+	"\aretries\x18\x02 \x01(\x05:\x013R\aretries\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x1e\n" +
+	"\aenabled\x18\x04 \x01(\b:\x04trueR\aenabled\x12<\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x11.full.LegacyState:\x13LEGACY_STATE_ACTIVER\x05state\x121\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x11.full.LegacyStateB\x06\x82\xa6\x1d\x028\x01R\x06status\x12\x19\n" +
+	"\x05ratio\x18\a \x01(\x01:\x030.5R\x05ratio\x12\x1f\n" +
+	"\x06region\x18\b \x01(\t:\aeu-westR\x06region\x12\x1a\n" +
+	"\bchecksum\x18\t \x01(\fR\bchecksum\x12\x14\n" +
+	"\x05codes\x18\n" +
+	" \x03(\x05R\x05codes\x12/\n" +
+	"\x05quota\x18\v \x01(\v2\x11.full.LegacyQuotaB\x06\x82\xa6\x1d\x02 \x01R\x05quota\x12\x12\n" +
+	"\x03seq\x18\f \x01(\x03H\x00R\x03seq\x12\x16\n" +
+	"\x05label\x18\r \x01(\tH\x00R\x05label:\x06\x82\xa6\x1d\x02\b\x01B\b\n" +
+	"\x06choice\"\xd1\x01\n" +
+	"\vLegacyEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\tR\x02id\x12*\n" +
+	"\x04meta\x18\x02 \x01(\n" +
+	"2\x16.full.LegacyEvent.MetaR\x04meta\x12-\n" +
+	"\x05entry\x18\x05 \x03(\n" +
+	"2\x17.full.LegacyEvent.EntryR\x05entry\x1a4\n" +
+	"\x04Meta\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\x1a\x19\n" +
+	"\x05Entry\x12\x10\n" +
+	"\x03key\x18\x06 \x01(\tR\x03key:\x06\x82\xa6\x1d\x02\b\x01*Z\n" +
+	"\vLegacyState\x12\x18\n" +
+	"\x14LEGACY_STATE_UNKNOWN\x10\x00\x12\x17\n" +
+	"\x13LEGACY_STATE_ACTIVE\x10\x01\x12\x18\n" +
+	"\x14LEGACY_STATE_RETIRED\x10\x02B2Z0github.com/yaroher/protoc-gen-go-plain/test/full"
+
+var (
+	file_test_full_legacy_proto_rawDescOnce sync.Once
+	file_test_full_legacy_proto_rawDescData []byte
+)
+
+func file_test_full_legacy_proto_rawDescGZIP() []byte {
+	file_test_full_legacy_proto_rawDescOnce.Do(func() {
+		file_test_full_legacy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_legacy_proto_rawDesc), len(file_test_full_legacy_proto_rawDesc)))
+	})
+	return file_test_full_legacy_proto_rawDescData
+}
+
+var file_test_full_legacy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_test_full_legacy_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_test_full_legacy_proto_goTypes = []any{
+	(LegacyState)(0),          // 0: full.LegacyState
+	(*LegacyQuota)(nil),       // 1: full.LegacyQuota
+	(*LegacyRecord)(nil),      // 2: full.LegacyRecord
+	(*LegacyEvent)(nil),       // 3: full.LegacyEvent
+	(*LegacyEvent_Meta)(nil),  // 4: full.LegacyEvent.Meta
+	(*LegacyEvent_Entry)(nil), // 5: full.LegacyEvent.Entry
+}
+var file_test_full_legacy_proto_depIdxs = []int32{
+	0, // 0: full.LegacyRecord.state:type_name -> full.LegacyState
+	0, // 1: full.LegacyRecord.status:type_name -> full.LegacyState
+	1, // 2: full.LegacyRecord.quota:type_name -> full.LegacyQuota
+	4, // 3: full.LegacyEvent.meta:type_name -> full.LegacyEvent.Meta
+	5, // 4: full.LegacyEvent.entry:type_name -> full.LegacyEvent.Entry
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_test_full_legacy_proto_init() }
+func file_test_full_legacy_proto_init() {
+	if File_test_full_legacy_proto != nil {
+		return
+	}
+	file_test_full_legacy_proto_msgTypes[1].OneofWrappers = []any{
+		(*LegacyRecord_Seq)(nil),
+		(*LegacyRecord_Label)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_legacy_proto_rawDesc), len(file_test_full_legacy_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_legacy_proto_goTypes,
+		DependencyIndexes: file_test_full_legacy_proto_depIdxs,
+		EnumInfos:         file_test_full_legacy_proto_enumTypes,
+		MessageInfos:      file_test_full_legacy_proto_msgTypes,
+	}.Build()
+	File_test_full_legacy_proto = out.File
+	file_test_full_legacy_proto_goTypes = nil
+	file_test_full_legacy_proto_depIdxs = nil
+}
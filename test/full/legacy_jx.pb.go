@@ -0,0 +1,367 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/legacy.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+)
+
+// MarshalJX encodes LegacyQuota to JSON using jx.Encoder
+func (p *LegacyQuota) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.MaxItems != nil {
+		e.FieldStart("maxItems")
+		e.Int32(*p.MaxItems)
+	}
+	if p.Unit != nil {
+		e.FieldStart("unit")
+		e.Str(*p.Unit)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes LegacyQuota from JSON using jx.Decoder
+func (p *LegacyQuota) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "maxItems":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.MaxItems = &v
+		case "unit":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Unit = &v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes LegacyRecord to JSON using jx.Encoder
+func (p *LegacyRecord) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.Id != nil {
+		e.FieldStart("id")
+		e.Str(*p.Id)
+	}
+	if p.Retries != nil {
+		e.FieldStart("retries")
+		e.Int32(*p.Retries)
+	}
+	if p.Owner != nil {
+		e.FieldStart("owner")
+		e.Str(*p.Owner)
+	}
+	if p.Enabled != nil {
+		e.FieldStart("enabled")
+		e.Bool(*p.Enabled)
+	}
+	if p.State != nil {
+		e.FieldStart("state")
+		e.Int32(int32(*p.State))
+	}
+	if p.Status != nil {
+		e.FieldStart("status")
+		e.Int32(int32(*p.Status))
+	}
+	if p.Ratio != nil {
+		e.FieldStart("ratio")
+		e.Float64(*p.Ratio)
+	}
+	if p.Region != nil {
+		e.FieldStart("region")
+		e.Str(*p.Region)
+	}
+	if len(p.Checksum) > 0 {
+		e.FieldStart("checksum")
+		e.Base64(p.Checksum)
+	}
+	if len(p.GetCodes()) > 0 {
+		e.FieldStart("codes")
+		e.ArrStart()
+		for _, v := range p.GetCodes() {
+			e.Int32(v)
+		}
+		e.ArrEnd()
+	}
+	if p.GetQuota() != nil {
+		e.FieldStart("quota")
+		p.GetQuota().MarshalJX(e)
+	}
+	switch v := p.GetChoice().(type) {
+	case *LegacyRecord_Seq:
+		e.FieldStart("seq")
+		e.Int64(v.Seq)
+	case *LegacyRecord_Label:
+		e.FieldStart("label")
+		e.Str(v.Label)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes LegacyRecord from JSON using jx.Decoder
+func (p *LegacyRecord) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = &v
+		case "retries":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Retries = &v
+		case "owner":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Owner = &v
+		case "enabled":
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.Enabled = &v
+		case "state":
+			v, err := enumjx.Decode(d, "full.LegacyState", LegacyState_value)
+			if err != nil {
+				return err
+			}
+			_ev := LegacyState(v)
+			p.State = &_ev
+		case "status":
+			v, err := enumjx.Decode(d, "full.LegacyState", LegacyState_value)
+			if err != nil {
+				return err
+			}
+			_ev := LegacyState(v)
+			p.Status = &_ev
+		case "ratio":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Ratio = &v
+		case "region":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Region = &v
+		case "checksum":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.Checksum = v
+		case "codes":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int32()
+				if err != nil {
+					return err
+				}
+				p.Codes = append(p.Codes, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "quota":
+			p.Quota = &LegacyQuota{}
+			if err := p.Quota.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "seq":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Choice = &LegacyRecord_Seq{Seq: v}
+		case "label":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Choice = &LegacyRecord_Label{Label: v}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes LegacyEvent to JSON using jx.Encoder
+func (p *LegacyEvent) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.Id != nil {
+		e.FieldStart("id")
+		e.Str(*p.Id)
+	}
+	if p.GetMeta() != nil {
+		e.FieldStart("meta")
+		p.GetMeta().MarshalJX(e)
+	}
+	if len(p.GetEntry()) > 0 {
+		e.FieldStart("entry")
+		e.ArrStart()
+		for _, v := range p.GetEntry() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes LegacyEvent from JSON using jx.Decoder
+func (p *LegacyEvent) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = &v
+		case "meta":
+			p.Meta = &LegacyEvent_Meta{}
+			if err := p.Meta.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "entry":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &LegacyEvent_Entry{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Entry = append(p.Entry, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes LegacyEvent_Meta to JSON using jx.Encoder
+func (p *LegacyEvent_Meta) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.Note != nil {
+		e.FieldStart("note")
+		e.Str(*p.Note)
+	}
+	if p.Version != nil {
+		e.FieldStart("version")
+		e.Int64(*p.Version)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes LegacyEvent_Meta from JSON using jx.Decoder
+func (p *LegacyEvent_Meta) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "note":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Note = &v
+		case "version":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Version = &v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes LegacyEvent_Entry to JSON using jx.Encoder
+func (p *LegacyEvent_Entry) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.Key != nil {
+		e.FieldStart("key")
+		e.Str(*p.Key)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes LegacyEvent_Entry from JSON using jx.Decoder
+func (p *LegacyEvent_Entry) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "key":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Key = &v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,1390 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/legacy.proto
+
+package full
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	math "math"
+	slices "slices"
+	sync "sync"
+)
+
+type LegacyRecordPlain struct {
+	Id          *string     `json:"id,omitempty"`
+	Retries     int32       `json:"retries"`
+	Owner       *string     `json:"owner,omitempty"`
+	Enabled     bool        `json:"enabled"`
+	State       LegacyState `json:"state"`
+	Status      *string     `json:"status,omitempty"`
+	Ratio       float64     `json:"ratio"`
+	Region      string      `json:"region"`
+	Checksum    []byte      `json:"checksum"`
+	Codes       []int32     `json:"codes"`
+	MaxItems    int32       `json:"maxItems"`
+	Unit        *string     `json:"unit,omitempty"`
+	ChoiceSeq   int64       `json:"choiceSeq"`   // origin: oneof_embed, empath: choice.seq
+	ChoiceLabel string      `json:"choiceLabel"` // origin: oneof_embed, empath: choice.label
+	// ChoiceCase indicates which variant of choice oneof is set
+	ChoiceCase LegacyRecordChoiceCase `json:"choice_case,omitempty"`
+}
+
+// LegacyRecordChoiceCase identifies the set variant of full.LegacyRecord.choice oneof, empty when none is set
+type LegacyRecordChoiceCase string
+
+const (
+	LegacyRecordChoiceCaseSeq   LegacyRecordChoiceCase = "seq"
+	LegacyRecordChoiceCaseLabel LegacyRecordChoiceCase = "label"
+)
+
+// Valid reports whether c is one of the LegacyRecordChoiceCase variants
+func (c LegacyRecordChoiceCase) Valid() bool {
+	switch c {
+	case LegacyRecordChoiceCaseSeq, LegacyRecordChoiceCaseLabel:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c LegacyRecordChoiceCase) String() string {
+	return string(c)
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *LegacyRecord) IntoPlain() *LegacyRecordPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &LegacyRecordPlain{}
+
+	// Detect choice oneof case
+	switch pb.Choice.(type) {
+	case *LegacyRecord_Seq:
+		p.ChoiceCase = LegacyRecordChoiceCaseSeq
+	case *LegacyRecord_Label:
+		p.ChoiceCase = LegacyRecordChoiceCaseLabel
+	}
+
+	p.Id = pb.Id
+	p.Retries = pb.GetRetries()
+	p.Owner = pb.Owner
+	p.Enabled = pb.GetEnabled()
+	p.State = pb.GetState()
+	if pb.Status != nil {
+		_tmp := (*pb.Status).String()
+		p.Status = &_tmp
+	}
+	p.Ratio = pb.GetRatio()
+	p.Region = pb.GetRegion()
+	p.Checksum = pb.Checksum
+	if len(pb.Codes) > 0 {
+		p.Codes = pb.Codes
+	} else {
+		p.Codes = []int32{}
+	}
+	// MaxItems from
+	p.MaxItems = pb.GetQuota().GetMaxItems()
+	// Unit from
+	if pb.GetQuota() != nil {
+		if pb.GetQuota().Unit != nil {
+			_tmp := pb.GetQuota().GetUnit()
+			p.Unit = &_tmp
+		}
+	}
+	// ChoiceSeq from choice.seq
+	if pb != nil {
+		p.ChoiceSeq = pb.GetSeq()
+	}
+	// ChoiceLabel from choice.label
+	if pb != nil {
+		p.ChoiceLabel = pb.GetLabel()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails or an enum name is unknown, use IntoPbE to handle the error.
+func (p *LegacyRecordPlain) IntoPb() *LegacyRecord {
+	pb, err := p.IntoPbE()
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError wrapping *enumjx.UnknownValueError for unknown enum names.
+func (p *LegacyRecordPlain) IntoPbE() (*LegacyRecord, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &LegacyRecord{}
+	var _err error
+
+	pb.Id = p.Id
+	if p.Retries != 3 {
+		pb.Retries = proto.Int32(p.Retries)
+	}
+	pb.Owner = p.Owner
+	if !p.Enabled {
+		pb.Enabled = proto.Bool(p.Enabled)
+	}
+	if p.State != LegacyState_LEGACY_STATE_ACTIVE {
+		pb.State = p.State.Enum()
+	}
+	if p.Status != nil {
+		pb.Status = enumjx.TryName[LegacyState](*p.Status, "full.LegacyState", LegacyState_value, "status", &_err).Enum()
+	}
+	if p.Ratio != 0.5 {
+		pb.Ratio = proto.Float64(p.Ratio)
+	}
+	if p.Region != "eu-west" {
+		pb.Region = proto.String(p.Region)
+	}
+	pb.Checksum = p.Checksum
+	pb.Codes = p.Codes
+	// MaxItems ->
+	if p.MaxItems != 10 {
+		if pb.Quota == nil {
+			pb.Quota = &LegacyQuota{}
+		}
+		pb.Quota.MaxItems = proto.Int32(p.MaxItems)
+	}
+	// Unit ->
+	if p.Unit != nil {
+		if pb.Quota == nil {
+			pb.Quota = &LegacyQuota{}
+		}
+		pb.Quota.Unit = p.Unit
+	}
+	// ChoiceSeq -> choice.seq
+	if p.ChoiceCase == LegacyRecordChoiceCaseSeq {
+		pb.Choice = &LegacyRecord_Seq{Seq: p.ChoiceSeq}
+	}
+	// ChoiceLabel -> choice.label
+	if p.ChoiceCase == LegacyRecordChoiceCaseLabel {
+		pb.Choice = &LegacyRecord_Label{Label: p.ChoiceLabel}
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// legacyRecordPlainMergePaths are the fields of LegacyRecord covered by LegacyRecordPlain
+var legacyRecordPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{7},
+	{8},
+	{9},
+	{10},
+	{11, 1},
+	{11, 2},
+	{12},
+	{13},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *LegacyRecordPlain) IntoPbMerge(dst *LegacyRecord) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, legacyRecordPlainMergePaths...)
+	return nil
+}
+
+// legacyRecordPlainFieldPaths link the JSON names of LegacyRecordPlain to field-mask paths of LegacyRecord
+var legacyRecordPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "retries", Path: "retries"},
+	{Name: "owner", Path: "owner"},
+	{Name: "enabled", Path: "enabled"},
+	{Name: "state", Path: "state"},
+	{Name: "status", Path: "status"},
+	{Name: "ratio", Path: "ratio"},
+	{Name: "region", Path: "region"},
+	{Name: "checksum", Path: "checksum"},
+	{Name: "codes", Path: "codes"},
+	{Name: "maxItems", Path: "quota.max_items"},
+	{Name: "unit", Path: "quota.unit"},
+	{Name: "choiceSeq", Path: "seq"},
+	{Name: "choiceLabel", Path: "label"},
+}
+
+// LegacyRecordPlainFieldMask builds a field mask of LegacyRecord from JSON names of LegacyRecordPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func LegacyRecordPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(legacyRecordPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// LegacyRecordPlainFieldNames translates a field mask of LegacyRecord into JSON names of LegacyRecordPlain.
+// A path to an embedded message yields all fields flattened from it.
+func LegacyRecordPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(legacyRecordPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of LegacyRecord, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *LegacyRecordPlain) ApplyToPb(pb *LegacyRecord, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), legacyRecordPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *LegacyRecord) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*LegacyRecordPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), legacyRecordPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &LegacyRecord{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *LegacyRecord) IntoPlainReuse(p *LegacyRecordPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// Detect choice oneof case
+	switch pb.Choice.(type) {
+	case *LegacyRecord_Seq:
+		p.ChoiceCase = LegacyRecordChoiceCaseSeq
+	case *LegacyRecord_Label:
+		p.ChoiceCase = LegacyRecordChoiceCaseLabel
+	}
+
+	p.Id = pb.Id
+	p.Retries = pb.GetRetries()
+	p.Owner = pb.Owner
+	p.Enabled = pb.GetEnabled()
+	p.State = pb.GetState()
+	if pb.Status != nil {
+		_tmp := (*pb.Status).String()
+		p.Status = &_tmp
+	}
+	p.Ratio = pb.GetRatio()
+	p.Region = pb.GetRegion()
+	p.Checksum = pb.Checksum
+	if len(pb.Codes) > 0 {
+		p.Codes = pb.Codes
+	} else {
+		p.Codes = []int32{}
+	}
+	// MaxItems from
+	p.MaxItems = pb.GetQuota().GetMaxItems()
+	// Unit from
+	if pb.GetQuota() != nil {
+		if pb.GetQuota().Unit != nil {
+			_tmp := pb.GetQuota().GetUnit()
+			p.Unit = &_tmp
+		}
+	}
+	// ChoiceSeq from choice.seq
+	if pb != nil {
+		p.ChoiceSeq = pb.GetSeq()
+	}
+	// ChoiceLabel from choice.label
+	if pb != nil {
+		p.ChoiceLabel = pb.GetLabel()
+	}
+}
+
+// MarshalJX encodes LegacyRecordPlain to JSON using jx.Encoder
+func (p *LegacyRecordPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.ChoiceCase != "" {
+		e.FieldStart("choice_case")
+		e.Str(p.ChoiceCase.String())
+	}
+	if p.Id != nil {
+		e.FieldStart("id")
+		e.Str(*p.Id)
+	}
+	if p.Retries != 3 {
+		e.FieldStart("retries")
+		e.Int32(p.Retries)
+	}
+	if p.Owner != nil {
+		e.FieldStart("owner")
+		e.Str(*p.Owner)
+	}
+	if !p.Enabled {
+		e.FieldStart("enabled")
+		e.Bool(p.Enabled)
+	}
+	if p.State != LegacyState_LEGACY_STATE_ACTIVE {
+		e.FieldStart("state")
+		e.Int32(int32(p.State))
+	}
+	if p.Status != nil {
+		e.FieldStart("status")
+		e.Str(*p.Status)
+	}
+	if p.Ratio != 0.5 {
+		e.FieldStart("ratio")
+		e.Float64(p.Ratio)
+	}
+	if p.Region != "eu-west" {
+		e.FieldStart("region")
+		e.Str(p.Region)
+	}
+	if p.Checksum != nil {
+		e.FieldStart("checksum")
+		e.Base64(p.Checksum)
+	}
+	if len(p.Codes) > 0 {
+		e.FieldStart("codes")
+		e.ArrStart()
+		for _, v := range p.Codes {
+			e.Int32(v)
+		}
+		e.ArrEnd()
+	}
+	if p.MaxItems != 10 {
+		e.FieldStart("maxItems")
+		e.Int32(p.MaxItems)
+	}
+	if p.Unit != nil {
+		e.FieldStart("unit")
+		e.Str(*p.Unit)
+	}
+	if p.ChoiceSeq != 0 {
+		e.FieldStart("choiceSeq")
+		e.Int64(p.ChoiceSeq)
+	}
+	if p.ChoiceLabel != "" {
+		e.FieldStart("choiceLabel")
+		e.Str(p.ChoiceLabel)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *LegacyRecordPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes LegacyRecordPlain from JSON using jx.Decoder
+func (p *LegacyRecordPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	p.Retries = 3
+	p.Enabled = true
+	p.State = LegacyState_LEGACY_STATE_ACTIVE
+	p.Ratio = 0.5
+	p.Region = "eu-west"
+	p.MaxItems = 10
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "choice_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ChoiceCase = LegacyRecordChoiceCase(v)
+			if v != "" && !p.ChoiceCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.LegacyRecord.choice", v)
+			}
+		case "id":
+			if d.Next() == jx.Null {
+				p.Id = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = &v
+		case "retries":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Retries = v
+		case "owner":
+			if d.Next() == jx.Null {
+				p.Owner = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Owner = &v
+		case "enabled":
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.Enabled = v
+		case "state":
+			v, err := enumjx.Decode(d, "full.LegacyState", LegacyState_value)
+			if err != nil {
+				return err
+			}
+			p.State = LegacyState(v)
+		case "status":
+			if d.Next() == jx.Null {
+				p.Status = nil
+				return d.Null()
+			}
+			v, err := enumjx.DecodeName(d, "full.LegacyState", LegacyState_value, LegacyState_name)
+			if err != nil {
+				return err
+			}
+			p.Status = &v
+		case "ratio":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Ratio = v
+		case "region":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Region = v
+		case "checksum":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.Checksum = v
+		case "codes":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int32()
+				if err != nil {
+					return err
+				}
+				p.Codes = append(p.Codes, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "maxItems":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.MaxItems = v
+		case "unit":
+			if d.Next() == jx.Null {
+				p.Unit = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Unit = &v
+		case "choiceSeq":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.ChoiceSeq = v
+		case "choiceLabel":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ChoiceLabel = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *LegacyRecordPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes LegacyRecordPlain in the protobuf wire format of LegacyRecord
+func (p *LegacyRecordPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends LegacyRecordPlain encoded in the protobuf wire format of LegacyRecord to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *LegacyRecordPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if p.Id != nil {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, *p.Id)
+	}
+	if p.Retries != 3 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.Retries))
+	}
+	if p.Owner != nil {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, *p.Owner)
+	}
+	if !p.Enabled {
+		b = protowire.AppendTag(b, 4, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(p.Enabled))
+	}
+	if p.State != LegacyState_LEGACY_STATE_ACTIVE {
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.State))
+	}
+	if p.Status != nil {
+		b = protowire.AppendTag(b, 6, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(enumjx.TryName[LegacyState](*p.Status, "full.LegacyState", LegacyState_value, "status", &_err)))
+	}
+	if p.Ratio != 0.5 {
+		b = protowire.AppendTag(b, 7, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(p.Ratio)))
+	}
+	if p.Region != "eu-west" {
+		b = protowire.AppendTag(b, 8, protowire.BytesType)
+		b = protowire.AppendString(b, p.Region)
+	}
+	if p.Checksum != nil {
+		b = protowire.AppendTag(b, 9, protowire.BytesType)
+		b = protowire.AppendBytes(b, p.Checksum)
+	}
+	for _, v := range p.Codes {
+		b = protowire.AppendTag(b, 10, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	// quota (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		_start := len(b)
+		if p.MaxItems != 10 {
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(p.MaxItems))
+		}
+		if p.Unit != nil {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, *p.Unit)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	if p.ChoiceCase == LegacyRecordChoiceCaseSeq {
+		b = protowire.AppendTag(b, 12, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.ChoiceSeq))
+	}
+	if p.ChoiceCase == LegacyRecordChoiceCaseLabel {
+		b = protowire.AppendTag(b, 13, protowire.BytesType)
+		b = protowire.AppendString(b, p.ChoiceLabel)
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes LegacyRecordPlain from the protobuf wire format of LegacyRecord.
+// Unknown fields are skipped.
+func (p *LegacyRecordPlain) UnmarshalProto(b []byte) error {
+	*p = LegacyRecordPlain{}
+	p.Retries = 3
+	p.Enabled = true
+	p.State = LegacyState_LEGACY_STATE_ACTIVE
+	p.Ratio = 0.5
+	p.Region = "eu-west"
+	p.MaxItems = 10
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			_tmp := v
+			p.Id = &_tmp
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Retries = int32(v)
+		case num == 3 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			_tmp := v
+			p.Owner = &_tmp
+		case num == 4 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Enabled = protowire.DecodeBool(v)
+		case num == 5 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.State = LegacyState(v)
+		case num == 6 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := LegacyState(v).String()
+			p.Status = &_tmp
+		case num == 7 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.Ratio = math.Float64frombits(v)
+		case num == 8 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Region = v
+		case num == 9 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.Checksum = append([]byte{}, v...)
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.Codes = append(p.Codes, int32(x))
+			}
+		case num == 10 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Codes = append(p.Codes, int32(v))
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.MaxItems = int32(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					_tmp := v
+					p.Unit = &_tmp
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 12 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.ChoiceCase = LegacyRecordChoiceCaseSeq
+			p.ChoiceSeq = int64(v)
+		case num == 13 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.ChoiceCase = LegacyRecordChoiceCaseLabel
+			p.ChoiceLabel = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// legacyRecordPlainReflect describes LegacyRecordPlain as message full.plain.LegacyRecordPlain
+var legacyRecordPlainReflect = plainreflect.NewMessageInfo(file_test_full_legacy_proto_plain, "LegacyRecordPlain",
+	plainreflect.Optional(func(p *LegacyRecordPlain) **string { return &p.Id }),
+	plainreflect.Scalar(func(p *LegacyRecordPlain) *int32 { return &p.Retries }),
+	plainreflect.Optional(func(p *LegacyRecordPlain) **string { return &p.Owner }),
+	plainreflect.Scalar(func(p *LegacyRecordPlain) *bool { return &p.Enabled }),
+	plainreflect.Enum(func(p *LegacyRecordPlain) *LegacyState { return &p.State }),
+	plainreflect.Optional(func(p *LegacyRecordPlain) **string { return &p.Status }),
+	plainreflect.Scalar(func(p *LegacyRecordPlain) *float64 { return &p.Ratio }),
+	plainreflect.Scalar(func(p *LegacyRecordPlain) *string { return &p.Region }),
+	plainreflect.Scalar(func(p *LegacyRecordPlain) *[]byte { return &p.Checksum }),
+	plainreflect.List(func(p *LegacyRecordPlain) *[]int32 { return &p.Codes }),
+	plainreflect.Scalar(func(p *LegacyRecordPlain) *int32 { return &p.MaxItems }),
+	plainreflect.Optional(func(p *LegacyRecordPlain) **string { return &p.Unit }),
+	plainreflect.Scalar(func(p *LegacyRecordPlain) *int64 { return &p.ChoiceSeq }),
+	plainreflect.Scalar(func(p *LegacyRecordPlain) *string { return &p.ChoiceLabel }),
+	plainreflect.Case(func(p *LegacyRecordPlain) *LegacyRecordChoiceCase { return &p.ChoiceCase }),
+)
+
+// ProtoReflect returns the reflective view of LegacyRecordPlain backed by the struct
+func (p *LegacyRecordPlain) ProtoReflect() protoreflect.Message {
+	return legacyRecordPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of LegacyRecordPlain
+func (p *LegacyRecordPlain) Clone() *LegacyRecordPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &LegacyRecordPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of LegacyRecordPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetLegacyRecordPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *LegacyRecordPlain) CopyTo(dst *LegacyRecordPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.ChoiceCase = p.ChoiceCase
+	if p.Id == nil {
+		dst.Id = nil
+	} else {
+		if dst.Id == nil {
+			dst.Id = new(string)
+		}
+		*dst.Id = *p.Id
+	}
+	dst.Retries = p.Retries
+	if p.Owner == nil {
+		dst.Owner = nil
+	} else {
+		if dst.Owner == nil {
+			dst.Owner = new(string)
+		}
+		*dst.Owner = *p.Owner
+	}
+	dst.Enabled = p.Enabled
+	dst.State = p.State
+	if p.Status == nil {
+		dst.Status = nil
+	} else {
+		if dst.Status == nil {
+			dst.Status = new(string)
+		}
+		*dst.Status = *p.Status
+	}
+	dst.Ratio = p.Ratio
+	dst.Region = p.Region
+	dst.Checksum = append(dst.Checksum[:0], p.Checksum...)
+	dst.Codes = append(dst.Codes[:0], p.Codes...)
+	dst.MaxItems = p.MaxItems
+	if p.Unit == nil {
+		dst.Unit = nil
+	} else {
+		if dst.Unit == nil {
+			dst.Unit = new(string)
+		}
+		*dst.Unit = *p.Unit
+	}
+	dst.ChoiceSeq = p.ChoiceSeq
+	dst.ChoiceLabel = p.ChoiceLabel
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *LegacyRecordPlain) Equal(other *LegacyRecordPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.ChoiceCase != other.ChoiceCase {
+		return false
+	}
+	if (p.Id == nil) != (other.Id == nil) || p.Id != nil && *p.Id != *other.Id {
+		return false
+	}
+	if p.Retries != other.Retries {
+		return false
+	}
+	if (p.Owner == nil) != (other.Owner == nil) || p.Owner != nil && *p.Owner != *other.Owner {
+		return false
+	}
+	if p.Enabled != other.Enabled {
+		return false
+	}
+	if p.State != other.State {
+		return false
+	}
+	if (p.Status == nil) != (other.Status == nil) || p.Status != nil && *p.Status != *other.Status {
+		return false
+	}
+	if p.Ratio != other.Ratio {
+		return false
+	}
+	if p.Region != other.Region {
+		return false
+	}
+	if !bytes.Equal(p.Checksum, other.Checksum) {
+		return false
+	}
+	if !slices.Equal(p.Codes, other.Codes) {
+		return false
+	}
+	if p.MaxItems != other.MaxItems {
+		return false
+	}
+	if (p.Unit == nil) != (other.Unit == nil) || p.Unit != nil && *p.Unit != *other.Unit {
+		return false
+	}
+	if p.ChoiceSeq != other.ChoiceSeq {
+		return false
+	}
+	if p.ChoiceLabel != other.ChoiceLabel {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of LegacyRecordPlain rows, in the order of ScanRow and Values
+func (p *LegacyRecordPlain) Columns() []string {
+	return []string{
+		"id",
+		"retries",
+		"owner",
+		"enabled",
+		"state",
+		"status",
+		"ratio",
+		"region",
+		"checksum",
+		"codes",
+		"max_items",
+		"unit",
+		"choice_seq",
+		"choice_label",
+		"choice_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into LegacyRecordPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *LegacyRecordPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Retries,
+		&p.Owner,
+		&p.Enabled,
+		&p.State,
+		&p.Status,
+		&p.Ratio,
+		&p.Region,
+		&p.Checksum,
+		plainsql.JSON(&p.Codes),
+		&p.MaxItems,
+		&p.Unit,
+		&p.ChoiceSeq,
+		&p.ChoiceLabel,
+		&p.ChoiceCase,
+	)
+}
+
+// Values returns the column values of LegacyRecordPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *LegacyRecordPlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Retries,
+		p.Owner,
+		p.Enabled,
+		p.State,
+		p.Status,
+		p.Ratio,
+		p.Region,
+		p.Checksum,
+		plainsql.JSON(&p.Codes),
+		p.MaxItems,
+		p.Unit,
+		p.ChoiceSeq,
+		p.ChoiceLabel,
+		p.ChoiceCase,
+	}
+}
+
+// legacyRecordPlainPool is a sync.Pool for LegacyRecordPlain objects
+var legacyRecordPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &LegacyRecordPlain{}
+	},
+}
+
+// GetLegacyRecordPlain returns a LegacyRecordPlain from the pool
+func GetLegacyRecordPlain() *LegacyRecordPlain {
+	return legacyRecordPlainPool.Get().(*LegacyRecordPlain)
+}
+
+// PutLegacyRecordPlain returns a LegacyRecordPlain to the pool after resetting it
+func PutLegacyRecordPlain(p *LegacyRecordPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	legacyRecordPlainPool.Put(p)
+}
+
+// Reset clears all fields in LegacyRecordPlain for reuse
+func (p *LegacyRecordPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.ChoiceCase = ""
+	p.Id = nil
+	p.Retries = 3
+	p.Owner = nil
+	p.Enabled = true
+	p.State = LegacyState_LEGACY_STATE_ACTIVE
+	p.Status = nil
+	p.Ratio = 0.5
+	p.Region = "eu-west"
+	p.Checksum = nil
+	p.Codes = p.Codes[:0]
+	p.MaxItems = 10
+	p.Unit = nil
+	p.ChoiceSeq = 0
+	p.ChoiceLabel = ""
+}
+
+// LegacyEvent has group fields: they map to messages, wire methods are not generated
+type LegacyEventPlain struct {
+	Id    *string              `json:"id,omitempty"`
+	Meta  *LegacyEvent_Meta    `json:"meta"`
+	Entry []*LegacyEvent_Entry `json:"entry"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *LegacyEvent) IntoPlain() *LegacyEventPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &LegacyEventPlain{}
+
+	p.Id = pb.Id
+	p.Meta = pb.Meta
+	p.Entry = pb.Entry
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *LegacyEventPlain) IntoPb() *LegacyEvent {
+	if p == nil {
+		return nil
+	}
+	pb := &LegacyEvent{}
+
+	pb.Id = p.Id
+	pb.Meta = p.Meta
+	pb.Entry = p.Entry
+	return pb
+}
+
+// legacyEventPlainMergePaths are the fields of LegacyEvent covered by LegacyEventPlain
+var legacyEventPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{5},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *LegacyEventPlain) IntoPbMerge(dst *LegacyEvent) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, legacyEventPlainMergePaths...)
+}
+
+// legacyEventPlainFieldPaths link the JSON names of LegacyEventPlain to field-mask paths of LegacyEvent
+var legacyEventPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "meta", Path: "meta"},
+	{Name: "entry", Path: "entry"},
+}
+
+// LegacyEventPlainFieldMask builds a field mask of LegacyEvent from JSON names of LegacyEventPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func LegacyEventPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(legacyEventPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// LegacyEventPlainFieldNames translates a field mask of LegacyEvent into JSON names of LegacyEventPlain.
+// A path to an embedded message yields all fields flattened from it.
+func LegacyEventPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(legacyEventPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of LegacyEvent, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *LegacyEventPlain) ApplyToPb(pb *LegacyEvent, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), legacyEventPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *LegacyEvent) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*LegacyEventPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), legacyEventPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &LegacyEvent{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *LegacyEvent) IntoPlainReuse(p *LegacyEventPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.Id = pb.Id
+	p.Meta = pb.Meta
+	p.Entry = pb.Entry
+}
+
+// MarshalJX encodes LegacyEventPlain to JSON using jx.Encoder
+func (p *LegacyEventPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Id != nil {
+		e.FieldStart("id")
+		e.Str(*p.Id)
+	}
+	if p.Meta != nil {
+		e.FieldStart("meta")
+		p.Meta.MarshalJX(e)
+	}
+	if p.Entry != nil {
+		e.FieldStart("entry")
+		e.ArrStart()
+		for _, v := range p.Entry {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *LegacyEventPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes LegacyEventPlain from JSON using jx.Decoder
+func (p *LegacyEventPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			if d.Next() == jx.Null {
+				p.Id = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = &v
+		case "meta":
+			p.Meta = &LegacyEvent_Meta{}
+			if err := p.Meta.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "entry":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v LegacyEvent_Entry
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Entry = append(p.Entry, &v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *LegacyEventPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// legacyEventPlainReflect describes LegacyEventPlain as message full.plain.LegacyEventPlain
+var legacyEventPlainReflect = plainreflect.NewMessageInfo(file_test_full_legacy_proto_plain, "LegacyEventPlain",
+	plainreflect.Optional(func(p *LegacyEventPlain) **string { return &p.Id }),
+	plainreflect.Message(func(p *LegacyEventPlain) **LegacyEvent_Meta { return &p.Meta }),
+	plainreflect.MessageList(func(p *LegacyEventPlain) *[]*LegacyEvent_Entry { return &p.Entry }),
+)
+
+// ProtoReflect returns the reflective view of LegacyEventPlain backed by the struct
+func (p *LegacyEventPlain) ProtoReflect() protoreflect.Message {
+	return legacyEventPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of LegacyEventPlain
+func (p *LegacyEventPlain) Clone() *LegacyEventPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &LegacyEventPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of LegacyEventPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetLegacyEventPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *LegacyEventPlain) CopyTo(dst *LegacyEventPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	if p.Id == nil {
+		dst.Id = nil
+	} else {
+		if dst.Id == nil {
+			dst.Id = new(string)
+		}
+		*dst.Id = *p.Id
+	}
+	if p.Meta == nil {
+		dst.Meta = nil
+	} else {
+		if dst.Meta == nil {
+			dst.Meta = new(LegacyEvent_Meta)
+		} else {
+			proto.Reset(dst.Meta)
+		}
+		proto.Merge(dst.Meta, p.Meta)
+	}
+	if cap(dst.Entry) < len(p.Entry) {
+		dst.Entry = append(dst.Entry[:cap(dst.Entry)], make([]*LegacyEvent_Entry, len(p.Entry)-cap(dst.Entry))...)
+	} else {
+		dst.Entry = dst.Entry[:len(p.Entry)]
+	}
+	for i, v := range p.Entry {
+		if v == nil {
+			dst.Entry[i] = nil
+			continue
+		}
+		if dst.Entry[i] == nil {
+			dst.Entry[i] = new(LegacyEvent_Entry)
+		} else {
+			proto.Reset(dst.Entry[i])
+		}
+		proto.Merge(dst.Entry[i], v)
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *LegacyEventPlain) Equal(other *LegacyEventPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Id == nil) != (other.Id == nil) || p.Id != nil && *p.Id != *other.Id {
+		return false
+	}
+	if !proto.Equal(p.Meta, other.Meta) {
+		return false
+	}
+	if len(p.Entry) != len(other.Entry) {
+		return false
+	}
+	for i := range p.Entry {
+		if !proto.Equal(p.Entry[i], other.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Columns returns the column names of LegacyEventPlain rows, in the order of ScanRow and Values
+func (p *LegacyEventPlain) Columns() []string {
+	return []string{
+		"id",
+		"meta",
+		"entry",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into LegacyEventPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *LegacyEventPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		plainsql.JSON(&p.Meta),
+		plainsql.JSON(&p.Entry),
+	)
+}
+
+// Values returns the column values of LegacyEventPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *LegacyEventPlain) Values() []any {
+	return []any{
+		p.Id,
+		plainsql.JSON(&p.Meta),
+		plainsql.JSON(&p.Entry),
+	}
+}
+
+// legacyEventPlainPool is a sync.Pool for LegacyEventPlain objects
+var legacyEventPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &LegacyEventPlain{}
+	},
+}
+
+// GetLegacyEventPlain returns a LegacyEventPlain from the pool
+func GetLegacyEventPlain() *LegacyEventPlain {
+	return legacyEventPlainPool.Get().(*LegacyEventPlain)
+}
+
+// PutLegacyEventPlain returns a LegacyEventPlain to the pool after resetting it
+func PutLegacyEventPlain(p *LegacyEventPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	legacyEventPlainPool.Put(p)
+}
+
+// Reset clears all fields in LegacyEventPlain for reuse
+func (p *LegacyEventPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Id = nil
+	p.Meta = nil
+	p.Entry = nil
+}
+
+// file_test_full_legacy_proto_plain_rawDesc is the serialized descriptor of test/full/legacy_plain.proto,
+// describing the Plain messages of test/full/legacy.proto in package full.plain
+const file_test_full_legacy_proto_plain_rawDesc = "" +
+	"\n\x1ctest/full/legacy_plain.proto\x12\nfull.plain\x1a\x16test/full/legacy.pro" +
+	"to\"\xd3\x03\n\x11LegacyRecordPlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n\aretries\x18\x02 \x01(\x05:\x013R\are" +
+	"tries\x12\x14\n\x05owner\x18\x03 \x01(\tR\x05owner\x12\x1e\n\aenabled\x18\x04 \x01(\b:\x04trueR\aenabled\x12<\n\x05s" +
+	"tate\x18\x05 \x01(\x0e2\x11.full.LegacyState:\x13LEGACY_STATE_ACTIVER\x05state\x12\x16\n\x06sta" +
+	"tus\x18\x06 \x01(\tR\x06status\x12\x19\n\x05ratio\x18\a \x01(\x01:\x030.5R\x05ratio\x12\x1f\n\x06region\x18\b \x01(\t:\aeu" +
+	"-westR\x06region\x12\x1a\n\bchecksum\x18\t \x01(\fR\bchecksum\x12\x14\n\x05codes\x18\n \x03(\x05R\x05codes\x12" +
+	"\x1f\n\tmax_items\x18\v \x01(\x05:\x0210R\bmaxItems\x12\x12\n\x04unit\x18\f \x01(\tR\x04unit\x12\x1d\n\nchoice_s" +
+	"eq\x18\r \x01(\x03R\tchoiceSeq\x12!\n\fchoice_label\x18\x0e \x01(\tR\vchoiceLabel\x12 \n\vchoice" +
+	"_case\x18\x0f \x01(\tR\vchoice_case\"}\n\x10LegacyEventPlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12*\n\x04" +
+	"meta\x18\x02 \x01(\v2\x16.full.LegacyEvent.MetaR\x04meta\x12-\n\x05entry\x18\x03 \x03(\v2\x17.full.L" +
+	"egacyEvent.EntryR\x05entryb\x06proto2"
+
+var file_test_full_legacy_proto_plain = plainreflect.NewFile("test/full/legacy_plain.proto", file_test_full_legacy_proto_plain_rawDesc)
+
+func init() { file_test_full_legacy_proto_plain_init() }
+
+func file_test_full_legacy_proto_plain_init() {
+	file_test_full_legacy_proto_init()
+	file_test_full_legacy_proto_plain.Init()
+}
@@ -0,0 +1,39 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/legacy.proto
+
+/** Fields of LegacyRecordPlain outside of oneofs */
+export interface LegacyRecordPlainFields {
+  id?: string;
+  retries?: number;
+  owner?: string;
+  enabled?: boolean;
+  state?: 0 | 1 | 2;
+  status?: "LEGACY_STATE_UNKNOWN" | "LEGACY_STATE_ACTIVE" | "LEGACY_STATE_RETIRED";
+  ratio?: number;
+  region?: string;
+  checksum?: string;
+  codes?: number[];
+  maxItems?: number;
+  unit?: string;
+}
+
+/** choice oneof of LegacyRecordPlain, by choice_case */
+export type LegacyRecordPlainChoice =
+  | {
+      choice_case: "seq";
+      choiceSeq?: number;
+    }
+  | {
+      choice_case: "label";
+      choiceLabel?: string;
+    }
+  | { choice_case?: undefined };
+
+export type LegacyRecordPlain = LegacyRecordPlainFields & LegacyRecordPlainChoice;
+
+/** LegacyEvent has group fields: they map to messages, wire methods are not generated */
+export interface LegacyEventPlain {
+  id?: string;
+  meta?: Record<string, unknown>;
+  entry?: (Record<string, unknown>)[];
+}
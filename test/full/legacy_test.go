@@ -0,0 +1,193 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func legacyRecord() *full.LegacyRecord {
+	return &full.LegacyRecord{
+		Id:       proto.String("rec-1"),
+		Retries:  proto.Int32(5),
+		Owner:    proto.String(""),
+		Enabled:  proto.Bool(false),
+		State:    full.LegacyState_LEGACY_STATE_RETIRED.Enum(),
+		Status:   full.LegacyState_LEGACY_STATE_UNKNOWN.Enum(),
+		Ratio:    proto.Float64(0.25),
+		Region:   proto.String("us-east"),
+		Checksum: []byte{},
+		Codes:    []int32{1, 2},
+		Quota:    &full.LegacyQuota{MaxItems: proto.Int32(20), Unit: proto.String("")},
+		Choice:   &full.LegacyRecord_Label{Label: "x"},
+	}
+}
+
+func TestLegacy_PresenceIsPointer(t *testing.T) {
+	plain := legacyRecord().IntoPlain()
+
+	require.NotNil(t, plain.Id)
+	assert.Equal(t, "rec-1", *plain.Id)
+	require.NotNil(t, plain.Owner, "set empty optional is present")
+	assert.Equal(t, "", *plain.Owner)
+	require.NotNil(t, plain.Status, "zero enum value is present")
+	assert.Equal(t, "LEGACY_STATE_UNKNOWN", *plain.Status)
+	require.NotNil(t, plain.Unit, "embedded optional keeps presence")
+	assert.Equal(t, "", *plain.Unit)
+
+	empty := (&full.LegacyRecord{}).IntoPlain()
+	assert.Nil(t, empty.Id)
+	assert.Nil(t, empty.Owner)
+	assert.Nil(t, empty.Status)
+	assert.Nil(t, empty.Unit)
+	assert.Nil(t, empty.Checksum)
+}
+
+func TestLegacy_DefaultsIntoPlain(t *testing.T) {
+	plain := (&full.LegacyRecord{}).IntoPlain()
+
+	assert.Equal(t, int32(3), plain.Retries)
+	assert.True(t, plain.Enabled)
+	assert.Equal(t, full.LegacyState_LEGACY_STATE_ACTIVE, plain.State)
+	assert.Equal(t, 0.5, plain.Ratio)
+	assert.Equal(t, "eu-west", plain.Region)
+	assert.Equal(t, int32(10), plain.MaxItems, "default of an embedded field without the parent")
+}
+
+func TestLegacy_Roundtrip(t *testing.T) {
+	original := legacyRecord()
+	assert.True(t, proto.Equal(original, original.IntoPlain().IntoPb()))
+}
+
+func TestLegacy_DefaultsIntoPb(t *testing.T) {
+	pb := (&full.LegacyRecord{}).IntoPlain().IntoPb()
+
+	assert.Nil(t, pb.Retries, "value equal to the default is left unset")
+	assert.Nil(t, pb.Enabled)
+	assert.Nil(t, pb.State)
+	assert.Nil(t, pb.Ratio)
+	assert.Nil(t, pb.Region)
+	assert.Nil(t, pb.Quota, "embedded message with defaults only is not created")
+	assert.Equal(t, int32(3), pb.GetRetries())
+}
+
+func TestLegacy_Reset(t *testing.T) {
+	plain := legacyRecord().IntoPlain()
+	plain.Reset()
+
+	assert.Nil(t, plain.Id)
+	assert.Nil(t, plain.Status)
+	assert.Equal(t, int32(3), plain.Retries)
+	assert.True(t, plain.Enabled)
+	assert.Equal(t, full.LegacyState_LEGACY_STATE_ACTIVE, plain.State)
+	assert.Equal(t, "eu-west", plain.Region)
+	assert.Equal(t, int32(10), plain.MaxItems)
+}
+
+func TestLegacy_JSON(t *testing.T) {
+	data, err := (&full.LegacyRecord{}).IntoPlain().MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(data), "defaults are omitted")
+
+	decoded := &full.LegacyRecordPlain{}
+	require.NoError(t, decoded.UnmarshalJSON([]byte(`{"id":"a","status":"LEGACY_STATE_RETIRED"}`)))
+	assert.Equal(t, "a", *decoded.Id)
+	assert.Equal(t, "LEGACY_STATE_RETIRED", *decoded.Status)
+	assert.Equal(t, int32(3), decoded.Retries, "missing fields keep the default")
+	assert.True(t, decoded.Enabled)
+	assert.Equal(t, int32(10), decoded.MaxItems)
+
+	plain := legacyRecord().IntoPlain()
+	data, err = plain.MarshalJSON()
+	require.NoError(t, err)
+	decoded = &full.LegacyRecordPlain{}
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	assert.True(t, proto.Equal(plain.IntoPb(), decoded.IntoPb()))
+}
+
+func TestLegacy_JSONNull(t *testing.T) {
+	data := []byte(`{"id": "a", "owner": null, "status": null, "checksum": null}`)
+
+	decoded := legacyRecord().IntoPlain()
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	assert.Equal(t, "a", *decoded.Id)
+	assert.Nil(t, decoded.Owner, "null unsets optional fields")
+	assert.Nil(t, decoded.Status)
+	assert.Nil(t, decoded.Checksum)
+
+	// protojson reads the same input into the same message
+	expected := &full.LegacyRecord{}
+	require.NoError(t, protojson.Unmarshal(data, expected))
+	fresh := &full.LegacyRecordPlain{}
+	require.NoError(t, fresh.UnmarshalJSON(data))
+	assert.True(t, proto.Equal(expected, fresh.IntoPb()))
+
+	// Fields without explicit presence still reject null
+	assert.Error(t, (&full.LegacyRecordPlain{}).UnmarshalJSON([]byte(`{"retries": null}`)))
+}
+
+func TestLegacy_Wire(t *testing.T) {
+	original := legacyRecord()
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(original)
+	require.NoError(t, err)
+
+	data, err := original.IntoPlain().MarshalProto()
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	decoded := &full.LegacyRecordPlain{}
+	require.NoError(t, decoded.UnmarshalProto(data))
+	assert.True(t, proto.Equal(original, decoded.IntoPb()))
+
+	require.NoError(t, decoded.UnmarshalProto(nil))
+	assert.Nil(t, decoded.Id)
+	assert.Equal(t, int32(3), decoded.Retries, "missing fields keep the default")
+	assert.Equal(t, "eu-west", decoded.Region)
+	assert.Equal(t, int32(10), decoded.MaxItems)
+}
+
+func TestLegacy_Groups(t *testing.T) {
+	original := &full.LegacyEvent{
+		Id:    proto.String("ev"),
+		Meta:  &full.LegacyEvent_Meta{Note: proto.String("n"), Version: proto.Int64(2)},
+		Entry: []*full.LegacyEvent_Entry{{Key: proto.String("k")}},
+	}
+	plain := original.IntoPlain()
+	assert.Equal(t, "n", plain.Meta.GetNote())
+	assert.True(t, proto.Equal(original, plain.IntoPb()))
+
+	data, err := plain.MarshalJSON()
+	require.NoError(t, err)
+	decoded := &full.LegacyEventPlain{}
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	assert.True(t, proto.Equal(original, decoded.IntoPb()))
+
+	_, hasWire := any(plain).(interface{ MarshalProto() ([]byte, error) })
+	assert.False(t, hasWire, "groups have no wire methods")
+}
+
+func TestLegacy_Reflect(t *testing.T) {
+	plain := legacyRecord().IntoPlain()
+	plain.Checksum = []byte("sum") // reflection treats empty bytes as unset
+	md := plain.ProtoReflect().Descriptor()
+	assert.Equal(t, protoreflect.Proto2, md.ParentFile().Syntax())
+	assert.Equal(t, protoreflect.FullName("full.LegacyState"), md.Fields().ByName("state").Enum().FullName())
+
+	data, err := proto.Marshal(plain)
+	require.NoError(t, err)
+	decoded := &full.LegacyRecordPlain{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	assert.True(t, proto.Equal(plain.IntoPb(), decoded.IntoPb()))
+
+	m := decoded.ProtoReflect()
+	retries := md.Fields().ByName("retries")
+	assert.Equal(t, int64(3), retries.Default().Int())
+	m.Clear(retries)
+	assert.Equal(t, int32(3), decoded.Retries, "Clear restores the default")
+	assert.False(t, m.Has(retries), "the default is unset")
+}
@@ -0,0 +1,318 @@
+// Field-level json_name and go_name overrides
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/naming.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/typepb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NamingAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	ZipCode       string                 `protobuf:"bytes,3,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamingAddress) Reset() {
+	*x = NamingAddress{}
+	mi := &file_test_full_naming_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamingAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamingAddress) ProtoMessage() {}
+
+func (x *NamingAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_naming_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamingAddress.ProtoReflect.Descriptor instead.
+func (*NamingAddress) Descriptor() ([]byte, []int) {
+	return file_test_full_naming_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NamingAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *NamingAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *NamingAddress) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
+type NamingPhone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamingPhone) Reset() {
+	*x = NamingPhone{}
+	mi := &file_test_full_naming_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamingPhone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamingPhone) ProtoMessage() {}
+
+func (x *NamingPhone) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_naming_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamingPhone.ProtoReflect.Descriptor instead.
+func (*NamingPhone) Descriptor() ([]byte, []int) {
+	return file_test_full_naming_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NamingPhone) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type NamingContact struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address *NamingAddress         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Phones  []*NamingPhone         `protobuf:"bytes,3,rep,name=phones,proto3" json:"phones,omitempty"`
+	// Types that are valid to be assigned to Channel:
+	//
+	//	*NamingContact_Email
+	//	*NamingContact_Fax
+	Channel       isNamingContact_Channel `protobuf_oneof:"channel"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamingContact) Reset() {
+	*x = NamingContact{}
+	mi := &file_test_full_naming_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamingContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamingContact) ProtoMessage() {}
+
+func (x *NamingContact) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_naming_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamingContact.ProtoReflect.Descriptor instead.
+func (*NamingContact) Descriptor() ([]byte, []int) {
+	return file_test_full_naming_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NamingContact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NamingContact) GetAddress() *NamingAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *NamingContact) GetPhones() []*NamingPhone {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+func (x *NamingContact) GetChannel() isNamingContact_Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *NamingContact) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Channel.(*NamingContact_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *NamingContact) GetFax() string {
+	if x != nil {
+		if x, ok := x.Channel.(*NamingContact_Fax); ok {
+			return x.Fax
+		}
+	}
+	return ""
+}
+
+type isNamingContact_Channel interface {
+	isNamingContact_Channel()
+}
+
+type NamingContact_Email struct {
+	Email string `protobuf:"bytes,4,opt,name=email,proto3,oneof"`
+}
+
+type NamingContact_Fax struct {
+	Fax string `protobuf:"bytes,5,opt,name=fax,proto3,oneof"`
+}
+
+func (*NamingContact_Email) isNamingContact_Channel() {}
+
+func (*NamingContact_Fax) isNamingContact_Channel() {}
+
+var File_test_full_naming_proto protoreflect.FileDescriptor
+
+const file_test_full_naming_proto_rawDesc = "" +
+	"\n" +
+	"\x16test/full/naming.proto\x12\x04full\x1a\x15goplain/goplain.proto\x1a\x1agoogle/protobuf/type.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x88\x01\n" +
+	"\rNamingAddress\x12)\n" +
+	"\x06street\x18\x01 \x01(\tB\x11\x82\xa6\x1d\rR\vstreet_lineR\x06street\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x128\n" +
+	"\bzip_code\x18\x03 \x01(\tB\x1d\x82\xa6\x1d\x19R\vpostal_codeZ\n" +
+	"PostalCodeR\azipCode\"3\n" +
+	"\vNamingPhone\x12$\n" +
+	"\x06number\x18\x01 \x01(\tB\f\x82\xa6\x1d\bR\x06msisdnR\x06number\"\xe6\x02\n" +
+	"\rNamingContact\x12+\n" +
+	"\x02id\x18\x01 \x01(\tB\x1b\x82\xa6\x1d\x17R\n" +
+	"contact_idZ\tContactIDR\x02id\x127\n" +
+	"\aaddress\x18\x02 \x01(\v2\x13.full.NamingAddressB\b\x82\xa6\x1d\x04 \x01(\x01R\aaddress\x12@\n" +
+	"\x06phones\x18\x03 \x03(\v2\x11.full.NamingPhoneB\x15\x82\xa6\x1d\x11 \x01R\rphone_numbersR\x06phones\x12\"\n" +
+	"\x05email\x18\x04 \x01(\tB\n" +
+	"\x82\xa6\x1d\x06R\x04mailH\x00R\x05email\x12\x12\n" +
+	"\x03fax\x18\x05 \x01(\tH\x00R\x03fax:j\x82\xa6\x1df\b\x01\"b\b\t\"\fdisplay_nameJG\n" +
+	"\ago_name\x12<\n" +
+	"/type.googleapis.com/google.protobuf.StringValue\x12\t\n" +
+	"\aDisplayR\adisplayB\t\n" +
+	"\achannelB2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_naming_proto_rawDescOnce sync.Once
+	file_test_full_naming_proto_rawDescData []byte
+)
+
+func file_test_full_naming_proto_rawDescGZIP() []byte {
+	file_test_full_naming_proto_rawDescOnce.Do(func() {
+		file_test_full_naming_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_naming_proto_rawDesc), len(file_test_full_naming_proto_rawDesc)))
+	})
+	return file_test_full_naming_proto_rawDescData
+}
+
+var file_test_full_naming_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_test_full_naming_proto_goTypes = []any{
+	(*NamingAddress)(nil), // 0: full.NamingAddress
+	(*NamingPhone)(nil),   // 1: full.NamingPhone
+	(*NamingContact)(nil), // 2: full.NamingContact
+}
+var file_test_full_naming_proto_depIdxs = []int32{
+	0, // 0: full.NamingContact.address:type_name -> full.NamingAddress
+	1, // 1: full.NamingContact.phones:type_name -> full.NamingPhone
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_test_full_naming_proto_init() }
+func file_test_full_naming_proto_init() {
+	if File_test_full_naming_proto != nil {
+		return
+	}
+	file_test_full_naming_proto_msgTypes[2].OneofWrappers = []any{
+		(*NamingContact_Email)(nil),
+		(*NamingContact_Fax)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_naming_proto_rawDesc), len(file_test_full_naming_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_naming_proto_goTypes,
+		DependencyIndexes: file_test_full_naming_proto_depIdxs,
+		MessageInfos:      file_test_full_naming_proto_msgTypes,
+	}.Build()
+	File_test_full_naming_proto = out.File
+	file_test_full_naming_proto_goTypes = nil
+	file_test_full_naming_proto_depIdxs = nil
+}
@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/naming.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+)
+
+// MarshalJX encodes NamingAddress to JSON using jx.Encoder
+func (p *NamingAddress) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetStreet() != "" {
+		e.FieldStart("street")
+		e.Str(p.GetStreet())
+	}
+	if p.GetCity() != "" {
+		e.FieldStart("city")
+		e.Str(p.GetCity())
+	}
+	if p.GetZipCode() != "" {
+		e.FieldStart("zipCode")
+		e.Str(p.GetZipCode())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes NamingAddress from JSON using jx.Decoder
+func (p *NamingAddress) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "street":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Street = v
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		case "zipCode":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ZipCode = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes NamingPhone to JSON using jx.Encoder
+func (p *NamingPhone) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetNumber() != "" {
+		e.FieldStart("number")
+		e.Str(p.GetNumber())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes NamingPhone from JSON using jx.Decoder
+func (p *NamingPhone) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "number":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Number = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes NamingContact to JSON using jx.Encoder
+func (p *NamingContact) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetAddress() != nil {
+		e.FieldStart("address")
+		p.GetAddress().MarshalJX(e)
+	}
+	if len(p.GetPhones()) > 0 {
+		e.FieldStart("phones")
+		e.ArrStart()
+		for _, v := range p.GetPhones() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	switch v := p.GetChannel().(type) {
+	case *NamingContact_Email:
+		e.FieldStart("email")
+		e.Str(v.Email)
+	case *NamingContact_Fax:
+		e.FieldStart("fax")
+		e.Str(v.Fax)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes NamingContact from JSON using jx.Decoder
+func (p *NamingContact) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "address":
+			p.Address = &NamingAddress{}
+			if err := p.Address.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "phones":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &NamingPhone{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Phones = append(p.Phones, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "email":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Channel = &NamingContact_Email{Email: v}
+		case "fax":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Channel = &NamingContact_Fax{Fax: v}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
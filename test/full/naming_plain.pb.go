@@ -0,0 +1,916 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/naming.proto
+
+package full
+
+import (
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	sync "sync"
+)
+
+type NamingContactPlain struct {
+	ContactID     string                         `json:"contact_id"`
+	AddressStreet string                         `json:"street_line"`   // origin: embed, empath: address.street
+	AddressCity   string                         `json:"addressCity"`   // origin: embed, empath: address.city
+	PostalCode    string                         `json:"postal_code"`   // origin: embed, empath: address.zip_code
+	Phones        []NamingContactPhonesItemPlain `json:"phone_numbers"` // origin: embed, empath: phones
+	ChannelEmail  string                         `json:"mail"`          // origin: oneof_embed, empath: channel.email
+	ChannelFax    string                         `json:"channelFax"`    // origin: oneof_embed, empath: channel.fax
+	Display       string                         `json:"display"`       // origin: virtual, empath: virtual
+	// ChannelCase indicates which variant of channel oneof is set
+	ChannelCase NamingContactChannelCase `json:"channel_case,omitempty"`
+}
+
+// NamingContactChannelCase identifies the set variant of full.NamingContact.channel oneof, empty when none is set
+type NamingContactChannelCase string
+
+const (
+	NamingContactChannelCaseEmail NamingContactChannelCase = "email"
+	NamingContactChannelCaseFax   NamingContactChannelCase = "fax"
+)
+
+// Valid reports whether c is one of the NamingContactChannelCase variants
+func (c NamingContactChannelCase) Valid() bool {
+	switch c {
+	case NamingContactChannelCaseEmail, NamingContactChannelCaseFax:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c NamingContactChannelCase) String() string {
+	return string(c)
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *NamingContact) IntoPlain() *NamingContactPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &NamingContactPlain{}
+
+	// Detect channel oneof case
+	switch pb.Channel.(type) {
+	case *NamingContact_Email:
+		p.ChannelCase = NamingContactChannelCaseEmail
+	case *NamingContact_Fax:
+		p.ChannelCase = NamingContactChannelCaseFax
+	}
+
+	p.ContactID = pb.Id
+	// AddressStreet from address.street
+	if pb.GetAddress() != nil {
+		p.AddressStreet = pb.GetAddress().GetStreet()
+	}
+	// AddressCity from address.city
+	if pb.GetAddress() != nil {
+		p.AddressCity = pb.GetAddress().GetCity()
+	}
+	// PostalCode from address.zip_code
+	if pb.GetAddress() != nil {
+		p.PostalCode = pb.GetAddress().GetZipCode()
+	}
+	// Phones from phones
+	if pb.GetPhones() != nil {
+		p.Phones = make([]NamingContactPhonesItemPlain, len(pb.GetPhones()))
+		for i, _elem := range pb.GetPhones() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.Phones[i], _elem
+			p.Number = pb.Number
+		}
+	}
+	// ChannelEmail from channel.email
+	if pb != nil {
+		p.ChannelEmail = pb.GetEmail()
+	}
+	// ChannelFax from channel.fax
+	if pb != nil {
+		p.ChannelFax = pb.GetFax()
+	}
+	// Display is virtual, no source in protobuf
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *NamingContactPlain) IntoPb() *NamingContact {
+	if p == nil {
+		return nil
+	}
+	pb := &NamingContact{}
+
+	pb.Id = p.ContactID
+	// AddressStreet -> address.street
+	if p.AddressStreet != "" {
+		if pb.Address == nil {
+			pb.Address = &NamingAddress{}
+		}
+		pb.Address.Street = p.AddressStreet
+	}
+	// AddressCity -> address.city
+	if p.AddressCity != "" {
+		if pb.Address == nil {
+			pb.Address = &NamingAddress{}
+		}
+		pb.Address.City = p.AddressCity
+	}
+	// PostalCode -> address.zip_code
+	if p.PostalCode != "" {
+		if pb.Address == nil {
+			pb.Address = &NamingAddress{}
+		}
+		pb.Address.ZipCode = p.PostalCode
+	}
+	// Phones -> phones
+	if len(p.Phones) > 0 {
+		_items := make([]*NamingPhone, len(p.Phones))
+		for i := range p.Phones {
+			_items[i] = &NamingPhone{}
+			p, pb := &p.Phones[i], _items[i]
+			pb.Number = p.Number
+		}
+		pb.Phones = _items
+	}
+	// ChannelEmail -> channel.email
+	if p.ChannelCase == NamingContactChannelCaseEmail {
+		pb.Channel = &NamingContact_Email{Email: p.ChannelEmail}
+	}
+	// ChannelFax -> channel.fax
+	if p.ChannelCase == NamingContactChannelCaseFax {
+		pb.Channel = &NamingContact_Fax{Fax: p.ChannelFax}
+	}
+	// Display is virtual, skipping
+	return pb
+}
+
+// namingContactPlainMergePaths are the fields of NamingContact covered by NamingContactPlain
+var namingContactPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2, 1},
+	{2, 2},
+	{2, 3},
+	{3, 1},
+	{4},
+	{5},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *NamingContactPlain) IntoPbMerge(dst *NamingContact) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, namingContactPlainMergePaths...)
+}
+
+// namingContactPlainFieldPaths link the JSON names of NamingContactPlain to field-mask paths of NamingContact
+var namingContactPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "contact_id", Path: "id"},
+	{Name: "street_line", Path: "address.street"},
+	{Name: "addressCity", Path: "address.city"},
+	{Name: "postal_code", Path: "address.zip_code"},
+	{Name: "phone_numbers", Path: "phones"},
+	{Name: "mail", Path: "email"},
+	{Name: "channelFax", Path: "fax"},
+}
+
+// NamingContactPlainFieldMask builds a field mask of NamingContact from JSON names of NamingContactPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func NamingContactPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(namingContactPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// NamingContactPlainFieldNames translates a field mask of NamingContact into JSON names of NamingContactPlain.
+// A path to an embedded message yields all fields flattened from it.
+func NamingContactPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(namingContactPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of NamingContact, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *NamingContactPlain) ApplyToPb(pb *NamingContact, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), namingContactPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *NamingContact) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*NamingContactPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), namingContactPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &NamingContact{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *NamingContact) IntoPlainReuse(p *NamingContactPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// Detect channel oneof case
+	switch pb.Channel.(type) {
+	case *NamingContact_Email:
+		p.ChannelCase = NamingContactChannelCaseEmail
+	case *NamingContact_Fax:
+		p.ChannelCase = NamingContactChannelCaseFax
+	}
+
+	p.ContactID = pb.Id
+	// AddressStreet from address.street
+	if pb.GetAddress() != nil {
+		p.AddressStreet = pb.GetAddress().GetStreet()
+	}
+	// AddressCity from address.city
+	if pb.GetAddress() != nil {
+		p.AddressCity = pb.GetAddress().GetCity()
+	}
+	// PostalCode from address.zip_code
+	if pb.GetAddress() != nil {
+		p.PostalCode = pb.GetAddress().GetZipCode()
+	}
+	// Phones from phones
+	if pb.GetPhones() != nil {
+		p.Phones = make([]NamingContactPhonesItemPlain, len(pb.GetPhones()))
+		for i, _elem := range pb.GetPhones() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.Phones[i], _elem
+			p.Number = pb.Number
+		}
+	}
+	// ChannelEmail from channel.email
+	if pb != nil {
+		p.ChannelEmail = pb.GetEmail()
+	}
+	// ChannelFax from channel.fax
+	if pb != nil {
+		p.ChannelFax = pb.GetFax()
+	}
+	// Display is virtual, no source in protobuf
+}
+
+// WithDisplay sets the virtual field Display
+func (p *NamingContactPlain) WithDisplay(v string) *NamingContactPlain {
+	p.Display = v
+	return p
+}
+
+// MarshalJX encodes NamingContactPlain to JSON using jx.Encoder
+func (p *NamingContactPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.ChannelCase != "" {
+		e.FieldStart("channel_case")
+		e.Str(p.ChannelCase.String())
+	}
+	if p.ContactID != "" {
+		e.FieldStart("contact_id")
+		e.Str(p.ContactID)
+	}
+	if p.AddressStreet != "" {
+		e.FieldStart("street_line")
+		e.Str(p.AddressStreet)
+	}
+	if p.AddressCity != "" {
+		e.FieldStart("addressCity")
+		e.Str(p.AddressCity)
+	}
+	if p.PostalCode != "" {
+		e.FieldStart("postal_code")
+		e.Str(p.PostalCode)
+	}
+	if len(p.Phones) > 0 {
+		e.FieldStart("phone_numbers")
+		e.ArrStart()
+		for _, v := range p.Phones {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if p.ChannelEmail != "" {
+		e.FieldStart("mail")
+		e.Str(p.ChannelEmail)
+	}
+	if p.ChannelFax != "" {
+		e.FieldStart("channelFax")
+		e.Str(p.ChannelFax)
+	}
+	if p.Display != "" {
+		e.FieldStart("display")
+		e.Str(p.Display)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *NamingContactPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes NamingContactPlain from JSON using jx.Decoder
+func (p *NamingContactPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "channel_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ChannelCase = NamingContactChannelCase(v)
+			if v != "" && !p.ChannelCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.NamingContact.channel", v)
+			}
+		case "contact_id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ContactID = v
+		case "street_line":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.AddressStreet = v
+		case "addressCity":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.AddressCity = v
+		case "postal_code":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.PostalCode = v
+		case "phone_numbers":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v NamingContactPhonesItemPlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Phones = append(p.Phones, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "mail":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ChannelEmail = v
+		case "channelFax":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ChannelFax = v
+		case "display":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Display = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *NamingContactPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes NamingContactPlain in the protobuf wire format of NamingContact
+func (p *NamingContactPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends NamingContactPlain encoded in the protobuf wire format of NamingContact to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *NamingContactPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.ContactID; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	// address (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		if v := p.AddressStreet; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.AddressCity; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.PostalCode; v != "" {
+			b = protowire.AppendTag(b, 3, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	for i := range p.Phones {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Phones[i].AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.ChannelCase == NamingContactChannelCaseEmail {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendString(b, p.ChannelEmail)
+	}
+	if p.ChannelCase == NamingContactChannelCaseFax {
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		b = protowire.AppendString(b, p.ChannelFax)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes NamingContactPlain from the protobuf wire format of NamingContact.
+// Unknown fields are skipped.
+func (p *NamingContactPlain) UnmarshalProto(b []byte) error {
+	*p = NamingContactPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.ContactID = v
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.AddressStreet = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.AddressCity = v
+				case num == 3 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.PostalCode = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e NamingContactPhonesItemPlain
+			if err := e.UnmarshalProto(v); err != nil {
+				return err
+			}
+			p.Phones = append(p.Phones, e)
+		case num == 4 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.ChannelCase = NamingContactChannelCaseEmail
+			p.ChannelEmail = v
+		case num == 5 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.ChannelCase = NamingContactChannelCaseFax
+			p.ChannelFax = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// namingContactPlainReflect describes NamingContactPlain as message full.plain.NamingContactPlain
+var namingContactPlainReflect = plainreflect.NewMessageInfo(file_test_full_naming_proto_plain, "NamingContactPlain",
+	plainreflect.Scalar(func(p *NamingContactPlain) *string { return &p.ContactID }),
+	plainreflect.Scalar(func(p *NamingContactPlain) *string { return &p.AddressStreet }),
+	plainreflect.Scalar(func(p *NamingContactPlain) *string { return &p.AddressCity }),
+	plainreflect.Scalar(func(p *NamingContactPlain) *string { return &p.PostalCode }),
+	plainreflect.ValueList(func(p *NamingContactPlain) *[]NamingContactPhonesItemPlain { return &p.Phones }),
+	plainreflect.Scalar(func(p *NamingContactPlain) *string { return &p.ChannelEmail }),
+	plainreflect.Scalar(func(p *NamingContactPlain) *string { return &p.ChannelFax }),
+	plainreflect.Scalar(func(p *NamingContactPlain) *string { return &p.Display }),
+	plainreflect.Case(func(p *NamingContactPlain) *NamingContactChannelCase { return &p.ChannelCase }),
+)
+
+// ProtoReflect returns the reflective view of NamingContactPlain backed by the struct
+func (p *NamingContactPlain) ProtoReflect() protoreflect.Message {
+	return namingContactPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of NamingContactPlain
+func (p *NamingContactPlain) Clone() *NamingContactPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &NamingContactPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of NamingContactPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetNamingContactPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *NamingContactPlain) CopyTo(dst *NamingContactPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.ChannelCase = p.ChannelCase
+	dst.ContactID = p.ContactID
+	dst.AddressStreet = p.AddressStreet
+	dst.AddressCity = p.AddressCity
+	dst.PostalCode = p.PostalCode
+	if cap(dst.Phones) < len(p.Phones) {
+		dst.Phones = make([]NamingContactPhonesItemPlain, len(p.Phones))
+	} else {
+		dst.Phones = dst.Phones[:len(p.Phones)]
+	}
+	for i := range p.Phones {
+		p.Phones[i].CopyTo(&dst.Phones[i])
+	}
+	dst.ChannelEmail = p.ChannelEmail
+	dst.ChannelFax = p.ChannelFax
+	dst.Display = p.Display
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *NamingContactPlain) Equal(other *NamingContactPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.ChannelCase != other.ChannelCase {
+		return false
+	}
+	if p.ContactID != other.ContactID {
+		return false
+	}
+	if p.AddressStreet != other.AddressStreet {
+		return false
+	}
+	if p.AddressCity != other.AddressCity {
+		return false
+	}
+	if p.PostalCode != other.PostalCode {
+		return false
+	}
+	if len(p.Phones) != len(other.Phones) {
+		return false
+	}
+	for i := range p.Phones {
+		if !p.Phones[i].Equal(&other.Phones[i]) {
+			return false
+		}
+	}
+	if p.ChannelEmail != other.ChannelEmail {
+		return false
+	}
+	if p.ChannelFax != other.ChannelFax {
+		return false
+	}
+	if p.Display != other.Display {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of NamingContactPlain rows, in the order of ScanRow and Values
+func (p *NamingContactPlain) Columns() []string {
+	return []string{
+		"id",
+		"address_street",
+		"address_city",
+		"address_zip_code",
+		"phones",
+		"channel_email",
+		"channel_fax",
+		"display_name",
+		"channel_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into NamingContactPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *NamingContactPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.ContactID,
+		&p.AddressStreet,
+		&p.AddressCity,
+		&p.PostalCode,
+		plainsql.JSON(&p.Phones),
+		&p.ChannelEmail,
+		&p.ChannelFax,
+		&p.Display,
+		&p.ChannelCase,
+	)
+}
+
+// Values returns the column values of NamingContactPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *NamingContactPlain) Values() []any {
+	return []any{
+		p.ContactID,
+		p.AddressStreet,
+		p.AddressCity,
+		p.PostalCode,
+		plainsql.JSON(&p.Phones),
+		p.ChannelEmail,
+		p.ChannelFax,
+		p.Display,
+		p.ChannelCase,
+	}
+}
+
+// namingContactPlainPool is a sync.Pool for NamingContactPlain objects
+var namingContactPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &NamingContactPlain{}
+	},
+}
+
+// GetNamingContactPlain returns a NamingContactPlain from the pool
+func GetNamingContactPlain() *NamingContactPlain {
+	return namingContactPlainPool.Get().(*NamingContactPlain)
+}
+
+// PutNamingContactPlain returns a NamingContactPlain to the pool after resetting it
+func PutNamingContactPlain(p *NamingContactPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	namingContactPlainPool.Put(p)
+}
+
+// Reset clears all fields in NamingContactPlain for reuse
+func (p *NamingContactPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.ChannelCase = ""
+	p.ContactID = ""
+	p.AddressStreet = ""
+	p.AddressCity = ""
+	p.PostalCode = ""
+	p.Phones = p.Phones[:0]
+	p.ChannelEmail = ""
+	p.ChannelFax = ""
+	p.Display = ""
+}
+
+// NamingContactPhonesItemPlain holds flattened fields of full.NamingPhone for repeated embed phones
+type NamingContactPhonesItemPlain struct {
+	Number string `json:"msisdn"`
+}
+
+// MarshalJX encodes NamingContactPhonesItemPlain to JSON using jx.Encoder
+func (p *NamingContactPhonesItemPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Number != "" {
+		e.FieldStart("msisdn")
+		e.Str(p.Number)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *NamingContactPhonesItemPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes NamingContactPhonesItemPlain from JSON using jx.Decoder
+func (p *NamingContactPhonesItemPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "msisdn":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Number = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *NamingContactPhonesItemPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes NamingContactPhonesItemPlain in the protobuf wire format of NamingPhone
+func (p *NamingContactPhonesItemPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends NamingContactPhonesItemPlain encoded in the protobuf wire format of NamingPhone to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *NamingContactPhonesItemPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Number; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes NamingContactPhonesItemPlain from the protobuf wire format of NamingPhone.
+// Unknown fields are skipped.
+func (p *NamingContactPhonesItemPlain) UnmarshalProto(b []byte) error {
+	*p = NamingContactPhonesItemPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Number = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// namingContactPhonesItemPlainReflect describes NamingContactPhonesItemPlain as message full.plain.NamingContactPhonesItemPlain
+var namingContactPhonesItemPlainReflect = plainreflect.NewMessageInfo(file_test_full_naming_proto_plain, "NamingContactPhonesItemPlain",
+	plainreflect.Scalar(func(p *NamingContactPhonesItemPlain) *string { return &p.Number }),
+)
+
+// ProtoReflect returns the reflective view of NamingContactPhonesItemPlain backed by the struct
+func (p *NamingContactPhonesItemPlain) ProtoReflect() protoreflect.Message {
+	return namingContactPhonesItemPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of NamingContactPhonesItemPlain
+func (p *NamingContactPhonesItemPlain) Clone() *NamingContactPhonesItemPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &NamingContactPhonesItemPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of NamingContactPhonesItemPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetNamingContactPhonesItemPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *NamingContactPhonesItemPlain) CopyTo(dst *NamingContactPhonesItemPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Number = p.Number
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *NamingContactPhonesItemPlain) Equal(other *NamingContactPhonesItemPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Number != other.Number {
+		return false
+	}
+	return true
+}
+
+// Reset clears all fields in NamingContactPhonesItemPlain for reuse
+func (p *NamingContactPhonesItemPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Number = ""
+}
+
+// file_test_full_naming_proto_plain_rawDesc is the serialized descriptor of test/full/naming_plain.proto,
+// describing the Plain messages of test/full/naming.proto in package full.plain
+const file_test_full_naming_proto_plain_rawDesc = "" +
+	"\n\x1ctest/full/naming_plain.proto\x12\nfull.plain\"\xe5\x02\n\x12NamingContactPlai" +
+	"n\x12\x16\n\x02id\x18\x01 \x01(\tR\ncontact_id\x12#\n\x0eaddress_street\x18\x02 \x01(\tR\vstreet_line\x12!" +
+	"\n\faddress_city\x18\x03 \x01(\tR\vaddressCity\x12%\n\x10address_zip_code\x18\x04 \x01(\tR\vpos" +
+	"tal_code\x12G\n\x06phones\x18\x05 \x03(\v2(.full.plain.NamingContactPhonesItemPla" +
+	"inR\rphone_numbers\x12\x1b\n\rchannel_email\x18\x06 \x01(\tR\x04mail\x12\x1f\n\vchannel_fax\x18\a " +
+	"\x01(\tR\nchannelFax\x12\x1d\n\fdisplay_name\x18\b \x01(\tR\adisplay\x12\"\n\fchannel_case\x18\t" +
+	" \x01(\tR\fchannel_case\"6\n\x1cNamingContactPhonesItemPlain\x12\x16\n\x06number\x18\x01 \x01" +
+	"(\tR\x06msisdnb\x06proto3"
+
+var file_test_full_naming_proto_plain = plainreflect.NewFile("test/full/naming_plain.proto", file_test_full_naming_proto_plain_rawDesc)
+
+func init() { file_test_full_naming_proto_plain_init() }
+
+func file_test_full_naming_proto_plain_init() {
+	file_test_full_naming_proto_plain.Init()
+}
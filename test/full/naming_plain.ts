@@ -0,0 +1,31 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/naming.proto
+
+/** Fields of NamingContactPlain outside of oneofs */
+export interface NamingContactPlainFields {
+  contact_id?: string;
+  street_line?: string;
+  addressCity?: string;
+  postal_code?: string;
+  phone_numbers?: NamingContactPhonesItemPlain[];
+  display?: string;
+}
+
+/** channel oneof of NamingContactPlain, by channel_case */
+export type NamingContactPlainChannel =
+  | {
+      channel_case: "email";
+      mail?: string;
+    }
+  | {
+      channel_case: "fax";
+      channelFax?: string;
+    }
+  | { channel_case?: undefined };
+
+export type NamingContactPlain = NamingContactPlainFields & NamingContactPlainChannel;
+
+/** NamingContactPhonesItemPlain holds flattened fields of full.NamingPhone for repeated embed phones */
+export interface NamingContactPhonesItemPlain {
+  msisdn?: string;
+}
@@ -0,0 +1,88 @@
+package full_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+)
+
+func namingContact() *full.NamingContact {
+	return &full.NamingContact{
+		Id:      "c-1",
+		Address: &full.NamingAddress{Street: "Main st", City: "Oslo", ZipCode: "0150"},
+		Phones:  []*full.NamingPhone{{Number: "+47"}},
+		Channel: &full.NamingContact_Email{Email: "a@b.c"},
+	}
+}
+
+func TestNaming_GoNames(t *testing.T) {
+	plain := namingContact().IntoPlain()
+	plain.Display = "Alice"
+
+	assert.Equal(t, "c-1", plain.ContactID)
+	assert.Equal(t, "Main st", plain.AddressStreet, "json_name keeps the prefixed Go name")
+	assert.Equal(t, "0150", plain.PostalCode, "go_name replaces the prefixed Go name")
+	assert.Equal(t, "a@b.c", plain.ChannelEmail)
+}
+
+func TestNaming_StructTags(t *testing.T) {
+	tags := map[string]string{}
+	typ := reflect.TypeOf(full.NamingContactPlain{})
+	for i := 0; i < typ.NumField(); i++ {
+		tags[typ.Field(i).Name] = typ.Field(i).Tag.Get("json")
+	}
+
+	assert.Equal(t, "contact_id", tags["ContactID"])
+	assert.Equal(t, "street_line", tags["AddressStreet"])
+	assert.Equal(t, "addressCity", tags["AddressCity"], "fields without overrides keep the prefix")
+	assert.Equal(t, "postal_code", tags["PostalCode"])
+	assert.Equal(t, "phone_numbers", tags["Phones"])
+	assert.Equal(t, "mail", tags["ChannelEmail"])
+	assert.Equal(t, "display", tags["Display"])
+
+	item, ok := reflect.TypeOf(full.NamingContactPhonesItemPlain{}).FieldByName("Number")
+	require.True(t, ok)
+	assert.Equal(t, "msisdn", item.Tag.Get("json"))
+}
+
+func TestNaming_JSONKeys(t *testing.T) {
+	plain := namingContact().IntoPlain()
+	plain.Display = "Alice"
+
+	data, err := plain.MarshalJSON()
+	require.NoError(t, err)
+
+	var keys map[string]any
+	require.NoError(t, json.Unmarshal(data, &keys))
+	for _, key := range []string{"contact_id", "street_line", "addressCity", "postal_code", "phone_numbers", "mail", "display"} {
+		assert.Contains(t, keys, key)
+	}
+	assert.NotContains(t, keys, "addressStreet")
+	assert.Equal(t, []any{map[string]any{"msisdn": "+47"}}, keys["phone_numbers"])
+
+	decoded := &full.NamingContactPlain{}
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	assert.Equal(t, plain, decoded)
+	assert.True(t, proto.Equal(namingContact(), decoded.IntoPb()))
+}
+
+func TestNaming_Wire(t *testing.T) {
+	original := namingContact()
+	data, err := original.IntoPlain().MarshalProto()
+	require.NoError(t, err)
+
+	decoded := &full.NamingContactPlain{}
+	require.NoError(t, decoded.UnmarshalProto(data))
+	assert.True(t, proto.Equal(original, decoded.IntoPb()))
+}
+
+func TestNaming_Reflect(t *testing.T) {
+	fields := (&full.NamingContactPlain{}).ProtoReflect().Descriptor().Fields()
+	assert.Equal(t, "street_line", fields.ByName("address_street").JSONName())
+	assert.Equal(t, "display", fields.ByName("display_name").JSONName())
+}
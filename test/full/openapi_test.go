@@ -0,0 +1,62 @@
+package full_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"github.com/yaroher/protoc-gen-go-plain/test/schematest"
+)
+
+func TestOpenAPI_MarshalOutput(t *testing.T) {
+	for name, doc := range schemaDocs() {
+		t.Run(name, func(t *testing.T) {
+			data, err := doc.MarshalJSON()
+			require.NoError(t, err)
+			component, _, _ := strings.Cut(name, "/")
+			assert.NoError(t, schematest.ValidateComponent("openapi.json", component, data), "%s", data)
+		})
+	}
+}
+
+func TestOpenAPI_Document(t *testing.T) {
+	data, err := os.ReadFile("openapi.json")
+	require.NoError(t, err)
+	var doc struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+	// Plain structs of all files of the run, with repeated embed rows
+	for _, name := range []string{"CustomerPlain", "CustomerAddressesItemPlain", "TableUserPlain", "TableUser_SessionPlain", "WireEnvelopePlain"} {
+		assert.Contains(t, doc.Components.Schemas, name)
+	}
+	assert.NotContains(t, string(data), "$defs")
+}
+
+func TestOpenAPI_Nullable(t *testing.T) {
+	// Fields with explicit presence are nullable, UnmarshalJSON unsets them on null
+	data := []byte(`{"id": "u-1", "nickname": null}`)
+	require.NoError(t, schematest.ValidateComponent("openapi.json", "TableUserPlain", data))
+	decoded := &full.TableUserPlain{Nickname: new(string)}
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	assert.Nil(t, decoded.Nickname)
+
+	data = []byte(`{"owner": null, "status": null}`)
+	require.NoError(t, schematest.ValidateComponent("openapi.json", "LegacyRecordPlain", data))
+	record := &full.LegacyRecordPlain{}
+	require.NoError(t, record.UnmarshalJSON(data))
+	assert.Nil(t, record.Owner)
+	assert.Nil(t, record.Status)
+
+	// Fields without explicit presence are not nullable
+	assert.Error(t, schematest.ValidateComponent("openapi.json", "TableUserPlain", []byte(`{"id": null}`)))
+	assert.Error(t, schematest.ValidateComponent("openapi.json", "LegacyRecordPlain", []byte(`{"status": "UNKNOWN"}`)))
+}
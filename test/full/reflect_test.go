@@ -0,0 +1,151 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Looked up while the test package is initialized, before any Plain value is used
+var (
+	registeredType, registeredTypeErr = protoregistry.GlobalTypes.FindMessageByName("full.plain.CollectionShelfPlain")
+	registeredFile, registeredFileErr = protoregistry.GlobalFiles.FindFileByPath("test/full/collection_plain.proto")
+)
+
+func TestReflect_RegisteredAtInit(t *testing.T) {
+	require.NoError(t, registeredTypeErr)
+	assert.IsType(t, &full.CollectionShelfPlain{}, registeredType.New().Interface())
+	require.NoError(t, registeredFileErr)
+	assert.Equal(t, protoreflect.FullName("full.plain"), registeredFile.Package())
+}
+
+func TestReflect_Descriptor(t *testing.T) {
+	var plain proto.Message = &full.WireEnvelopePlain{}
+	md := plain.ProtoReflect().Descriptor()
+
+	assert.Equal(t, protoreflect.FullName("full.plain.WireEnvelopePlain"), md.FullName())
+	assert.Equal(t, "test/full/wire_plain.proto", md.ParentFile().Path())
+
+	fields := md.Fields()
+	assert.Equal(t, protoreflect.StringKind, fields.ByName("color").Kind(), "enum_as_string is a string")
+	assert.Equal(t, protoreflect.EnumKind, fields.ByName("raw_color").Kind())
+	assert.Equal(t, protoreflect.FullName("full.WireColor"), fields.ByName("raw_color").Enum().FullName())
+	assert.Equal(t, protoreflect.Int64Kind, fields.ByName("scalars_f_int64").Kind())
+	assert.Equal(t, "scalarsFInt64", fields.ByName("scalars_f_int64").JSONName())
+	assert.Equal(t, protoreflect.BytesKind, fields.ByName("raw_size").Kind(), "serialized field is bytes")
+
+	cover := fields.ByName("cover")
+	assert.Equal(t, protoreflect.FullName("full.plain.WireAttachmentPlain"), cover.Message().FullName())
+	assert.Equal(t, protoreflect.FullName("full.common.Money"), fields.ByName("price").Message().FullName())
+
+	byID := fields.ByName("attachments_by_id")
+	require.True(t, byID.IsMap())
+	assert.Equal(t, protoreflect.Int32Kind, byID.MapKey().Kind())
+	assert.Equal(t, protoreflect.FullName("full.plain.WireAttachmentPlain"), byID.MapValue().Message().FullName())
+
+	thumbnails := fields.ByName("thumbnails")
+	assert.True(t, thumbnails.IsList())
+	assert.Equal(t, protoreflect.FullName("full.plain.WireEnvelopeThumbnailsItemPlain"), thumbnails.Message().FullName())
+
+	payloadCase := fields.ByName("payload_case")
+	require.NotNil(t, payloadCase, "oneof case field is described")
+	assert.Equal(t, protoreflect.StringKind, payloadCase.Kind())
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName("full.plain.WireEnvelopePlain")
+	require.NoError(t, err)
+	assert.IsType(t, &full.WireEnvelopePlain{}, mt.New().Interface())
+}
+
+func TestReflect_ProtoMarshalRoundtrip(t *testing.T) {
+	plain := wireEnvelope().IntoPlain()
+
+	data, err := proto.Marshal(plain)
+	require.NoError(t, err)
+
+	decoded := &full.WireEnvelopePlain{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	assert.True(t, proto.Equal(plain, decoded))
+	assert.Equal(t, plain.AttachmentsById[10].SizeWidth, decoded.AttachmentsById[10].SizeWidth)
+	assert.Equal(t, plain.Thumbnails, decoded.Thumbnails)
+	assert.Equal(t, full.WireEnvelopePayloadCaseImage, decoded.PayloadCase)
+	assert.True(t, proto.Equal(plain.IntoPb(), decoded.IntoPb()))
+}
+
+func TestReflect_CloneEqual(t *testing.T) {
+	plain := wireEnvelope().IntoPlain()
+
+	clone := proto.Clone(plain).(*full.WireEnvelopePlain)
+	assert.True(t, proto.Equal(plain, clone))
+	assert.Equal(t, plain, clone)
+
+	clone.Attachments[0].Name = "changed"
+	clone.AttachmentsById[10].SizeWidth = 1
+	clone.Price.Units = 1
+	assert.Equal(t, "x", plain.Attachments[0].Name, "clone is deep")
+	assert.Equal(t, int32(640), plain.AttachmentsById[10].SizeWidth, "clone is deep")
+	assert.Equal(t, int64(150), plain.Price.Units, "clone is deep")
+	assert.False(t, proto.Equal(plain, clone))
+}
+
+func TestReflect_Protojson(t *testing.T) {
+	plain := (&full.ChoiceDocument{
+		Id:     "doc-1",
+		Source: &full.ChoiceDocument_Price{Price: &common.Money{Currency: "USD", Units: 5}},
+	}).IntoPlain()
+
+	data, err := protojson.Marshal(plain)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"source_case":"price"`)
+	assert.Contains(t, string(data), `"sourcePrice":{"currency":"USD"`)
+
+	decoded := &full.ChoiceDocumentPlain{}
+	require.NoError(t, protojson.Unmarshal(data, decoded))
+	assert.Equal(t, full.ChoiceDocumentSourceCasePrice, decoded.SourceCase)
+	assert.True(t, proto.Equal(plain, decoded))
+}
+
+func TestReflect_Range(t *testing.T) {
+	plain := &full.ChoiceFilePlain{Path: "/tmp/a"}
+
+	var names []protoreflect.Name
+	plain.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		names = append(names, fd.Name())
+		return true
+	})
+	assert.Equal(t, []protoreflect.Name{"path"}, names, "only populated fields are visited")
+
+	m := plain.ProtoReflect()
+	size := m.Descriptor().Fields().ByName("size")
+	m.Set(size, protoreflect.ValueOfInt64(42))
+	assert.Equal(t, int64(42), plain.Size)
+	m.Clear(size)
+	assert.Zero(t, plain.Size)
+}
+
+func TestReflect_AllMessages(t *testing.T) {
+	messages := []proto.Message{
+		&full.ChoiceDocumentPlain{},
+		&full.ChoiceFilePlain{},
+		&full.CustomerPlain{},
+		&full.InvoicePlain{},
+		&full.LeasePlain{},
+		&full.SubscriptionPlain{},
+		&full.TicketPlain{},
+		&full.WireEnvelopePlain{},
+	}
+	for _, msg := range messages {
+		md := msg.ProtoReflect().Descriptor()
+		t.Run(string(md.Name()), func(t *testing.T) {
+			assert.Equal(t, protoreflect.Name("plain"), md.ParentFile().Package().Name())
+			_, err := proto.Marshal(msg)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,401 @@
+// Repeated embed: repeated message fields flattened into row structs
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/repeated_embed.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddressKind int32
+
+const (
+	AddressKind_ADDRESS_KIND_UNSPECIFIED AddressKind = 0
+	AddressKind_ADDRESS_KIND_HOME        AddressKind = 1
+	AddressKind_ADDRESS_KIND_WORK        AddressKind = 2
+)
+
+// Enum value maps for AddressKind.
+var (
+	AddressKind_name = map[int32]string{
+		0: "ADDRESS_KIND_UNSPECIFIED",
+		1: "ADDRESS_KIND_HOME",
+		2: "ADDRESS_KIND_WORK",
+	}
+	AddressKind_value = map[string]int32{
+		"ADDRESS_KIND_UNSPECIFIED": 0,
+		"ADDRESS_KIND_HOME":        1,
+		"ADDRESS_KIND_WORK":        2,
+	}
+)
+
+func (x AddressKind) Enum() *AddressKind {
+	p := new(AddressKind)
+	*p = x
+	return p
+}
+
+func (x AddressKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_test_full_repeated_embed_proto_enumTypes[0].Descriptor()
+}
+
+func (AddressKind) Type() protoreflect.EnumType {
+	return &file_test_full_repeated_embed_proto_enumTypes[0]
+}
+
+func (x AddressKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressKind.Descriptor instead.
+func (AddressKind) EnumDescriptor() ([]byte, []int) {
+	return file_test_full_repeated_embed_proto_rawDescGZIP(), []int{0}
+}
+
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_test_full_repeated_embed_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_repeated_embed_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_test_full_repeated_embed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GeoPoint) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+type PostalAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Point         *GeoPoint              `protobuf:"bytes,3,opt,name=point,proto3" json:"point,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Kind          AddressKind            `protobuf:"varint,5,opt,name=kind,proto3,enum=full.AddressKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostalAddress) Reset() {
+	*x = PostalAddress{}
+	mi := &file_test_full_repeated_embed_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostalAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostalAddress) ProtoMessage() {}
+
+func (x *PostalAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_repeated_embed_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostalAddress.ProtoReflect.Descriptor instead.
+func (*PostalAddress) Descriptor() ([]byte, []int) {
+	return file_test_full_repeated_embed_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PostalAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetPoint() *GeoPoint {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *PostalAddress) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PostalAddress) GetKind() AddressKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AddressKind_ADDRESS_KIND_UNSPECIFIED
+}
+
+type ShippingProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Primary addresses list, one row per element
+	Addresses     []*PostalAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingProfile) Reset() {
+	*x = ShippingProfile{}
+	mi := &file_test_full_repeated_embed_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingProfile) ProtoMessage() {}
+
+func (x *ShippingProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_repeated_embed_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingProfile.ProtoReflect.Descriptor instead.
+func (*ShippingProfile) Descriptor() ([]byte, []int) {
+	return file_test_full_repeated_embed_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShippingProfile) GetAddresses() []*PostalAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type Customer struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Addresses []*PostalAddress       `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Repeated embed reached through a regular embed
+	Shipping      *ShippingProfile `protobuf:"bytes,4,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_test_full_repeated_embed_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer) ProtoMessage() {}
+
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_repeated_embed_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_test_full_repeated_embed_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Customer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Customer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Customer) GetAddresses() []*PostalAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *Customer) GetShipping() *ShippingProfile {
+	if x != nil {
+		return x.Shipping
+	}
+	return nil
+}
+
+var File_test_full_repeated_embed_proto protoreflect.FileDescriptor
+
+const file_test_full_repeated_embed_proto_rawDesc = "" +
+	"\n" +
+	"\x1etest/full/repeated_embed.proto\x12\x04full\x1a\x15goplain/goplain.proto\".\n" +
+	"\bGeoPoint\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x02 \x01(\x01R\x03lng\"\xae\x01\n" +
+	"\rPostalAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12.\n" +
+	"\x05point\x18\x03 \x01(\v2\x0e.full.GeoPointB\b\x82\xa6\x1d\x04 \x01(\x01R\x05point\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12-\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x11.full.AddressKindB\x06\x82\xa6\x1d\x028\x01R\x04kind\"L\n" +
+	"\x0fShippingProfile\x129\n" +
+	"\taddresses\x18\x01 \x03(\v2\x13.full.PostalAddressB\x06\x82\xa6\x1d\x02 \x01R\taddresses\"\xae\x01\n" +
+	"\bCustomer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\taddresses\x18\x03 \x03(\v2\x13.full.PostalAddressB\x06\x82\xa6\x1d\x02 \x01R\taddresses\x12;\n" +
+	"\bshipping\x18\x04 \x01(\v2\x15.full.ShippingProfileB\b\x82\xa6\x1d\x04 \x01(\x01R\bshipping:\x06\x82\xa6\x1d\x02\b\x01*Y\n" +
+	"\vAddressKind\x12\x1c\n" +
+	"\x18ADDRESS_KIND_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ADDRESS_KIND_HOME\x10\x01\x12\x15\n" +
+	"\x11ADDRESS_KIND_WORK\x10\x02B2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_repeated_embed_proto_rawDescOnce sync.Once
+	file_test_full_repeated_embed_proto_rawDescData []byte
+)
+
+func file_test_full_repeated_embed_proto_rawDescGZIP() []byte {
+	file_test_full_repeated_embed_proto_rawDescOnce.Do(func() {
+		file_test_full_repeated_embed_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_repeated_embed_proto_rawDesc), len(file_test_full_repeated_embed_proto_rawDesc)))
+	})
+	return file_test_full_repeated_embed_proto_rawDescData
+}
+
+var file_test_full_repeated_embed_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_test_full_repeated_embed_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_test_full_repeated_embed_proto_goTypes = []any{
+	(AddressKind)(0),        // 0: full.AddressKind
+	(*GeoPoint)(nil),        // 1: full.GeoPoint
+	(*PostalAddress)(nil),   // 2: full.PostalAddress
+	(*ShippingProfile)(nil), // 3: full.ShippingProfile
+	(*Customer)(nil),        // 4: full.Customer
+}
+var file_test_full_repeated_embed_proto_depIdxs = []int32{
+	1, // 0: full.PostalAddress.point:type_name -> full.GeoPoint
+	0, // 1: full.PostalAddress.kind:type_name -> full.AddressKind
+	2, // 2: full.ShippingProfile.addresses:type_name -> full.PostalAddress
+	2, // 3: full.Customer.addresses:type_name -> full.PostalAddress
+	3, // 4: full.Customer.shipping:type_name -> full.ShippingProfile
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_test_full_repeated_embed_proto_init() }
+func file_test_full_repeated_embed_proto_init() {
+	if File_test_full_repeated_embed_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_repeated_embed_proto_rawDesc), len(file_test_full_repeated_embed_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_repeated_embed_proto_goTypes,
+		DependencyIndexes: file_test_full_repeated_embed_proto_depIdxs,
+		EnumInfos:         file_test_full_repeated_embed_proto_enumTypes,
+		MessageInfos:      file_test_full_repeated_embed_proto_msgTypes,
+	}.Build()
+	File_test_full_repeated_embed_proto = out.File
+	file_test_full_repeated_embed_proto_goTypes = nil
+	file_test_full_repeated_embed_proto_depIdxs = nil
+}
@@ -0,0 +1,256 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/repeated_embed.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+)
+
+// MarshalJX encodes GeoPoint to JSON using jx.Encoder
+func (p *GeoPoint) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetLat() != 0 {
+		e.FieldStart("lat")
+		e.Float64(p.GetLat())
+	}
+	if p.GetLng() != 0 {
+		e.FieldStart("lng")
+		e.Float64(p.GetLng())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes GeoPoint from JSON using jx.Decoder
+func (p *GeoPoint) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "lat":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Lat = v
+		case "lng":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Lng = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes PostalAddress to JSON using jx.Encoder
+func (p *PostalAddress) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetStreet() != "" {
+		e.FieldStart("street")
+		e.Str(p.GetStreet())
+	}
+	if p.GetCity() != "" {
+		e.FieldStart("city")
+		e.Str(p.GetCity())
+	}
+	if p.GetPoint() != nil {
+		e.FieldStart("point")
+		p.GetPoint().MarshalJX(e)
+	}
+	if len(p.GetTags()) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.GetTags() {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("kind")
+	e.Int32(int32(p.GetKind()))
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes PostalAddress from JSON using jx.Decoder
+func (p *PostalAddress) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "street":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Street = v
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		case "point":
+			p.Point = &GeoPoint{}
+			if err := p.Point.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "kind":
+			v, err := enumjx.Decode(d, "full.AddressKind", AddressKind_value)
+			if err != nil {
+				return err
+			}
+			p.Kind = AddressKind(v)
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes ShippingProfile to JSON using jx.Encoder
+func (p *ShippingProfile) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if len(p.GetAddresses()) > 0 {
+		e.FieldStart("addresses")
+		e.ArrStart()
+		for _, v := range p.GetAddresses() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes ShippingProfile from JSON using jx.Decoder
+func (p *ShippingProfile) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "addresses":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &PostalAddress{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Addresses = append(p.Addresses, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes Customer to JSON using jx.Encoder
+func (p *Customer) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetName() != "" {
+		e.FieldStart("name")
+		e.Str(p.GetName())
+	}
+	if len(p.GetAddresses()) > 0 {
+		e.FieldStart("addresses")
+		e.ArrStart()
+		for _, v := range p.GetAddresses() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if p.GetShipping() != nil {
+		e.FieldStart("shipping")
+		p.GetShipping().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes Customer from JSON using jx.Decoder
+func (p *Customer) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		case "addresses":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &PostalAddress{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Addresses = append(p.Addresses, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "shipping":
+			p.Shipping = &ShippingProfile{}
+			if err := p.Shipping.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,1340 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/repeated_embed.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	math "math"
+	slices "slices"
+	sync "sync"
+)
+
+type CustomerPlain struct {
+	Id                string                               `json:"id"`
+	Name              string                               `json:"name"`
+	Addresses         []CustomerAddressesItemPlain         `json:"addresses"`         // origin: embed, empath: addresses
+	ShippingAddresses []CustomerShippingAddressesItemPlain `json:"shippingAddresses"` // origin: embed, empath: shipping.addresses
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *Customer) IntoPlain() *CustomerPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &CustomerPlain{}
+
+	p.Id = pb.Id
+	p.Name = pb.Name
+	// Addresses from addresses
+	if pb.GetAddresses() != nil {
+		p.Addresses = make([]CustomerAddressesItemPlain, len(pb.GetAddresses()))
+		for i, _elem := range pb.GetAddresses() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.Addresses[i], _elem
+			p.Street = pb.Street
+			p.City = pb.City
+			// PointLat from point.lat
+			if pb.GetPoint() != nil {
+				p.PointLat = pb.GetPoint().GetLat()
+			}
+			// PointLng from point.lng
+			if pb.GetPoint() != nil {
+				p.PointLng = pb.GetPoint().GetLng()
+			}
+			if len(pb.Tags) > 0 {
+				p.Tags = pb.Tags
+			} else {
+				p.Tags = []string{}
+			}
+			p.Kind = pb.Kind.String()
+		}
+	}
+	// ShippingAddresses from shipping.addresses
+	if pb.GetShipping() != nil && pb.GetShipping().GetAddresses() != nil {
+		p.ShippingAddresses = make([]CustomerShippingAddressesItemPlain, len(pb.GetShipping().GetAddresses()))
+		for i, _elem := range pb.GetShipping().GetAddresses() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.ShippingAddresses[i], _elem
+			p.Street = pb.Street
+			p.City = pb.City
+			// PointLat from point.lat
+			if pb.GetPoint() != nil {
+				p.PointLat = pb.GetPoint().GetLat()
+			}
+			// PointLng from point.lng
+			if pb.GetPoint() != nil {
+				p.PointLng = pb.GetPoint().GetLng()
+			}
+			if len(pb.Tags) > 0 {
+				p.Tags = pb.Tags
+			} else {
+				p.Tags = []string{}
+			}
+			p.Kind = pb.Kind.String()
+		}
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails or an enum name is unknown, use IntoPbE to handle the error.
+func (p *CustomerPlain) IntoPb() *Customer {
+	pb, err := p.IntoPbE()
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError wrapping *enumjx.UnknownValueError for unknown enum names.
+func (p *CustomerPlain) IntoPbE() (*Customer, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &Customer{}
+	var _err error
+
+	pb.Id = p.Id
+	pb.Name = p.Name
+	// Addresses -> addresses
+	if len(p.Addresses) > 0 {
+		_items := make([]*PostalAddress, len(p.Addresses))
+		for i := range p.Addresses {
+			_items[i] = &PostalAddress{}
+			p, pb := &p.Addresses[i], _items[i]
+			pb.Street = p.Street
+			pb.City = p.City
+			// PointLat -> point.lat
+			if pb.Point == nil {
+				pb.Point = &GeoPoint{}
+			}
+			pb.Point.Lat = p.PointLat
+			// PointLng -> point.lng
+			if pb.Point == nil {
+				pb.Point = &GeoPoint{}
+			}
+			pb.Point.Lng = p.PointLng
+			pb.Tags = p.Tags
+			pb.Kind = enumjx.TryName[AddressKind](p.Kind, "full.AddressKind", AddressKind_value, "kind", &_err)
+		}
+		pb.Addresses = _items
+	}
+	// ShippingAddresses -> shipping.addresses
+	if len(p.ShippingAddresses) > 0 {
+		_items := make([]*PostalAddress, len(p.ShippingAddresses))
+		for i := range p.ShippingAddresses {
+			_items[i] = &PostalAddress{}
+			p, pb := &p.ShippingAddresses[i], _items[i]
+			pb.Street = p.Street
+			pb.City = p.City
+			// PointLat -> point.lat
+			if pb.Point == nil {
+				pb.Point = &GeoPoint{}
+			}
+			pb.Point.Lat = p.PointLat
+			// PointLng -> point.lng
+			if pb.Point == nil {
+				pb.Point = &GeoPoint{}
+			}
+			pb.Point.Lng = p.PointLng
+			pb.Tags = p.Tags
+			pb.Kind = enumjx.TryName[AddressKind](p.Kind, "full.AddressKind", AddressKind_value, "kind", &_err)
+		}
+		if pb.Shipping == nil {
+			pb.Shipping = &ShippingProfile{}
+		}
+		pb.Shipping.Addresses = _items
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// customerPlainMergePaths are the fields of Customer covered by CustomerPlain
+var customerPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3, 1},
+	{3, 2},
+	{3, 3, 1},
+	{3, 3, 2},
+	{3, 4},
+	{3, 5},
+	{4, 1, 1},
+	{4, 1, 2},
+	{4, 1, 3, 1},
+	{4, 1, 3, 2},
+	{4, 1, 4},
+	{4, 1, 5},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *CustomerPlain) IntoPbMerge(dst *Customer) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, customerPlainMergePaths...)
+	return nil
+}
+
+// customerPlainFieldPaths link the JSON names of CustomerPlain to field-mask paths of Customer
+var customerPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "name", Path: "name"},
+	{Name: "addresses", Path: "addresses"},
+	{Name: "shippingAddresses", Path: "shipping.addresses"},
+}
+
+// CustomerPlainFieldMask builds a field mask of Customer from JSON names of CustomerPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func CustomerPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(customerPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// CustomerPlainFieldNames translates a field mask of Customer into JSON names of CustomerPlain.
+// A path to an embedded message yields all fields flattened from it.
+func CustomerPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(customerPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Customer, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *CustomerPlain) ApplyToPb(pb *Customer, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), customerPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Customer) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*CustomerPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), customerPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Customer{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *Customer) IntoPlainReuse(p *CustomerPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.Id = pb.Id
+	p.Name = pb.Name
+	// Addresses from addresses
+	if pb.GetAddresses() != nil {
+		p.Addresses = make([]CustomerAddressesItemPlain, len(pb.GetAddresses()))
+		for i, _elem := range pb.GetAddresses() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.Addresses[i], _elem
+			p.Street = pb.Street
+			p.City = pb.City
+			// PointLat from point.lat
+			if pb.GetPoint() != nil {
+				p.PointLat = pb.GetPoint().GetLat()
+			}
+			// PointLng from point.lng
+			if pb.GetPoint() != nil {
+				p.PointLng = pb.GetPoint().GetLng()
+			}
+			if len(pb.Tags) > 0 {
+				p.Tags = pb.Tags
+			} else {
+				p.Tags = []string{}
+			}
+			p.Kind = pb.Kind.String()
+		}
+	}
+	// ShippingAddresses from shipping.addresses
+	if pb.GetShipping() != nil && pb.GetShipping().GetAddresses() != nil {
+		p.ShippingAddresses = make([]CustomerShippingAddressesItemPlain, len(pb.GetShipping().GetAddresses()))
+		for i, _elem := range pb.GetShipping().GetAddresses() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.ShippingAddresses[i], _elem
+			p.Street = pb.Street
+			p.City = pb.City
+			// PointLat from point.lat
+			if pb.GetPoint() != nil {
+				p.PointLat = pb.GetPoint().GetLat()
+			}
+			// PointLng from point.lng
+			if pb.GetPoint() != nil {
+				p.PointLng = pb.GetPoint().GetLng()
+			}
+			if len(pb.Tags) > 0 {
+				p.Tags = pb.Tags
+			} else {
+				p.Tags = []string{}
+			}
+			p.Kind = pb.Kind.String()
+		}
+	}
+}
+
+// MarshalJX encodes CustomerPlain to JSON using jx.Encoder
+func (p *CustomerPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	if p.Name != "" {
+		e.FieldStart("name")
+		e.Str(p.Name)
+	}
+	if len(p.Addresses) > 0 {
+		e.FieldStart("addresses")
+		e.ArrStart()
+		for _, v := range p.Addresses {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if len(p.ShippingAddresses) > 0 {
+		e.FieldStart("shippingAddresses")
+		e.ArrStart()
+		for _, v := range p.ShippingAddresses {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *CustomerPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes CustomerPlain from JSON using jx.Decoder
+func (p *CustomerPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		case "addresses":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v CustomerAddressesItemPlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Addresses = append(p.Addresses, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "shippingAddresses":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v CustomerShippingAddressesItemPlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.ShippingAddresses = append(p.ShippingAddresses, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *CustomerPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes CustomerPlain in the protobuf wire format of Customer
+func (p *CustomerPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends CustomerPlain encoded in the protobuf wire format of Customer to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *CustomerPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Name; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	for i := range p.Addresses {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Addresses[i].AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	// shipping (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		_start := len(b)
+		for i := range p.ShippingAddresses {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			_start := len(b)
+			if b, err = p.ShippingAddresses[i].AppendProto(b); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes CustomerPlain from the protobuf wire format of Customer.
+// Unknown fields are skipped.
+func (p *CustomerPlain) UnmarshalProto(b []byte) error {
+	*p = CustomerPlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Name = v
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e CustomerAddressesItemPlain
+			if err := e.UnmarshalProto(v); err != nil {
+				return err
+			}
+			p.Addresses = append(p.Addresses, e)
+		case num == 4 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					var e CustomerShippingAddressesItemPlain
+					if err := e.UnmarshalProto(v); err != nil {
+						return err
+					}
+					p.ShippingAddresses = append(p.ShippingAddresses, e)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// customerPlainReflect describes CustomerPlain as message full.plain.CustomerPlain
+var customerPlainReflect = plainreflect.NewMessageInfo(file_test_full_repeated_embed_proto_plain, "CustomerPlain",
+	plainreflect.Scalar(func(p *CustomerPlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *CustomerPlain) *string { return &p.Name }),
+	plainreflect.ValueList(func(p *CustomerPlain) *[]CustomerAddressesItemPlain { return &p.Addresses }),
+	plainreflect.ValueList(func(p *CustomerPlain) *[]CustomerShippingAddressesItemPlain { return &p.ShippingAddresses }),
+)
+
+// ProtoReflect returns the reflective view of CustomerPlain backed by the struct
+func (p *CustomerPlain) ProtoReflect() protoreflect.Message {
+	return customerPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of CustomerPlain
+func (p *CustomerPlain) Clone() *CustomerPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &CustomerPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of CustomerPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetCustomerPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *CustomerPlain) CopyTo(dst *CustomerPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Id = p.Id
+	dst.Name = p.Name
+	if cap(dst.Addresses) < len(p.Addresses) {
+		dst.Addresses = make([]CustomerAddressesItemPlain, len(p.Addresses))
+	} else {
+		dst.Addresses = dst.Addresses[:len(p.Addresses)]
+	}
+	for i := range p.Addresses {
+		p.Addresses[i].CopyTo(&dst.Addresses[i])
+	}
+	if cap(dst.ShippingAddresses) < len(p.ShippingAddresses) {
+		dst.ShippingAddresses = make([]CustomerShippingAddressesItemPlain, len(p.ShippingAddresses))
+	} else {
+		dst.ShippingAddresses = dst.ShippingAddresses[:len(p.ShippingAddresses)]
+	}
+	for i := range p.ShippingAddresses {
+		p.ShippingAddresses[i].CopyTo(&dst.ShippingAddresses[i])
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *CustomerPlain) Equal(other *CustomerPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if len(p.Addresses) != len(other.Addresses) {
+		return false
+	}
+	for i := range p.Addresses {
+		if !p.Addresses[i].Equal(&other.Addresses[i]) {
+			return false
+		}
+	}
+	if len(p.ShippingAddresses) != len(other.ShippingAddresses) {
+		return false
+	}
+	for i := range p.ShippingAddresses {
+		if !p.ShippingAddresses[i].Equal(&other.ShippingAddresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Columns returns the column names of CustomerPlain rows, in the order of ScanRow and Values
+func (p *CustomerPlain) Columns() []string {
+	return []string{
+		"id",
+		"name",
+		"addresses",
+		"shipping_addresses",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into CustomerPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *CustomerPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Name,
+		plainsql.JSON(&p.Addresses),
+		plainsql.JSON(&p.ShippingAddresses),
+	)
+}
+
+// Values returns the column values of CustomerPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *CustomerPlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Name,
+		plainsql.JSON(&p.Addresses),
+		plainsql.JSON(&p.ShippingAddresses),
+	}
+}
+
+// customerPlainPool is a sync.Pool for CustomerPlain objects
+var customerPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &CustomerPlain{}
+	},
+}
+
+// GetCustomerPlain returns a CustomerPlain from the pool
+func GetCustomerPlain() *CustomerPlain {
+	return customerPlainPool.Get().(*CustomerPlain)
+}
+
+// PutCustomerPlain returns a CustomerPlain to the pool after resetting it
+func PutCustomerPlain(p *CustomerPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	customerPlainPool.Put(p)
+}
+
+// Reset clears all fields in CustomerPlain for reuse
+func (p *CustomerPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Id = ""
+	p.Name = ""
+	p.Addresses = p.Addresses[:0]
+	p.ShippingAddresses = p.ShippingAddresses[:0]
+}
+
+// CustomerAddressesItemPlain holds flattened fields of full.PostalAddress for repeated embed addresses
+type CustomerAddressesItemPlain struct {
+	Street   string   `json:"street"`
+	City     string   `json:"city"`
+	PointLat float64  `json:"pointLat"` // origin: embed, empath: point.lat
+	PointLng float64  `json:"pointLng"` // origin: embed, empath: point.lng
+	Tags     []string `json:"tags"`
+	Kind     string   `json:"kind"`
+}
+
+// MarshalJX encodes CustomerAddressesItemPlain to JSON using jx.Encoder
+func (p *CustomerAddressesItemPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Street != "" {
+		e.FieldStart("street")
+		e.Str(p.Street)
+	}
+	if p.City != "" {
+		e.FieldStart("city")
+		e.Str(p.City)
+	}
+	if p.PointLat != 0 {
+		e.FieldStart("pointLat")
+		e.Float64(p.PointLat)
+	}
+	if p.PointLng != 0 {
+		e.FieldStart("pointLng")
+		e.Float64(p.PointLng)
+	}
+	if len(p.Tags) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.Tags {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if p.Kind != "" {
+		e.FieldStart("kind")
+		e.Str(p.Kind)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *CustomerAddressesItemPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes CustomerAddressesItemPlain from JSON using jx.Decoder
+func (p *CustomerAddressesItemPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "street":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Street = v
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		case "pointLat":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.PointLat = v
+		case "pointLng":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.PointLng = v
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "kind":
+			v, err := enumjx.DecodeName(d, "full.AddressKind", AddressKind_value, AddressKind_name)
+			if err != nil {
+				return err
+			}
+			p.Kind = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *CustomerAddressesItemPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes CustomerAddressesItemPlain in the protobuf wire format of PostalAddress
+func (p *CustomerAddressesItemPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends CustomerAddressesItemPlain encoded in the protobuf wire format of PostalAddress to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *CustomerAddressesItemPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Street; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.City; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	// point (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if v := p.PointLat; v != 0 || math.Signbit(float64(v)) {
+			b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+			b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+		}
+		if v := p.PointLng; v != 0 || math.Signbit(float64(v)) {
+			b = protowire.AppendTag(b, 2, protowire.Fixed64Type)
+			b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	for _, v := range p.Tags {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := enumjx.TryName[AddressKind](p.Kind, "full.AddressKind", AddressKind_value, "kind", &_err); v != 0 {
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes CustomerAddressesItemPlain from the protobuf wire format of PostalAddress.
+// Unknown fields are skipped.
+func (p *CustomerAddressesItemPlain) UnmarshalProto(b []byte) error {
+	*p = CustomerAddressesItemPlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Street = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.City = v
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					p.PointLat = math.Float64frombits(v)
+				case num == 2 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					p.PointLng = math.Float64frombits(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 4 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Tags = append(p.Tags, v)
+		case num == 5 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Kind = AddressKind(v).String()
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// customerAddressesItemPlainReflect describes CustomerAddressesItemPlain as message full.plain.CustomerAddressesItemPlain
+var customerAddressesItemPlainReflect = plainreflect.NewMessageInfo(file_test_full_repeated_embed_proto_plain, "CustomerAddressesItemPlain",
+	plainreflect.Scalar(func(p *CustomerAddressesItemPlain) *string { return &p.Street }),
+	plainreflect.Scalar(func(p *CustomerAddressesItemPlain) *string { return &p.City }),
+	plainreflect.Scalar(func(p *CustomerAddressesItemPlain) *float64 { return &p.PointLat }),
+	plainreflect.Scalar(func(p *CustomerAddressesItemPlain) *float64 { return &p.PointLng }),
+	plainreflect.List(func(p *CustomerAddressesItemPlain) *[]string { return &p.Tags }),
+	plainreflect.Scalar(func(p *CustomerAddressesItemPlain) *string { return &p.Kind }),
+)
+
+// ProtoReflect returns the reflective view of CustomerAddressesItemPlain backed by the struct
+func (p *CustomerAddressesItemPlain) ProtoReflect() protoreflect.Message {
+	return customerAddressesItemPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of CustomerAddressesItemPlain
+func (p *CustomerAddressesItemPlain) Clone() *CustomerAddressesItemPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &CustomerAddressesItemPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of CustomerAddressesItemPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetCustomerAddressesItemPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *CustomerAddressesItemPlain) CopyTo(dst *CustomerAddressesItemPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Street = p.Street
+	dst.City = p.City
+	dst.PointLat = p.PointLat
+	dst.PointLng = p.PointLng
+	dst.Tags = append(dst.Tags[:0], p.Tags...)
+	dst.Kind = p.Kind
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *CustomerAddressesItemPlain) Equal(other *CustomerAddressesItemPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Street != other.Street {
+		return false
+	}
+	if p.City != other.City {
+		return false
+	}
+	if p.PointLat != other.PointLat {
+		return false
+	}
+	if p.PointLng != other.PointLng {
+		return false
+	}
+	if !slices.Equal(p.Tags, other.Tags) {
+		return false
+	}
+	if p.Kind != other.Kind {
+		return false
+	}
+	return true
+}
+
+// Reset clears all fields in CustomerAddressesItemPlain for reuse
+func (p *CustomerAddressesItemPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Street = ""
+	p.City = ""
+	p.PointLat = 0
+	p.PointLng = 0
+	p.Tags = p.Tags[:0]
+	p.Kind = ""
+}
+
+// CustomerShippingAddressesItemPlain holds flattened fields of full.PostalAddress for repeated embed shipping.addresses
+type CustomerShippingAddressesItemPlain struct {
+	Street   string   `json:"street"`
+	City     string   `json:"city"`
+	PointLat float64  `json:"pointLat"` // origin: embed, empath: point.lat
+	PointLng float64  `json:"pointLng"` // origin: embed, empath: point.lng
+	Tags     []string `json:"tags"`
+	Kind     string   `json:"kind"`
+}
+
+// MarshalJX encodes CustomerShippingAddressesItemPlain to JSON using jx.Encoder
+func (p *CustomerShippingAddressesItemPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Street != "" {
+		e.FieldStart("street")
+		e.Str(p.Street)
+	}
+	if p.City != "" {
+		e.FieldStart("city")
+		e.Str(p.City)
+	}
+	if p.PointLat != 0 {
+		e.FieldStart("pointLat")
+		e.Float64(p.PointLat)
+	}
+	if p.PointLng != 0 {
+		e.FieldStart("pointLng")
+		e.Float64(p.PointLng)
+	}
+	if len(p.Tags) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.Tags {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if p.Kind != "" {
+		e.FieldStart("kind")
+		e.Str(p.Kind)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *CustomerShippingAddressesItemPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes CustomerShippingAddressesItemPlain from JSON using jx.Decoder
+func (p *CustomerShippingAddressesItemPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "street":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Street = v
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		case "pointLat":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.PointLat = v
+		case "pointLng":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.PointLng = v
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "kind":
+			v, err := enumjx.DecodeName(d, "full.AddressKind", AddressKind_value, AddressKind_name)
+			if err != nil {
+				return err
+			}
+			p.Kind = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *CustomerShippingAddressesItemPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes CustomerShippingAddressesItemPlain in the protobuf wire format of PostalAddress
+func (p *CustomerShippingAddressesItemPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends CustomerShippingAddressesItemPlain encoded in the protobuf wire format of PostalAddress to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *CustomerShippingAddressesItemPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Street; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.City; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	// point (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if v := p.PointLat; v != 0 || math.Signbit(float64(v)) {
+			b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+			b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+		}
+		if v := p.PointLng; v != 0 || math.Signbit(float64(v)) {
+			b = protowire.AppendTag(b, 2, protowire.Fixed64Type)
+			b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	for _, v := range p.Tags {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := enumjx.TryName[AddressKind](p.Kind, "full.AddressKind", AddressKind_value, "kind", &_err); v != 0 {
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes CustomerShippingAddressesItemPlain from the protobuf wire format of PostalAddress.
+// Unknown fields are skipped.
+func (p *CustomerShippingAddressesItemPlain) UnmarshalProto(b []byte) error {
+	*p = CustomerShippingAddressesItemPlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Street = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.City = v
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					p.PointLat = math.Float64frombits(v)
+				case num == 2 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					p.PointLng = math.Float64frombits(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 4 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Tags = append(p.Tags, v)
+		case num == 5 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Kind = AddressKind(v).String()
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// customerShippingAddressesItemPlainReflect describes CustomerShippingAddressesItemPlain as message full.plain.CustomerShippingAddressesItemPlain
+var customerShippingAddressesItemPlainReflect = plainreflect.NewMessageInfo(file_test_full_repeated_embed_proto_plain, "CustomerShippingAddressesItemPlain",
+	plainreflect.Scalar(func(p *CustomerShippingAddressesItemPlain) *string { return &p.Street }),
+	plainreflect.Scalar(func(p *CustomerShippingAddressesItemPlain) *string { return &p.City }),
+	plainreflect.Scalar(func(p *CustomerShippingAddressesItemPlain) *float64 { return &p.PointLat }),
+	plainreflect.Scalar(func(p *CustomerShippingAddressesItemPlain) *float64 { return &p.PointLng }),
+	plainreflect.List(func(p *CustomerShippingAddressesItemPlain) *[]string { return &p.Tags }),
+	plainreflect.Scalar(func(p *CustomerShippingAddressesItemPlain) *string { return &p.Kind }),
+)
+
+// ProtoReflect returns the reflective view of CustomerShippingAddressesItemPlain backed by the struct
+func (p *CustomerShippingAddressesItemPlain) ProtoReflect() protoreflect.Message {
+	return customerShippingAddressesItemPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of CustomerShippingAddressesItemPlain
+func (p *CustomerShippingAddressesItemPlain) Clone() *CustomerShippingAddressesItemPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &CustomerShippingAddressesItemPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of CustomerShippingAddressesItemPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetCustomerShippingAddressesItemPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *CustomerShippingAddressesItemPlain) CopyTo(dst *CustomerShippingAddressesItemPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Street = p.Street
+	dst.City = p.City
+	dst.PointLat = p.PointLat
+	dst.PointLng = p.PointLng
+	dst.Tags = append(dst.Tags[:0], p.Tags...)
+	dst.Kind = p.Kind
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *CustomerShippingAddressesItemPlain) Equal(other *CustomerShippingAddressesItemPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Street != other.Street {
+		return false
+	}
+	if p.City != other.City {
+		return false
+	}
+	if p.PointLat != other.PointLat {
+		return false
+	}
+	if p.PointLng != other.PointLng {
+		return false
+	}
+	if !slices.Equal(p.Tags, other.Tags) {
+		return false
+	}
+	if p.Kind != other.Kind {
+		return false
+	}
+	return true
+}
+
+// Reset clears all fields in CustomerShippingAddressesItemPlain for reuse
+func (p *CustomerShippingAddressesItemPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Street = ""
+	p.City = ""
+	p.PointLat = 0
+	p.PointLng = 0
+	p.Tags = p.Tags[:0]
+	p.Kind = ""
+}
+
+// file_test_full_repeated_embed_proto_plain_rawDesc is the serialized descriptor of test/full/repeated_embed_plain.proto,
+// describing the Plain messages of test/full/repeated_embed.proto in package full.plain
+const file_test_full_repeated_embed_proto_plain_rawDesc = "" +
+	"\n$test/full/repeated_embed_plain.proto\x12\nfull.plain\"\xd8\x01\n\rCustomerP" +
+	"lain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12D\n\taddresses\x18\x03 \x03(\v2&.fu" +
+	"ll.plain.CustomerAddressesItemPlainR\taddresses\x12]\n\x12shipping_addre" +
+	"sses\x18\x04 \x03(\v2..full.plain.CustomerShippingAddressesItemPlainR\x11ship" +
+	"pingAddresses\"\xaa\x01\n\x1aCustomerAddressesItemPlain\x12\x16\n\x06street\x18\x01 \x01(\tR\x06st" +
+	"reet\x12\x12\n\x04city\x18\x02 \x01(\tR\x04city\x12\x1b\n\tpoint_lat\x18\x03 \x01(\x01R\bpointLat\x12\x1b\n\tpoint_l" +
+	"ng\x18\x04 \x01(\x01R\bpointLng\x12\x12\n\x04tags\x18\x05 \x03(\tR\x04tags\x12\x12\n\x04kind\x18\x06 \x01(\tR\x04kind\"\xb2\x01\n\"C" +
+	"ustomerShippingAddressesItemPlain\x12\x16\n\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n\x04cit" +
+	"y\x18\x02 \x01(\tR\x04city\x12\x1b\n\tpoint_lat\x18\x03 \x01(\x01R\bpointLat\x12\x1b\n\tpoint_lng\x18\x04 \x01(\x01R\bp" +
+	"ointLng\x12\x12\n\x04tags\x18\x05 \x03(\tR\x04tags\x12\x12\n\x04kind\x18\x06 \x01(\tR\x04kindb\x06proto3"
+
+var file_test_full_repeated_embed_proto_plain = plainreflect.NewFile("test/full/repeated_embed_plain.proto", file_test_full_repeated_embed_proto_plain_rawDesc)
+
+func init() { file_test_full_repeated_embed_proto_plain_init() }
+
+func file_test_full_repeated_embed_proto_plain_init() {
+	file_test_full_repeated_embed_proto_plain.Init()
+}
@@ -0,0 +1,30 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/repeated_embed.proto
+
+export interface CustomerPlain {
+  id?: string;
+  name?: string;
+  addresses?: CustomerAddressesItemPlain[];
+  /** Primary addresses list, one row per element */
+  shippingAddresses?: CustomerShippingAddressesItemPlain[];
+}
+
+/** CustomerAddressesItemPlain holds flattened fields of full.PostalAddress for repeated embed addresses */
+export interface CustomerAddressesItemPlain {
+  street?: string;
+  city?: string;
+  pointLat?: number;
+  pointLng?: number;
+  tags?: string[];
+  kind?: "ADDRESS_KIND_UNSPECIFIED" | "ADDRESS_KIND_HOME" | "ADDRESS_KIND_WORK";
+}
+
+/** CustomerShippingAddressesItemPlain holds flattened fields of full.PostalAddress for repeated embed shipping.addresses */
+export interface CustomerShippingAddressesItemPlain {
+  street?: string;
+  city?: string;
+  pointLat?: number;
+  pointLng?: number;
+  tags?: string[];
+  kind?: "ADDRESS_KIND_UNSPECIFIED" | "ADDRESS_KIND_HOME" | "ADDRESS_KIND_WORK";
+}
@@ -0,0 +1,84 @@
+package full_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+)
+
+func newCustomer() *full.Customer {
+	return &full.Customer{
+		Id:   "cust-1",
+		Name: "Jane",
+		Addresses: []*full.PostalAddress{
+			{
+				Street: "Main st. 1",
+				City:   "Springfield",
+				Point:  &full.GeoPoint{Lat: 39.78, Lng: -89.65},
+				Tags:   []string{"billing"},
+				Kind:   full.AddressKind_ADDRESS_KIND_HOME,
+			},
+			{
+				Street: "Market st. 5",
+				City:   "Shelbyville",
+				Point:  &full.GeoPoint{},
+				Kind:   full.AddressKind_ADDRESS_KIND_WORK,
+			},
+		},
+		Shipping: &full.ShippingProfile{
+			Addresses: []*full.PostalAddress{
+				{Street: "Dock 7", City: "Capital City", Point: &full.GeoPoint{Lat: 1, Lng: 2}},
+			},
+		},
+	}
+}
+
+func TestRepeatedEmbed_Rows(t *testing.T) {
+	plain := newCustomer().IntoPlain()
+
+	require.Len(t, plain.Addresses, 2)
+	assert.Equal(t, "Main st. 1", plain.Addresses[0].Street)
+	assert.Equal(t, 39.78, plain.Addresses[0].PointLat)
+	assert.Equal(t, -89.65, plain.Addresses[0].PointLng)
+	assert.Equal(t, []string{"billing"}, plain.Addresses[0].Tags)
+	assert.Equal(t, "ADDRESS_KIND_HOME", plain.Addresses[0].Kind)
+	assert.Equal(t, "Shelbyville", plain.Addresses[1].City)
+
+	require.Len(t, plain.ShippingAddresses, 1)
+	assert.Equal(t, "Dock 7", plain.ShippingAddresses[0].Street)
+	assert.Equal(t, float64(2), plain.ShippingAddresses[0].PointLng)
+}
+
+func TestRoundtrip_RepeatedEmbed(t *testing.T) {
+	original := newCustomer()
+
+	restored := original.IntoPlain().IntoPb()
+	require.True(t, proto.Equal(original, restored), "Customer pb roundtrip failed")
+
+	jsonData, err := original.IntoPlain().MarshalJSON()
+	require.NoError(t, err)
+
+	plain2 := &full.CustomerPlain{}
+	require.NoError(t, plain2.UnmarshalJSON(jsonData))
+	require.True(t, proto.Equal(original, plain2.IntoPb()), "Customer JSON roundtrip failed")
+
+	t.Logf("Customer roundtrip: %d bytes JSON", len(jsonData))
+}
+
+func TestRepeatedEmbed_Reset(t *testing.T) {
+	plain := full.GetCustomerPlain()
+	newCustomer().IntoPlainReuse(plain)
+	require.Len(t, plain.Addresses, 2)
+
+	full.PutCustomerPlain(plain)
+	assert.Empty(t, plain.Addresses)
+	assert.Empty(t, plain.ShippingAddresses)
+
+	row := full.CustomerAddressesItemPlain{Street: "Main st. 1", Tags: []string{"billing"}}
+	row.Reset()
+	assert.Empty(t, row.Street)
+	assert.Empty(t, row.Tags)
+}
@@ -6,6 +6,7 @@ package full
 import (
 	fmt "fmt"
 	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
 	protojson "google.golang.org/protobuf/encoding/protojson"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -325,23 +326,27 @@ func (p *Metadata) UnmarshalJX(d *jx.Decoder) error {
 			if p.Labels == nil {
 				p.Labels = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Labels[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "tags":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Tags = append(p.Tags, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -452,14 +457,16 @@ func (p *Level4) UnmarshalJX(d *jx.Decoder) error {
 				return err
 			}
 		case "items":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v := &Level5{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.Items = append(p.Items, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -518,14 +525,16 @@ func (p *Level3) UnmarshalJX(d *jx.Decoder) error {
 			if p.Children == nil {
 				p.Children = make(map[string]*Level4)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v := &Level4{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.Children[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -580,14 +589,16 @@ func (p *Level2) UnmarshalJX(d *jx.Decoder) error {
 				return err
 			}
 		case "sections":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v := &Level3{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.Sections = append(p.Sections, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -1086,23 +1097,27 @@ func (p *TableContent) UnmarshalJX(d *jx.Decoder) error {
 	return d.Obj(func(d *jx.Decoder, key string) error {
 		switch key {
 		case "headers":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Headers = append(p.Headers, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "rows":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Rows = append(p.Rows, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -1247,13 +1262,13 @@ func (p *Document) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.Title = v
 		case "status":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
 			if err != nil {
 				return err
 			}
 			p.Status = Status(v)
 		case "priority":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Priority", Priority_value)
 			if err != nil {
 				return err
 			}
@@ -1289,49 +1304,57 @@ func (p *Document) UnmarshalJX(d *jx.Decoder) error {
 				return err
 			}
 		case "keywords":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Keywords = append(p.Keywords, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "attributes":
 			if p.Attributes == nil {
 				p.Attributes = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Attributes[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "locations":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v := &Address{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.Locations = append(p.Locations, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "structure":
 			p.Structure = &Level1{}
 			if err := p.Structure.UnmarshalJX(d); err != nil {
 				return err
 			}
 		case "children":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v := &Document{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.Children = append(p.Children, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "parent":
 			p.Parent = &Document{}
 			if err := p.Parent.UnmarshalJX(d); err != nil {
@@ -1465,14 +1488,16 @@ func (p *TreeNode) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.Type = v
 		case "children":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v := &TreeNode{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.Children = append(p.Children, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "parent":
 			p.Parent = &TreeNode{}
 			if err := p.Parent.UnmarshalJX(d); err != nil {
@@ -1606,14 +1631,16 @@ func (p *UserUpdatedEvent) UnmarshalJX(d *jx.Decoder) error {
 			if p.Changes == nil {
 				p.Changes = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Changes[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -1719,14 +1746,16 @@ func (p *OrderCreatedEvent) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.UserId = v
 		case "itemIds":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.ItemIds = append(p.ItemIds, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "totalAmount":
 			v, err := d.Float64()
 			if err != nil {
@@ -2428,115 +2457,137 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.OptionalBytes = v
 		case "stringList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.StringList = append(p.StringList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "intList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Int32()
 				if err != nil {
 					return err
 				}
 				p.IntList = append(p.IntList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "doubleList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Float64()
 				if err != nil {
 					return err
 				}
 				p.DoubleList = append(p.DoubleList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "bytesList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Base64()
 				if err != nil {
 					return err
 				}
 				p.BytesList = append(p.BytesList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "boolList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Bool()
 				if err != nil {
 					return err
 				}
 				p.BoolList = append(p.BoolList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "floatList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Float32()
 				if err != nil {
 					return err
 				}
 				p.FloatList = append(p.FloatList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "int64List":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Int64()
 				if err != nil {
 					return err
 				}
 				p.Int64List = append(p.Int64List, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "uint32List":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.UInt32()
 				if err != nil {
 					return err
 				}
 				p.Uint32List = append(p.Uint32List, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "uint64List":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.UInt64()
 				if err != nil {
 					return err
 				}
 				p.Uint64List = append(p.Uint64List, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "stringMap":
 			if p.StringMap == nil {
 				p.StringMap = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.StringMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "intMap":
 			if p.IntMap == nil {
 				p.IntMap = make(map[string]int32)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Int32()
 				if err != nil {
 					return err
 				}
 				p.IntMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "intKeyMap":
 			if p.IntKeyMap == nil {
 				p.IntKeyMap = make(map[int32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -2547,24 +2598,28 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.IntKeyMap[int32(keyInt)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "nestedMap":
 			if p.NestedMap == nil {
 				p.NestedMap = make(map[string]*Config)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v := &Config{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.NestedMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "int64KeyMap":
 			if p.Int64KeyMap == nil {
 				p.Int64KeyMap = make(map[int64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -2575,12 +2630,14 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Int64KeyMap[keyInt] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "uint32KeyMap":
 			if p.Uint32KeyMap == nil {
 				p.Uint32KeyMap = make(map[uint32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyUint, err := strconv.ParseUint(key, 10, 32)
 				if err != nil {
 					return err
@@ -2591,12 +2648,14 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Uint32KeyMap[uint32(keyUint)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "uint64KeyMap":
 			if p.Uint64KeyMap == nil {
 				p.Uint64KeyMap = make(map[uint64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyUint, err := strconv.ParseUint(key, 10, 64)
 				if err != nil {
 					return err
@@ -2607,12 +2666,14 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Uint64KeyMap[keyUint] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "sint32KeyMap":
 			if p.Sint32KeyMap == nil {
 				p.Sint32KeyMap = make(map[int32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -2623,12 +2684,14 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Sint32KeyMap[int32(keyInt)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "sint64KeyMap":
 			if p.Sint64KeyMap == nil {
 				p.Sint64KeyMap = make(map[int64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -2639,12 +2702,14 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Sint64KeyMap[keyInt] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "fixed32KeyMap":
 			if p.Fixed32KeyMap == nil {
 				p.Fixed32KeyMap = make(map[uint32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyUint, err := strconv.ParseUint(key, 10, 32)
 				if err != nil {
 					return err
@@ -2655,12 +2720,14 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Fixed32KeyMap[uint32(keyUint)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "fixed64KeyMap":
 			if p.Fixed64KeyMap == nil {
 				p.Fixed64KeyMap = make(map[uint64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyUint, err := strconv.ParseUint(key, 10, 64)
 				if err != nil {
 					return err
@@ -2671,12 +2738,14 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Fixed64KeyMap[keyUint] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "sfixed32KeyMap":
 			if p.Sfixed32KeyMap == nil {
 				p.Sfixed32KeyMap = make(map[int32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -2687,12 +2756,14 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Sfixed32KeyMap[int32(keyInt)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "sfixed64KeyMap":
 			if p.Sfixed64KeyMap == nil {
 				p.Sfixed64KeyMap = make(map[int64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -2703,12 +2774,14 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Sfixed64KeyMap[keyInt] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "boolKeyMap":
 			if p.BoolKeyMap == nil {
 				p.BoolKeyMap = make(map[bool]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyBool, err := strconv.ParseBool(key)
 				if err != nil {
 					return err
@@ -2719,144 +2792,166 @@ func (p *Config) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.BoolKeyMap[keyBool] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "doubleMap":
 			if p.DoubleMap == nil {
 				p.DoubleMap = make(map[string]float64)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Float64()
 				if err != nil {
 					return err
 				}
 				p.DoubleMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "bytesMap":
 			if p.BytesMap == nil {
 				p.BytesMap = make(map[string][]byte)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Base64()
 				if err != nil {
 					return err
 				}
 				p.BytesMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "boolMap":
 			if p.BoolMap == nil {
 				p.BoolMap = make(map[string]bool)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Bool()
 				if err != nil {
 					return err
 				}
 				p.BoolMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "floatMap":
 			if p.FloatMap == nil {
 				p.FloatMap = make(map[string]float32)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Float32()
 				if err != nil {
 					return err
 				}
 				p.FloatMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "status":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
 			if err != nil {
 				return err
 			}
 			p.Status = Status(v)
 		case "statusList":
-			return d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Int32()
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.Status", Status_value)
 				if err != nil {
 					return err
 				}
 				p.StatusList = append(p.StatusList, Status(v))
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "statusMap":
 			if p.StatusMap == nil {
 				p.StatusMap = make(map[string]Status)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Int32()
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := enumjx.Decode(d, "full.Status", Status_value)
 				if err != nil {
 					return err
 				}
 				p.StatusMap[key] = Status(v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "optionalStatus":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
 			if err != nil {
 				return err
 			}
 			_ev := Status(v)
 			p.OptionalStatus = &_ev
 		case "nestedEnum":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Config.NestedEnum", Config_NestedEnum_value)
 			if err != nil {
 				return err
 			}
 			p.NestedEnum = Config_NestedEnum(v)
 		case "nestedEnumList":
-			return d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Int32()
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.Config.NestedEnum", Config_NestedEnum_value)
 				if err != nil {
 					return err
 				}
 				p.NestedEnumList = append(p.NestedEnumList, Config_NestedEnum(v))
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "nestedConfig":
 			p.NestedConfig = &Config_NestedConfig{}
 			if err := p.NestedConfig.UnmarshalJX(d); err != nil {
 				return err
 			}
 		case "nestedConfigList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v := &Config_NestedConfig{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.NestedConfigList = append(p.NestedConfigList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "nestedConfigMap":
 			if p.NestedConfigMap == nil {
 				p.NestedConfigMap = make(map[string]*Config_NestedConfig)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v := &Config_NestedConfig{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.NestedConfigMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "parent":
 			p.Parent = &Config{}
 			if err := p.Parent.UnmarshalJX(d); err != nil {
 				return err
 			}
 		case "children":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v := &Config{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.Children = append(p.Children, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -3326,7 +3421,7 @@ func (p *WellKnownTypes) UnmarshalJX(d *jx.Decoder) error {
 				return err
 			}
 		case "payloads":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				raw, err := d.Raw()
 				if err != nil {
 					return err
@@ -3337,14 +3432,16 @@ func (p *WellKnownTypes) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Payloads = append(p.Payloads, _v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "empty":
 			if err := d.Skip(); err != nil {
 				return err
 			}
 			p.Empty = &emptypb.Empty{}
 		case "timestamps":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				raw, err := d.Raw()
 				if err != nil {
 					return err
@@ -3355,9 +3452,11 @@ func (p *WellKnownTypes) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Timestamps = append(p.Timestamps, _v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "durations":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				raw, err := d.Raw()
 				if err != nil {
 					return err
@@ -3368,9 +3467,11 @@ func (p *WellKnownTypes) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Durations = append(p.Durations, _v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strings":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				raw, err := d.Raw()
 				if err != nil {
 					return err
@@ -3381,7 +3482,9 @@ func (p *WellKnownTypes) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Strings = append(p.Strings, _v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -3646,115 +3749,133 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 			if p.StrStr == nil {
 				p.StrStr = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.StrStr[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strInt32":
 			if p.StrInt32 == nil {
 				p.StrInt32 = make(map[string]int32)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Int32()
 				if err != nil {
 					return err
 				}
 				p.StrInt32[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strInt64":
 			if p.StrInt64 == nil {
 				p.StrInt64 = make(map[string]int64)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Int64()
 				if err != nil {
 					return err
 				}
 				p.StrInt64[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strUint32":
 			if p.StrUint32 == nil {
 				p.StrUint32 = make(map[string]uint32)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.UInt32()
 				if err != nil {
 					return err
 				}
 				p.StrUint32[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strUint64":
 			if p.StrUint64 == nil {
 				p.StrUint64 = make(map[string]uint64)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.UInt64()
 				if err != nil {
 					return err
 				}
 				p.StrUint64[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strFloat":
 			if p.StrFloat == nil {
 				p.StrFloat = make(map[string]float32)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Float32()
 				if err != nil {
 					return err
 				}
 				p.StrFloat[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strDouble":
 			if p.StrDouble == nil {
 				p.StrDouble = make(map[string]float64)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Float64()
 				if err != nil {
 					return err
 				}
 				p.StrDouble[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strBool":
 			if p.StrBool == nil {
 				p.StrBool = make(map[string]bool)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Bool()
 				if err != nil {
 					return err
 				}
 				p.StrBool[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strBytes":
 			if p.StrBytes == nil {
 				p.StrBytes = make(map[string][]byte)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Base64()
 				if err != nil {
 					return err
 				}
 				p.StrBytes[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "int32Str":
 			if p.Int32Str == nil {
 				p.Int32Str = make(map[int32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -3765,12 +3886,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Int32Str[int32(keyInt)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "int64Str":
 			if p.Int64Str == nil {
 				p.Int64Str = make(map[int64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -3781,12 +3904,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Int64Str[keyInt] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "uint32Str":
 			if p.Uint32Str == nil {
 				p.Uint32Str = make(map[uint32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyUint, err := strconv.ParseUint(key, 10, 32)
 				if err != nil {
 					return err
@@ -3797,12 +3922,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Uint32Str[uint32(keyUint)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "uint64Str":
 			if p.Uint64Str == nil {
 				p.Uint64Str = make(map[uint64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyUint, err := strconv.ParseUint(key, 10, 64)
 				if err != nil {
 					return err
@@ -3813,12 +3940,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Uint64Str[keyUint] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "sint32Str":
 			if p.Sint32Str == nil {
 				p.Sint32Str = make(map[int32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -3829,12 +3958,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Sint32Str[int32(keyInt)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "sint64Str":
 			if p.Sint64Str == nil {
 				p.Sint64Str = make(map[int64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -3845,12 +3976,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Sint64Str[keyInt] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "fixed32Str":
 			if p.Fixed32Str == nil {
 				p.Fixed32Str = make(map[uint32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyUint, err := strconv.ParseUint(key, 10, 32)
 				if err != nil {
 					return err
@@ -3861,12 +3994,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Fixed32Str[uint32(keyUint)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "fixed64Str":
 			if p.Fixed64Str == nil {
 				p.Fixed64Str = make(map[uint64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyUint, err := strconv.ParseUint(key, 10, 64)
 				if err != nil {
 					return err
@@ -3877,12 +4012,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Fixed64Str[keyUint] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "sfixed32Str":
 			if p.Sfixed32Str == nil {
 				p.Sfixed32Str = make(map[int32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -3893,12 +4030,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Sfixed32Str[int32(keyInt)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "sfixed64Str":
 			if p.Sfixed64Str == nil {
 				p.Sfixed64Str = make(map[int64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -3909,12 +4048,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Sfixed64Str[keyInt] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "boolStr":
 			if p.BoolStr == nil {
 				p.BoolStr = make(map[bool]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyBool, err := strconv.ParseBool(key)
 				if err != nil {
 					return err
@@ -3925,24 +4066,28 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.BoolStr[keyBool] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strMessage":
 			if p.StrMessage == nil {
 				p.StrMessage = make(map[string]*Address)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v := &Address{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.StrMessage[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "int32Message":
 			if p.Int32Message == nil {
 				p.Int32Message = make(map[int32]*Address)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -3953,12 +4098,14 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Int32Message[int32(keyInt)] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "int64Message":
 			if p.Int64Message == nil {
 				p.Int64Message = make(map[int64]*Metadata)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -3969,47 +4116,55 @@ func (p *MapShowcase) UnmarshalJX(d *jx.Decoder) error {
 				}
 				p.Int64Message[keyInt] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "strEnum":
 			if p.StrEnum == nil {
 				p.StrEnum = make(map[string]Status)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Int32()
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := enumjx.Decode(d, "full.Status", Status_value)
 				if err != nil {
 					return err
 				}
 				p.StrEnum[key] = Status(v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "int32Enum":
 			if p.Int32Enum == nil {
 				p.Int32Enum = make(map[int32]Priority)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				keyInt, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
 				}
-				v, err := d.Int32()
+				v, err := enumjx.Decode(d, "full.Priority", Priority_value)
 				if err != nil {
 					return err
 				}
 				p.Int32Enum[int32(keyInt)] = Priority(v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "nested":
 			if p.Nested == nil {
 				p.Nested = make(map[string]*Config)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v := &Config{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.Nested[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -4207,14 +4362,14 @@ func (p *OptionalShowcase) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.OptBytes = v
 		case "optStatus":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
 			if err != nil {
 				return err
 			}
 			_ev := Status(v)
 			p.OptStatus = &_ev
 		case "optPriority":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Priority", Priority_value)
 			if err != nil {
 				return err
 			}
@@ -4394,11 +4549,23 @@ func (p *OneofShowcase) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.MessageChoice = &OneofShowcase_Metadata{Metadata: v}
 		case "status":
-			return d.Skip()
+			ev, err := enumjx.Decode(d, "full.Status", Status_value)
+			if err != nil {
+				return err
+			}
+			p.EnumChoice = &OneofShowcase_Status{Status: Status(ev)}
 		case "priority":
-			return d.Skip()
+			ev, err := enumjx.Decode(d, "full.Priority", Priority_value)
+			if err != nil {
+				return err
+			}
+			p.EnumChoice = &OneofShowcase_Priority{Priority: Priority(ev)}
 		case "error":
-			return d.Skip()
+			ev, err := enumjx.Decode(d, "full.ErrorCode", ErrorCode_value)
+			if err != nil {
+				return err
+			}
+			p.EnumChoice = &OneofShowcase_Error{Error: ErrorCode(ev)}
 		case "text":
 			v := &TextContent{}
 			if err := v.UnmarshalJX(d); err != nil {
@@ -4566,14 +4733,16 @@ func (p *ProcessStarted) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.Command = v
 		case "args":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Args = append(p.Args, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "startTime":
 			v, err := d.Int64()
 			if err != nil {
@@ -4815,14 +4984,16 @@ func (p *PlatformEvent) UnmarshalJX(d *jx.Decoder) error {
 			if p.Labels == nil {
 				p.Labels = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Labels[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "heartbeat":
 			v := &Heartbeat{}
 			if err := v.UnmarshalJX(d); err != nil {
@@ -5041,41 +5212,47 @@ func (p *DefaultsShowcase) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.EmptyBytes = v
 		case "zeroEnum":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
 			if err != nil {
 				return err
 			}
 			p.ZeroEnum = Status(v)
 		case "emptyList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.EmptyList = append(p.EmptyList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "emptyIntList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Int32()
 				if err != nil {
 					return err
 				}
 				p.EmptyIntList = append(p.EmptyIntList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "emptyMap":
 			if p.EmptyMap == nil {
 				p.EmptyMap = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.EmptyMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "nilMessage":
 			p.NilMessage = &Address{}
 			if err := p.NilMessage.UnmarshalJX(d); err != nil {
@@ -5162,41 +5339,47 @@ func (p *ComplexNested) UnmarshalJX(d *jx.Decoder) error {
 				return err
 			}
 		case "innerList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v := &ComplexNested_Inner{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.InnerList = append(p.InnerList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "innerMap":
 			if p.InnerMap == nil {
 				p.InnerMap = make(map[string]*ComplexNested_Inner)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v := &ComplexNested_Inner{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.InnerMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "innerEnum":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.ComplexNested.InnerEnum", ComplexNested_InnerEnum_value)
 			if err != nil {
 				return err
 			}
 			p.InnerEnum = ComplexNested_InnerEnum(v)
 		case "innerEnumList":
-			return d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Int32()
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.ComplexNested.InnerEnum", ComplexNested_InnerEnum_value)
 				if err != nil {
 					return err
 				}
 				p.InnerEnumList = append(p.InnerEnumList, ComplexNested_InnerEnum(v))
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "choiceInner":
 			v := &ComplexNested_Inner{}
 			if err := v.UnmarshalJX(d); err != nil {
@@ -5273,14 +5456,16 @@ func (p *ComplexNested_Inner) UnmarshalJX(d *jx.Decoder) error {
 				return err
 			}
 		case "deepList":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v := &ComplexNested_Inner_DeepInner{}
 				if err := v.UnmarshalJX(d); err != nil {
 					return err
 				}
 				p.DeepList = append(p.DeepList, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -5335,26 +5520,30 @@ func (p *ComplexNested_Inner_DeepInner) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.DeepValue = v
 		case "tags":
-			return d.Arr(func(d *jx.Decoder) error {
+			if err := d.Arr(func(d *jx.Decoder) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Tags = append(p.Tags, v)
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "scores":
 			if p.Scores == nil {
 				p.Scores = make(map[string]int32)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Int32()
 				if err != nil {
 					return err
 				}
 				p.Scores[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		default:
 			return d.Skip()
 		}
@@ -4,18 +4,31 @@
 package full
 
 import (
+	bytes "bytes"
 	json "encoding/json"
 	fmt "fmt"
 	jx "github.com/go-faster/jx"
 	cast "github.com/yaroher/protoc-gen-go-plain/cast"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	plainequal "github.com/yaroher/protoc-gen-go-plain/plainequal"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
 	protojson "google.golang.org/protobuf/encoding/protojson"
+	protowire "google.golang.org/protobuf/encoding/protowire"
 	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	maps "maps"
+	math "math"
+	slices "slices"
 	strconv "strconv"
 	sync "sync"
 	time "time"
@@ -65,6 +78,81 @@ func (p *MetricsPlain) IntoPb(c *MetricsPlainCasters) *Metrics {
 	return pb
 }
 
+// metricsPlainMergePaths are the fields of Metrics covered by MetricsPlain
+var metricsPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *MetricsPlain) IntoPbMerge(dst *Metrics, c *MetricsPlainCasters) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb(c)
+	plainmerge.CopyPaths(dst, src, metricsPlainMergePaths...)
+}
+
+// metricsPlainFieldPaths link the JSON names of MetricsPlain to field-mask paths of Metrics
+var metricsPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "durationNs", Path: "duration_ns"},
+	{Name: "timestampUnix", Path: "timestamp_unix"},
+	{Name: "bytesProcessed", Path: "bytes_processed"},
+	{Name: "requestsCount", Path: "requests_count"},
+	{Name: "successRate", Path: "success_rate"},
+}
+
+// MetricsPlainFieldMask builds a field mask of Metrics from JSON names of MetricsPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func MetricsPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(metricsPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// MetricsPlainFieldNames translates a field mask of Metrics into JSON names of MetricsPlain.
+// A path to an embedded message yields all fields flattened from it.
+func MetricsPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(metricsPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Metrics, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *MetricsPlain) ApplyToPb(pb *Metrics, mask *fieldmaskpb.FieldMask, c *MetricsPlainCasters) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), metricsPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb(c)
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Metrics) IntoPlainMasked(mask *fieldmaskpb.FieldMask, c *MetricsPlainCasters) (*MetricsPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), metricsPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Metrics{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(c), nil
+}
+
 // MarshalJX encodes MetricsPlain to JSON using jx.Encoder
 func (p *MetricsPlain) MarshalJX(e *jx.Encoder) {
 	if p == nil {
@@ -154,6 +242,104 @@ func (p *MetricsPlain) UnmarshalJSON(data []byte) error {
 	return p.UnmarshalJX(d)
 }
 
+// metricsPlainReflect describes MetricsPlain as message full.plain.MetricsPlain
+var metricsPlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "MetricsPlain",
+	plainreflect.Scalar(func(p *MetricsPlain) *int64 { return &p.TimestampUnix }),
+	plainreflect.Scalar(func(p *MetricsPlain) *int64 { return &p.BytesProcessed }),
+	plainreflect.Scalar(func(p *MetricsPlain) *int32 { return &p.RequestsCount }),
+	plainreflect.Scalar(func(p *MetricsPlain) *float64 { return &p.SuccessRate }),
+)
+
+// ProtoReflect returns the reflective view of MetricsPlain backed by the struct
+func (p *MetricsPlain) ProtoReflect() protoreflect.Message {
+	return metricsPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of MetricsPlain
+func (p *MetricsPlain) Clone() *MetricsPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &MetricsPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of MetricsPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetMetricsPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *MetricsPlain) CopyTo(dst *MetricsPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.DurationNs = p.DurationNs
+	dst.TimestampUnix = p.TimestampUnix
+	dst.BytesProcessed = p.BytesProcessed
+	dst.RequestsCount = p.RequestsCount
+	dst.SuccessRate = p.SuccessRate
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *MetricsPlain) Equal(other *MetricsPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if !plainequal.Equal(p.DurationNs, other.DurationNs) {
+		return false
+	}
+	if p.TimestampUnix != other.TimestampUnix {
+		return false
+	}
+	if p.BytesProcessed != other.BytesProcessed {
+		return false
+	}
+	if p.RequestsCount != other.RequestsCount {
+		return false
+	}
+	if p.SuccessRate != other.SuccessRate {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of MetricsPlain rows, in the order of ScanRow and Values
+func (p *MetricsPlain) Columns() []string {
+	return []string{
+		"duration_ns",
+		"timestamp_unix",
+		"bytes_processed",
+		"requests_count",
+		"success_rate",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into MetricsPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *MetricsPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.DurationNs,
+		&p.TimestampUnix,
+		&p.BytesProcessed,
+		&p.RequestsCount,
+		&p.SuccessRate,
+	)
+}
+
+// Values returns the column values of MetricsPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *MetricsPlain) Values() []any {
+	return []any{
+		p.DurationNs,
+		p.TimestampUnix,
+		p.BytesProcessed,
+		p.RequestsCount,
+		p.SuccessRate,
+	}
+}
+
 // metricsPlainPool is a sync.Pool for MetricsPlain objects
 var metricsPlainPool = sync.Pool{
 	New: func() interface{} {
@@ -229,6 +415,79 @@ func (p *CustomTypesPlain) IntoPb() *CustomTypes {
 	return pb
 }
 
+// customTypesPlainMergePaths are the fields of CustomTypes covered by CustomTypesPlain
+var customTypesPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *CustomTypesPlain) IntoPbMerge(dst *CustomTypes) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, customTypesPlainMergePaths...)
+}
+
+// customTypesPlainFieldPaths link the JSON names of CustomTypesPlain to field-mask paths of CustomTypes
+var customTypesPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "rawJson", Path: "raw_json"},
+	{Name: "name", Path: "name"},
+	{Name: "count", Path: "count"},
+	{Name: "label", Path: "label"},
+}
+
+// CustomTypesPlainFieldMask builds a field mask of CustomTypes from JSON names of CustomTypesPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func CustomTypesPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(customTypesPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// CustomTypesPlainFieldNames translates a field mask of CustomTypes into JSON names of CustomTypesPlain.
+// A path to an embedded message yields all fields flattened from it.
+func CustomTypesPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(customTypesPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of CustomTypes, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *CustomTypesPlain) ApplyToPb(pb *CustomTypes, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), customTypesPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *CustomTypes) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*CustomTypesPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), customTypesPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &CustomTypes{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
 // IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
 func (pb *CustomTypes) IntoPlainReuse(p *CustomTypesPlain) {
 	if pb == nil || p == nil {
@@ -322,6 +581,191 @@ func (p *CustomTypesPlain) UnmarshalJSON(data []byte) error {
 	return p.UnmarshalJX(d)
 }
 
+// MarshalProto encodes CustomTypesPlain in the protobuf wire format of CustomTypes
+func (p *CustomTypesPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends CustomTypesPlain encoded in the protobuf wire format of CustomTypes to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *CustomTypesPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := []byte(p.RawJson); len(v) > 0 {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendBytes(b, v)
+	}
+	if v := p.Name; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Count; v != 0 {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		_start := len(b)
+		if v := p.Label; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes CustomTypesPlain from the protobuf wire format of CustomTypes.
+// Unknown fields are skipped.
+func (p *CustomTypesPlain) UnmarshalProto(b []byte) error {
+	*p = CustomTypesPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.RawJson = json.RawMessage(append([]byte{}, v...))
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Name = v
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Count = int64(v)
+		case num == 4 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					e = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			p.Label = e
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// customTypesPlainReflect describes CustomTypesPlain as message full.plain.CustomTypesPlain
+var customTypesPlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "CustomTypesPlain",
+	plainreflect.Scalar(func(p *CustomTypesPlain) *string { return &p.Name }),
+	plainreflect.Scalar(func(p *CustomTypesPlain) *int64 { return &p.Count }),
+	plainreflect.Scalar(func(p *CustomTypesPlain) *string { return &p.Label }),
+)
+
+// ProtoReflect returns the reflective view of CustomTypesPlain backed by the struct
+func (p *CustomTypesPlain) ProtoReflect() protoreflect.Message {
+	return customTypesPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of CustomTypesPlain
+func (p *CustomTypesPlain) Clone() *CustomTypesPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &CustomTypesPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of CustomTypesPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetCustomTypesPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *CustomTypesPlain) CopyTo(dst *CustomTypesPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.RawJson = p.RawJson
+	dst.Name = p.Name
+	dst.Count = p.Count
+	dst.Label = p.Label
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *CustomTypesPlain) Equal(other *CustomTypesPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if !plainequal.Equal(p.RawJson, other.RawJson) {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.Count != other.Count {
+		return false
+	}
+	if p.Label != other.Label {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of CustomTypesPlain rows, in the order of ScanRow and Values
+func (p *CustomTypesPlain) Columns() []string {
+	return []string{
+		"raw_json",
+		"name",
+		"count",
+		"label",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into CustomTypesPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *CustomTypesPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.RawJson,
+		&p.Name,
+		&p.Count,
+		&p.Label,
+	)
+}
+
+// Values returns the column values of CustomTypesPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *CustomTypesPlain) Values() []any {
+	return []any{
+		p.RawJson,
+		p.Name,
+		p.Count,
+		p.Label,
+	}
+}
+
 // customTypesPlainPool is a sync.Pool for CustomTypesPlain objects
 var customTypesPlainPool = sync.Pool{
 	New: func() interface{} {
@@ -356,33 +800,86 @@ func (p *CustomTypesPlain) Reset() {
 }
 
 type DocumentPlain struct {
-	Id                       string            `json:"id"`
-	Title                    string            `json:"title"`
-	Status                   Status            `json:"status"`
-	Priority                 Priority          `json:"priority"`
-	Description              string            `json:"description"` // origin: type_alias, empath: description
-	Version                  int64             `json:"version"`     // origin: type_alias, empath: version
-	IsPublic                 bool              `json:"isPublic"`    // origin: type_alias, empath: is_public
-	Email                    string            `json:"email"`
-	Phone                    string            `json:"phone"`
-	Address                  *Address          `json:"address"`
-	Metadata                 *Metadata         `json:"metadata"`
-	Performance              []byte            `json:"performance"` // origin: serialized, empath: performance
-	Keywords                 []string          `json:"keywords"`
-	Attributes               map[string]string `json:"attributes"`
-	Locations                []*Address        `json:"locations"`
-	Structure                *Level1           `json:"structure"`
-	Children                 []DocumentPlain   `json:"children"`
-	Parent                   *DocumentPlain    `json:"parent"`
-	TextContentTextContent   *TextContent      `json:"textContentTextContent"`   // origin: oneof_embed, empath: text_content.text_content
-	ImageContentImageContent *ImageContent     `json:"imageContentImageContent"` // origin: oneof_embed, empath: image_content.image_content
-	VideoContentVideoContent *VideoContent     `json:"videoContentVideoContent"` // origin: oneof_embed, empath: video_content.video_content
-	CodeContentCodeContent   *CodeContent      `json:"codeContentCodeContent"`   // origin: oneof_embed, empath: code_content.code_content
-	TableContentTableContent *TableContent     `json:"tableContentTableContent"` // origin: oneof_embed, empath: table_content.table_content
-	ComputedHash             string            `json:"computedHash"`             // origin: virtual, empath: virtual
-	IsValid                  bool              `json:"isValid"`                  // origin: virtual, empath: virtual
+	Id                  string            `json:"id"`
+	Title               string            `json:"title"`
+	Status              Status            `json:"status"`
+	Priority            Priority          `json:"priority"`
+	Description         string            `json:"description"` // origin: type_alias, empath: description
+	Version             int64             `json:"version"`     // origin: type_alias, empath: version
+	IsPublic            bool              `json:"isPublic"`    // origin: type_alias, empath: is_public
+	Email               string            `json:"email"`
+	Phone               string            `json:"phone"`
+	Address             *Address          `json:"address"`
+	Metadata            *Metadata         `json:"metadata"`
+	Performance         []byte            `json:"performance"` // origin: serialized, empath: performance
+	Keywords            []string          `json:"keywords"`
+	Attributes          map[string]string `json:"attributes"`
+	Locations           []*Address        `json:"locations"`
+	Structure           *Level1           `json:"structure"`
+	Children            []DocumentPlain   `json:"children"`
+	Parent              *DocumentPlain    `json:"parent"`
+	ContentTextContent  *TextContent      `json:"contentTextContent"`  // origin: oneof_embed, empath: content.text_content
+	ContentImageContent *ImageContent     `json:"contentImageContent"` // origin: oneof_embed, empath: content.image_content
+	ContentVideoContent *VideoContent     `json:"contentVideoContent"` // origin: oneof_embed, empath: content.video_content
+	ContentCodeContent  *CodeContent      `json:"contentCodeContent"`  // origin: oneof_embed, empath: content.code_content
+	ContentTableContent *TableContent     `json:"contentTableContent"` // origin: oneof_embed, empath: content.table_content
+	SourceUrl           string            `json:"sourceUrl"`           // origin: oneof_embed, empath: source.url
+	SourceFilePath      string            `json:"sourceFilePath"`      // origin: oneof_embed, empath: source.file_path
+	SourceRawData       []byte            `json:"sourceRawData"`       // origin: oneof_embed, empath: source.raw_data
+	ComputedHash        string            `json:"computedHash"`        // origin: virtual, empath: virtual
+	IsValid             bool              `json:"isValid"`             // origin: virtual, empath: virtual
 	// ContentCase indicates which variant of content oneof is set
-	ContentCase string `json:"content_case,omitempty"`
+	ContentCase DocumentContentCase `json:"content_case,omitempty"`
+	// SourceCase indicates which variant of source oneof is set
+	SourceCase DocumentSourceCase `json:"source_case,omitempty"`
+}
+
+// DocumentContentCase identifies the set variant of full.Document.content oneof, empty when none is set
+type DocumentContentCase string
+
+const (
+	DocumentContentCaseTextContent  DocumentContentCase = "text_content"
+	DocumentContentCaseImageContent DocumentContentCase = "image_content"
+	DocumentContentCaseVideoContent DocumentContentCase = "video_content"
+	DocumentContentCaseCodeContent  DocumentContentCase = "code_content"
+	DocumentContentCaseTableContent DocumentContentCase = "table_content"
+)
+
+// Valid reports whether c is one of the DocumentContentCase variants
+func (c DocumentContentCase) Valid() bool {
+	switch c {
+	case DocumentContentCaseTextContent, DocumentContentCaseImageContent, DocumentContentCaseVideoContent, DocumentContentCaseCodeContent, DocumentContentCaseTableContent:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c DocumentContentCase) String() string {
+	return string(c)
+}
+
+// DocumentSourceCase identifies the set variant of full.Document.source oneof, empty when none is set
+type DocumentSourceCase string
+
+const (
+	DocumentSourceCaseUrl      DocumentSourceCase = "url"
+	DocumentSourceCaseFilePath DocumentSourceCase = "file_path"
+	DocumentSourceCaseRawData  DocumentSourceCase = "raw_data"
+)
+
+// Valid reports whether c is one of the DocumentSourceCase variants
+func (c DocumentSourceCase) Valid() bool {
+	switch c {
+	case DocumentSourceCaseUrl, DocumentSourceCaseFilePath, DocumentSourceCaseRawData:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c DocumentSourceCase) String() string {
+	return string(c)
 }
 
 // IntoPlain converts protobuf message to plain struct
@@ -395,15 +892,25 @@ func (pb *Document) IntoPlain() *DocumentPlain {
 	// Detect content oneof case
 	switch pb.Content.(type) {
 	case *Document_TextContent:
-		p.ContentCase = "text_content"
+		p.ContentCase = DocumentContentCaseTextContent
 	case *Document_ImageContent:
-		p.ContentCase = "image_content"
+		p.ContentCase = DocumentContentCaseImageContent
 	case *Document_VideoContent:
-		p.ContentCase = "video_content"
+		p.ContentCase = DocumentContentCaseVideoContent
 	case *Document_CodeContent:
-		p.ContentCase = "code_content"
+		p.ContentCase = DocumentContentCaseCodeContent
 	case *Document_TableContent:
-		p.ContentCase = "table_content"
+		p.ContentCase = DocumentContentCaseTableContent
+	}
+
+	// Detect source oneof case
+	switch pb.Source.(type) {
+	case *Document_Url:
+		p.SourceCase = DocumentSourceCaseUrl
+	case *Document_FilePath:
+		p.SourceCase = DocumentSourceCaseFilePath
+	case *Document_RawData:
+		p.SourceCase = DocumentSourceCaseRawData
 	}
 
 	p.Id = pb.Id
@@ -437,11 +944,17 @@ func (pb *Document) IntoPlain() *DocumentPlain {
 	p.Metadata = pb.Metadata
 	// Performance serialized from performance
 	if pb.Performance != nil {
-		if data, err := proto.Marshal(pb.Performance); err == nil {
+		if data, err := protojson.Marshal(pb.Performance); err == nil {
 			p.Performance = data
 		}
+	} else {
+		p.Performance = []byte{}
+	}
+	if len(pb.Keywords) > 0 {
+		p.Keywords = pb.Keywords
+	} else {
+		p.Keywords = []string{}
 	}
-	p.Keywords = pb.Keywords
 	p.Attributes = pb.Attributes
 	p.Locations = pb.Locations
 	p.Structure = pb.Structure
@@ -452,29 +965,43 @@ func (pb *Document) IntoPlain() *DocumentPlain {
 				p.Children[i] = *v.IntoPlain()
 			}
 		}
+	} else {
+		p.Children = []DocumentPlain{}
 	}
 	if pb.Parent != nil {
 		p.Parent = pb.Parent.IntoPlain()
 	}
-	// TextContentTextContent from text_content.text_content
+	// ContentTextContent from content.text_content
 	if pb.GetTextContent() != nil {
-		p.TextContentTextContent = pb.GetTextContent()
+		p.ContentTextContent = pb.GetTextContent()
 	}
-	// ImageContentImageContent from image_content.image_content
+	// ContentImageContent from content.image_content
 	if pb.GetImageContent() != nil {
-		p.ImageContentImageContent = pb.GetImageContent()
+		p.ContentImageContent = pb.GetImageContent()
 	}
-	// VideoContentVideoContent from video_content.video_content
+	// ContentVideoContent from content.video_content
 	if pb.GetVideoContent() != nil {
-		p.VideoContentVideoContent = pb.GetVideoContent()
+		p.ContentVideoContent = pb.GetVideoContent()
 	}
-	// CodeContentCodeContent from code_content.code_content
+	// ContentCodeContent from content.code_content
 	if pb.GetCodeContent() != nil {
-		p.CodeContentCodeContent = pb.GetCodeContent()
+		p.ContentCodeContent = pb.GetCodeContent()
 	}
-	// TableContentTableContent from table_content.table_content
+	// ContentTableContent from content.table_content
 	if pb.GetTableContent() != nil {
-		p.TableContentTableContent = pb.GetTableContent()
+		p.ContentTableContent = pb.GetTableContent()
+	}
+	// SourceUrl from source.url
+	if pb != nil {
+		p.SourceUrl = pb.GetUrl()
+	}
+	// SourceFilePath from source.file_path
+	if pb != nil {
+		p.SourceFilePath = pb.GetFilePath()
+	}
+	// SourceRawData from source.raw_data
+	if pb != nil {
+		p.SourceRawData = pb.GetRawData()
 	}
 	// ComputedHash is virtual, no source in protobuf
 	// IsValid is virtual, no source in protobuf
@@ -528,9 +1055,8 @@ func (p *DocumentPlain) IntoPb() *Document {
 	pb.Metadata = p.Metadata
 	// Performance deserialize -> performance
 	if len(p.Performance) > 0 {
-		// TODO: Initialize parent structures for Performance
 		var msg Metrics
-		if err := proto.Unmarshal(p.Performance, &msg); err == nil {
+		if err := protojson.Unmarshal(p.Performance, &msg); err == nil {
 			pb.Performance = &msg
 		}
 	}
@@ -547,31 +1073,160 @@ func (p *DocumentPlain) IntoPb() *Document {
 	if p.Parent != nil {
 		pb.Parent = p.Parent.IntoPb()
 	}
-	// TextContentTextContent -> text_content.text_content
-	if p.TextContentTextContent != nil && p.ContentCase == "text_content" {
-		pb.Content = &Document_TextContent{TextContent: p.TextContentTextContent}
+	// ContentTextContent -> content.text_content
+	if p.ContentTextContent != nil && p.ContentCase == DocumentContentCaseTextContent {
+		pb.Content = &Document_TextContent{TextContent: p.ContentTextContent}
+	}
+	// ContentImageContent -> content.image_content
+	if p.ContentImageContent != nil && p.ContentCase == DocumentContentCaseImageContent {
+		pb.Content = &Document_ImageContent{ImageContent: p.ContentImageContent}
+	}
+	// ContentVideoContent -> content.video_content
+	if p.ContentVideoContent != nil && p.ContentCase == DocumentContentCaseVideoContent {
+		pb.Content = &Document_VideoContent{VideoContent: p.ContentVideoContent}
+	}
+	// ContentCodeContent -> content.code_content
+	if p.ContentCodeContent != nil && p.ContentCase == DocumentContentCaseCodeContent {
+		pb.Content = &Document_CodeContent{CodeContent: p.ContentCodeContent}
 	}
-	// ImageContentImageContent -> image_content.image_content
-	if p.ImageContentImageContent != nil && p.ContentCase == "image_content" {
-		pb.Content = &Document_ImageContent{ImageContent: p.ImageContentImageContent}
+	// ContentTableContent -> content.table_content
+	if p.ContentTableContent != nil && p.ContentCase == DocumentContentCaseTableContent {
+		pb.Content = &Document_TableContent{TableContent: p.ContentTableContent}
 	}
-	// VideoContentVideoContent -> video_content.video_content
-	if p.VideoContentVideoContent != nil && p.ContentCase == "video_content" {
-		pb.Content = &Document_VideoContent{VideoContent: p.VideoContentVideoContent}
+	// SourceUrl -> source.url
+	if p.SourceCase == DocumentSourceCaseUrl {
+		pb.Source = &Document_Url{Url: p.SourceUrl}
 	}
-	// CodeContentCodeContent -> code_content.code_content
-	if p.CodeContentCodeContent != nil && p.ContentCase == "code_content" {
-		pb.Content = &Document_CodeContent{CodeContent: p.CodeContentCodeContent}
+	// SourceFilePath -> source.file_path
+	if p.SourceCase == DocumentSourceCaseFilePath {
+		pb.Source = &Document_FilePath{FilePath: p.SourceFilePath}
 	}
-	// TableContentTableContent -> table_content.table_content
-	if p.TableContentTableContent != nil && p.ContentCase == "table_content" {
-		pb.Content = &Document_TableContent{TableContent: p.TableContentTableContent}
+	// SourceRawData -> source.raw_data
+	if p.SourceCase == DocumentSourceCaseRawData {
+		pb.Source = &Document_RawData{RawData: p.SourceRawData}
 	}
 	// ComputedHash is virtual, skipping
 	// IsValid is virtual, skipping
 	return pb
 }
 
+// documentPlainMergePaths are the fields of Document covered by DocumentPlain
+var documentPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{7},
+	{8, 1},
+	{8, 2},
+	{8, 3},
+	{9},
+	{10},
+	{11},
+	{12},
+	{13},
+	{14},
+	{40},
+	{41},
+	{20},
+	{21},
+	{22},
+	{23},
+	{24},
+	{30},
+	{31},
+	{32},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *DocumentPlain) IntoPbMerge(dst *Document) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, documentPlainMergePaths...)
+}
+
+// documentPlainFieldPaths link the JSON names of DocumentPlain to field-mask paths of Document
+var documentPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "title", Path: "title"},
+	{Name: "status", Path: "status"},
+	{Name: "priority", Path: "priority"},
+	{Name: "description", Path: "description"},
+	{Name: "version", Path: "version"},
+	{Name: "isPublic", Path: "is_public"},
+	{Name: "email", Path: "author.email"},
+	{Name: "phone", Path: "author.phone"},
+	{Name: "address", Path: "author.address"},
+	{Name: "metadata", Path: "metadata"},
+	{Name: "performance", Path: "performance"},
+	{Name: "keywords", Path: "keywords"},
+	{Name: "attributes", Path: "attributes"},
+	{Name: "locations", Path: "locations"},
+	{Name: "structure", Path: "structure"},
+	{Name: "children", Path: "children"},
+	{Name: "parent", Path: "parent"},
+	{Name: "contentTextContent", Path: "text_content"},
+	{Name: "contentImageContent", Path: "image_content"},
+	{Name: "contentVideoContent", Path: "video_content"},
+	{Name: "contentCodeContent", Path: "code_content"},
+	{Name: "contentTableContent", Path: "table_content"},
+	{Name: "sourceUrl", Path: "url"},
+	{Name: "sourceFilePath", Path: "file_path"},
+	{Name: "sourceRawData", Path: "raw_data"},
+}
+
+// DocumentPlainFieldMask builds a field mask of Document from JSON names of DocumentPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func DocumentPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(documentPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// DocumentPlainFieldNames translates a field mask of Document into JSON names of DocumentPlain.
+// A path to an embedded message yields all fields flattened from it.
+func DocumentPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(documentPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Document, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *DocumentPlain) ApplyToPb(pb *Document, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), documentPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Document) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*DocumentPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), documentPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Document{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
 // IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
 func (pb *Document) IntoPlainReuse(p *DocumentPlain) {
 	if pb == nil || p == nil {
@@ -583,15 +1238,25 @@ func (pb *Document) IntoPlainReuse(p *DocumentPlain) {
 	// Detect content oneof case
 	switch pb.Content.(type) {
 	case *Document_TextContent:
-		p.ContentCase = "text_content"
+		p.ContentCase = DocumentContentCaseTextContent
 	case *Document_ImageContent:
-		p.ContentCase = "image_content"
+		p.ContentCase = DocumentContentCaseImageContent
 	case *Document_VideoContent:
-		p.ContentCase = "video_content"
+		p.ContentCase = DocumentContentCaseVideoContent
 	case *Document_CodeContent:
-		p.ContentCase = "code_content"
+		p.ContentCase = DocumentContentCaseCodeContent
 	case *Document_TableContent:
-		p.ContentCase = "table_content"
+		p.ContentCase = DocumentContentCaseTableContent
+	}
+
+	// Detect source oneof case
+	switch pb.Source.(type) {
+	case *Document_Url:
+		p.SourceCase = DocumentSourceCaseUrl
+	case *Document_FilePath:
+		p.SourceCase = DocumentSourceCaseFilePath
+	case *Document_RawData:
+		p.SourceCase = DocumentSourceCaseRawData
 	}
 
 	p.Id = pb.Id
@@ -625,11 +1290,17 @@ func (pb *Document) IntoPlainReuse(p *DocumentPlain) {
 	p.Metadata = pb.Metadata
 	// Performance serialized from performance
 	if pb.Performance != nil {
-		if data, err := proto.Marshal(pb.Performance); err == nil {
+		if data, err := protojson.Marshal(pb.Performance); err == nil {
 			p.Performance = data
 		}
+	} else {
+		p.Performance = []byte{}
+	}
+	if len(pb.Keywords) > 0 {
+		p.Keywords = pb.Keywords
+	} else {
+		p.Keywords = []string{}
 	}
-	p.Keywords = pb.Keywords
 	p.Attributes = pb.Attributes
 	p.Locations = pb.Locations
 	p.Structure = pb.Structure
@@ -640,34 +1311,60 @@ func (pb *Document) IntoPlainReuse(p *DocumentPlain) {
 				p.Children[i] = *v.IntoPlain()
 			}
 		}
+	} else {
+		p.Children = []DocumentPlain{}
 	}
 	if pb.Parent != nil {
 		p.Parent = pb.Parent.IntoPlain()
 	}
-	// TextContentTextContent from text_content.text_content
+	// ContentTextContent from content.text_content
 	if pb.GetTextContent() != nil {
-		p.TextContentTextContent = pb.GetTextContent()
+		p.ContentTextContent = pb.GetTextContent()
 	}
-	// ImageContentImageContent from image_content.image_content
+	// ContentImageContent from content.image_content
 	if pb.GetImageContent() != nil {
-		p.ImageContentImageContent = pb.GetImageContent()
+		p.ContentImageContent = pb.GetImageContent()
 	}
-	// VideoContentVideoContent from video_content.video_content
+	// ContentVideoContent from content.video_content
 	if pb.GetVideoContent() != nil {
-		p.VideoContentVideoContent = pb.GetVideoContent()
+		p.ContentVideoContent = pb.GetVideoContent()
 	}
-	// CodeContentCodeContent from code_content.code_content
+	// ContentCodeContent from content.code_content
 	if pb.GetCodeContent() != nil {
-		p.CodeContentCodeContent = pb.GetCodeContent()
+		p.ContentCodeContent = pb.GetCodeContent()
 	}
-	// TableContentTableContent from table_content.table_content
+	// ContentTableContent from content.table_content
 	if pb.GetTableContent() != nil {
-		p.TableContentTableContent = pb.GetTableContent()
+		p.ContentTableContent = pb.GetTableContent()
+	}
+	// SourceUrl from source.url
+	if pb != nil {
+		p.SourceUrl = pb.GetUrl()
+	}
+	// SourceFilePath from source.file_path
+	if pb != nil {
+		p.SourceFilePath = pb.GetFilePath()
+	}
+	// SourceRawData from source.raw_data
+	if pb != nil {
+		p.SourceRawData = pb.GetRawData()
 	}
 	// ComputedHash is virtual, no source in protobuf
 	// IsValid is virtual, no source in protobuf
 }
 
+// WithComputedHash sets the virtual field ComputedHash
+func (p *DocumentPlain) WithComputedHash(v string) *DocumentPlain {
+	p.ComputedHash = v
+	return p
+}
+
+// WithIsValid sets the virtual field IsValid
+func (p *DocumentPlain) WithIsValid(v bool) *DocumentPlain {
+	p.IsValid = v
+	return p
+}
+
 // MarshalJX encodes DocumentPlain to JSON using jx.Encoder
 func (p *DocumentPlain) MarshalJX(e *jx.Encoder) {
 	if p == nil {
@@ -679,7 +1376,11 @@ func (p *DocumentPlain) MarshalJX(e *jx.Encoder) {
 
 	if p.ContentCase != "" {
 		e.FieldStart("content_case")
-		e.Str(p.ContentCase)
+		e.Str(p.ContentCase.String())
+	}
+	if p.SourceCase != "" {
+		e.FieldStart("source_case")
+		e.Str(p.SourceCase.String())
 	}
 	if p.Id != "" {
 		e.FieldStart("id")
@@ -689,10 +1390,14 @@ func (p *DocumentPlain) MarshalJX(e *jx.Encoder) {
 		e.FieldStart("title")
 		e.Str(p.Title)
 	}
-	e.FieldStart("status")
-	e.Int32(int32(p.Status))
-	e.FieldStart("priority")
-	e.Int32(int32(p.Priority))
+	if p.Status != 0 {
+		e.FieldStart("status")
+		e.Int32(int32(p.Status))
+	}
+	if p.Priority != 0 {
+		e.FieldStart("priority")
+		e.Int32(int32(p.Priority))
+	}
 	if p.Description != "" {
 		e.FieldStart("description")
 		e.Str(p.Description)
@@ -764,25 +1469,37 @@ func (p *DocumentPlain) MarshalJX(e *jx.Encoder) {
 		e.FieldStart("parent")
 		p.Parent.MarshalJX(e)
 	}
-	if p.TextContentTextContent != nil {
-		e.FieldStart("textContentTextContent")
-		p.TextContentTextContent.MarshalJX(e)
+	if p.ContentTextContent != nil {
+		e.FieldStart("contentTextContent")
+		p.ContentTextContent.MarshalJX(e)
+	}
+	if p.ContentImageContent != nil {
+		e.FieldStart("contentImageContent")
+		p.ContentImageContent.MarshalJX(e)
+	}
+	if p.ContentVideoContent != nil {
+		e.FieldStart("contentVideoContent")
+		p.ContentVideoContent.MarshalJX(e)
 	}
-	if p.ImageContentImageContent != nil {
-		e.FieldStart("imageContentImageContent")
-		p.ImageContentImageContent.MarshalJX(e)
+	if p.ContentCodeContent != nil {
+		e.FieldStart("contentCodeContent")
+		p.ContentCodeContent.MarshalJX(e)
 	}
-	if p.VideoContentVideoContent != nil {
-		e.FieldStart("videoContentVideoContent")
-		p.VideoContentVideoContent.MarshalJX(e)
+	if p.ContentTableContent != nil {
+		e.FieldStart("contentTableContent")
+		p.ContentTableContent.MarshalJX(e)
 	}
-	if p.CodeContentCodeContent != nil {
-		e.FieldStart("codeContentCodeContent")
-		p.CodeContentCodeContent.MarshalJX(e)
+	if p.SourceUrl != "" {
+		e.FieldStart("sourceUrl")
+		e.Str(p.SourceUrl)
 	}
-	if p.TableContentTableContent != nil {
-		e.FieldStart("tableContentTableContent")
-		p.TableContentTableContent.MarshalJX(e)
+	if p.SourceFilePath != "" {
+		e.FieldStart("sourceFilePath")
+		e.Str(p.SourceFilePath)
+	}
+	if len(p.SourceRawData) > 0 {
+		e.FieldStart("sourceRawData")
+		e.Base64(p.SourceRawData)
 	}
 	if p.ComputedHash != "" {
 		e.FieldStart("computedHash")
@@ -816,7 +1533,19 @@ func (p *DocumentPlain) UnmarshalJX(d *jx.Decoder) error {
 			if err != nil {
 				return err
 			}
-			p.ContentCase = v
+			p.ContentCase = DocumentContentCase(v)
+			if v != "" && !p.ContentCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.Document.content", v)
+			}
+		case "source_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.SourceCase = DocumentSourceCase(v)
+			if v != "" && !p.SourceCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.Document.source", v)
+			}
 		case "id":
 			v, err := d.Str()
 			if err != nil {
@@ -830,13 +1559,13 @@ func (p *DocumentPlain) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.Title = v
 		case "status":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
 			if err != nil {
 				return err
 			}
 			p.Status = Status(v)
 		case "priority":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Priority", Priority_value)
 			if err != nil {
 				return err
 			}
@@ -902,14 +1631,16 @@ func (p *DocumentPlain) UnmarshalJX(d *jx.Decoder) error {
 			if p.Attributes == nil {
 				p.Attributes = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Attributes[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "locations":
 			if err := d.Arr(func(d *jx.Decoder) error {
 				var v Address
@@ -942,31 +1673,49 @@ func (p *DocumentPlain) UnmarshalJX(d *jx.Decoder) error {
 			if err := p.Parent.UnmarshalJX(d); err != nil {
 				return err
 			}
-		case "textContentTextContent":
-			p.TextContentTextContent = &TextContent{}
-			if err := p.TextContentTextContent.UnmarshalJX(d); err != nil {
+		case "contentTextContent":
+			p.ContentTextContent = &TextContent{}
+			if err := p.ContentTextContent.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "contentImageContent":
+			p.ContentImageContent = &ImageContent{}
+			if err := p.ContentImageContent.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "contentVideoContent":
+			p.ContentVideoContent = &VideoContent{}
+			if err := p.ContentVideoContent.UnmarshalJX(d); err != nil {
 				return err
 			}
-		case "imageContentImageContent":
-			p.ImageContentImageContent = &ImageContent{}
-			if err := p.ImageContentImageContent.UnmarshalJX(d); err != nil {
+		case "contentCodeContent":
+			p.ContentCodeContent = &CodeContent{}
+			if err := p.ContentCodeContent.UnmarshalJX(d); err != nil {
 				return err
 			}
-		case "videoContentVideoContent":
-			p.VideoContentVideoContent = &VideoContent{}
-			if err := p.VideoContentVideoContent.UnmarshalJX(d); err != nil {
+		case "contentTableContent":
+			p.ContentTableContent = &TableContent{}
+			if err := p.ContentTableContent.UnmarshalJX(d); err != nil {
 				return err
 			}
-		case "codeContentCodeContent":
-			p.CodeContentCodeContent = &CodeContent{}
-			if err := p.CodeContentCodeContent.UnmarshalJX(d); err != nil {
+		case "sourceUrl":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.SourceUrl = v
+		case "sourceFilePath":
+			v, err := d.Str()
+			if err != nil {
 				return err
 			}
-		case "tableContentTableContent":
-			p.TableContentTableContent = &TableContent{}
-			if err := p.TableContentTableContent.UnmarshalJX(d); err != nil {
+			p.SourceFilePath = v
+		case "sourceRawData":
+			v, err := d.Base64()
+			if err != nil {
 				return err
 			}
+			p.SourceRawData = v
 		case "computedHash":
 			v, err := d.Str()
 			if err != nil {
@@ -992,653 +1741,1274 @@ func (p *DocumentPlain) UnmarshalJSON(data []byte) error {
 	return p.UnmarshalJX(d)
 }
 
-// documentPlainPool is a sync.Pool for DocumentPlain objects
-var documentPlainPool = sync.Pool{
-	New: func() interface{} {
-		return &DocumentPlain{}
-	},
-}
-
-// GetDocumentPlain returns a DocumentPlain from the pool
-func GetDocumentPlain() *DocumentPlain {
-	return documentPlainPool.Get().(*DocumentPlain)
+// MarshalProto encodes DocumentPlain in the protobuf wire format of Document
+func (p *DocumentPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
 }
 
-// PutDocumentPlain returns a DocumentPlain to the pool after resetting it
-func PutDocumentPlain(p *DocumentPlain) {
+// AppendProto appends DocumentPlain encoded in the protobuf wire format of Document to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *DocumentPlain) AppendProto(b []byte) ([]byte, error) {
 	if p == nil {
-		return
+		return b, nil
 	}
-	p.Reset()
-	documentPlainPool.Put(p)
-}
-
-// Reset clears all fields in DocumentPlain for reuse
-func (p *DocumentPlain) Reset() {
-	if p == nil {
-		return
+	var err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
 	}
-
-	p.ContentCase = ""
-	p.Id = ""
-	p.Title = ""
-	p.Status = 0
-	p.Priority = 0
-	p.Description = ""
-	p.Version = 0
-	p.IsPublic = false
-	p.Email = ""
-	p.Phone = ""
-	p.Address = nil
-	p.Metadata = nil
-	p.Performance = nil
-	p.Keywords = p.Keywords[:0]
-	for k := range p.Attributes {
-		delete(p.Attributes, k)
+	if v := p.Title; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
 	}
-	p.Locations = nil
-	p.Structure = nil
-	p.Children = p.Children[:0]
-	p.Parent = nil
-	p.TextContentTextContent = nil
-	p.ImageContentImageContent = nil
-	p.VideoContentVideoContent = nil
-	p.CodeContentCodeContent = nil
-	p.TableContentTableContent = nil
-	p.ComputedHash = ""
-	p.IsValid = false
-}
-
-type TreeNodePlain struct {
-	Id         string            `json:"id"`
-	Name       string            `json:"name"`
-	Type       string            `json:"type"`
-	Children   []TreeNodePlain   `json:"children"`
-	Parent     *TreeNodePlain    `json:"parent"`
-	CreatedBy  string            `json:"createdBy"`
-	CreatedAt  int64             `json:"createdAt"`
-	ModifiedBy string            `json:"modifiedBy"`
-	ModifiedAt int64             `json:"modifiedAt"`
-	Labels     map[string]string `json:"labels"`
-	Tags       []string          `json:"tags"`
-	TextText   *TextContent      `json:"textText"`   // origin: oneof_embed, empath: text.text
-	ImageImage *ImageContent     `json:"imageImage"` // origin: oneof_embed, empath: image.image
-	CodeCode   *CodeContent      `json:"codeCode"`   // origin: oneof_embed, empath: code.code
-	// PayloadCase indicates which variant of payload oneof is set
-	PayloadCase string `json:"payload_case,omitempty"`
-}
-
-// IntoPlain converts protobuf message to plain struct
-func (pb *TreeNode) IntoPlain() *TreeNodePlain {
-	if pb == nil {
-		return nil
+	if v := p.Status; v != 0 {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
 	}
-	p := &TreeNodePlain{}
-
-	// Detect payload oneof case
-	switch pb.Payload.(type) {
-	case *TreeNode_Text:
-		p.PayloadCase = "text"
-	case *TreeNode_Image:
-		p.PayloadCase = "image"
-	case *TreeNode_Code:
-		p.PayloadCase = "code"
+	if v := p.Priority; v != 0 {
+		b = protowire.AppendTag(b, 4, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
 	}
-
-	p.Id = pb.Id
-	p.Name = pb.Name
-	p.Type = pb.Type
-	if len(pb.Children) > 0 {
-		p.Children = make([]TreeNodePlain, len(pb.Children))
-		for i, v := range pb.Children {
-			if v != nil {
-				p.Children[i] = *v.IntoPlain()
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		_start := len(b)
+		if v := p.Description; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		_start := len(b)
+		if v := p.Version; v != 0 {
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 7, protowire.BytesType)
+		_start := len(b)
+		if v := p.IsPublic; v {
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	// author (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 8, protowire.BytesType)
+		_start := len(b)
+		if v := p.Email; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.Phone; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if p.Address != nil {
+			b = protowire.AppendTag(b, 3, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Address); err != nil {
+				return nil, err
 			}
+			b = plainwire.FinishLen(b, _start)
 		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
 	}
-	if pb.Parent != nil {
-		p.Parent = pb.Parent.IntoPlain()
+	if p.Metadata != nil {
+		b = protowire.AppendTag(b, 9, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Metadata); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
 	}
-	// CreatedBy from
-	if pb.GetInfo() != nil {
-		p.CreatedBy = pb.GetInfo().GetCreatedBy()
+	if len(p.Performance) > 0 {
+		m := &Metrics{}
+		if err := protojson.Unmarshal(p.Performance, m); err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, v := range p.Keywords {
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	for _, k := range plainwire.SortedKeys(p.Attributes) {
+		v := p.Attributes[k]
+		b = protowire.AppendTag(b, 12, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, m := range p.Locations {
+		b = protowire.AppendTag(b, 13, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
 	}
-	// CreatedAt from
-	if pb.GetInfo() != nil {
-		p.CreatedAt = pb.GetInfo().GetCreatedAt()
+	if p.Structure != nil {
+		b = protowire.AppendTag(b, 14, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Structure); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
 	}
-	// ModifiedBy from
-	if pb.GetInfo() != nil {
-		p.ModifiedBy = pb.GetInfo().GetModifiedBy()
+	for i := range p.Children {
+		b = protowire.AppendTag(b, 40, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Children[i].AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
 	}
-	// ModifiedAt from
-	if pb.GetInfo() != nil {
-		p.ModifiedAt = pb.GetInfo().GetModifiedAt()
+	if p.Parent != nil {
+		b = protowire.AppendTag(b, 41, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Parent.AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
 	}
-	// Labels from
-	if pb.GetInfo() != nil && pb.GetInfo().GetLabels() != nil {
-		p.Labels = pb.GetInfo().GetLabels()
+	if p.ContentCase == DocumentContentCaseTextContent {
+		if p.ContentTextContent != nil {
+			b = protowire.AppendTag(b, 20, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ContentTextContent); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-	// Tags from
-	if pb.GetInfo() != nil {
-		p.Tags = pb.GetInfo().GetTags()
+	if p.ContentCase == DocumentContentCaseImageContent {
+		if p.ContentImageContent != nil {
+			b = protowire.AppendTag(b, 21, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ContentImageContent); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-	// TextText from text.text
-	if pb.GetText() != nil {
-		p.TextText = pb.GetText()
+	if p.ContentCase == DocumentContentCaseVideoContent {
+		if p.ContentVideoContent != nil {
+			b = protowire.AppendTag(b, 22, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ContentVideoContent); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-	// ImageImage from image.image
-	if pb.GetImage() != nil {
-		p.ImageImage = pb.GetImage()
+	if p.ContentCase == DocumentContentCaseCodeContent {
+		if p.ContentCodeContent != nil {
+			b = protowire.AppendTag(b, 23, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ContentCodeContent); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-	// CodeCode from code.code
-	if pb.GetCode() != nil {
-		p.CodeCode = pb.GetCode()
+	if p.ContentCase == DocumentContentCaseTableContent {
+		if p.ContentTableContent != nil {
+			b = protowire.AppendTag(b, 24, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ContentTableContent); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-	return p
+	if p.SourceCase == DocumentSourceCaseUrl {
+		b = protowire.AppendTag(b, 30, protowire.BytesType)
+		b = protowire.AppendString(b, p.SourceUrl)
+	}
+	if p.SourceCase == DocumentSourceCaseFilePath {
+		b = protowire.AppendTag(b, 31, protowire.BytesType)
+		b = protowire.AppendString(b, p.SourceFilePath)
+	}
+	if p.SourceCase == DocumentSourceCaseRawData {
+		b = protowire.AppendTag(b, 32, protowire.BytesType)
+		b = protowire.AppendBytes(b, p.SourceRawData)
+	}
+	return b, err
 }
 
-// IntoPb converts plain struct to protobuf message
-func (p *TreeNodePlain) IntoPb() *TreeNode {
+// UnmarshalProto decodes DocumentPlain from the protobuf wire format of Document.
+// Unknown fields are skipped.
+func (p *DocumentPlain) UnmarshalProto(b []byte) error {
+	*p = DocumentPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Title = v
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Status = Status(v)
+		case num == 4 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Priority = Priority(v)
+		case num == 5 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					e = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			p.Description = e
+		case num == 6 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e int64
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					e = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			p.Version = e
+		case num == 7 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e bool
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					e = protowire.DecodeBool(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			p.IsPublic = e
+		case num == 8 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.Email = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.Phone = v
+				case num == 3 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					m := &Address{}
+					if err := proto.Unmarshal(v, m); err != nil {
+						return err
+					}
+					p.Address = m
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 9 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &Metadata{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Metadata = m
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &Metrics{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			data, err := protojson.Marshal(m)
+			if err != nil {
+				return err
+			}
+			p.Performance = data
+		case num == 11 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Keywords = append(p.Keywords, v)
+		case num == 12 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Attributes == nil {
+				p.Attributes = make(map[string]string)
+			}
+			p.Attributes[mk] = mv
+		case num == 13 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &Address{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Locations = append(p.Locations, m)
+		case num == 14 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &Level1{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Structure = m
+		case num == 40 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e DocumentPlain
+			if err := e.UnmarshalProto(v); err != nil {
+				return err
+			}
+			p.Children = append(p.Children, e)
+		case num == 41 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.Parent = &DocumentPlain{}
+			if err := p.Parent.UnmarshalProto(v); err != nil {
+				return err
+			}
+		case num == 20 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ContentCase = DocumentContentCaseTextContent
+			m := &TextContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.ContentTextContent = m
+		case num == 21 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ContentCase = DocumentContentCaseImageContent
+			m := &ImageContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.ContentImageContent = m
+		case num == 22 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ContentCase = DocumentContentCaseVideoContent
+			m := &VideoContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.ContentVideoContent = m
+		case num == 23 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ContentCase = DocumentContentCaseCodeContent
+			m := &CodeContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.ContentCodeContent = m
+		case num == 24 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ContentCase = DocumentContentCaseTableContent
+			m := &TableContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.ContentTableContent = m
+		case num == 30 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.SourceCase = DocumentSourceCaseUrl
+			p.SourceUrl = v
+		case num == 31 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.SourceCase = DocumentSourceCaseFilePath
+			p.SourceFilePath = v
+		case num == 32 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.SourceCase = DocumentSourceCaseRawData
+			p.SourceRawData = append([]byte{}, v...)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// documentPlainReflect describes DocumentPlain as message full.plain.DocumentPlain
+var documentPlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "DocumentPlain",
+	plainreflect.Scalar(func(p *DocumentPlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *DocumentPlain) *string { return &p.Title }),
+	plainreflect.Enum(func(p *DocumentPlain) *Status { return &p.Status }),
+	plainreflect.Enum(func(p *DocumentPlain) *Priority { return &p.Priority }),
+	plainreflect.Scalar(func(p *DocumentPlain) *string { return &p.Description }),
+	plainreflect.Scalar(func(p *DocumentPlain) *int64 { return &p.Version }),
+	plainreflect.Scalar(func(p *DocumentPlain) *bool { return &p.IsPublic }),
+	plainreflect.Scalar(func(p *DocumentPlain) *string { return &p.Email }),
+	plainreflect.Scalar(func(p *DocumentPlain) *string { return &p.Phone }),
+	plainreflect.Message(func(p *DocumentPlain) **Address { return &p.Address }),
+	plainreflect.Message(func(p *DocumentPlain) **Metadata { return &p.Metadata }),
+	plainreflect.Scalar(func(p *DocumentPlain) *[]byte { return &p.Performance }),
+	plainreflect.List(func(p *DocumentPlain) *[]string { return &p.Keywords }),
+	plainreflect.Map(func(p *DocumentPlain) *map[string]string { return &p.Attributes }),
+	plainreflect.MessageList(func(p *DocumentPlain) *[]*Address { return &p.Locations }),
+	plainreflect.Message(func(p *DocumentPlain) **Level1 { return &p.Structure }),
+	plainreflect.ValueList(func(p *DocumentPlain) *[]DocumentPlain { return &p.Children }),
+	plainreflect.Message(func(p *DocumentPlain) **DocumentPlain { return &p.Parent }),
+	plainreflect.Message(func(p *DocumentPlain) **TextContent { return &p.ContentTextContent }),
+	plainreflect.Message(func(p *DocumentPlain) **ImageContent { return &p.ContentImageContent }),
+	plainreflect.Message(func(p *DocumentPlain) **VideoContent { return &p.ContentVideoContent }),
+	plainreflect.Message(func(p *DocumentPlain) **CodeContent { return &p.ContentCodeContent }),
+	plainreflect.Message(func(p *DocumentPlain) **TableContent { return &p.ContentTableContent }),
+	plainreflect.Scalar(func(p *DocumentPlain) *string { return &p.SourceUrl }),
+	plainreflect.Scalar(func(p *DocumentPlain) *string { return &p.SourceFilePath }),
+	plainreflect.Scalar(func(p *DocumentPlain) *[]byte { return &p.SourceRawData }),
+	plainreflect.Scalar(func(p *DocumentPlain) *string { return &p.ComputedHash }),
+	plainreflect.Scalar(func(p *DocumentPlain) *bool { return &p.IsValid }),
+	plainreflect.Case(func(p *DocumentPlain) *DocumentContentCase { return &p.ContentCase }),
+	plainreflect.Case(func(p *DocumentPlain) *DocumentSourceCase { return &p.SourceCase }),
+)
+
+// ProtoReflect returns the reflective view of DocumentPlain backed by the struct
+func (p *DocumentPlain) ProtoReflect() protoreflect.Message {
+	return documentPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of DocumentPlain
+func (p *DocumentPlain) Clone() *DocumentPlain {
 	if p == nil {
 		return nil
 	}
-	pb := &TreeNode{}
+	dst := &DocumentPlain{}
+	p.CopyTo(dst)
+	return dst
+}
 
-	pb.Id = p.Id
-	pb.Name = p.Name
-	pb.Type = p.Type
-	if len(p.Children) > 0 {
-		pb.Children = make([]*TreeNode, len(p.Children))
-		for i := range p.Children {
-			pb.Children[i] = (&p.Children[i]).IntoPb()
-		}
+// CopyTo overwrites dst with a deep copy of DocumentPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetDocumentPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *DocumentPlain) CopyTo(dst *DocumentPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
 	}
-	if p.Parent != nil {
-		pb.Parent = p.Parent.IntoPb()
+	dst.ContentCase = p.ContentCase
+	dst.SourceCase = p.SourceCase
+	dst.Id = p.Id
+	dst.Title = p.Title
+	dst.Status = p.Status
+	dst.Priority = p.Priority
+	dst.Description = p.Description
+	dst.Version = p.Version
+	dst.IsPublic = p.IsPublic
+	dst.Email = p.Email
+	dst.Phone = p.Phone
+	if p.Address == nil {
+		dst.Address = nil
+	} else {
+		if dst.Address == nil {
+			dst.Address = new(Address)
+		} else {
+			proto.Reset(dst.Address)
+		}
+		proto.Merge(dst.Address, p.Address)
 	}
-	// CreatedBy ->
-	if p.CreatedBy != "" {
-		if pb.Info == nil {
-			pb.Info = &Metadata{}
+	if p.Metadata == nil {
+		dst.Metadata = nil
+	} else {
+		if dst.Metadata == nil {
+			dst.Metadata = new(Metadata)
+		} else {
+			proto.Reset(dst.Metadata)
 		}
-		pb.Info.CreatedBy = p.CreatedBy
+		proto.Merge(dst.Metadata, p.Metadata)
 	}
-	// CreatedAt ->
-	if pb.Info == nil {
-		pb.Info = &Metadata{}
+	dst.Performance = append(dst.Performance[:0], p.Performance...)
+	dst.Keywords = append(dst.Keywords[:0], p.Keywords...)
+	for k := range dst.Attributes {
+		delete(dst.Attributes, k)
 	}
-	pb.Info.CreatedAt = p.CreatedAt
-	// ModifiedBy ->
-	if p.ModifiedBy != "" {
-		if pb.Info == nil {
-			pb.Info = &Metadata{}
+	if dst.Attributes == nil && p.Attributes != nil {
+		dst.Attributes = make(map[string]string, len(p.Attributes))
+	}
+	for k, v := range p.Attributes {
+		dst.Attributes[k] = v
+	}
+	if cap(dst.Locations) < len(p.Locations) {
+		dst.Locations = append(dst.Locations[:cap(dst.Locations)], make([]*Address, len(p.Locations)-cap(dst.Locations))...)
+	} else {
+		dst.Locations = dst.Locations[:len(p.Locations)]
+	}
+	for i, v := range p.Locations {
+		if v == nil {
+			dst.Locations[i] = nil
+			continue
 		}
-		pb.Info.ModifiedBy = p.ModifiedBy
+		if dst.Locations[i] == nil {
+			dst.Locations[i] = new(Address)
+		} else {
+			proto.Reset(dst.Locations[i])
+		}
+		proto.Merge(dst.Locations[i], v)
 	}
-	// ModifiedAt ->
-	if pb.Info == nil {
-		pb.Info = &Metadata{}
+	if p.Structure == nil {
+		dst.Structure = nil
+	} else {
+		if dst.Structure == nil {
+			dst.Structure = new(Level1)
+		} else {
+			proto.Reset(dst.Structure)
+		}
+		proto.Merge(dst.Structure, p.Structure)
 	}
-	pb.Info.ModifiedAt = p.ModifiedAt
-	// Labels ->
-	if pb.Info == nil {
-		pb.Info = &Metadata{}
+	if cap(dst.Children) < len(p.Children) {
+		dst.Children = make([]DocumentPlain, len(p.Children))
+	} else {
+		dst.Children = dst.Children[:len(p.Children)]
 	}
-	pb.Info.Labels = p.Labels
-	// Tags ->
-	if len(p.Tags) > 0 {
-		if pb.Info == nil {
-			pb.Info = &Metadata{}
+	for i := range p.Children {
+		p.Children[i].CopyTo(&dst.Children[i])
+	}
+	if p.Parent == nil {
+		dst.Parent = nil
+	} else {
+		if dst.Parent == nil {
+			dst.Parent = new(DocumentPlain)
 		}
-		pb.Info.Tags = p.Tags
+		p.Parent.CopyTo(dst.Parent)
+	}
+	if p.ContentTextContent == nil {
+		dst.ContentTextContent = nil
+	} else {
+		if dst.ContentTextContent == nil {
+			dst.ContentTextContent = new(TextContent)
+		} else {
+			proto.Reset(dst.ContentTextContent)
+		}
+		proto.Merge(dst.ContentTextContent, p.ContentTextContent)
+	}
+	if p.ContentImageContent == nil {
+		dst.ContentImageContent = nil
+	} else {
+		if dst.ContentImageContent == nil {
+			dst.ContentImageContent = new(ImageContent)
+		} else {
+			proto.Reset(dst.ContentImageContent)
+		}
+		proto.Merge(dst.ContentImageContent, p.ContentImageContent)
 	}
-	// TextText -> text.text
-	if p.TextText != nil && p.PayloadCase == "text" {
-		pb.Payload = &TreeNode_Text{Text: p.TextText}
+	if p.ContentVideoContent == nil {
+		dst.ContentVideoContent = nil
+	} else {
+		if dst.ContentVideoContent == nil {
+			dst.ContentVideoContent = new(VideoContent)
+		} else {
+			proto.Reset(dst.ContentVideoContent)
+		}
+		proto.Merge(dst.ContentVideoContent, p.ContentVideoContent)
 	}
-	// ImageImage -> image.image
-	if p.ImageImage != nil && p.PayloadCase == "image" {
-		pb.Payload = &TreeNode_Image{Image: p.ImageImage}
+	if p.ContentCodeContent == nil {
+		dst.ContentCodeContent = nil
+	} else {
+		if dst.ContentCodeContent == nil {
+			dst.ContentCodeContent = new(CodeContent)
+		} else {
+			proto.Reset(dst.ContentCodeContent)
+		}
+		proto.Merge(dst.ContentCodeContent, p.ContentCodeContent)
 	}
-	// CodeCode -> code.code
-	if p.CodeCode != nil && p.PayloadCase == "code" {
-		pb.Payload = &TreeNode_Code{Code: p.CodeCode}
+	if p.ContentTableContent == nil {
+		dst.ContentTableContent = nil
+	} else {
+		if dst.ContentTableContent == nil {
+			dst.ContentTableContent = new(TableContent)
+		} else {
+			proto.Reset(dst.ContentTableContent)
+		}
+		proto.Merge(dst.ContentTableContent, p.ContentTableContent)
 	}
-	return pb
+	dst.SourceUrl = p.SourceUrl
+	dst.SourceFilePath = p.SourceFilePath
+	dst.SourceRawData = append(dst.SourceRawData[:0], p.SourceRawData...)
+	dst.ComputedHash = p.ComputedHash
+	dst.IsValid = p.IsValid
 }
 
-// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
-func (pb *TreeNode) IntoPlainReuse(p *TreeNodePlain) {
-	if pb == nil || p == nil {
-		return
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *DocumentPlain) Equal(other *DocumentPlain) bool {
+	if p == nil || other == nil {
+		return p == other
 	}
-	// Reset before filling
-	p.Reset()
-
-	// Detect payload oneof case
-	switch pb.Payload.(type) {
-	case *TreeNode_Text:
-		p.PayloadCase = "text"
-	case *TreeNode_Image:
-		p.PayloadCase = "image"
-	case *TreeNode_Code:
-		p.PayloadCase = "code"
+	if p.ContentCase != other.ContentCase {
+		return false
 	}
-
-	p.Id = pb.Id
-	p.Name = pb.Name
-	p.Type = pb.Type
-	if len(pb.Children) > 0 {
-		p.Children = make([]TreeNodePlain, len(pb.Children))
-		for i, v := range pb.Children {
-			if v != nil {
-				p.Children[i] = *v.IntoPlain()
-			}
-		}
+	if p.SourceCase != other.SourceCase {
+		return false
 	}
-	if pb.Parent != nil {
-		p.Parent = pb.Parent.IntoPlain()
+	if p.Id != other.Id {
+		return false
 	}
-	// CreatedBy from
-	if pb.GetInfo() != nil {
-		p.CreatedBy = pb.GetInfo().GetCreatedBy()
+	if p.Title != other.Title {
+		return false
 	}
-	// CreatedAt from
-	if pb.GetInfo() != nil {
-		p.CreatedAt = pb.GetInfo().GetCreatedAt()
+	if p.Status != other.Status {
+		return false
 	}
-	// ModifiedBy from
-	if pb.GetInfo() != nil {
-		p.ModifiedBy = pb.GetInfo().GetModifiedBy()
+	if p.Priority != other.Priority {
+		return false
 	}
-	// ModifiedAt from
-	if pb.GetInfo() != nil {
-		p.ModifiedAt = pb.GetInfo().GetModifiedAt()
+	if p.Description != other.Description {
+		return false
 	}
-	// Labels from
-	if pb.GetInfo() != nil && pb.GetInfo().GetLabels() != nil {
-		p.Labels = pb.GetInfo().GetLabels()
+	if p.Version != other.Version {
+		return false
 	}
-	// Tags from
-	if pb.GetInfo() != nil {
-		p.Tags = pb.GetInfo().GetTags()
+	if p.IsPublic != other.IsPublic {
+		return false
 	}
-	// TextText from text.text
-	if pb.GetText() != nil {
-		p.TextText = pb.GetText()
+	if p.Email != other.Email {
+		return false
 	}
-	// ImageImage from image.image
-	if pb.GetImage() != nil {
-		p.ImageImage = pb.GetImage()
+	if p.Phone != other.Phone {
+		return false
 	}
-	// CodeCode from code.code
-	if pb.GetCode() != nil {
-		p.CodeCode = pb.GetCode()
+	if !proto.Equal(p.Address, other.Address) {
+		return false
 	}
-}
-
-// MarshalJX encodes TreeNodePlain to JSON using jx.Encoder
-func (p *TreeNodePlain) MarshalJX(e *jx.Encoder) {
-	if p == nil {
-		e.Null()
-		return
+	if !proto.Equal(p.Metadata, other.Metadata) {
+		return false
 	}
-
-	e.ObjStart()
-
-	if p.PayloadCase != "" {
-		e.FieldStart("payload_case")
-		e.Str(p.PayloadCase)
+	if !bytes.Equal(p.Performance, other.Performance) {
+		return false
 	}
-	if p.Id != "" {
-		e.FieldStart("id")
-		e.Str(p.Id)
+	if !slices.Equal(p.Keywords, other.Keywords) {
+		return false
 	}
-	if p.Name != "" {
-		e.FieldStart("name")
-		e.Str(p.Name)
+	if !maps.Equal(p.Attributes, other.Attributes) {
+		return false
 	}
-	if p.Type != "" {
-		e.FieldStart("type")
-		e.Str(p.Type)
+	if len(p.Locations) != len(other.Locations) {
+		return false
 	}
-	if len(p.Children) > 0 {
-		e.FieldStart("children")
-		e.ArrStart()
-		for _, v := range p.Children {
-			(&v).MarshalJX(e)
+	for i := range p.Locations {
+		if !proto.Equal(p.Locations[i], other.Locations[i]) {
+			return false
 		}
-		e.ArrEnd()
 	}
-	if p.Parent != nil {
-		e.FieldStart("parent")
-		p.Parent.MarshalJX(e)
+	if !proto.Equal(p.Structure, other.Structure) {
+		return false
 	}
-	if p.CreatedBy != "" {
-		e.FieldStart("createdBy")
-		e.Str(p.CreatedBy)
+	if len(p.Children) != len(other.Children) {
+		return false
 	}
-	if p.CreatedAt != 0 {
-		e.FieldStart("createdAt")
-		e.Int64(p.CreatedAt)
+	for i := range p.Children {
+		if !p.Children[i].Equal(&other.Children[i]) {
+			return false
+		}
 	}
-	if p.ModifiedBy != "" {
-		e.FieldStart("modifiedBy")
-		e.Str(p.ModifiedBy)
+	if !p.Parent.Equal(other.Parent) {
+		return false
 	}
-	if p.ModifiedAt != 0 {
-		e.FieldStart("modifiedAt")
-		e.Int64(p.ModifiedAt)
+	if !proto.Equal(p.ContentTextContent, other.ContentTextContent) {
+		return false
 	}
-	e.FieldStart("labels")
-	e.ObjStart()
-	for k, v := range p.Labels {
-		e.FieldStart(k)
-		e.Str(v)
+	if !proto.Equal(p.ContentImageContent, other.ContentImageContent) {
+		return false
 	}
-	e.ObjEnd()
-	if len(p.Tags) > 0 {
-		e.FieldStart("tags")
-		e.ArrStart()
-		for _, v := range p.Tags {
-			e.Str(v)
-		}
-		e.ArrEnd()
+	if !proto.Equal(p.ContentVideoContent, other.ContentVideoContent) {
+		return false
 	}
-	if p.TextText != nil {
-		e.FieldStart("textText")
-		p.TextText.MarshalJX(e)
+	if !proto.Equal(p.ContentCodeContent, other.ContentCodeContent) {
+		return false
 	}
-	if p.ImageImage != nil {
-		e.FieldStart("imageImage")
-		p.ImageImage.MarshalJX(e)
+	if !proto.Equal(p.ContentTableContent, other.ContentTableContent) {
+		return false
 	}
-	if p.CodeCode != nil {
-		e.FieldStart("codeCode")
-		p.CodeCode.MarshalJX(e)
+	if p.SourceUrl != other.SourceUrl {
+		return false
 	}
-	e.ObjEnd()
-}
-
-// MarshalJSON implements json.Marshaler using jx
-func (p *TreeNodePlain) MarshalJSON() ([]byte, error) {
-	e := jx.GetEncoder()
-	defer jx.PutEncoder(e)
-	p.MarshalJX(e)
-	return e.Bytes(), nil
+	if p.SourceFilePath != other.SourceFilePath {
+		return false
+	}
+	if !bytes.Equal(p.SourceRawData, other.SourceRawData) {
+		return false
+	}
+	if p.ComputedHash != other.ComputedHash {
+		return false
+	}
+	if p.IsValid != other.IsValid {
+		return false
+	}
+	return true
 }
 
-// UnmarshalJX decodes TreeNodePlain from JSON using jx.Decoder
-func (p *TreeNodePlain) UnmarshalJX(d *jx.Decoder) error {
-	if p == nil {
-		return nil
+// Columns returns the column names of DocumentPlain rows, in the order of ScanRow and Values
+func (p *DocumentPlain) Columns() []string {
+	return []string{
+		"id",
+		"title",
+		"status",
+		"priority",
+		"description",
+		"version",
+		"is_public",
+		"email",
+		"phone",
+		"address",
+		"metadata",
+		"performance",
+		"keywords",
+		"attributes",
+		"locations",
+		"structure",
+		"children",
+		"parent",
+		"content_text_content",
+		"content_image_content",
+		"content_video_content",
+		"content_code_content",
+		"content_table_content",
+		"source_url",
+		"source_file_path",
+		"source_raw_data",
+		"computed_hash",
+		"is_valid",
+		"content_case",
+		"source_case",
 	}
+}
 
-	return d.Obj(func(d *jx.Decoder, key string) error {
-		switch key {
-		case "payload_case":
-			v, err := d.Str()
-			if err != nil {
-				return err
-			}
-			p.PayloadCase = v
-		case "id":
-			v, err := d.Str()
-			if err != nil {
-				return err
-			}
-			p.Id = v
-		case "name":
-			v, err := d.Str()
-			if err != nil {
-				return err
-			}
-			p.Name = v
-		case "type":
-			v, err := d.Str()
-			if err != nil {
-				return err
-			}
-			p.Type = v
-		case "children":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				var v TreeNodePlain
-				if err := v.UnmarshalJX(d); err != nil {
-					return err
-				}
-				p.Children = append(p.Children, v)
-				return nil
-			}); err != nil {
-				return err
-			}
-		case "parent":
-			p.Parent = &TreeNodePlain{}
-			if err := p.Parent.UnmarshalJX(d); err != nil {
-				return err
-			}
-		case "createdBy":
-			v, err := d.Str()
-			if err != nil {
-				return err
-			}
-			p.CreatedBy = v
-		case "createdAt":
-			v, err := d.Int64()
-			if err != nil {
-				return err
-			}
-			p.CreatedAt = v
-		case "modifiedBy":
-			v, err := d.Str()
-			if err != nil {
-				return err
-			}
-			p.ModifiedBy = v
-		case "modifiedAt":
-			v, err := d.Int64()
-			if err != nil {
-				return err
-			}
-			p.ModifiedAt = v
-		case "labels":
-			if p.Labels == nil {
-				p.Labels = make(map[string]string)
-			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Labels[key] = v
-				return nil
-			})
-		case "tags":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Tags = append(p.Tags, v)
-				return nil
-			}); err != nil {
-				return err
-			}
-		case "textText":
-			p.TextText = &TextContent{}
-			if err := p.TextText.UnmarshalJX(d); err != nil {
-				return err
-			}
-		case "imageImage":
-			p.ImageImage = &ImageContent{}
-			if err := p.ImageImage.UnmarshalJX(d); err != nil {
-				return err
-			}
-		case "codeCode":
-			p.CodeCode = &CodeContent{}
-			if err := p.CodeCode.UnmarshalJX(d); err != nil {
-				return err
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	})
+// ScanRow scans a row with the columns of Columns into DocumentPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *DocumentPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Title,
+		&p.Status,
+		&p.Priority,
+		&p.Description,
+		&p.Version,
+		&p.IsPublic,
+		&p.Email,
+		&p.Phone,
+		plainsql.JSON(&p.Address),
+		plainsql.JSON(&p.Metadata),
+		&p.Performance,
+		plainsql.JSON(&p.Keywords),
+		plainsql.JSON(&p.Attributes),
+		plainsql.JSON(&p.Locations),
+		plainsql.JSON(&p.Structure),
+		plainsql.JSON(&p.Children),
+		plainsql.JSON(&p.Parent),
+		plainsql.JSON(&p.ContentTextContent),
+		plainsql.JSON(&p.ContentImageContent),
+		plainsql.JSON(&p.ContentVideoContent),
+		plainsql.JSON(&p.ContentCodeContent),
+		plainsql.JSON(&p.ContentTableContent),
+		&p.SourceUrl,
+		&p.SourceFilePath,
+		&p.SourceRawData,
+		&p.ComputedHash,
+		&p.IsValid,
+		&p.ContentCase,
+		&p.SourceCase,
+	)
 }
 
-// UnmarshalJSON implements json.Unmarshaler using jx
-func (p *TreeNodePlain) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return p.UnmarshalJX(d)
+// Values returns the column values of DocumentPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *DocumentPlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Title,
+		p.Status,
+		p.Priority,
+		p.Description,
+		p.Version,
+		p.IsPublic,
+		p.Email,
+		p.Phone,
+		plainsql.JSON(&p.Address),
+		plainsql.JSON(&p.Metadata),
+		p.Performance,
+		plainsql.JSON(&p.Keywords),
+		plainsql.JSON(&p.Attributes),
+		plainsql.JSON(&p.Locations),
+		plainsql.JSON(&p.Structure),
+		plainsql.JSON(&p.Children),
+		plainsql.JSON(&p.Parent),
+		plainsql.JSON(&p.ContentTextContent),
+		plainsql.JSON(&p.ContentImageContent),
+		plainsql.JSON(&p.ContentVideoContent),
+		plainsql.JSON(&p.ContentCodeContent),
+		plainsql.JSON(&p.ContentTableContent),
+		p.SourceUrl,
+		p.SourceFilePath,
+		p.SourceRawData,
+		p.ComputedHash,
+		p.IsValid,
+		p.ContentCase,
+		p.SourceCase,
+	}
 }
 
-// treeNodePlainPool is a sync.Pool for TreeNodePlain objects
-var treeNodePlainPool = sync.Pool{
+// documentPlainPool is a sync.Pool for DocumentPlain objects
+var documentPlainPool = sync.Pool{
 	New: func() interface{} {
-		return &TreeNodePlain{}
+		return &DocumentPlain{}
 	},
 }
 
-// GetTreeNodePlain returns a TreeNodePlain from the pool
-func GetTreeNodePlain() *TreeNodePlain {
-	return treeNodePlainPool.Get().(*TreeNodePlain)
+// GetDocumentPlain returns a DocumentPlain from the pool
+func GetDocumentPlain() *DocumentPlain {
+	return documentPlainPool.Get().(*DocumentPlain)
 }
 
-// PutTreeNodePlain returns a TreeNodePlain to the pool after resetting it
-func PutTreeNodePlain(p *TreeNodePlain) {
+// PutDocumentPlain returns a DocumentPlain to the pool after resetting it
+func PutDocumentPlain(p *DocumentPlain) {
 	if p == nil {
 		return
 	}
 	p.Reset()
-	treeNodePlainPool.Put(p)
+	documentPlainPool.Put(p)
 }
 
-// Reset clears all fields in TreeNodePlain for reuse
-func (p *TreeNodePlain) Reset() {
+// Reset clears all fields in DocumentPlain for reuse
+func (p *DocumentPlain) Reset() {
 	if p == nil {
 		return
 	}
 
-	p.PayloadCase = ""
+	p.ContentCase = ""
+	p.SourceCase = ""
 	p.Id = ""
-	p.Name = ""
-	p.Type = ""
+	p.Title = ""
+	p.Status = 0
+	p.Priority = 0
+	p.Description = ""
+	p.Version = 0
+	p.IsPublic = false
+	p.Email = ""
+	p.Phone = ""
+	p.Address = nil
+	p.Metadata = nil
+	p.Performance = nil
+	p.Keywords = p.Keywords[:0]
+	for k := range p.Attributes {
+		delete(p.Attributes, k)
+	}
+	p.Locations = nil
+	p.Structure = nil
 	p.Children = p.Children[:0]
 	p.Parent = nil
-	p.CreatedBy = ""
-	p.CreatedAt = 0
-	p.ModifiedBy = ""
-	p.ModifiedAt = 0
-	for k := range p.Labels {
-		delete(p.Labels, k)
-	}
-	p.Tags = p.Tags[:0]
-	p.TextText = nil
-	p.ImageImage = nil
-	p.CodeCode = nil
+	p.ContentTextContent = nil
+	p.ContentImageContent = nil
+	p.ContentVideoContent = nil
+	p.ContentCodeContent = nil
+	p.ContentTableContent = nil
+	p.SourceUrl = ""
+	p.SourceFilePath = ""
+	p.SourceRawData = nil
+	p.ComputedHash = ""
+	p.IsValid = false
 }
 
-type EventPlain struct {
-	EventId                      string               `json:"eventId"`
-	EventType                    string               `json:"eventType"`
-	Timestamp                    int64                `json:"timestamp"`
-	Source                       string               `json:"source"`
-	Meta                         *Metadata            `json:"meta"`
-	UserCreatedUserCreated       *UserCreatedEvent    `json:"userCreatedUserCreated"`       // origin: oneof_embed, empath: user_created.user_created
-	UserUpdatedUserUpdated       *UserUpdatedEvent    `json:"userUpdatedUserUpdated"`       // origin: oneof_embed, empath: user_updated.user_updated
-	UserDeletedUserDeleted       *UserDeletedEvent    `json:"userDeletedUserDeleted"`       // origin: oneof_embed, empath: user_deleted.user_deleted
-	OrderCreatedOrderCreated     *OrderCreatedEvent   `json:"orderCreatedOrderCreated"`     // origin: oneof_embed, empath: order_created.order_created
-	OrderCompletedOrderCompleted *OrderCompletedEvent `json:"orderCompletedOrderCompleted"` // origin: oneof_embed, empath: order_completed.order_completed
+type TreeNodePlain struct {
+	Id           string            `json:"id"`
+	Name         string            `json:"name"`
+	Type         string            `json:"type"`
+	Children     []TreeNodePlain   `json:"children"`
+	Parent       *TreeNodePlain    `json:"parent"`
+	CreatedBy    string            `json:"createdBy"`
+	CreatedAt    int64             `json:"createdAt"`
+	ModifiedBy   string            `json:"modifiedBy"`
+	ModifiedAt   int64             `json:"modifiedAt"`
+	Labels       map[string]string `json:"labels"`
+	Tags         []string          `json:"tags"`
+	PayloadText  *TextContent      `json:"payloadText"`  // origin: oneof_embed, empath: payload.text
+	PayloadImage *ImageContent     `json:"payloadImage"` // origin: oneof_embed, empath: payload.image
+	PayloadCode  *CodeContent      `json:"payloadCode"`  // origin: oneof_embed, empath: payload.code
 	// PayloadCase indicates which variant of payload oneof is set
-	PayloadCase string `json:"payload_case,omitempty"`
+	PayloadCase TreeNodePayloadCase `json:"payload_case,omitempty"`
+}
+
+// TreeNodePayloadCase identifies the set variant of full.TreeNode.payload oneof, empty when none is set
+type TreeNodePayloadCase string
+
+const (
+	TreeNodePayloadCaseText  TreeNodePayloadCase = "text"
+	TreeNodePayloadCaseImage TreeNodePayloadCase = "image"
+	TreeNodePayloadCaseCode  TreeNodePayloadCase = "code"
+)
+
+// Valid reports whether c is one of the TreeNodePayloadCase variants
+func (c TreeNodePayloadCase) Valid() bool {
+	switch c {
+	case TreeNodePayloadCaseText, TreeNodePayloadCaseImage, TreeNodePayloadCaseCode:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c TreeNodePayloadCase) String() string {
+	return string(c)
 }
 
 // IntoPlain converts protobuf message to plain struct
-func (pb *Event) IntoPlain() *EventPlain {
+func (pb *TreeNode) IntoPlain() *TreeNodePlain {
 	if pb == nil {
 		return nil
 	}
-	p := &EventPlain{}
+	p := &TreeNodePlain{}
 
 	// Detect payload oneof case
 	switch pb.Payload.(type) {
-	case *Event_UserCreated:
-		p.PayloadCase = "user_created"
-	case *Event_UserUpdated:
-		p.PayloadCase = "user_updated"
-	case *Event_UserDeleted:
-		p.PayloadCase = "user_deleted"
-	case *Event_OrderCreated:
-		p.PayloadCase = "order_created"
-	case *Event_OrderCompleted:
-		p.PayloadCase = "order_completed"
-	}
-
-	p.EventId = pb.EventId
-	p.EventType = pb.EventType
-	p.Timestamp = pb.Timestamp
-	p.Source = pb.Source
-	p.Meta = pb.Meta
-	// UserCreatedUserCreated from user_created.user_created
-	if pb.GetUserCreated() != nil {
-		p.UserCreatedUserCreated = pb.GetUserCreated()
+	case *TreeNode_Text:
+		p.PayloadCase = TreeNodePayloadCaseText
+	case *TreeNode_Image:
+		p.PayloadCase = TreeNodePayloadCaseImage
+	case *TreeNode_Code:
+		p.PayloadCase = TreeNodePayloadCaseCode
 	}
-	// UserUpdatedUserUpdated from user_updated.user_updated
-	if pb.GetUserUpdated() != nil {
-		p.UserUpdatedUserUpdated = pb.GetUserUpdated()
+
+	p.Id = pb.Id
+	p.Name = pb.Name
+	p.Type = pb.Type
+	if len(pb.Children) > 0 {
+		p.Children = make([]TreeNodePlain, len(pb.Children))
+		for i, v := range pb.Children {
+			if v != nil {
+				p.Children[i] = *v.IntoPlain()
+			}
+		}
+	} else {
+		p.Children = []TreeNodePlain{}
 	}
-	// UserDeletedUserDeleted from user_deleted.user_deleted
-	if pb.GetUserDeleted() != nil {
-		p.UserDeletedUserDeleted = pb.GetUserDeleted()
+	if pb.Parent != nil {
+		p.Parent = pb.Parent.IntoPlain()
 	}
-	// OrderCreatedOrderCreated from order_created.order_created
-	if pb.GetOrderCreated() != nil {
-		p.OrderCreatedOrderCreated = pb.GetOrderCreated()
+	// CreatedBy from
+	if pb.GetInfo() != nil {
+		p.CreatedBy = pb.GetInfo().GetCreatedBy()
 	}
-	// OrderCompletedOrderCompleted from order_completed.order_completed
-	if pb.GetOrderCompleted() != nil {
-		p.OrderCompletedOrderCompleted = pb.GetOrderCompleted()
+	// CreatedAt from
+	if pb.GetInfo() != nil {
+		p.CreatedAt = pb.GetInfo().GetCreatedAt()
+	}
+	// ModifiedBy from
+	if pb.GetInfo() != nil {
+		p.ModifiedBy = pb.GetInfo().GetModifiedBy()
+	}
+	// ModifiedAt from
+	if pb.GetInfo() != nil {
+		p.ModifiedAt = pb.GetInfo().GetModifiedAt()
+	}
+	// Labels from
+	if pb.GetInfo() != nil && pb.GetInfo().GetLabels() != nil {
+		p.Labels = pb.GetInfo().GetLabels()
+	}
+	// Tags from
+	if pb.GetInfo() != nil {
+		if len(pb.GetInfo().GetTags()) > 0 {
+			p.Tags = pb.GetInfo().GetTags()
+		} else {
+			p.Tags = []string{}
+		}
+	}
+	// PayloadText from payload.text
+	if pb.GetText() != nil {
+		p.PayloadText = pb.GetText()
+	}
+	// PayloadImage from payload.image
+	if pb.GetImage() != nil {
+		p.PayloadImage = pb.GetImage()
+	}
+	// PayloadCode from payload.code
+	if pb.GetCode() != nil {
+		p.PayloadCode = pb.GetCode()
 	}
 	return p
 }
 
 // IntoPb converts plain struct to protobuf message
-func (p *EventPlain) IntoPb() *Event {
+func (p *TreeNodePlain) IntoPb() *TreeNode {
 	if p == nil {
 		return nil
 	}
-	pb := &Event{}
+	pb := &TreeNode{}
 
-	pb.EventId = p.EventId
-	pb.EventType = p.EventType
-	pb.Timestamp = p.Timestamp
-	pb.Source = p.Source
-	pb.Meta = p.Meta
-	// UserCreatedUserCreated -> user_created.user_created
-	if p.UserCreatedUserCreated != nil && p.PayloadCase == "user_created" {
-		pb.Payload = &Event_UserCreated{UserCreated: p.UserCreatedUserCreated}
+	pb.Id = p.Id
+	pb.Name = p.Name
+	pb.Type = p.Type
+	if len(p.Children) > 0 {
+		pb.Children = make([]*TreeNode, len(p.Children))
+		for i := range p.Children {
+			pb.Children[i] = (&p.Children[i]).IntoPb()
+		}
+	}
+	if p.Parent != nil {
+		pb.Parent = p.Parent.IntoPb()
+	}
+	// CreatedBy ->
+	if p.CreatedBy != "" {
+		if pb.Info == nil {
+			pb.Info = &Metadata{}
+		}
+		pb.Info.CreatedBy = p.CreatedBy
+	}
+	// CreatedAt ->
+	if pb.Info == nil {
+		pb.Info = &Metadata{}
+	}
+	pb.Info.CreatedAt = p.CreatedAt
+	// ModifiedBy ->
+	if p.ModifiedBy != "" {
+		if pb.Info == nil {
+			pb.Info = &Metadata{}
+		}
+		pb.Info.ModifiedBy = p.ModifiedBy
+	}
+	// ModifiedAt ->
+	if pb.Info == nil {
+		pb.Info = &Metadata{}
+	}
+	pb.Info.ModifiedAt = p.ModifiedAt
+	// Labels ->
+	if len(p.Labels) > 0 {
+		if pb.Info == nil {
+			pb.Info = &Metadata{}
+		}
+		pb.Info.Labels = p.Labels
 	}
-	// UserUpdatedUserUpdated -> user_updated.user_updated
-	if p.UserUpdatedUserUpdated != nil && p.PayloadCase == "user_updated" {
-		pb.Payload = &Event_UserUpdated{UserUpdated: p.UserUpdatedUserUpdated}
+	// Tags ->
+	if len(p.Tags) > 0 {
+		if pb.Info == nil {
+			pb.Info = &Metadata{}
+		}
+		pb.Info.Tags = p.Tags
 	}
-	// UserDeletedUserDeleted -> user_deleted.user_deleted
-	if p.UserDeletedUserDeleted != nil && p.PayloadCase == "user_deleted" {
-		pb.Payload = &Event_UserDeleted{UserDeleted: p.UserDeletedUserDeleted}
+	// PayloadText -> payload.text
+	if p.PayloadText != nil && p.PayloadCase == TreeNodePayloadCaseText {
+		pb.Payload = &TreeNode_Text{Text: p.PayloadText}
 	}
-	// OrderCreatedOrderCreated -> order_created.order_created
-	if p.OrderCreatedOrderCreated != nil && p.PayloadCase == "order_created" {
-		pb.Payload = &Event_OrderCreated{OrderCreated: p.OrderCreatedOrderCreated}
+	// PayloadImage -> payload.image
+	if p.PayloadImage != nil && p.PayloadCase == TreeNodePayloadCaseImage {
+		pb.Payload = &TreeNode_Image{Image: p.PayloadImage}
 	}
-	// OrderCompletedOrderCompleted -> order_completed.order_completed
-	if p.OrderCompletedOrderCompleted != nil && p.PayloadCase == "order_completed" {
-		pb.Payload = &Event_OrderCompleted{OrderCompleted: p.OrderCompletedOrderCompleted}
+	// PayloadCode -> payload.code
+	if p.PayloadCode != nil && p.PayloadCase == TreeNodePayloadCaseCode {
+		pb.Payload = &TreeNode_Code{Code: p.PayloadCode}
 	}
 	return pb
 }
 
+// treeNodePlainMergePaths are the fields of TreeNode covered by TreeNodePlain
+var treeNodePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6, 1},
+	{6, 2},
+	{6, 3},
+	{6, 4},
+	{6, 5},
+	{6, 6},
+	{10},
+	{11},
+	{12},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *TreeNodePlain) IntoPbMerge(dst *TreeNode) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, treeNodePlainMergePaths...)
+}
+
+// treeNodePlainFieldPaths link the JSON names of TreeNodePlain to field-mask paths of TreeNode
+var treeNodePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "name", Path: "name"},
+	{Name: "type", Path: "type"},
+	{Name: "children", Path: "children"},
+	{Name: "parent", Path: "parent"},
+	{Name: "createdBy", Path: "info.created_by"},
+	{Name: "createdAt", Path: "info.created_at"},
+	{Name: "modifiedBy", Path: "info.modified_by"},
+	{Name: "modifiedAt", Path: "info.modified_at"},
+	{Name: "labels", Path: "info.labels"},
+	{Name: "tags", Path: "info.tags"},
+	{Name: "payloadText", Path: "text"},
+	{Name: "payloadImage", Path: "image"},
+	{Name: "payloadCode", Path: "code"},
+}
+
+// TreeNodePlainFieldMask builds a field mask of TreeNode from JSON names of TreeNodePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func TreeNodePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(treeNodePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// TreeNodePlainFieldNames translates a field mask of TreeNode into JSON names of TreeNodePlain.
+// A path to an embedded message yields all fields flattened from it.
+func TreeNodePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(treeNodePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of TreeNode, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *TreeNodePlain) ApplyToPb(pb *TreeNode, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), treeNodePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *TreeNode) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*TreeNodePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), treeNodePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &TreeNode{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
 // IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
-func (pb *Event) IntoPlainReuse(p *EventPlain) {
+func (pb *TreeNode) IntoPlainReuse(p *TreeNodePlain) {
 	if pb == nil || p == nil {
 		return
 	}
@@ -1647,47 +3017,74 @@ func (pb *Event) IntoPlainReuse(p *EventPlain) {
 
 	// Detect payload oneof case
 	switch pb.Payload.(type) {
-	case *Event_UserCreated:
-		p.PayloadCase = "user_created"
-	case *Event_UserUpdated:
-		p.PayloadCase = "user_updated"
-	case *Event_UserDeleted:
-		p.PayloadCase = "user_deleted"
-	case *Event_OrderCreated:
-		p.PayloadCase = "order_created"
-	case *Event_OrderCompleted:
-		p.PayloadCase = "order_completed"
+	case *TreeNode_Text:
+		p.PayloadCase = TreeNodePayloadCaseText
+	case *TreeNode_Image:
+		p.PayloadCase = TreeNodePayloadCaseImage
+	case *TreeNode_Code:
+		p.PayloadCase = TreeNodePayloadCaseCode
 	}
 
-	p.EventId = pb.EventId
-	p.EventType = pb.EventType
-	p.Timestamp = pb.Timestamp
-	p.Source = pb.Source
-	p.Meta = pb.Meta
-	// UserCreatedUserCreated from user_created.user_created
-	if pb.GetUserCreated() != nil {
-		p.UserCreatedUserCreated = pb.GetUserCreated()
+	p.Id = pb.Id
+	p.Name = pb.Name
+	p.Type = pb.Type
+	if len(pb.Children) > 0 {
+		p.Children = make([]TreeNodePlain, len(pb.Children))
+		for i, v := range pb.Children {
+			if v != nil {
+				p.Children[i] = *v.IntoPlain()
+			}
+		}
+	} else {
+		p.Children = []TreeNodePlain{}
 	}
-	// UserUpdatedUserUpdated from user_updated.user_updated
-	if pb.GetUserUpdated() != nil {
-		p.UserUpdatedUserUpdated = pb.GetUserUpdated()
+	if pb.Parent != nil {
+		p.Parent = pb.Parent.IntoPlain()
 	}
-	// UserDeletedUserDeleted from user_deleted.user_deleted
-	if pb.GetUserDeleted() != nil {
-		p.UserDeletedUserDeleted = pb.GetUserDeleted()
+	// CreatedBy from
+	if pb.GetInfo() != nil {
+		p.CreatedBy = pb.GetInfo().GetCreatedBy()
 	}
-	// OrderCreatedOrderCreated from order_created.order_created
-	if pb.GetOrderCreated() != nil {
-		p.OrderCreatedOrderCreated = pb.GetOrderCreated()
+	// CreatedAt from
+	if pb.GetInfo() != nil {
+		p.CreatedAt = pb.GetInfo().GetCreatedAt()
 	}
-	// OrderCompletedOrderCompleted from order_completed.order_completed
-	if pb.GetOrderCompleted() != nil {
-		p.OrderCompletedOrderCompleted = pb.GetOrderCompleted()
+	// ModifiedBy from
+	if pb.GetInfo() != nil {
+		p.ModifiedBy = pb.GetInfo().GetModifiedBy()
+	}
+	// ModifiedAt from
+	if pb.GetInfo() != nil {
+		p.ModifiedAt = pb.GetInfo().GetModifiedAt()
+	}
+	// Labels from
+	if pb.GetInfo() != nil && pb.GetInfo().GetLabels() != nil {
+		p.Labels = pb.GetInfo().GetLabels()
+	}
+	// Tags from
+	if pb.GetInfo() != nil {
+		if len(pb.GetInfo().GetTags()) > 0 {
+			p.Tags = pb.GetInfo().GetTags()
+		} else {
+			p.Tags = []string{}
+		}
+	}
+	// PayloadText from payload.text
+	if pb.GetText() != nil {
+		p.PayloadText = pb.GetText()
+	}
+	// PayloadImage from payload.image
+	if pb.GetImage() != nil {
+		p.PayloadImage = pb.GetImage()
+	}
+	// PayloadCode from payload.code
+	if pb.GetCode() != nil {
+		p.PayloadCode = pb.GetCode()
 	}
 }
 
-// MarshalJX encodes EventPlain to JSON using jx.Encoder
-func (p *EventPlain) MarshalJX(e *jx.Encoder) {
+// MarshalJX encodes TreeNodePlain to JSON using jx.Encoder
+func (p *TreeNodePlain) MarshalJX(e *jx.Encoder) {
 	if p == nil {
 		e.Null()
 		return
@@ -1697,61 +3094,88 @@ func (p *EventPlain) MarshalJX(e *jx.Encoder) {
 
 	if p.PayloadCase != "" {
 		e.FieldStart("payload_case")
-		e.Str(p.PayloadCase)
+		e.Str(p.PayloadCase.String())
 	}
-	if p.EventId != "" {
-		e.FieldStart("eventId")
-		e.Str(p.EventId)
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
 	}
-	if p.EventType != "" {
-		e.FieldStart("eventType")
-		e.Str(p.EventType)
+	if p.Name != "" {
+		e.FieldStart("name")
+		e.Str(p.Name)
 	}
-	if p.Timestamp != 0 {
-		e.FieldStart("timestamp")
-		e.Int64(p.Timestamp)
+	if p.Type != "" {
+		e.FieldStart("type")
+		e.Str(p.Type)
 	}
-	if p.Source != "" {
-		e.FieldStart("source")
-		e.Str(p.Source)
+	if len(p.Children) > 0 {
+		e.FieldStart("children")
+		e.ArrStart()
+		for _, v := range p.Children {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
 	}
-	if p.Meta != nil {
-		e.FieldStart("meta")
-		p.Meta.MarshalJX(e)
+	if p.Parent != nil {
+		e.FieldStart("parent")
+		p.Parent.MarshalJX(e)
 	}
-	if p.UserCreatedUserCreated != nil {
-		e.FieldStart("userCreatedUserCreated")
-		p.UserCreatedUserCreated.MarshalJX(e)
+	if p.CreatedBy != "" {
+		e.FieldStart("createdBy")
+		e.Str(p.CreatedBy)
 	}
-	if p.UserUpdatedUserUpdated != nil {
-		e.FieldStart("userUpdatedUserUpdated")
-		p.UserUpdatedUserUpdated.MarshalJX(e)
+	if p.CreatedAt != 0 {
+		e.FieldStart("createdAt")
+		e.Int64(p.CreatedAt)
 	}
-	if p.UserDeletedUserDeleted != nil {
-		e.FieldStart("userDeletedUserDeleted")
-		p.UserDeletedUserDeleted.MarshalJX(e)
+	if p.ModifiedBy != "" {
+		e.FieldStart("modifiedBy")
+		e.Str(p.ModifiedBy)
 	}
-	if p.OrderCreatedOrderCreated != nil {
-		e.FieldStart("orderCreatedOrderCreated")
-		p.OrderCreatedOrderCreated.MarshalJX(e)
+	if p.ModifiedAt != 0 {
+		e.FieldStart("modifiedAt")
+		e.Int64(p.ModifiedAt)
 	}
-	if p.OrderCompletedOrderCompleted != nil {
-		e.FieldStart("orderCompletedOrderCompleted")
-		p.OrderCompletedOrderCompleted.MarshalJX(e)
+	e.FieldStart("labels")
+	e.ObjStart()
+	for k, v := range p.Labels {
+		e.FieldStart(k)
+		e.Str(v)
+	}
+	e.ObjEnd()
+	if len(p.Tags) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.Tags {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if p.PayloadText != nil {
+		e.FieldStart("payloadText")
+		p.PayloadText.MarshalJX(e)
+	}
+	if p.PayloadImage != nil {
+		e.FieldStart("payloadImage")
+		p.PayloadImage.MarshalJX(e)
+	}
+	if p.PayloadCode != nil {
+		e.FieldStart("payloadCode")
+		p.PayloadCode.MarshalJX(e)
 	}
 	e.ObjEnd()
 }
 
-// MarshalJSON implements json.Marshaler using jx
-func (p *EventPlain) MarshalJSON() ([]byte, error) {
-	e := jx.GetEncoder()
-	defer jx.PutEncoder(e)
-	p.MarshalJX(e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJX decodes EventPlain from JSON using jx.Decoder
-func (p *EventPlain) UnmarshalJX(d *jx.Decoder) error {
+// MarshalJSON implements json.Marshaler using jx
+func (p *TreeNodePlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes TreeNodePlain from JSON using jx.Decoder
+func (p *TreeNodePlain) UnmarshalJX(d *jx.Decoder) error {
 	if p == nil {
 		return nil
 	}
@@ -1763,59 +3187,106 @@ func (p *EventPlain) UnmarshalJX(d *jx.Decoder) error {
 			if err != nil {
 				return err
 			}
-			p.PayloadCase = v
-		case "eventId":
+			p.PayloadCase = TreeNodePayloadCase(v)
+			if v != "" && !p.PayloadCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.TreeNode.payload", v)
+			}
+		case "id":
 			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.EventId = v
-		case "eventType":
+			p.Id = v
+		case "name":
 			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.EventType = v
-		case "timestamp":
+			p.Name = v
+		case "type":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Type = v
+		case "children":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v TreeNodePlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Children = append(p.Children, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "parent":
+			p.Parent = &TreeNodePlain{}
+			if err := p.Parent.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "createdBy":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.CreatedBy = v
+		case "createdAt":
 			v, err := d.Int64()
 			if err != nil {
 				return err
 			}
-			p.Timestamp = v
-		case "source":
+			p.CreatedAt = v
+		case "modifiedBy":
 			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.Source = v
-		case "meta":
-			p.Meta = &Metadata{}
-			if err := p.Meta.UnmarshalJX(d); err != nil {
+			p.ModifiedBy = v
+		case "modifiedAt":
+			v, err := d.Int64()
+			if err != nil {
 				return err
 			}
-		case "userCreatedUserCreated":
-			p.UserCreatedUserCreated = &UserCreatedEvent{}
-			if err := p.UserCreatedUserCreated.UnmarshalJX(d); err != nil {
+			p.ModifiedAt = v
+		case "labels":
+			if p.Labels == nil {
+				p.Labels = make(map[string]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Labels[key] = v
+				return nil
+			}); err != nil {
 				return err
 			}
-		case "userUpdatedUserUpdated":
-			p.UserUpdatedUserUpdated = &UserUpdatedEvent{}
-			if err := p.UserUpdatedUserUpdated.UnmarshalJX(d); err != nil {
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
 				return err
 			}
-		case "userDeletedUserDeleted":
-			p.UserDeletedUserDeleted = &UserDeletedEvent{}
-			if err := p.UserDeletedUserDeleted.UnmarshalJX(d); err != nil {
+		case "payloadText":
+			p.PayloadText = &TextContent{}
+			if err := p.PayloadText.UnmarshalJX(d); err != nil {
 				return err
 			}
-		case "orderCreatedOrderCreated":
-			p.OrderCreatedOrderCreated = &OrderCreatedEvent{}
-			if err := p.OrderCreatedOrderCreated.UnmarshalJX(d); err != nil {
+		case "payloadImage":
+			p.PayloadImage = &ImageContent{}
+			if err := p.PayloadImage.UnmarshalJX(d); err != nil {
 				return err
 			}
-		case "orderCompletedOrderCompleted":
-			p.OrderCompletedOrderCompleted = &OrderCompletedEvent{}
-			if err := p.OrderCompletedOrderCompleted.UnmarshalJX(d); err != nil {
+		case "payloadCode":
+			p.PayloadCode = &CodeContent{}
+			if err := p.PayloadCode.UnmarshalJX(d); err != nil {
 				return err
 			}
 		default:
@@ -1826,386 +3297,806 @@ func (p *EventPlain) UnmarshalJX(d *jx.Decoder) error {
 }
 
 // UnmarshalJSON implements json.Unmarshaler using jx
-func (p *EventPlain) UnmarshalJSON(data []byte) error {
+func (p *TreeNodePlain) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return p.UnmarshalJX(d)
 }
 
-// eventPlainPool is a sync.Pool for EventPlain objects
-var eventPlainPool = sync.Pool{
+// MarshalProto encodes TreeNodePlain in the protobuf wire format of TreeNode
+func (p *TreeNodePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends TreeNodePlain encoded in the protobuf wire format of TreeNode to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *TreeNodePlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Name; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Type; v != "" {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	for i := range p.Children {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Children[i].AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Parent != nil {
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Parent.AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	// info (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		_start := len(b)
+		if v := p.CreatedBy; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.CreatedAt; v != 0 {
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.ModifiedBy; v != "" {
+			b = protowire.AppendTag(b, 3, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.ModifiedAt; v != 0 {
+			b = protowire.AppendTag(b, 4, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		for _, k := range plainwire.SortedKeys(p.Labels) {
+			v := p.Labels[k]
+			b = protowire.AppendTag(b, 5, protowire.BytesType)
+			_start := len(b)
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, k)
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+			b = plainwire.FinishLen(b, _start)
+		}
+		for _, v := range p.Tags {
+			b = protowire.AppendTag(b, 6, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	if p.PayloadCase == TreeNodePayloadCaseText {
+		if p.PayloadText != nil {
+			b = protowire.AppendTag(b, 10, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.PayloadText); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.PayloadCase == TreeNodePayloadCaseImage {
+		if p.PayloadImage != nil {
+			b = protowire.AppendTag(b, 11, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.PayloadImage); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.PayloadCase == TreeNodePayloadCaseCode {
+		if p.PayloadCode != nil {
+			b = protowire.AppendTag(b, 12, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.PayloadCode); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes TreeNodePlain from the protobuf wire format of TreeNode.
+// Unknown fields are skipped.
+func (p *TreeNodePlain) UnmarshalProto(b []byte) error {
+	*p = TreeNodePlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Name = v
+		case num == 3 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Type = v
+		case num == 4 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e TreeNodePlain
+			if err := e.UnmarshalProto(v); err != nil {
+				return err
+			}
+			p.Children = append(p.Children, e)
+		case num == 5 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.Parent = &TreeNodePlain{}
+			if err := p.Parent.UnmarshalProto(v); err != nil {
+				return err
+			}
+		case num == 6 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.CreatedBy = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.CreatedAt = int64(v)
+				case num == 3 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.ModifiedBy = v
+				case num == 4 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ModifiedAt = int64(v)
+				case num == 5 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					var mk string
+					var mv string
+					for b := v; len(b) > 0; {
+						num, typ, n := protowire.ConsumeTag(b)
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+						switch {
+						case num == 1 && typ == protowire.BytesType:
+							var v string
+							v, n = protowire.ConsumeString(b)
+							mk = v
+						case num == 2 && typ == protowire.BytesType:
+							var v string
+							v, n = protowire.ConsumeString(b)
+							mv = v
+						default:
+							n = protowire.ConsumeFieldValue(num, typ, b)
+						}
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+					}
+					if p.Labels == nil {
+						p.Labels = make(map[string]string)
+					}
+					p.Labels[mk] = mv
+				case num == 6 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.Tags = append(p.Tags, v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = TreeNodePayloadCaseText
+			m := &TextContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.PayloadText = m
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = TreeNodePayloadCaseImage
+			m := &ImageContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.PayloadImage = m
+		case num == 12 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = TreeNodePayloadCaseCode
+			m := &CodeContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.PayloadCode = m
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// treeNodePlainReflect describes TreeNodePlain as message full.plain.TreeNodePlain
+var treeNodePlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "TreeNodePlain",
+	plainreflect.Scalar(func(p *TreeNodePlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *TreeNodePlain) *string { return &p.Name }),
+	plainreflect.Scalar(func(p *TreeNodePlain) *string { return &p.Type }),
+	plainreflect.ValueList(func(p *TreeNodePlain) *[]TreeNodePlain { return &p.Children }),
+	plainreflect.Message(func(p *TreeNodePlain) **TreeNodePlain { return &p.Parent }),
+	plainreflect.Scalar(func(p *TreeNodePlain) *string { return &p.CreatedBy }),
+	plainreflect.Scalar(func(p *TreeNodePlain) *int64 { return &p.CreatedAt }),
+	plainreflect.Scalar(func(p *TreeNodePlain) *string { return &p.ModifiedBy }),
+	plainreflect.Scalar(func(p *TreeNodePlain) *int64 { return &p.ModifiedAt }),
+	plainreflect.Map(func(p *TreeNodePlain) *map[string]string { return &p.Labels }),
+	plainreflect.List(func(p *TreeNodePlain) *[]string { return &p.Tags }),
+	plainreflect.Message(func(p *TreeNodePlain) **TextContent { return &p.PayloadText }),
+	plainreflect.Message(func(p *TreeNodePlain) **ImageContent { return &p.PayloadImage }),
+	plainreflect.Message(func(p *TreeNodePlain) **CodeContent { return &p.PayloadCode }),
+	plainreflect.Case(func(p *TreeNodePlain) *TreeNodePayloadCase { return &p.PayloadCase }),
+)
+
+// ProtoReflect returns the reflective view of TreeNodePlain backed by the struct
+func (p *TreeNodePlain) ProtoReflect() protoreflect.Message {
+	return treeNodePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of TreeNodePlain
+func (p *TreeNodePlain) Clone() *TreeNodePlain {
+	if p == nil {
+		return nil
+	}
+	dst := &TreeNodePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of TreeNodePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetTreeNodePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *TreeNodePlain) CopyTo(dst *TreeNodePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.PayloadCase = p.PayloadCase
+	dst.Id = p.Id
+	dst.Name = p.Name
+	dst.Type = p.Type
+	if cap(dst.Children) < len(p.Children) {
+		dst.Children = make([]TreeNodePlain, len(p.Children))
+	} else {
+		dst.Children = dst.Children[:len(p.Children)]
+	}
+	for i := range p.Children {
+		p.Children[i].CopyTo(&dst.Children[i])
+	}
+	if p.Parent == nil {
+		dst.Parent = nil
+	} else {
+		if dst.Parent == nil {
+			dst.Parent = new(TreeNodePlain)
+		}
+		p.Parent.CopyTo(dst.Parent)
+	}
+	dst.CreatedBy = p.CreatedBy
+	dst.CreatedAt = p.CreatedAt
+	dst.ModifiedBy = p.ModifiedBy
+	dst.ModifiedAt = p.ModifiedAt
+	for k := range dst.Labels {
+		delete(dst.Labels, k)
+	}
+	if dst.Labels == nil && p.Labels != nil {
+		dst.Labels = make(map[string]string, len(p.Labels))
+	}
+	for k, v := range p.Labels {
+		dst.Labels[k] = v
+	}
+	dst.Tags = append(dst.Tags[:0], p.Tags...)
+	if p.PayloadText == nil {
+		dst.PayloadText = nil
+	} else {
+		if dst.PayloadText == nil {
+			dst.PayloadText = new(TextContent)
+		} else {
+			proto.Reset(dst.PayloadText)
+		}
+		proto.Merge(dst.PayloadText, p.PayloadText)
+	}
+	if p.PayloadImage == nil {
+		dst.PayloadImage = nil
+	} else {
+		if dst.PayloadImage == nil {
+			dst.PayloadImage = new(ImageContent)
+		} else {
+			proto.Reset(dst.PayloadImage)
+		}
+		proto.Merge(dst.PayloadImage, p.PayloadImage)
+	}
+	if p.PayloadCode == nil {
+		dst.PayloadCode = nil
+	} else {
+		if dst.PayloadCode == nil {
+			dst.PayloadCode = new(CodeContent)
+		} else {
+			proto.Reset(dst.PayloadCode)
+		}
+		proto.Merge(dst.PayloadCode, p.PayloadCode)
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *TreeNodePlain) Equal(other *TreeNodePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.PayloadCase != other.PayloadCase {
+		return false
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.Type != other.Type {
+		return false
+	}
+	if len(p.Children) != len(other.Children) {
+		return false
+	}
+	for i := range p.Children {
+		if !p.Children[i].Equal(&other.Children[i]) {
+			return false
+		}
+	}
+	if !p.Parent.Equal(other.Parent) {
+		return false
+	}
+	if p.CreatedBy != other.CreatedBy {
+		return false
+	}
+	if p.CreatedAt != other.CreatedAt {
+		return false
+	}
+	if p.ModifiedBy != other.ModifiedBy {
+		return false
+	}
+	if p.ModifiedAt != other.ModifiedAt {
+		return false
+	}
+	if !maps.Equal(p.Labels, other.Labels) {
+		return false
+	}
+	if !slices.Equal(p.Tags, other.Tags) {
+		return false
+	}
+	if !proto.Equal(p.PayloadText, other.PayloadText) {
+		return false
+	}
+	if !proto.Equal(p.PayloadImage, other.PayloadImage) {
+		return false
+	}
+	if !proto.Equal(p.PayloadCode, other.PayloadCode) {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of TreeNodePlain rows, in the order of ScanRow and Values
+func (p *TreeNodePlain) Columns() []string {
+	return []string{
+		"id",
+		"name",
+		"type",
+		"children",
+		"parent",
+		"created_by",
+		"created_at",
+		"modified_by",
+		"modified_at",
+		"labels",
+		"tags",
+		"payload_text",
+		"payload_image",
+		"payload_code",
+		"payload_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into TreeNodePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *TreeNodePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Name,
+		&p.Type,
+		plainsql.JSON(&p.Children),
+		plainsql.JSON(&p.Parent),
+		&p.CreatedBy,
+		&p.CreatedAt,
+		&p.ModifiedBy,
+		&p.ModifiedAt,
+		plainsql.JSON(&p.Labels),
+		plainsql.JSON(&p.Tags),
+		plainsql.JSON(&p.PayloadText),
+		plainsql.JSON(&p.PayloadImage),
+		plainsql.JSON(&p.PayloadCode),
+		&p.PayloadCase,
+	)
+}
+
+// Values returns the column values of TreeNodePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *TreeNodePlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Name,
+		p.Type,
+		plainsql.JSON(&p.Children),
+		plainsql.JSON(&p.Parent),
+		p.CreatedBy,
+		p.CreatedAt,
+		p.ModifiedBy,
+		p.ModifiedAt,
+		plainsql.JSON(&p.Labels),
+		plainsql.JSON(&p.Tags),
+		plainsql.JSON(&p.PayloadText),
+		plainsql.JSON(&p.PayloadImage),
+		plainsql.JSON(&p.PayloadCode),
+		p.PayloadCase,
+	}
+}
+
+// treeNodePlainPool is a sync.Pool for TreeNodePlain objects
+var treeNodePlainPool = sync.Pool{
 	New: func() interface{} {
-		return &EventPlain{}
+		return &TreeNodePlain{}
 	},
 }
 
-// GetEventPlain returns a EventPlain from the pool
-func GetEventPlain() *EventPlain {
-	return eventPlainPool.Get().(*EventPlain)
+// GetTreeNodePlain returns a TreeNodePlain from the pool
+func GetTreeNodePlain() *TreeNodePlain {
+	return treeNodePlainPool.Get().(*TreeNodePlain)
 }
 
-// PutEventPlain returns a EventPlain to the pool after resetting it
-func PutEventPlain(p *EventPlain) {
+// PutTreeNodePlain returns a TreeNodePlain to the pool after resetting it
+func PutTreeNodePlain(p *TreeNodePlain) {
 	if p == nil {
 		return
 	}
 	p.Reset()
-	eventPlainPool.Put(p)
+	treeNodePlainPool.Put(p)
 }
 
-// Reset clears all fields in EventPlain for reuse
-func (p *EventPlain) Reset() {
+// Reset clears all fields in TreeNodePlain for reuse
+func (p *TreeNodePlain) Reset() {
 	if p == nil {
 		return
 	}
 
 	p.PayloadCase = ""
-	p.EventId = ""
-	p.EventType = ""
-	p.Timestamp = 0
-	p.Source = ""
-	p.Meta = nil
-	p.UserCreatedUserCreated = nil
-	p.UserUpdatedUserUpdated = nil
-	p.UserDeletedUserDeleted = nil
-	p.OrderCreatedOrderCreated = nil
-	p.OrderCompletedOrderCompleted = nil
+	p.Id = ""
+	p.Name = ""
+	p.Type = ""
+	p.Children = p.Children[:0]
+	p.Parent = nil
+	p.CreatedBy = ""
+	p.CreatedAt = 0
+	p.ModifiedBy = ""
+	p.ModifiedAt = 0
+	for k := range p.Labels {
+		delete(p.Labels, k)
+	}
+	p.Tags = p.Tags[:0]
+	p.PayloadText = nil
+	p.PayloadImage = nil
+	p.PayloadCode = nil
 }
 
-type ConfigPlain struct {
-	DoubleVal        float64                         `json:"doubleVal"`
-	FloatVal         float32                         `json:"floatVal"`
-	Int32Val         int32                           `json:"int32Val"`
-	Int64Val         int64                           `json:"int64Val"`
-	Uint32Val        uint32                          `json:"uint32Val"`
-	Uint64Val        uint64                          `json:"uint64Val"`
-	Sint32Val        int32                           `json:"sint32Val"`
-	Sint64Val        int64                           `json:"sint64Val"`
-	Fixed32Val       uint32                          `json:"fixed32Val"`
-	Fixed64Val       uint64                          `json:"fixed64Val"`
-	Sfixed32Val      int32                           `json:"sfixed32Val"`
-	Sfixed64Val      int64                           `json:"sfixed64Val"`
-	BoolVal          bool                            `json:"boolVal"`
-	StringVal        string                          `json:"stringVal"`
-	BytesVal         []byte                          `json:"bytesVal"`
-	OptionalString   string                          `json:"optionalString,omitempty"`
-	OptionalInt      int32                           `json:"optionalInt,omitempty"`
-	OptionalBool     bool                            `json:"optionalBool,omitempty"`
-	OptionalDouble   float64                         `json:"optionalDouble,omitempty"`
-	OptionalBytes    []byte                          `json:"optionalBytes,omitempty"`
-	StringList       []string                        `json:"stringList"`
-	IntList          []int32                         `json:"intList"`
-	DoubleList       []float64                       `json:"doubleList"`
-	BytesList        [][]byte                        `json:"bytesList"`
-	BoolList         []bool                          `json:"boolList"`
-	FloatList        []float32                       `json:"floatList"`
-	Int64List        []int64                         `json:"int64List"`
-	Uint32List       []uint32                        `json:"uint32List"`
-	Uint64List       []uint64                        `json:"uint64List"`
-	StringMap        map[string]string               `json:"stringMap"`
-	IntMap           map[string]int32                `json:"intMap"`
-	IntKeyMap        map[int32]string                `json:"intKeyMap"`
-	NestedMap        map[string]*ConfigPlain         `json:"nestedMap"`
-	Int64KeyMap      map[int64]string                `json:"int64KeyMap"`
-	Uint32KeyMap     map[uint32]string               `json:"uint32KeyMap"`
-	Uint64KeyMap     map[uint64]string               `json:"uint64KeyMap"`
-	Sint32KeyMap     map[int32]string                `json:"sint32KeyMap"`
-	Sint64KeyMap     map[int64]string                `json:"sint64KeyMap"`
-	Fixed32KeyMap    map[uint32]string               `json:"fixed32KeyMap"`
-	Fixed64KeyMap    map[uint64]string               `json:"fixed64KeyMap"`
-	Sfixed32KeyMap   map[int32]string                `json:"sfixed32KeyMap"`
-	Sfixed64KeyMap   map[int64]string                `json:"sfixed64KeyMap"`
-	BoolKeyMap       map[bool]string                 `json:"boolKeyMap"`
-	DoubleMap        map[string]float64              `json:"doubleMap"`
-	BytesMap         map[string][]byte               `json:"bytesMap"`
-	BoolMap          map[string]bool                 `json:"boolMap"`
-	FloatMap         map[string]float32              `json:"floatMap"`
-	Status           Status                          `json:"status"`
-	StatusList       []Status                        `json:"statusList"`
-	StatusMap        map[string]Status               `json:"statusMap"`
-	OptionalStatus   Status                          `json:"optionalStatus,omitempty"`
-	NestedEnum       Config_NestedEnum               `json:"nestedEnum"`
-	NestedEnumList   []Config_NestedEnum             `json:"nestedEnumList"`
-	NestedConfig     *Config_NestedConfig            `json:"nestedConfig"`
-	NestedConfigList []*Config_NestedConfig          `json:"nestedConfigList"`
-	NestedConfigMap  map[string]*Config_NestedConfig `json:"nestedConfigMap"`
-	Parent           *ConfigPlain                    `json:"parent"`
-	Children         []ConfigPlain                   `json:"children"`
+type EventPlain struct {
+	EventId               string               `json:"eventId"`
+	EventType             string               `json:"eventType"`
+	Timestamp             int64                `json:"timestamp"`
+	Source                string               `json:"source"`
+	Meta                  *Metadata            `json:"meta"`
+	PayloadUserCreated    *UserCreatedEvent    `json:"payloadUserCreated"`    // origin: oneof_embed, empath: payload.user_created
+	PayloadUserUpdated    *UserUpdatedEvent    `json:"payloadUserUpdated"`    // origin: oneof_embed, empath: payload.user_updated
+	PayloadUserDeleted    *UserDeletedEvent    `json:"payloadUserDeleted"`    // origin: oneof_embed, empath: payload.user_deleted
+	PayloadOrderCreated   *OrderCreatedEvent   `json:"payloadOrderCreated"`   // origin: oneof_embed, empath: payload.order_created
+	PayloadOrderCompleted *OrderCompletedEvent `json:"payloadOrderCompleted"` // origin: oneof_embed, empath: payload.order_completed
+	// PayloadCase indicates which variant of payload oneof is set
+	PayloadCase EventPayloadCase `json:"payload_case,omitempty"`
+}
+
+// EventPayloadCase identifies the set variant of full.Event.payload oneof, empty when none is set
+type EventPayloadCase string
+
+const (
+	EventPayloadCaseUserCreated    EventPayloadCase = "user_created"
+	EventPayloadCaseUserUpdated    EventPayloadCase = "user_updated"
+	EventPayloadCaseUserDeleted    EventPayloadCase = "user_deleted"
+	EventPayloadCaseOrderCreated   EventPayloadCase = "order_created"
+	EventPayloadCaseOrderCompleted EventPayloadCase = "order_completed"
+)
+
+// Valid reports whether c is one of the EventPayloadCase variants
+func (c EventPayloadCase) Valid() bool {
+	switch c {
+	case EventPayloadCaseUserCreated, EventPayloadCaseUserUpdated, EventPayloadCaseUserDeleted, EventPayloadCaseOrderCreated, EventPayloadCaseOrderCompleted:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c EventPayloadCase) String() string {
+	return string(c)
 }
 
 // IntoPlain converts protobuf message to plain struct
-func (pb *Config) IntoPlain() *ConfigPlain {
+func (pb *Event) IntoPlain() *EventPlain {
 	if pb == nil {
 		return nil
 	}
-	p := &ConfigPlain{}
+	p := &EventPlain{}
 
-	p.DoubleVal = pb.DoubleVal
-	p.FloatVal = pb.FloatVal
-	p.Int32Val = pb.Int32Val
-	p.Int64Val = pb.Int64Val
-	p.Uint32Val = pb.Uint32Val
-	p.Uint64Val = pb.Uint64Val
-	p.Sint32Val = pb.Sint32Val
-	p.Sint64Val = pb.Sint64Val
-	p.Fixed32Val = pb.Fixed32Val
-	p.Fixed64Val = pb.Fixed64Val
-	p.Sfixed32Val = pb.Sfixed32Val
-	p.Sfixed64Val = pb.Sfixed64Val
-	p.BoolVal = pb.BoolVal
-	p.StringVal = pb.StringVal
-	p.BytesVal = pb.BytesVal
-	if pb.OptionalString != nil {
-		p.OptionalString = *pb.OptionalString
-	}
-	if pb.OptionalInt != nil {
-		p.OptionalInt = *pb.OptionalInt
-	}
-	if pb.OptionalBool != nil {
-		p.OptionalBool = *pb.OptionalBool
-	}
-	if pb.OptionalDouble != nil {
-		p.OptionalDouble = *pb.OptionalDouble
-	}
-	p.OptionalBytes = pb.OptionalBytes
-	p.StringList = pb.StringList
-	p.IntList = pb.IntList
-	p.DoubleList = pb.DoubleList
-	p.BytesList = pb.BytesList
-	p.BoolList = pb.BoolList
-	p.FloatList = pb.FloatList
-	p.Int64List = pb.Int64List
-	p.Uint32List = pb.Uint32List
-	p.Uint64List = pb.Uint64List
-	p.StringMap = pb.StringMap
-	p.IntMap = pb.IntMap
-	p.IntKeyMap = pb.IntKeyMap
-	if len(pb.NestedMap) > 0 {
-		p.NestedMap = make(map[string]*ConfigPlain, len(pb.NestedMap))
-		for k, v := range pb.NestedMap {
-			if v != nil {
-				p.NestedMap[k] = v.IntoPlain()
-			}
-		}
+	// Detect payload oneof case
+	switch pb.Payload.(type) {
+	case *Event_UserCreated:
+		p.PayloadCase = EventPayloadCaseUserCreated
+	case *Event_UserUpdated:
+		p.PayloadCase = EventPayloadCaseUserUpdated
+	case *Event_UserDeleted:
+		p.PayloadCase = EventPayloadCaseUserDeleted
+	case *Event_OrderCreated:
+		p.PayloadCase = EventPayloadCaseOrderCreated
+	case *Event_OrderCompleted:
+		p.PayloadCase = EventPayloadCaseOrderCompleted
 	}
-	p.Int64KeyMap = pb.Int64KeyMap
-	p.Uint32KeyMap = pb.Uint32KeyMap
-	p.Uint64KeyMap = pb.Uint64KeyMap
-	p.Sint32KeyMap = pb.Sint32KeyMap
-	p.Sint64KeyMap = pb.Sint64KeyMap
-	p.Fixed32KeyMap = pb.Fixed32KeyMap
-	p.Fixed64KeyMap = pb.Fixed64KeyMap
-	p.Sfixed32KeyMap = pb.Sfixed32KeyMap
-	p.Sfixed64KeyMap = pb.Sfixed64KeyMap
-	p.BoolKeyMap = pb.BoolKeyMap
-	p.DoubleMap = pb.DoubleMap
-	p.BytesMap = pb.BytesMap
-	p.BoolMap = pb.BoolMap
-	p.FloatMap = pb.FloatMap
-	p.Status = pb.Status
-	p.StatusList = pb.StatusList
-	p.StatusMap = pb.StatusMap
-	if pb.OptionalStatus != nil {
-		p.OptionalStatus = *pb.OptionalStatus
+
+	p.EventId = pb.EventId
+	p.EventType = pb.EventType
+	p.Timestamp = pb.Timestamp
+	p.Source = pb.Source
+	p.Meta = pb.Meta
+	// PayloadUserCreated from payload.user_created
+	if pb.GetUserCreated() != nil {
+		p.PayloadUserCreated = pb.GetUserCreated()
 	}
-	p.NestedEnum = pb.NestedEnum
-	p.NestedEnumList = pb.NestedEnumList
-	p.NestedConfig = pb.NestedConfig
-	p.NestedConfigList = pb.NestedConfigList
-	p.NestedConfigMap = pb.NestedConfigMap
-	if pb.Parent != nil {
-		p.Parent = pb.Parent.IntoPlain()
+	// PayloadUserUpdated from payload.user_updated
+	if pb.GetUserUpdated() != nil {
+		p.PayloadUserUpdated = pb.GetUserUpdated()
 	}
-	if len(pb.Children) > 0 {
-		p.Children = make([]ConfigPlain, len(pb.Children))
-		for i, v := range pb.Children {
-			if v != nil {
-				p.Children[i] = *v.IntoPlain()
-			}
-		}
+	// PayloadUserDeleted from payload.user_deleted
+	if pb.GetUserDeleted() != nil {
+		p.PayloadUserDeleted = pb.GetUserDeleted()
+	}
+	// PayloadOrderCreated from payload.order_created
+	if pb.GetOrderCreated() != nil {
+		p.PayloadOrderCreated = pb.GetOrderCreated()
+	}
+	// PayloadOrderCompleted from payload.order_completed
+	if pb.GetOrderCompleted() != nil {
+		p.PayloadOrderCompleted = pb.GetOrderCompleted()
 	}
 	return p
 }
 
 // IntoPb converts plain struct to protobuf message
-func (p *ConfigPlain) IntoPb() *Config {
+func (p *EventPlain) IntoPb() *Event {
 	if p == nil {
 		return nil
 	}
-	pb := &Config{}
+	pb := &Event{}
 
-	pb.DoubleVal = p.DoubleVal
-	pb.FloatVal = p.FloatVal
-	pb.Int32Val = p.Int32Val
-	pb.Int64Val = p.Int64Val
-	pb.Uint32Val = p.Uint32Val
-	pb.Uint64Val = p.Uint64Val
-	pb.Sint32Val = p.Sint32Val
-	pb.Sint64Val = p.Sint64Val
-	pb.Fixed32Val = p.Fixed32Val
-	pb.Fixed64Val = p.Fixed64Val
-	pb.Sfixed32Val = p.Sfixed32Val
-	pb.Sfixed64Val = p.Sfixed64Val
-	pb.BoolVal = p.BoolVal
-	pb.StringVal = p.StringVal
-	pb.BytesVal = p.BytesVal
-	if p.OptionalString != "" {
-		pb.OptionalString = &p.OptionalString
+	pb.EventId = p.EventId
+	pb.EventType = p.EventType
+	pb.Timestamp = p.Timestamp
+	pb.Source = p.Source
+	pb.Meta = p.Meta
+	// PayloadUserCreated -> payload.user_created
+	if p.PayloadUserCreated != nil && p.PayloadCase == EventPayloadCaseUserCreated {
+		pb.Payload = &Event_UserCreated{UserCreated: p.PayloadUserCreated}
 	}
-	pb.OptionalInt = &p.OptionalInt
-	pb.OptionalBool = &p.OptionalBool
-	pb.OptionalDouble = &p.OptionalDouble
-	pb.OptionalBytes = p.OptionalBytes
-	pb.StringList = p.StringList
-	pb.IntList = p.IntList
-	pb.DoubleList = p.DoubleList
-	pb.BytesList = p.BytesList
-	pb.BoolList = p.BoolList
-	pb.FloatList = p.FloatList
-	pb.Int64List = p.Int64List
-	pb.Uint32List = p.Uint32List
-	pb.Uint64List = p.Uint64List
-	pb.StringMap = p.StringMap
-	pb.IntMap = p.IntMap
-	pb.IntKeyMap = p.IntKeyMap
-	if len(p.NestedMap) > 0 {
-		pb.NestedMap = make(map[string]*Config, len(p.NestedMap))
-		for k, v := range p.NestedMap {
-			if v != nil {
-				pb.NestedMap[k] = v.IntoPb()
-			}
-		}
+	// PayloadUserUpdated -> payload.user_updated
+	if p.PayloadUserUpdated != nil && p.PayloadCase == EventPayloadCaseUserUpdated {
+		pb.Payload = &Event_UserUpdated{UserUpdated: p.PayloadUserUpdated}
 	}
-	pb.Int64KeyMap = p.Int64KeyMap
-	pb.Uint32KeyMap = p.Uint32KeyMap
-	pb.Uint64KeyMap = p.Uint64KeyMap
-	pb.Sint32KeyMap = p.Sint32KeyMap
-	pb.Sint64KeyMap = p.Sint64KeyMap
-	pb.Fixed32KeyMap = p.Fixed32KeyMap
-	pb.Fixed64KeyMap = p.Fixed64KeyMap
-	pb.Sfixed32KeyMap = p.Sfixed32KeyMap
-	pb.Sfixed64KeyMap = p.Sfixed64KeyMap
-	pb.BoolKeyMap = p.BoolKeyMap
-	pb.DoubleMap = p.DoubleMap
-	pb.BytesMap = p.BytesMap
-	pb.BoolMap = p.BoolMap
-	pb.FloatMap = p.FloatMap
-	pb.Status = p.Status
-	pb.StatusList = p.StatusList
-	pb.StatusMap = p.StatusMap
-	pb.OptionalStatus = &p.OptionalStatus
-	pb.NestedEnum = p.NestedEnum
-	pb.NestedEnumList = p.NestedEnumList
-	pb.NestedConfig = p.NestedConfig
-	pb.NestedConfigList = p.NestedConfigList
-	pb.NestedConfigMap = p.NestedConfigMap
-	if p.Parent != nil {
-		pb.Parent = p.Parent.IntoPb()
+	// PayloadUserDeleted -> payload.user_deleted
+	if p.PayloadUserDeleted != nil && p.PayloadCase == EventPayloadCaseUserDeleted {
+		pb.Payload = &Event_UserDeleted{UserDeleted: p.PayloadUserDeleted}
 	}
-	if len(p.Children) > 0 {
-		pb.Children = make([]*Config, len(p.Children))
-		for i := range p.Children {
-			pb.Children[i] = (&p.Children[i]).IntoPb()
-		}
+	// PayloadOrderCreated -> payload.order_created
+	if p.PayloadOrderCreated != nil && p.PayloadCase == EventPayloadCaseOrderCreated {
+		pb.Payload = &Event_OrderCreated{OrderCreated: p.PayloadOrderCreated}
+	}
+	// PayloadOrderCompleted -> payload.order_completed
+	if p.PayloadOrderCompleted != nil && p.PayloadCase == EventPayloadCaseOrderCompleted {
+		pb.Payload = &Event_OrderCompleted{OrderCompleted: p.PayloadOrderCompleted}
 	}
 	return pb
 }
 
+// eventPlainMergePaths are the fields of Event covered by EventPlain
+var eventPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{10},
+	{11},
+	{12},
+	{13},
+	{14},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *EventPlain) IntoPbMerge(dst *Event) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, eventPlainMergePaths...)
+}
+
+// eventPlainFieldPaths link the JSON names of EventPlain to field-mask paths of Event
+var eventPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "eventId", Path: "event_id"},
+	{Name: "eventType", Path: "event_type"},
+	{Name: "timestamp", Path: "timestamp"},
+	{Name: "source", Path: "source"},
+	{Name: "meta", Path: "meta"},
+	{Name: "payloadUserCreated", Path: "user_created"},
+	{Name: "payloadUserUpdated", Path: "user_updated"},
+	{Name: "payloadUserDeleted", Path: "user_deleted"},
+	{Name: "payloadOrderCreated", Path: "order_created"},
+	{Name: "payloadOrderCompleted", Path: "order_completed"},
+}
+
+// EventPlainFieldMask builds a field mask of Event from JSON names of EventPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func EventPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(eventPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// EventPlainFieldNames translates a field mask of Event into JSON names of EventPlain.
+// A path to an embedded message yields all fields flattened from it.
+func EventPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(eventPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Event, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *EventPlain) ApplyToPb(pb *Event, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), eventPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Event) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*EventPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), eventPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Event{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
 // IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
-func (pb *Config) IntoPlainReuse(p *ConfigPlain) {
+func (pb *Event) IntoPlainReuse(p *EventPlain) {
 	if pb == nil || p == nil {
 		return
 	}
 	// Reset before filling
 	p.Reset()
 
-	p.DoubleVal = pb.DoubleVal
-	p.FloatVal = pb.FloatVal
-	p.Int32Val = pb.Int32Val
-	p.Int64Val = pb.Int64Val
-	p.Uint32Val = pb.Uint32Val
-	p.Uint64Val = pb.Uint64Val
-	p.Sint32Val = pb.Sint32Val
-	p.Sint64Val = pb.Sint64Val
-	p.Fixed32Val = pb.Fixed32Val
-	p.Fixed64Val = pb.Fixed64Val
-	p.Sfixed32Val = pb.Sfixed32Val
-	p.Sfixed64Val = pb.Sfixed64Val
-	p.BoolVal = pb.BoolVal
-	p.StringVal = pb.StringVal
-	p.BytesVal = pb.BytesVal
-	if pb.OptionalString != nil {
-		p.OptionalString = *pb.OptionalString
-	}
-	if pb.OptionalInt != nil {
-		p.OptionalInt = *pb.OptionalInt
-	}
-	if pb.OptionalBool != nil {
-		p.OptionalBool = *pb.OptionalBool
-	}
-	if pb.OptionalDouble != nil {
-		p.OptionalDouble = *pb.OptionalDouble
-	}
-	p.OptionalBytes = pb.OptionalBytes
-	p.StringList = pb.StringList
-	p.IntList = pb.IntList
-	p.DoubleList = pb.DoubleList
-	p.BytesList = pb.BytesList
-	p.BoolList = pb.BoolList
-	p.FloatList = pb.FloatList
-	p.Int64List = pb.Int64List
-	p.Uint32List = pb.Uint32List
-	p.Uint64List = pb.Uint64List
-	p.StringMap = pb.StringMap
-	p.IntMap = pb.IntMap
-	p.IntKeyMap = pb.IntKeyMap
-	if len(pb.NestedMap) > 0 {
-		p.NestedMap = make(map[string]*ConfigPlain, len(pb.NestedMap))
-		for k, v := range pb.NestedMap {
-			if v != nil {
-				p.NestedMap[k] = v.IntoPlain()
-			}
-		}
-	}
-	p.Int64KeyMap = pb.Int64KeyMap
-	p.Uint32KeyMap = pb.Uint32KeyMap
-	p.Uint64KeyMap = pb.Uint64KeyMap
-	p.Sint32KeyMap = pb.Sint32KeyMap
-	p.Sint64KeyMap = pb.Sint64KeyMap
-	p.Fixed32KeyMap = pb.Fixed32KeyMap
-	p.Fixed64KeyMap = pb.Fixed64KeyMap
-	p.Sfixed32KeyMap = pb.Sfixed32KeyMap
-	p.Sfixed64KeyMap = pb.Sfixed64KeyMap
-	p.BoolKeyMap = pb.BoolKeyMap
-	p.DoubleMap = pb.DoubleMap
-	p.BytesMap = pb.BytesMap
-	p.BoolMap = pb.BoolMap
-	p.FloatMap = pb.FloatMap
-	p.Status = pb.Status
-	p.StatusList = pb.StatusList
-	p.StatusMap = pb.StatusMap
-	if pb.OptionalStatus != nil {
-		p.OptionalStatus = *pb.OptionalStatus
+	// Detect payload oneof case
+	switch pb.Payload.(type) {
+	case *Event_UserCreated:
+		p.PayloadCase = EventPayloadCaseUserCreated
+	case *Event_UserUpdated:
+		p.PayloadCase = EventPayloadCaseUserUpdated
+	case *Event_UserDeleted:
+		p.PayloadCase = EventPayloadCaseUserDeleted
+	case *Event_OrderCreated:
+		p.PayloadCase = EventPayloadCaseOrderCreated
+	case *Event_OrderCompleted:
+		p.PayloadCase = EventPayloadCaseOrderCompleted
 	}
-	p.NestedEnum = pb.NestedEnum
-	p.NestedEnumList = pb.NestedEnumList
-	p.NestedConfig = pb.NestedConfig
-	p.NestedConfigList = pb.NestedConfigList
-	p.NestedConfigMap = pb.NestedConfigMap
-	if pb.Parent != nil {
-		p.Parent = pb.Parent.IntoPlain()
+
+	p.EventId = pb.EventId
+	p.EventType = pb.EventType
+	p.Timestamp = pb.Timestamp
+	p.Source = pb.Source
+	p.Meta = pb.Meta
+	// PayloadUserCreated from payload.user_created
+	if pb.GetUserCreated() != nil {
+		p.PayloadUserCreated = pb.GetUserCreated()
 	}
-	if len(pb.Children) > 0 {
-		p.Children = make([]ConfigPlain, len(pb.Children))
-		for i, v := range pb.Children {
-			if v != nil {
-				p.Children[i] = *v.IntoPlain()
-			}
-		}
+	// PayloadUserUpdated from payload.user_updated
+	if pb.GetUserUpdated() != nil {
+		p.PayloadUserUpdated = pb.GetUserUpdated()
+	}
+	// PayloadUserDeleted from payload.user_deleted
+	if pb.GetUserDeleted() != nil {
+		p.PayloadUserDeleted = pb.GetUserDeleted()
+	}
+	// PayloadOrderCreated from payload.order_created
+	if pb.GetOrderCreated() != nil {
+		p.PayloadOrderCreated = pb.GetOrderCreated()
+	}
+	// PayloadOrderCompleted from payload.order_completed
+	if pb.GetOrderCompleted() != nil {
+		p.PayloadOrderCompleted = pb.GetOrderCompleted()
 	}
 }
 
-// MarshalJX encodes ConfigPlain to JSON using jx.Encoder
-func (p *ConfigPlain) MarshalJX(e *jx.Encoder) {
+// MarshalJX encodes EventPlain to JSON using jx.Encoder
+func (p *EventPlain) MarshalJX(e *jx.Encoder) {
 	if p == nil {
 		e.Null()
 		return
@@ -2213,611 +4104,6718 @@ func (p *ConfigPlain) MarshalJX(e *jx.Encoder) {
 
 	e.ObjStart()
 
-	if p.DoubleVal != 0 {
-		e.FieldStart("doubleVal")
-		e.Float64(p.DoubleVal)
-	}
-	if p.FloatVal != 0 {
-		e.FieldStart("floatVal")
-		e.Float32(p.FloatVal)
-	}
-	if p.Int32Val != 0 {
-		e.FieldStart("int32Val")
-		e.Int32(p.Int32Val)
-	}
-	if p.Int64Val != 0 {
-		e.FieldStart("int64Val")
-		e.Int64(p.Int64Val)
+	if p.PayloadCase != "" {
+		e.FieldStart("payload_case")
+		e.Str(p.PayloadCase.String())
 	}
-	if p.Uint32Val != 0 {
-		e.FieldStart("uint32Val")
-		e.UInt32(p.Uint32Val)
+	if p.EventId != "" {
+		e.FieldStart("eventId")
+		e.Str(p.EventId)
 	}
-	if p.Uint64Val != 0 {
-		e.FieldStart("uint64Val")
-		e.UInt64(p.Uint64Val)
+	if p.EventType != "" {
+		e.FieldStart("eventType")
+		e.Str(p.EventType)
 	}
-	if p.Sint32Val != 0 {
-		e.FieldStart("sint32Val")
-		e.Int32(p.Sint32Val)
+	if p.Timestamp != 0 {
+		e.FieldStart("timestamp")
+		e.Int64(p.Timestamp)
 	}
-	if p.Sint64Val != 0 {
-		e.FieldStart("sint64Val")
-		e.Int64(p.Sint64Val)
+	if p.Source != "" {
+		e.FieldStart("source")
+		e.Str(p.Source)
 	}
-	if p.Fixed32Val != 0 {
-		e.FieldStart("fixed32Val")
-		e.UInt32(p.Fixed32Val)
+	if p.Meta != nil {
+		e.FieldStart("meta")
+		p.Meta.MarshalJX(e)
 	}
-	if p.Fixed64Val != 0 {
-		e.FieldStart("fixed64Val")
-		e.UInt64(p.Fixed64Val)
+	if p.PayloadUserCreated != nil {
+		e.FieldStart("payloadUserCreated")
+		p.PayloadUserCreated.MarshalJX(e)
 	}
-	if p.Sfixed32Val != 0 {
-		e.FieldStart("sfixed32Val")
-		e.Int32(p.Sfixed32Val)
+	if p.PayloadUserUpdated != nil {
+		e.FieldStart("payloadUserUpdated")
+		p.PayloadUserUpdated.MarshalJX(e)
 	}
-	if p.Sfixed64Val != 0 {
-		e.FieldStart("sfixed64Val")
-		e.Int64(p.Sfixed64Val)
+	if p.PayloadUserDeleted != nil {
+		e.FieldStart("payloadUserDeleted")
+		p.PayloadUserDeleted.MarshalJX(e)
 	}
-	if p.BoolVal {
-		e.FieldStart("boolVal")
-		e.Bool(p.BoolVal)
+	if p.PayloadOrderCreated != nil {
+		e.FieldStart("payloadOrderCreated")
+		p.PayloadOrderCreated.MarshalJX(e)
 	}
-	if p.StringVal != "" {
-		e.FieldStart("stringVal")
-		e.Str(p.StringVal)
+	if p.PayloadOrderCompleted != nil {
+		e.FieldStart("payloadOrderCompleted")
+		p.PayloadOrderCompleted.MarshalJX(e)
 	}
-	if len(p.BytesVal) > 0 {
-		e.FieldStart("bytesVal")
-		e.Base64(p.BytesVal)
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *EventPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes EventPlain from JSON using jx.Decoder
+func (p *EventPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
 	}
-	if p.OptionalString != "" {
-		e.FieldStart("optionalString")
-		e.Str(p.OptionalString)
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "payload_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.PayloadCase = EventPayloadCase(v)
+			if v != "" && !p.PayloadCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.Event.payload", v)
+			}
+		case "eventId":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.EventId = v
+		case "eventType":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.EventType = v
+		case "timestamp":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Timestamp = v
+		case "source":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Source = v
+		case "meta":
+			p.Meta = &Metadata{}
+			if err := p.Meta.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "payloadUserCreated":
+			p.PayloadUserCreated = &UserCreatedEvent{}
+			if err := p.PayloadUserCreated.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "payloadUserUpdated":
+			p.PayloadUserUpdated = &UserUpdatedEvent{}
+			if err := p.PayloadUserUpdated.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "payloadUserDeleted":
+			p.PayloadUserDeleted = &UserDeletedEvent{}
+			if err := p.PayloadUserDeleted.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "payloadOrderCreated":
+			p.PayloadOrderCreated = &OrderCreatedEvent{}
+			if err := p.PayloadOrderCreated.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "payloadOrderCompleted":
+			p.PayloadOrderCompleted = &OrderCompletedEvent{}
+			if err := p.PayloadOrderCompleted.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *EventPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes EventPlain in the protobuf wire format of Event
+func (p *EventPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends EventPlain encoded in the protobuf wire format of Event to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *EventPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
 	}
-	if p.OptionalInt != 0 {
-		e.FieldStart("optionalInt")
-		e.Int32(p.OptionalInt)
+	var err error
+	if v := p.EventId; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
 	}
-	if p.OptionalBool {
-		e.FieldStart("optionalBool")
-		e.Bool(p.OptionalBool)
+	if v := p.EventType; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
 	}
-	if p.OptionalDouble != 0 {
-		e.FieldStart("optionalDouble")
-		e.Float64(p.OptionalDouble)
+	if v := p.Timestamp; v != 0 {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
 	}
-	if len(p.OptionalBytes) > 0 {
-		e.FieldStart("optionalBytes")
-		e.Base64(p.OptionalBytes)
+	if v := p.Source; v != "" {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendString(b, v)
 	}
-	if len(p.StringList) > 0 {
-		e.FieldStart("stringList")
-		e.ArrStart()
-		for _, v := range p.StringList {
-			e.Str(v)
+	if p.Meta != nil {
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Meta); err != nil {
+			return nil, err
 		}
-		e.ArrEnd()
+		b = plainwire.FinishLen(b, _start)
 	}
-	if len(p.IntList) > 0 {
-		e.FieldStart("intList")
-		e.ArrStart()
-		for _, v := range p.IntList {
-			e.Int32(v)
+	if p.PayloadCase == EventPayloadCaseUserCreated {
+		if p.PayloadUserCreated != nil {
+			b = protowire.AppendTag(b, 10, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.PayloadUserCreated); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
 		}
-		e.ArrEnd()
 	}
-	if len(p.DoubleList) > 0 {
-		e.FieldStart("doubleList")
-		e.ArrStart()
-		for _, v := range p.DoubleList {
-			e.Float64(v)
+	if p.PayloadCase == EventPayloadCaseUserUpdated {
+		if p.PayloadUserUpdated != nil {
+			b = protowire.AppendTag(b, 11, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.PayloadUserUpdated); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
 		}
-		e.ArrEnd()
 	}
-	if len(p.BytesList) > 0 {
-		e.FieldStart("bytesList")
-		e.ArrStart()
-		for _, v := range p.BytesList {
-			e.Base64(v)
+	if p.PayloadCase == EventPayloadCaseUserDeleted {
+		if p.PayloadUserDeleted != nil {
+			b = protowire.AppendTag(b, 12, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.PayloadUserDeleted); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
 		}
-		e.ArrEnd()
 	}
-	if len(p.BoolList) > 0 {
-		e.FieldStart("boolList")
-		e.ArrStart()
-		for _, v := range p.BoolList {
-			e.Bool(v)
+	if p.PayloadCase == EventPayloadCaseOrderCreated {
+		if p.PayloadOrderCreated != nil {
+			b = protowire.AppendTag(b, 13, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.PayloadOrderCreated); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
 		}
-		e.ArrEnd()
 	}
-	if len(p.FloatList) > 0 {
-		e.FieldStart("floatList")
-		e.ArrStart()
-		for _, v := range p.FloatList {
-			e.Float32(v)
+	if p.PayloadCase == EventPayloadCaseOrderCompleted {
+		if p.PayloadOrderCompleted != nil {
+			b = protowire.AppendTag(b, 14, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.PayloadOrderCompleted); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
 		}
-		e.ArrEnd()
 	}
-	if len(p.Int64List) > 0 {
-		e.FieldStart("int64List")
-		e.ArrStart()
-		for _, v := range p.Int64List {
-			e.Int64(v)
+	return b, err
+}
+
+// UnmarshalProto decodes EventPlain from the protobuf wire format of Event.
+// Unknown fields are skipped.
+func (p *EventPlain) UnmarshalProto(b []byte) error {
+	*p = EventPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
 		}
-		e.ArrEnd()
-	}
-	if len(p.Uint32List) > 0 {
-		e.FieldStart("uint32List")
-		e.ArrStart()
-		for _, v := range p.Uint32List {
-			e.UInt32(v)
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.EventId = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.EventType = v
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Timestamp = int64(v)
+		case num == 4 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Source = v
+		case num == 5 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &Metadata{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Meta = m
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = EventPayloadCaseUserCreated
+			m := &UserCreatedEvent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.PayloadUserCreated = m
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = EventPayloadCaseUserUpdated
+			m := &UserUpdatedEvent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.PayloadUserUpdated = m
+		case num == 12 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = EventPayloadCaseUserDeleted
+			m := &UserDeletedEvent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.PayloadUserDeleted = m
+		case num == 13 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = EventPayloadCaseOrderCreated
+			m := &OrderCreatedEvent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.PayloadOrderCreated = m
+		case num == 14 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = EventPayloadCaseOrderCompleted
+			m := &OrderCompletedEvent{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.PayloadOrderCompleted = m
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
 		}
-		e.ArrEnd()
-	}
-	if len(p.Uint64List) > 0 {
-		e.FieldStart("uint64List")
-		e.ArrStart()
-		for _, v := range p.Uint64List {
-			e.UInt64(v)
+		if n < 0 {
+			return protowire.ParseError(n)
 		}
-		e.ArrEnd()
-	}
-	e.FieldStart("stringMap")
-	e.ObjStart()
-	for k, v := range p.StringMap {
-		e.FieldStart(k)
-		e.Str(v)
-	}
-	e.ObjEnd()
-	e.FieldStart("intMap")
-	e.ObjStart()
-	for k, v := range p.IntMap {
-		e.FieldStart(k)
-		e.Int32(v)
-	}
-	e.ObjEnd()
-	e.FieldStart("intKeyMap")
-	e.ObjStart()
-	for k, v := range p.IntKeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
-	}
-	e.ObjEnd()
-	e.FieldStart("nestedMap")
-	e.ObjStart()
-	for k, v := range p.NestedMap {
-		e.FieldStart(k)
-		v.MarshalJX(e)
+		b = b[n:]
 	}
-	e.ObjEnd()
-	e.FieldStart("int64KeyMap")
-	e.ObjStart()
-	for k, v := range p.Int64KeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	return nil
+}
+
+// eventPlainReflect describes EventPlain as message full.plain.EventPlain
+var eventPlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "EventPlain",
+	plainreflect.Scalar(func(p *EventPlain) *string { return &p.EventId }),
+	plainreflect.Scalar(func(p *EventPlain) *string { return &p.EventType }),
+	plainreflect.Scalar(func(p *EventPlain) *int64 { return &p.Timestamp }),
+	plainreflect.Scalar(func(p *EventPlain) *string { return &p.Source }),
+	plainreflect.Message(func(p *EventPlain) **Metadata { return &p.Meta }),
+	plainreflect.Message(func(p *EventPlain) **UserCreatedEvent { return &p.PayloadUserCreated }),
+	plainreflect.Message(func(p *EventPlain) **UserUpdatedEvent { return &p.PayloadUserUpdated }),
+	plainreflect.Message(func(p *EventPlain) **UserDeletedEvent { return &p.PayloadUserDeleted }),
+	plainreflect.Message(func(p *EventPlain) **OrderCreatedEvent { return &p.PayloadOrderCreated }),
+	plainreflect.Message(func(p *EventPlain) **OrderCompletedEvent { return &p.PayloadOrderCompleted }),
+	plainreflect.Case(func(p *EventPlain) *EventPayloadCase { return &p.PayloadCase }),
+)
+
+// ProtoReflect returns the reflective view of EventPlain backed by the struct
+func (p *EventPlain) ProtoReflect() protoreflect.Message {
+	return eventPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of EventPlain
+func (p *EventPlain) Clone() *EventPlain {
+	if p == nil {
+		return nil
 	}
-	e.ObjEnd()
-	e.FieldStart("uint32KeyMap")
-	e.ObjStart()
-	for k, v := range p.Uint32KeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	dst := &EventPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of EventPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetEventPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *EventPlain) CopyTo(dst *EventPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
 	}
-	e.ObjEnd()
-	e.FieldStart("uint64KeyMap")
-	e.ObjStart()
-	for k, v := range p.Uint64KeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	dst.PayloadCase = p.PayloadCase
+	dst.EventId = p.EventId
+	dst.EventType = p.EventType
+	dst.Timestamp = p.Timestamp
+	dst.Source = p.Source
+	if p.Meta == nil {
+		dst.Meta = nil
+	} else {
+		if dst.Meta == nil {
+			dst.Meta = new(Metadata)
+		} else {
+			proto.Reset(dst.Meta)
+		}
+		proto.Merge(dst.Meta, p.Meta)
 	}
-	e.ObjEnd()
-	e.FieldStart("sint32KeyMap")
-	e.ObjStart()
-	for k, v := range p.Sint32KeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.PayloadUserCreated == nil {
+		dst.PayloadUserCreated = nil
+	} else {
+		if dst.PayloadUserCreated == nil {
+			dst.PayloadUserCreated = new(UserCreatedEvent)
+		} else {
+			proto.Reset(dst.PayloadUserCreated)
+		}
+		proto.Merge(dst.PayloadUserCreated, p.PayloadUserCreated)
 	}
-	e.ObjEnd()
-	e.FieldStart("sint64KeyMap")
-	e.ObjStart()
-	for k, v := range p.Sint64KeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.PayloadUserUpdated == nil {
+		dst.PayloadUserUpdated = nil
+	} else {
+		if dst.PayloadUserUpdated == nil {
+			dst.PayloadUserUpdated = new(UserUpdatedEvent)
+		} else {
+			proto.Reset(dst.PayloadUserUpdated)
+		}
+		proto.Merge(dst.PayloadUserUpdated, p.PayloadUserUpdated)
 	}
-	e.ObjEnd()
-	e.FieldStart("fixed32KeyMap")
-	e.ObjStart()
-	for k, v := range p.Fixed32KeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.PayloadUserDeleted == nil {
+		dst.PayloadUserDeleted = nil
+	} else {
+		if dst.PayloadUserDeleted == nil {
+			dst.PayloadUserDeleted = new(UserDeletedEvent)
+		} else {
+			proto.Reset(dst.PayloadUserDeleted)
+		}
+		proto.Merge(dst.PayloadUserDeleted, p.PayloadUserDeleted)
 	}
-	e.ObjEnd()
-	e.FieldStart("fixed64KeyMap")
-	e.ObjStart()
-	for k, v := range p.Fixed64KeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.PayloadOrderCreated == nil {
+		dst.PayloadOrderCreated = nil
+	} else {
+		if dst.PayloadOrderCreated == nil {
+			dst.PayloadOrderCreated = new(OrderCreatedEvent)
+		} else {
+			proto.Reset(dst.PayloadOrderCreated)
+		}
+		proto.Merge(dst.PayloadOrderCreated, p.PayloadOrderCreated)
 	}
-	e.ObjEnd()
-	e.FieldStart("sfixed32KeyMap")
-	e.ObjStart()
-	for k, v := range p.Sfixed32KeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.PayloadOrderCompleted == nil {
+		dst.PayloadOrderCompleted = nil
+	} else {
+		if dst.PayloadOrderCompleted == nil {
+			dst.PayloadOrderCompleted = new(OrderCompletedEvent)
+		} else {
+			proto.Reset(dst.PayloadOrderCompleted)
+		}
+		proto.Merge(dst.PayloadOrderCompleted, p.PayloadOrderCompleted)
 	}
-	e.ObjEnd()
-	e.FieldStart("sfixed64KeyMap")
-	e.ObjStart()
-	for k, v := range p.Sfixed64KeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *EventPlain) Equal(other *EventPlain) bool {
+	if p == nil || other == nil {
+		return p == other
 	}
-	e.ObjEnd()
-	e.FieldStart("boolKeyMap")
-	e.ObjStart()
-	for k, v := range p.BoolKeyMap {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.PayloadCase != other.PayloadCase {
+		return false
 	}
-	e.ObjEnd()
-	e.FieldStart("doubleMap")
-	e.ObjStart()
-	for k, v := range p.DoubleMap {
-		e.FieldStart(k)
-		e.Float64(v)
+	if p.EventId != other.EventId {
+		return false
 	}
-	e.ObjEnd()
-	e.FieldStart("bytesMap")
-	e.ObjStart()
-	for k, v := range p.BytesMap {
-		e.FieldStart(k)
-		e.Base64(v)
+	if p.EventType != other.EventType {
+		return false
 	}
-	e.ObjEnd()
-	e.FieldStart("boolMap")
-	e.ObjStart()
-	for k, v := range p.BoolMap {
-		e.FieldStart(k)
-		e.Bool(v)
+	if p.Timestamp != other.Timestamp {
+		return false
 	}
-	e.ObjEnd()
-	e.FieldStart("floatMap")
-	e.ObjStart()
-	for k, v := range p.FloatMap {
-		e.FieldStart(k)
-		e.Float32(v)
+	if p.Source != other.Source {
+		return false
 	}
-	e.ObjEnd()
-	e.FieldStart("status")
-	e.Int32(int32(p.Status))
-	if len(p.StatusList) > 0 {
-		e.FieldStart("statusList")
-		e.ArrStart()
-		for _, v := range p.StatusList {
-			e.Int32(int32(v))
-		}
-		e.ArrEnd()
+	if !proto.Equal(p.Meta, other.Meta) {
+		return false
 	}
-	e.FieldStart("statusMap")
-	e.ObjStart()
-	for k, v := range p.StatusMap {
-		e.FieldStart(k)
-		e.Int32(int32(v))
+	if !proto.Equal(p.PayloadUserCreated, other.PayloadUserCreated) {
+		return false
 	}
-	e.ObjEnd()
-	e.FieldStart("optionalStatus")
-	e.Int32(int32(p.OptionalStatus))
-	e.FieldStart("nestedEnum")
-	e.Int32(int32(p.NestedEnum))
-	if len(p.NestedEnumList) > 0 {
-		e.FieldStart("nestedEnumList")
-		e.ArrStart()
-		for _, v := range p.NestedEnumList {
-			e.Int32(int32(v))
-		}
-		e.ArrEnd()
+	if !proto.Equal(p.PayloadUserUpdated, other.PayloadUserUpdated) {
+		return false
 	}
-	if p.NestedConfig != nil {
-		e.FieldStart("nestedConfig")
-		p.NestedConfig.MarshalJX(e)
+	if !proto.Equal(p.PayloadUserDeleted, other.PayloadUserDeleted) {
+		return false
 	}
-	if p.NestedConfigList != nil {
-		e.FieldStart("nestedConfigList")
-		e.ArrStart()
-		for _, v := range p.NestedConfigList {
-			v.MarshalJX(e)
-		}
-		e.ArrEnd()
+	if !proto.Equal(p.PayloadOrderCreated, other.PayloadOrderCreated) {
+		return false
 	}
-	e.FieldStart("nestedConfigMap")
-	e.ObjStart()
-	for k, v := range p.NestedConfigMap {
-		e.FieldStart(k)
-		v.MarshalJX(e)
+	if !proto.Equal(p.PayloadOrderCompleted, other.PayloadOrderCompleted) {
+		return false
 	}
-	e.ObjEnd()
-	if p.Parent != nil {
-		e.FieldStart("parent")
-		p.Parent.MarshalJX(e)
+	return true
+}
+
+// Columns returns the column names of EventPlain rows, in the order of ScanRow and Values
+func (p *EventPlain) Columns() []string {
+	return []string{
+		"event_id",
+		"event_type",
+		"timestamp",
+		"source",
+		"meta",
+		"payload_user_created",
+		"payload_user_updated",
+		"payload_user_deleted",
+		"payload_order_created",
+		"payload_order_completed",
+		"payload_case",
 	}
-	if len(p.Children) > 0 {
-		e.FieldStart("children")
-		e.ArrStart()
-		for _, v := range p.Children {
-			(&v).MarshalJX(e)
-		}
-		e.ArrEnd()
+}
+
+// ScanRow scans a row with the columns of Columns into EventPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *EventPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.EventId,
+		&p.EventType,
+		&p.Timestamp,
+		&p.Source,
+		plainsql.JSON(&p.Meta),
+		plainsql.JSON(&p.PayloadUserCreated),
+		plainsql.JSON(&p.PayloadUserUpdated),
+		plainsql.JSON(&p.PayloadUserDeleted),
+		plainsql.JSON(&p.PayloadOrderCreated),
+		plainsql.JSON(&p.PayloadOrderCompleted),
+		&p.PayloadCase,
+	)
+}
+
+// Values returns the column values of EventPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *EventPlain) Values() []any {
+	return []any{
+		p.EventId,
+		p.EventType,
+		p.Timestamp,
+		p.Source,
+		plainsql.JSON(&p.Meta),
+		plainsql.JSON(&p.PayloadUserCreated),
+		plainsql.JSON(&p.PayloadUserUpdated),
+		plainsql.JSON(&p.PayloadUserDeleted),
+		plainsql.JSON(&p.PayloadOrderCreated),
+		plainsql.JSON(&p.PayloadOrderCompleted),
+		p.PayloadCase,
 	}
-	e.ObjEnd()
 }
 
-// MarshalJSON implements json.Marshaler using jx
-func (p *ConfigPlain) MarshalJSON() ([]byte, error) {
-	e := jx.GetEncoder()
-	defer jx.PutEncoder(e)
-	p.MarshalJX(e)
-	return e.Bytes(), nil
+// eventPlainPool is a sync.Pool for EventPlain objects
+var eventPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &EventPlain{}
+	},
 }
 
-// UnmarshalJX decodes ConfigPlain from JSON using jx.Decoder
-func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
+// GetEventPlain returns a EventPlain from the pool
+func GetEventPlain() *EventPlain {
+	return eventPlainPool.Get().(*EventPlain)
+}
+
+// PutEventPlain returns a EventPlain to the pool after resetting it
+func PutEventPlain(p *EventPlain) {
 	if p == nil {
-		return nil
+		return
 	}
+	p.Reset()
+	eventPlainPool.Put(p)
+}
 
-	return d.Obj(func(d *jx.Decoder, key string) error {
-		switch key {
-		case "doubleVal":
-			v, err := d.Float64()
-			if err != nil {
-				return err
-			}
-			p.DoubleVal = v
-		case "floatVal":
-			v, err := d.Float32()
-			if err != nil {
-				return err
-			}
-			p.FloatVal = v
-		case "int32Val":
-			v, err := d.Int32()
+// Reset clears all fields in EventPlain for reuse
+func (p *EventPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.PayloadCase = ""
+	p.EventId = ""
+	p.EventType = ""
+	p.Timestamp = 0
+	p.Source = ""
+	p.Meta = nil
+	p.PayloadUserCreated = nil
+	p.PayloadUserUpdated = nil
+	p.PayloadUserDeleted = nil
+	p.PayloadOrderCreated = nil
+	p.PayloadOrderCompleted = nil
+}
+
+type ConfigPlain struct {
+	DoubleVal        float64                         `json:"doubleVal"`
+	FloatVal         float32                         `json:"floatVal"`
+	Int32Val         int32                           `json:"int32Val"`
+	Int64Val         int64                           `json:"int64Val"`
+	Uint32Val        uint32                          `json:"uint32Val"`
+	Uint64Val        uint64                          `json:"uint64Val"`
+	Sint32Val        int32                           `json:"sint32Val"`
+	Sint64Val        int64                           `json:"sint64Val"`
+	Fixed32Val       uint32                          `json:"fixed32Val"`
+	Fixed64Val       uint64                          `json:"fixed64Val"`
+	Sfixed32Val      int32                           `json:"sfixed32Val"`
+	Sfixed64Val      int64                           `json:"sfixed64Val"`
+	BoolVal          bool                            `json:"boolVal"`
+	StringVal        string                          `json:"stringVal"`
+	BytesVal         []byte                          `json:"bytesVal"`
+	OptionalString   *string                         `json:"optionalString,omitempty"`
+	OptionalInt      *int32                          `json:"optionalInt,omitempty"`
+	OptionalBool     *bool                           `json:"optionalBool,omitempty"`
+	OptionalDouble   *float64                        `json:"optionalDouble,omitempty"`
+	OptionalBytes    *[]byte                         `json:"optionalBytes,omitempty"`
+	StringList       []string                        `json:"stringList"`
+	IntList          []int32                         `json:"intList"`
+	DoubleList       []float64                       `json:"doubleList"`
+	BytesList        [][]byte                        `json:"bytesList"`
+	BoolList         []bool                          `json:"boolList"`
+	FloatList        []float32                       `json:"floatList"`
+	Int64List        []int64                         `json:"int64List"`
+	Uint32List       []uint32                        `json:"uint32List"`
+	Uint64List       []uint64                        `json:"uint64List"`
+	StringMap        map[string]string               `json:"stringMap"`
+	IntMap           map[string]int32                `json:"intMap"`
+	IntKeyMap        map[int32]string                `json:"intKeyMap"`
+	NestedMap        map[string]*ConfigPlain         `json:"nestedMap"`
+	Int64KeyMap      map[int64]string                `json:"int64KeyMap"`
+	Uint32KeyMap     map[uint32]string               `json:"uint32KeyMap"`
+	Uint64KeyMap     map[uint64]string               `json:"uint64KeyMap"`
+	Sint32KeyMap     map[int32]string                `json:"sint32KeyMap"`
+	Sint64KeyMap     map[int64]string                `json:"sint64KeyMap"`
+	Fixed32KeyMap    map[uint32]string               `json:"fixed32KeyMap"`
+	Fixed64KeyMap    map[uint64]string               `json:"fixed64KeyMap"`
+	Sfixed32KeyMap   map[int32]string                `json:"sfixed32KeyMap"`
+	Sfixed64KeyMap   map[int64]string                `json:"sfixed64KeyMap"`
+	BoolKeyMap       map[bool]string                 `json:"boolKeyMap"`
+	DoubleMap        map[string]float64              `json:"doubleMap"`
+	BytesMap         map[string][]byte               `json:"bytesMap"`
+	BoolMap          map[string]bool                 `json:"boolMap"`
+	FloatMap         map[string]float32              `json:"floatMap"`
+	Status           Status                          `json:"status"`
+	StatusList       []Status                        `json:"statusList"`
+	StatusMap        map[string]Status               `json:"statusMap"`
+	OptionalStatus   *Status                         `json:"optionalStatus,omitempty"`
+	NestedEnum       Config_NestedEnum               `json:"nestedEnum"`
+	NestedEnumList   []Config_NestedEnum             `json:"nestedEnumList"`
+	NestedConfig     *Config_NestedConfig            `json:"nestedConfig"`
+	NestedConfigList []*Config_NestedConfig          `json:"nestedConfigList"`
+	NestedConfigMap  map[string]*Config_NestedConfig `json:"nestedConfigMap"`
+	Parent           *ConfigPlain                    `json:"parent"`
+	Children         []ConfigPlain                   `json:"children"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *Config) IntoPlain() *ConfigPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &ConfigPlain{}
+
+	p.DoubleVal = pb.DoubleVal
+	p.FloatVal = pb.FloatVal
+	p.Int32Val = pb.Int32Val
+	p.Int64Val = pb.Int64Val
+	p.Uint32Val = pb.Uint32Val
+	p.Uint64Val = pb.Uint64Val
+	p.Sint32Val = pb.Sint32Val
+	p.Sint64Val = pb.Sint64Val
+	p.Fixed32Val = pb.Fixed32Val
+	p.Fixed64Val = pb.Fixed64Val
+	p.Sfixed32Val = pb.Sfixed32Val
+	p.Sfixed64Val = pb.Sfixed64Val
+	p.BoolVal = pb.BoolVal
+	p.StringVal = pb.StringVal
+	p.BytesVal = pb.BytesVal
+	p.OptionalString = pb.OptionalString
+	p.OptionalInt = pb.OptionalInt
+	p.OptionalBool = pb.OptionalBool
+	p.OptionalDouble = pb.OptionalDouble
+	p.OptionalBytes = &pb.OptionalBytes
+	if len(pb.StringList) > 0 {
+		p.StringList = pb.StringList
+	} else {
+		p.StringList = []string{}
+	}
+	if len(pb.IntList) > 0 {
+		p.IntList = pb.IntList
+	} else {
+		p.IntList = []int32{}
+	}
+	if len(pb.DoubleList) > 0 {
+		p.DoubleList = pb.DoubleList
+	} else {
+		p.DoubleList = []float64{}
+	}
+	if len(pb.BytesList) > 0 {
+		p.BytesList = pb.BytesList
+	} else {
+		p.BytesList = [][]byte{}
+	}
+	if len(pb.BoolList) > 0 {
+		p.BoolList = pb.BoolList
+	} else {
+		p.BoolList = []bool{}
+	}
+	if len(pb.FloatList) > 0 {
+		p.FloatList = pb.FloatList
+	} else {
+		p.FloatList = []float32{}
+	}
+	if len(pb.Int64List) > 0 {
+		p.Int64List = pb.Int64List
+	} else {
+		p.Int64List = []int64{}
+	}
+	if len(pb.Uint32List) > 0 {
+		p.Uint32List = pb.Uint32List
+	} else {
+		p.Uint32List = []uint32{}
+	}
+	if len(pb.Uint64List) > 0 {
+		p.Uint64List = pb.Uint64List
+	} else {
+		p.Uint64List = []uint64{}
+	}
+	p.StringMap = pb.StringMap
+	p.IntMap = pb.IntMap
+	p.IntKeyMap = pb.IntKeyMap
+	if len(pb.NestedMap) > 0 {
+		p.NestedMap = make(map[string]*ConfigPlain, len(pb.NestedMap))
+		for k, v := range pb.NestedMap {
+			if v != nil {
+				p.NestedMap[k] = v.IntoPlain()
+			}
+		}
+	}
+	p.Int64KeyMap = pb.Int64KeyMap
+	p.Uint32KeyMap = pb.Uint32KeyMap
+	p.Uint64KeyMap = pb.Uint64KeyMap
+	p.Sint32KeyMap = pb.Sint32KeyMap
+	p.Sint64KeyMap = pb.Sint64KeyMap
+	p.Fixed32KeyMap = pb.Fixed32KeyMap
+	p.Fixed64KeyMap = pb.Fixed64KeyMap
+	p.Sfixed32KeyMap = pb.Sfixed32KeyMap
+	p.Sfixed64KeyMap = pb.Sfixed64KeyMap
+	p.BoolKeyMap = pb.BoolKeyMap
+	p.DoubleMap = pb.DoubleMap
+	p.BytesMap = pb.BytesMap
+	p.BoolMap = pb.BoolMap
+	p.FloatMap = pb.FloatMap
+	p.Status = pb.Status
+	if len(pb.StatusList) > 0 {
+		p.StatusList = pb.StatusList
+	} else {
+		p.StatusList = []Status{}
+	}
+	p.StatusMap = pb.StatusMap
+	p.OptionalStatus = pb.OptionalStatus
+	p.NestedEnum = pb.NestedEnum
+	if len(pb.NestedEnumList) > 0 {
+		p.NestedEnumList = pb.NestedEnumList
+	} else {
+		p.NestedEnumList = []Config_NestedEnum{}
+	}
+	p.NestedConfig = pb.NestedConfig
+	p.NestedConfigList = pb.NestedConfigList
+	p.NestedConfigMap = pb.NestedConfigMap
+	if pb.Parent != nil {
+		p.Parent = pb.Parent.IntoPlain()
+	}
+	if len(pb.Children) > 0 {
+		p.Children = make([]ConfigPlain, len(pb.Children))
+		for i, v := range pb.Children {
+			if v != nil {
+				p.Children[i] = *v.IntoPlain()
+			}
+		}
+	} else {
+		p.Children = []ConfigPlain{}
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *ConfigPlain) IntoPb() *Config {
+	if p == nil {
+		return nil
+	}
+	pb := &Config{}
+
+	pb.DoubleVal = p.DoubleVal
+	pb.FloatVal = p.FloatVal
+	pb.Int32Val = p.Int32Val
+	pb.Int64Val = p.Int64Val
+	pb.Uint32Val = p.Uint32Val
+	pb.Uint64Val = p.Uint64Val
+	pb.Sint32Val = p.Sint32Val
+	pb.Sint64Val = p.Sint64Val
+	pb.Fixed32Val = p.Fixed32Val
+	pb.Fixed64Val = p.Fixed64Val
+	pb.Sfixed32Val = p.Sfixed32Val
+	pb.Sfixed64Val = p.Sfixed64Val
+	pb.BoolVal = p.BoolVal
+	pb.StringVal = p.StringVal
+	pb.BytesVal = p.BytesVal
+	pb.OptionalString = p.OptionalString
+	pb.OptionalInt = p.OptionalInt
+	pb.OptionalBool = p.OptionalBool
+	pb.OptionalDouble = p.OptionalDouble
+	if p.OptionalBytes != nil {
+		pb.OptionalBytes = *p.OptionalBytes
+	}
+	pb.StringList = p.StringList
+	pb.IntList = p.IntList
+	pb.DoubleList = p.DoubleList
+	pb.BytesList = p.BytesList
+	pb.BoolList = p.BoolList
+	pb.FloatList = p.FloatList
+	pb.Int64List = p.Int64List
+	pb.Uint32List = p.Uint32List
+	pb.Uint64List = p.Uint64List
+	pb.StringMap = p.StringMap
+	pb.IntMap = p.IntMap
+	pb.IntKeyMap = p.IntKeyMap
+	if len(p.NestedMap) > 0 {
+		pb.NestedMap = make(map[string]*Config, len(p.NestedMap))
+		for k, v := range p.NestedMap {
+			if v != nil {
+				pb.NestedMap[k] = v.IntoPb()
+			}
+		}
+	}
+	pb.Int64KeyMap = p.Int64KeyMap
+	pb.Uint32KeyMap = p.Uint32KeyMap
+	pb.Uint64KeyMap = p.Uint64KeyMap
+	pb.Sint32KeyMap = p.Sint32KeyMap
+	pb.Sint64KeyMap = p.Sint64KeyMap
+	pb.Fixed32KeyMap = p.Fixed32KeyMap
+	pb.Fixed64KeyMap = p.Fixed64KeyMap
+	pb.Sfixed32KeyMap = p.Sfixed32KeyMap
+	pb.Sfixed64KeyMap = p.Sfixed64KeyMap
+	pb.BoolKeyMap = p.BoolKeyMap
+	pb.DoubleMap = p.DoubleMap
+	pb.BytesMap = p.BytesMap
+	pb.BoolMap = p.BoolMap
+	pb.FloatMap = p.FloatMap
+	pb.Status = p.Status
+	pb.StatusList = p.StatusList
+	pb.StatusMap = p.StatusMap
+	pb.OptionalStatus = p.OptionalStatus
+	pb.NestedEnum = p.NestedEnum
+	pb.NestedEnumList = p.NestedEnumList
+	pb.NestedConfig = p.NestedConfig
+	pb.NestedConfigList = p.NestedConfigList
+	pb.NestedConfigMap = p.NestedConfigMap
+	if p.Parent != nil {
+		pb.Parent = p.Parent.IntoPb()
+	}
+	if len(p.Children) > 0 {
+		pb.Children = make([]*Config, len(p.Children))
+		for i := range p.Children {
+			pb.Children[i] = (&p.Children[i]).IntoPb()
+		}
+	}
+	return pb
+}
+
+// configPlainMergePaths are the fields of Config covered by ConfigPlain
+var configPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{7},
+	{8},
+	{9},
+	{10},
+	{11},
+	{12},
+	{13},
+	{14},
+	{15},
+	{20},
+	{21},
+	{22},
+	{23},
+	{24},
+	{30},
+	{31},
+	{32},
+	{33},
+	{34},
+	{35},
+	{36},
+	{37},
+	{38},
+	{40},
+	{41},
+	{42},
+	{43},
+	{44},
+	{45},
+	{46},
+	{47},
+	{48},
+	{49},
+	{50},
+	{51},
+	{52},
+	{53},
+	{60},
+	{61},
+	{62},
+	{63},
+	{70},
+	{71},
+	{72},
+	{73},
+	{80},
+	{81},
+	{90},
+	{91},
+	{92},
+	{100},
+	{101},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *ConfigPlain) IntoPbMerge(dst *Config) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, configPlainMergePaths...)
+}
+
+// configPlainFieldPaths link the JSON names of ConfigPlain to field-mask paths of Config
+var configPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "doubleVal", Path: "double_val"},
+	{Name: "floatVal", Path: "float_val"},
+	{Name: "int32Val", Path: "int32_val"},
+	{Name: "int64Val", Path: "int64_val"},
+	{Name: "uint32Val", Path: "uint32_val"},
+	{Name: "uint64Val", Path: "uint64_val"},
+	{Name: "sint32Val", Path: "sint32_val"},
+	{Name: "sint64Val", Path: "sint64_val"},
+	{Name: "fixed32Val", Path: "fixed32_val"},
+	{Name: "fixed64Val", Path: "fixed64_val"},
+	{Name: "sfixed32Val", Path: "sfixed32_val"},
+	{Name: "sfixed64Val", Path: "sfixed64_val"},
+	{Name: "boolVal", Path: "bool_val"},
+	{Name: "stringVal", Path: "string_val"},
+	{Name: "bytesVal", Path: "bytes_val"},
+	{Name: "optionalString", Path: "optional_string"},
+	{Name: "optionalInt", Path: "optional_int"},
+	{Name: "optionalBool", Path: "optional_bool"},
+	{Name: "optionalDouble", Path: "optional_double"},
+	{Name: "optionalBytes", Path: "optional_bytes"},
+	{Name: "stringList", Path: "string_list"},
+	{Name: "intList", Path: "int_list"},
+	{Name: "doubleList", Path: "double_list"},
+	{Name: "bytesList", Path: "bytes_list"},
+	{Name: "boolList", Path: "bool_list"},
+	{Name: "floatList", Path: "float_list"},
+	{Name: "int64List", Path: "int64_list"},
+	{Name: "uint32List", Path: "uint32_list"},
+	{Name: "uint64List", Path: "uint64_list"},
+	{Name: "stringMap", Path: "string_map"},
+	{Name: "intMap", Path: "int_map"},
+	{Name: "intKeyMap", Path: "int_key_map"},
+	{Name: "nestedMap", Path: "nested_map"},
+	{Name: "int64KeyMap", Path: "int64_key_map"},
+	{Name: "uint32KeyMap", Path: "uint32_key_map"},
+	{Name: "uint64KeyMap", Path: "uint64_key_map"},
+	{Name: "sint32KeyMap", Path: "sint32_key_map"},
+	{Name: "sint64KeyMap", Path: "sint64_key_map"},
+	{Name: "fixed32KeyMap", Path: "fixed32_key_map"},
+	{Name: "fixed64KeyMap", Path: "fixed64_key_map"},
+	{Name: "sfixed32KeyMap", Path: "sfixed32_key_map"},
+	{Name: "sfixed64KeyMap", Path: "sfixed64_key_map"},
+	{Name: "boolKeyMap", Path: "bool_key_map"},
+	{Name: "doubleMap", Path: "double_map"},
+	{Name: "bytesMap", Path: "bytes_map"},
+	{Name: "boolMap", Path: "bool_map"},
+	{Name: "floatMap", Path: "float_map"},
+	{Name: "status", Path: "status"},
+	{Name: "statusList", Path: "status_list"},
+	{Name: "statusMap", Path: "status_map"},
+	{Name: "optionalStatus", Path: "optional_status"},
+	{Name: "nestedEnum", Path: "nested_enum"},
+	{Name: "nestedEnumList", Path: "nested_enum_list"},
+	{Name: "nestedConfig", Path: "nested_config"},
+	{Name: "nestedConfigList", Path: "nested_config_list"},
+	{Name: "nestedConfigMap", Path: "nested_config_map"},
+	{Name: "parent", Path: "parent"},
+	{Name: "children", Path: "children"},
+}
+
+// ConfigPlainFieldMask builds a field mask of Config from JSON names of ConfigPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func ConfigPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(configPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// ConfigPlainFieldNames translates a field mask of Config into JSON names of ConfigPlain.
+// A path to an embedded message yields all fields flattened from it.
+func ConfigPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(configPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Config, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *ConfigPlain) ApplyToPb(pb *Config, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), configPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Config) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*ConfigPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), configPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Config{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *Config) IntoPlainReuse(p *ConfigPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.DoubleVal = pb.DoubleVal
+	p.FloatVal = pb.FloatVal
+	p.Int32Val = pb.Int32Val
+	p.Int64Val = pb.Int64Val
+	p.Uint32Val = pb.Uint32Val
+	p.Uint64Val = pb.Uint64Val
+	p.Sint32Val = pb.Sint32Val
+	p.Sint64Val = pb.Sint64Val
+	p.Fixed32Val = pb.Fixed32Val
+	p.Fixed64Val = pb.Fixed64Val
+	p.Sfixed32Val = pb.Sfixed32Val
+	p.Sfixed64Val = pb.Sfixed64Val
+	p.BoolVal = pb.BoolVal
+	p.StringVal = pb.StringVal
+	p.BytesVal = pb.BytesVal
+	p.OptionalString = pb.OptionalString
+	p.OptionalInt = pb.OptionalInt
+	p.OptionalBool = pb.OptionalBool
+	p.OptionalDouble = pb.OptionalDouble
+	p.OptionalBytes = &pb.OptionalBytes
+	if len(pb.StringList) > 0 {
+		p.StringList = pb.StringList
+	} else {
+		p.StringList = []string{}
+	}
+	if len(pb.IntList) > 0 {
+		p.IntList = pb.IntList
+	} else {
+		p.IntList = []int32{}
+	}
+	if len(pb.DoubleList) > 0 {
+		p.DoubleList = pb.DoubleList
+	} else {
+		p.DoubleList = []float64{}
+	}
+	if len(pb.BytesList) > 0 {
+		p.BytesList = pb.BytesList
+	} else {
+		p.BytesList = [][]byte{}
+	}
+	if len(pb.BoolList) > 0 {
+		p.BoolList = pb.BoolList
+	} else {
+		p.BoolList = []bool{}
+	}
+	if len(pb.FloatList) > 0 {
+		p.FloatList = pb.FloatList
+	} else {
+		p.FloatList = []float32{}
+	}
+	if len(pb.Int64List) > 0 {
+		p.Int64List = pb.Int64List
+	} else {
+		p.Int64List = []int64{}
+	}
+	if len(pb.Uint32List) > 0 {
+		p.Uint32List = pb.Uint32List
+	} else {
+		p.Uint32List = []uint32{}
+	}
+	if len(pb.Uint64List) > 0 {
+		p.Uint64List = pb.Uint64List
+	} else {
+		p.Uint64List = []uint64{}
+	}
+	p.StringMap = pb.StringMap
+	p.IntMap = pb.IntMap
+	p.IntKeyMap = pb.IntKeyMap
+	if len(pb.NestedMap) > 0 {
+		p.NestedMap = make(map[string]*ConfigPlain, len(pb.NestedMap))
+		for k, v := range pb.NestedMap {
+			if v != nil {
+				p.NestedMap[k] = v.IntoPlain()
+			}
+		}
+	}
+	p.Int64KeyMap = pb.Int64KeyMap
+	p.Uint32KeyMap = pb.Uint32KeyMap
+	p.Uint64KeyMap = pb.Uint64KeyMap
+	p.Sint32KeyMap = pb.Sint32KeyMap
+	p.Sint64KeyMap = pb.Sint64KeyMap
+	p.Fixed32KeyMap = pb.Fixed32KeyMap
+	p.Fixed64KeyMap = pb.Fixed64KeyMap
+	p.Sfixed32KeyMap = pb.Sfixed32KeyMap
+	p.Sfixed64KeyMap = pb.Sfixed64KeyMap
+	p.BoolKeyMap = pb.BoolKeyMap
+	p.DoubleMap = pb.DoubleMap
+	p.BytesMap = pb.BytesMap
+	p.BoolMap = pb.BoolMap
+	p.FloatMap = pb.FloatMap
+	p.Status = pb.Status
+	if len(pb.StatusList) > 0 {
+		p.StatusList = pb.StatusList
+	} else {
+		p.StatusList = []Status{}
+	}
+	p.StatusMap = pb.StatusMap
+	p.OptionalStatus = pb.OptionalStatus
+	p.NestedEnum = pb.NestedEnum
+	if len(pb.NestedEnumList) > 0 {
+		p.NestedEnumList = pb.NestedEnumList
+	} else {
+		p.NestedEnumList = []Config_NestedEnum{}
+	}
+	p.NestedConfig = pb.NestedConfig
+	p.NestedConfigList = pb.NestedConfigList
+	p.NestedConfigMap = pb.NestedConfigMap
+	if pb.Parent != nil {
+		p.Parent = pb.Parent.IntoPlain()
+	}
+	if len(pb.Children) > 0 {
+		p.Children = make([]ConfigPlain, len(pb.Children))
+		for i, v := range pb.Children {
+			if v != nil {
+				p.Children[i] = *v.IntoPlain()
+			}
+		}
+	} else {
+		p.Children = []ConfigPlain{}
+	}
+}
+
+// MarshalJX encodes ConfigPlain to JSON using jx.Encoder
+func (p *ConfigPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.DoubleVal != 0 {
+		e.FieldStart("doubleVal")
+		e.Float64(p.DoubleVal)
+	}
+	if p.FloatVal != 0 {
+		e.FieldStart("floatVal")
+		e.Float32(p.FloatVal)
+	}
+	if p.Int32Val != 0 {
+		e.FieldStart("int32Val")
+		e.Int32(p.Int32Val)
+	}
+	if p.Int64Val != 0 {
+		e.FieldStart("int64Val")
+		e.Int64(p.Int64Val)
+	}
+	if p.Uint32Val != 0 {
+		e.FieldStart("uint32Val")
+		e.UInt32(p.Uint32Val)
+	}
+	if p.Uint64Val != 0 {
+		e.FieldStart("uint64Val")
+		e.UInt64(p.Uint64Val)
+	}
+	if p.Sint32Val != 0 {
+		e.FieldStart("sint32Val")
+		e.Int32(p.Sint32Val)
+	}
+	if p.Sint64Val != 0 {
+		e.FieldStart("sint64Val")
+		e.Int64(p.Sint64Val)
+	}
+	if p.Fixed32Val != 0 {
+		e.FieldStart("fixed32Val")
+		e.UInt32(p.Fixed32Val)
+	}
+	if p.Fixed64Val != 0 {
+		e.FieldStart("fixed64Val")
+		e.UInt64(p.Fixed64Val)
+	}
+	if p.Sfixed32Val != 0 {
+		e.FieldStart("sfixed32Val")
+		e.Int32(p.Sfixed32Val)
+	}
+	if p.Sfixed64Val != 0 {
+		e.FieldStart("sfixed64Val")
+		e.Int64(p.Sfixed64Val)
+	}
+	if p.BoolVal {
+		e.FieldStart("boolVal")
+		e.Bool(p.BoolVal)
+	}
+	if p.StringVal != "" {
+		e.FieldStart("stringVal")
+		e.Str(p.StringVal)
+	}
+	if len(p.BytesVal) > 0 {
+		e.FieldStart("bytesVal")
+		e.Base64(p.BytesVal)
+	}
+	if p.OptionalString != nil {
+		e.FieldStart("optionalString")
+		e.Str(*p.OptionalString)
+	}
+	if p.OptionalInt != nil {
+		e.FieldStart("optionalInt")
+		e.Int32(*p.OptionalInt)
+	}
+	if p.OptionalBool != nil {
+		e.FieldStart("optionalBool")
+		e.Bool(*p.OptionalBool)
+	}
+	if p.OptionalDouble != nil {
+		e.FieldStart("optionalDouble")
+		e.Float64(*p.OptionalDouble)
+	}
+	if p.OptionalBytes != nil {
+		e.FieldStart("optionalBytes")
+		e.Base64(*p.OptionalBytes)
+	}
+	if len(p.StringList) > 0 {
+		e.FieldStart("stringList")
+		e.ArrStart()
+		for _, v := range p.StringList {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.IntList) > 0 {
+		e.FieldStart("intList")
+		e.ArrStart()
+		for _, v := range p.IntList {
+			e.Int32(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.DoubleList) > 0 {
+		e.FieldStart("doubleList")
+		e.ArrStart()
+		for _, v := range p.DoubleList {
+			e.Float64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.BytesList) > 0 {
+		e.FieldStart("bytesList")
+		e.ArrStart()
+		for _, v := range p.BytesList {
+			e.Base64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.BoolList) > 0 {
+		e.FieldStart("boolList")
+		e.ArrStart()
+		for _, v := range p.BoolList {
+			e.Bool(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.FloatList) > 0 {
+		e.FieldStart("floatList")
+		e.ArrStart()
+		for _, v := range p.FloatList {
+			e.Float32(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.Int64List) > 0 {
+		e.FieldStart("int64List")
+		e.ArrStart()
+		for _, v := range p.Int64List {
+			e.Int64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.Uint32List) > 0 {
+		e.FieldStart("uint32List")
+		e.ArrStart()
+		for _, v := range p.Uint32List {
+			e.UInt32(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.Uint64List) > 0 {
+		e.FieldStart("uint64List")
+		e.ArrStart()
+		for _, v := range p.Uint64List {
+			e.UInt64(v)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("stringMap")
+	e.ObjStart()
+	for k, v := range p.StringMap {
+		e.FieldStart(k)
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("intMap")
+	e.ObjStart()
+	for k, v := range p.IntMap {
+		e.FieldStart(k)
+		e.Int32(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("intKeyMap")
+	e.ObjStart()
+	for k, v := range p.IntKeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("nestedMap")
+	e.ObjStart()
+	for k, v := range p.NestedMap {
+		e.FieldStart(k)
+		v.MarshalJX(e)
+	}
+	e.ObjEnd()
+	e.FieldStart("int64KeyMap")
+	e.ObjStart()
+	for k, v := range p.Int64KeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("uint32KeyMap")
+	e.ObjStart()
+	for k, v := range p.Uint32KeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("uint64KeyMap")
+	e.ObjStart()
+	for k, v := range p.Uint64KeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("sint32KeyMap")
+	e.ObjStart()
+	for k, v := range p.Sint32KeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("sint64KeyMap")
+	e.ObjStart()
+	for k, v := range p.Sint64KeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("fixed32KeyMap")
+	e.ObjStart()
+	for k, v := range p.Fixed32KeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("fixed64KeyMap")
+	e.ObjStart()
+	for k, v := range p.Fixed64KeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("sfixed32KeyMap")
+	e.ObjStart()
+	for k, v := range p.Sfixed32KeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("sfixed64KeyMap")
+	e.ObjStart()
+	for k, v := range p.Sfixed64KeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("boolKeyMap")
+	e.ObjStart()
+	for k, v := range p.BoolKeyMap {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("doubleMap")
+	e.ObjStart()
+	for k, v := range p.DoubleMap {
+		e.FieldStart(k)
+		e.Float64(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("bytesMap")
+	e.ObjStart()
+	for k, v := range p.BytesMap {
+		e.FieldStart(k)
+		e.Base64(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("boolMap")
+	e.ObjStart()
+	for k, v := range p.BoolMap {
+		e.FieldStart(k)
+		e.Bool(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("floatMap")
+	e.ObjStart()
+	for k, v := range p.FloatMap {
+		e.FieldStart(k)
+		e.Float32(v)
+	}
+	e.ObjEnd()
+	if p.Status != 0 {
+		e.FieldStart("status")
+		e.Int32(int32(p.Status))
+	}
+	if len(p.StatusList) > 0 {
+		e.FieldStart("statusList")
+		e.ArrStart()
+		for _, v := range p.StatusList {
+			e.Int32(int32(v))
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("statusMap")
+	e.ObjStart()
+	for k, v := range p.StatusMap {
+		e.FieldStart(k)
+		e.Int32(int32(v))
+	}
+	e.ObjEnd()
+	if p.OptionalStatus != nil {
+		e.FieldStart("optionalStatus")
+		e.Int32(int32(*p.OptionalStatus))
+	}
+	if p.NestedEnum != 0 {
+		e.FieldStart("nestedEnum")
+		e.Int32(int32(p.NestedEnum))
+	}
+	if len(p.NestedEnumList) > 0 {
+		e.FieldStart("nestedEnumList")
+		e.ArrStart()
+		for _, v := range p.NestedEnumList {
+			e.Int32(int32(v))
+		}
+		e.ArrEnd()
+	}
+	if p.NestedConfig != nil {
+		e.FieldStart("nestedConfig")
+		p.NestedConfig.MarshalJX(e)
+	}
+	if p.NestedConfigList != nil {
+		e.FieldStart("nestedConfigList")
+		e.ArrStart()
+		for _, v := range p.NestedConfigList {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("nestedConfigMap")
+	e.ObjStart()
+	for k, v := range p.NestedConfigMap {
+		e.FieldStart(k)
+		v.MarshalJX(e)
+	}
+	e.ObjEnd()
+	if p.Parent != nil {
+		e.FieldStart("parent")
+		p.Parent.MarshalJX(e)
+	}
+	if len(p.Children) > 0 {
+		e.FieldStart("children")
+		e.ArrStart()
+		for _, v := range p.Children {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *ConfigPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes ConfigPlain from JSON using jx.Decoder
+func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "doubleVal":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.DoubleVal = v
+		case "floatVal":
+			v, err := d.Float32()
+			if err != nil {
+				return err
+			}
+			p.FloatVal = v
+		case "int32Val":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Int32Val = v
+		case "int64Val":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Int64Val = v
+		case "uint32Val":
+			v, err := d.UInt32()
+			if err != nil {
+				return err
+			}
+			p.Uint32Val = v
+		case "uint64Val":
+			v, err := d.UInt64()
+			if err != nil {
+				return err
+			}
+			p.Uint64Val = v
+		case "sint32Val":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Sint32Val = v
+		case "sint64Val":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Sint64Val = v
+		case "fixed32Val":
+			v, err := d.UInt32()
+			if err != nil {
+				return err
+			}
+			p.Fixed32Val = v
+		case "fixed64Val":
+			v, err := d.UInt64()
+			if err != nil {
+				return err
+			}
+			p.Fixed64Val = v
+		case "sfixed32Val":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Sfixed32Val = v
+		case "sfixed64Val":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Sfixed64Val = v
+		case "boolVal":
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.BoolVal = v
+		case "stringVal":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.StringVal = v
+		case "bytesVal":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.BytesVal = v
+		case "optionalString":
+			if d.Next() == jx.Null {
+				p.OptionalString = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.OptionalString = &v
+		case "optionalInt":
+			if d.Next() == jx.Null {
+				p.OptionalInt = nil
+				return d.Null()
+			}
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.OptionalInt = &v
+		case "optionalBool":
+			if d.Next() == jx.Null {
+				p.OptionalBool = nil
+				return d.Null()
+			}
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.OptionalBool = &v
+		case "optionalDouble":
+			if d.Next() == jx.Null {
+				p.OptionalDouble = nil
+				return d.Null()
+			}
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.OptionalDouble = &v
+		case "optionalBytes":
+			if d.Next() == jx.Null {
+				p.OptionalBytes = nil
+				return d.Null()
+			}
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.OptionalBytes = &v
+		case "stringList":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.StringList = append(p.StringList, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "intList":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int32()
+				if err != nil {
+					return err
+				}
+				p.IntList = append(p.IntList, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "doubleList":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Float64()
+				if err != nil {
+					return err
+				}
+				p.DoubleList = append(p.DoubleList, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "bytesList":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Base64()
+				if err != nil {
+					return err
+				}
+				p.BytesList = append(p.BytesList, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "boolList":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Bool()
+				if err != nil {
+					return err
+				}
+				p.BoolList = append(p.BoolList, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "floatList":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Float32()
+				if err != nil {
+					return err
+				}
+				p.FloatList = append(p.FloatList, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "int64List":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int64()
+				if err != nil {
+					return err
+				}
+				p.Int64List = append(p.Int64List, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "uint32List":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.UInt32()
+				if err != nil {
+					return err
+				}
+				p.Uint32List = append(p.Uint32List, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "uint64List":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.UInt64()
+				if err != nil {
+					return err
+				}
+				p.Uint64List = append(p.Uint64List, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "stringMap":
+			if p.StringMap == nil {
+				p.StringMap = make(map[string]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.StringMap[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "intMap":
+			if p.IntMap == nil {
+				p.IntMap = make(map[string]int32)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Int32()
+				if err != nil {
+					return err
+				}
+				p.IntMap[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "intKeyMap":
+			if p.IntKeyMap == nil {
+				p.IntKeyMap = make(map[int32]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_k, err := strconv.ParseInt(key, 10, 32)
+				if err != nil {
+					return err
+				}
+				_mapKey := int32(_k)
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.IntKeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "nestedMap":
+			if p.NestedMap == nil {
+				p.NestedMap = make(map[string]*ConfigPlain)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				p.NestedMap[key] = &ConfigPlain{}
+				if err := p.NestedMap[key].UnmarshalJX(d); err != nil {
+					return err
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "int64KeyMap":
+			if p.Int64KeyMap == nil {
+				p.Int64KeyMap = make(map[int64]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_mapKey, err := strconv.ParseInt(key, 10, 64)
+				if err != nil {
+					return err
+				}
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Int64KeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "uint32KeyMap":
+			if p.Uint32KeyMap == nil {
+				p.Uint32KeyMap = make(map[uint32]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_k, err := strconv.ParseUint(key, 10, 32)
+				if err != nil {
+					return err
+				}
+				_mapKey := uint32(_k)
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Uint32KeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "uint64KeyMap":
+			if p.Uint64KeyMap == nil {
+				p.Uint64KeyMap = make(map[uint64]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_mapKey, err := strconv.ParseUint(key, 10, 64)
+				if err != nil {
+					return err
+				}
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Uint64KeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "sint32KeyMap":
+			if p.Sint32KeyMap == nil {
+				p.Sint32KeyMap = make(map[int32]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_k, err := strconv.ParseInt(key, 10, 32)
+				if err != nil {
+					return err
+				}
+				_mapKey := int32(_k)
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Sint32KeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "sint64KeyMap":
+			if p.Sint64KeyMap == nil {
+				p.Sint64KeyMap = make(map[int64]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_mapKey, err := strconv.ParseInt(key, 10, 64)
+				if err != nil {
+					return err
+				}
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Sint64KeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "fixed32KeyMap":
+			if p.Fixed32KeyMap == nil {
+				p.Fixed32KeyMap = make(map[uint32]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_k, err := strconv.ParseUint(key, 10, 32)
+				if err != nil {
+					return err
+				}
+				_mapKey := uint32(_k)
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Fixed32KeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "fixed64KeyMap":
+			if p.Fixed64KeyMap == nil {
+				p.Fixed64KeyMap = make(map[uint64]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_mapKey, err := strconv.ParseUint(key, 10, 64)
+				if err != nil {
+					return err
+				}
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Fixed64KeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "sfixed32KeyMap":
+			if p.Sfixed32KeyMap == nil {
+				p.Sfixed32KeyMap = make(map[int32]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_k, err := strconv.ParseInt(key, 10, 32)
+				if err != nil {
+					return err
+				}
+				_mapKey := int32(_k)
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Sfixed32KeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "sfixed64KeyMap":
+			if p.Sfixed64KeyMap == nil {
+				p.Sfixed64KeyMap = make(map[int64]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_mapKey, err := strconv.ParseInt(key, 10, 64)
+				if err != nil {
+					return err
+				}
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Sfixed64KeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "boolKeyMap":
+			if p.BoolKeyMap == nil {
+				p.BoolKeyMap = make(map[bool]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_mapKey, err := strconv.ParseBool(key)
+				if err != nil {
+					return err
+				}
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.BoolKeyMap[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "doubleMap":
+			if p.DoubleMap == nil {
+				p.DoubleMap = make(map[string]float64)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Float64()
+				if err != nil {
+					return err
+				}
+				p.DoubleMap[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "bytesMap":
+			if p.BytesMap == nil {
+				p.BytesMap = make(map[string][]byte)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Base64()
+				if err != nil {
+					return err
+				}
+				p.BytesMap[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "boolMap":
+			if p.BoolMap == nil {
+				p.BoolMap = make(map[string]bool)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Bool()
+				if err != nil {
+					return err
+				}
+				p.BoolMap[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "floatMap":
+			if p.FloatMap == nil {
+				p.FloatMap = make(map[string]float32)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Float32()
+				if err != nil {
+					return err
+				}
+				p.FloatMap[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "status":
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
+			if err != nil {
+				return err
+			}
+			p.Status = Status(v)
+		case "statusList":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.Status", Status_value)
+				if err != nil {
+					return err
+				}
+				p.StatusList = append(p.StatusList, Status(v))
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "statusMap":
+			if p.StatusMap == nil {
+				p.StatusMap = make(map[string]Status)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := enumjx.Decode(d, "full.Status", Status_value)
+				if err != nil {
+					return err
+				}
+				p.StatusMap[key] = Status(v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "optionalStatus":
+			if d.Next() == jx.Null {
+				p.OptionalStatus = nil
+				return d.Null()
+			}
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
+			if err != nil {
+				return err
+			}
+			_ev := Status(v)
+			p.OptionalStatus = &_ev
+		case "nestedEnum":
+			v, err := enumjx.Decode(d, "full.Config.NestedEnum", Config_NestedEnum_value)
+			if err != nil {
+				return err
+			}
+			p.NestedEnum = Config_NestedEnum(v)
+		case "nestedEnumList":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.Config.NestedEnum", Config_NestedEnum_value)
+				if err != nil {
+					return err
+				}
+				p.NestedEnumList = append(p.NestedEnumList, Config_NestedEnum(v))
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "nestedConfig":
+			p.NestedConfig = &Config_NestedConfig{}
+			if err := p.NestedConfig.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "nestedConfigList":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v Config_NestedConfig
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.NestedConfigList = append(p.NestedConfigList, &v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "nestedConfigMap":
+			if p.NestedConfigMap == nil {
+				p.NestedConfigMap = make(map[string]*Config_NestedConfig)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				p.NestedConfigMap[key] = &Config_NestedConfig{}
+				if err := p.NestedConfigMap[key].UnmarshalJX(d); err != nil {
+					return err
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "parent":
+			p.Parent = &ConfigPlain{}
+			if err := p.Parent.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "children":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v ConfigPlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Children = append(p.Children, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *ConfigPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes ConfigPlain in the protobuf wire format of Config
+func (p *ConfigPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends ConfigPlain encoded in the protobuf wire format of Config to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *ConfigPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.DoubleVal; v != 0 || math.Signbit(float64(v)) {
+		b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+	}
+	if v := p.FloatVal; v != 0 || math.Signbit(float64(v)) {
+		b = protowire.AppendTag(b, 2, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, math.Float32bits(float32(v)))
+	}
+	if v := p.Int32Val; v != 0 {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.Int64Val; v != 0 {
+		b = protowire.AppendTag(b, 4, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.Uint32Val; v != 0 {
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.Uint64Val; v != 0 {
+		b = protowire.AppendTag(b, 6, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.Sint32Val; v != 0 {
+		b = protowire.AppendTag(b, 7, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(v)))
+	}
+	if v := p.Sint64Val; v != 0 {
+		b = protowire.AppendTag(b, 8, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(v)))
+	}
+	if v := p.Fixed32Val; v != 0 {
+		b = protowire.AppendTag(b, 9, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, uint32(v))
+	}
+	if v := p.Fixed64Val; v != 0 {
+		b = protowire.AppendTag(b, 10, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, uint64(v))
+	}
+	if v := p.Sfixed32Val; v != 0 {
+		b = protowire.AppendTag(b, 11, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, uint32(v))
+	}
+	if v := p.Sfixed64Val; v != 0 {
+		b = protowire.AppendTag(b, 12, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, uint64(v))
+	}
+	if v := p.BoolVal; v {
+		b = protowire.AppendTag(b, 13, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+	}
+	if v := p.StringVal; v != "" {
+		b = protowire.AppendTag(b, 14, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.BytesVal; len(v) > 0 {
+		b = protowire.AppendTag(b, 15, protowire.BytesType)
+		b = protowire.AppendBytes(b, v)
+	}
+	if p.OptionalString != nil {
+		b = protowire.AppendTag(b, 20, protowire.BytesType)
+		b = protowire.AppendString(b, *p.OptionalString)
+	}
+	if p.OptionalInt != nil {
+		b = protowire.AppendTag(b, 21, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(*p.OptionalInt))
+	}
+	if p.OptionalBool != nil {
+		b = protowire.AppendTag(b, 22, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(*p.OptionalBool))
+	}
+	if p.OptionalDouble != nil {
+		b = protowire.AppendTag(b, 23, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(*p.OptionalDouble)))
+	}
+	if p.OptionalBytes != nil {
+		b = protowire.AppendTag(b, 24, protowire.BytesType)
+		b = protowire.AppendBytes(b, *p.OptionalBytes)
+	}
+	for _, v := range p.StringList {
+		b = protowire.AppendTag(b, 30, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if len(p.IntList) > 0 {
+		b = protowire.AppendTag(b, 31, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.IntList {
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if len(p.DoubleList) > 0 {
+		b = protowire.AppendTag(b, 32, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.DoubleList {
+			b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, v := range p.BytesList {
+		b = protowire.AppendTag(b, 33, protowire.BytesType)
+		b = protowire.AppendBytes(b, v)
+	}
+	if len(p.BoolList) > 0 {
+		b = protowire.AppendTag(b, 34, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.BoolList {
+			b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if len(p.FloatList) > 0 {
+		b = protowire.AppendTag(b, 35, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.FloatList {
+			b = protowire.AppendFixed32(b, math.Float32bits(float32(v)))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if len(p.Int64List) > 0 {
+		b = protowire.AppendTag(b, 36, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.Int64List {
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if len(p.Uint32List) > 0 {
+		b = protowire.AppendTag(b, 37, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.Uint32List {
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if len(p.Uint64List) > 0 {
+		b = protowire.AppendTag(b, 38, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.Uint64List {
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StringMap) {
+		v := p.StringMap[k]
+		b = protowire.AppendTag(b, 40, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.IntMap) {
+		v := p.IntMap[k]
+		b = protowire.AppendTag(b, 41, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.IntKeyMap) {
+		v := p.IntKeyMap[k]
+		b = protowire.AppendTag(b, 42, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.NestedMap) {
+		v := p.NestedMap[k]
+		b = protowire.AppendTag(b, 43, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_value := len(b)
+		if b, err = v.AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _value)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Int64KeyMap) {
+		v := p.Int64KeyMap[k]
+		b = protowire.AppendTag(b, 44, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Uint32KeyMap) {
+		v := p.Uint32KeyMap[k]
+		b = protowire.AppendTag(b, 45, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Uint64KeyMap) {
+		v := p.Uint64KeyMap[k]
+		b = protowire.AppendTag(b, 46, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Sint32KeyMap) {
+		v := p.Sint32KeyMap[k]
+		b = protowire.AppendTag(b, 47, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(k)))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Sint64KeyMap) {
+		v := p.Sint64KeyMap[k]
+		b = protowire.AppendTag(b, 48, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(k)))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Fixed32KeyMap) {
+		v := p.Fixed32KeyMap[k]
+		b = protowire.AppendTag(b, 49, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, uint32(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Fixed64KeyMap) {
+		v := p.Fixed64KeyMap[k]
+		b = protowire.AppendTag(b, 50, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Sfixed32KeyMap) {
+		v := p.Sfixed32KeyMap[k]
+		b = protowire.AppendTag(b, 51, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, uint32(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Sfixed64KeyMap) {
+		v := p.Sfixed64KeyMap[k]
+		b = protowire.AppendTag(b, 52, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedBoolKeys(p.BoolKeyMap) {
+		v := p.BoolKeyMap[k]
+		b = protowire.AppendTag(b, 53, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.DoubleMap) {
+		v := p.DoubleMap[k]
+		b = protowire.AppendTag(b, 60, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.BytesMap) {
+		v := p.BytesMap[k]
+		b = protowire.AppendTag(b, 61, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendBytes(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.BoolMap) {
+		v := p.BoolMap[k]
+		b = protowire.AppendTag(b, 62, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.FloatMap) {
+		v := p.FloatMap[k]
+		b = protowire.AppendTag(b, 63, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, math.Float32bits(float32(v)))
+		b = plainwire.FinishLen(b, _start)
+	}
+	if v := p.Status; v != 0 {
+		b = protowire.AppendTag(b, 70, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if len(p.StatusList) > 0 {
+		b = protowire.AppendTag(b, 71, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.StatusList {
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StatusMap) {
+		v := p.StatusMap[k]
+		b = protowire.AppendTag(b, 72, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.OptionalStatus != nil {
+		b = protowire.AppendTag(b, 73, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(*p.OptionalStatus))
+	}
+	if v := p.NestedEnum; v != 0 {
+		b = protowire.AppendTag(b, 80, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if len(p.NestedEnumList) > 0 {
+		b = protowire.AppendTag(b, 81, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.NestedEnumList {
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NestedConfig != nil {
+		b = protowire.AppendTag(b, 90, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NestedConfig); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, m := range p.NestedConfigList {
+		b = protowire.AppendTag(b, 91, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.NestedConfigMap) {
+		v := p.NestedConfigMap[k]
+		b = protowire.AppendTag(b, 92, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_value := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, v); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _value)
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Parent != nil {
+		b = protowire.AppendTag(b, 100, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Parent.AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for i := range p.Children {
+		b = protowire.AppendTag(b, 101, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Children[i].AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes ConfigPlain from the protobuf wire format of Config.
+// Unknown fields are skipped.
+func (p *ConfigPlain) UnmarshalProto(b []byte) error {
+	*p = ConfigPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.DoubleVal = math.Float64frombits(v)
+		case num == 2 && typ == protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			p.FloatVal = math.Float32frombits(v)
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Int32Val = int32(v)
+		case num == 4 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Int64Val = int64(v)
+		case num == 5 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Uint32Val = uint32(v)
+		case num == 6 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Uint64Val = v
+		case num == 7 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Sint32Val = int32(protowire.DecodeZigZag(v & math.MaxUint32))
+		case num == 8 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Sint64Val = protowire.DecodeZigZag(v)
+		case num == 9 && typ == protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			p.Fixed32Val = v
+		case num == 10 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.Fixed64Val = v
+		case num == 11 && typ == protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			p.Sfixed32Val = int32(v)
+		case num == 12 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.Sfixed64Val = int64(v)
+		case num == 13 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.BoolVal = protowire.DecodeBool(v)
+		case num == 14 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.StringVal = v
+		case num == 15 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.BytesVal = append([]byte{}, v...)
+		case num == 20 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			_tmp := v
+			p.OptionalString = &_tmp
+		case num == 21 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := int32(v)
+			p.OptionalInt = &_tmp
+		case num == 22 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := protowire.DecodeBool(v)
+			p.OptionalBool = &_tmp
+		case num == 23 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			_tmp := math.Float64frombits(v)
+			p.OptionalDouble = &_tmp
+		case num == 24 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			_tmp := append([]byte{}, v...)
+			p.OptionalBytes = &_tmp
+		case num == 30 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.StringList = append(p.StringList, v)
+		case num == 31 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.IntList = append(p.IntList, int32(x))
+			}
+		case num == 31 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.IntList = append(p.IntList, int32(v))
+		case num == 32 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeFixed64(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.DoubleList = append(p.DoubleList, math.Float64frombits(x))
+			}
+		case num == 32 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.DoubleList = append(p.DoubleList, math.Float64frombits(v))
+		case num == 33 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.BytesList = append(p.BytesList, append([]byte{}, v...))
+		case num == 34 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.BoolList = append(p.BoolList, protowire.DecodeBool(x))
+			}
+		case num == 34 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.BoolList = append(p.BoolList, protowire.DecodeBool(v))
+		case num == 35 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeFixed32(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.FloatList = append(p.FloatList, math.Float32frombits(x))
+			}
+		case num == 35 && typ == protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			p.FloatList = append(p.FloatList, math.Float32frombits(v))
+		case num == 36 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.Int64List = append(p.Int64List, int64(x))
+			}
+		case num == 36 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Int64List = append(p.Int64List, int64(v))
+		case num == 37 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.Uint32List = append(p.Uint32List, uint32(x))
+			}
+		case num == 37 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Uint32List = append(p.Uint32List, uint32(v))
+		case num == 38 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.Uint64List = append(p.Uint64List, x)
+			}
+		case num == 38 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Uint64List = append(p.Uint64List, v)
+		case num == 40 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.StringMap == nil {
+				p.StringMap = make(map[string]string)
+			}
+			p.StringMap[mk] = mv
+		case num == 41 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv int32
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = int32(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.IntMap == nil {
+				p.IntMap = make(map[string]int32)
+			}
+			p.IntMap[mk] = mv
+		case num == 42 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int32(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.IntKeyMap == nil {
+				p.IntKeyMap = make(map[int32]string)
+			}
+			p.IntKeyMap[mk] = mv
+		case num == 43 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			mv := &ConfigPlain{}
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					if err := mv.UnmarshalProto(v); err != nil {
+						return err
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.NestedMap == nil {
+				p.NestedMap = make(map[string]*ConfigPlain)
+			}
+			p.NestedMap[mk] = mv
+		case num == 44 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int64(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Int64KeyMap == nil {
+				p.Int64KeyMap = make(map[int64]string)
+			}
+			p.Int64KeyMap[mk] = mv
+		case num == 45 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk uint32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = uint32(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Uint32KeyMap == nil {
+				p.Uint32KeyMap = make(map[uint32]string)
+			}
+			p.Uint32KeyMap[mk] = mv
+		case num == 46 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk uint64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Uint64KeyMap == nil {
+				p.Uint64KeyMap = make(map[uint64]string)
+			}
+			p.Uint64KeyMap[mk] = mv
+		case num == 47 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int32(protowire.DecodeZigZag(v & math.MaxUint32))
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Sint32KeyMap == nil {
+				p.Sint32KeyMap = make(map[int32]string)
+			}
+			p.Sint32KeyMap[mk] = mv
+		case num == 48 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = protowire.DecodeZigZag(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Sint64KeyMap == nil {
+				p.Sint64KeyMap = make(map[int64]string)
+			}
+			p.Sint64KeyMap[mk] = mv
+		case num == 49 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk uint32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed32Type:
+					var v uint32
+					v, n = protowire.ConsumeFixed32(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Fixed32KeyMap == nil {
+				p.Fixed32KeyMap = make(map[uint32]string)
+			}
+			p.Fixed32KeyMap[mk] = mv
+		case num == 50 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk uint64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Fixed64KeyMap == nil {
+				p.Fixed64KeyMap = make(map[uint64]string)
+			}
+			p.Fixed64KeyMap[mk] = mv
+		case num == 51 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed32Type:
+					var v uint32
+					v, n = protowire.ConsumeFixed32(b)
+					mk = int32(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Sfixed32KeyMap == nil {
+				p.Sfixed32KeyMap = make(map[int32]string)
+			}
+			p.Sfixed32KeyMap[mk] = mv
+		case num == 52 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					mk = int64(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Sfixed64KeyMap == nil {
+				p.Sfixed64KeyMap = make(map[int64]string)
+			}
+			p.Sfixed64KeyMap[mk] = mv
+		case num == 53 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk bool
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = protowire.DecodeBool(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.BoolKeyMap == nil {
+				p.BoolKeyMap = make(map[bool]string)
+			}
+			p.BoolKeyMap[mk] = mv
+		case num == 60 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv float64
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					mv = math.Float64frombits(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.DoubleMap == nil {
+				p.DoubleMap = make(map[string]float64)
+			}
+			p.DoubleMap[mk] = mv
+		case num == 61 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv []byte
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					mv = append([]byte{}, v...)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.BytesMap == nil {
+				p.BytesMap = make(map[string][]byte)
+			}
+			p.BytesMap[mk] = mv
+		case num == 62 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv bool
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = protowire.DecodeBool(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.BoolMap == nil {
+				p.BoolMap = make(map[string]bool)
+			}
+			p.BoolMap[mk] = mv
+		case num == 63 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv float32
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.Fixed32Type:
+					var v uint32
+					v, n = protowire.ConsumeFixed32(b)
+					mv = math.Float32frombits(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.FloatMap == nil {
+				p.FloatMap = make(map[string]float32)
+			}
+			p.FloatMap[mk] = mv
+		case num == 70 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Status = Status(v)
+		case num == 71 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.StatusList = append(p.StatusList, Status(x))
+			}
+		case num == 71 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.StatusList = append(p.StatusList, Status(v))
+		case num == 72 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv Status
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = Status(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.StatusMap == nil {
+				p.StatusMap = make(map[string]Status)
+			}
+			p.StatusMap[mk] = mv
+		case num == 73 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := Status(v)
+			p.OptionalStatus = &_tmp
+		case num == 80 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.NestedEnum = Config_NestedEnum(v)
+		case num == 81 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.NestedEnumList = append(p.NestedEnumList, Config_NestedEnum(x))
+			}
+		case num == 81 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.NestedEnumList = append(p.NestedEnumList, Config_NestedEnum(v))
+		case num == 90 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &Config_NestedConfig{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.NestedConfig = m
+		case num == 91 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &Config_NestedConfig{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.NestedConfigList = append(p.NestedConfigList, m)
+		case num == 92 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			mv := &Config_NestedConfig{}
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					if err := proto.Unmarshal(v, mv); err != nil {
+						return err
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.NestedConfigMap == nil {
+				p.NestedConfigMap = make(map[string]*Config_NestedConfig)
+			}
+			p.NestedConfigMap[mk] = mv
+		case num == 100 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.Parent = &ConfigPlain{}
+			if err := p.Parent.UnmarshalProto(v); err != nil {
+				return err
+			}
+		case num == 101 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e ConfigPlain
+			if err := e.UnmarshalProto(v); err != nil {
+				return err
+			}
+			p.Children = append(p.Children, e)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// configPlainReflect describes ConfigPlain as message full.plain.ConfigPlain
+var configPlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "ConfigPlain",
+	plainreflect.Scalar(func(p *ConfigPlain) *float64 { return &p.DoubleVal }),
+	plainreflect.Scalar(func(p *ConfigPlain) *float32 { return &p.FloatVal }),
+	plainreflect.Scalar(func(p *ConfigPlain) *int32 { return &p.Int32Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *int64 { return &p.Int64Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *uint32 { return &p.Uint32Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *uint64 { return &p.Uint64Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *int32 { return &p.Sint32Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *int64 { return &p.Sint64Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *uint32 { return &p.Fixed32Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *uint64 { return &p.Fixed64Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *int32 { return &p.Sfixed32Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *int64 { return &p.Sfixed64Val }),
+	plainreflect.Scalar(func(p *ConfigPlain) *bool { return &p.BoolVal }),
+	plainreflect.Scalar(func(p *ConfigPlain) *string { return &p.StringVal }),
+	plainreflect.Scalar(func(p *ConfigPlain) *[]byte { return &p.BytesVal }),
+	plainreflect.Optional(func(p *ConfigPlain) **string { return &p.OptionalString }),
+	plainreflect.Optional(func(p *ConfigPlain) **int32 { return &p.OptionalInt }),
+	plainreflect.Optional(func(p *ConfigPlain) **bool { return &p.OptionalBool }),
+	plainreflect.Optional(func(p *ConfigPlain) **float64 { return &p.OptionalDouble }),
+	plainreflect.Optional(func(p *ConfigPlain) **[]byte { return &p.OptionalBytes }),
+	plainreflect.List(func(p *ConfigPlain) *[]string { return &p.StringList }),
+	plainreflect.List(func(p *ConfigPlain) *[]int32 { return &p.IntList }),
+	plainreflect.List(func(p *ConfigPlain) *[]float64 { return &p.DoubleList }),
+	plainreflect.List(func(p *ConfigPlain) *[][]byte { return &p.BytesList }),
+	plainreflect.List(func(p *ConfigPlain) *[]bool { return &p.BoolList }),
+	plainreflect.List(func(p *ConfigPlain) *[]float32 { return &p.FloatList }),
+	plainreflect.List(func(p *ConfigPlain) *[]int64 { return &p.Int64List }),
+	plainreflect.List(func(p *ConfigPlain) *[]uint32 { return &p.Uint32List }),
+	plainreflect.List(func(p *ConfigPlain) *[]uint64 { return &p.Uint64List }),
+	plainreflect.Map(func(p *ConfigPlain) *map[string]string { return &p.StringMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[string]int32 { return &p.IntMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[int32]string { return &p.IntKeyMap }),
+	plainreflect.MessageMap(func(p *ConfigPlain) *map[string]*ConfigPlain { return &p.NestedMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[int64]string { return &p.Int64KeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[uint32]string { return &p.Uint32KeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[uint64]string { return &p.Uint64KeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[int32]string { return &p.Sint32KeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[int64]string { return &p.Sint64KeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[uint32]string { return &p.Fixed32KeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[uint64]string { return &p.Fixed64KeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[int32]string { return &p.Sfixed32KeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[int64]string { return &p.Sfixed64KeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[bool]string { return &p.BoolKeyMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[string]float64 { return &p.DoubleMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[string][]byte { return &p.BytesMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[string]bool { return &p.BoolMap }),
+	plainreflect.Map(func(p *ConfigPlain) *map[string]float32 { return &p.FloatMap }),
+	plainreflect.Enum(func(p *ConfigPlain) *Status { return &p.Status }),
+	plainreflect.EnumList(func(p *ConfigPlain) *[]Status { return &p.StatusList }),
+	plainreflect.EnumMap(func(p *ConfigPlain) *map[string]Status { return &p.StatusMap }),
+	plainreflect.OptionalEnum(func(p *ConfigPlain) **Status { return &p.OptionalStatus }),
+	plainreflect.Enum(func(p *ConfigPlain) *Config_NestedEnum { return &p.NestedEnum }),
+	plainreflect.EnumList(func(p *ConfigPlain) *[]Config_NestedEnum { return &p.NestedEnumList }),
+	plainreflect.Message(func(p *ConfigPlain) **Config_NestedConfig { return &p.NestedConfig }),
+	plainreflect.MessageList(func(p *ConfigPlain) *[]*Config_NestedConfig { return &p.NestedConfigList }),
+	plainreflect.MessageMap(func(p *ConfigPlain) *map[string]*Config_NestedConfig { return &p.NestedConfigMap }),
+	plainreflect.Message(func(p *ConfigPlain) **ConfigPlain { return &p.Parent }),
+	plainreflect.ValueList(func(p *ConfigPlain) *[]ConfigPlain { return &p.Children }),
+)
+
+// ProtoReflect returns the reflective view of ConfigPlain backed by the struct
+func (p *ConfigPlain) ProtoReflect() protoreflect.Message {
+	return configPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of ConfigPlain
+func (p *ConfigPlain) Clone() *ConfigPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &ConfigPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of ConfigPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetConfigPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *ConfigPlain) CopyTo(dst *ConfigPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.DoubleVal = p.DoubleVal
+	dst.FloatVal = p.FloatVal
+	dst.Int32Val = p.Int32Val
+	dst.Int64Val = p.Int64Val
+	dst.Uint32Val = p.Uint32Val
+	dst.Uint64Val = p.Uint64Val
+	dst.Sint32Val = p.Sint32Val
+	dst.Sint64Val = p.Sint64Val
+	dst.Fixed32Val = p.Fixed32Val
+	dst.Fixed64Val = p.Fixed64Val
+	dst.Sfixed32Val = p.Sfixed32Val
+	dst.Sfixed64Val = p.Sfixed64Val
+	dst.BoolVal = p.BoolVal
+	dst.StringVal = p.StringVal
+	dst.BytesVal = append(dst.BytesVal[:0], p.BytesVal...)
+	if p.OptionalString == nil {
+		dst.OptionalString = nil
+	} else {
+		if dst.OptionalString == nil {
+			dst.OptionalString = new(string)
+		}
+		*dst.OptionalString = *p.OptionalString
+	}
+	if p.OptionalInt == nil {
+		dst.OptionalInt = nil
+	} else {
+		if dst.OptionalInt == nil {
+			dst.OptionalInt = new(int32)
+		}
+		*dst.OptionalInt = *p.OptionalInt
+	}
+	if p.OptionalBool == nil {
+		dst.OptionalBool = nil
+	} else {
+		if dst.OptionalBool == nil {
+			dst.OptionalBool = new(bool)
+		}
+		*dst.OptionalBool = *p.OptionalBool
+	}
+	if p.OptionalDouble == nil {
+		dst.OptionalDouble = nil
+	} else {
+		if dst.OptionalDouble == nil {
+			dst.OptionalDouble = new(float64)
+		}
+		*dst.OptionalDouble = *p.OptionalDouble
+	}
+	if p.OptionalBytes == nil {
+		dst.OptionalBytes = nil
+	} else {
+		if dst.OptionalBytes == nil {
+			dst.OptionalBytes = new([]byte)
+		}
+		*dst.OptionalBytes = append((*dst.OptionalBytes)[:0], *p.OptionalBytes...)
+	}
+	dst.StringList = append(dst.StringList[:0], p.StringList...)
+	dst.IntList = append(dst.IntList[:0], p.IntList...)
+	dst.DoubleList = append(dst.DoubleList[:0], p.DoubleList...)
+	dst.BytesList = append(dst.BytesList[:0], p.BytesList...)
+	for i, v := range dst.BytesList {
+		dst.BytesList[i] = append([]byte(nil), v...)
+	}
+	dst.BoolList = append(dst.BoolList[:0], p.BoolList...)
+	dst.FloatList = append(dst.FloatList[:0], p.FloatList...)
+	dst.Int64List = append(dst.Int64List[:0], p.Int64List...)
+	dst.Uint32List = append(dst.Uint32List[:0], p.Uint32List...)
+	dst.Uint64List = append(dst.Uint64List[:0], p.Uint64List...)
+	for k := range dst.StringMap {
+		delete(dst.StringMap, k)
+	}
+	if dst.StringMap == nil && p.StringMap != nil {
+		dst.StringMap = make(map[string]string, len(p.StringMap))
+	}
+	for k, v := range p.StringMap {
+		dst.StringMap[k] = v
+	}
+	for k := range dst.IntMap {
+		delete(dst.IntMap, k)
+	}
+	if dst.IntMap == nil && p.IntMap != nil {
+		dst.IntMap = make(map[string]int32, len(p.IntMap))
+	}
+	for k, v := range p.IntMap {
+		dst.IntMap[k] = v
+	}
+	for k := range dst.IntKeyMap {
+		delete(dst.IntKeyMap, k)
+	}
+	if dst.IntKeyMap == nil && p.IntKeyMap != nil {
+		dst.IntKeyMap = make(map[int32]string, len(p.IntKeyMap))
+	}
+	for k, v := range p.IntKeyMap {
+		dst.IntKeyMap[k] = v
+	}
+	for k := range dst.NestedMap {
+		if _, ok := p.NestedMap[k]; !ok {
+			delete(dst.NestedMap, k)
+		}
+	}
+	if dst.NestedMap == nil && p.NestedMap != nil {
+		dst.NestedMap = make(map[string]*ConfigPlain, len(p.NestedMap))
+	}
+	for k, v := range p.NestedMap {
+		if v == nil {
+			dst.NestedMap[k] = nil
+			continue
+		}
+		d := dst.NestedMap[k]
+		if d == nil {
+			d = new(ConfigPlain)
+		}
+		v.CopyTo(d)
+		dst.NestedMap[k] = d
+	}
+	for k := range dst.Int64KeyMap {
+		delete(dst.Int64KeyMap, k)
+	}
+	if dst.Int64KeyMap == nil && p.Int64KeyMap != nil {
+		dst.Int64KeyMap = make(map[int64]string, len(p.Int64KeyMap))
+	}
+	for k, v := range p.Int64KeyMap {
+		dst.Int64KeyMap[k] = v
+	}
+	for k := range dst.Uint32KeyMap {
+		delete(dst.Uint32KeyMap, k)
+	}
+	if dst.Uint32KeyMap == nil && p.Uint32KeyMap != nil {
+		dst.Uint32KeyMap = make(map[uint32]string, len(p.Uint32KeyMap))
+	}
+	for k, v := range p.Uint32KeyMap {
+		dst.Uint32KeyMap[k] = v
+	}
+	for k := range dst.Uint64KeyMap {
+		delete(dst.Uint64KeyMap, k)
+	}
+	if dst.Uint64KeyMap == nil && p.Uint64KeyMap != nil {
+		dst.Uint64KeyMap = make(map[uint64]string, len(p.Uint64KeyMap))
+	}
+	for k, v := range p.Uint64KeyMap {
+		dst.Uint64KeyMap[k] = v
+	}
+	for k := range dst.Sint32KeyMap {
+		delete(dst.Sint32KeyMap, k)
+	}
+	if dst.Sint32KeyMap == nil && p.Sint32KeyMap != nil {
+		dst.Sint32KeyMap = make(map[int32]string, len(p.Sint32KeyMap))
+	}
+	for k, v := range p.Sint32KeyMap {
+		dst.Sint32KeyMap[k] = v
+	}
+	for k := range dst.Sint64KeyMap {
+		delete(dst.Sint64KeyMap, k)
+	}
+	if dst.Sint64KeyMap == nil && p.Sint64KeyMap != nil {
+		dst.Sint64KeyMap = make(map[int64]string, len(p.Sint64KeyMap))
+	}
+	for k, v := range p.Sint64KeyMap {
+		dst.Sint64KeyMap[k] = v
+	}
+	for k := range dst.Fixed32KeyMap {
+		delete(dst.Fixed32KeyMap, k)
+	}
+	if dst.Fixed32KeyMap == nil && p.Fixed32KeyMap != nil {
+		dst.Fixed32KeyMap = make(map[uint32]string, len(p.Fixed32KeyMap))
+	}
+	for k, v := range p.Fixed32KeyMap {
+		dst.Fixed32KeyMap[k] = v
+	}
+	for k := range dst.Fixed64KeyMap {
+		delete(dst.Fixed64KeyMap, k)
+	}
+	if dst.Fixed64KeyMap == nil && p.Fixed64KeyMap != nil {
+		dst.Fixed64KeyMap = make(map[uint64]string, len(p.Fixed64KeyMap))
+	}
+	for k, v := range p.Fixed64KeyMap {
+		dst.Fixed64KeyMap[k] = v
+	}
+	for k := range dst.Sfixed32KeyMap {
+		delete(dst.Sfixed32KeyMap, k)
+	}
+	if dst.Sfixed32KeyMap == nil && p.Sfixed32KeyMap != nil {
+		dst.Sfixed32KeyMap = make(map[int32]string, len(p.Sfixed32KeyMap))
+	}
+	for k, v := range p.Sfixed32KeyMap {
+		dst.Sfixed32KeyMap[k] = v
+	}
+	for k := range dst.Sfixed64KeyMap {
+		delete(dst.Sfixed64KeyMap, k)
+	}
+	if dst.Sfixed64KeyMap == nil && p.Sfixed64KeyMap != nil {
+		dst.Sfixed64KeyMap = make(map[int64]string, len(p.Sfixed64KeyMap))
+	}
+	for k, v := range p.Sfixed64KeyMap {
+		dst.Sfixed64KeyMap[k] = v
+	}
+	for k := range dst.BoolKeyMap {
+		delete(dst.BoolKeyMap, k)
+	}
+	if dst.BoolKeyMap == nil && p.BoolKeyMap != nil {
+		dst.BoolKeyMap = make(map[bool]string, len(p.BoolKeyMap))
+	}
+	for k, v := range p.BoolKeyMap {
+		dst.BoolKeyMap[k] = v
+	}
+	for k := range dst.DoubleMap {
+		delete(dst.DoubleMap, k)
+	}
+	if dst.DoubleMap == nil && p.DoubleMap != nil {
+		dst.DoubleMap = make(map[string]float64, len(p.DoubleMap))
+	}
+	for k, v := range p.DoubleMap {
+		dst.DoubleMap[k] = v
+	}
+	for k := range dst.BytesMap {
+		delete(dst.BytesMap, k)
+	}
+	if dst.BytesMap == nil && p.BytesMap != nil {
+		dst.BytesMap = make(map[string][]byte, len(p.BytesMap))
+	}
+	for k, v := range p.BytesMap {
+		dst.BytesMap[k] = append([]byte(nil), v...)
+	}
+	for k := range dst.BoolMap {
+		delete(dst.BoolMap, k)
+	}
+	if dst.BoolMap == nil && p.BoolMap != nil {
+		dst.BoolMap = make(map[string]bool, len(p.BoolMap))
+	}
+	for k, v := range p.BoolMap {
+		dst.BoolMap[k] = v
+	}
+	for k := range dst.FloatMap {
+		delete(dst.FloatMap, k)
+	}
+	if dst.FloatMap == nil && p.FloatMap != nil {
+		dst.FloatMap = make(map[string]float32, len(p.FloatMap))
+	}
+	for k, v := range p.FloatMap {
+		dst.FloatMap[k] = v
+	}
+	dst.Status = p.Status
+	dst.StatusList = append(dst.StatusList[:0], p.StatusList...)
+	for k := range dst.StatusMap {
+		delete(dst.StatusMap, k)
+	}
+	if dst.StatusMap == nil && p.StatusMap != nil {
+		dst.StatusMap = make(map[string]Status, len(p.StatusMap))
+	}
+	for k, v := range p.StatusMap {
+		dst.StatusMap[k] = v
+	}
+	if p.OptionalStatus == nil {
+		dst.OptionalStatus = nil
+	} else {
+		if dst.OptionalStatus == nil {
+			dst.OptionalStatus = new(Status)
+		}
+		*dst.OptionalStatus = *p.OptionalStatus
+	}
+	dst.NestedEnum = p.NestedEnum
+	dst.NestedEnumList = append(dst.NestedEnumList[:0], p.NestedEnumList...)
+	if p.NestedConfig == nil {
+		dst.NestedConfig = nil
+	} else {
+		if dst.NestedConfig == nil {
+			dst.NestedConfig = new(Config_NestedConfig)
+		} else {
+			proto.Reset(dst.NestedConfig)
+		}
+		proto.Merge(dst.NestedConfig, p.NestedConfig)
+	}
+	if cap(dst.NestedConfigList) < len(p.NestedConfigList) {
+		dst.NestedConfigList = append(dst.NestedConfigList[:cap(dst.NestedConfigList)], make([]*Config_NestedConfig, len(p.NestedConfigList)-cap(dst.NestedConfigList))...)
+	} else {
+		dst.NestedConfigList = dst.NestedConfigList[:len(p.NestedConfigList)]
+	}
+	for i, v := range p.NestedConfigList {
+		if v == nil {
+			dst.NestedConfigList[i] = nil
+			continue
+		}
+		if dst.NestedConfigList[i] == nil {
+			dst.NestedConfigList[i] = new(Config_NestedConfig)
+		} else {
+			proto.Reset(dst.NestedConfigList[i])
+		}
+		proto.Merge(dst.NestedConfigList[i], v)
+	}
+	for k := range dst.NestedConfigMap {
+		if _, ok := p.NestedConfigMap[k]; !ok {
+			delete(dst.NestedConfigMap, k)
+		}
+	}
+	if dst.NestedConfigMap == nil && p.NestedConfigMap != nil {
+		dst.NestedConfigMap = make(map[string]*Config_NestedConfig, len(p.NestedConfigMap))
+	}
+	for k, v := range p.NestedConfigMap {
+		if v == nil {
+			dst.NestedConfigMap[k] = nil
+			continue
+		}
+		d := dst.NestedConfigMap[k]
+		if d == nil {
+			d = new(Config_NestedConfig)
+		} else {
+			proto.Reset(d)
+		}
+		proto.Merge(d, v)
+		dst.NestedConfigMap[k] = d
+	}
+	if p.Parent == nil {
+		dst.Parent = nil
+	} else {
+		if dst.Parent == nil {
+			dst.Parent = new(ConfigPlain)
+		}
+		p.Parent.CopyTo(dst.Parent)
+	}
+	if cap(dst.Children) < len(p.Children) {
+		dst.Children = make([]ConfigPlain, len(p.Children))
+	} else {
+		dst.Children = dst.Children[:len(p.Children)]
+	}
+	for i := range p.Children {
+		p.Children[i].CopyTo(&dst.Children[i])
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *ConfigPlain) Equal(other *ConfigPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.DoubleVal != other.DoubleVal {
+		return false
+	}
+	if p.FloatVal != other.FloatVal {
+		return false
+	}
+	if p.Int32Val != other.Int32Val {
+		return false
+	}
+	if p.Int64Val != other.Int64Val {
+		return false
+	}
+	if p.Uint32Val != other.Uint32Val {
+		return false
+	}
+	if p.Uint64Val != other.Uint64Val {
+		return false
+	}
+	if p.Sint32Val != other.Sint32Val {
+		return false
+	}
+	if p.Sint64Val != other.Sint64Val {
+		return false
+	}
+	if p.Fixed32Val != other.Fixed32Val {
+		return false
+	}
+	if p.Fixed64Val != other.Fixed64Val {
+		return false
+	}
+	if p.Sfixed32Val != other.Sfixed32Val {
+		return false
+	}
+	if p.Sfixed64Val != other.Sfixed64Val {
+		return false
+	}
+	if p.BoolVal != other.BoolVal {
+		return false
+	}
+	if p.StringVal != other.StringVal {
+		return false
+	}
+	if !bytes.Equal(p.BytesVal, other.BytesVal) {
+		return false
+	}
+	if (p.OptionalString == nil) != (other.OptionalString == nil) || p.OptionalString != nil && *p.OptionalString != *other.OptionalString {
+		return false
+	}
+	if (p.OptionalInt == nil) != (other.OptionalInt == nil) || p.OptionalInt != nil && *p.OptionalInt != *other.OptionalInt {
+		return false
+	}
+	if (p.OptionalBool == nil) != (other.OptionalBool == nil) || p.OptionalBool != nil && *p.OptionalBool != *other.OptionalBool {
+		return false
+	}
+	if (p.OptionalDouble == nil) != (other.OptionalDouble == nil) || p.OptionalDouble != nil && *p.OptionalDouble != *other.OptionalDouble {
+		return false
+	}
+	if (p.OptionalBytes == nil) != (other.OptionalBytes == nil) || p.OptionalBytes != nil && !bytes.Equal(*p.OptionalBytes, *other.OptionalBytes) {
+		return false
+	}
+	if !slices.Equal(p.StringList, other.StringList) {
+		return false
+	}
+	if !slices.Equal(p.IntList, other.IntList) {
+		return false
+	}
+	if !slices.Equal(p.DoubleList, other.DoubleList) {
+		return false
+	}
+	if len(p.BytesList) != len(other.BytesList) {
+		return false
+	}
+	for i := range p.BytesList {
+		if !bytes.Equal(p.BytesList[i], other.BytesList[i]) {
+			return false
+		}
+	}
+	if !slices.Equal(p.BoolList, other.BoolList) {
+		return false
+	}
+	if !slices.Equal(p.FloatList, other.FloatList) {
+		return false
+	}
+	if !slices.Equal(p.Int64List, other.Int64List) {
+		return false
+	}
+	if !slices.Equal(p.Uint32List, other.Uint32List) {
+		return false
+	}
+	if !slices.Equal(p.Uint64List, other.Uint64List) {
+		return false
+	}
+	if !maps.Equal(p.StringMap, other.StringMap) {
+		return false
+	}
+	if !maps.Equal(p.IntMap, other.IntMap) {
+		return false
+	}
+	if !maps.Equal(p.IntKeyMap, other.IntKeyMap) {
+		return false
+	}
+	if len(p.NestedMap) != len(other.NestedMap) {
+		return false
+	}
+	for k, v := range p.NestedMap {
+		w, ok := other.NestedMap[k]
+		if !ok || !v.Equal(w) {
+			return false
+		}
+	}
+	if !maps.Equal(p.Int64KeyMap, other.Int64KeyMap) {
+		return false
+	}
+	if !maps.Equal(p.Uint32KeyMap, other.Uint32KeyMap) {
+		return false
+	}
+	if !maps.Equal(p.Uint64KeyMap, other.Uint64KeyMap) {
+		return false
+	}
+	if !maps.Equal(p.Sint32KeyMap, other.Sint32KeyMap) {
+		return false
+	}
+	if !maps.Equal(p.Sint64KeyMap, other.Sint64KeyMap) {
+		return false
+	}
+	if !maps.Equal(p.Fixed32KeyMap, other.Fixed32KeyMap) {
+		return false
+	}
+	if !maps.Equal(p.Fixed64KeyMap, other.Fixed64KeyMap) {
+		return false
+	}
+	if !maps.Equal(p.Sfixed32KeyMap, other.Sfixed32KeyMap) {
+		return false
+	}
+	if !maps.Equal(p.Sfixed64KeyMap, other.Sfixed64KeyMap) {
+		return false
+	}
+	if !maps.Equal(p.BoolKeyMap, other.BoolKeyMap) {
+		return false
+	}
+	if !maps.Equal(p.DoubleMap, other.DoubleMap) {
+		return false
+	}
+	if len(p.BytesMap) != len(other.BytesMap) {
+		return false
+	}
+	for k, v := range p.BytesMap {
+		w, ok := other.BytesMap[k]
+		if !ok || !bytes.Equal(v, w) {
+			return false
+		}
+	}
+	if !maps.Equal(p.BoolMap, other.BoolMap) {
+		return false
+	}
+	if !maps.Equal(p.FloatMap, other.FloatMap) {
+		return false
+	}
+	if p.Status != other.Status {
+		return false
+	}
+	if !slices.Equal(p.StatusList, other.StatusList) {
+		return false
+	}
+	if !maps.Equal(p.StatusMap, other.StatusMap) {
+		return false
+	}
+	if (p.OptionalStatus == nil) != (other.OptionalStatus == nil) || p.OptionalStatus != nil && *p.OptionalStatus != *other.OptionalStatus {
+		return false
+	}
+	if p.NestedEnum != other.NestedEnum {
+		return false
+	}
+	if !slices.Equal(p.NestedEnumList, other.NestedEnumList) {
+		return false
+	}
+	if !proto.Equal(p.NestedConfig, other.NestedConfig) {
+		return false
+	}
+	if len(p.NestedConfigList) != len(other.NestedConfigList) {
+		return false
+	}
+	for i := range p.NestedConfigList {
+		if !proto.Equal(p.NestedConfigList[i], other.NestedConfigList[i]) {
+			return false
+		}
+	}
+	if len(p.NestedConfigMap) != len(other.NestedConfigMap) {
+		return false
+	}
+	for k, v := range p.NestedConfigMap {
+		w, ok := other.NestedConfigMap[k]
+		if !ok || !proto.Equal(v, w) {
+			return false
+		}
+	}
+	if !p.Parent.Equal(other.Parent) {
+		return false
+	}
+	if len(p.Children) != len(other.Children) {
+		return false
+	}
+	for i := range p.Children {
+		if !p.Children[i].Equal(&other.Children[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Columns returns the column names of ConfigPlain rows, in the order of ScanRow and Values
+func (p *ConfigPlain) Columns() []string {
+	return []string{
+		"double_val",
+		"float_val",
+		"int32_val",
+		"int64_val",
+		"uint32_val",
+		"uint64_val",
+		"sint32_val",
+		"sint64_val",
+		"fixed32_val",
+		"fixed64_val",
+		"sfixed32_val",
+		"sfixed64_val",
+		"bool_val",
+		"string_val",
+		"bytes_val",
+		"optional_string",
+		"optional_int",
+		"optional_bool",
+		"optional_double",
+		"optional_bytes",
+		"string_list",
+		"int_list",
+		"double_list",
+		"bytes_list",
+		"bool_list",
+		"float_list",
+		"int64_list",
+		"uint32_list",
+		"uint64_list",
+		"string_map",
+		"int_map",
+		"int_key_map",
+		"nested_map",
+		"int64_key_map",
+		"uint32_key_map",
+		"uint64_key_map",
+		"sint32_key_map",
+		"sint64_key_map",
+		"fixed32_key_map",
+		"fixed64_key_map",
+		"sfixed32_key_map",
+		"sfixed64_key_map",
+		"bool_key_map",
+		"double_map",
+		"bytes_map",
+		"bool_map",
+		"float_map",
+		"status",
+		"status_list",
+		"status_map",
+		"optional_status",
+		"nested_enum",
+		"nested_enum_list",
+		"nested_config",
+		"nested_config_list",
+		"nested_config_map",
+		"parent",
+		"children",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into ConfigPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *ConfigPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.DoubleVal,
+		&p.FloatVal,
+		&p.Int32Val,
+		&p.Int64Val,
+		&p.Uint32Val,
+		&p.Uint64Val,
+		&p.Sint32Val,
+		&p.Sint64Val,
+		&p.Fixed32Val,
+		&p.Fixed64Val,
+		&p.Sfixed32Val,
+		&p.Sfixed64Val,
+		&p.BoolVal,
+		&p.StringVal,
+		&p.BytesVal,
+		&p.OptionalString,
+		&p.OptionalInt,
+		&p.OptionalBool,
+		&p.OptionalDouble,
+		&p.OptionalBytes,
+		plainsql.JSON(&p.StringList),
+		plainsql.JSON(&p.IntList),
+		plainsql.JSON(&p.DoubleList),
+		plainsql.JSON(&p.BytesList),
+		plainsql.JSON(&p.BoolList),
+		plainsql.JSON(&p.FloatList),
+		plainsql.JSON(&p.Int64List),
+		plainsql.JSON(&p.Uint32List),
+		plainsql.JSON(&p.Uint64List),
+		plainsql.JSON(&p.StringMap),
+		plainsql.JSON(&p.IntMap),
+		plainsql.JSON(&p.IntKeyMap),
+		plainsql.JSON(&p.NestedMap),
+		plainsql.JSON(&p.Int64KeyMap),
+		plainsql.JSON(&p.Uint32KeyMap),
+		plainsql.JSON(&p.Uint64KeyMap),
+		plainsql.JSON(&p.Sint32KeyMap),
+		plainsql.JSON(&p.Sint64KeyMap),
+		plainsql.JSON(&p.Fixed32KeyMap),
+		plainsql.JSON(&p.Fixed64KeyMap),
+		plainsql.JSON(&p.Sfixed32KeyMap),
+		plainsql.JSON(&p.Sfixed64KeyMap),
+		plainsql.JSON(&p.BoolKeyMap),
+		plainsql.JSON(&p.DoubleMap),
+		plainsql.JSON(&p.BytesMap),
+		plainsql.JSON(&p.BoolMap),
+		plainsql.JSON(&p.FloatMap),
+		&p.Status,
+		plainsql.JSON(&p.StatusList),
+		plainsql.JSON(&p.StatusMap),
+		&p.OptionalStatus,
+		&p.NestedEnum,
+		plainsql.JSON(&p.NestedEnumList),
+		plainsql.JSON(&p.NestedConfig),
+		plainsql.JSON(&p.NestedConfigList),
+		plainsql.JSON(&p.NestedConfigMap),
+		plainsql.JSON(&p.Parent),
+		plainsql.JSON(&p.Children),
+	)
+}
+
+// Values returns the column values of ConfigPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *ConfigPlain) Values() []any {
+	return []any{
+		p.DoubleVal,
+		p.FloatVal,
+		p.Int32Val,
+		p.Int64Val,
+		p.Uint32Val,
+		plainsql.Uint64(&p.Uint64Val),
+		p.Sint32Val,
+		p.Sint64Val,
+		p.Fixed32Val,
+		plainsql.Uint64(&p.Fixed64Val),
+		p.Sfixed32Val,
+		p.Sfixed64Val,
+		p.BoolVal,
+		p.StringVal,
+		p.BytesVal,
+		p.OptionalString,
+		p.OptionalInt,
+		p.OptionalBool,
+		p.OptionalDouble,
+		p.OptionalBytes,
+		plainsql.JSON(&p.StringList),
+		plainsql.JSON(&p.IntList),
+		plainsql.JSON(&p.DoubleList),
+		plainsql.JSON(&p.BytesList),
+		plainsql.JSON(&p.BoolList),
+		plainsql.JSON(&p.FloatList),
+		plainsql.JSON(&p.Int64List),
+		plainsql.JSON(&p.Uint32List),
+		plainsql.JSON(&p.Uint64List),
+		plainsql.JSON(&p.StringMap),
+		plainsql.JSON(&p.IntMap),
+		plainsql.JSON(&p.IntKeyMap),
+		plainsql.JSON(&p.NestedMap),
+		plainsql.JSON(&p.Int64KeyMap),
+		plainsql.JSON(&p.Uint32KeyMap),
+		plainsql.JSON(&p.Uint64KeyMap),
+		plainsql.JSON(&p.Sint32KeyMap),
+		plainsql.JSON(&p.Sint64KeyMap),
+		plainsql.JSON(&p.Fixed32KeyMap),
+		plainsql.JSON(&p.Fixed64KeyMap),
+		plainsql.JSON(&p.Sfixed32KeyMap),
+		plainsql.JSON(&p.Sfixed64KeyMap),
+		plainsql.JSON(&p.BoolKeyMap),
+		plainsql.JSON(&p.DoubleMap),
+		plainsql.JSON(&p.BytesMap),
+		plainsql.JSON(&p.BoolMap),
+		plainsql.JSON(&p.FloatMap),
+		p.Status,
+		plainsql.JSON(&p.StatusList),
+		plainsql.JSON(&p.StatusMap),
+		p.OptionalStatus,
+		p.NestedEnum,
+		plainsql.JSON(&p.NestedEnumList),
+		plainsql.JSON(&p.NestedConfig),
+		plainsql.JSON(&p.NestedConfigList),
+		plainsql.JSON(&p.NestedConfigMap),
+		plainsql.JSON(&p.Parent),
+		plainsql.JSON(&p.Children),
+	}
+}
+
+// configPlainPool is a sync.Pool for ConfigPlain objects
+var configPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &ConfigPlain{}
+	},
+}
+
+// GetConfigPlain returns a ConfigPlain from the pool
+func GetConfigPlain() *ConfigPlain {
+	return configPlainPool.Get().(*ConfigPlain)
+}
+
+// PutConfigPlain returns a ConfigPlain to the pool after resetting it
+func PutConfigPlain(p *ConfigPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	configPlainPool.Put(p)
+}
+
+// Reset clears all fields in ConfigPlain for reuse
+func (p *ConfigPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.DoubleVal = 0
+	p.FloatVal = 0
+	p.Int32Val = 0
+	p.Int64Val = 0
+	p.Uint32Val = 0
+	p.Uint64Val = 0
+	p.Sint32Val = 0
+	p.Sint64Val = 0
+	p.Fixed32Val = 0
+	p.Fixed64Val = 0
+	p.Sfixed32Val = 0
+	p.Sfixed64Val = 0
+	p.BoolVal = false
+	p.StringVal = ""
+	p.BytesVal = nil
+	p.OptionalString = nil
+	p.OptionalInt = nil
+	p.OptionalBool = nil
+	p.OptionalDouble = nil
+	p.OptionalBytes = nil
+	p.StringList = p.StringList[:0]
+	p.IntList = p.IntList[:0]
+	p.DoubleList = p.DoubleList[:0]
+	p.BytesList = p.BytesList[:0]
+	p.BoolList = p.BoolList[:0]
+	p.FloatList = p.FloatList[:0]
+	p.Int64List = p.Int64List[:0]
+	p.Uint32List = p.Uint32List[:0]
+	p.Uint64List = p.Uint64List[:0]
+	for k := range p.StringMap {
+		delete(p.StringMap, k)
+	}
+	for k := range p.IntMap {
+		delete(p.IntMap, k)
+	}
+	for k := range p.IntKeyMap {
+		delete(p.IntKeyMap, k)
+	}
+	for k := range p.NestedMap {
+		delete(p.NestedMap, k)
+	}
+	for k := range p.Int64KeyMap {
+		delete(p.Int64KeyMap, k)
+	}
+	for k := range p.Uint32KeyMap {
+		delete(p.Uint32KeyMap, k)
+	}
+	for k := range p.Uint64KeyMap {
+		delete(p.Uint64KeyMap, k)
+	}
+	for k := range p.Sint32KeyMap {
+		delete(p.Sint32KeyMap, k)
+	}
+	for k := range p.Sint64KeyMap {
+		delete(p.Sint64KeyMap, k)
+	}
+	for k := range p.Fixed32KeyMap {
+		delete(p.Fixed32KeyMap, k)
+	}
+	for k := range p.Fixed64KeyMap {
+		delete(p.Fixed64KeyMap, k)
+	}
+	for k := range p.Sfixed32KeyMap {
+		delete(p.Sfixed32KeyMap, k)
+	}
+	for k := range p.Sfixed64KeyMap {
+		delete(p.Sfixed64KeyMap, k)
+	}
+	for k := range p.BoolKeyMap {
+		delete(p.BoolKeyMap, k)
+	}
+	for k := range p.DoubleMap {
+		delete(p.DoubleMap, k)
+	}
+	for k := range p.BytesMap {
+		delete(p.BytesMap, k)
+	}
+	for k := range p.BoolMap {
+		delete(p.BoolMap, k)
+	}
+	for k := range p.FloatMap {
+		delete(p.FloatMap, k)
+	}
+	p.Status = 0
+	p.StatusList = p.StatusList[:0]
+	for k := range p.StatusMap {
+		delete(p.StatusMap, k)
+	}
+	p.OptionalStatus = nil
+	p.NestedEnum = 0
+	p.NestedEnumList = p.NestedEnumList[:0]
+	p.NestedConfig = nil
+	p.NestedConfigList = nil
+	for k := range p.NestedConfigMap {
+		delete(p.NestedConfigMap, k)
+	}
+	p.Parent = nil
+	p.Children = p.Children[:0]
+}
+
+type WellKnownTypesPlain struct {
+	CreatedAt      *timestamppb.Timestamp    `json:"createdAt"`
+	Ttl            *durationpb.Duration      `json:"ttl"`
+	UpdatedAt      *timestamppb.Timestamp    `json:"updatedAt"`
+	Latency        *durationpb.Duration      `json:"latency"`
+	NullableString *wrapperspb.StringValue   `json:"nullableString"`
+	NullableInt32  *wrapperspb.Int32Value    `json:"nullableInt32"`
+	NullableInt64  *wrapperspb.Int64Value    `json:"nullableInt64"`
+	NullableUint32 *wrapperspb.UInt32Value   `json:"nullableUint32"`
+	NullableUint64 *wrapperspb.UInt64Value   `json:"nullableUint64"`
+	NullableFloat  *wrapperspb.FloatValue    `json:"nullableFloat"`
+	NullableDouble *wrapperspb.DoubleValue   `json:"nullableDouble"`
+	NullableBool   *wrapperspb.BoolValue     `json:"nullableBool"`
+	NullableBytes  *wrapperspb.BytesValue    `json:"nullableBytes"`
+	Metadata       *structpb.Struct          `json:"metadata"`
+	DynamicValue   *structpb.Value           `json:"dynamicValue"`
+	ListValue      *structpb.ListValue       `json:"listValue"`
+	Payload        *anypb.Any                `json:"payload"`
+	Payloads       []*anypb.Any              `json:"payloads"`
+	Empty          *emptypb.Empty            `json:"empty"`
+	Timestamps     []*timestamppb.Timestamp  `json:"timestamps"`
+	Durations      []*durationpb.Duration    `json:"durations"`
+	Strings        []*wrapperspb.StringValue `json:"strings"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *WellKnownTypes) IntoPlain() *WellKnownTypesPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &WellKnownTypesPlain{}
+
+	p.CreatedAt = pb.CreatedAt
+	p.Ttl = pb.Ttl
+	p.UpdatedAt = pb.UpdatedAt
+	p.Latency = pb.Latency
+	p.NullableString = pb.NullableString
+	p.NullableInt32 = pb.NullableInt32
+	p.NullableInt64 = pb.NullableInt64
+	p.NullableUint32 = pb.NullableUint32
+	p.NullableUint64 = pb.NullableUint64
+	p.NullableFloat = pb.NullableFloat
+	p.NullableDouble = pb.NullableDouble
+	p.NullableBool = pb.NullableBool
+	p.NullableBytes = pb.NullableBytes
+	p.Metadata = pb.Metadata
+	p.DynamicValue = pb.DynamicValue
+	p.ListValue = pb.ListValue
+	p.Payload = pb.Payload
+	p.Payloads = pb.Payloads
+	p.Empty = pb.Empty
+	p.Timestamps = pb.Timestamps
+	p.Durations = pb.Durations
+	p.Strings = pb.Strings
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *WellKnownTypesPlain) IntoPb() *WellKnownTypes {
+	if p == nil {
+		return nil
+	}
+	pb := &WellKnownTypes{}
+
+	pb.CreatedAt = p.CreatedAt
+	pb.Ttl = p.Ttl
+	pb.UpdatedAt = p.UpdatedAt
+	pb.Latency = p.Latency
+	pb.NullableString = p.NullableString
+	pb.NullableInt32 = p.NullableInt32
+	pb.NullableInt64 = p.NullableInt64
+	pb.NullableUint32 = p.NullableUint32
+	pb.NullableUint64 = p.NullableUint64
+	pb.NullableFloat = p.NullableFloat
+	pb.NullableDouble = p.NullableDouble
+	pb.NullableBool = p.NullableBool
+	pb.NullableBytes = p.NullableBytes
+	pb.Metadata = p.Metadata
+	pb.DynamicValue = p.DynamicValue
+	pb.ListValue = p.ListValue
+	pb.Payload = p.Payload
+	pb.Payloads = p.Payloads
+	pb.Empty = p.Empty
+	pb.Timestamps = p.Timestamps
+	pb.Durations = p.Durations
+	pb.Strings = p.Strings
+	return pb
+}
+
+// wellKnownTypesPlainMergePaths are the fields of WellKnownTypes covered by WellKnownTypesPlain
+var wellKnownTypesPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{10},
+	{11},
+	{12},
+	{13},
+	{14},
+	{15},
+	{16},
+	{17},
+	{18},
+	{20},
+	{21},
+	{22},
+	{30},
+	{31},
+	{40},
+	{50},
+	{51},
+	{52},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *WellKnownTypesPlain) IntoPbMerge(dst *WellKnownTypes) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, wellKnownTypesPlainMergePaths...)
+}
+
+// wellKnownTypesPlainFieldPaths link the JSON names of WellKnownTypesPlain to field-mask paths of WellKnownTypes
+var wellKnownTypesPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "createdAt", Path: "created_at"},
+	{Name: "ttl", Path: "ttl"},
+	{Name: "updatedAt", Path: "updated_at"},
+	{Name: "latency", Path: "latency"},
+	{Name: "nullableString", Path: "nullable_string"},
+	{Name: "nullableInt32", Path: "nullable_int32"},
+	{Name: "nullableInt64", Path: "nullable_int64"},
+	{Name: "nullableUint32", Path: "nullable_uint32"},
+	{Name: "nullableUint64", Path: "nullable_uint64"},
+	{Name: "nullableFloat", Path: "nullable_float"},
+	{Name: "nullableDouble", Path: "nullable_double"},
+	{Name: "nullableBool", Path: "nullable_bool"},
+	{Name: "nullableBytes", Path: "nullable_bytes"},
+	{Name: "metadata", Path: "metadata"},
+	{Name: "dynamicValue", Path: "dynamic_value"},
+	{Name: "listValue", Path: "list_value"},
+	{Name: "payload", Path: "payload"},
+	{Name: "payloads", Path: "payloads"},
+	{Name: "empty", Path: "empty"},
+	{Name: "timestamps", Path: "timestamps"},
+	{Name: "durations", Path: "durations"},
+	{Name: "strings", Path: "strings"},
+}
+
+// WellKnownTypesPlainFieldMask builds a field mask of WellKnownTypes from JSON names of WellKnownTypesPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func WellKnownTypesPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(wellKnownTypesPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// WellKnownTypesPlainFieldNames translates a field mask of WellKnownTypes into JSON names of WellKnownTypesPlain.
+// A path to an embedded message yields all fields flattened from it.
+func WellKnownTypesPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(wellKnownTypesPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of WellKnownTypes, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *WellKnownTypesPlain) ApplyToPb(pb *WellKnownTypes, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), wellKnownTypesPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *WellKnownTypes) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*WellKnownTypesPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), wellKnownTypesPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &WellKnownTypes{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *WellKnownTypes) IntoPlainReuse(p *WellKnownTypesPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.CreatedAt = pb.CreatedAt
+	p.Ttl = pb.Ttl
+	p.UpdatedAt = pb.UpdatedAt
+	p.Latency = pb.Latency
+	p.NullableString = pb.NullableString
+	p.NullableInt32 = pb.NullableInt32
+	p.NullableInt64 = pb.NullableInt64
+	p.NullableUint32 = pb.NullableUint32
+	p.NullableUint64 = pb.NullableUint64
+	p.NullableFloat = pb.NullableFloat
+	p.NullableDouble = pb.NullableDouble
+	p.NullableBool = pb.NullableBool
+	p.NullableBytes = pb.NullableBytes
+	p.Metadata = pb.Metadata
+	p.DynamicValue = pb.DynamicValue
+	p.ListValue = pb.ListValue
+	p.Payload = pb.Payload
+	p.Payloads = pb.Payloads
+	p.Empty = pb.Empty
+	p.Timestamps = pb.Timestamps
+	p.Durations = pb.Durations
+	p.Strings = pb.Strings
+}
+
+// MarshalJX encodes WellKnownTypesPlain to JSON using jx.Encoder
+func (p *WellKnownTypesPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.CreatedAt != nil {
+		e.FieldStart("createdAt")
+		if data, err := protojson.Marshal(p.CreatedAt); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.Ttl != nil {
+		e.FieldStart("ttl")
+		if data, err := protojson.Marshal(p.Ttl); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.UpdatedAt != nil {
+		e.FieldStart("updatedAt")
+		if data, err := protojson.Marshal(p.UpdatedAt); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.Latency != nil {
+		e.FieldStart("latency")
+		if data, err := protojson.Marshal(p.Latency); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.NullableString != nil {
+		e.FieldStart("nullableString")
+		if data, err := protojson.Marshal(p.NullableString); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.NullableInt32 != nil {
+		e.FieldStart("nullableInt32")
+		if data, err := protojson.Marshal(p.NullableInt32); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.NullableInt64 != nil {
+		e.FieldStart("nullableInt64")
+		if data, err := protojson.Marshal(p.NullableInt64); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.NullableUint32 != nil {
+		e.FieldStart("nullableUint32")
+		if data, err := protojson.Marshal(p.NullableUint32); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.NullableUint64 != nil {
+		e.FieldStart("nullableUint64")
+		if data, err := protojson.Marshal(p.NullableUint64); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.NullableFloat != nil {
+		e.FieldStart("nullableFloat")
+		if data, err := protojson.Marshal(p.NullableFloat); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.NullableDouble != nil {
+		e.FieldStart("nullableDouble")
+		if data, err := protojson.Marshal(p.NullableDouble); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.NullableBool != nil {
+		e.FieldStart("nullableBool")
+		if data, err := protojson.Marshal(p.NullableBool); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.NullableBytes != nil {
+		e.FieldStart("nullableBytes")
+		if data, err := protojson.Marshal(p.NullableBytes); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.Metadata != nil {
+		e.FieldStart("metadata")
+		if data, err := protojson.Marshal(p.Metadata); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.DynamicValue != nil {
+		e.FieldStart("dynamicValue")
+		if data, err := protojson.Marshal(p.DynamicValue); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.ListValue != nil {
+		e.FieldStart("listValue")
+		if data, err := protojson.Marshal(p.ListValue); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.Payload != nil {
+		e.FieldStart("payload")
+		if data, err := protojson.Marshal(p.Payload); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.Payloads != nil {
+		e.FieldStart("payloads")
+		e.ArrStart()
+		for _, v := range p.Payloads {
+			if data, err := protojson.Marshal(v); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		}
+		e.ArrEnd()
+	}
+	if p.Empty != nil {
+		e.FieldStart("empty")
+		if data, err := protojson.Marshal(p.Empty); err == nil {
+			e.Raw(data)
+		} else {
+			e.Null()
+		}
+	}
+	if p.Timestamps != nil {
+		e.FieldStart("timestamps")
+		e.ArrStart()
+		for _, v := range p.Timestamps {
+			if data, err := protojson.Marshal(v); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		}
+		e.ArrEnd()
+	}
+	if p.Durations != nil {
+		e.FieldStart("durations")
+		e.ArrStart()
+		for _, v := range p.Durations {
+			if data, err := protojson.Marshal(v); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		}
+		e.ArrEnd()
+	}
+	if p.Strings != nil {
+		e.FieldStart("strings")
+		e.ArrStart()
+		for _, v := range p.Strings {
+			if data, err := protojson.Marshal(v); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *WellKnownTypesPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes WellKnownTypesPlain from JSON using jx.Decoder
+func (p *WellKnownTypesPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "createdAt":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.CreatedAt = &timestamppb.Timestamp{}
+			if err := protojson.Unmarshal(raw, p.CreatedAt); err != nil {
+				return err
+			}
+		case "ttl":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Ttl = &durationpb.Duration{}
+			if err := protojson.Unmarshal(raw, p.Ttl); err != nil {
+				return err
+			}
+		case "updatedAt":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.UpdatedAt = &timestamppb.Timestamp{}
+			if err := protojson.Unmarshal(raw, p.UpdatedAt); err != nil {
+				return err
+			}
+		case "latency":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Latency = &durationpb.Duration{}
+			if err := protojson.Unmarshal(raw, p.Latency); err != nil {
+				return err
+			}
+		case "nullableString":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.NullableString = &wrapperspb.StringValue{}
+			if err := protojson.Unmarshal(raw, p.NullableString); err != nil {
+				return err
+			}
+		case "nullableInt32":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.NullableInt32 = &wrapperspb.Int32Value{}
+			if err := protojson.Unmarshal(raw, p.NullableInt32); err != nil {
+				return err
+			}
+		case "nullableInt64":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.NullableInt64 = &wrapperspb.Int64Value{}
+			if err := protojson.Unmarshal(raw, p.NullableInt64); err != nil {
+				return err
+			}
+		case "nullableUint32":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.NullableUint32 = &wrapperspb.UInt32Value{}
+			if err := protojson.Unmarshal(raw, p.NullableUint32); err != nil {
+				return err
+			}
+		case "nullableUint64":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.NullableUint64 = &wrapperspb.UInt64Value{}
+			if err := protojson.Unmarshal(raw, p.NullableUint64); err != nil {
+				return err
+			}
+		case "nullableFloat":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.NullableFloat = &wrapperspb.FloatValue{}
+			if err := protojson.Unmarshal(raw, p.NullableFloat); err != nil {
+				return err
+			}
+		case "nullableDouble":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.NullableDouble = &wrapperspb.DoubleValue{}
+			if err := protojson.Unmarshal(raw, p.NullableDouble); err != nil {
+				return err
+			}
+		case "nullableBool":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.NullableBool = &wrapperspb.BoolValue{}
+			if err := protojson.Unmarshal(raw, p.NullableBool); err != nil {
+				return err
+			}
+		case "nullableBytes":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.NullableBytes = &wrapperspb.BytesValue{}
+			if err := protojson.Unmarshal(raw, p.NullableBytes); err != nil {
+				return err
+			}
+		case "metadata":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Metadata = &structpb.Struct{}
+			if err := protojson.Unmarshal(raw, p.Metadata); err != nil {
+				return err
+			}
+		case "dynamicValue":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.DynamicValue = &structpb.Value{}
+			if err := protojson.Unmarshal(raw, p.DynamicValue); err != nil {
+				return err
+			}
+		case "listValue":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.ListValue = &structpb.ListValue{}
+			if err := protojson.Unmarshal(raw, p.ListValue); err != nil {
+				return err
+			}
+		case "payload":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Payload = &anypb.Any{}
+			if err := protojson.Unmarshal(raw, p.Payload); err != nil {
+				return err
+			}
+		case "payloads":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				raw, err := d.Raw()
+				if err != nil {
+					return err
+				}
+				var v anypb.Any
+				if err := protojson.Unmarshal(raw, &v); err != nil {
+					return err
+				}
+				p.Payloads = append(p.Payloads, &v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "empty":
+			raw, err := d.Raw()
 			if err != nil {
 				return err
 			}
-			p.Int32Val = v
-		case "int64Val":
-			v, err := d.Int64()
-			if err != nil {
+			p.Empty = &emptypb.Empty{}
+			if err := protojson.Unmarshal(raw, p.Empty); err != nil {
+				return err
+			}
+		case "timestamps":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				raw, err := d.Raw()
+				if err != nil {
+					return err
+				}
+				var v timestamppb.Timestamp
+				if err := protojson.Unmarshal(raw, &v); err != nil {
+					return err
+				}
+				p.Timestamps = append(p.Timestamps, &v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "durations":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				raw, err := d.Raw()
+				if err != nil {
+					return err
+				}
+				var v durationpb.Duration
+				if err := protojson.Unmarshal(raw, &v); err != nil {
+					return err
+				}
+				p.Durations = append(p.Durations, &v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "strings":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				raw, err := d.Raw()
+				if err != nil {
+					return err
+				}
+				var v wrapperspb.StringValue
+				if err := protojson.Unmarshal(raw, &v); err != nil {
+					return err
+				}
+				p.Strings = append(p.Strings, &v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *WellKnownTypesPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes WellKnownTypesPlain in the protobuf wire format of WellKnownTypes
+func (p *WellKnownTypesPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends WellKnownTypesPlain encoded in the protobuf wire format of WellKnownTypes to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *WellKnownTypesPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if p.CreatedAt != nil {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.CreatedAt); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Ttl != nil {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Ttl); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.UpdatedAt != nil {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.UpdatedAt); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Latency != nil {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Latency); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NullableString != nil {
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NullableString); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NullableInt32 != nil {
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NullableInt32); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NullableInt64 != nil {
+		b = protowire.AppendTag(b, 12, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NullableInt64); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NullableUint32 != nil {
+		b = protowire.AppendTag(b, 13, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NullableUint32); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NullableUint64 != nil {
+		b = protowire.AppendTag(b, 14, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NullableUint64); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NullableFloat != nil {
+		b = protowire.AppendTag(b, 15, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NullableFloat); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NullableDouble != nil {
+		b = protowire.AppendTag(b, 16, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NullableDouble); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NullableBool != nil {
+		b = protowire.AppendTag(b, 17, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NullableBool); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NullableBytes != nil {
+		b = protowire.AppendTag(b, 18, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NullableBytes); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Metadata != nil {
+		b = protowire.AppendTag(b, 20, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Metadata); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.DynamicValue != nil {
+		b = protowire.AppendTag(b, 21, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.DynamicValue); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.ListValue != nil {
+		b = protowire.AppendTag(b, 22, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ListValue); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Payload != nil {
+		b = protowire.AppendTag(b, 30, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Payload); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, m := range p.Payloads {
+		b = protowire.AppendTag(b, 31, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Empty != nil {
+		b = protowire.AppendTag(b, 40, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Empty); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, m := range p.Timestamps {
+		b = protowire.AppendTag(b, 50, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, m := range p.Durations {
+		b = protowire.AppendTag(b, 51, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, m := range p.Strings {
+		b = protowire.AppendTag(b, 52, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes WellKnownTypesPlain from the protobuf wire format of WellKnownTypes.
+// Unknown fields are skipped.
+func (p *WellKnownTypesPlain) UnmarshalProto(b []byte) error {
+	*p = WellKnownTypesPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &timestamppb.Timestamp{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.CreatedAt = m
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &durationpb.Duration{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Int64Val = v
-		case "uint32Val":
-			v, err := d.UInt32()
-			if err != nil {
+			p.Ttl = m
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &timestamppb.Timestamp{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Uint32Val = v
-		case "uint64Val":
-			v, err := d.UInt64()
-			if err != nil {
+			p.UpdatedAt = m
+		case num == 4 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &durationpb.Duration{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Uint64Val = v
-		case "sint32Val":
-			v, err := d.Int32()
-			if err != nil {
+			p.Latency = m
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.StringValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Sint32Val = v
-		case "sint64Val":
-			v, err := d.Int64()
-			if err != nil {
+			p.NullableString = m
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.Int32Value{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Sint64Val = v
-		case "fixed32Val":
-			v, err := d.UInt32()
-			if err != nil {
+			p.NullableInt32 = m
+		case num == 12 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.Int64Value{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Fixed32Val = v
-		case "fixed64Val":
-			v, err := d.UInt64()
-			if err != nil {
+			p.NullableInt64 = m
+		case num == 13 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.UInt32Value{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Fixed64Val = v
-		case "sfixed32Val":
-			v, err := d.Int32()
-			if err != nil {
+			p.NullableUint32 = m
+		case num == 14 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.UInt64Value{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Sfixed32Val = v
-		case "sfixed64Val":
-			v, err := d.Int64()
-			if err != nil {
+			p.NullableUint64 = m
+		case num == 15 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.FloatValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Sfixed64Val = v
-		case "boolVal":
-			v, err := d.Bool()
-			if err != nil {
+			p.NullableFloat = m
+		case num == 16 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.DoubleValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.BoolVal = v
-		case "stringVal":
-			v, err := d.Str()
-			if err != nil {
+			p.NullableDouble = m
+		case num == 17 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.BoolValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.StringVal = v
-		case "bytesVal":
-			v, err := d.Base64()
-			if err != nil {
+			p.NullableBool = m
+		case num == 18 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.BytesValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.BytesVal = v
-		case "optionalString":
-			v, err := d.Str()
-			if err != nil {
+			p.NullableBytes = m
+		case num == 20 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &structpb.Struct{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.OptionalString = v
-		case "optionalInt":
-			v, err := d.Int32()
-			if err != nil {
+			p.Metadata = m
+		case num == 21 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &structpb.Value{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.DynamicValue = m
+		case num == 22 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &structpb.ListValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.ListValue = m
+		case num == 30 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &anypb.Any{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Payload = m
+		case num == 31 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &anypb.Any{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Payloads = append(p.Payloads, m)
+		case num == 40 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &emptypb.Empty{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Empty = m
+		case num == 50 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &timestamppb.Timestamp{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Timestamps = append(p.Timestamps, m)
+		case num == 51 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &durationpb.Duration{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Durations = append(p.Durations, m)
+		case num == 52 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.StringValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.OptionalInt = v
-		case "optionalBool":
-			v, err := d.Bool()
-			if err != nil {
-				return err
+			p.Strings = append(p.Strings, m)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// wellKnownTypesPlainReflect describes WellKnownTypesPlain as message full.plain.WellKnownTypesPlain
+var wellKnownTypesPlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "WellKnownTypesPlain",
+	plainreflect.Message(func(p *WellKnownTypesPlain) **timestamppb.Timestamp { return &p.CreatedAt }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **durationpb.Duration { return &p.Ttl }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **timestamppb.Timestamp { return &p.UpdatedAt }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **durationpb.Duration { return &p.Latency }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **wrapperspb.StringValue { return &p.NullableString }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **wrapperspb.Int32Value { return &p.NullableInt32 }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **wrapperspb.Int64Value { return &p.NullableInt64 }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **wrapperspb.UInt32Value { return &p.NullableUint32 }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **wrapperspb.UInt64Value { return &p.NullableUint64 }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **wrapperspb.FloatValue { return &p.NullableFloat }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **wrapperspb.DoubleValue { return &p.NullableDouble }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **wrapperspb.BoolValue { return &p.NullableBool }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **wrapperspb.BytesValue { return &p.NullableBytes }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **structpb.Struct { return &p.Metadata }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **structpb.Value { return &p.DynamicValue }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **structpb.ListValue { return &p.ListValue }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **anypb.Any { return &p.Payload }),
+	plainreflect.MessageList(func(p *WellKnownTypesPlain) *[]*anypb.Any { return &p.Payloads }),
+	plainreflect.Message(func(p *WellKnownTypesPlain) **emptypb.Empty { return &p.Empty }),
+	plainreflect.MessageList(func(p *WellKnownTypesPlain) *[]*timestamppb.Timestamp { return &p.Timestamps }),
+	plainreflect.MessageList(func(p *WellKnownTypesPlain) *[]*durationpb.Duration { return &p.Durations }),
+	plainreflect.MessageList(func(p *WellKnownTypesPlain) *[]*wrapperspb.StringValue { return &p.Strings }),
+)
+
+// ProtoReflect returns the reflective view of WellKnownTypesPlain backed by the struct
+func (p *WellKnownTypesPlain) ProtoReflect() protoreflect.Message {
+	return wellKnownTypesPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of WellKnownTypesPlain
+func (p *WellKnownTypesPlain) Clone() *WellKnownTypesPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &WellKnownTypesPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of WellKnownTypesPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetWellKnownTypesPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *WellKnownTypesPlain) CopyTo(dst *WellKnownTypesPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	if p.CreatedAt == nil {
+		dst.CreatedAt = nil
+	} else {
+		if dst.CreatedAt == nil {
+			dst.CreatedAt = new(timestamppb.Timestamp)
+		} else {
+			proto.Reset(dst.CreatedAt)
+		}
+		proto.Merge(dst.CreatedAt, p.CreatedAt)
+	}
+	if p.Ttl == nil {
+		dst.Ttl = nil
+	} else {
+		if dst.Ttl == nil {
+			dst.Ttl = new(durationpb.Duration)
+		} else {
+			proto.Reset(dst.Ttl)
+		}
+		proto.Merge(dst.Ttl, p.Ttl)
+	}
+	if p.UpdatedAt == nil {
+		dst.UpdatedAt = nil
+	} else {
+		if dst.UpdatedAt == nil {
+			dst.UpdatedAt = new(timestamppb.Timestamp)
+		} else {
+			proto.Reset(dst.UpdatedAt)
+		}
+		proto.Merge(dst.UpdatedAt, p.UpdatedAt)
+	}
+	if p.Latency == nil {
+		dst.Latency = nil
+	} else {
+		if dst.Latency == nil {
+			dst.Latency = new(durationpb.Duration)
+		} else {
+			proto.Reset(dst.Latency)
+		}
+		proto.Merge(dst.Latency, p.Latency)
+	}
+	if p.NullableString == nil {
+		dst.NullableString = nil
+	} else {
+		if dst.NullableString == nil {
+			dst.NullableString = new(wrapperspb.StringValue)
+		} else {
+			proto.Reset(dst.NullableString)
+		}
+		proto.Merge(dst.NullableString, p.NullableString)
+	}
+	if p.NullableInt32 == nil {
+		dst.NullableInt32 = nil
+	} else {
+		if dst.NullableInt32 == nil {
+			dst.NullableInt32 = new(wrapperspb.Int32Value)
+		} else {
+			proto.Reset(dst.NullableInt32)
+		}
+		proto.Merge(dst.NullableInt32, p.NullableInt32)
+	}
+	if p.NullableInt64 == nil {
+		dst.NullableInt64 = nil
+	} else {
+		if dst.NullableInt64 == nil {
+			dst.NullableInt64 = new(wrapperspb.Int64Value)
+		} else {
+			proto.Reset(dst.NullableInt64)
+		}
+		proto.Merge(dst.NullableInt64, p.NullableInt64)
+	}
+	if p.NullableUint32 == nil {
+		dst.NullableUint32 = nil
+	} else {
+		if dst.NullableUint32 == nil {
+			dst.NullableUint32 = new(wrapperspb.UInt32Value)
+		} else {
+			proto.Reset(dst.NullableUint32)
+		}
+		proto.Merge(dst.NullableUint32, p.NullableUint32)
+	}
+	if p.NullableUint64 == nil {
+		dst.NullableUint64 = nil
+	} else {
+		if dst.NullableUint64 == nil {
+			dst.NullableUint64 = new(wrapperspb.UInt64Value)
+		} else {
+			proto.Reset(dst.NullableUint64)
+		}
+		proto.Merge(dst.NullableUint64, p.NullableUint64)
+	}
+	if p.NullableFloat == nil {
+		dst.NullableFloat = nil
+	} else {
+		if dst.NullableFloat == nil {
+			dst.NullableFloat = new(wrapperspb.FloatValue)
+		} else {
+			proto.Reset(dst.NullableFloat)
+		}
+		proto.Merge(dst.NullableFloat, p.NullableFloat)
+	}
+	if p.NullableDouble == nil {
+		dst.NullableDouble = nil
+	} else {
+		if dst.NullableDouble == nil {
+			dst.NullableDouble = new(wrapperspb.DoubleValue)
+		} else {
+			proto.Reset(dst.NullableDouble)
+		}
+		proto.Merge(dst.NullableDouble, p.NullableDouble)
+	}
+	if p.NullableBool == nil {
+		dst.NullableBool = nil
+	} else {
+		if dst.NullableBool == nil {
+			dst.NullableBool = new(wrapperspb.BoolValue)
+		} else {
+			proto.Reset(dst.NullableBool)
+		}
+		proto.Merge(dst.NullableBool, p.NullableBool)
+	}
+	if p.NullableBytes == nil {
+		dst.NullableBytes = nil
+	} else {
+		if dst.NullableBytes == nil {
+			dst.NullableBytes = new(wrapperspb.BytesValue)
+		} else {
+			proto.Reset(dst.NullableBytes)
+		}
+		proto.Merge(dst.NullableBytes, p.NullableBytes)
+	}
+	if p.Metadata == nil {
+		dst.Metadata = nil
+	} else {
+		if dst.Metadata == nil {
+			dst.Metadata = new(structpb.Struct)
+		} else {
+			proto.Reset(dst.Metadata)
+		}
+		proto.Merge(dst.Metadata, p.Metadata)
+	}
+	if p.DynamicValue == nil {
+		dst.DynamicValue = nil
+	} else {
+		if dst.DynamicValue == nil {
+			dst.DynamicValue = new(structpb.Value)
+		} else {
+			proto.Reset(dst.DynamicValue)
+		}
+		proto.Merge(dst.DynamicValue, p.DynamicValue)
+	}
+	if p.ListValue == nil {
+		dst.ListValue = nil
+	} else {
+		if dst.ListValue == nil {
+			dst.ListValue = new(structpb.ListValue)
+		} else {
+			proto.Reset(dst.ListValue)
+		}
+		proto.Merge(dst.ListValue, p.ListValue)
+	}
+	if p.Payload == nil {
+		dst.Payload = nil
+	} else {
+		if dst.Payload == nil {
+			dst.Payload = new(anypb.Any)
+		} else {
+			proto.Reset(dst.Payload)
+		}
+		proto.Merge(dst.Payload, p.Payload)
+	}
+	if cap(dst.Payloads) < len(p.Payloads) {
+		dst.Payloads = append(dst.Payloads[:cap(dst.Payloads)], make([]*anypb.Any, len(p.Payloads)-cap(dst.Payloads))...)
+	} else {
+		dst.Payloads = dst.Payloads[:len(p.Payloads)]
+	}
+	for i, v := range p.Payloads {
+		if v == nil {
+			dst.Payloads[i] = nil
+			continue
+		}
+		if dst.Payloads[i] == nil {
+			dst.Payloads[i] = new(anypb.Any)
+		} else {
+			proto.Reset(dst.Payloads[i])
+		}
+		proto.Merge(dst.Payloads[i], v)
+	}
+	if p.Empty == nil {
+		dst.Empty = nil
+	} else {
+		if dst.Empty == nil {
+			dst.Empty = new(emptypb.Empty)
+		} else {
+			proto.Reset(dst.Empty)
+		}
+		proto.Merge(dst.Empty, p.Empty)
+	}
+	if cap(dst.Timestamps) < len(p.Timestamps) {
+		dst.Timestamps = append(dst.Timestamps[:cap(dst.Timestamps)], make([]*timestamppb.Timestamp, len(p.Timestamps)-cap(dst.Timestamps))...)
+	} else {
+		dst.Timestamps = dst.Timestamps[:len(p.Timestamps)]
+	}
+	for i, v := range p.Timestamps {
+		if v == nil {
+			dst.Timestamps[i] = nil
+			continue
+		}
+		if dst.Timestamps[i] == nil {
+			dst.Timestamps[i] = new(timestamppb.Timestamp)
+		} else {
+			proto.Reset(dst.Timestamps[i])
+		}
+		proto.Merge(dst.Timestamps[i], v)
+	}
+	if cap(dst.Durations) < len(p.Durations) {
+		dst.Durations = append(dst.Durations[:cap(dst.Durations)], make([]*durationpb.Duration, len(p.Durations)-cap(dst.Durations))...)
+	} else {
+		dst.Durations = dst.Durations[:len(p.Durations)]
+	}
+	for i, v := range p.Durations {
+		if v == nil {
+			dst.Durations[i] = nil
+			continue
+		}
+		if dst.Durations[i] == nil {
+			dst.Durations[i] = new(durationpb.Duration)
+		} else {
+			proto.Reset(dst.Durations[i])
+		}
+		proto.Merge(dst.Durations[i], v)
+	}
+	if cap(dst.Strings) < len(p.Strings) {
+		dst.Strings = append(dst.Strings[:cap(dst.Strings)], make([]*wrapperspb.StringValue, len(p.Strings)-cap(dst.Strings))...)
+	} else {
+		dst.Strings = dst.Strings[:len(p.Strings)]
+	}
+	for i, v := range p.Strings {
+		if v == nil {
+			dst.Strings[i] = nil
+			continue
+		}
+		if dst.Strings[i] == nil {
+			dst.Strings[i] = new(wrapperspb.StringValue)
+		} else {
+			proto.Reset(dst.Strings[i])
+		}
+		proto.Merge(dst.Strings[i], v)
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *WellKnownTypesPlain) Equal(other *WellKnownTypesPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if !proto.Equal(p.CreatedAt, other.CreatedAt) {
+		return false
+	}
+	if !proto.Equal(p.Ttl, other.Ttl) {
+		return false
+	}
+	if !proto.Equal(p.UpdatedAt, other.UpdatedAt) {
+		return false
+	}
+	if !proto.Equal(p.Latency, other.Latency) {
+		return false
+	}
+	if !proto.Equal(p.NullableString, other.NullableString) {
+		return false
+	}
+	if !proto.Equal(p.NullableInt32, other.NullableInt32) {
+		return false
+	}
+	if !proto.Equal(p.NullableInt64, other.NullableInt64) {
+		return false
+	}
+	if !proto.Equal(p.NullableUint32, other.NullableUint32) {
+		return false
+	}
+	if !proto.Equal(p.NullableUint64, other.NullableUint64) {
+		return false
+	}
+	if !proto.Equal(p.NullableFloat, other.NullableFloat) {
+		return false
+	}
+	if !proto.Equal(p.NullableDouble, other.NullableDouble) {
+		return false
+	}
+	if !proto.Equal(p.NullableBool, other.NullableBool) {
+		return false
+	}
+	if !proto.Equal(p.NullableBytes, other.NullableBytes) {
+		return false
+	}
+	if !proto.Equal(p.Metadata, other.Metadata) {
+		return false
+	}
+	if !proto.Equal(p.DynamicValue, other.DynamicValue) {
+		return false
+	}
+	if !proto.Equal(p.ListValue, other.ListValue) {
+		return false
+	}
+	if !proto.Equal(p.Payload, other.Payload) {
+		return false
+	}
+	if len(p.Payloads) != len(other.Payloads) {
+		return false
+	}
+	for i := range p.Payloads {
+		if !proto.Equal(p.Payloads[i], other.Payloads[i]) {
+			return false
+		}
+	}
+	if !proto.Equal(p.Empty, other.Empty) {
+		return false
+	}
+	if len(p.Timestamps) != len(other.Timestamps) {
+		return false
+	}
+	for i := range p.Timestamps {
+		if !proto.Equal(p.Timestamps[i], other.Timestamps[i]) {
+			return false
+		}
+	}
+	if len(p.Durations) != len(other.Durations) {
+		return false
+	}
+	for i := range p.Durations {
+		if !proto.Equal(p.Durations[i], other.Durations[i]) {
+			return false
+		}
+	}
+	if len(p.Strings) != len(other.Strings) {
+		return false
+	}
+	for i := range p.Strings {
+		if !proto.Equal(p.Strings[i], other.Strings[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Columns returns the column names of WellKnownTypesPlain rows, in the order of ScanRow and Values
+func (p *WellKnownTypesPlain) Columns() []string {
+	return []string{
+		"created_at",
+		"ttl",
+		"updated_at",
+		"latency",
+		"nullable_string",
+		"nullable_int32",
+		"nullable_int64",
+		"nullable_uint32",
+		"nullable_uint64",
+		"nullable_float",
+		"nullable_double",
+		"nullable_bool",
+		"nullable_bytes",
+		"metadata",
+		"dynamic_value",
+		"list_value",
+		"payload",
+		"payloads",
+		"empty",
+		"timestamps",
+		"durations",
+		"strings",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into WellKnownTypesPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *WellKnownTypesPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		plainsql.JSON(&p.CreatedAt),
+		plainsql.JSON(&p.Ttl),
+		plainsql.JSON(&p.UpdatedAt),
+		plainsql.JSON(&p.Latency),
+		plainsql.JSON(&p.NullableString),
+		plainsql.JSON(&p.NullableInt32),
+		plainsql.JSON(&p.NullableInt64),
+		plainsql.JSON(&p.NullableUint32),
+		plainsql.JSON(&p.NullableUint64),
+		plainsql.JSON(&p.NullableFloat),
+		plainsql.JSON(&p.NullableDouble),
+		plainsql.JSON(&p.NullableBool),
+		plainsql.JSON(&p.NullableBytes),
+		plainsql.JSON(&p.Metadata),
+		plainsql.JSON(&p.DynamicValue),
+		plainsql.JSON(&p.ListValue),
+		plainsql.JSON(&p.Payload),
+		plainsql.JSON(&p.Payloads),
+		plainsql.JSON(&p.Empty),
+		plainsql.JSON(&p.Timestamps),
+		plainsql.JSON(&p.Durations),
+		plainsql.JSON(&p.Strings),
+	)
+}
+
+// Values returns the column values of WellKnownTypesPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *WellKnownTypesPlain) Values() []any {
+	return []any{
+		plainsql.JSON(&p.CreatedAt),
+		plainsql.JSON(&p.Ttl),
+		plainsql.JSON(&p.UpdatedAt),
+		plainsql.JSON(&p.Latency),
+		plainsql.JSON(&p.NullableString),
+		plainsql.JSON(&p.NullableInt32),
+		plainsql.JSON(&p.NullableInt64),
+		plainsql.JSON(&p.NullableUint32),
+		plainsql.JSON(&p.NullableUint64),
+		plainsql.JSON(&p.NullableFloat),
+		plainsql.JSON(&p.NullableDouble),
+		plainsql.JSON(&p.NullableBool),
+		plainsql.JSON(&p.NullableBytes),
+		plainsql.JSON(&p.Metadata),
+		plainsql.JSON(&p.DynamicValue),
+		plainsql.JSON(&p.ListValue),
+		plainsql.JSON(&p.Payload),
+		plainsql.JSON(&p.Payloads),
+		plainsql.JSON(&p.Empty),
+		plainsql.JSON(&p.Timestamps),
+		plainsql.JSON(&p.Durations),
+		plainsql.JSON(&p.Strings),
+	}
+}
+
+// wellKnownTypesPlainPool is a sync.Pool for WellKnownTypesPlain objects
+var wellKnownTypesPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &WellKnownTypesPlain{}
+	},
+}
+
+// GetWellKnownTypesPlain returns a WellKnownTypesPlain from the pool
+func GetWellKnownTypesPlain() *WellKnownTypesPlain {
+	return wellKnownTypesPlainPool.Get().(*WellKnownTypesPlain)
+}
+
+// PutWellKnownTypesPlain returns a WellKnownTypesPlain to the pool after resetting it
+func PutWellKnownTypesPlain(p *WellKnownTypesPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	wellKnownTypesPlainPool.Put(p)
+}
+
+// Reset clears all fields in WellKnownTypesPlain for reuse
+func (p *WellKnownTypesPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.CreatedAt = nil
+	p.Ttl = nil
+	p.UpdatedAt = nil
+	p.Latency = nil
+	p.NullableString = nil
+	p.NullableInt32 = nil
+	p.NullableInt64 = nil
+	p.NullableUint32 = nil
+	p.NullableUint64 = nil
+	p.NullableFloat = nil
+	p.NullableDouble = nil
+	p.NullableBool = nil
+	p.NullableBytes = nil
+	p.Metadata = nil
+	p.DynamicValue = nil
+	p.ListValue = nil
+	p.Payload = nil
+	p.Payloads = nil
+	p.Empty = nil
+	p.Timestamps = nil
+	p.Durations = nil
+	p.Strings = nil
+}
+
+type MapShowcasePlain struct {
+	StrStr       map[string]string       `json:"strStr"`
+	StrInt32     map[string]int32        `json:"strInt32"`
+	StrInt64     map[string]int64        `json:"strInt64"`
+	StrUint32    map[string]uint32       `json:"strUint32"`
+	StrUint64    map[string]uint64       `json:"strUint64"`
+	StrFloat     map[string]float32      `json:"strFloat"`
+	StrDouble    map[string]float64      `json:"strDouble"`
+	StrBool      map[string]bool         `json:"strBool"`
+	StrBytes     map[string][]byte       `json:"strBytes"`
+	Int32Str     map[int32]string        `json:"int32Str"`
+	Int64Str     map[int64]string        `json:"int64Str"`
+	Uint32Str    map[uint32]string       `json:"uint32Str"`
+	Uint64Str    map[uint64]string       `json:"uint64Str"`
+	Sint32Str    map[int32]string        `json:"sint32Str"`
+	Sint64Str    map[int64]string        `json:"sint64Str"`
+	Fixed32Str   map[uint32]string       `json:"fixed32Str"`
+	Fixed64Str   map[uint64]string       `json:"fixed64Str"`
+	Sfixed32Str  map[int32]string        `json:"sfixed32Str"`
+	Sfixed64Str  map[int64]string        `json:"sfixed64Str"`
+	BoolStr      map[bool]string         `json:"boolStr"`
+	StrMessage   map[string]*Address     `json:"strMessage"`
+	Int32Message map[int32]*Address      `json:"int32Message"`
+	Int64Message map[int64]*Metadata     `json:"int64Message"`
+	StrEnum      map[string]Status       `json:"strEnum"`
+	Int32Enum    map[int32]Priority      `json:"int32Enum"`
+	Nested       map[string]*ConfigPlain `json:"nested"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *MapShowcase) IntoPlain() *MapShowcasePlain {
+	if pb == nil {
+		return nil
+	}
+	p := &MapShowcasePlain{}
+
+	p.StrStr = pb.StrStr
+	p.StrInt32 = pb.StrInt32
+	p.StrInt64 = pb.StrInt64
+	p.StrUint32 = pb.StrUint32
+	p.StrUint64 = pb.StrUint64
+	p.StrFloat = pb.StrFloat
+	p.StrDouble = pb.StrDouble
+	p.StrBool = pb.StrBool
+	p.StrBytes = pb.StrBytes
+	p.Int32Str = pb.Int32Str
+	p.Int64Str = pb.Int64Str
+	p.Uint32Str = pb.Uint32Str
+	p.Uint64Str = pb.Uint64Str
+	p.Sint32Str = pb.Sint32Str
+	p.Sint64Str = pb.Sint64Str
+	p.Fixed32Str = pb.Fixed32Str
+	p.Fixed64Str = pb.Fixed64Str
+	p.Sfixed32Str = pb.Sfixed32Str
+	p.Sfixed64Str = pb.Sfixed64Str
+	p.BoolStr = pb.BoolStr
+	p.StrMessage = pb.StrMessage
+	p.Int32Message = pb.Int32Message
+	p.Int64Message = pb.Int64Message
+	p.StrEnum = pb.StrEnum
+	p.Int32Enum = pb.Int32Enum
+	if len(pb.Nested) > 0 {
+		p.Nested = make(map[string]*ConfigPlain, len(pb.Nested))
+		for k, v := range pb.Nested {
+			if v != nil {
+				p.Nested[k] = v.IntoPlain()
+			}
+		}
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *MapShowcasePlain) IntoPb() *MapShowcase {
+	if p == nil {
+		return nil
+	}
+	pb := &MapShowcase{}
+
+	pb.StrStr = p.StrStr
+	pb.StrInt32 = p.StrInt32
+	pb.StrInt64 = p.StrInt64
+	pb.StrUint32 = p.StrUint32
+	pb.StrUint64 = p.StrUint64
+	pb.StrFloat = p.StrFloat
+	pb.StrDouble = p.StrDouble
+	pb.StrBool = p.StrBool
+	pb.StrBytes = p.StrBytes
+	pb.Int32Str = p.Int32Str
+	pb.Int64Str = p.Int64Str
+	pb.Uint32Str = p.Uint32Str
+	pb.Uint64Str = p.Uint64Str
+	pb.Sint32Str = p.Sint32Str
+	pb.Sint64Str = p.Sint64Str
+	pb.Fixed32Str = p.Fixed32Str
+	pb.Fixed64Str = p.Fixed64Str
+	pb.Sfixed32Str = p.Sfixed32Str
+	pb.Sfixed64Str = p.Sfixed64Str
+	pb.BoolStr = p.BoolStr
+	pb.StrMessage = p.StrMessage
+	pb.Int32Message = p.Int32Message
+	pb.Int64Message = p.Int64Message
+	pb.StrEnum = p.StrEnum
+	pb.Int32Enum = p.Int32Enum
+	if len(p.Nested) > 0 {
+		pb.Nested = make(map[string]*Config, len(p.Nested))
+		for k, v := range p.Nested {
+			if v != nil {
+				pb.Nested[k] = v.IntoPb()
 			}
-			p.OptionalBool = v
-		case "optionalDouble":
-			v, err := d.Float64()
-			if err != nil {
-				return err
+		}
+	}
+	return pb
+}
+
+// mapShowcasePlainMergePaths are the fields of MapShowcase covered by MapShowcasePlain
+var mapShowcasePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{7},
+	{8},
+	{9},
+	{10},
+	{11},
+	{12},
+	{13},
+	{14},
+	{15},
+	{16},
+	{17},
+	{18},
+	{19},
+	{20},
+	{30},
+	{31},
+	{32},
+	{40},
+	{41},
+	{50},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *MapShowcasePlain) IntoPbMerge(dst *MapShowcase) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, mapShowcasePlainMergePaths...)
+}
+
+// mapShowcasePlainFieldPaths link the JSON names of MapShowcasePlain to field-mask paths of MapShowcase
+var mapShowcasePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "strStr", Path: "str_str"},
+	{Name: "strInt32", Path: "str_int32"},
+	{Name: "strInt64", Path: "str_int64"},
+	{Name: "strUint32", Path: "str_uint32"},
+	{Name: "strUint64", Path: "str_uint64"},
+	{Name: "strFloat", Path: "str_float"},
+	{Name: "strDouble", Path: "str_double"},
+	{Name: "strBool", Path: "str_bool"},
+	{Name: "strBytes", Path: "str_bytes"},
+	{Name: "int32Str", Path: "int32_str"},
+	{Name: "int64Str", Path: "int64_str"},
+	{Name: "uint32Str", Path: "uint32_str"},
+	{Name: "uint64Str", Path: "uint64_str"},
+	{Name: "sint32Str", Path: "sint32_str"},
+	{Name: "sint64Str", Path: "sint64_str"},
+	{Name: "fixed32Str", Path: "fixed32_str"},
+	{Name: "fixed64Str", Path: "fixed64_str"},
+	{Name: "sfixed32Str", Path: "sfixed32_str"},
+	{Name: "sfixed64Str", Path: "sfixed64_str"},
+	{Name: "boolStr", Path: "bool_str"},
+	{Name: "strMessage", Path: "str_message"},
+	{Name: "int32Message", Path: "int32_message"},
+	{Name: "int64Message", Path: "int64_message"},
+	{Name: "strEnum", Path: "str_enum"},
+	{Name: "int32Enum", Path: "int32_enum"},
+	{Name: "nested", Path: "nested"},
+}
+
+// MapShowcasePlainFieldMask builds a field mask of MapShowcase from JSON names of MapShowcasePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func MapShowcasePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(mapShowcasePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// MapShowcasePlainFieldNames translates a field mask of MapShowcase into JSON names of MapShowcasePlain.
+// A path to an embedded message yields all fields flattened from it.
+func MapShowcasePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(mapShowcasePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of MapShowcase, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *MapShowcasePlain) ApplyToPb(pb *MapShowcase, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), mapShowcasePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *MapShowcase) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*MapShowcasePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), mapShowcasePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &MapShowcase{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *MapShowcase) IntoPlainReuse(p *MapShowcasePlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.StrStr = pb.StrStr
+	p.StrInt32 = pb.StrInt32
+	p.StrInt64 = pb.StrInt64
+	p.StrUint32 = pb.StrUint32
+	p.StrUint64 = pb.StrUint64
+	p.StrFloat = pb.StrFloat
+	p.StrDouble = pb.StrDouble
+	p.StrBool = pb.StrBool
+	p.StrBytes = pb.StrBytes
+	p.Int32Str = pb.Int32Str
+	p.Int64Str = pb.Int64Str
+	p.Uint32Str = pb.Uint32Str
+	p.Uint64Str = pb.Uint64Str
+	p.Sint32Str = pb.Sint32Str
+	p.Sint64Str = pb.Sint64Str
+	p.Fixed32Str = pb.Fixed32Str
+	p.Fixed64Str = pb.Fixed64Str
+	p.Sfixed32Str = pb.Sfixed32Str
+	p.Sfixed64Str = pb.Sfixed64Str
+	p.BoolStr = pb.BoolStr
+	p.StrMessage = pb.StrMessage
+	p.Int32Message = pb.Int32Message
+	p.Int64Message = pb.Int64Message
+	p.StrEnum = pb.StrEnum
+	p.Int32Enum = pb.Int32Enum
+	if len(pb.Nested) > 0 {
+		p.Nested = make(map[string]*ConfigPlain, len(pb.Nested))
+		for k, v := range pb.Nested {
+			if v != nil {
+				p.Nested[k] = v.IntoPlain()
 			}
-			p.OptionalDouble = v
-		case "optionalBytes":
-			v, err := d.Base64()
-			if err != nil {
-				return err
+		}
+	}
+}
+
+// MarshalJX encodes MapShowcasePlain to JSON using jx.Encoder
+func (p *MapShowcasePlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	e.FieldStart("strStr")
+	e.ObjStart()
+	for k, v := range p.StrStr {
+		e.FieldStart(k)
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("strInt32")
+	e.ObjStart()
+	for k, v := range p.StrInt32 {
+		e.FieldStart(k)
+		e.Int32(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("strInt64")
+	e.ObjStart()
+	for k, v := range p.StrInt64 {
+		e.FieldStart(k)
+		e.Int64(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("strUint32")
+	e.ObjStart()
+	for k, v := range p.StrUint32 {
+		e.FieldStart(k)
+		e.UInt32(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("strUint64")
+	e.ObjStart()
+	for k, v := range p.StrUint64 {
+		e.FieldStart(k)
+		e.UInt64(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("strFloat")
+	e.ObjStart()
+	for k, v := range p.StrFloat {
+		e.FieldStart(k)
+		e.Float32(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("strDouble")
+	e.ObjStart()
+	for k, v := range p.StrDouble {
+		e.FieldStart(k)
+		e.Float64(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("strBool")
+	e.ObjStart()
+	for k, v := range p.StrBool {
+		e.FieldStart(k)
+		e.Bool(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("strBytes")
+	e.ObjStart()
+	for k, v := range p.StrBytes {
+		e.FieldStart(k)
+		e.Base64(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("int32Str")
+	e.ObjStart()
+	for k, v := range p.Int32Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("int64Str")
+	e.ObjStart()
+	for k, v := range p.Int64Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("uint32Str")
+	e.ObjStart()
+	for k, v := range p.Uint32Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("uint64Str")
+	e.ObjStart()
+	for k, v := range p.Uint64Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("sint32Str")
+	e.ObjStart()
+	for k, v := range p.Sint32Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("sint64Str")
+	e.ObjStart()
+	for k, v := range p.Sint64Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("fixed32Str")
+	e.ObjStart()
+	for k, v := range p.Fixed32Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("fixed64Str")
+	e.ObjStart()
+	for k, v := range p.Fixed64Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("sfixed32Str")
+	e.ObjStart()
+	for k, v := range p.Sfixed32Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("sfixed64Str")
+	e.ObjStart()
+	for k, v := range p.Sfixed64Str {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("boolStr")
+	e.ObjStart()
+	for k, v := range p.BoolStr {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("strMessage")
+	e.ObjStart()
+	for k, v := range p.StrMessage {
+		e.FieldStart(k)
+		v.MarshalJX(e)
+	}
+	e.ObjEnd()
+	e.FieldStart("int32Message")
+	e.ObjStart()
+	for k, v := range p.Int32Message {
+		e.FieldStart(fmt.Sprint(k))
+		v.MarshalJX(e)
+	}
+	e.ObjEnd()
+	e.FieldStart("int64Message")
+	e.ObjStart()
+	for k, v := range p.Int64Message {
+		e.FieldStart(fmt.Sprint(k))
+		v.MarshalJX(e)
+	}
+	e.ObjEnd()
+	e.FieldStart("strEnum")
+	e.ObjStart()
+	for k, v := range p.StrEnum {
+		e.FieldStart(k)
+		e.Int32(int32(v))
+	}
+	e.ObjEnd()
+	e.FieldStart("int32Enum")
+	e.ObjStart()
+	for k, v := range p.Int32Enum {
+		e.FieldStart(fmt.Sprint(k))
+		e.Int32(int32(v))
+	}
+	e.ObjEnd()
+	e.FieldStart("nested")
+	e.ObjStart()
+	for k, v := range p.Nested {
+		e.FieldStart(k)
+		v.MarshalJX(e)
+	}
+	e.ObjEnd()
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *MapShowcasePlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes MapShowcasePlain from JSON using jx.Decoder
+func (p *MapShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "strStr":
+			if p.StrStr == nil {
+				p.StrStr = make(map[string]string)
 			}
-			p.OptionalBytes = v
-		case "stringList":
-			if err := d.Arr(func(d *jx.Decoder) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
-				p.StringList = append(p.StringList, v)
+				p.StrStr[key] = v
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "intList":
-			if err := d.Arr(func(d *jx.Decoder) error {
+		case "strInt32":
+			if p.StrInt32 == nil {
+				p.StrInt32 = make(map[string]int32)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Int32()
 				if err != nil {
 					return err
 				}
-				p.IntList = append(p.IntList, v)
+				p.StrInt32[key] = v
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "doubleList":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Float64()
+		case "strInt64":
+			if p.StrInt64 == nil {
+				p.StrInt64 = make(map[string]int64)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Int64()
 				if err != nil {
 					return err
 				}
-				p.DoubleList = append(p.DoubleList, v)
+				p.StrInt64[key] = v
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "bytesList":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Base64()
+		case "strUint32":
+			if p.StrUint32 == nil {
+				p.StrUint32 = make(map[string]uint32)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.UInt32()
 				if err != nil {
 					return err
 				}
-				p.BytesList = append(p.BytesList, v)
+				p.StrUint32[key] = v
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "boolList":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Bool()
+		case "strUint64":
+			if p.StrUint64 == nil {
+				p.StrUint64 = make(map[string]uint64)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.UInt64()
 				if err != nil {
 					return err
 				}
-				p.BoolList = append(p.BoolList, v)
+				p.StrUint64[key] = v
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "floatList":
-			if err := d.Arr(func(d *jx.Decoder) error {
+		case "strFloat":
+			if p.StrFloat == nil {
+				p.StrFloat = make(map[string]float32)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Float32()
 				if err != nil {
 					return err
 				}
-				p.FloatList = append(p.FloatList, v)
+				p.StrFloat[key] = v
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "int64List":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Int64()
-				if err != nil {
-					return err
-				}
-				p.Int64List = append(p.Int64List, v)
-				return nil
-			}); err != nil {
-				return err
+		case "strDouble":
+			if p.StrDouble == nil {
+				p.StrDouble = make(map[string]float64)
 			}
-		case "uint32List":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.UInt32()
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Float64()
 				if err != nil {
 					return err
 				}
-				p.Uint32List = append(p.Uint32List, v)
+				p.StrDouble[key] = v
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "uint64List":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.UInt64()
+		case "strBool":
+			if p.StrBool == nil {
+				p.StrBool = make(map[string]bool)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Bool()
 				if err != nil {
 					return err
 				}
-				p.Uint64List = append(p.Uint64List, v)
+				p.StrBool[key] = v
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "stringMap":
-			if p.StringMap == nil {
-				p.StringMap = make(map[string]string)
+		case "strBytes":
+			if p.StrBytes == nil {
+				p.StrBytes = make(map[string][]byte)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Str()
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Base64()
 				if err != nil {
 					return err
 				}
-				p.StringMap[key] = v
+				p.StrBytes[key] = v
 				return nil
-			})
-		case "intMap":
-			if p.IntMap == nil {
-				p.IntMap = make(map[string]int32)
+			}); err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Int32()
-				if err != nil {
-					return err
-				}
-				p.IntMap[key] = v
-				return nil
-			})
-		case "intKeyMap":
-			if p.IntKeyMap == nil {
-				p.IntKeyMap = make(map[int32]string)
+		case "int32Str":
+			if p.Int32Str == nil {
+				p.Int32Str = make(map[int32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_k, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -2827,25 +10825,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.IntKeyMap[_mapKey] = v
+				p.Int32Str[_mapKey] = v
 				return nil
-			})
-		case "nestedMap":
-			if p.NestedMap == nil {
-				p.NestedMap = make(map[string]*ConfigPlain)
+			}); err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				p.NestedMap[key] = &ConfigPlain{}
-				if err := p.NestedMap[key].UnmarshalJX(d); err != nil {
-					return err
-				}
-				return nil
-			})
-		case "int64KeyMap":
-			if p.Int64KeyMap == nil {
-				p.Int64KeyMap = make(map[int64]string)
+		case "int64Str":
+			if p.Int64Str == nil {
+				p.Int64Str = make(map[int64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_mapKey, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -2854,14 +10843,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.Int64KeyMap[_mapKey] = v
+				p.Int64Str[_mapKey] = v
 				return nil
-			})
-		case "uint32KeyMap":
-			if p.Uint32KeyMap == nil {
-				p.Uint32KeyMap = make(map[uint32]string)
+			}); err != nil {
+				return err
+			}
+		case "uint32Str":
+			if p.Uint32Str == nil {
+				p.Uint32Str = make(map[uint32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_k, err := strconv.ParseUint(key, 10, 32)
 				if err != nil {
 					return err
@@ -2871,14 +10862,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.Uint32KeyMap[_mapKey] = v
+				p.Uint32Str[_mapKey] = v
 				return nil
-			})
-		case "uint64KeyMap":
-			if p.Uint64KeyMap == nil {
-				p.Uint64KeyMap = make(map[uint64]string)
+			}); err != nil {
+				return err
+			}
+		case "uint64Str":
+			if p.Uint64Str == nil {
+				p.Uint64Str = make(map[uint64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_mapKey, err := strconv.ParseUint(key, 10, 64)
 				if err != nil {
 					return err
@@ -2887,14 +10880,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.Uint64KeyMap[_mapKey] = v
+				p.Uint64Str[_mapKey] = v
 				return nil
-			})
-		case "sint32KeyMap":
-			if p.Sint32KeyMap == nil {
-				p.Sint32KeyMap = make(map[int32]string)
+			}); err != nil {
+				return err
+			}
+		case "sint32Str":
+			if p.Sint32Str == nil {
+				p.Sint32Str = make(map[int32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_k, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -2904,14 +10899,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.Sint32KeyMap[_mapKey] = v
+				p.Sint32Str[_mapKey] = v
 				return nil
-			})
-		case "sint64KeyMap":
-			if p.Sint64KeyMap == nil {
-				p.Sint64KeyMap = make(map[int64]string)
+			}); err != nil {
+				return err
+			}
+		case "sint64Str":
+			if p.Sint64Str == nil {
+				p.Sint64Str = make(map[int64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_mapKey, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -2920,14 +10917,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.Sint64KeyMap[_mapKey] = v
+				p.Sint64Str[_mapKey] = v
 				return nil
-			})
-		case "fixed32KeyMap":
-			if p.Fixed32KeyMap == nil {
-				p.Fixed32KeyMap = make(map[uint32]string)
+			}); err != nil {
+				return err
+			}
+		case "fixed32Str":
+			if p.Fixed32Str == nil {
+				p.Fixed32Str = make(map[uint32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_k, err := strconv.ParseUint(key, 10, 32)
 				if err != nil {
 					return err
@@ -2937,14 +10936,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.Fixed32KeyMap[_mapKey] = v
+				p.Fixed32Str[_mapKey] = v
 				return nil
-			})
-		case "fixed64KeyMap":
-			if p.Fixed64KeyMap == nil {
-				p.Fixed64KeyMap = make(map[uint64]string)
+			}); err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+		case "fixed64Str":
+			if p.Fixed64Str == nil {
+				p.Fixed64Str = make(map[uint64]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_mapKey, err := strconv.ParseUint(key, 10, 64)
 				if err != nil {
 					return err
@@ -2953,14 +10954,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.Fixed64KeyMap[_mapKey] = v
+				p.Fixed64Str[_mapKey] = v
 				return nil
-			})
-		case "sfixed32KeyMap":
-			if p.Sfixed32KeyMap == nil {
-				p.Sfixed32KeyMap = make(map[int32]string)
+			}); err != nil {
+				return err
+			}
+		case "sfixed32Str":
+			if p.Sfixed32Str == nil {
+				p.Sfixed32Str = make(map[int32]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_k, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
@@ -2970,14 +10973,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.Sfixed32KeyMap[_mapKey] = v
+				p.Sfixed32Str[_mapKey] = v
 				return nil
-			})
-		case "sfixed64KeyMap":
-			if p.Sfixed64KeyMap == nil {
-				p.Sfixed64KeyMap = make(map[int64]string)
+			}); err != nil {
+				return err
+			}
+		case "sfixed64Str":
+			if p.Sfixed64Str == nil {
+				p.Sfixed64Str = make(map[int64]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_mapKey, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
@@ -2986,14 +10991,16 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.Sfixed64KeyMap[_mapKey] = v
+				p.Sfixed64Str[_mapKey] = v
 				return nil
-			})
-		case "boolKeyMap":
-			if p.BoolKeyMap == nil {
-				p.BoolKeyMap = make(map[bool]string)
+			}); err != nil {
+				return err
+			}
+		case "boolStr":
+			if p.BoolStr == nil {
+				p.BoolStr = make(map[bool]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				_mapKey, err := strconv.ParseBool(key)
 				if err != nil {
 					return err
@@ -3002,1658 +11009,2451 @@ func (p *ConfigPlain) UnmarshalJX(d *jx.Decoder) error {
 				if err != nil {
 					return err
 				}
-				p.BoolKeyMap[_mapKey] = v
+				p.BoolStr[_mapKey] = v
 				return nil
-			})
-		case "doubleMap":
-			if p.DoubleMap == nil {
-				p.DoubleMap = make(map[string]float64)
+			}); err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Float64()
-				if err != nil {
-					return err
-				}
-				p.DoubleMap[key] = v
-				return nil
-			})
-		case "bytesMap":
-			if p.BytesMap == nil {
-				p.BytesMap = make(map[string][]byte)
+		case "strMessage":
+			if p.StrMessage == nil {
+				p.StrMessage = make(map[string]*Address)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Base64()
-				if err != nil {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				p.StrMessage[key] = &Address{}
+				if err := p.StrMessage[key].UnmarshalJX(d); err != nil {
 					return err
 				}
-				p.BytesMap[key] = v
 				return nil
-			})
-		case "boolMap":
-			if p.BoolMap == nil {
-				p.BoolMap = make(map[string]bool)
+			}); err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Bool()
-				if err != nil {
-					return err
-				}
-				p.BoolMap[key] = v
-				return nil
-			})
-		case "floatMap":
-			if p.FloatMap == nil {
-				p.FloatMap = make(map[string]float32)
+		case "int32Message":
+			if p.Int32Message == nil {
+				p.Int32Message = make(map[int32]*Address)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Float32()
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_k, err := strconv.ParseInt(key, 10, 32)
 				if err != nil {
 					return err
 				}
-				p.FloatMap[key] = v
-				return nil
-			})
-		case "status":
-			v, err := d.Int32()
-			if err != nil {
-				return err
-			}
-			p.Status = Status(v)
-		case "statusList":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Int32()
-				if err != nil {
+				_mapKey := int32(_k)
+				p.Int32Message[_mapKey] = &Address{}
+				if err := p.Int32Message[_mapKey].UnmarshalJX(d); err != nil {
 					return err
 				}
-				p.StatusList = append(p.StatusList, Status(v))
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "statusMap":
-			if p.StatusMap == nil {
-				p.StatusMap = make(map[string]Status)
+		case "int64Message":
+			if p.Int64Message == nil {
+				p.Int64Message = make(map[int64]*Metadata)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Int32()
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_mapKey, err := strconv.ParseInt(key, 10, 64)
 				if err != nil {
 					return err
 				}
-				p.StatusMap[key] = Status(v)
-				return nil
-			})
-		case "optionalStatus":
-			v, err := d.Int32()
-			if err != nil {
-				return err
-			}
-			p.OptionalStatus = Status(v)
-		case "nestedEnum":
-			v, err := d.Int32()
-			if err != nil {
-				return err
-			}
-			p.NestedEnum = Config_NestedEnum(v)
-		case "nestedEnumList":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Int32()
-				if err != nil {
+				p.Int64Message[_mapKey] = &Metadata{}
+				if err := p.Int64Message[_mapKey].UnmarshalJX(d); err != nil {
 					return err
 				}
-				p.NestedEnumList = append(p.NestedEnumList, Config_NestedEnum(v))
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "nestedConfig":
-			p.NestedConfig = &Config_NestedConfig{}
-			if err := p.NestedConfig.UnmarshalJX(d); err != nil {
-				return err
+		case "strEnum":
+			if p.StrEnum == nil {
+				p.StrEnum = make(map[string]Status)
 			}
-		case "nestedConfigList":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				var v Config_NestedConfig
-				if err := v.UnmarshalJX(d); err != nil {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := enumjx.Decode(d, "full.Status", Status_value)
+				if err != nil {
 					return err
 				}
-				p.NestedConfigList = append(p.NestedConfigList, &v)
+				p.StrEnum[key] = Status(v)
 				return nil
 			}); err != nil {
 				return err
 			}
-		case "nestedConfigMap":
-			if p.NestedConfigMap == nil {
-				p.NestedConfigMap = make(map[string]*Config_NestedConfig)
+		case "int32Enum":
+			if p.Int32Enum == nil {
+				p.Int32Enum = make(map[int32]Priority)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				p.NestedConfigMap[key] = &Config_NestedConfig{}
-				if err := p.NestedConfigMap[key].UnmarshalJX(d); err != nil {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_k, err := strconv.ParseInt(key, 10, 32)
+				if err != nil {
 					return err
 				}
-				return nil
-			})
-		case "parent":
-			p.Parent = &ConfigPlain{}
-			if err := p.Parent.UnmarshalJX(d); err != nil {
-				return err
-			}
-		case "children":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				var v ConfigPlain
-				if err := v.UnmarshalJX(d); err != nil {
+				_mapKey := int32(_k)
+				v, err := enumjx.Decode(d, "full.Priority", Priority_value)
+				if err != nil {
 					return err
 				}
-				p.Children = append(p.Children, v)
+				p.Int32Enum[_mapKey] = Priority(v)
 				return nil
 			}); err != nil {
 				return err
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	})
-}
-
-// UnmarshalJSON implements json.Unmarshaler using jx
-func (p *ConfigPlain) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return p.UnmarshalJX(d)
-}
-
-// configPlainPool is a sync.Pool for ConfigPlain objects
-var configPlainPool = sync.Pool{
-	New: func() interface{} {
-		return &ConfigPlain{}
-	},
-}
-
-// GetConfigPlain returns a ConfigPlain from the pool
-func GetConfigPlain() *ConfigPlain {
-	return configPlainPool.Get().(*ConfigPlain)
-}
-
-// PutConfigPlain returns a ConfigPlain to the pool after resetting it
-func PutConfigPlain(p *ConfigPlain) {
-	if p == nil {
-		return
-	}
-	p.Reset()
-	configPlainPool.Put(p)
-}
-
-// Reset clears all fields in ConfigPlain for reuse
-func (p *ConfigPlain) Reset() {
-	if p == nil {
-		return
-	}
-
-	p.DoubleVal = 0
-	p.FloatVal = 0
-	p.Int32Val = 0
-	p.Int64Val = 0
-	p.Uint32Val = 0
-	p.Uint64Val = 0
-	p.Sint32Val = 0
-	p.Sint64Val = 0
-	p.Fixed32Val = 0
-	p.Fixed64Val = 0
-	p.Sfixed32Val = 0
-	p.Sfixed64Val = 0
-	p.BoolVal = false
-	p.StringVal = ""
-	p.BytesVal = nil
-	p.OptionalString = ""
-	p.OptionalInt = 0
-	p.OptionalBool = false
-	p.OptionalDouble = 0
-	p.OptionalBytes = nil
-	p.StringList = p.StringList[:0]
-	p.IntList = p.IntList[:0]
-	p.DoubleList = p.DoubleList[:0]
-	p.BytesList = p.BytesList[:0]
-	p.BoolList = p.BoolList[:0]
-	p.FloatList = p.FloatList[:0]
-	p.Int64List = p.Int64List[:0]
-	p.Uint32List = p.Uint32List[:0]
-	p.Uint64List = p.Uint64List[:0]
-	for k := range p.StringMap {
-		delete(p.StringMap, k)
-	}
-	for k := range p.IntMap {
-		delete(p.IntMap, k)
-	}
-	for k := range p.IntKeyMap {
-		delete(p.IntKeyMap, k)
-	}
-	for k := range p.NestedMap {
-		delete(p.NestedMap, k)
-	}
-	for k := range p.Int64KeyMap {
-		delete(p.Int64KeyMap, k)
-	}
-	for k := range p.Uint32KeyMap {
-		delete(p.Uint32KeyMap, k)
-	}
-	for k := range p.Uint64KeyMap {
-		delete(p.Uint64KeyMap, k)
-	}
-	for k := range p.Sint32KeyMap {
-		delete(p.Sint32KeyMap, k)
-	}
-	for k := range p.Sint64KeyMap {
-		delete(p.Sint64KeyMap, k)
-	}
-	for k := range p.Fixed32KeyMap {
-		delete(p.Fixed32KeyMap, k)
-	}
-	for k := range p.Fixed64KeyMap {
-		delete(p.Fixed64KeyMap, k)
-	}
-	for k := range p.Sfixed32KeyMap {
-		delete(p.Sfixed32KeyMap, k)
-	}
-	for k := range p.Sfixed64KeyMap {
-		delete(p.Sfixed64KeyMap, k)
-	}
-	for k := range p.BoolKeyMap {
-		delete(p.BoolKeyMap, k)
-	}
-	for k := range p.DoubleMap {
-		delete(p.DoubleMap, k)
-	}
-	for k := range p.BytesMap {
-		delete(p.BytesMap, k)
-	}
-	for k := range p.BoolMap {
-		delete(p.BoolMap, k)
-	}
-	for k := range p.FloatMap {
-		delete(p.FloatMap, k)
-	}
-	p.Status = 0
-	p.StatusList = p.StatusList[:0]
-	for k := range p.StatusMap {
-		delete(p.StatusMap, k)
-	}
-	p.OptionalStatus = 0
-	p.NestedEnum = 0
-	p.NestedEnumList = p.NestedEnumList[:0]
-	p.NestedConfig = nil
-	p.NestedConfigList = nil
-	for k := range p.NestedConfigMap {
-		delete(p.NestedConfigMap, k)
-	}
-	p.Parent = nil
-	p.Children = p.Children[:0]
-}
-
-type WellKnownTypesPlain struct {
-	CreatedAt      *timestamppb.Timestamp    `json:"createdAt"`
-	Ttl            *durationpb.Duration      `json:"ttl"`
-	UpdatedAt      *timestamppb.Timestamp    `json:"updatedAt"`
-	Latency        *durationpb.Duration      `json:"latency"`
-	NullableString *wrapperspb.StringValue   `json:"nullableString"`
-	NullableInt32  *wrapperspb.Int32Value    `json:"nullableInt32"`
-	NullableInt64  *wrapperspb.Int64Value    `json:"nullableInt64"`
-	NullableUint32 *wrapperspb.UInt32Value   `json:"nullableUint32"`
-	NullableUint64 *wrapperspb.UInt64Value   `json:"nullableUint64"`
-	NullableFloat  *wrapperspb.FloatValue    `json:"nullableFloat"`
-	NullableDouble *wrapperspb.DoubleValue   `json:"nullableDouble"`
-	NullableBool   *wrapperspb.BoolValue     `json:"nullableBool"`
-	NullableBytes  *wrapperspb.BytesValue    `json:"nullableBytes"`
-	Metadata       *structpb.Struct          `json:"metadata"`
-	DynamicValue   *structpb.Value           `json:"dynamicValue"`
-	ListValue      *structpb.ListValue       `json:"listValue"`
-	Payload        *anypb.Any                `json:"payload"`
-	Payloads       []*anypb.Any              `json:"payloads"`
-	Empty          *emptypb.Empty            `json:"empty"`
-	Timestamps     []*timestamppb.Timestamp  `json:"timestamps"`
-	Durations      []*durationpb.Duration    `json:"durations"`
-	Strings        []*wrapperspb.StringValue `json:"strings"`
-}
-
-// IntoPlain converts protobuf message to plain struct
-func (pb *WellKnownTypes) IntoPlain() *WellKnownTypesPlain {
-	if pb == nil {
-		return nil
-	}
-	p := &WellKnownTypesPlain{}
-
-	p.CreatedAt = pb.CreatedAt
-	p.Ttl = pb.Ttl
-	p.UpdatedAt = pb.UpdatedAt
-	p.Latency = pb.Latency
-	p.NullableString = pb.NullableString
-	p.NullableInt32 = pb.NullableInt32
-	p.NullableInt64 = pb.NullableInt64
-	p.NullableUint32 = pb.NullableUint32
-	p.NullableUint64 = pb.NullableUint64
-	p.NullableFloat = pb.NullableFloat
-	p.NullableDouble = pb.NullableDouble
-	p.NullableBool = pb.NullableBool
-	p.NullableBytes = pb.NullableBytes
-	p.Metadata = pb.Metadata
-	p.DynamicValue = pb.DynamicValue
-	p.ListValue = pb.ListValue
-	p.Payload = pb.Payload
-	p.Payloads = pb.Payloads
-	p.Empty = pb.Empty
-	p.Timestamps = pb.Timestamps
-	p.Durations = pb.Durations
-	p.Strings = pb.Strings
-	return p
-}
-
-// IntoPb converts plain struct to protobuf message
-func (p *WellKnownTypesPlain) IntoPb() *WellKnownTypes {
-	if p == nil {
-		return nil
-	}
-	pb := &WellKnownTypes{}
-
-	pb.CreatedAt = p.CreatedAt
-	pb.Ttl = p.Ttl
-	pb.UpdatedAt = p.UpdatedAt
-	pb.Latency = p.Latency
-	pb.NullableString = p.NullableString
-	pb.NullableInt32 = p.NullableInt32
-	pb.NullableInt64 = p.NullableInt64
-	pb.NullableUint32 = p.NullableUint32
-	pb.NullableUint64 = p.NullableUint64
-	pb.NullableFloat = p.NullableFloat
-	pb.NullableDouble = p.NullableDouble
-	pb.NullableBool = p.NullableBool
-	pb.NullableBytes = p.NullableBytes
-	pb.Metadata = p.Metadata
-	pb.DynamicValue = p.DynamicValue
-	pb.ListValue = p.ListValue
-	pb.Payload = p.Payload
-	pb.Payloads = p.Payloads
-	pb.Empty = p.Empty
-	pb.Timestamps = p.Timestamps
-	pb.Durations = p.Durations
-	pb.Strings = p.Strings
-	return pb
+		case "nested":
+			if p.Nested == nil {
+				p.Nested = make(map[string]*ConfigPlain)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				p.Nested[key] = &ConfigPlain{}
+				if err := p.Nested[key].UnmarshalJX(d); err != nil {
+					return err
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
 }
 
-// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
-func (pb *WellKnownTypes) IntoPlainReuse(p *WellKnownTypesPlain) {
-	if pb == nil || p == nil {
-		return
-	}
-	// Reset before filling
-	p.Reset()
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *MapShowcasePlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
 
-	p.CreatedAt = pb.CreatedAt
-	p.Ttl = pb.Ttl
-	p.UpdatedAt = pb.UpdatedAt
-	p.Latency = pb.Latency
-	p.NullableString = pb.NullableString
-	p.NullableInt32 = pb.NullableInt32
-	p.NullableInt64 = pb.NullableInt64
-	p.NullableUint32 = pb.NullableUint32
-	p.NullableUint64 = pb.NullableUint64
-	p.NullableFloat = pb.NullableFloat
-	p.NullableDouble = pb.NullableDouble
-	p.NullableBool = pb.NullableBool
-	p.NullableBytes = pb.NullableBytes
-	p.Metadata = pb.Metadata
-	p.DynamicValue = pb.DynamicValue
-	p.ListValue = pb.ListValue
-	p.Payload = pb.Payload
-	p.Payloads = pb.Payloads
-	p.Empty = pb.Empty
-	p.Timestamps = pb.Timestamps
-	p.Durations = pb.Durations
-	p.Strings = pb.Strings
+// MarshalProto encodes MapShowcasePlain in the protobuf wire format of MapShowcase
+func (p *MapShowcasePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
 }
 
-// MarshalJX encodes WellKnownTypesPlain to JSON using jx.Encoder
-func (p *WellKnownTypesPlain) MarshalJX(e *jx.Encoder) {
+// AppendProto appends MapShowcasePlain encoded in the protobuf wire format of MapShowcase to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *MapShowcasePlain) AppendProto(b []byte) ([]byte, error) {
 	if p == nil {
-		e.Null()
-		return
-	}
-
-	e.ObjStart()
-
-	if p.CreatedAt != nil {
-		e.FieldStart("createdAt")
-		if data, err := protojson.Marshal(p.CreatedAt); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.Ttl != nil {
-		e.FieldStart("ttl")
-		if data, err := protojson.Marshal(p.Ttl); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.UpdatedAt != nil {
-		e.FieldStart("updatedAt")
-		if data, err := protojson.Marshal(p.UpdatedAt); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.Latency != nil {
-		e.FieldStart("latency")
-		if data, err := protojson.Marshal(p.Latency); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.NullableString != nil {
-		e.FieldStart("nullableString")
-		if data, err := protojson.Marshal(p.NullableString); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.NullableInt32 != nil {
-		e.FieldStart("nullableInt32")
-		if data, err := protojson.Marshal(p.NullableInt32); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.NullableInt64 != nil {
-		e.FieldStart("nullableInt64")
-		if data, err := protojson.Marshal(p.NullableInt64); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.NullableUint32 != nil {
-		e.FieldStart("nullableUint32")
-		if data, err := protojson.Marshal(p.NullableUint32); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.NullableUint64 != nil {
-		e.FieldStart("nullableUint64")
-		if data, err := protojson.Marshal(p.NullableUint64); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.NullableFloat != nil {
-		e.FieldStart("nullableFloat")
-		if data, err := protojson.Marshal(p.NullableFloat); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.NullableDouble != nil {
-		e.FieldStart("nullableDouble")
-		if data, err := protojson.Marshal(p.NullableDouble); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.NullableBool != nil {
-		e.FieldStart("nullableBool")
-		if data, err := protojson.Marshal(p.NullableBool); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.NullableBytes != nil {
-		e.FieldStart("nullableBytes")
-		if data, err := protojson.Marshal(p.NullableBytes); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
+		return b, nil
+	}
+	var err error
+	for _, k := range plainwire.SortedKeys(p.StrStr) {
+		v := p.StrStr[k]
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrInt32) {
+		v := p.StrInt32[k]
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrInt64) {
+		v := p.StrInt64[k]
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrUint32) {
+		v := p.StrUint32[k]
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrUint64) {
+		v := p.StrUint64[k]
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrFloat) {
+		v := p.StrFloat[k]
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, math.Float32bits(float32(v)))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrDouble) {
+		v := p.StrDouble[k]
+		b = protowire.AppendTag(b, 7, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrBool) {
+		v := p.StrBool[k]
+		b = protowire.AppendTag(b, 8, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrBytes) {
+		v := p.StrBytes[k]
+		b = protowire.AppendTag(b, 9, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendBytes(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Int32Str) {
+		v := p.Int32Str[k]
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Int64Str) {
+		v := p.Int64Str[k]
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Uint32Str) {
+		v := p.Uint32Str[k]
+		b = protowire.AppendTag(b, 12, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Uint64Str) {
+		v := p.Uint64Str[k]
+		b = protowire.AppendTag(b, 13, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Sint32Str) {
+		v := p.Sint32Str[k]
+		b = protowire.AppendTag(b, 14, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(k)))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Sint64Str) {
+		v := p.Sint64Str[k]
+		b = protowire.AppendTag(b, 15, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(k)))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Fixed32Str) {
+		v := p.Fixed32Str[k]
+		b = protowire.AppendTag(b, 16, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, uint32(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Fixed64Str) {
+		v := p.Fixed64Str[k]
+		b = protowire.AppendTag(b, 17, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Sfixed32Str) {
+		v := p.Sfixed32Str[k]
+		b = protowire.AppendTag(b, 18, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, uint32(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Sfixed64Str) {
+		v := p.Sfixed64Str[k]
+		b = protowire.AppendTag(b, 19, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedBoolKeys(p.BoolStr) {
+		v := p.BoolStr[k]
+		b = protowire.AppendTag(b, 20, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrMessage) {
+		v := p.StrMessage[k]
+		b = protowire.AppendTag(b, 30, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_value := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, v); err != nil {
+			return nil, err
 		}
-	}
-	if p.Metadata != nil {
-		e.FieldStart("metadata")
-		if data, err := protojson.Marshal(p.Metadata); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
+		b = plainwire.FinishLen(b, _value)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Int32Message) {
+		v := p.Int32Message[k]
+		b = protowire.AppendTag(b, 31, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_value := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, v); err != nil {
+			return nil, err
 		}
-	}
-	if p.DynamicValue != nil {
-		e.FieldStart("dynamicValue")
-		if data, err := protojson.Marshal(p.DynamicValue); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
+		b = plainwire.FinishLen(b, _value)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Int64Message) {
+		v := p.Int64Message[k]
+		b = protowire.AppendTag(b, 32, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_value := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, v); err != nil {
+			return nil, err
 		}
-	}
-	if p.ListValue != nil {
-		e.FieldStart("listValue")
-		if data, err := protojson.Marshal(p.ListValue); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
+		b = plainwire.FinishLen(b, _value)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.StrEnum) {
+		v := p.StrEnum[k]
+		b = protowire.AppendTag(b, 40, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Int32Enum) {
+		v := p.Int32Enum[k]
+		b = protowire.AppendTag(b, 41, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Nested) {
+		v := p.Nested[k]
+		b = protowire.AppendTag(b, 50, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_value := len(b)
+		if b, err = v.AppendProto(b); err != nil {
+			return nil, err
 		}
+		b = plainwire.FinishLen(b, _value)
+		b = plainwire.FinishLen(b, _start)
 	}
-	if p.Payload != nil {
-		e.FieldStart("payload")
-		if data, err := protojson.Marshal(p.Payload); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
+	return b, err
+}
+
+// UnmarshalProto decodes MapShowcasePlain from the protobuf wire format of MapShowcase.
+// Unknown fields are skipped.
+func (p *MapShowcasePlain) UnmarshalProto(b []byte) error {
+	*p = MapShowcasePlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
 		}
-	}
-	if p.Payloads != nil {
-		e.FieldStart("payloads")
-		e.ArrStart()
-		for _, v := range p.Payloads {
-			if data, err := protojson.Marshal(v); err == nil {
-				e.Raw(data)
-			} else {
-				e.Null()
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-		}
-		e.ArrEnd()
-	}
-	if p.Empty != nil {
-		e.FieldStart("empty")
-		if data, err := protojson.Marshal(p.Empty); err == nil {
-			e.Raw(data)
-		} else {
-			e.Null()
-		}
-	}
-	if p.Timestamps != nil {
-		e.FieldStart("timestamps")
-		e.ArrStart()
-		for _, v := range p.Timestamps {
-			if data, err := protojson.Marshal(v); err == nil {
-				e.Raw(data)
-			} else {
-				e.Null()
+			if p.StrStr == nil {
+				p.StrStr = make(map[string]string)
 			}
-		}
-		e.ArrEnd()
-	}
-	if p.Durations != nil {
-		e.FieldStart("durations")
-		e.ArrStart()
-		for _, v := range p.Durations {
-			if data, err := protojson.Marshal(v); err == nil {
-				e.Raw(data)
-			} else {
-				e.Null()
+			p.StrStr[mk] = mv
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv int32
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = int32(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-		}
-		e.ArrEnd()
-	}
-	if p.Strings != nil {
-		e.FieldStart("strings")
-		e.ArrStart()
-		for _, v := range p.Strings {
-			if data, err := protojson.Marshal(v); err == nil {
-				e.Raw(data)
-			} else {
-				e.Null()
+			if p.StrInt32 == nil {
+				p.StrInt32 = make(map[string]int32)
+			}
+			p.StrInt32[mk] = mv
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv int64
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.StrInt64 == nil {
+				p.StrInt64 = make(map[string]int64)
+			}
+			p.StrInt64[mk] = mv
+		case num == 4 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv uint32
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = uint32(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.StrUint32 == nil {
+				p.StrUint32 = make(map[string]uint32)
 			}
-		}
-		e.ArrEnd()
-	}
-	e.ObjEnd()
-}
-
-// MarshalJSON implements json.Marshaler using jx
-func (p *WellKnownTypesPlain) MarshalJSON() ([]byte, error) {
-	e := jx.GetEncoder()
-	defer jx.PutEncoder(e)
-	p.MarshalJX(e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJX decodes WellKnownTypesPlain from JSON using jx.Decoder
-func (p *WellKnownTypesPlain) UnmarshalJX(d *jx.Decoder) error {
-	if p == nil {
-		return nil
-	}
-
-	return d.Obj(func(d *jx.Decoder, key string) error {
-		switch key {
-		case "createdAt":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.StrUint32[mk] = mv
+		case num == 5 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv uint64
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.CreatedAt = &timestamppb.Timestamp{}
-			if err := protojson.Unmarshal(raw, p.CreatedAt); err != nil {
-				return err
+			if p.StrUint64 == nil {
+				p.StrUint64 = make(map[string]uint64)
 			}
-		case "ttl":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.StrUint64[mk] = mv
+		case num == 6 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv float32
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.Fixed32Type:
+					var v uint32
+					v, n = protowire.ConsumeFixed32(b)
+					mv = math.Float32frombits(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.Ttl = &durationpb.Duration{}
-			if err := protojson.Unmarshal(raw, p.Ttl); err != nil {
-				return err
+			if p.StrFloat == nil {
+				p.StrFloat = make(map[string]float32)
 			}
-		case "updatedAt":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.StrFloat[mk] = mv
+		case num == 7 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv float64
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					mv = math.Float64frombits(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.UpdatedAt = &timestamppb.Timestamp{}
-			if err := protojson.Unmarshal(raw, p.UpdatedAt); err != nil {
-				return err
+			if p.StrDouble == nil {
+				p.StrDouble = make(map[string]float64)
 			}
-		case "latency":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.StrDouble[mk] = mv
+		case num == 8 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv bool
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = protowire.DecodeBool(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.Latency = &durationpb.Duration{}
-			if err := protojson.Unmarshal(raw, p.Latency); err != nil {
-				return err
+			if p.StrBool == nil {
+				p.StrBool = make(map[string]bool)
 			}
-		case "nullableString":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.StrBool[mk] = mv
+		case num == 9 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv []byte
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					mv = append([]byte{}, v...)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.NullableString = &wrapperspb.StringValue{}
-			if err := protojson.Unmarshal(raw, p.NullableString); err != nil {
-				return err
+			if p.StrBytes == nil {
+				p.StrBytes = make(map[string][]byte)
 			}
-		case "nullableInt32":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.StrBytes[mk] = mv
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int32(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.NullableInt32 = &wrapperspb.Int32Value{}
-			if err := protojson.Unmarshal(raw, p.NullableInt32); err != nil {
-				return err
+			if p.Int32Str == nil {
+				p.Int32Str = make(map[int32]string)
 			}
-		case "nullableInt64":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Int32Str[mk] = mv
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int64(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.NullableInt64 = &wrapperspb.Int64Value{}
-			if err := protojson.Unmarshal(raw, p.NullableInt64); err != nil {
-				return err
+			if p.Int64Str == nil {
+				p.Int64Str = make(map[int64]string)
 			}
-		case "nullableUint32":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Int64Str[mk] = mv
+		case num == 12 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk uint32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = uint32(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.NullableUint32 = &wrapperspb.UInt32Value{}
-			if err := protojson.Unmarshal(raw, p.NullableUint32); err != nil {
-				return err
+			if p.Uint32Str == nil {
+				p.Uint32Str = make(map[uint32]string)
 			}
-		case "nullableUint64":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Uint32Str[mk] = mv
+		case num == 13 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk uint64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.NullableUint64 = &wrapperspb.UInt64Value{}
-			if err := protojson.Unmarshal(raw, p.NullableUint64); err != nil {
-				return err
+			if p.Uint64Str == nil {
+				p.Uint64Str = make(map[uint64]string)
 			}
-		case "nullableFloat":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Uint64Str[mk] = mv
+		case num == 14 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int32(protowire.DecodeZigZag(v & math.MaxUint32))
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.NullableFloat = &wrapperspb.FloatValue{}
-			if err := protojson.Unmarshal(raw, p.NullableFloat); err != nil {
-				return err
+			if p.Sint32Str == nil {
+				p.Sint32Str = make(map[int32]string)
 			}
-		case "nullableDouble":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Sint32Str[mk] = mv
+		case num == 15 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = protowire.DecodeZigZag(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.NullableDouble = &wrapperspb.DoubleValue{}
-			if err := protojson.Unmarshal(raw, p.NullableDouble); err != nil {
-				return err
+			if p.Sint64Str == nil {
+				p.Sint64Str = make(map[int64]string)
 			}
-		case "nullableBool":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Sint64Str[mk] = mv
+		case num == 16 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk uint32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed32Type:
+					var v uint32
+					v, n = protowire.ConsumeFixed32(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.NullableBool = &wrapperspb.BoolValue{}
-			if err := protojson.Unmarshal(raw, p.NullableBool); err != nil {
-				return err
+			if p.Fixed32Str == nil {
+				p.Fixed32Str = make(map[uint32]string)
 			}
-		case "nullableBytes":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Fixed32Str[mk] = mv
+		case num == 17 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk uint64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.NullableBytes = &wrapperspb.BytesValue{}
-			if err := protojson.Unmarshal(raw, p.NullableBytes); err != nil {
-				return err
+			if p.Fixed64Str == nil {
+				p.Fixed64Str = make(map[uint64]string)
 			}
-		case "metadata":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Fixed64Str[mk] = mv
+		case num == 18 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int32
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed32Type:
+					var v uint32
+					v, n = protowire.ConsumeFixed32(b)
+					mk = int32(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.Metadata = &structpb.Struct{}
-			if err := protojson.Unmarshal(raw, p.Metadata); err != nil {
-				return err
+			if p.Sfixed32Str == nil {
+				p.Sfixed32Str = make(map[int32]string)
 			}
-		case "dynamicValue":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Sfixed32Str[mk] = mv
+		case num == 19 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int64
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					mk = int64(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.DynamicValue = &structpb.Value{}
-			if err := protojson.Unmarshal(raw, p.DynamicValue); err != nil {
-				return err
+			if p.Sfixed64Str == nil {
+				p.Sfixed64Str = make(map[int64]string)
 			}
-		case "listValue":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.Sfixed64Str[mk] = mv
+		case num == 20 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk bool
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = protowire.DecodeBool(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.ListValue = &structpb.ListValue{}
-			if err := protojson.Unmarshal(raw, p.ListValue); err != nil {
-				return err
+			if p.BoolStr == nil {
+				p.BoolStr = make(map[bool]string)
 			}
-		case "payload":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			p.BoolStr[mk] = mv
+		case num == 30 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			mv := &Address{}
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					if err := proto.Unmarshal(v, mv); err != nil {
+						return err
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-			p.Payload = &anypb.Any{}
-			if err := protojson.Unmarshal(raw, p.Payload); err != nil {
-				return err
+			if p.StrMessage == nil {
+				p.StrMessage = make(map[string]*Address)
 			}
-		case "payloads":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				raw, err := d.Raw()
-				if err != nil {
-					return err
+			p.StrMessage[mk] = mv
+		case num == 31 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int32
+			mv := &Address{}
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
 				}
-				var v anypb.Any
-				if err := protojson.Unmarshal(raw, &v); err != nil {
-					return err
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int32(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					if err := proto.Unmarshal(v, mv); err != nil {
+						return err
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
 				}
-				p.Payloads = append(p.Payloads, &v)
-				return nil
-			}); err != nil {
-				return err
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-		case "empty":
-			raw, err := d.Raw()
-			if err != nil {
-				return err
+			if p.Int32Message == nil {
+				p.Int32Message = make(map[int32]*Address)
 			}
-			p.Empty = &emptypb.Empty{}
-			if err := protojson.Unmarshal(raw, p.Empty); err != nil {
-				return err
+			p.Int32Message[mk] = mv
+		case num == 32 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int64
+			mv := &Metadata{}
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int64(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					if err := proto.Unmarshal(v, mv); err != nil {
+						return err
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-		case "timestamps":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				raw, err := d.Raw()
-				if err != nil {
-					return err
+			if p.Int64Message == nil {
+				p.Int64Message = make(map[int64]*Metadata)
+			}
+			p.Int64Message[mk] = mv
+		case num == 40 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv Status
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
 				}
-				var v timestamppb.Timestamp
-				if err := protojson.Unmarshal(raw, &v); err != nil {
-					return err
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = Status(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
 				}
-				p.Timestamps = append(p.Timestamps, &v)
-				return nil
-			}); err != nil {
-				return err
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-		case "durations":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				raw, err := d.Raw()
-				if err != nil {
-					return err
+			if p.StrEnum == nil {
+				p.StrEnum = make(map[string]Status)
+			}
+			p.StrEnum[mk] = mv
+		case num == 41 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int32
+			var mv Priority
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
 				}
-				var v durationpb.Duration
-				if err := protojson.Unmarshal(raw, &v); err != nil {
-					return err
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int32(v)
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = Priority(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
 				}
-				p.Durations = append(p.Durations, &v)
-				return nil
-			}); err != nil {
-				return err
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
 			}
-		case "strings":
-			if err := d.Arr(func(d *jx.Decoder) error {
-				raw, err := d.Raw()
-				if err != nil {
-					return err
+			if p.Int32Enum == nil {
+				p.Int32Enum = make(map[int32]Priority)
+			}
+			p.Int32Enum[mk] = mv
+		case num == 50 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			mv := &ConfigPlain{}
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
 				}
-				var v wrapperspb.StringValue
-				if err := protojson.Unmarshal(raw, &v); err != nil {
-					return err
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					if err := mv.UnmarshalProto(v); err != nil {
+						return err
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
 				}
-				p.Strings = append(p.Strings, &v)
-				return nil
-			}); err != nil {
-				return err
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Nested == nil {
+				p.Nested = make(map[string]*ConfigPlain)
 			}
+			p.Nested[mk] = mv
 		default:
-			return d.Skip()
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
 		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// mapShowcasePlainReflect describes MapShowcasePlain as message full.plain.MapShowcasePlain
+var mapShowcasePlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "MapShowcasePlain",
+	plainreflect.Map(func(p *MapShowcasePlain) *map[string]string { return &p.StrStr }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[string]int32 { return &p.StrInt32 }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[string]int64 { return &p.StrInt64 }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[string]uint32 { return &p.StrUint32 }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[string]uint64 { return &p.StrUint64 }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[string]float32 { return &p.StrFloat }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[string]float64 { return &p.StrDouble }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[string]bool { return &p.StrBool }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[string][]byte { return &p.StrBytes }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[int32]string { return &p.Int32Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[int64]string { return &p.Int64Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[uint32]string { return &p.Uint32Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[uint64]string { return &p.Uint64Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[int32]string { return &p.Sint32Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[int64]string { return &p.Sint64Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[uint32]string { return &p.Fixed32Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[uint64]string { return &p.Fixed64Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[int32]string { return &p.Sfixed32Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[int64]string { return &p.Sfixed64Str }),
+	plainreflect.Map(func(p *MapShowcasePlain) *map[bool]string { return &p.BoolStr }),
+	plainreflect.MessageMap(func(p *MapShowcasePlain) *map[string]*Address { return &p.StrMessage }),
+	plainreflect.MessageMap(func(p *MapShowcasePlain) *map[int32]*Address { return &p.Int32Message }),
+	plainreflect.MessageMap(func(p *MapShowcasePlain) *map[int64]*Metadata { return &p.Int64Message }),
+	plainreflect.EnumMap(func(p *MapShowcasePlain) *map[string]Status { return &p.StrEnum }),
+	plainreflect.EnumMap(func(p *MapShowcasePlain) *map[int32]Priority { return &p.Int32Enum }),
+	plainreflect.MessageMap(func(p *MapShowcasePlain) *map[string]*ConfigPlain { return &p.Nested }),
+)
+
+// ProtoReflect returns the reflective view of MapShowcasePlain backed by the struct
+func (p *MapShowcasePlain) ProtoReflect() protoreflect.Message {
+	return mapShowcasePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of MapShowcasePlain
+func (p *MapShowcasePlain) Clone() *MapShowcasePlain {
+	if p == nil {
 		return nil
-	})
+	}
+	dst := &MapShowcasePlain{}
+	p.CopyTo(dst)
+	return dst
 }
 
-// UnmarshalJSON implements json.Unmarshaler using jx
-func (p *WellKnownTypesPlain) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return p.UnmarshalJX(d)
+// CopyTo overwrites dst with a deep copy of MapShowcasePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetMapShowcasePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *MapShowcasePlain) CopyTo(dst *MapShowcasePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	for k := range dst.StrStr {
+		delete(dst.StrStr, k)
+	}
+	if dst.StrStr == nil && p.StrStr != nil {
+		dst.StrStr = make(map[string]string, len(p.StrStr))
+	}
+	for k, v := range p.StrStr {
+		dst.StrStr[k] = v
+	}
+	for k := range dst.StrInt32 {
+		delete(dst.StrInt32, k)
+	}
+	if dst.StrInt32 == nil && p.StrInt32 != nil {
+		dst.StrInt32 = make(map[string]int32, len(p.StrInt32))
+	}
+	for k, v := range p.StrInt32 {
+		dst.StrInt32[k] = v
+	}
+	for k := range dst.StrInt64 {
+		delete(dst.StrInt64, k)
+	}
+	if dst.StrInt64 == nil && p.StrInt64 != nil {
+		dst.StrInt64 = make(map[string]int64, len(p.StrInt64))
+	}
+	for k, v := range p.StrInt64 {
+		dst.StrInt64[k] = v
+	}
+	for k := range dst.StrUint32 {
+		delete(dst.StrUint32, k)
+	}
+	if dst.StrUint32 == nil && p.StrUint32 != nil {
+		dst.StrUint32 = make(map[string]uint32, len(p.StrUint32))
+	}
+	for k, v := range p.StrUint32 {
+		dst.StrUint32[k] = v
+	}
+	for k := range dst.StrUint64 {
+		delete(dst.StrUint64, k)
+	}
+	if dst.StrUint64 == nil && p.StrUint64 != nil {
+		dst.StrUint64 = make(map[string]uint64, len(p.StrUint64))
+	}
+	for k, v := range p.StrUint64 {
+		dst.StrUint64[k] = v
+	}
+	for k := range dst.StrFloat {
+		delete(dst.StrFloat, k)
+	}
+	if dst.StrFloat == nil && p.StrFloat != nil {
+		dst.StrFloat = make(map[string]float32, len(p.StrFloat))
+	}
+	for k, v := range p.StrFloat {
+		dst.StrFloat[k] = v
+	}
+	for k := range dst.StrDouble {
+		delete(dst.StrDouble, k)
+	}
+	if dst.StrDouble == nil && p.StrDouble != nil {
+		dst.StrDouble = make(map[string]float64, len(p.StrDouble))
+	}
+	for k, v := range p.StrDouble {
+		dst.StrDouble[k] = v
+	}
+	for k := range dst.StrBool {
+		delete(dst.StrBool, k)
+	}
+	if dst.StrBool == nil && p.StrBool != nil {
+		dst.StrBool = make(map[string]bool, len(p.StrBool))
+	}
+	for k, v := range p.StrBool {
+		dst.StrBool[k] = v
+	}
+	for k := range dst.StrBytes {
+		delete(dst.StrBytes, k)
+	}
+	if dst.StrBytes == nil && p.StrBytes != nil {
+		dst.StrBytes = make(map[string][]byte, len(p.StrBytes))
+	}
+	for k, v := range p.StrBytes {
+		dst.StrBytes[k] = append([]byte(nil), v...)
+	}
+	for k := range dst.Int32Str {
+		delete(dst.Int32Str, k)
+	}
+	if dst.Int32Str == nil && p.Int32Str != nil {
+		dst.Int32Str = make(map[int32]string, len(p.Int32Str))
+	}
+	for k, v := range p.Int32Str {
+		dst.Int32Str[k] = v
+	}
+	for k := range dst.Int64Str {
+		delete(dst.Int64Str, k)
+	}
+	if dst.Int64Str == nil && p.Int64Str != nil {
+		dst.Int64Str = make(map[int64]string, len(p.Int64Str))
+	}
+	for k, v := range p.Int64Str {
+		dst.Int64Str[k] = v
+	}
+	for k := range dst.Uint32Str {
+		delete(dst.Uint32Str, k)
+	}
+	if dst.Uint32Str == nil && p.Uint32Str != nil {
+		dst.Uint32Str = make(map[uint32]string, len(p.Uint32Str))
+	}
+	for k, v := range p.Uint32Str {
+		dst.Uint32Str[k] = v
+	}
+	for k := range dst.Uint64Str {
+		delete(dst.Uint64Str, k)
+	}
+	if dst.Uint64Str == nil && p.Uint64Str != nil {
+		dst.Uint64Str = make(map[uint64]string, len(p.Uint64Str))
+	}
+	for k, v := range p.Uint64Str {
+		dst.Uint64Str[k] = v
+	}
+	for k := range dst.Sint32Str {
+		delete(dst.Sint32Str, k)
+	}
+	if dst.Sint32Str == nil && p.Sint32Str != nil {
+		dst.Sint32Str = make(map[int32]string, len(p.Sint32Str))
+	}
+	for k, v := range p.Sint32Str {
+		dst.Sint32Str[k] = v
+	}
+	for k := range dst.Sint64Str {
+		delete(dst.Sint64Str, k)
+	}
+	if dst.Sint64Str == nil && p.Sint64Str != nil {
+		dst.Sint64Str = make(map[int64]string, len(p.Sint64Str))
+	}
+	for k, v := range p.Sint64Str {
+		dst.Sint64Str[k] = v
+	}
+	for k := range dst.Fixed32Str {
+		delete(dst.Fixed32Str, k)
+	}
+	if dst.Fixed32Str == nil && p.Fixed32Str != nil {
+		dst.Fixed32Str = make(map[uint32]string, len(p.Fixed32Str))
+	}
+	for k, v := range p.Fixed32Str {
+		dst.Fixed32Str[k] = v
+	}
+	for k := range dst.Fixed64Str {
+		delete(dst.Fixed64Str, k)
+	}
+	if dst.Fixed64Str == nil && p.Fixed64Str != nil {
+		dst.Fixed64Str = make(map[uint64]string, len(p.Fixed64Str))
+	}
+	for k, v := range p.Fixed64Str {
+		dst.Fixed64Str[k] = v
+	}
+	for k := range dst.Sfixed32Str {
+		delete(dst.Sfixed32Str, k)
+	}
+	if dst.Sfixed32Str == nil && p.Sfixed32Str != nil {
+		dst.Sfixed32Str = make(map[int32]string, len(p.Sfixed32Str))
+	}
+	for k, v := range p.Sfixed32Str {
+		dst.Sfixed32Str[k] = v
+	}
+	for k := range dst.Sfixed64Str {
+		delete(dst.Sfixed64Str, k)
+	}
+	if dst.Sfixed64Str == nil && p.Sfixed64Str != nil {
+		dst.Sfixed64Str = make(map[int64]string, len(p.Sfixed64Str))
+	}
+	for k, v := range p.Sfixed64Str {
+		dst.Sfixed64Str[k] = v
+	}
+	for k := range dst.BoolStr {
+		delete(dst.BoolStr, k)
+	}
+	if dst.BoolStr == nil && p.BoolStr != nil {
+		dst.BoolStr = make(map[bool]string, len(p.BoolStr))
+	}
+	for k, v := range p.BoolStr {
+		dst.BoolStr[k] = v
+	}
+	for k := range dst.StrMessage {
+		if _, ok := p.StrMessage[k]; !ok {
+			delete(dst.StrMessage, k)
+		}
+	}
+	if dst.StrMessage == nil && p.StrMessage != nil {
+		dst.StrMessage = make(map[string]*Address, len(p.StrMessage))
+	}
+	for k, v := range p.StrMessage {
+		if v == nil {
+			dst.StrMessage[k] = nil
+			continue
+		}
+		d := dst.StrMessage[k]
+		if d == nil {
+			d = new(Address)
+		} else {
+			proto.Reset(d)
+		}
+		proto.Merge(d, v)
+		dst.StrMessage[k] = d
+	}
+	for k := range dst.Int32Message {
+		if _, ok := p.Int32Message[k]; !ok {
+			delete(dst.Int32Message, k)
+		}
+	}
+	if dst.Int32Message == nil && p.Int32Message != nil {
+		dst.Int32Message = make(map[int32]*Address, len(p.Int32Message))
+	}
+	for k, v := range p.Int32Message {
+		if v == nil {
+			dst.Int32Message[k] = nil
+			continue
+		}
+		d := dst.Int32Message[k]
+		if d == nil {
+			d = new(Address)
+		} else {
+			proto.Reset(d)
+		}
+		proto.Merge(d, v)
+		dst.Int32Message[k] = d
+	}
+	for k := range dst.Int64Message {
+		if _, ok := p.Int64Message[k]; !ok {
+			delete(dst.Int64Message, k)
+		}
+	}
+	if dst.Int64Message == nil && p.Int64Message != nil {
+		dst.Int64Message = make(map[int64]*Metadata, len(p.Int64Message))
+	}
+	for k, v := range p.Int64Message {
+		if v == nil {
+			dst.Int64Message[k] = nil
+			continue
+		}
+		d := dst.Int64Message[k]
+		if d == nil {
+			d = new(Metadata)
+		} else {
+			proto.Reset(d)
+		}
+		proto.Merge(d, v)
+		dst.Int64Message[k] = d
+	}
+	for k := range dst.StrEnum {
+		delete(dst.StrEnum, k)
+	}
+	if dst.StrEnum == nil && p.StrEnum != nil {
+		dst.StrEnum = make(map[string]Status, len(p.StrEnum))
+	}
+	for k, v := range p.StrEnum {
+		dst.StrEnum[k] = v
+	}
+	for k := range dst.Int32Enum {
+		delete(dst.Int32Enum, k)
+	}
+	if dst.Int32Enum == nil && p.Int32Enum != nil {
+		dst.Int32Enum = make(map[int32]Priority, len(p.Int32Enum))
+	}
+	for k, v := range p.Int32Enum {
+		dst.Int32Enum[k] = v
+	}
+	for k := range dst.Nested {
+		if _, ok := p.Nested[k]; !ok {
+			delete(dst.Nested, k)
+		}
+	}
+	if dst.Nested == nil && p.Nested != nil {
+		dst.Nested = make(map[string]*ConfigPlain, len(p.Nested))
+	}
+	for k, v := range p.Nested {
+		if v == nil {
+			dst.Nested[k] = nil
+			continue
+		}
+		d := dst.Nested[k]
+		if d == nil {
+			d = new(ConfigPlain)
+		}
+		v.CopyTo(d)
+		dst.Nested[k] = d
+	}
 }
 
-// wellKnownTypesPlainPool is a sync.Pool for WellKnownTypesPlain objects
-var wellKnownTypesPlainPool = sync.Pool{
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *MapShowcasePlain) Equal(other *MapShowcasePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if !maps.Equal(p.StrStr, other.StrStr) {
+		return false
+	}
+	if !maps.Equal(p.StrInt32, other.StrInt32) {
+		return false
+	}
+	if !maps.Equal(p.StrInt64, other.StrInt64) {
+		return false
+	}
+	if !maps.Equal(p.StrUint32, other.StrUint32) {
+		return false
+	}
+	if !maps.Equal(p.StrUint64, other.StrUint64) {
+		return false
+	}
+	if !maps.Equal(p.StrFloat, other.StrFloat) {
+		return false
+	}
+	if !maps.Equal(p.StrDouble, other.StrDouble) {
+		return false
+	}
+	if !maps.Equal(p.StrBool, other.StrBool) {
+		return false
+	}
+	if len(p.StrBytes) != len(other.StrBytes) {
+		return false
+	}
+	for k, v := range p.StrBytes {
+		w, ok := other.StrBytes[k]
+		if !ok || !bytes.Equal(v, w) {
+			return false
+		}
+	}
+	if !maps.Equal(p.Int32Str, other.Int32Str) {
+		return false
+	}
+	if !maps.Equal(p.Int64Str, other.Int64Str) {
+		return false
+	}
+	if !maps.Equal(p.Uint32Str, other.Uint32Str) {
+		return false
+	}
+	if !maps.Equal(p.Uint64Str, other.Uint64Str) {
+		return false
+	}
+	if !maps.Equal(p.Sint32Str, other.Sint32Str) {
+		return false
+	}
+	if !maps.Equal(p.Sint64Str, other.Sint64Str) {
+		return false
+	}
+	if !maps.Equal(p.Fixed32Str, other.Fixed32Str) {
+		return false
+	}
+	if !maps.Equal(p.Fixed64Str, other.Fixed64Str) {
+		return false
+	}
+	if !maps.Equal(p.Sfixed32Str, other.Sfixed32Str) {
+		return false
+	}
+	if !maps.Equal(p.Sfixed64Str, other.Sfixed64Str) {
+		return false
+	}
+	if !maps.Equal(p.BoolStr, other.BoolStr) {
+		return false
+	}
+	if len(p.StrMessage) != len(other.StrMessage) {
+		return false
+	}
+	for k, v := range p.StrMessage {
+		w, ok := other.StrMessage[k]
+		if !ok || !proto.Equal(v, w) {
+			return false
+		}
+	}
+	if len(p.Int32Message) != len(other.Int32Message) {
+		return false
+	}
+	for k, v := range p.Int32Message {
+		w, ok := other.Int32Message[k]
+		if !ok || !proto.Equal(v, w) {
+			return false
+		}
+	}
+	if len(p.Int64Message) != len(other.Int64Message) {
+		return false
+	}
+	for k, v := range p.Int64Message {
+		w, ok := other.Int64Message[k]
+		if !ok || !proto.Equal(v, w) {
+			return false
+		}
+	}
+	if !maps.Equal(p.StrEnum, other.StrEnum) {
+		return false
+	}
+	if !maps.Equal(p.Int32Enum, other.Int32Enum) {
+		return false
+	}
+	if len(p.Nested) != len(other.Nested) {
+		return false
+	}
+	for k, v := range p.Nested {
+		w, ok := other.Nested[k]
+		if !ok || !v.Equal(w) {
+			return false
+		}
+	}
+	return true
+}
+
+// Columns returns the column names of MapShowcasePlain rows, in the order of ScanRow and Values
+func (p *MapShowcasePlain) Columns() []string {
+	return []string{
+		"str_str",
+		"str_int32",
+		"str_int64",
+		"str_uint32",
+		"str_uint64",
+		"str_float",
+		"str_double",
+		"str_bool",
+		"str_bytes",
+		"int32_str",
+		"int64_str",
+		"uint32_str",
+		"uint64_str",
+		"sint32_str",
+		"sint64_str",
+		"fixed32_str",
+		"fixed64_str",
+		"sfixed32_str",
+		"sfixed64_str",
+		"bool_str",
+		"str_message",
+		"int32_message",
+		"int64_message",
+		"str_enum",
+		"int32_enum",
+		"nested",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into MapShowcasePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *MapShowcasePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		plainsql.JSON(&p.StrStr),
+		plainsql.JSON(&p.StrInt32),
+		plainsql.JSON(&p.StrInt64),
+		plainsql.JSON(&p.StrUint32),
+		plainsql.JSON(&p.StrUint64),
+		plainsql.JSON(&p.StrFloat),
+		plainsql.JSON(&p.StrDouble),
+		plainsql.JSON(&p.StrBool),
+		plainsql.JSON(&p.StrBytes),
+		plainsql.JSON(&p.Int32Str),
+		plainsql.JSON(&p.Int64Str),
+		plainsql.JSON(&p.Uint32Str),
+		plainsql.JSON(&p.Uint64Str),
+		plainsql.JSON(&p.Sint32Str),
+		plainsql.JSON(&p.Sint64Str),
+		plainsql.JSON(&p.Fixed32Str),
+		plainsql.JSON(&p.Fixed64Str),
+		plainsql.JSON(&p.Sfixed32Str),
+		plainsql.JSON(&p.Sfixed64Str),
+		plainsql.JSON(&p.BoolStr),
+		plainsql.JSON(&p.StrMessage),
+		plainsql.JSON(&p.Int32Message),
+		plainsql.JSON(&p.Int64Message),
+		plainsql.JSON(&p.StrEnum),
+		plainsql.JSON(&p.Int32Enum),
+		plainsql.JSON(&p.Nested),
+	)
+}
+
+// Values returns the column values of MapShowcasePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *MapShowcasePlain) Values() []any {
+	return []any{
+		plainsql.JSON(&p.StrStr),
+		plainsql.JSON(&p.StrInt32),
+		plainsql.JSON(&p.StrInt64),
+		plainsql.JSON(&p.StrUint32),
+		plainsql.JSON(&p.StrUint64),
+		plainsql.JSON(&p.StrFloat),
+		plainsql.JSON(&p.StrDouble),
+		plainsql.JSON(&p.StrBool),
+		plainsql.JSON(&p.StrBytes),
+		plainsql.JSON(&p.Int32Str),
+		plainsql.JSON(&p.Int64Str),
+		plainsql.JSON(&p.Uint32Str),
+		plainsql.JSON(&p.Uint64Str),
+		plainsql.JSON(&p.Sint32Str),
+		plainsql.JSON(&p.Sint64Str),
+		plainsql.JSON(&p.Fixed32Str),
+		plainsql.JSON(&p.Fixed64Str),
+		plainsql.JSON(&p.Sfixed32Str),
+		plainsql.JSON(&p.Sfixed64Str),
+		plainsql.JSON(&p.BoolStr),
+		plainsql.JSON(&p.StrMessage),
+		plainsql.JSON(&p.Int32Message),
+		plainsql.JSON(&p.Int64Message),
+		plainsql.JSON(&p.StrEnum),
+		plainsql.JSON(&p.Int32Enum),
+		plainsql.JSON(&p.Nested),
+	}
+}
+
+// mapShowcasePlainPool is a sync.Pool for MapShowcasePlain objects
+var mapShowcasePlainPool = sync.Pool{
 	New: func() interface{} {
-		return &WellKnownTypesPlain{}
+		return &MapShowcasePlain{}
 	},
 }
 
-// GetWellKnownTypesPlain returns a WellKnownTypesPlain from the pool
-func GetWellKnownTypesPlain() *WellKnownTypesPlain {
-	return wellKnownTypesPlainPool.Get().(*WellKnownTypesPlain)
+// GetMapShowcasePlain returns a MapShowcasePlain from the pool
+func GetMapShowcasePlain() *MapShowcasePlain {
+	return mapShowcasePlainPool.Get().(*MapShowcasePlain)
 }
 
-// PutWellKnownTypesPlain returns a WellKnownTypesPlain to the pool after resetting it
-func PutWellKnownTypesPlain(p *WellKnownTypesPlain) {
+// PutMapShowcasePlain returns a MapShowcasePlain to the pool after resetting it
+func PutMapShowcasePlain(p *MapShowcasePlain) {
 	if p == nil {
 		return
 	}
 	p.Reset()
-	wellKnownTypesPlainPool.Put(p)
+	mapShowcasePlainPool.Put(p)
 }
 
-// Reset clears all fields in WellKnownTypesPlain for reuse
-func (p *WellKnownTypesPlain) Reset() {
+// Reset clears all fields in MapShowcasePlain for reuse
+func (p *MapShowcasePlain) Reset() {
 	if p == nil {
 		return
 	}
 
-	p.CreatedAt = nil
-	p.Ttl = nil
-	p.UpdatedAt = nil
-	p.Latency = nil
-	p.NullableString = nil
-	p.NullableInt32 = nil
-	p.NullableInt64 = nil
-	p.NullableUint32 = nil
-	p.NullableUint64 = nil
-	p.NullableFloat = nil
-	p.NullableDouble = nil
-	p.NullableBool = nil
-	p.NullableBytes = nil
-	p.Metadata = nil
-	p.DynamicValue = nil
-	p.ListValue = nil
-	p.Payload = nil
-	p.Payloads = nil
-	p.Empty = nil
-	p.Timestamps = nil
-	p.Durations = nil
-	p.Strings = nil
+	for k := range p.StrStr {
+		delete(p.StrStr, k)
+	}
+	for k := range p.StrInt32 {
+		delete(p.StrInt32, k)
+	}
+	for k := range p.StrInt64 {
+		delete(p.StrInt64, k)
+	}
+	for k := range p.StrUint32 {
+		delete(p.StrUint32, k)
+	}
+	for k := range p.StrUint64 {
+		delete(p.StrUint64, k)
+	}
+	for k := range p.StrFloat {
+		delete(p.StrFloat, k)
+	}
+	for k := range p.StrDouble {
+		delete(p.StrDouble, k)
+	}
+	for k := range p.StrBool {
+		delete(p.StrBool, k)
+	}
+	for k := range p.StrBytes {
+		delete(p.StrBytes, k)
+	}
+	for k := range p.Int32Str {
+		delete(p.Int32Str, k)
+	}
+	for k := range p.Int64Str {
+		delete(p.Int64Str, k)
+	}
+	for k := range p.Uint32Str {
+		delete(p.Uint32Str, k)
+	}
+	for k := range p.Uint64Str {
+		delete(p.Uint64Str, k)
+	}
+	for k := range p.Sint32Str {
+		delete(p.Sint32Str, k)
+	}
+	for k := range p.Sint64Str {
+		delete(p.Sint64Str, k)
+	}
+	for k := range p.Fixed32Str {
+		delete(p.Fixed32Str, k)
+	}
+	for k := range p.Fixed64Str {
+		delete(p.Fixed64Str, k)
+	}
+	for k := range p.Sfixed32Str {
+		delete(p.Sfixed32Str, k)
+	}
+	for k := range p.Sfixed64Str {
+		delete(p.Sfixed64Str, k)
+	}
+	for k := range p.BoolStr {
+		delete(p.BoolStr, k)
+	}
+	for k := range p.StrMessage {
+		delete(p.StrMessage, k)
+	}
+	for k := range p.Int32Message {
+		delete(p.Int32Message, k)
+	}
+	for k := range p.Int64Message {
+		delete(p.Int64Message, k)
+	}
+	for k := range p.StrEnum {
+		delete(p.StrEnum, k)
+	}
+	for k := range p.Int32Enum {
+		delete(p.Int32Enum, k)
+	}
+	for k := range p.Nested {
+		delete(p.Nested, k)
+	}
 }
 
-type MapShowcasePlain struct {
-	StrStr       map[string]string       `json:"strStr"`
-	StrInt32     map[string]int32        `json:"strInt32"`
-	StrInt64     map[string]int64        `json:"strInt64"`
-	StrUint32    map[string]uint32       `json:"strUint32"`
-	StrUint64    map[string]uint64       `json:"strUint64"`
-	StrFloat     map[string]float32      `json:"strFloat"`
-	StrDouble    map[string]float64      `json:"strDouble"`
-	StrBool      map[string]bool         `json:"strBool"`
-	StrBytes     map[string][]byte       `json:"strBytes"`
-	Int32Str     map[int32]string        `json:"int32Str"`
-	Int64Str     map[int64]string        `json:"int64Str"`
-	Uint32Str    map[uint32]string       `json:"uint32Str"`
-	Uint64Str    map[uint64]string       `json:"uint64Str"`
-	Sint32Str    map[int32]string        `json:"sint32Str"`
-	Sint64Str    map[int64]string        `json:"sint64Str"`
-	Fixed32Str   map[uint32]string       `json:"fixed32Str"`
-	Fixed64Str   map[uint64]string       `json:"fixed64Str"`
-	Sfixed32Str  map[int32]string        `json:"sfixed32Str"`
-	Sfixed64Str  map[int64]string        `json:"sfixed64Str"`
-	BoolStr      map[bool]string         `json:"boolStr"`
-	StrMessage   map[string]*Address     `json:"strMessage"`
-	Int32Message map[int32]*Address      `json:"int32Message"`
-	Int64Message map[int64]*Metadata     `json:"int64Message"`
-	StrEnum      map[string]Status       `json:"strEnum"`
-	Int32Enum    map[int32]Priority      `json:"int32Enum"`
-	Nested       map[string]*ConfigPlain `json:"nested"`
+type OptionalShowcasePlain struct {
+	OptDouble     *float64  `json:"optDouble,omitempty"`
+	OptFloat      *float32  `json:"optFloat,omitempty"`
+	OptInt32      *int32    `json:"optInt32,omitempty"`
+	OptInt64      *int64    `json:"optInt64,omitempty"`
+	OptUint32     *uint32   `json:"optUint32,omitempty"`
+	OptUint64     *uint64   `json:"optUint64,omitempty"`
+	OptSint32     *int32    `json:"optSint32,omitempty"`
+	OptSint64     *int64    `json:"optSint64,omitempty"`
+	OptFixed32    *uint32   `json:"optFixed32,omitempty"`
+	OptFixed64    *uint64   `json:"optFixed64,omitempty"`
+	OptSfixed32   *int32    `json:"optSfixed32,omitempty"`
+	OptSfixed64   *int64    `json:"optSfixed64,omitempty"`
+	OptBool       *bool     `json:"optBool,omitempty"`
+	OptString     *string   `json:"optString,omitempty"`
+	OptBytes      *[]byte   `json:"optBytes,omitempty"`
+	OptStatus     *Status   `json:"optStatus,omitempty"`
+	OptPriority   *Priority `json:"optPriority,omitempty"`
+	RegularDouble float64   `json:"regularDouble"`
+	RegularString string    `json:"regularString"`
+	RegularBool   bool      `json:"regularBool"`
 }
 
 // IntoPlain converts protobuf message to plain struct
-func (pb *MapShowcase) IntoPlain() *MapShowcasePlain {
+func (pb *OptionalShowcase) IntoPlain() *OptionalShowcasePlain {
 	if pb == nil {
 		return nil
 	}
-	p := &MapShowcasePlain{}
+	p := &OptionalShowcasePlain{}
 
-	p.StrStr = pb.StrStr
-	p.StrInt32 = pb.StrInt32
-	p.StrInt64 = pb.StrInt64
-	p.StrUint32 = pb.StrUint32
-	p.StrUint64 = pb.StrUint64
-	p.StrFloat = pb.StrFloat
-	p.StrDouble = pb.StrDouble
-	p.StrBool = pb.StrBool
-	p.StrBytes = pb.StrBytes
-	p.Int32Str = pb.Int32Str
-	p.Int64Str = pb.Int64Str
-	p.Uint32Str = pb.Uint32Str
-	p.Uint64Str = pb.Uint64Str
-	p.Sint32Str = pb.Sint32Str
-	p.Sint64Str = pb.Sint64Str
-	p.Fixed32Str = pb.Fixed32Str
-	p.Fixed64Str = pb.Fixed64Str
-	p.Sfixed32Str = pb.Sfixed32Str
-	p.Sfixed64Str = pb.Sfixed64Str
-	p.BoolStr = pb.BoolStr
-	p.StrMessage = pb.StrMessage
-	p.Int32Message = pb.Int32Message
-	p.Int64Message = pb.Int64Message
-	p.StrEnum = pb.StrEnum
-	p.Int32Enum = pb.Int32Enum
-	if len(pb.Nested) > 0 {
-		p.Nested = make(map[string]*ConfigPlain, len(pb.Nested))
-		for k, v := range pb.Nested {
-			if v != nil {
-				p.Nested[k] = v.IntoPlain()
-			}
-		}
-	}
+	p.OptDouble = pb.OptDouble
+	p.OptFloat = pb.OptFloat
+	p.OptInt32 = pb.OptInt32
+	p.OptInt64 = pb.OptInt64
+	p.OptUint32 = pb.OptUint32
+	p.OptUint64 = pb.OptUint64
+	p.OptSint32 = pb.OptSint32
+	p.OptSint64 = pb.OptSint64
+	p.OptFixed32 = pb.OptFixed32
+	p.OptFixed64 = pb.OptFixed64
+	p.OptSfixed32 = pb.OptSfixed32
+	p.OptSfixed64 = pb.OptSfixed64
+	p.OptBool = pb.OptBool
+	p.OptString = pb.OptString
+	p.OptBytes = &pb.OptBytes
+	p.OptStatus = pb.OptStatus
+	p.OptPriority = pb.OptPriority
+	p.RegularDouble = pb.RegularDouble
+	p.RegularString = pb.RegularString
+	p.RegularBool = pb.RegularBool
 	return p
 }
 
 // IntoPb converts plain struct to protobuf message
-func (p *MapShowcasePlain) IntoPb() *MapShowcase {
+func (p *OptionalShowcasePlain) IntoPb() *OptionalShowcase {
 	if p == nil {
 		return nil
 	}
-	pb := &MapShowcase{}
+	pb := &OptionalShowcase{}
 
-	pb.StrStr = p.StrStr
-	pb.StrInt32 = p.StrInt32
-	pb.StrInt64 = p.StrInt64
-	pb.StrUint32 = p.StrUint32
-	pb.StrUint64 = p.StrUint64
-	pb.StrFloat = p.StrFloat
-	pb.StrDouble = p.StrDouble
-	pb.StrBool = p.StrBool
-	pb.StrBytes = p.StrBytes
-	pb.Int32Str = p.Int32Str
-	pb.Int64Str = p.Int64Str
-	pb.Uint32Str = p.Uint32Str
-	pb.Uint64Str = p.Uint64Str
-	pb.Sint32Str = p.Sint32Str
-	pb.Sint64Str = p.Sint64Str
-	pb.Fixed32Str = p.Fixed32Str
-	pb.Fixed64Str = p.Fixed64Str
-	pb.Sfixed32Str = p.Sfixed32Str
-	pb.Sfixed64Str = p.Sfixed64Str
-	pb.BoolStr = p.BoolStr
-	pb.StrMessage = p.StrMessage
-	pb.Int32Message = p.Int32Message
-	pb.Int64Message = p.Int64Message
-	pb.StrEnum = p.StrEnum
-	pb.Int32Enum = p.Int32Enum
-	if len(p.Nested) > 0 {
-		pb.Nested = make(map[string]*Config, len(p.Nested))
-		for k, v := range p.Nested {
-			if v != nil {
-				pb.Nested[k] = v.IntoPb()
-			}
-		}
-	}
+	pb.OptDouble = p.OptDouble
+	pb.OptFloat = p.OptFloat
+	pb.OptInt32 = p.OptInt32
+	pb.OptInt64 = p.OptInt64
+	pb.OptUint32 = p.OptUint32
+	pb.OptUint64 = p.OptUint64
+	pb.OptSint32 = p.OptSint32
+	pb.OptSint64 = p.OptSint64
+	pb.OptFixed32 = p.OptFixed32
+	pb.OptFixed64 = p.OptFixed64
+	pb.OptSfixed32 = p.OptSfixed32
+	pb.OptSfixed64 = p.OptSfixed64
+	pb.OptBool = p.OptBool
+	pb.OptString = p.OptString
+	if p.OptBytes != nil {
+		pb.OptBytes = *p.OptBytes
+	}
+	pb.OptStatus = p.OptStatus
+	pb.OptPriority = p.OptPriority
+	pb.RegularDouble = p.RegularDouble
+	pb.RegularString = p.RegularString
+	pb.RegularBool = p.RegularBool
 	return pb
 }
 
-// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
-func (pb *MapShowcase) IntoPlainReuse(p *MapShowcasePlain) {
-	if pb == nil || p == nil {
-		return
-	}
-	// Reset before filling
-	p.Reset()
-
-	p.StrStr = pb.StrStr
-	p.StrInt32 = pb.StrInt32
-	p.StrInt64 = pb.StrInt64
-	p.StrUint32 = pb.StrUint32
-	p.StrUint64 = pb.StrUint64
-	p.StrFloat = pb.StrFloat
-	p.StrDouble = pb.StrDouble
-	p.StrBool = pb.StrBool
-	p.StrBytes = pb.StrBytes
-	p.Int32Str = pb.Int32Str
-	p.Int64Str = pb.Int64Str
-	p.Uint32Str = pb.Uint32Str
-	p.Uint64Str = pb.Uint64Str
-	p.Sint32Str = pb.Sint32Str
-	p.Sint64Str = pb.Sint64Str
-	p.Fixed32Str = pb.Fixed32Str
-	p.Fixed64Str = pb.Fixed64Str
-	p.Sfixed32Str = pb.Sfixed32Str
-	p.Sfixed64Str = pb.Sfixed64Str
-	p.BoolStr = pb.BoolStr
-	p.StrMessage = pb.StrMessage
-	p.Int32Message = pb.Int32Message
-	p.Int64Message = pb.Int64Message
-	p.StrEnum = pb.StrEnum
-	p.Int32Enum = pb.Int32Enum
-	if len(pb.Nested) > 0 {
-		p.Nested = make(map[string]*ConfigPlain, len(pb.Nested))
-		for k, v := range pb.Nested {
-			if v != nil {
-				p.Nested[k] = v.IntoPlain()
-			}
-		}
-	}
+// optionalShowcasePlainMergePaths are the fields of OptionalShowcase covered by OptionalShowcasePlain
+var optionalShowcasePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{7},
+	{8},
+	{9},
+	{10},
+	{11},
+	{12},
+	{13},
+	{14},
+	{15},
+	{20},
+	{21},
+	{30},
+	{31},
+	{32},
 }
 
-// MarshalJX encodes MapShowcasePlain to JSON using jx.Encoder
-func (p *MapShowcasePlain) MarshalJX(e *jx.Encoder) {
-	if p == nil {
-		e.Null()
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *OptionalShowcasePlain) IntoPbMerge(dst *OptionalShowcase) {
+	if p == nil || dst == nil {
 		return
 	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, optionalShowcasePlainMergePaths...)
+}
+
+// optionalShowcasePlainFieldPaths link the JSON names of OptionalShowcasePlain to field-mask paths of OptionalShowcase
+var optionalShowcasePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "optDouble", Path: "opt_double"},
+	{Name: "optFloat", Path: "opt_float"},
+	{Name: "optInt32", Path: "opt_int32"},
+	{Name: "optInt64", Path: "opt_int64"},
+	{Name: "optUint32", Path: "opt_uint32"},
+	{Name: "optUint64", Path: "opt_uint64"},
+	{Name: "optSint32", Path: "opt_sint32"},
+	{Name: "optSint64", Path: "opt_sint64"},
+	{Name: "optFixed32", Path: "opt_fixed32"},
+	{Name: "optFixed64", Path: "opt_fixed64"},
+	{Name: "optSfixed32", Path: "opt_sfixed32"},
+	{Name: "optSfixed64", Path: "opt_sfixed64"},
+	{Name: "optBool", Path: "opt_bool"},
+	{Name: "optString", Path: "opt_string"},
+	{Name: "optBytes", Path: "opt_bytes"},
+	{Name: "optStatus", Path: "opt_status"},
+	{Name: "optPriority", Path: "opt_priority"},
+	{Name: "regularDouble", Path: "regular_double"},
+	{Name: "regularString", Path: "regular_string"},
+	{Name: "regularBool", Path: "regular_bool"},
+}
+
+// OptionalShowcasePlainFieldMask builds a field mask of OptionalShowcase from JSON names of OptionalShowcasePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func OptionalShowcasePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(optionalShowcasePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
 
-	e.ObjStart()
+// OptionalShowcasePlainFieldNames translates a field mask of OptionalShowcase into JSON names of OptionalShowcasePlain.
+// A path to an embedded message yields all fields flattened from it.
+func OptionalShowcasePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(optionalShowcasePlainFieldPaths, mask.GetPaths())
+}
 
-	e.FieldStart("strStr")
-	e.ObjStart()
-	for k, v := range p.StrStr {
-		e.FieldStart(k)
-		e.Str(v)
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of OptionalShowcase, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *OptionalShowcasePlain) ApplyToPb(pb *OptionalShowcase, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
 	}
-	e.ObjEnd()
-	e.FieldStart("strInt32")
-	e.ObjStart()
-	for k, v := range p.StrInt32 {
-		e.FieldStart(k)
-		e.Int32(v)
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), optionalShowcasePlainMergePaths)
+	if err != nil {
+		return err
 	}
-	e.ObjEnd()
-	e.FieldStart("strInt64")
-	e.ObjStart()
-	for k, v := range p.StrInt64 {
-		e.FieldStart(k)
-		e.Int64(v)
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *OptionalShowcase) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*OptionalShowcasePlain, error) {
+	if pb == nil {
+		return nil, nil
 	}
-	e.ObjEnd()
-	e.FieldStart("strUint32")
-	e.ObjStart()
-	for k, v := range p.StrUint32 {
-		e.FieldStart(k)
-		e.UInt32(v)
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), optionalShowcasePlainMergePaths)
+	if err != nil {
+		return nil, err
 	}
-	e.ObjEnd()
-	e.FieldStart("strUint64")
-	e.ObjStart()
-	for k, v := range p.StrUint64 {
-		e.FieldStart(k)
-		e.UInt64(v)
+	masked := &OptionalShowcase{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *OptionalShowcase) IntoPlainReuse(p *OptionalShowcasePlain) {
+	if pb == nil || p == nil {
+		return
 	}
-	e.ObjEnd()
-	e.FieldStart("strFloat")
-	e.ObjStart()
-	for k, v := range p.StrFloat {
-		e.FieldStart(k)
-		e.Float32(v)
+	// Reset before filling
+	p.Reset()
+
+	p.OptDouble = pb.OptDouble
+	p.OptFloat = pb.OptFloat
+	p.OptInt32 = pb.OptInt32
+	p.OptInt64 = pb.OptInt64
+	p.OptUint32 = pb.OptUint32
+	p.OptUint64 = pb.OptUint64
+	p.OptSint32 = pb.OptSint32
+	p.OptSint64 = pb.OptSint64
+	p.OptFixed32 = pb.OptFixed32
+	p.OptFixed64 = pb.OptFixed64
+	p.OptSfixed32 = pb.OptSfixed32
+	p.OptSfixed64 = pb.OptSfixed64
+	p.OptBool = pb.OptBool
+	p.OptString = pb.OptString
+	p.OptBytes = &pb.OptBytes
+	p.OptStatus = pb.OptStatus
+	p.OptPriority = pb.OptPriority
+	p.RegularDouble = pb.RegularDouble
+	p.RegularString = pb.RegularString
+	p.RegularBool = pb.RegularBool
+}
+
+// MarshalJX encodes OptionalShowcasePlain to JSON using jx.Encoder
+func (p *OptionalShowcasePlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
 	}
-	e.ObjEnd()
-	e.FieldStart("strDouble")
+
 	e.ObjStart()
-	for k, v := range p.StrDouble {
-		e.FieldStart(k)
-		e.Float64(v)
+
+	if p.OptDouble != nil {
+		e.FieldStart("optDouble")
+		e.Float64(*p.OptDouble)
 	}
-	e.ObjEnd()
-	e.FieldStart("strBool")
-	e.ObjStart()
-	for k, v := range p.StrBool {
-		e.FieldStart(k)
-		e.Bool(v)
+	if p.OptFloat != nil {
+		e.FieldStart("optFloat")
+		e.Float32(*p.OptFloat)
 	}
-	e.ObjEnd()
-	e.FieldStart("strBytes")
-	e.ObjStart()
-	for k, v := range p.StrBytes {
-		e.FieldStart(k)
-		e.Base64(v)
+	if p.OptInt32 != nil {
+		e.FieldStart("optInt32")
+		e.Int32(*p.OptInt32)
 	}
-	e.ObjEnd()
-	e.FieldStart("int32Str")
-	e.ObjStart()
-	for k, v := range p.Int32Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptInt64 != nil {
+		e.FieldStart("optInt64")
+		e.Int64(*p.OptInt64)
 	}
-	e.ObjEnd()
-	e.FieldStart("int64Str")
-	e.ObjStart()
-	for k, v := range p.Int64Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptUint32 != nil {
+		e.FieldStart("optUint32")
+		e.UInt32(*p.OptUint32)
 	}
-	e.ObjEnd()
-	e.FieldStart("uint32Str")
-	e.ObjStart()
-	for k, v := range p.Uint32Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptUint64 != nil {
+		e.FieldStart("optUint64")
+		e.UInt64(*p.OptUint64)
 	}
-	e.ObjEnd()
-	e.FieldStart("uint64Str")
-	e.ObjStart()
-	for k, v := range p.Uint64Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptSint32 != nil {
+		e.FieldStart("optSint32")
+		e.Int32(*p.OptSint32)
 	}
-	e.ObjEnd()
-	e.FieldStart("sint32Str")
-	e.ObjStart()
-	for k, v := range p.Sint32Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptSint64 != nil {
+		e.FieldStart("optSint64")
+		e.Int64(*p.OptSint64)
 	}
-	e.ObjEnd()
-	e.FieldStart("sint64Str")
-	e.ObjStart()
-	for k, v := range p.Sint64Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptFixed32 != nil {
+		e.FieldStart("optFixed32")
+		e.UInt32(*p.OptFixed32)
 	}
-	e.ObjEnd()
-	e.FieldStart("fixed32Str")
-	e.ObjStart()
-	for k, v := range p.Fixed32Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptFixed64 != nil {
+		e.FieldStart("optFixed64")
+		e.UInt64(*p.OptFixed64)
 	}
-	e.ObjEnd()
-	e.FieldStart("fixed64Str")
-	e.ObjStart()
-	for k, v := range p.Fixed64Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptSfixed32 != nil {
+		e.FieldStart("optSfixed32")
+		e.Int32(*p.OptSfixed32)
 	}
-	e.ObjEnd()
-	e.FieldStart("sfixed32Str")
-	e.ObjStart()
-	for k, v := range p.Sfixed32Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptSfixed64 != nil {
+		e.FieldStart("optSfixed64")
+		e.Int64(*p.OptSfixed64)
 	}
-	e.ObjEnd()
-	e.FieldStart("sfixed64Str")
-	e.ObjStart()
-	for k, v := range p.Sfixed64Str {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptBool != nil {
+		e.FieldStart("optBool")
+		e.Bool(*p.OptBool)
 	}
-	e.ObjEnd()
-	e.FieldStart("boolStr")
-	e.ObjStart()
-	for k, v := range p.BoolStr {
-		e.FieldStart(fmt.Sprint(k))
-		e.Str(v)
+	if p.OptString != nil {
+		e.FieldStart("optString")
+		e.Str(*p.OptString)
 	}
-	e.ObjEnd()
-	e.FieldStart("strMessage")
-	e.ObjStart()
-	for k, v := range p.StrMessage {
-		e.FieldStart(k)
-		v.MarshalJX(e)
+	if p.OptBytes != nil {
+		e.FieldStart("optBytes")
+		e.Base64(*p.OptBytes)
 	}
-	e.ObjEnd()
-	e.FieldStart("int32Message")
-	e.ObjStart()
-	for k, v := range p.Int32Message {
-		e.FieldStart(fmt.Sprint(k))
-		v.MarshalJX(e)
+	if p.OptStatus != nil {
+		e.FieldStart("optStatus")
+		e.Int32(int32(*p.OptStatus))
 	}
-	e.ObjEnd()
-	e.FieldStart("int64Message")
-	e.ObjStart()
-	for k, v := range p.Int64Message {
-		e.FieldStart(fmt.Sprint(k))
-		v.MarshalJX(e)
+	if p.OptPriority != nil {
+		e.FieldStart("optPriority")
+		e.Int32(int32(*p.OptPriority))
 	}
-	e.ObjEnd()
-	e.FieldStart("strEnum")
-	e.ObjStart()
-	for k, v := range p.StrEnum {
-		e.FieldStart(k)
-		e.Int32(int32(v))
+	if p.RegularDouble != 0 {
+		e.FieldStart("regularDouble")
+		e.Float64(p.RegularDouble)
 	}
-	e.ObjEnd()
-	e.FieldStart("int32Enum")
-	e.ObjStart()
-	for k, v := range p.Int32Enum {
-		e.FieldStart(fmt.Sprint(k))
-		e.Int32(int32(v))
+	if p.RegularString != "" {
+		e.FieldStart("regularString")
+		e.Str(p.RegularString)
 	}
-	e.ObjEnd()
-	e.FieldStart("nested")
-	e.ObjStart()
-	for k, v := range p.Nested {
-		e.FieldStart(k)
-		v.MarshalJX(e)
+	if p.RegularBool {
+		e.FieldStart("regularBool")
+		e.Bool(p.RegularBool)
 	}
 	e.ObjEnd()
-	e.ObjEnd()
 }
 
 // MarshalJSON implements json.Marshaler using jx
-func (p *MapShowcasePlain) MarshalJSON() ([]byte, error) {
+func (p *OptionalShowcasePlain) MarshalJSON() ([]byte, error) {
 	e := jx.GetEncoder()
 	defer jx.PutEncoder(e)
 	p.MarshalJX(e)
 	return e.Bytes(), nil
 }
 
-// UnmarshalJX decodes MapShowcasePlain from JSON using jx.Decoder
-func (p *MapShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
+// UnmarshalJX decodes OptionalShowcasePlain from JSON using jx.Decoder
+func (p *OptionalShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
 	if p == nil {
 		return nil
 	}
 
 	return d.Obj(func(d *jx.Decoder, key string) error {
 		switch key {
-		case "strStr":
-			if p.StrStr == nil {
-				p.StrStr = make(map[string]string)
+		case "optDouble":
+			if d.Next() == jx.Null {
+				p.OptDouble = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.StrStr[key] = v
-				return nil
-			})
-		case "strInt32":
-			if p.StrInt32 == nil {
-				p.StrInt32 = make(map[string]int32)
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.OptDouble = &v
+		case "optFloat":
+			if d.Next() == jx.Null {
+				p.OptFloat = nil
+				return d.Null()
+			}
+			v, err := d.Float32()
+			if err != nil {
+				return err
+			}
+			p.OptFloat = &v
+		case "optInt32":
+			if d.Next() == jx.Null {
+				p.OptInt32 = nil
+				return d.Null()
+			}
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.OptInt32 = &v
+		case "optInt64":
+			if d.Next() == jx.Null {
+				p.OptInt64 = nil
+				return d.Null()
+			}
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.OptInt64 = &v
+		case "optUint32":
+			if d.Next() == jx.Null {
+				p.OptUint32 = nil
+				return d.Null()
+			}
+			v, err := d.UInt32()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Int32()
-				if err != nil {
-					return err
-				}
-				p.StrInt32[key] = v
-				return nil
-			})
-		case "strInt64":
-			if p.StrInt64 == nil {
-				p.StrInt64 = make(map[string]int64)
+			p.OptUint32 = &v
+		case "optUint64":
+			if d.Next() == jx.Null {
+				p.OptUint64 = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Int64()
-				if err != nil {
-					return err
-				}
-				p.StrInt64[key] = v
-				return nil
-			})
-		case "strUint32":
-			if p.StrUint32 == nil {
-				p.StrUint32 = make(map[string]uint32)
+			v, err := d.UInt64()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.UInt32()
-				if err != nil {
-					return err
-				}
-				p.StrUint32[key] = v
-				return nil
-			})
-		case "strUint64":
-			if p.StrUint64 == nil {
-				p.StrUint64 = make(map[string]uint64)
+			p.OptUint64 = &v
+		case "optSint32":
+			if d.Next() == jx.Null {
+				p.OptSint32 = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.UInt64()
-				if err != nil {
-					return err
-				}
-				p.StrUint64[key] = v
-				return nil
-			})
-		case "strFloat":
-			if p.StrFloat == nil {
-				p.StrFloat = make(map[string]float32)
+			v, err := d.Int32()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Float32()
-				if err != nil {
-					return err
-				}
-				p.StrFloat[key] = v
-				return nil
-			})
-		case "strDouble":
-			if p.StrDouble == nil {
-				p.StrDouble = make(map[string]float64)
+			p.OptSint32 = &v
+		case "optSint64":
+			if d.Next() == jx.Null {
+				p.OptSint64 = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Float64()
-				if err != nil {
-					return err
-				}
-				p.StrDouble[key] = v
-				return nil
-			})
-		case "strBool":
-			if p.StrBool == nil {
-				p.StrBool = make(map[string]bool)
+			v, err := d.Int64()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Bool()
-				if err != nil {
-					return err
-				}
-				p.StrBool[key] = v
-				return nil
-			})
-		case "strBytes":
-			if p.StrBytes == nil {
-				p.StrBytes = make(map[string][]byte)
+			p.OptSint64 = &v
+		case "optFixed32":
+			if d.Next() == jx.Null {
+				p.OptFixed32 = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Base64()
-				if err != nil {
-					return err
-				}
-				p.StrBytes[key] = v
-				return nil
-			})
-		case "int32Str":
-			if p.Int32Str == nil {
-				p.Int32Str = make(map[int32]string)
+			v, err := d.UInt32()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_k, err := strconv.ParseInt(key, 10, 32)
-				if err != nil {
-					return err
-				}
-				_mapKey := int32(_k)
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Int32Str[_mapKey] = v
-				return nil
-			})
-		case "int64Str":
-			if p.Int64Str == nil {
-				p.Int64Str = make(map[int64]string)
+			p.OptFixed32 = &v
+		case "optFixed64":
+			if d.Next() == jx.Null {
+				p.OptFixed64 = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_mapKey, err := strconv.ParseInt(key, 10, 64)
-				if err != nil {
-					return err
-				}
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Int64Str[_mapKey] = v
-				return nil
-			})
-		case "uint32Str":
-			if p.Uint32Str == nil {
-				p.Uint32Str = make(map[uint32]string)
+			v, err := d.UInt64()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_k, err := strconv.ParseUint(key, 10, 32)
-				if err != nil {
-					return err
-				}
-				_mapKey := uint32(_k)
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Uint32Str[_mapKey] = v
-				return nil
-			})
-		case "uint64Str":
-			if p.Uint64Str == nil {
-				p.Uint64Str = make(map[uint64]string)
+			p.OptFixed64 = &v
+		case "optSfixed32":
+			if d.Next() == jx.Null {
+				p.OptSfixed32 = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_mapKey, err := strconv.ParseUint(key, 10, 64)
-				if err != nil {
-					return err
-				}
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Uint64Str[_mapKey] = v
-				return nil
-			})
-		case "sint32Str":
-			if p.Sint32Str == nil {
-				p.Sint32Str = make(map[int32]string)
+			v, err := d.Int32()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_k, err := strconv.ParseInt(key, 10, 32)
-				if err != nil {
-					return err
-				}
-				_mapKey := int32(_k)
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Sint32Str[_mapKey] = v
-				return nil
-			})
-		case "sint64Str":
-			if p.Sint64Str == nil {
-				p.Sint64Str = make(map[int64]string)
+			p.OptSfixed32 = &v
+		case "optSfixed64":
+			if d.Next() == jx.Null {
+				p.OptSfixed64 = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_mapKey, err := strconv.ParseInt(key, 10, 64)
-				if err != nil {
-					return err
-				}
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Sint64Str[_mapKey] = v
-				return nil
-			})
-		case "fixed32Str":
-			if p.Fixed32Str == nil {
-				p.Fixed32Str = make(map[uint32]string)
+			v, err := d.Int64()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_k, err := strconv.ParseUint(key, 10, 32)
-				if err != nil {
-					return err
-				}
-				_mapKey := uint32(_k)
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Fixed32Str[_mapKey] = v
-				return nil
-			})
-		case "fixed64Str":
-			if p.Fixed64Str == nil {
-				p.Fixed64Str = make(map[uint64]string)
+			p.OptSfixed64 = &v
+		case "optBool":
+			if d.Next() == jx.Null {
+				p.OptBool = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_mapKey, err := strconv.ParseUint(key, 10, 64)
-				if err != nil {
-					return err
-				}
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Fixed64Str[_mapKey] = v
-				return nil
-			})
-		case "sfixed32Str":
-			if p.Sfixed32Str == nil {
-				p.Sfixed32Str = make(map[int32]string)
+			v, err := d.Bool()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_k, err := strconv.ParseInt(key, 10, 32)
-				if err != nil {
-					return err
-				}
-				_mapKey := int32(_k)
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Sfixed32Str[_mapKey] = v
-				return nil
-			})
-		case "sfixed64Str":
-			if p.Sfixed64Str == nil {
-				p.Sfixed64Str = make(map[int64]string)
+			p.OptBool = &v
+		case "optString":
+			if d.Next() == jx.Null {
+				p.OptString = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_mapKey, err := strconv.ParseInt(key, 10, 64)
-				if err != nil {
-					return err
-				}
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.Sfixed64Str[_mapKey] = v
-				return nil
-			})
-		case "boolStr":
-			if p.BoolStr == nil {
-				p.BoolStr = make(map[bool]string)
+			v, err := d.Str()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_mapKey, err := strconv.ParseBool(key)
-				if err != nil {
-					return err
-				}
-				v, err := d.Str()
-				if err != nil {
-					return err
-				}
-				p.BoolStr[_mapKey] = v
-				return nil
-			})
-		case "strMessage":
-			if p.StrMessage == nil {
-				p.StrMessage = make(map[string]*Address)
+			p.OptString = &v
+		case "optBytes":
+			if d.Next() == jx.Null {
+				p.OptBytes = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				p.StrMessage[key] = &Address{}
-				if err := p.StrMessage[key].UnmarshalJX(d); err != nil {
-					return err
-				}
-				return nil
-			})
-		case "int32Message":
-			if p.Int32Message == nil {
-				p.Int32Message = make(map[int32]*Address)
+			v, err := d.Base64()
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_k, err := strconv.ParseInt(key, 10, 32)
-				if err != nil {
-					return err
-				}
-				_mapKey := int32(_k)
-				p.Int32Message[_mapKey] = &Address{}
-				if err := p.Int32Message[_mapKey].UnmarshalJX(d); err != nil {
-					return err
-				}
-				return nil
-			})
-		case "int64Message":
-			if p.Int64Message == nil {
-				p.Int64Message = make(map[int64]*Metadata)
+			p.OptBytes = &v
+		case "optStatus":
+			if d.Next() == jx.Null {
+				p.OptStatus = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_mapKey, err := strconv.ParseInt(key, 10, 64)
-				if err != nil {
-					return err
-				}
-				p.Int64Message[_mapKey] = &Metadata{}
-				if err := p.Int64Message[_mapKey].UnmarshalJX(d); err != nil {
-					return err
-				}
-				return nil
-			})
-		case "strEnum":
-			if p.StrEnum == nil {
-				p.StrEnum = make(map[string]Status)
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				v, err := d.Int32()
-				if err != nil {
-					return err
-				}
-				p.StrEnum[key] = Status(v)
-				return nil
-			})
-		case "int32Enum":
-			if p.Int32Enum == nil {
-				p.Int32Enum = make(map[int32]Priority)
+			_ev := Status(v)
+			p.OptStatus = &_ev
+		case "optPriority":
+			if d.Next() == jx.Null {
+				p.OptPriority = nil
+				return d.Null()
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				_k, err := strconv.ParseInt(key, 10, 32)
-				if err != nil {
-					return err
-				}
-				_mapKey := int32(_k)
-				v, err := d.Int32()
-				if err != nil {
-					return err
-				}
-				p.Int32Enum[_mapKey] = Priority(v)
-				return nil
-			})
-		case "nested":
-			if p.Nested == nil {
-				p.Nested = make(map[string]*ConfigPlain)
+			v, err := enumjx.Decode(d, "full.Priority", Priority_value)
+			if err != nil {
+				return err
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
-				p.Nested[key] = &ConfigPlain{}
-				if err := p.Nested[key].UnmarshalJX(d); err != nil {
-					return err
-				}
-				return nil
-			})
+			_ev := Priority(v)
+			p.OptPriority = &_ev
+		case "regularDouble":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.RegularDouble = v
+		case "regularString":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.RegularString = v
+		case "regularBool":
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.RegularBool = v
 		default:
 			return d.Skip()
 		}
@@ -4661,300 +13461,1279 @@ func (p *MapShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
 	})
 }
 
-// UnmarshalJSON implements json.Unmarshaler using jx
-func (p *MapShowcasePlain) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return p.UnmarshalJX(d)
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *OptionalShowcasePlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes OptionalShowcasePlain in the protobuf wire format of OptionalShowcase
+func (p *OptionalShowcasePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends OptionalShowcasePlain encoded in the protobuf wire format of OptionalShowcase to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *OptionalShowcasePlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if p.OptDouble != nil {
+		b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(*p.OptDouble)))
+	}
+	if p.OptFloat != nil {
+		b = protowire.AppendTag(b, 2, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, math.Float32bits(float32(*p.OptFloat)))
+	}
+	if p.OptInt32 != nil {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(*p.OptInt32))
+	}
+	if p.OptInt64 != nil {
+		b = protowire.AppendTag(b, 4, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(*p.OptInt64))
+	}
+	if p.OptUint32 != nil {
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(*p.OptUint32))
+	}
+	if p.OptUint64 != nil {
+		b = protowire.AppendTag(b, 6, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(*p.OptUint64))
+	}
+	if p.OptSint32 != nil {
+		b = protowire.AppendTag(b, 7, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(*p.OptSint32)))
+	}
+	if p.OptSint64 != nil {
+		b = protowire.AppendTag(b, 8, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(*p.OptSint64)))
+	}
+	if p.OptFixed32 != nil {
+		b = protowire.AppendTag(b, 9, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, uint32(*p.OptFixed32))
+	}
+	if p.OptFixed64 != nil {
+		b = protowire.AppendTag(b, 10, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, uint64(*p.OptFixed64))
+	}
+	if p.OptSfixed32 != nil {
+		b = protowire.AppendTag(b, 11, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, uint32(*p.OptSfixed32))
+	}
+	if p.OptSfixed64 != nil {
+		b = protowire.AppendTag(b, 12, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, uint64(*p.OptSfixed64))
+	}
+	if p.OptBool != nil {
+		b = protowire.AppendTag(b, 13, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(*p.OptBool))
+	}
+	if p.OptString != nil {
+		b = protowire.AppendTag(b, 14, protowire.BytesType)
+		b = protowire.AppendString(b, *p.OptString)
+	}
+	if p.OptBytes != nil {
+		b = protowire.AppendTag(b, 15, protowire.BytesType)
+		b = protowire.AppendBytes(b, *p.OptBytes)
+	}
+	if p.OptStatus != nil {
+		b = protowire.AppendTag(b, 20, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(*p.OptStatus))
+	}
+	if p.OptPriority != nil {
+		b = protowire.AppendTag(b, 21, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(*p.OptPriority))
+	}
+	if v := p.RegularDouble; v != 0 || math.Signbit(float64(v)) {
+		b = protowire.AppendTag(b, 30, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+	}
+	if v := p.RegularString; v != "" {
+		b = protowire.AppendTag(b, 31, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.RegularBool; v {
+		b = protowire.AppendTag(b, 32, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes OptionalShowcasePlain from the protobuf wire format of OptionalShowcase.
+// Unknown fields are skipped.
+func (p *OptionalShowcasePlain) UnmarshalProto(b []byte) error {
+	*p = OptionalShowcasePlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			_tmp := math.Float64frombits(v)
+			p.OptDouble = &_tmp
+		case num == 2 && typ == protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			_tmp := math.Float32frombits(v)
+			p.OptFloat = &_tmp
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := int32(v)
+			p.OptInt32 = &_tmp
+		case num == 4 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := int64(v)
+			p.OptInt64 = &_tmp
+		case num == 5 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := uint32(v)
+			p.OptUint32 = &_tmp
+		case num == 6 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := v
+			p.OptUint64 = &_tmp
+		case num == 7 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := int32(protowire.DecodeZigZag(v & math.MaxUint32))
+			p.OptSint32 = &_tmp
+		case num == 8 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := protowire.DecodeZigZag(v)
+			p.OptSint64 = &_tmp
+		case num == 9 && typ == protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			_tmp := v
+			p.OptFixed32 = &_tmp
+		case num == 10 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			_tmp := v
+			p.OptFixed64 = &_tmp
+		case num == 11 && typ == protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			_tmp := int32(v)
+			p.OptSfixed32 = &_tmp
+		case num == 12 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			_tmp := int64(v)
+			p.OptSfixed64 = &_tmp
+		case num == 13 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := protowire.DecodeBool(v)
+			p.OptBool = &_tmp
+		case num == 14 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			_tmp := v
+			p.OptString = &_tmp
+		case num == 15 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			_tmp := append([]byte{}, v...)
+			p.OptBytes = &_tmp
+		case num == 20 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := Status(v)
+			p.OptStatus = &_tmp
+		case num == 21 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			_tmp := Priority(v)
+			p.OptPriority = &_tmp
+		case num == 30 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.RegularDouble = math.Float64frombits(v)
+		case num == 31 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.RegularString = v
+		case num == 32 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.RegularBool = protowire.DecodeBool(v)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// optionalShowcasePlainReflect describes OptionalShowcasePlain as message full.plain.OptionalShowcasePlain
+var optionalShowcasePlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "OptionalShowcasePlain",
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **float64 { return &p.OptDouble }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **float32 { return &p.OptFloat }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **int32 { return &p.OptInt32 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **int64 { return &p.OptInt64 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **uint32 { return &p.OptUint32 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **uint64 { return &p.OptUint64 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **int32 { return &p.OptSint32 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **int64 { return &p.OptSint64 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **uint32 { return &p.OptFixed32 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **uint64 { return &p.OptFixed64 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **int32 { return &p.OptSfixed32 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **int64 { return &p.OptSfixed64 }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **bool { return &p.OptBool }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **string { return &p.OptString }),
+	plainreflect.Optional(func(p *OptionalShowcasePlain) **[]byte { return &p.OptBytes }),
+	plainreflect.OptionalEnum(func(p *OptionalShowcasePlain) **Status { return &p.OptStatus }),
+	plainreflect.OptionalEnum(func(p *OptionalShowcasePlain) **Priority { return &p.OptPriority }),
+	plainreflect.Scalar(func(p *OptionalShowcasePlain) *float64 { return &p.RegularDouble }),
+	plainreflect.Scalar(func(p *OptionalShowcasePlain) *string { return &p.RegularString }),
+	plainreflect.Scalar(func(p *OptionalShowcasePlain) *bool { return &p.RegularBool }),
+)
+
+// ProtoReflect returns the reflective view of OptionalShowcasePlain backed by the struct
+func (p *OptionalShowcasePlain) ProtoReflect() protoreflect.Message {
+	return optionalShowcasePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of OptionalShowcasePlain
+func (p *OptionalShowcasePlain) Clone() *OptionalShowcasePlain {
+	if p == nil {
+		return nil
+	}
+	dst := &OptionalShowcasePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of OptionalShowcasePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetOptionalShowcasePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *OptionalShowcasePlain) CopyTo(dst *OptionalShowcasePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	if p.OptDouble == nil {
+		dst.OptDouble = nil
+	} else {
+		if dst.OptDouble == nil {
+			dst.OptDouble = new(float64)
+		}
+		*dst.OptDouble = *p.OptDouble
+	}
+	if p.OptFloat == nil {
+		dst.OptFloat = nil
+	} else {
+		if dst.OptFloat == nil {
+			dst.OptFloat = new(float32)
+		}
+		*dst.OptFloat = *p.OptFloat
+	}
+	if p.OptInt32 == nil {
+		dst.OptInt32 = nil
+	} else {
+		if dst.OptInt32 == nil {
+			dst.OptInt32 = new(int32)
+		}
+		*dst.OptInt32 = *p.OptInt32
+	}
+	if p.OptInt64 == nil {
+		dst.OptInt64 = nil
+	} else {
+		if dst.OptInt64 == nil {
+			dst.OptInt64 = new(int64)
+		}
+		*dst.OptInt64 = *p.OptInt64
+	}
+	if p.OptUint32 == nil {
+		dst.OptUint32 = nil
+	} else {
+		if dst.OptUint32 == nil {
+			dst.OptUint32 = new(uint32)
+		}
+		*dst.OptUint32 = *p.OptUint32
+	}
+	if p.OptUint64 == nil {
+		dst.OptUint64 = nil
+	} else {
+		if dst.OptUint64 == nil {
+			dst.OptUint64 = new(uint64)
+		}
+		*dst.OptUint64 = *p.OptUint64
+	}
+	if p.OptSint32 == nil {
+		dst.OptSint32 = nil
+	} else {
+		if dst.OptSint32 == nil {
+			dst.OptSint32 = new(int32)
+		}
+		*dst.OptSint32 = *p.OptSint32
+	}
+	if p.OptSint64 == nil {
+		dst.OptSint64 = nil
+	} else {
+		if dst.OptSint64 == nil {
+			dst.OptSint64 = new(int64)
+		}
+		*dst.OptSint64 = *p.OptSint64
+	}
+	if p.OptFixed32 == nil {
+		dst.OptFixed32 = nil
+	} else {
+		if dst.OptFixed32 == nil {
+			dst.OptFixed32 = new(uint32)
+		}
+		*dst.OptFixed32 = *p.OptFixed32
+	}
+	if p.OptFixed64 == nil {
+		dst.OptFixed64 = nil
+	} else {
+		if dst.OptFixed64 == nil {
+			dst.OptFixed64 = new(uint64)
+		}
+		*dst.OptFixed64 = *p.OptFixed64
+	}
+	if p.OptSfixed32 == nil {
+		dst.OptSfixed32 = nil
+	} else {
+		if dst.OptSfixed32 == nil {
+			dst.OptSfixed32 = new(int32)
+		}
+		*dst.OptSfixed32 = *p.OptSfixed32
+	}
+	if p.OptSfixed64 == nil {
+		dst.OptSfixed64 = nil
+	} else {
+		if dst.OptSfixed64 == nil {
+			dst.OptSfixed64 = new(int64)
+		}
+		*dst.OptSfixed64 = *p.OptSfixed64
+	}
+	if p.OptBool == nil {
+		dst.OptBool = nil
+	} else {
+		if dst.OptBool == nil {
+			dst.OptBool = new(bool)
+		}
+		*dst.OptBool = *p.OptBool
+	}
+	if p.OptString == nil {
+		dst.OptString = nil
+	} else {
+		if dst.OptString == nil {
+			dst.OptString = new(string)
+		}
+		*dst.OptString = *p.OptString
+	}
+	if p.OptBytes == nil {
+		dst.OptBytes = nil
+	} else {
+		if dst.OptBytes == nil {
+			dst.OptBytes = new([]byte)
+		}
+		*dst.OptBytes = append((*dst.OptBytes)[:0], *p.OptBytes...)
+	}
+	if p.OptStatus == nil {
+		dst.OptStatus = nil
+	} else {
+		if dst.OptStatus == nil {
+			dst.OptStatus = new(Status)
+		}
+		*dst.OptStatus = *p.OptStatus
+	}
+	if p.OptPriority == nil {
+		dst.OptPriority = nil
+	} else {
+		if dst.OptPriority == nil {
+			dst.OptPriority = new(Priority)
+		}
+		*dst.OptPriority = *p.OptPriority
+	}
+	dst.RegularDouble = p.RegularDouble
+	dst.RegularString = p.RegularString
+	dst.RegularBool = p.RegularBool
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *OptionalShowcasePlain) Equal(other *OptionalShowcasePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.OptDouble == nil) != (other.OptDouble == nil) || p.OptDouble != nil && *p.OptDouble != *other.OptDouble {
+		return false
+	}
+	if (p.OptFloat == nil) != (other.OptFloat == nil) || p.OptFloat != nil && *p.OptFloat != *other.OptFloat {
+		return false
+	}
+	if (p.OptInt32 == nil) != (other.OptInt32 == nil) || p.OptInt32 != nil && *p.OptInt32 != *other.OptInt32 {
+		return false
+	}
+	if (p.OptInt64 == nil) != (other.OptInt64 == nil) || p.OptInt64 != nil && *p.OptInt64 != *other.OptInt64 {
+		return false
+	}
+	if (p.OptUint32 == nil) != (other.OptUint32 == nil) || p.OptUint32 != nil && *p.OptUint32 != *other.OptUint32 {
+		return false
+	}
+	if (p.OptUint64 == nil) != (other.OptUint64 == nil) || p.OptUint64 != nil && *p.OptUint64 != *other.OptUint64 {
+		return false
+	}
+	if (p.OptSint32 == nil) != (other.OptSint32 == nil) || p.OptSint32 != nil && *p.OptSint32 != *other.OptSint32 {
+		return false
+	}
+	if (p.OptSint64 == nil) != (other.OptSint64 == nil) || p.OptSint64 != nil && *p.OptSint64 != *other.OptSint64 {
+		return false
+	}
+	if (p.OptFixed32 == nil) != (other.OptFixed32 == nil) || p.OptFixed32 != nil && *p.OptFixed32 != *other.OptFixed32 {
+		return false
+	}
+	if (p.OptFixed64 == nil) != (other.OptFixed64 == nil) || p.OptFixed64 != nil && *p.OptFixed64 != *other.OptFixed64 {
+		return false
+	}
+	if (p.OptSfixed32 == nil) != (other.OptSfixed32 == nil) || p.OptSfixed32 != nil && *p.OptSfixed32 != *other.OptSfixed32 {
+		return false
+	}
+	if (p.OptSfixed64 == nil) != (other.OptSfixed64 == nil) || p.OptSfixed64 != nil && *p.OptSfixed64 != *other.OptSfixed64 {
+		return false
+	}
+	if (p.OptBool == nil) != (other.OptBool == nil) || p.OptBool != nil && *p.OptBool != *other.OptBool {
+		return false
+	}
+	if (p.OptString == nil) != (other.OptString == nil) || p.OptString != nil && *p.OptString != *other.OptString {
+		return false
+	}
+	if (p.OptBytes == nil) != (other.OptBytes == nil) || p.OptBytes != nil && !bytes.Equal(*p.OptBytes, *other.OptBytes) {
+		return false
+	}
+	if (p.OptStatus == nil) != (other.OptStatus == nil) || p.OptStatus != nil && *p.OptStatus != *other.OptStatus {
+		return false
+	}
+	if (p.OptPriority == nil) != (other.OptPriority == nil) || p.OptPriority != nil && *p.OptPriority != *other.OptPriority {
+		return false
+	}
+	if p.RegularDouble != other.RegularDouble {
+		return false
+	}
+	if p.RegularString != other.RegularString {
+		return false
+	}
+	if p.RegularBool != other.RegularBool {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of OptionalShowcasePlain rows, in the order of ScanRow and Values
+func (p *OptionalShowcasePlain) Columns() []string {
+	return []string{
+		"opt_double",
+		"opt_float",
+		"opt_int32",
+		"opt_int64",
+		"opt_uint32",
+		"opt_uint64",
+		"opt_sint32",
+		"opt_sint64",
+		"opt_fixed32",
+		"opt_fixed64",
+		"opt_sfixed32",
+		"opt_sfixed64",
+		"opt_bool",
+		"opt_string",
+		"opt_bytes",
+		"opt_status",
+		"opt_priority",
+		"regular_double",
+		"regular_string",
+		"regular_bool",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into OptionalShowcasePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *OptionalShowcasePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.OptDouble,
+		&p.OptFloat,
+		&p.OptInt32,
+		&p.OptInt64,
+		&p.OptUint32,
+		&p.OptUint64,
+		&p.OptSint32,
+		&p.OptSint64,
+		&p.OptFixed32,
+		&p.OptFixed64,
+		&p.OptSfixed32,
+		&p.OptSfixed64,
+		&p.OptBool,
+		&p.OptString,
+		&p.OptBytes,
+		&p.OptStatus,
+		&p.OptPriority,
+		&p.RegularDouble,
+		&p.RegularString,
+		&p.RegularBool,
+	)
+}
+
+// Values returns the column values of OptionalShowcasePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *OptionalShowcasePlain) Values() []any {
+	return []any{
+		p.OptDouble,
+		p.OptFloat,
+		p.OptInt32,
+		p.OptInt64,
+		p.OptUint32,
+		plainsql.Uint64(p.OptUint64),
+		p.OptSint32,
+		p.OptSint64,
+		p.OptFixed32,
+		plainsql.Uint64(p.OptFixed64),
+		p.OptSfixed32,
+		p.OptSfixed64,
+		p.OptBool,
+		p.OptString,
+		p.OptBytes,
+		p.OptStatus,
+		p.OptPriority,
+		p.RegularDouble,
+		p.RegularString,
+		p.RegularBool,
+	}
+}
+
+// optionalShowcasePlainPool is a sync.Pool for OptionalShowcasePlain objects
+var optionalShowcasePlainPool = sync.Pool{
+	New: func() interface{} {
+		return &OptionalShowcasePlain{}
+	},
+}
+
+// GetOptionalShowcasePlain returns a OptionalShowcasePlain from the pool
+func GetOptionalShowcasePlain() *OptionalShowcasePlain {
+	return optionalShowcasePlainPool.Get().(*OptionalShowcasePlain)
+}
+
+// PutOptionalShowcasePlain returns a OptionalShowcasePlain to the pool after resetting it
+func PutOptionalShowcasePlain(p *OptionalShowcasePlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	optionalShowcasePlainPool.Put(p)
+}
+
+// Reset clears all fields in OptionalShowcasePlain for reuse
+func (p *OptionalShowcasePlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.OptDouble = nil
+	p.OptFloat = nil
+	p.OptInt32 = nil
+	p.OptInt64 = nil
+	p.OptUint32 = nil
+	p.OptUint64 = nil
+	p.OptSint32 = nil
+	p.OptSint64 = nil
+	p.OptFixed32 = nil
+	p.OptFixed64 = nil
+	p.OptSfixed32 = nil
+	p.OptSfixed64 = nil
+	p.OptBool = nil
+	p.OptString = nil
+	p.OptBytes = nil
+	p.OptStatus = nil
+	p.OptPriority = nil
+	p.RegularDouble = 0
+	p.RegularString = ""
+	p.RegularBool = false
+}
+
+type OneofShowcasePlain struct {
+	Id                      string        `json:"id"`
+	ScalarChoiceStrVal      string        `json:"scalarChoiceStrVal"`      // origin: oneof_embed, empath: scalar_choice.str_val
+	ScalarChoiceIntVal      int32         `json:"scalarChoiceIntVal"`      // origin: oneof_embed, empath: scalar_choice.int_val
+	ScalarChoiceLongVal     int64         `json:"scalarChoiceLongVal"`     // origin: oneof_embed, empath: scalar_choice.long_val
+	ScalarChoiceDoubleVal   float64       `json:"scalarChoiceDoubleVal"`   // origin: oneof_embed, empath: scalar_choice.double_val
+	ScalarChoiceBoolVal     bool          `json:"scalarChoiceBoolVal"`     // origin: oneof_embed, empath: scalar_choice.bool_val
+	ScalarChoiceBytesVal    []byte        `json:"scalarChoiceBytesVal"`    // origin: oneof_embed, empath: scalar_choice.bytes_val
+	MessageChoiceAddress    *Address      `json:"messageChoiceAddress"`    // origin: oneof_embed, empath: message_choice.address
+	MessageChoiceContact    *ContactInfo  `json:"messageChoiceContact"`    // origin: oneof_embed, empath: message_choice.contact
+	MessageChoiceMetadata   *Metadata     `json:"messageChoiceMetadata"`   // origin: oneof_embed, empath: message_choice.metadata
+	EnumChoiceStatus        Status        `json:"enumChoiceStatus"`        // origin: oneof_embed, empath: enum_choice.status
+	EnumChoicePriority      Priority      `json:"enumChoicePriority"`      // origin: oneof_embed, empath: enum_choice.priority
+	EnumChoiceError         ErrorCode     `json:"enumChoiceError"`         // origin: oneof_embed, empath: enum_choice.error
+	ContentText             *TextContent  `json:"contentText"`             // origin: oneof_embed, empath: content.text
+	ContentImage            *ImageContent `json:"contentImage"`            // origin: oneof_embed, empath: content.image
+	ContentCode             *CodeContent  `json:"contentCode"`             // origin: oneof_embed, empath: content.code
+	SourceTypeUrl           string        `json:"sourceTypeUrl"`           // origin: oneof_embed, empath: source_type.url
+	SourceTypeFilePath      string        `json:"sourceTypeFilePath"`      // origin: oneof_embed, empath: source_type.file_path
+	DestinationTypeDestUrl  string        `json:"destinationTypeDestUrl"`  // origin: oneof_embed, empath: destination_type.dest_url
+	DestinationTypeDestPath string        `json:"destinationTypeDestPath"` // origin: oneof_embed, empath: destination_type.dest_path
+	// ScalarChoiceCase indicates which variant of scalar_choice oneof is set
+	ScalarChoiceCase OneofShowcaseScalarChoiceCase `json:"scalar_choice_case,omitempty"`
+	// MessageChoiceCase indicates which variant of message_choice oneof is set
+	MessageChoiceCase OneofShowcaseMessageChoiceCase `json:"message_choice_case,omitempty"`
+	// EnumChoiceCase indicates which variant of enum_choice oneof is set
+	EnumChoiceCase OneofShowcaseEnumChoiceCase `json:"enum_choice_case,omitempty"`
+	// ContentCase indicates which variant of content oneof is set
+	ContentCase OneofShowcaseContentCase `json:"content_case,omitempty"`
+	// SourceTypeCase indicates which variant of source_type oneof is set
+	SourceTypeCase OneofShowcaseSourceTypeCase `json:"source_type_case,omitempty"`
+	// DestinationTypeCase indicates which variant of destination_type oneof is set
+	DestinationTypeCase OneofShowcaseDestinationTypeCase `json:"destination_type_case,omitempty"`
+}
+
+// OneofShowcaseScalarChoiceCase identifies the set variant of full.OneofShowcase.scalar_choice oneof, empty when none is set
+type OneofShowcaseScalarChoiceCase string
+
+const (
+	OneofShowcaseScalarChoiceCaseStrVal    OneofShowcaseScalarChoiceCase = "str_val"
+	OneofShowcaseScalarChoiceCaseIntVal    OneofShowcaseScalarChoiceCase = "int_val"
+	OneofShowcaseScalarChoiceCaseLongVal   OneofShowcaseScalarChoiceCase = "long_val"
+	OneofShowcaseScalarChoiceCaseDoubleVal OneofShowcaseScalarChoiceCase = "double_val"
+	OneofShowcaseScalarChoiceCaseBoolVal   OneofShowcaseScalarChoiceCase = "bool_val"
+	OneofShowcaseScalarChoiceCaseBytesVal  OneofShowcaseScalarChoiceCase = "bytes_val"
+)
+
+// Valid reports whether c is one of the OneofShowcaseScalarChoiceCase variants
+func (c OneofShowcaseScalarChoiceCase) Valid() bool {
+	switch c {
+	case OneofShowcaseScalarChoiceCaseStrVal, OneofShowcaseScalarChoiceCaseIntVal, OneofShowcaseScalarChoiceCaseLongVal, OneofShowcaseScalarChoiceCaseDoubleVal, OneofShowcaseScalarChoiceCaseBoolVal, OneofShowcaseScalarChoiceCaseBytesVal:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c OneofShowcaseScalarChoiceCase) String() string {
+	return string(c)
+}
+
+// OneofShowcaseMessageChoiceCase identifies the set variant of full.OneofShowcase.message_choice oneof, empty when none is set
+type OneofShowcaseMessageChoiceCase string
+
+const (
+	OneofShowcaseMessageChoiceCaseAddress  OneofShowcaseMessageChoiceCase = "address"
+	OneofShowcaseMessageChoiceCaseContact  OneofShowcaseMessageChoiceCase = "contact"
+	OneofShowcaseMessageChoiceCaseMetadata OneofShowcaseMessageChoiceCase = "metadata"
+)
+
+// Valid reports whether c is one of the OneofShowcaseMessageChoiceCase variants
+func (c OneofShowcaseMessageChoiceCase) Valid() bool {
+	switch c {
+	case OneofShowcaseMessageChoiceCaseAddress, OneofShowcaseMessageChoiceCaseContact, OneofShowcaseMessageChoiceCaseMetadata:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c OneofShowcaseMessageChoiceCase) String() string {
+	return string(c)
 }
 
-// mapShowcasePlainPool is a sync.Pool for MapShowcasePlain objects
-var mapShowcasePlainPool = sync.Pool{
-	New: func() interface{} {
-		return &MapShowcasePlain{}
-	},
+// OneofShowcaseEnumChoiceCase identifies the set variant of full.OneofShowcase.enum_choice oneof, empty when none is set
+type OneofShowcaseEnumChoiceCase string
+
+const (
+	OneofShowcaseEnumChoiceCaseStatus   OneofShowcaseEnumChoiceCase = "status"
+	OneofShowcaseEnumChoiceCasePriority OneofShowcaseEnumChoiceCase = "priority"
+	OneofShowcaseEnumChoiceCaseError    OneofShowcaseEnumChoiceCase = "error"
+)
+
+// Valid reports whether c is one of the OneofShowcaseEnumChoiceCase variants
+func (c OneofShowcaseEnumChoiceCase) Valid() bool {
+	switch c {
+	case OneofShowcaseEnumChoiceCaseStatus, OneofShowcaseEnumChoiceCasePriority, OneofShowcaseEnumChoiceCaseError:
+		return true
+	}
+	return false
 }
 
-// GetMapShowcasePlain returns a MapShowcasePlain from the pool
-func GetMapShowcasePlain() *MapShowcasePlain {
-	return mapShowcasePlainPool.Get().(*MapShowcasePlain)
+// String returns the proto name of the variant
+func (c OneofShowcaseEnumChoiceCase) String() string {
+	return string(c)
 }
 
-// PutMapShowcasePlain returns a MapShowcasePlain to the pool after resetting it
-func PutMapShowcasePlain(p *MapShowcasePlain) {
-	if p == nil {
-		return
+// OneofShowcaseContentCase identifies the set variant of full.OneofShowcase.content oneof, empty when none is set
+type OneofShowcaseContentCase string
+
+const (
+	OneofShowcaseContentCaseText  OneofShowcaseContentCase = "text"
+	OneofShowcaseContentCaseImage OneofShowcaseContentCase = "image"
+	OneofShowcaseContentCaseCode  OneofShowcaseContentCase = "code"
+)
+
+// Valid reports whether c is one of the OneofShowcaseContentCase variants
+func (c OneofShowcaseContentCase) Valid() bool {
+	switch c {
+	case OneofShowcaseContentCaseText, OneofShowcaseContentCaseImage, OneofShowcaseContentCaseCode:
+		return true
 	}
-	p.Reset()
-	mapShowcasePlainPool.Put(p)
+	return false
 }
 
-// Reset clears all fields in MapShowcasePlain for reuse
-func (p *MapShowcasePlain) Reset() {
-	if p == nil {
-		return
-	}
+// String returns the proto name of the variant
+func (c OneofShowcaseContentCase) String() string {
+	return string(c)
+}
 
-	for k := range p.StrStr {
-		delete(p.StrStr, k)
+// OneofShowcaseSourceTypeCase identifies the set variant of full.OneofShowcase.source_type oneof, empty when none is set
+type OneofShowcaseSourceTypeCase string
+
+const (
+	OneofShowcaseSourceTypeCaseUrl      OneofShowcaseSourceTypeCase = "url"
+	OneofShowcaseSourceTypeCaseFilePath OneofShowcaseSourceTypeCase = "file_path"
+)
+
+// Valid reports whether c is one of the OneofShowcaseSourceTypeCase variants
+func (c OneofShowcaseSourceTypeCase) Valid() bool {
+	switch c {
+	case OneofShowcaseSourceTypeCaseUrl, OneofShowcaseSourceTypeCaseFilePath:
+		return true
 	}
-	for k := range p.StrInt32 {
-		delete(p.StrInt32, k)
+	return false
+}
+
+// String returns the proto name of the variant
+func (c OneofShowcaseSourceTypeCase) String() string {
+	return string(c)
+}
+
+// OneofShowcaseDestinationTypeCase identifies the set variant of full.OneofShowcase.destination_type oneof, empty when none is set
+type OneofShowcaseDestinationTypeCase string
+
+const (
+	OneofShowcaseDestinationTypeCaseDestUrl  OneofShowcaseDestinationTypeCase = "dest_url"
+	OneofShowcaseDestinationTypeCaseDestPath OneofShowcaseDestinationTypeCase = "dest_path"
+)
+
+// Valid reports whether c is one of the OneofShowcaseDestinationTypeCase variants
+func (c OneofShowcaseDestinationTypeCase) Valid() bool {
+	switch c {
+	case OneofShowcaseDestinationTypeCaseDestUrl, OneofShowcaseDestinationTypeCaseDestPath:
+		return true
 	}
-	for k := range p.StrInt64 {
-		delete(p.StrInt64, k)
+	return false
+}
+
+// String returns the proto name of the variant
+func (c OneofShowcaseDestinationTypeCase) String() string {
+	return string(c)
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *OneofShowcase) IntoPlain() *OneofShowcasePlain {
+	if pb == nil {
+		return nil
 	}
-	for k := range p.StrUint32 {
-		delete(p.StrUint32, k)
+	p := &OneofShowcasePlain{}
+
+	// Detect scalar_choice oneof case
+	switch pb.ScalarChoice.(type) {
+	case *OneofShowcase_StrVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseStrVal
+	case *OneofShowcase_IntVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseIntVal
+	case *OneofShowcase_LongVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseLongVal
+	case *OneofShowcase_DoubleVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseDoubleVal
+	case *OneofShowcase_BoolVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseBoolVal
+	case *OneofShowcase_BytesVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseBytesVal
+	}
+
+	// Detect message_choice oneof case
+	switch pb.MessageChoice.(type) {
+	case *OneofShowcase_Address:
+		p.MessageChoiceCase = OneofShowcaseMessageChoiceCaseAddress
+	case *OneofShowcase_Contact:
+		p.MessageChoiceCase = OneofShowcaseMessageChoiceCaseContact
+	case *OneofShowcase_Metadata:
+		p.MessageChoiceCase = OneofShowcaseMessageChoiceCaseMetadata
+	}
+
+	// Detect enum_choice oneof case
+	switch pb.EnumChoice.(type) {
+	case *OneofShowcase_Status:
+		p.EnumChoiceCase = OneofShowcaseEnumChoiceCaseStatus
+	case *OneofShowcase_Priority:
+		p.EnumChoiceCase = OneofShowcaseEnumChoiceCasePriority
+	case *OneofShowcase_Error:
+		p.EnumChoiceCase = OneofShowcaseEnumChoiceCaseError
 	}
-	for k := range p.StrUint64 {
-		delete(p.StrUint64, k)
+
+	// Detect content oneof case
+	switch pb.Content.(type) {
+	case *OneofShowcase_Text:
+		p.ContentCase = OneofShowcaseContentCaseText
+	case *OneofShowcase_Image:
+		p.ContentCase = OneofShowcaseContentCaseImage
+	case *OneofShowcase_Code:
+		p.ContentCase = OneofShowcaseContentCaseCode
 	}
-	for k := range p.StrFloat {
-		delete(p.StrFloat, k)
+
+	// Detect source_type oneof case
+	switch pb.SourceType.(type) {
+	case *OneofShowcase_Url:
+		p.SourceTypeCase = OneofShowcaseSourceTypeCaseUrl
+	case *OneofShowcase_FilePath:
+		p.SourceTypeCase = OneofShowcaseSourceTypeCaseFilePath
 	}
-	for k := range p.StrDouble {
-		delete(p.StrDouble, k)
+
+	// Detect destination_type oneof case
+	switch pb.DestinationType.(type) {
+	case *OneofShowcase_DestUrl:
+		p.DestinationTypeCase = OneofShowcaseDestinationTypeCaseDestUrl
+	case *OneofShowcase_DestPath:
+		p.DestinationTypeCase = OneofShowcaseDestinationTypeCaseDestPath
 	}
-	for k := range p.StrBool {
-		delete(p.StrBool, k)
+
+	p.Id = pb.Id
+	// ScalarChoiceStrVal from scalar_choice.str_val
+	if pb != nil {
+		p.ScalarChoiceStrVal = pb.GetStrVal()
 	}
-	for k := range p.StrBytes {
-		delete(p.StrBytes, k)
+	// ScalarChoiceIntVal from scalar_choice.int_val
+	if pb != nil {
+		p.ScalarChoiceIntVal = pb.GetIntVal()
 	}
-	for k := range p.Int32Str {
-		delete(p.Int32Str, k)
+	// ScalarChoiceLongVal from scalar_choice.long_val
+	if pb != nil {
+		p.ScalarChoiceLongVal = pb.GetLongVal()
 	}
-	for k := range p.Int64Str {
-		delete(p.Int64Str, k)
+	// ScalarChoiceDoubleVal from scalar_choice.double_val
+	if pb != nil {
+		p.ScalarChoiceDoubleVal = pb.GetDoubleVal()
 	}
-	for k := range p.Uint32Str {
-		delete(p.Uint32Str, k)
+	// ScalarChoiceBoolVal from scalar_choice.bool_val
+	if pb != nil {
+		p.ScalarChoiceBoolVal = pb.GetBoolVal()
 	}
-	for k := range p.Uint64Str {
-		delete(p.Uint64Str, k)
+	// ScalarChoiceBytesVal from scalar_choice.bytes_val
+	if pb != nil {
+		p.ScalarChoiceBytesVal = pb.GetBytesVal()
 	}
-	for k := range p.Sint32Str {
-		delete(p.Sint32Str, k)
+	// MessageChoiceAddress from message_choice.address
+	if pb.GetAddress() != nil {
+		p.MessageChoiceAddress = pb.GetAddress()
 	}
-	for k := range p.Sint64Str {
-		delete(p.Sint64Str, k)
+	// MessageChoiceContact from message_choice.contact
+	if pb.GetContact() != nil {
+		p.MessageChoiceContact = pb.GetContact()
 	}
-	for k := range p.Fixed32Str {
-		delete(p.Fixed32Str, k)
+	// MessageChoiceMetadata from message_choice.metadata
+	if pb.GetMetadata() != nil {
+		p.MessageChoiceMetadata = pb.GetMetadata()
 	}
-	for k := range p.Fixed64Str {
-		delete(p.Fixed64Str, k)
+	// EnumChoiceStatus from enum_choice.status
+	if pb != nil {
+		p.EnumChoiceStatus = pb.GetStatus()
 	}
-	for k := range p.Sfixed32Str {
-		delete(p.Sfixed32Str, k)
+	// EnumChoicePriority from enum_choice.priority
+	if pb != nil {
+		p.EnumChoicePriority = pb.GetPriority()
 	}
-	for k := range p.Sfixed64Str {
-		delete(p.Sfixed64Str, k)
+	// EnumChoiceError from enum_choice.error
+	if pb != nil {
+		p.EnumChoiceError = pb.GetError()
 	}
-	for k := range p.BoolStr {
-		delete(p.BoolStr, k)
+	// ContentText from content.text
+	if pb.GetText() != nil {
+		p.ContentText = pb.GetText()
 	}
-	for k := range p.StrMessage {
-		delete(p.StrMessage, k)
+	// ContentImage from content.image
+	if pb.GetImage() != nil {
+		p.ContentImage = pb.GetImage()
 	}
-	for k := range p.Int32Message {
-		delete(p.Int32Message, k)
+	// ContentCode from content.code
+	if pb.GetCode() != nil {
+		p.ContentCode = pb.GetCode()
 	}
-	for k := range p.Int64Message {
-		delete(p.Int64Message, k)
+	// SourceTypeUrl from source_type.url
+	if pb != nil {
+		p.SourceTypeUrl = pb.GetUrl()
 	}
-	for k := range p.StrEnum {
-		delete(p.StrEnum, k)
+	// SourceTypeFilePath from source_type.file_path
+	if pb != nil {
+		p.SourceTypeFilePath = pb.GetFilePath()
 	}
-	for k := range p.Int32Enum {
-		delete(p.Int32Enum, k)
+	// DestinationTypeDestUrl from destination_type.dest_url
+	if pb != nil {
+		p.DestinationTypeDestUrl = pb.GetDestUrl()
 	}
-	for k := range p.Nested {
-		delete(p.Nested, k)
+	// DestinationTypeDestPath from destination_type.dest_path
+	if pb != nil {
+		p.DestinationTypeDestPath = pb.GetDestPath()
 	}
+	return p
 }
 
-type OptionalShowcasePlain struct {
-	OptDouble     float64  `json:"optDouble,omitempty"`
-	OptFloat      float32  `json:"optFloat,omitempty"`
-	OptInt32      int32    `json:"optInt32,omitempty"`
-	OptInt64      int64    `json:"optInt64,omitempty"`
-	OptUint32     uint32   `json:"optUint32,omitempty"`
-	OptUint64     uint64   `json:"optUint64,omitempty"`
-	OptSint32     int32    `json:"optSint32,omitempty"`
-	OptSint64     int64    `json:"optSint64,omitempty"`
-	OptFixed32    uint32   `json:"optFixed32,omitempty"`
-	OptFixed64    uint64   `json:"optFixed64,omitempty"`
-	OptSfixed32   int32    `json:"optSfixed32,omitempty"`
-	OptSfixed64   int64    `json:"optSfixed64,omitempty"`
-	OptBool       bool     `json:"optBool,omitempty"`
-	OptString     string   `json:"optString,omitempty"`
-	OptBytes      []byte   `json:"optBytes,omitempty"`
-	OptStatus     Status   `json:"optStatus,omitempty"`
-	OptPriority   Priority `json:"optPriority,omitempty"`
-	RegularDouble float64  `json:"regularDouble"`
-	RegularString string   `json:"regularString"`
-	RegularBool   bool     `json:"regularBool"`
-}
-
-// IntoPlain converts protobuf message to plain struct
-func (pb *OptionalShowcase) IntoPlain() *OptionalShowcasePlain {
-	if pb == nil {
+// IntoPb converts plain struct to protobuf message
+func (p *OneofShowcasePlain) IntoPb() *OneofShowcase {
+	if p == nil {
 		return nil
 	}
-	p := &OptionalShowcasePlain{}
+	pb := &OneofShowcase{}
 
-	if pb.OptDouble != nil {
-		p.OptDouble = *pb.OptDouble
+	pb.Id = p.Id
+	// ScalarChoiceStrVal -> scalar_choice.str_val
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseStrVal {
+		pb.ScalarChoice = &OneofShowcase_StrVal{StrVal: p.ScalarChoiceStrVal}
 	}
-	if pb.OptFloat != nil {
-		p.OptFloat = *pb.OptFloat
+	// ScalarChoiceIntVal -> scalar_choice.int_val
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseIntVal {
+		pb.ScalarChoice = &OneofShowcase_IntVal{IntVal: p.ScalarChoiceIntVal}
 	}
-	if pb.OptInt32 != nil {
-		p.OptInt32 = *pb.OptInt32
+	// ScalarChoiceLongVal -> scalar_choice.long_val
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseLongVal {
+		pb.ScalarChoice = &OneofShowcase_LongVal{LongVal: p.ScalarChoiceLongVal}
 	}
-	if pb.OptInt64 != nil {
-		p.OptInt64 = *pb.OptInt64
+	// ScalarChoiceDoubleVal -> scalar_choice.double_val
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseDoubleVal {
+		pb.ScalarChoice = &OneofShowcase_DoubleVal{DoubleVal: p.ScalarChoiceDoubleVal}
 	}
-	if pb.OptUint32 != nil {
-		p.OptUint32 = *pb.OptUint32
+	// ScalarChoiceBoolVal -> scalar_choice.bool_val
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseBoolVal {
+		pb.ScalarChoice = &OneofShowcase_BoolVal{BoolVal: p.ScalarChoiceBoolVal}
 	}
-	if pb.OptUint64 != nil {
-		p.OptUint64 = *pb.OptUint64
+	// ScalarChoiceBytesVal -> scalar_choice.bytes_val
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseBytesVal {
+		pb.ScalarChoice = &OneofShowcase_BytesVal{BytesVal: p.ScalarChoiceBytesVal}
 	}
-	if pb.OptSint32 != nil {
-		p.OptSint32 = *pb.OptSint32
+	// MessageChoiceAddress -> message_choice.address
+	if p.MessageChoiceAddress != nil && p.MessageChoiceCase == OneofShowcaseMessageChoiceCaseAddress {
+		pb.MessageChoice = &OneofShowcase_Address{Address: p.MessageChoiceAddress}
 	}
-	if pb.OptSint64 != nil {
-		p.OptSint64 = *pb.OptSint64
+	// MessageChoiceContact -> message_choice.contact
+	if p.MessageChoiceContact != nil && p.MessageChoiceCase == OneofShowcaseMessageChoiceCaseContact {
+		pb.MessageChoice = &OneofShowcase_Contact{Contact: p.MessageChoiceContact}
 	}
-	if pb.OptFixed32 != nil {
-		p.OptFixed32 = *pb.OptFixed32
+	// MessageChoiceMetadata -> message_choice.metadata
+	if p.MessageChoiceMetadata != nil && p.MessageChoiceCase == OneofShowcaseMessageChoiceCaseMetadata {
+		pb.MessageChoice = &OneofShowcase_Metadata{Metadata: p.MessageChoiceMetadata}
 	}
-	if pb.OptFixed64 != nil {
-		p.OptFixed64 = *pb.OptFixed64
+	// EnumChoiceStatus -> enum_choice.status
+	if p.EnumChoiceCase == OneofShowcaseEnumChoiceCaseStatus {
+		pb.EnumChoice = &OneofShowcase_Status{Status: p.EnumChoiceStatus}
 	}
-	if pb.OptSfixed32 != nil {
-		p.OptSfixed32 = *pb.OptSfixed32
+	// EnumChoicePriority -> enum_choice.priority
+	if p.EnumChoiceCase == OneofShowcaseEnumChoiceCasePriority {
+		pb.EnumChoice = &OneofShowcase_Priority{Priority: p.EnumChoicePriority}
 	}
-	if pb.OptSfixed64 != nil {
-		p.OptSfixed64 = *pb.OptSfixed64
+	// EnumChoiceError -> enum_choice.error
+	if p.EnumChoiceCase == OneofShowcaseEnumChoiceCaseError {
+		pb.EnumChoice = &OneofShowcase_Error{Error: p.EnumChoiceError}
 	}
-	if pb.OptBool != nil {
-		p.OptBool = *pb.OptBool
+	// ContentText -> content.text
+	if p.ContentText != nil && p.ContentCase == OneofShowcaseContentCaseText {
+		pb.Content = &OneofShowcase_Text{Text: p.ContentText}
 	}
-	if pb.OptString != nil {
-		p.OptString = *pb.OptString
+	// ContentImage -> content.image
+	if p.ContentImage != nil && p.ContentCase == OneofShowcaseContentCaseImage {
+		pb.Content = &OneofShowcase_Image{Image: p.ContentImage}
 	}
-	p.OptBytes = pb.OptBytes
-	if pb.OptStatus != nil {
-		p.OptStatus = *pb.OptStatus
+	// ContentCode -> content.code
+	if p.ContentCode != nil && p.ContentCase == OneofShowcaseContentCaseCode {
+		pb.Content = &OneofShowcase_Code{Code: p.ContentCode}
 	}
-	if pb.OptPriority != nil {
-		p.OptPriority = *pb.OptPriority
+	// SourceTypeUrl -> source_type.url
+	if p.SourceTypeCase == OneofShowcaseSourceTypeCaseUrl {
+		pb.SourceType = &OneofShowcase_Url{Url: p.SourceTypeUrl}
 	}
-	p.RegularDouble = pb.RegularDouble
-	p.RegularString = pb.RegularString
-	p.RegularBool = pb.RegularBool
-	return p
+	// SourceTypeFilePath -> source_type.file_path
+	if p.SourceTypeCase == OneofShowcaseSourceTypeCaseFilePath {
+		pb.SourceType = &OneofShowcase_FilePath{FilePath: p.SourceTypeFilePath}
+	}
+	// DestinationTypeDestUrl -> destination_type.dest_url
+	if p.DestinationTypeCase == OneofShowcaseDestinationTypeCaseDestUrl {
+		pb.DestinationType = &OneofShowcase_DestUrl{DestUrl: p.DestinationTypeDestUrl}
+	}
+	// DestinationTypeDestPath -> destination_type.dest_path
+	if p.DestinationTypeCase == OneofShowcaseDestinationTypeCaseDestPath {
+		pb.DestinationType = &OneofShowcase_DestPath{DestPath: p.DestinationTypeDestPath}
+	}
+	return pb
 }
 
-// IntoPb converts plain struct to protobuf message
-func (p *OptionalShowcasePlain) IntoPb() *OptionalShowcase {
-	if p == nil {
+// oneofShowcasePlainMergePaths are the fields of OneofShowcase covered by OneofShowcasePlain
+var oneofShowcasePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{10},
+	{11},
+	{12},
+	{13},
+	{14},
+	{15},
+	{20},
+	{21},
+	{22},
+	{30},
+	{31},
+	{32},
+	{40},
+	{41},
+	{42},
+	{50},
+	{51},
+	{60},
+	{61},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *OneofShowcasePlain) IntoPbMerge(dst *OneofShowcase) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, oneofShowcasePlainMergePaths...)
+}
+
+// oneofShowcasePlainFieldPaths link the JSON names of OneofShowcasePlain to field-mask paths of OneofShowcase
+var oneofShowcasePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "scalarChoiceStrVal", Path: "str_val"},
+	{Name: "scalarChoiceIntVal", Path: "int_val"},
+	{Name: "scalarChoiceLongVal", Path: "long_val"},
+	{Name: "scalarChoiceDoubleVal", Path: "double_val"},
+	{Name: "scalarChoiceBoolVal", Path: "bool_val"},
+	{Name: "scalarChoiceBytesVal", Path: "bytes_val"},
+	{Name: "messageChoiceAddress", Path: "address"},
+	{Name: "messageChoiceContact", Path: "contact"},
+	{Name: "messageChoiceMetadata", Path: "metadata"},
+	{Name: "enumChoiceStatus", Path: "status"},
+	{Name: "enumChoicePriority", Path: "priority"},
+	{Name: "enumChoiceError", Path: "error"},
+	{Name: "contentText", Path: "text"},
+	{Name: "contentImage", Path: "image"},
+	{Name: "contentCode", Path: "code"},
+	{Name: "sourceTypeUrl", Path: "url"},
+	{Name: "sourceTypeFilePath", Path: "file_path"},
+	{Name: "destinationTypeDestUrl", Path: "dest_url"},
+	{Name: "destinationTypeDestPath", Path: "dest_path"},
+}
+
+// OneofShowcasePlainFieldMask builds a field mask of OneofShowcase from JSON names of OneofShowcasePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func OneofShowcasePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(oneofShowcasePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// OneofShowcasePlainFieldNames translates a field mask of OneofShowcase into JSON names of OneofShowcasePlain.
+// A path to an embedded message yields all fields flattened from it.
+func OneofShowcasePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(oneofShowcasePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of OneofShowcase, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *OneofShowcasePlain) ApplyToPb(pb *OneofShowcase, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
 		return nil
 	}
-	pb := &OptionalShowcase{}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), oneofShowcasePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
 
-	pb.OptDouble = &p.OptDouble
-	pb.OptFloat = &p.OptFloat
-	pb.OptInt32 = &p.OptInt32
-	pb.OptInt64 = &p.OptInt64
-	pb.OptUint32 = &p.OptUint32
-	pb.OptUint64 = &p.OptUint64
-	pb.OptSint32 = &p.OptSint32
-	pb.OptSint64 = &p.OptSint64
-	pb.OptFixed32 = &p.OptFixed32
-	pb.OptFixed64 = &p.OptFixed64
-	pb.OptSfixed32 = &p.OptSfixed32
-	pb.OptSfixed64 = &p.OptSfixed64
-	pb.OptBool = &p.OptBool
-	if p.OptString != "" {
-		pb.OptString = &p.OptString
-	}
-	pb.OptBytes = p.OptBytes
-	pb.OptStatus = &p.OptStatus
-	pb.OptPriority = &p.OptPriority
-	pb.RegularDouble = p.RegularDouble
-	pb.RegularString = p.RegularString
-	pb.RegularBool = p.RegularBool
-	return pb
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *OneofShowcase) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*OneofShowcasePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), oneofShowcasePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &OneofShowcase{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
 }
 
 // IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
-func (pb *OptionalShowcase) IntoPlainReuse(p *OptionalShowcasePlain) {
+func (pb *OneofShowcase) IntoPlainReuse(p *OneofShowcasePlain) {
 	if pb == nil || p == nil {
 		return
 	}
 	// Reset before filling
 	p.Reset()
 
-	if pb.OptDouble != nil {
-		p.OptDouble = *pb.OptDouble
+	// Detect scalar_choice oneof case
+	switch pb.ScalarChoice.(type) {
+	case *OneofShowcase_StrVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseStrVal
+	case *OneofShowcase_IntVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseIntVal
+	case *OneofShowcase_LongVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseLongVal
+	case *OneofShowcase_DoubleVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseDoubleVal
+	case *OneofShowcase_BoolVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseBoolVal
+	case *OneofShowcase_BytesVal:
+		p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseBytesVal
+	}
+
+	// Detect message_choice oneof case
+	switch pb.MessageChoice.(type) {
+	case *OneofShowcase_Address:
+		p.MessageChoiceCase = OneofShowcaseMessageChoiceCaseAddress
+	case *OneofShowcase_Contact:
+		p.MessageChoiceCase = OneofShowcaseMessageChoiceCaseContact
+	case *OneofShowcase_Metadata:
+		p.MessageChoiceCase = OneofShowcaseMessageChoiceCaseMetadata
+	}
+
+	// Detect enum_choice oneof case
+	switch pb.EnumChoice.(type) {
+	case *OneofShowcase_Status:
+		p.EnumChoiceCase = OneofShowcaseEnumChoiceCaseStatus
+	case *OneofShowcase_Priority:
+		p.EnumChoiceCase = OneofShowcaseEnumChoiceCasePriority
+	case *OneofShowcase_Error:
+		p.EnumChoiceCase = OneofShowcaseEnumChoiceCaseError
+	}
+
+	// Detect content oneof case
+	switch pb.Content.(type) {
+	case *OneofShowcase_Text:
+		p.ContentCase = OneofShowcaseContentCaseText
+	case *OneofShowcase_Image:
+		p.ContentCase = OneofShowcaseContentCaseImage
+	case *OneofShowcase_Code:
+		p.ContentCase = OneofShowcaseContentCaseCode
+	}
+
+	// Detect source_type oneof case
+	switch pb.SourceType.(type) {
+	case *OneofShowcase_Url:
+		p.SourceTypeCase = OneofShowcaseSourceTypeCaseUrl
+	case *OneofShowcase_FilePath:
+		p.SourceTypeCase = OneofShowcaseSourceTypeCaseFilePath
 	}
-	if pb.OptFloat != nil {
-		p.OptFloat = *pb.OptFloat
+
+	// Detect destination_type oneof case
+	switch pb.DestinationType.(type) {
+	case *OneofShowcase_DestUrl:
+		p.DestinationTypeCase = OneofShowcaseDestinationTypeCaseDestUrl
+	case *OneofShowcase_DestPath:
+		p.DestinationTypeCase = OneofShowcaseDestinationTypeCaseDestPath
 	}
-	if pb.OptInt32 != nil {
-		p.OptInt32 = *pb.OptInt32
+
+	p.Id = pb.Id
+	// ScalarChoiceStrVal from scalar_choice.str_val
+	if pb != nil {
+		p.ScalarChoiceStrVal = pb.GetStrVal()
 	}
-	if pb.OptInt64 != nil {
-		p.OptInt64 = *pb.OptInt64
+	// ScalarChoiceIntVal from scalar_choice.int_val
+	if pb != nil {
+		p.ScalarChoiceIntVal = pb.GetIntVal()
 	}
-	if pb.OptUint32 != nil {
-		p.OptUint32 = *pb.OptUint32
+	// ScalarChoiceLongVal from scalar_choice.long_val
+	if pb != nil {
+		p.ScalarChoiceLongVal = pb.GetLongVal()
 	}
-	if pb.OptUint64 != nil {
-		p.OptUint64 = *pb.OptUint64
+	// ScalarChoiceDoubleVal from scalar_choice.double_val
+	if pb != nil {
+		p.ScalarChoiceDoubleVal = pb.GetDoubleVal()
 	}
-	if pb.OptSint32 != nil {
-		p.OptSint32 = *pb.OptSint32
+	// ScalarChoiceBoolVal from scalar_choice.bool_val
+	if pb != nil {
+		p.ScalarChoiceBoolVal = pb.GetBoolVal()
 	}
-	if pb.OptSint64 != nil {
-		p.OptSint64 = *pb.OptSint64
+	// ScalarChoiceBytesVal from scalar_choice.bytes_val
+	if pb != nil {
+		p.ScalarChoiceBytesVal = pb.GetBytesVal()
 	}
-	if pb.OptFixed32 != nil {
-		p.OptFixed32 = *pb.OptFixed32
+	// MessageChoiceAddress from message_choice.address
+	if pb.GetAddress() != nil {
+		p.MessageChoiceAddress = pb.GetAddress()
 	}
-	if pb.OptFixed64 != nil {
-		p.OptFixed64 = *pb.OptFixed64
+	// MessageChoiceContact from message_choice.contact
+	if pb.GetContact() != nil {
+		p.MessageChoiceContact = pb.GetContact()
 	}
-	if pb.OptSfixed32 != nil {
-		p.OptSfixed32 = *pb.OptSfixed32
+	// MessageChoiceMetadata from message_choice.metadata
+	if pb.GetMetadata() != nil {
+		p.MessageChoiceMetadata = pb.GetMetadata()
 	}
-	if pb.OptSfixed64 != nil {
-		p.OptSfixed64 = *pb.OptSfixed64
+	// EnumChoiceStatus from enum_choice.status
+	if pb != nil {
+		p.EnumChoiceStatus = pb.GetStatus()
 	}
-	if pb.OptBool != nil {
-		p.OptBool = *pb.OptBool
+	// EnumChoicePriority from enum_choice.priority
+	if pb != nil {
+		p.EnumChoicePriority = pb.GetPriority()
 	}
-	if pb.OptString != nil {
-		p.OptString = *pb.OptString
+	// EnumChoiceError from enum_choice.error
+	if pb != nil {
+		p.EnumChoiceError = pb.GetError()
 	}
-	p.OptBytes = pb.OptBytes
-	if pb.OptStatus != nil {
-		p.OptStatus = *pb.OptStatus
+	// ContentText from content.text
+	if pb.GetText() != nil {
+		p.ContentText = pb.GetText()
 	}
-	if pb.OptPriority != nil {
-		p.OptPriority = *pb.OptPriority
+	// ContentImage from content.image
+	if pb.GetImage() != nil {
+		p.ContentImage = pb.GetImage()
+	}
+	// ContentCode from content.code
+	if pb.GetCode() != nil {
+		p.ContentCode = pb.GetCode()
+	}
+	// SourceTypeUrl from source_type.url
+	if pb != nil {
+		p.SourceTypeUrl = pb.GetUrl()
+	}
+	// SourceTypeFilePath from source_type.file_path
+	if pb != nil {
+		p.SourceTypeFilePath = pb.GetFilePath()
+	}
+	// DestinationTypeDestUrl from destination_type.dest_url
+	if pb != nil {
+		p.DestinationTypeDestUrl = pb.GetDestUrl()
+	}
+	// DestinationTypeDestPath from destination_type.dest_path
+	if pb != nil {
+		p.DestinationTypeDestPath = pb.GetDestPath()
 	}
-	p.RegularDouble = pb.RegularDouble
-	p.RegularString = pb.RegularString
-	p.RegularBool = pb.RegularBool
 }
 
-// MarshalJX encodes OptionalShowcasePlain to JSON using jx.Encoder
-func (p *OptionalShowcasePlain) MarshalJX(e *jx.Encoder) {
+// MarshalJX encodes OneofShowcasePlain to JSON using jx.Encoder
+func (p *OneofShowcasePlain) MarshalJX(e *jx.Encoder) {
 	if p == nil {
 		e.Null()
 		return
@@ -4962,221 +14741,297 @@ func (p *OptionalShowcasePlain) MarshalJX(e *jx.Encoder) {
 
 	e.ObjStart()
 
-	if p.OptDouble != 0 {
-		e.FieldStart("optDouble")
-		e.Float64(p.OptDouble)
+	if p.ScalarChoiceCase != "" {
+		e.FieldStart("scalar_choice_case")
+		e.Str(p.ScalarChoiceCase.String())
 	}
-	if p.OptFloat != 0 {
-		e.FieldStart("optFloat")
-		e.Float32(p.OptFloat)
+	if p.MessageChoiceCase != "" {
+		e.FieldStart("message_choice_case")
+		e.Str(p.MessageChoiceCase.String())
 	}
-	if p.OptInt32 != 0 {
-		e.FieldStart("optInt32")
-		e.Int32(p.OptInt32)
+	if p.EnumChoiceCase != "" {
+		e.FieldStart("enum_choice_case")
+		e.Str(p.EnumChoiceCase.String())
 	}
-	if p.OptInt64 != 0 {
-		e.FieldStart("optInt64")
-		e.Int64(p.OptInt64)
+	if p.ContentCase != "" {
+		e.FieldStart("content_case")
+		e.Str(p.ContentCase.String())
 	}
-	if p.OptUint32 != 0 {
-		e.FieldStart("optUint32")
-		e.UInt32(p.OptUint32)
+	if p.SourceTypeCase != "" {
+		e.FieldStart("source_type_case")
+		e.Str(p.SourceTypeCase.String())
 	}
-	if p.OptUint64 != 0 {
-		e.FieldStart("optUint64")
-		e.UInt64(p.OptUint64)
+	if p.DestinationTypeCase != "" {
+		e.FieldStart("destination_type_case")
+		e.Str(p.DestinationTypeCase.String())
 	}
-	if p.OptSint32 != 0 {
-		e.FieldStart("optSint32")
-		e.Int32(p.OptSint32)
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
 	}
-	if p.OptSint64 != 0 {
-		e.FieldStart("optSint64")
-		e.Int64(p.OptSint64)
+	if p.ScalarChoiceStrVal != "" {
+		e.FieldStart("scalarChoiceStrVal")
+		e.Str(p.ScalarChoiceStrVal)
 	}
-	if p.OptFixed32 != 0 {
-		e.FieldStart("optFixed32")
-		e.UInt32(p.OptFixed32)
+	if p.ScalarChoiceIntVal != 0 {
+		e.FieldStart("scalarChoiceIntVal")
+		e.Int32(p.ScalarChoiceIntVal)
 	}
-	if p.OptFixed64 != 0 {
-		e.FieldStart("optFixed64")
-		e.UInt64(p.OptFixed64)
+	if p.ScalarChoiceLongVal != 0 {
+		e.FieldStart("scalarChoiceLongVal")
+		e.Int64(p.ScalarChoiceLongVal)
 	}
-	if p.OptSfixed32 != 0 {
-		e.FieldStart("optSfixed32")
-		e.Int32(p.OptSfixed32)
+	if p.ScalarChoiceDoubleVal != 0 {
+		e.FieldStart("scalarChoiceDoubleVal")
+		e.Float64(p.ScalarChoiceDoubleVal)
 	}
-	if p.OptSfixed64 != 0 {
-		e.FieldStart("optSfixed64")
-		e.Int64(p.OptSfixed64)
+	if p.ScalarChoiceBoolVal {
+		e.FieldStart("scalarChoiceBoolVal")
+		e.Bool(p.ScalarChoiceBoolVal)
 	}
-	if p.OptBool {
-		e.FieldStart("optBool")
-		e.Bool(p.OptBool)
+	if len(p.ScalarChoiceBytesVal) > 0 {
+		e.FieldStart("scalarChoiceBytesVal")
+		e.Base64(p.ScalarChoiceBytesVal)
 	}
-	if p.OptString != "" {
-		e.FieldStart("optString")
-		e.Str(p.OptString)
+	if p.MessageChoiceAddress != nil {
+		e.FieldStart("messageChoiceAddress")
+		p.MessageChoiceAddress.MarshalJX(e)
 	}
-	if len(p.OptBytes) > 0 {
-		e.FieldStart("optBytes")
-		e.Base64(p.OptBytes)
+	if p.MessageChoiceContact != nil {
+		e.FieldStart("messageChoiceContact")
+		p.MessageChoiceContact.MarshalJX(e)
 	}
-	e.FieldStart("optStatus")
-	e.Int32(int32(p.OptStatus))
-	e.FieldStart("optPriority")
-	e.Int32(int32(p.OptPriority))
-	if p.RegularDouble != 0 {
-		e.FieldStart("regularDouble")
-		e.Float64(p.RegularDouble)
+	if p.MessageChoiceMetadata != nil {
+		e.FieldStart("messageChoiceMetadata")
+		p.MessageChoiceMetadata.MarshalJX(e)
 	}
-	if p.RegularString != "" {
-		e.FieldStart("regularString")
-		e.Str(p.RegularString)
+	if p.EnumChoiceStatus != 0 {
+		e.FieldStart("enumChoiceStatus")
+		e.Int32(int32(p.EnumChoiceStatus))
 	}
-	if p.RegularBool {
-		e.FieldStart("regularBool")
-		e.Bool(p.RegularBool)
+	if p.EnumChoicePriority != 0 {
+		e.FieldStart("enumChoicePriority")
+		e.Int32(int32(p.EnumChoicePriority))
+	}
+	if p.EnumChoiceError != 0 {
+		e.FieldStart("enumChoiceError")
+		e.Int32(int32(p.EnumChoiceError))
+	}
+	if p.ContentText != nil {
+		e.FieldStart("contentText")
+		p.ContentText.MarshalJX(e)
+	}
+	if p.ContentImage != nil {
+		e.FieldStart("contentImage")
+		p.ContentImage.MarshalJX(e)
+	}
+	if p.ContentCode != nil {
+		e.FieldStart("contentCode")
+		p.ContentCode.MarshalJX(e)
+	}
+	if p.SourceTypeUrl != "" {
+		e.FieldStart("sourceTypeUrl")
+		e.Str(p.SourceTypeUrl)
+	}
+	if p.SourceTypeFilePath != "" {
+		e.FieldStart("sourceTypeFilePath")
+		e.Str(p.SourceTypeFilePath)
+	}
+	if p.DestinationTypeDestUrl != "" {
+		e.FieldStart("destinationTypeDestUrl")
+		e.Str(p.DestinationTypeDestUrl)
+	}
+	if p.DestinationTypeDestPath != "" {
+		e.FieldStart("destinationTypeDestPath")
+		e.Str(p.DestinationTypeDestPath)
 	}
 	e.ObjEnd()
 }
 
 // MarshalJSON implements json.Marshaler using jx
-func (p *OptionalShowcasePlain) MarshalJSON() ([]byte, error) {
+func (p *OneofShowcasePlain) MarshalJSON() ([]byte, error) {
 	e := jx.GetEncoder()
 	defer jx.PutEncoder(e)
 	p.MarshalJX(e)
 	return e.Bytes(), nil
 }
 
-// UnmarshalJX decodes OptionalShowcasePlain from JSON using jx.Decoder
-func (p *OptionalShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
+// UnmarshalJX decodes OneofShowcasePlain from JSON using jx.Decoder
+func (p *OneofShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
 	if p == nil {
 		return nil
 	}
 
 	return d.Obj(func(d *jx.Decoder, key string) error {
 		switch key {
-		case "optDouble":
-			v, err := d.Float64()
+		case "scalar_choice_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ScalarChoiceCase = OneofShowcaseScalarChoiceCase(v)
+			if v != "" && !p.ScalarChoiceCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.OneofShowcase.scalar_choice", v)
+			}
+		case "message_choice_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.MessageChoiceCase = OneofShowcaseMessageChoiceCase(v)
+			if v != "" && !p.MessageChoiceCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.OneofShowcase.message_choice", v)
+			}
+		case "enum_choice_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.EnumChoiceCase = OneofShowcaseEnumChoiceCase(v)
+			if v != "" && !p.EnumChoiceCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.OneofShowcase.enum_choice", v)
+			}
+		case "content_case":
+			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.OptDouble = v
-		case "optFloat":
-			v, err := d.Float32()
-			if err != nil {
-				return err
+			p.ContentCase = OneofShowcaseContentCase(v)
+			if v != "" && !p.ContentCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.OneofShowcase.content", v)
 			}
-			p.OptFloat = v
-		case "optInt32":
-			v, err := d.Int32()
+		case "source_type_case":
+			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.OptInt32 = v
-		case "optInt64":
-			v, err := d.Int64()
+			p.SourceTypeCase = OneofShowcaseSourceTypeCase(v)
+			if v != "" && !p.SourceTypeCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.OneofShowcase.source_type", v)
+			}
+		case "destination_type_case":
+			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.OptInt64 = v
-		case "optUint32":
-			v, err := d.UInt32()
+			p.DestinationTypeCase = OneofShowcaseDestinationTypeCase(v)
+			if v != "" && !p.DestinationTypeCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.OneofShowcase.destination_type", v)
+			}
+		case "id":
+			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.OptUint32 = v
-		case "optUint64":
-			v, err := d.UInt64()
+			p.Id = v
+		case "scalarChoiceStrVal":
+			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.OptUint64 = v
-		case "optSint32":
+			p.ScalarChoiceStrVal = v
+		case "scalarChoiceIntVal":
 			v, err := d.Int32()
 			if err != nil {
 				return err
 			}
-			p.OptSint32 = v
-		case "optSint64":
+			p.ScalarChoiceIntVal = v
+		case "scalarChoiceLongVal":
 			v, err := d.Int64()
 			if err != nil {
 				return err
 			}
-			p.OptSint64 = v
-		case "optFixed32":
-			v, err := d.UInt32()
+			p.ScalarChoiceLongVal = v
+		case "scalarChoiceDoubleVal":
+			v, err := d.Float64()
 			if err != nil {
 				return err
 			}
-			p.OptFixed32 = v
-		case "optFixed64":
-			v, err := d.UInt64()
+			p.ScalarChoiceDoubleVal = v
+		case "scalarChoiceBoolVal":
+			v, err := d.Bool()
 			if err != nil {
 				return err
 			}
-			p.OptFixed64 = v
-		case "optSfixed32":
-			v, err := d.Int32()
+			p.ScalarChoiceBoolVal = v
+		case "scalarChoiceBytesVal":
+			v, err := d.Base64()
 			if err != nil {
 				return err
 			}
-			p.OptSfixed32 = v
-		case "optSfixed64":
-			v, err := d.Int64()
-			if err != nil {
+			p.ScalarChoiceBytesVal = v
+		case "messageChoiceAddress":
+			p.MessageChoiceAddress = &Address{}
+			if err := p.MessageChoiceAddress.UnmarshalJX(d); err != nil {
 				return err
 			}
-			p.OptSfixed64 = v
-		case "optBool":
-			v, err := d.Bool()
-			if err != nil {
+		case "messageChoiceContact":
+			p.MessageChoiceContact = &ContactInfo{}
+			if err := p.MessageChoiceContact.UnmarshalJX(d); err != nil {
 				return err
 			}
-			p.OptBool = v
-		case "optString":
-			v, err := d.Str()
+		case "messageChoiceMetadata":
+			p.MessageChoiceMetadata = &Metadata{}
+			if err := p.MessageChoiceMetadata.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "enumChoiceStatus":
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
 			if err != nil {
 				return err
 			}
-			p.OptString = v
-		case "optBytes":
-			v, err := d.Base64()
+			p.EnumChoiceStatus = Status(v)
+		case "enumChoicePriority":
+			v, err := enumjx.Decode(d, "full.Priority", Priority_value)
 			if err != nil {
 				return err
 			}
-			p.OptBytes = v
-		case "optStatus":
-			v, err := d.Int32()
+			p.EnumChoicePriority = Priority(v)
+		case "enumChoiceError":
+			v, err := enumjx.Decode(d, "full.ErrorCode", ErrorCode_value)
 			if err != nil {
 				return err
 			}
-			p.OptStatus = Status(v)
-		case "optPriority":
-			v, err := d.Int32()
+			p.EnumChoiceError = ErrorCode(v)
+		case "contentText":
+			p.ContentText = &TextContent{}
+			if err := p.ContentText.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "contentImage":
+			p.ContentImage = &ImageContent{}
+			if err := p.ContentImage.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "contentCode":
+			p.ContentCode = &CodeContent{}
+			if err := p.ContentCode.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "sourceTypeUrl":
+			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.OptPriority = Priority(v)
-		case "regularDouble":
-			v, err := d.Float64()
+			p.SourceTypeUrl = v
+		case "sourceTypeFilePath":
+			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.RegularDouble = v
-		case "regularString":
+			p.SourceTypeFilePath = v
+		case "destinationTypeDestUrl":
 			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.RegularString = v
-		case "regularBool":
-			v, err := d.Bool()
+			p.DestinationTypeDestUrl = v
+		case "destinationTypeDestPath":
+			v, err := d.Str()
 			if err != nil {
 				return err
 			}
-			p.RegularBool = v
+			p.DestinationTypeDestPath = v
 		default:
 			return d.Skip()
 		}
@@ -5185,244 +15040,606 @@ func (p *OptionalShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
 }
 
 // UnmarshalJSON implements json.Unmarshaler using jx
-func (p *OptionalShowcasePlain) UnmarshalJSON(data []byte) error {
+func (p *OneofShowcasePlain) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return p.UnmarshalJX(d)
 }
 
-// optionalShowcasePlainPool is a sync.Pool for OptionalShowcasePlain objects
-var optionalShowcasePlainPool = sync.Pool{
-	New: func() interface{} {
-		return &OptionalShowcasePlain{}
-	},
-}
-
-// GetOptionalShowcasePlain returns a OptionalShowcasePlain from the pool
-func GetOptionalShowcasePlain() *OptionalShowcasePlain {
-	return optionalShowcasePlainPool.Get().(*OptionalShowcasePlain)
-}
-
-// PutOptionalShowcasePlain returns a OptionalShowcasePlain to the pool after resetting it
-func PutOptionalShowcasePlain(p *OptionalShowcasePlain) {
-	if p == nil {
-		return
-	}
-	p.Reset()
-	optionalShowcasePlainPool.Put(p)
+// MarshalProto encodes OneofShowcasePlain in the protobuf wire format of OneofShowcase
+func (p *OneofShowcasePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
 }
 
-// Reset clears all fields in OptionalShowcasePlain for reuse
-func (p *OptionalShowcasePlain) Reset() {
+// AppendProto appends OneofShowcasePlain encoded in the protobuf wire format of OneofShowcase to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *OneofShowcasePlain) AppendProto(b []byte) ([]byte, error) {
 	if p == nil {
-		return
+		return b, nil
 	}
-
-	p.OptDouble = 0
-	p.OptFloat = 0
-	p.OptInt32 = 0
-	p.OptInt64 = 0
-	p.OptUint32 = 0
-	p.OptUint64 = 0
-	p.OptSint32 = 0
-	p.OptSint64 = 0
-	p.OptFixed32 = 0
-	p.OptFixed64 = 0
-	p.OptSfixed32 = 0
-	p.OptSfixed64 = 0
-	p.OptBool = false
-	p.OptString = ""
-	p.OptBytes = nil
-	p.OptStatus = 0
-	p.OptPriority = 0
-	p.RegularDouble = 0
-	p.RegularString = ""
-	p.RegularBool = false
-}
-
-type OneofShowcasePlain struct {
-	Id         string        `json:"id"`
-	TextText   *TextContent  `json:"textText"`   // origin: oneof_embed, empath: text.text
-	ImageImage *ImageContent `json:"imageImage"` // origin: oneof_embed, empath: image.image
-	CodeCode   *CodeContent  `json:"codeCode"`   // origin: oneof_embed, empath: code.code
-	// ContentCase indicates which variant of content oneof is set
-	ContentCase string `json:"content_case,omitempty"`
-}
-
-// IntoPlain converts protobuf message to plain struct
-func (pb *OneofShowcase) IntoPlain() *OneofShowcasePlain {
-	if pb == nil {
-		return nil
+	var err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
 	}
-	p := &OneofShowcasePlain{}
-
-	// Detect content oneof case
-	switch pb.Content.(type) {
-	case *OneofShowcase_Text:
-		p.ContentCase = "text"
-	case *OneofShowcase_Image:
-		p.ContentCase = "image"
-	case *OneofShowcase_Code:
-		p.ContentCase = "code"
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseStrVal {
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		b = protowire.AppendString(b, p.ScalarChoiceStrVal)
 	}
-
-	p.Id = pb.Id
-	// TextText from text.text
-	if pb.GetText() != nil {
-		p.TextText = pb.GetText()
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseIntVal {
+		b = protowire.AppendTag(b, 11, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.ScalarChoiceIntVal))
 	}
-	// ImageImage from image.image
-	if pb.GetImage() != nil {
-		p.ImageImage = pb.GetImage()
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseLongVal {
+		b = protowire.AppendTag(b, 12, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.ScalarChoiceLongVal))
 	}
-	// CodeCode from code.code
-	if pb.GetCode() != nil {
-		p.CodeCode = pb.GetCode()
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseDoubleVal {
+		b = protowire.AppendTag(b, 13, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(p.ScalarChoiceDoubleVal)))
 	}
-	return p
-}
-
-// IntoPb converts plain struct to protobuf message
-func (p *OneofShowcasePlain) IntoPb() *OneofShowcase {
-	if p == nil {
-		return nil
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseBoolVal {
+		b = protowire.AppendTag(b, 14, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(p.ScalarChoiceBoolVal))
 	}
-	pb := &OneofShowcase{}
-
-	pb.Id = p.Id
-	// TextText -> text.text
-	if p.TextText != nil && p.ContentCase == "text" {
-		pb.Content = &OneofShowcase_Text{Text: p.TextText}
+	if p.ScalarChoiceCase == OneofShowcaseScalarChoiceCaseBytesVal {
+		b = protowire.AppendTag(b, 15, protowire.BytesType)
+		b = protowire.AppendBytes(b, p.ScalarChoiceBytesVal)
 	}
-	// ImageImage -> image.image
-	if p.ImageImage != nil && p.ContentCase == "image" {
-		pb.Content = &OneofShowcase_Image{Image: p.ImageImage}
+	if p.MessageChoiceCase == OneofShowcaseMessageChoiceCaseAddress {
+		if p.MessageChoiceAddress != nil {
+			b = protowire.AppendTag(b, 20, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.MessageChoiceAddress); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-	// CodeCode -> code.code
-	if p.CodeCode != nil && p.ContentCase == "code" {
-		pb.Content = &OneofShowcase_Code{Code: p.CodeCode}
+	if p.MessageChoiceCase == OneofShowcaseMessageChoiceCaseContact {
+		if p.MessageChoiceContact != nil {
+			b = protowire.AppendTag(b, 21, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.MessageChoiceContact); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-	return pb
-}
-
-// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
-func (pb *OneofShowcase) IntoPlainReuse(p *OneofShowcasePlain) {
-	if pb == nil || p == nil {
-		return
+	if p.MessageChoiceCase == OneofShowcaseMessageChoiceCaseMetadata {
+		if p.MessageChoiceMetadata != nil {
+			b = protowire.AppendTag(b, 22, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.MessageChoiceMetadata); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-	// Reset before filling
-	p.Reset()
-
-	// Detect content oneof case
-	switch pb.Content.(type) {
-	case *OneofShowcase_Text:
-		p.ContentCase = "text"
-	case *OneofShowcase_Image:
-		p.ContentCase = "image"
-	case *OneofShowcase_Code:
-		p.ContentCase = "code"
+	if p.EnumChoiceCase == OneofShowcaseEnumChoiceCaseStatus {
+		b = protowire.AppendTag(b, 30, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.EnumChoiceStatus))
 	}
-
-	p.Id = pb.Id
-	// TextText from text.text
-	if pb.GetText() != nil {
-		p.TextText = pb.GetText()
+	if p.EnumChoiceCase == OneofShowcaseEnumChoiceCasePriority {
+		b = protowire.AppendTag(b, 31, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.EnumChoicePriority))
 	}
-	// ImageImage from image.image
-	if pb.GetImage() != nil {
-		p.ImageImage = pb.GetImage()
+	if p.EnumChoiceCase == OneofShowcaseEnumChoiceCaseError {
+		b = protowire.AppendTag(b, 32, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.EnumChoiceError))
 	}
-	// CodeCode from code.code
-	if pb.GetCode() != nil {
-		p.CodeCode = pb.GetCode()
+	if p.ContentCase == OneofShowcaseContentCaseText {
+		if p.ContentText != nil {
+			b = protowire.AppendTag(b, 40, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ContentText); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-}
-
-// MarshalJX encodes OneofShowcasePlain to JSON using jx.Encoder
-func (p *OneofShowcasePlain) MarshalJX(e *jx.Encoder) {
-	if p == nil {
-		e.Null()
-		return
+	if p.ContentCase == OneofShowcaseContentCaseImage {
+		if p.ContentImage != nil {
+			b = protowire.AppendTag(b, 41, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ContentImage); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-
-	e.ObjStart()
-
-	if p.ContentCase != "" {
-		e.FieldStart("content_case")
-		e.Str(p.ContentCase)
+	if p.ContentCase == OneofShowcaseContentCaseCode {
+		if p.ContentCode != nil {
+			b = protowire.AppendTag(b, 42, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ContentCode); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
 	}
-	if p.Id != "" {
-		e.FieldStart("id")
-		e.Str(p.Id)
+	if p.SourceTypeCase == OneofShowcaseSourceTypeCaseUrl {
+		b = protowire.AppendTag(b, 50, protowire.BytesType)
+		b = protowire.AppendString(b, p.SourceTypeUrl)
 	}
-	if p.TextText != nil {
-		e.FieldStart("textText")
-		p.TextText.MarshalJX(e)
+	if p.SourceTypeCase == OneofShowcaseSourceTypeCaseFilePath {
+		b = protowire.AppendTag(b, 51, protowire.BytesType)
+		b = protowire.AppendString(b, p.SourceTypeFilePath)
 	}
-	if p.ImageImage != nil {
-		e.FieldStart("imageImage")
-		p.ImageImage.MarshalJX(e)
+	if p.DestinationTypeCase == OneofShowcaseDestinationTypeCaseDestUrl {
+		b = protowire.AppendTag(b, 60, protowire.BytesType)
+		b = protowire.AppendString(b, p.DestinationTypeDestUrl)
 	}
-	if p.CodeCode != nil {
-		e.FieldStart("codeCode")
-		p.CodeCode.MarshalJX(e)
+	if p.DestinationTypeCase == OneofShowcaseDestinationTypeCaseDestPath {
+		b = protowire.AppendTag(b, 61, protowire.BytesType)
+		b = protowire.AppendString(b, p.DestinationTypeDestPath)
 	}
-	e.ObjEnd()
-}
-
-// MarshalJSON implements json.Marshaler using jx
-func (p *OneofShowcasePlain) MarshalJSON() ([]byte, error) {
-	e := jx.GetEncoder()
-	defer jx.PutEncoder(e)
-	p.MarshalJX(e)
-	return e.Bytes(), nil
+	return b, err
 }
 
-// UnmarshalJX decodes OneofShowcasePlain from JSON using jx.Decoder
-func (p *OneofShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
-	if p == nil {
-		return nil
-	}
-
-	return d.Obj(func(d *jx.Decoder, key string) error {
-		switch key {
-		case "content_case":
-			v, err := d.Str()
-			if err != nil {
+// UnmarshalProto decodes OneofShowcasePlain from the protobuf wire format of OneofShowcase.
+// Unknown fields are skipped.
+func (p *OneofShowcasePlain) UnmarshalProto(b []byte) error {
+	*p = OneofShowcasePlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 10 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseStrVal
+			p.ScalarChoiceStrVal = v
+		case num == 11 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseIntVal
+			p.ScalarChoiceIntVal = int32(v)
+		case num == 12 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseLongVal
+			p.ScalarChoiceLongVal = int64(v)
+		case num == 13 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseDoubleVal
+			p.ScalarChoiceDoubleVal = math.Float64frombits(v)
+		case num == 14 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseBoolVal
+			p.ScalarChoiceBoolVal = protowire.DecodeBool(v)
+		case num == 15 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ScalarChoiceCase = OneofShowcaseScalarChoiceCaseBytesVal
+			p.ScalarChoiceBytesVal = append([]byte{}, v...)
+		case num == 20 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.MessageChoiceCase = OneofShowcaseMessageChoiceCaseAddress
+			m := &Address{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.ContentCase = v
-		case "id":
-			v, err := d.Str()
-			if err != nil {
+			p.MessageChoiceAddress = m
+		case num == 21 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.MessageChoiceCase = OneofShowcaseMessageChoiceCaseContact
+			m := &ContactInfo{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-			p.Id = v
-		case "textText":
-			p.TextText = &TextContent{}
-			if err := p.TextText.UnmarshalJX(d); err != nil {
+			p.MessageChoiceContact = m
+		case num == 22 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.MessageChoiceCase = OneofShowcaseMessageChoiceCaseMetadata
+			m := &Metadata{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.MessageChoiceMetadata = m
+		case num == 30 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.EnumChoiceCase = OneofShowcaseEnumChoiceCaseStatus
+			p.EnumChoiceStatus = Status(v)
+		case num == 31 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.EnumChoiceCase = OneofShowcaseEnumChoiceCasePriority
+			p.EnumChoicePriority = Priority(v)
+		case num == 32 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.EnumChoiceCase = OneofShowcaseEnumChoiceCaseError
+			p.EnumChoiceError = ErrorCode(v)
+		case num == 40 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ContentCase = OneofShowcaseContentCaseText
+			m := &TextContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-		case "imageImage":
-			p.ImageImage = &ImageContent{}
-			if err := p.ImageImage.UnmarshalJX(d); err != nil {
+			p.ContentText = m
+		case num == 41 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ContentCase = OneofShowcaseContentCaseImage
+			m := &ImageContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
-		case "codeCode":
-			p.CodeCode = &CodeContent{}
-			if err := p.CodeCode.UnmarshalJX(d); err != nil {
+			p.ContentImage = m
+		case num == 42 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ContentCase = OneofShowcaseContentCaseCode
+			m := &CodeContent{}
+			if err := proto.Unmarshal(v, m); err != nil {
 				return err
 			}
+			p.ContentCode = m
+		case num == 50 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.SourceTypeCase = OneofShowcaseSourceTypeCaseUrl
+			p.SourceTypeUrl = v
+		case num == 51 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.SourceTypeCase = OneofShowcaseSourceTypeCaseFilePath
+			p.SourceTypeFilePath = v
+		case num == 60 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.DestinationTypeCase = OneofShowcaseDestinationTypeCaseDestUrl
+			p.DestinationTypeDestUrl = v
+		case num == 61 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.DestinationTypeCase = OneofShowcaseDestinationTypeCaseDestPath
+			p.DestinationTypeDestPath = v
 		default:
-			return d.Skip()
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
 		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// oneofShowcasePlainReflect describes OneofShowcasePlain as message full.plain.OneofShowcasePlain
+var oneofShowcasePlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "OneofShowcasePlain",
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *string { return &p.ScalarChoiceStrVal }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *int32 { return &p.ScalarChoiceIntVal }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *int64 { return &p.ScalarChoiceLongVal }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *float64 { return &p.ScalarChoiceDoubleVal }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *bool { return &p.ScalarChoiceBoolVal }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *[]byte { return &p.ScalarChoiceBytesVal }),
+	plainreflect.Message(func(p *OneofShowcasePlain) **Address { return &p.MessageChoiceAddress }),
+	plainreflect.Message(func(p *OneofShowcasePlain) **ContactInfo { return &p.MessageChoiceContact }),
+	plainreflect.Message(func(p *OneofShowcasePlain) **Metadata { return &p.MessageChoiceMetadata }),
+	plainreflect.Enum(func(p *OneofShowcasePlain) *Status { return &p.EnumChoiceStatus }),
+	plainreflect.Enum(func(p *OneofShowcasePlain) *Priority { return &p.EnumChoicePriority }),
+	plainreflect.Enum(func(p *OneofShowcasePlain) *ErrorCode { return &p.EnumChoiceError }),
+	plainreflect.Message(func(p *OneofShowcasePlain) **TextContent { return &p.ContentText }),
+	plainreflect.Message(func(p *OneofShowcasePlain) **ImageContent { return &p.ContentImage }),
+	plainreflect.Message(func(p *OneofShowcasePlain) **CodeContent { return &p.ContentCode }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *string { return &p.SourceTypeUrl }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *string { return &p.SourceTypeFilePath }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *string { return &p.DestinationTypeDestUrl }),
+	plainreflect.Scalar(func(p *OneofShowcasePlain) *string { return &p.DestinationTypeDestPath }),
+	plainreflect.Case(func(p *OneofShowcasePlain) *OneofShowcaseScalarChoiceCase { return &p.ScalarChoiceCase }),
+	plainreflect.Case(func(p *OneofShowcasePlain) *OneofShowcaseMessageChoiceCase { return &p.MessageChoiceCase }),
+	plainreflect.Case(func(p *OneofShowcasePlain) *OneofShowcaseEnumChoiceCase { return &p.EnumChoiceCase }),
+	plainreflect.Case(func(p *OneofShowcasePlain) *OneofShowcaseContentCase { return &p.ContentCase }),
+	plainreflect.Case(func(p *OneofShowcasePlain) *OneofShowcaseSourceTypeCase { return &p.SourceTypeCase }),
+	plainreflect.Case(func(p *OneofShowcasePlain) *OneofShowcaseDestinationTypeCase { return &p.DestinationTypeCase }),
+)
+
+// ProtoReflect returns the reflective view of OneofShowcasePlain backed by the struct
+func (p *OneofShowcasePlain) ProtoReflect() protoreflect.Message {
+	return oneofShowcasePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of OneofShowcasePlain
+func (p *OneofShowcasePlain) Clone() *OneofShowcasePlain {
+	if p == nil {
 		return nil
-	})
+	}
+	dst := &OneofShowcasePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of OneofShowcasePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetOneofShowcasePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *OneofShowcasePlain) CopyTo(dst *OneofShowcasePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.ScalarChoiceCase = p.ScalarChoiceCase
+	dst.MessageChoiceCase = p.MessageChoiceCase
+	dst.EnumChoiceCase = p.EnumChoiceCase
+	dst.ContentCase = p.ContentCase
+	dst.SourceTypeCase = p.SourceTypeCase
+	dst.DestinationTypeCase = p.DestinationTypeCase
+	dst.Id = p.Id
+	dst.ScalarChoiceStrVal = p.ScalarChoiceStrVal
+	dst.ScalarChoiceIntVal = p.ScalarChoiceIntVal
+	dst.ScalarChoiceLongVal = p.ScalarChoiceLongVal
+	dst.ScalarChoiceDoubleVal = p.ScalarChoiceDoubleVal
+	dst.ScalarChoiceBoolVal = p.ScalarChoiceBoolVal
+	dst.ScalarChoiceBytesVal = append(dst.ScalarChoiceBytesVal[:0], p.ScalarChoiceBytesVal...)
+	if p.MessageChoiceAddress == nil {
+		dst.MessageChoiceAddress = nil
+	} else {
+		if dst.MessageChoiceAddress == nil {
+			dst.MessageChoiceAddress = new(Address)
+		} else {
+			proto.Reset(dst.MessageChoiceAddress)
+		}
+		proto.Merge(dst.MessageChoiceAddress, p.MessageChoiceAddress)
+	}
+	if p.MessageChoiceContact == nil {
+		dst.MessageChoiceContact = nil
+	} else {
+		if dst.MessageChoiceContact == nil {
+			dst.MessageChoiceContact = new(ContactInfo)
+		} else {
+			proto.Reset(dst.MessageChoiceContact)
+		}
+		proto.Merge(dst.MessageChoiceContact, p.MessageChoiceContact)
+	}
+	if p.MessageChoiceMetadata == nil {
+		dst.MessageChoiceMetadata = nil
+	} else {
+		if dst.MessageChoiceMetadata == nil {
+			dst.MessageChoiceMetadata = new(Metadata)
+		} else {
+			proto.Reset(dst.MessageChoiceMetadata)
+		}
+		proto.Merge(dst.MessageChoiceMetadata, p.MessageChoiceMetadata)
+	}
+	dst.EnumChoiceStatus = p.EnumChoiceStatus
+	dst.EnumChoicePriority = p.EnumChoicePriority
+	dst.EnumChoiceError = p.EnumChoiceError
+	if p.ContentText == nil {
+		dst.ContentText = nil
+	} else {
+		if dst.ContentText == nil {
+			dst.ContentText = new(TextContent)
+		} else {
+			proto.Reset(dst.ContentText)
+		}
+		proto.Merge(dst.ContentText, p.ContentText)
+	}
+	if p.ContentImage == nil {
+		dst.ContentImage = nil
+	} else {
+		if dst.ContentImage == nil {
+			dst.ContentImage = new(ImageContent)
+		} else {
+			proto.Reset(dst.ContentImage)
+		}
+		proto.Merge(dst.ContentImage, p.ContentImage)
+	}
+	if p.ContentCode == nil {
+		dst.ContentCode = nil
+	} else {
+		if dst.ContentCode == nil {
+			dst.ContentCode = new(CodeContent)
+		} else {
+			proto.Reset(dst.ContentCode)
+		}
+		proto.Merge(dst.ContentCode, p.ContentCode)
+	}
+	dst.SourceTypeUrl = p.SourceTypeUrl
+	dst.SourceTypeFilePath = p.SourceTypeFilePath
+	dst.DestinationTypeDestUrl = p.DestinationTypeDestUrl
+	dst.DestinationTypeDestPath = p.DestinationTypeDestPath
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *OneofShowcasePlain) Equal(other *OneofShowcasePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.ScalarChoiceCase != other.ScalarChoiceCase {
+		return false
+	}
+	if p.MessageChoiceCase != other.MessageChoiceCase {
+		return false
+	}
+	if p.EnumChoiceCase != other.EnumChoiceCase {
+		return false
+	}
+	if p.ContentCase != other.ContentCase {
+		return false
+	}
+	if p.SourceTypeCase != other.SourceTypeCase {
+		return false
+	}
+	if p.DestinationTypeCase != other.DestinationTypeCase {
+		return false
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if p.ScalarChoiceStrVal != other.ScalarChoiceStrVal {
+		return false
+	}
+	if p.ScalarChoiceIntVal != other.ScalarChoiceIntVal {
+		return false
+	}
+	if p.ScalarChoiceLongVal != other.ScalarChoiceLongVal {
+		return false
+	}
+	if p.ScalarChoiceDoubleVal != other.ScalarChoiceDoubleVal {
+		return false
+	}
+	if p.ScalarChoiceBoolVal != other.ScalarChoiceBoolVal {
+		return false
+	}
+	if !bytes.Equal(p.ScalarChoiceBytesVal, other.ScalarChoiceBytesVal) {
+		return false
+	}
+	if !proto.Equal(p.MessageChoiceAddress, other.MessageChoiceAddress) {
+		return false
+	}
+	if !proto.Equal(p.MessageChoiceContact, other.MessageChoiceContact) {
+		return false
+	}
+	if !proto.Equal(p.MessageChoiceMetadata, other.MessageChoiceMetadata) {
+		return false
+	}
+	if p.EnumChoiceStatus != other.EnumChoiceStatus {
+		return false
+	}
+	if p.EnumChoicePriority != other.EnumChoicePriority {
+		return false
+	}
+	if p.EnumChoiceError != other.EnumChoiceError {
+		return false
+	}
+	if !proto.Equal(p.ContentText, other.ContentText) {
+		return false
+	}
+	if !proto.Equal(p.ContentImage, other.ContentImage) {
+		return false
+	}
+	if !proto.Equal(p.ContentCode, other.ContentCode) {
+		return false
+	}
+	if p.SourceTypeUrl != other.SourceTypeUrl {
+		return false
+	}
+	if p.SourceTypeFilePath != other.SourceTypeFilePath {
+		return false
+	}
+	if p.DestinationTypeDestUrl != other.DestinationTypeDestUrl {
+		return false
+	}
+	if p.DestinationTypeDestPath != other.DestinationTypeDestPath {
+		return false
+	}
+	return true
 }
 
-// UnmarshalJSON implements json.Unmarshaler using jx
-func (p *OneofShowcasePlain) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return p.UnmarshalJX(d)
+// Columns returns the column names of OneofShowcasePlain rows, in the order of ScanRow and Values
+func (p *OneofShowcasePlain) Columns() []string {
+	return []string{
+		"id",
+		"scalar_choice_str_val",
+		"scalar_choice_int_val",
+		"scalar_choice_long_val",
+		"scalar_choice_double_val",
+		"scalar_choice_bool_val",
+		"scalar_choice_bytes_val",
+		"message_choice_address",
+		"message_choice_contact",
+		"message_choice_metadata",
+		"enum_choice_status",
+		"enum_choice_priority",
+		"enum_choice_error",
+		"content_text",
+		"content_image",
+		"content_code",
+		"source_type_url",
+		"source_type_file_path",
+		"destination_type_dest_url",
+		"destination_type_dest_path",
+		"scalar_choice_case",
+		"message_choice_case",
+		"enum_choice_case",
+		"content_case",
+		"source_type_case",
+		"destination_type_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into OneofShowcasePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *OneofShowcasePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.ScalarChoiceStrVal,
+		&p.ScalarChoiceIntVal,
+		&p.ScalarChoiceLongVal,
+		&p.ScalarChoiceDoubleVal,
+		&p.ScalarChoiceBoolVal,
+		&p.ScalarChoiceBytesVal,
+		plainsql.JSON(&p.MessageChoiceAddress),
+		plainsql.JSON(&p.MessageChoiceContact),
+		plainsql.JSON(&p.MessageChoiceMetadata),
+		&p.EnumChoiceStatus,
+		&p.EnumChoicePriority,
+		&p.EnumChoiceError,
+		plainsql.JSON(&p.ContentText),
+		plainsql.JSON(&p.ContentImage),
+		plainsql.JSON(&p.ContentCode),
+		&p.SourceTypeUrl,
+		&p.SourceTypeFilePath,
+		&p.DestinationTypeDestUrl,
+		&p.DestinationTypeDestPath,
+		&p.ScalarChoiceCase,
+		&p.MessageChoiceCase,
+		&p.EnumChoiceCase,
+		&p.ContentCase,
+		&p.SourceTypeCase,
+		&p.DestinationTypeCase,
+	)
+}
+
+// Values returns the column values of OneofShowcasePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *OneofShowcasePlain) Values() []any {
+	return []any{
+		p.Id,
+		p.ScalarChoiceStrVal,
+		p.ScalarChoiceIntVal,
+		p.ScalarChoiceLongVal,
+		p.ScalarChoiceDoubleVal,
+		p.ScalarChoiceBoolVal,
+		p.ScalarChoiceBytesVal,
+		plainsql.JSON(&p.MessageChoiceAddress),
+		plainsql.JSON(&p.MessageChoiceContact),
+		plainsql.JSON(&p.MessageChoiceMetadata),
+		p.EnumChoiceStatus,
+		p.EnumChoicePriority,
+		p.EnumChoiceError,
+		plainsql.JSON(&p.ContentText),
+		plainsql.JSON(&p.ContentImage),
+		plainsql.JSON(&p.ContentCode),
+		p.SourceTypeUrl,
+		p.SourceTypeFilePath,
+		p.DestinationTypeDestUrl,
+		p.DestinationTypeDestPath,
+		p.ScalarChoiceCase,
+		p.MessageChoiceCase,
+		p.EnumChoiceCase,
+		p.ContentCase,
+		p.SourceTypeCase,
+		p.DestinationTypeCase,
+	}
 }
 
 // oneofShowcasePlainPool is a sync.Pool for OneofShowcasePlain objects
@@ -5452,11 +15669,32 @@ func (p *OneofShowcasePlain) Reset() {
 		return
 	}
 
+	p.ScalarChoiceCase = ""
+	p.MessageChoiceCase = ""
+	p.EnumChoiceCase = ""
 	p.ContentCase = ""
+	p.SourceTypeCase = ""
+	p.DestinationTypeCase = ""
 	p.Id = ""
-	p.TextText = nil
-	p.ImageImage = nil
-	p.CodeCode = nil
+	p.ScalarChoiceStrVal = ""
+	p.ScalarChoiceIntVal = 0
+	p.ScalarChoiceLongVal = 0
+	p.ScalarChoiceDoubleVal = 0
+	p.ScalarChoiceBoolVal = false
+	p.ScalarChoiceBytesVal = nil
+	p.MessageChoiceAddress = nil
+	p.MessageChoiceContact = nil
+	p.MessageChoiceMetadata = nil
+	p.EnumChoiceStatus = 0
+	p.EnumChoicePriority = 0
+	p.EnumChoiceError = 0
+	p.ContentText = nil
+	p.ContentImage = nil
+	p.ContentCode = nil
+	p.SourceTypeUrl = ""
+	p.SourceTypeFilePath = ""
+	p.DestinationTypeDestUrl = ""
+	p.DestinationTypeDestPath = ""
 }
 
 // This demonstrates oneof with embed=true where variants also have embed=true
@@ -5487,7 +15725,31 @@ type PlatformEventPlain struct {
 	NetworkEventBytesSent     int64             `json:"networkEventBytesSent"`     // origin: oneof_embed, empath: network_event.bytes_sent
 	NetworkEventBytesReceived int64             `json:"networkEventBytesReceived"` // origin: oneof_embed, empath: network_event.bytes_received
 	// PlatformEventCase indicates which variant of platform_event oneof is set
-	PlatformEventCase string `json:"platform_event_case,omitempty"`
+	PlatformEventCase PlatformEventPlatformEventCase `json:"platform_event_case,omitempty"`
+}
+
+// PlatformEventPlatformEventCase identifies the set variant of full.PlatformEvent.platform_event oneof, empty when none is set
+type PlatformEventPlatformEventCase string
+
+const (
+	PlatformEventPlatformEventCaseHeartbeat      PlatformEventPlatformEventCase = "heartbeat"
+	PlatformEventPlatformEventCaseProcessStarted PlatformEventPlatformEventCase = "process_started"
+	PlatformEventPlatformEventCaseProcessExited  PlatformEventPlatformEventCase = "process_exited"
+	PlatformEventPlatformEventCaseNetworkEvent   PlatformEventPlatformEventCase = "network_event"
+)
+
+// Valid reports whether c is one of the PlatformEventPlatformEventCase variants
+func (c PlatformEventPlatformEventCase) Valid() bool {
+	switch c {
+	case PlatformEventPlatformEventCaseHeartbeat, PlatformEventPlatformEventCaseProcessStarted, PlatformEventPlatformEventCaseProcessExited, PlatformEventPlatformEventCaseNetworkEvent:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c PlatformEventPlatformEventCase) String() string {
+	return string(c)
 }
 
 // IntoPlain converts protobuf message to plain struct
@@ -5500,13 +15762,13 @@ func (pb *PlatformEvent) IntoPlain() *PlatformEventPlain {
 	// Detect platform_event oneof case
 	switch pb.PlatformEvent.(type) {
 	case *PlatformEvent_Heartbeat:
-		p.PlatformEventCase = "heartbeat"
+		p.PlatformEventCase = PlatformEventPlatformEventCaseHeartbeat
 	case *PlatformEvent_ProcessStarted:
-		p.PlatformEventCase = "process_started"
+		p.PlatformEventCase = PlatformEventPlatformEventCaseProcessStarted
 	case *PlatformEvent_ProcessExited:
-		p.PlatformEventCase = "process_exited"
+		p.PlatformEventCase = PlatformEventPlatformEventCaseProcessExited
 	case *PlatformEvent_NetworkEvent:
-		p.PlatformEventCase = "network_event"
+		p.PlatformEventCase = PlatformEventPlatformEventCaseNetworkEvent
 	}
 
 	p.EventId = pb.EventId
@@ -5539,7 +15801,11 @@ func (pb *PlatformEvent) IntoPlain() *PlatformEventPlain {
 	}
 	// ProcessStartedArgs from process_started.args
 	if pb.GetProcessStarted() != nil {
-		p.ProcessStartedArgs = pb.GetProcessStarted().GetArgs()
+		if len(pb.GetProcessStarted().GetArgs()) > 0 {
+			p.ProcessStartedArgs = pb.GetProcessStarted().GetArgs()
+		} else {
+			p.ProcessStartedArgs = []string{}
+		}
 	}
 	// ProcessStartedStartTime from process_started.start_time
 	if pb.GetProcessStarted() != nil {
@@ -5600,126 +15866,126 @@ func (p *PlatformEventPlain) IntoPb() *PlatformEvent {
 	pb.Source = p.Source
 	pb.Labels = p.Labels
 	// HeartbeatTimestamp -> heartbeat.timestamp
-	if p.PlatformEventCase == "heartbeat" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseHeartbeat {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_Heartbeat); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_Heartbeat{Heartbeat: &Heartbeat{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_Heartbeat).Heartbeat.Timestamp = p.HeartbeatTimestamp
 	}
 	// HeartbeatNodeId -> heartbeat.node_id
-	if p.PlatformEventCase == "heartbeat" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseHeartbeat {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_Heartbeat); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_Heartbeat{Heartbeat: &Heartbeat{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_Heartbeat).Heartbeat.NodeId = p.HeartbeatNodeId
 	}
 	// HeartbeatCpuPercent -> heartbeat.cpu_percent
-	if p.PlatformEventCase == "heartbeat" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseHeartbeat {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_Heartbeat); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_Heartbeat{Heartbeat: &Heartbeat{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_Heartbeat).Heartbeat.CpuPercent = p.HeartbeatCpuPercent
 	}
 	// HeartbeatMemoryBytes -> heartbeat.memory_bytes
-	if p.PlatformEventCase == "heartbeat" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseHeartbeat {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_Heartbeat); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_Heartbeat{Heartbeat: &Heartbeat{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_Heartbeat).Heartbeat.MemoryBytes = p.HeartbeatMemoryBytes
 	}
 	// ProcessStartedProcessId -> process_started.process_id
-	if p.PlatformEventCase == "process_started" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseProcessStarted {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_ProcessStarted); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_ProcessStarted{ProcessStarted: &ProcessStarted{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_ProcessStarted).ProcessStarted.ProcessId = p.ProcessStartedProcessId
 	}
 	// ProcessStartedCommand -> process_started.command
-	if p.PlatformEventCase == "process_started" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseProcessStarted {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_ProcessStarted); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_ProcessStarted{ProcessStarted: &ProcessStarted{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_ProcessStarted).ProcessStarted.Command = p.ProcessStartedCommand
 	}
 	// ProcessStartedArgs -> process_started.args
-	if len(p.ProcessStartedArgs) > 0 && p.PlatformEventCase == "process_started" {
+	if len(p.ProcessStartedArgs) > 0 && p.PlatformEventCase == PlatformEventPlatformEventCaseProcessStarted {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_ProcessStarted); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_ProcessStarted{ProcessStarted: &ProcessStarted{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_ProcessStarted).ProcessStarted.Args = p.ProcessStartedArgs
 	}
 	// ProcessStartedStartTime -> process_started.start_time
-	if p.PlatformEventCase == "process_started" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseProcessStarted {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_ProcessStarted); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_ProcessStarted{ProcessStarted: &ProcessStarted{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_ProcessStarted).ProcessStarted.StartTime = p.ProcessStartedStartTime
 	}
 	// ProcessExitedProcessId -> process_exited.process_id
-	if p.PlatformEventCase == "process_exited" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseProcessExited {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_ProcessExited); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_ProcessExited{ProcessExited: &ProcessExited{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_ProcessExited).ProcessExited.ProcessId = p.ProcessExitedProcessId
 	}
 	// ProcessExitedExitCode -> process_exited.exit_code
-	if p.PlatformEventCase == "process_exited" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseProcessExited {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_ProcessExited); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_ProcessExited{ProcessExited: &ProcessExited{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_ProcessExited).ProcessExited.ExitCode = p.ProcessExitedExitCode
 	}
 	// ProcessExitedExitTime -> process_exited.exit_time
-	if p.PlatformEventCase == "process_exited" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseProcessExited {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_ProcessExited); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_ProcessExited{ProcessExited: &ProcessExited{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_ProcessExited).ProcessExited.ExitTime = p.ProcessExitedExitTime
 	}
 	// ProcessExitedSignal -> process_exited.signal
-	if p.PlatformEventCase == "process_exited" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseProcessExited {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_ProcessExited); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_ProcessExited{ProcessExited: &ProcessExited{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_ProcessExited).ProcessExited.Signal = p.ProcessExitedSignal
 	}
 	// NetworkEventInterfaceName -> network_event.interface_name
-	if p.PlatformEventCase == "network_event" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseNetworkEvent {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_NetworkEvent); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_NetworkEvent{NetworkEvent: &NetworkEvent{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_NetworkEvent).NetworkEvent.InterfaceName = p.NetworkEventInterfaceName
 	}
 	// NetworkEventRemoteAddr -> network_event.remote_addr
-	if p.PlatformEventCase == "network_event" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseNetworkEvent {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_NetworkEvent); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_NetworkEvent{NetworkEvent: &NetworkEvent{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_NetworkEvent).NetworkEvent.RemoteAddr = p.NetworkEventRemoteAddr
 	}
 	// NetworkEventRemotePort -> network_event.remote_port
-	if p.PlatformEventCase == "network_event" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseNetworkEvent {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_NetworkEvent); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_NetworkEvent{NetworkEvent: &NetworkEvent{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_NetworkEvent).NetworkEvent.RemotePort = p.NetworkEventRemotePort
 	}
 	// NetworkEventProtocol -> network_event.protocol
-	if p.PlatformEventCase == "network_event" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseNetworkEvent {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_NetworkEvent); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_NetworkEvent{NetworkEvent: &NetworkEvent{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_NetworkEvent).NetworkEvent.Protocol = p.NetworkEventProtocol
 	}
 	// NetworkEventBytesSent -> network_event.bytes_sent
-	if p.PlatformEventCase == "network_event" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseNetworkEvent {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_NetworkEvent); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_NetworkEvent{NetworkEvent: &NetworkEvent{}}
 		}
 		pb.PlatformEvent.(*PlatformEvent_NetworkEvent).NetworkEvent.BytesSent = p.NetworkEventBytesSent
 	}
 	// NetworkEventBytesReceived -> network_event.bytes_received
-	if p.PlatformEventCase == "network_event" {
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseNetworkEvent {
 		if _, ok := pb.PlatformEvent.(*PlatformEvent_NetworkEvent); !ok || pb.PlatformEvent == nil {
 			pb.PlatformEvent = &PlatformEvent_NetworkEvent{NetworkEvent: &NetworkEvent{}}
 		}
@@ -5728,6 +15994,101 @@ func (p *PlatformEventPlain) IntoPb() *PlatformEvent {
 	return pb
 }
 
+// platformEventPlainMergePaths are the fields of PlatformEvent covered by PlatformEventPlain
+var platformEventPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{20},
+	{10},
+	{11},
+	{12},
+	{13},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *PlatformEventPlain) IntoPbMerge(dst *PlatformEvent) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, platformEventPlainMergePaths...)
+}
+
+// platformEventPlainFieldPaths link the JSON names of PlatformEventPlain to field-mask paths of PlatformEvent
+var platformEventPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "eventId", Path: "event_id"},
+	{Name: "eventTime", Path: "event_time"},
+	{Name: "source", Path: "source"},
+	{Name: "labels", Path: "labels"},
+	{Name: "heartbeatTimestamp", Path: "heartbeat.timestamp"},
+	{Name: "heartbeatNodeId", Path: "heartbeat.node_id"},
+	{Name: "heartbeatCpuPercent", Path: "heartbeat.cpu_percent"},
+	{Name: "heartbeatMemoryBytes", Path: "heartbeat.memory_bytes"},
+	{Name: "processStartedProcessId", Path: "process_started.process_id"},
+	{Name: "processStartedCommand", Path: "process_started.command"},
+	{Name: "processStartedArgs", Path: "process_started.args"},
+	{Name: "processStartedStartTime", Path: "process_started.start_time"},
+	{Name: "processExitedProcessId", Path: "process_exited.process_id"},
+	{Name: "processExitedExitCode", Path: "process_exited.exit_code"},
+	{Name: "processExitedExitTime", Path: "process_exited.exit_time"},
+	{Name: "processExitedSignal", Path: "process_exited.signal"},
+	{Name: "networkEventInterfaceName", Path: "network_event.interface_name"},
+	{Name: "networkEventRemoteAddr", Path: "network_event.remote_addr"},
+	{Name: "networkEventRemotePort", Path: "network_event.remote_port"},
+	{Name: "networkEventProtocol", Path: "network_event.protocol"},
+	{Name: "networkEventBytesSent", Path: "network_event.bytes_sent"},
+	{Name: "networkEventBytesReceived", Path: "network_event.bytes_received"},
+}
+
+// PlatformEventPlainFieldMask builds a field mask of PlatformEvent from JSON names of PlatformEventPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func PlatformEventPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(platformEventPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// PlatformEventPlainFieldNames translates a field mask of PlatformEvent into JSON names of PlatformEventPlain.
+// A path to an embedded message yields all fields flattened from it.
+func PlatformEventPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(platformEventPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of PlatformEvent, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *PlatformEventPlain) ApplyToPb(pb *PlatformEvent, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), platformEventPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *PlatformEvent) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*PlatformEventPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), platformEventPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &PlatformEvent{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
 // IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
 func (pb *PlatformEvent) IntoPlainReuse(p *PlatformEventPlain) {
 	if pb == nil || p == nil {
@@ -5739,13 +16100,13 @@ func (pb *PlatformEvent) IntoPlainReuse(p *PlatformEventPlain) {
 	// Detect platform_event oneof case
 	switch pb.PlatformEvent.(type) {
 	case *PlatformEvent_Heartbeat:
-		p.PlatformEventCase = "heartbeat"
+		p.PlatformEventCase = PlatformEventPlatformEventCaseHeartbeat
 	case *PlatformEvent_ProcessStarted:
-		p.PlatformEventCase = "process_started"
+		p.PlatformEventCase = PlatformEventPlatformEventCaseProcessStarted
 	case *PlatformEvent_ProcessExited:
-		p.PlatformEventCase = "process_exited"
+		p.PlatformEventCase = PlatformEventPlatformEventCaseProcessExited
 	case *PlatformEvent_NetworkEvent:
-		p.PlatformEventCase = "network_event"
+		p.PlatformEventCase = PlatformEventPlatformEventCaseNetworkEvent
 	}
 
 	p.EventId = pb.EventId
@@ -5778,7 +16139,11 @@ func (pb *PlatformEvent) IntoPlainReuse(p *PlatformEventPlain) {
 	}
 	// ProcessStartedArgs from process_started.args
 	if pb.GetProcessStarted() != nil {
-		p.ProcessStartedArgs = pb.GetProcessStarted().GetArgs()
+		if len(pb.GetProcessStarted().GetArgs()) > 0 {
+			p.ProcessStartedArgs = pb.GetProcessStarted().GetArgs()
+		} else {
+			p.ProcessStartedArgs = []string{}
+		}
 	}
 	// ProcessStartedStartTime from process_started.start_time
 	if pb.GetProcessStarted() != nil {
@@ -5837,7 +16202,7 @@ func (p *PlatformEventPlain) MarshalJX(e *jx.Encoder) {
 
 	if p.PlatformEventCase != "" {
 		e.FieldStart("platform_event_case")
-		e.Str(p.PlatformEventCase)
+		e.Str(p.PlatformEventCase.String())
 	}
 	if p.EventId != "" {
 		e.FieldStart("eventId")
@@ -5958,7 +16323,10 @@ func (p *PlatformEventPlain) UnmarshalJX(d *jx.Decoder) error {
 			if err != nil {
 				return err
 			}
-			p.PlatformEventCase = v
+			p.PlatformEventCase = PlatformEventPlatformEventCase(v)
+			if v != "" && !p.PlatformEventCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.PlatformEvent.platform_event", v)
+			}
 		case "eventId":
 			v, err := d.Str()
 			if err != nil {
@@ -5981,14 +16349,16 @@ func (p *PlatformEventPlain) UnmarshalJX(d *jx.Decoder) error {
 			if p.Labels == nil {
 				p.Labels = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.Labels[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "heartbeatTimestamp":
 			v, err := d.Int64()
 			if err != nil {
@@ -6115,6 +16485,604 @@ func (p *PlatformEventPlain) UnmarshalJSON(data []byte) error {
 	return p.UnmarshalJX(d)
 }
 
+// MarshalProto encodes PlatformEventPlain in the protobuf wire format of PlatformEvent
+func (p *PlatformEventPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends PlatformEventPlain encoded in the protobuf wire format of PlatformEvent to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *PlatformEventPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.EventId; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.EventTime; v != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.Source; v != "" {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	for _, k := range plainwire.SortedKeys(p.Labels) {
+		v := p.Labels[k]
+		b = protowire.AppendTag(b, 20, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	// heartbeat (embedded)
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseHeartbeat {
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		_start := len(b)
+		if v := p.HeartbeatTimestamp; v != 0 {
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.HeartbeatNodeId; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.HeartbeatCpuPercent; v != 0 {
+			b = protowire.AppendTag(b, 3, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.HeartbeatMemoryBytes; v != 0 {
+			b = protowire.AppendTag(b, 4, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	// process_started (embedded)
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseProcessStarted {
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		_start := len(b)
+		if v := p.ProcessStartedProcessId; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.ProcessStartedCommand; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		for _, v := range p.ProcessStartedArgs {
+			b = protowire.AppendTag(b, 3, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.ProcessStartedStartTime; v != 0 {
+			b = protowire.AppendTag(b, 4, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	// process_exited (embedded)
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseProcessExited {
+		b = protowire.AppendTag(b, 12, protowire.BytesType)
+		_start := len(b)
+		if v := p.ProcessExitedProcessId; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.ProcessExitedExitCode; v != 0 {
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.ProcessExitedExitTime; v != 0 {
+			b = protowire.AppendTag(b, 3, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.ProcessExitedSignal; v != "" {
+			b = protowire.AppendTag(b, 4, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	// network_event (embedded)
+	if p.PlatformEventCase == PlatformEventPlatformEventCaseNetworkEvent {
+		b = protowire.AppendTag(b, 13, protowire.BytesType)
+		_start := len(b)
+		if v := p.NetworkEventInterfaceName; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.NetworkEventRemoteAddr; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.NetworkEventRemotePort; v != 0 {
+			b = protowire.AppendTag(b, 3, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.NetworkEventProtocol; v != "" {
+			b = protowire.AppendTag(b, 4, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.NetworkEventBytesSent; v != 0 {
+			b = protowire.AppendTag(b, 5, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.NetworkEventBytesReceived; v != 0 {
+			b = protowire.AppendTag(b, 6, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes PlatformEventPlain from the protobuf wire format of PlatformEvent.
+// Unknown fields are skipped.
+func (p *PlatformEventPlain) UnmarshalProto(b []byte) error {
+	*p = PlatformEventPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.EventId = v
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.EventTime = int64(v)
+		case num == 3 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Source = v
+		case num == 20 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Labels == nil {
+				p.Labels = make(map[string]string)
+			}
+			p.Labels[mk] = mv
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PlatformEventCase = PlatformEventPlatformEventCaseHeartbeat
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.HeartbeatTimestamp = int64(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.HeartbeatNodeId = v
+				case num == 3 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.HeartbeatCpuPercent = int32(v)
+				case num == 4 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.HeartbeatMemoryBytes = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PlatformEventCase = PlatformEventPlatformEventCaseProcessStarted
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.ProcessStartedProcessId = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.ProcessStartedCommand = v
+				case num == 3 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.ProcessStartedArgs = append(p.ProcessStartedArgs, v)
+				case num == 4 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ProcessStartedStartTime = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 12 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PlatformEventCase = PlatformEventPlatformEventCaseProcessExited
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.ProcessExitedProcessId = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ProcessExitedExitCode = int32(v)
+				case num == 3 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ProcessExitedExitTime = int64(v)
+				case num == 4 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.ProcessExitedSignal = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 13 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PlatformEventCase = PlatformEventPlatformEventCaseNetworkEvent
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.NetworkEventInterfaceName = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.NetworkEventRemoteAddr = v
+				case num == 3 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.NetworkEventRemotePort = int32(v)
+				case num == 4 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.NetworkEventProtocol = v
+				case num == 5 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.NetworkEventBytesSent = int64(v)
+				case num == 6 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.NetworkEventBytesReceived = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// platformEventPlainReflect describes PlatformEventPlain as message full.plain.PlatformEventPlain
+var platformEventPlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "PlatformEventPlain",
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.EventId }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int64 { return &p.EventTime }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.Source }),
+	plainreflect.Map(func(p *PlatformEventPlain) *map[string]string { return &p.Labels }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int64 { return &p.HeartbeatTimestamp }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.HeartbeatNodeId }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int32 { return &p.HeartbeatCpuPercent }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int64 { return &p.HeartbeatMemoryBytes }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.ProcessStartedProcessId }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.ProcessStartedCommand }),
+	plainreflect.List(func(p *PlatformEventPlain) *[]string { return &p.ProcessStartedArgs }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int64 { return &p.ProcessStartedStartTime }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.ProcessExitedProcessId }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int32 { return &p.ProcessExitedExitCode }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int64 { return &p.ProcessExitedExitTime }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.ProcessExitedSignal }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.NetworkEventInterfaceName }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.NetworkEventRemoteAddr }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int32 { return &p.NetworkEventRemotePort }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *string { return &p.NetworkEventProtocol }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int64 { return &p.NetworkEventBytesSent }),
+	plainreflect.Scalar(func(p *PlatformEventPlain) *int64 { return &p.NetworkEventBytesReceived }),
+	plainreflect.Case(func(p *PlatformEventPlain) *PlatformEventPlatformEventCase { return &p.PlatformEventCase }),
+)
+
+// ProtoReflect returns the reflective view of PlatformEventPlain backed by the struct
+func (p *PlatformEventPlain) ProtoReflect() protoreflect.Message {
+	return platformEventPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of PlatformEventPlain
+func (p *PlatformEventPlain) Clone() *PlatformEventPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &PlatformEventPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of PlatformEventPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetPlatformEventPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *PlatformEventPlain) CopyTo(dst *PlatformEventPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.PlatformEventCase = p.PlatformEventCase
+	dst.EventId = p.EventId
+	dst.EventTime = p.EventTime
+	dst.Source = p.Source
+	for k := range dst.Labels {
+		delete(dst.Labels, k)
+	}
+	if dst.Labels == nil && p.Labels != nil {
+		dst.Labels = make(map[string]string, len(p.Labels))
+	}
+	for k, v := range p.Labels {
+		dst.Labels[k] = v
+	}
+	dst.HeartbeatTimestamp = p.HeartbeatTimestamp
+	dst.HeartbeatNodeId = p.HeartbeatNodeId
+	dst.HeartbeatCpuPercent = p.HeartbeatCpuPercent
+	dst.HeartbeatMemoryBytes = p.HeartbeatMemoryBytes
+	dst.ProcessStartedProcessId = p.ProcessStartedProcessId
+	dst.ProcessStartedCommand = p.ProcessStartedCommand
+	dst.ProcessStartedArgs = append(dst.ProcessStartedArgs[:0], p.ProcessStartedArgs...)
+	dst.ProcessStartedStartTime = p.ProcessStartedStartTime
+	dst.ProcessExitedProcessId = p.ProcessExitedProcessId
+	dst.ProcessExitedExitCode = p.ProcessExitedExitCode
+	dst.ProcessExitedExitTime = p.ProcessExitedExitTime
+	dst.ProcessExitedSignal = p.ProcessExitedSignal
+	dst.NetworkEventInterfaceName = p.NetworkEventInterfaceName
+	dst.NetworkEventRemoteAddr = p.NetworkEventRemoteAddr
+	dst.NetworkEventRemotePort = p.NetworkEventRemotePort
+	dst.NetworkEventProtocol = p.NetworkEventProtocol
+	dst.NetworkEventBytesSent = p.NetworkEventBytesSent
+	dst.NetworkEventBytesReceived = p.NetworkEventBytesReceived
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *PlatformEventPlain) Equal(other *PlatformEventPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.PlatformEventCase != other.PlatformEventCase {
+		return false
+	}
+	if p.EventId != other.EventId {
+		return false
+	}
+	if p.EventTime != other.EventTime {
+		return false
+	}
+	if p.Source != other.Source {
+		return false
+	}
+	if !maps.Equal(p.Labels, other.Labels) {
+		return false
+	}
+	if p.HeartbeatTimestamp != other.HeartbeatTimestamp {
+		return false
+	}
+	if p.HeartbeatNodeId != other.HeartbeatNodeId {
+		return false
+	}
+	if p.HeartbeatCpuPercent != other.HeartbeatCpuPercent {
+		return false
+	}
+	if p.HeartbeatMemoryBytes != other.HeartbeatMemoryBytes {
+		return false
+	}
+	if p.ProcessStartedProcessId != other.ProcessStartedProcessId {
+		return false
+	}
+	if p.ProcessStartedCommand != other.ProcessStartedCommand {
+		return false
+	}
+	if !slices.Equal(p.ProcessStartedArgs, other.ProcessStartedArgs) {
+		return false
+	}
+	if p.ProcessStartedStartTime != other.ProcessStartedStartTime {
+		return false
+	}
+	if p.ProcessExitedProcessId != other.ProcessExitedProcessId {
+		return false
+	}
+	if p.ProcessExitedExitCode != other.ProcessExitedExitCode {
+		return false
+	}
+	if p.ProcessExitedExitTime != other.ProcessExitedExitTime {
+		return false
+	}
+	if p.ProcessExitedSignal != other.ProcessExitedSignal {
+		return false
+	}
+	if p.NetworkEventInterfaceName != other.NetworkEventInterfaceName {
+		return false
+	}
+	if p.NetworkEventRemoteAddr != other.NetworkEventRemoteAddr {
+		return false
+	}
+	if p.NetworkEventRemotePort != other.NetworkEventRemotePort {
+		return false
+	}
+	if p.NetworkEventProtocol != other.NetworkEventProtocol {
+		return false
+	}
+	if p.NetworkEventBytesSent != other.NetworkEventBytesSent {
+		return false
+	}
+	if p.NetworkEventBytesReceived != other.NetworkEventBytesReceived {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of PlatformEventPlain rows, in the order of ScanRow and Values
+func (p *PlatformEventPlain) Columns() []string {
+	return []string{
+		"event_id",
+		"event_time",
+		"source",
+		"labels",
+		"heartbeat_timestamp",
+		"heartbeat_node_id",
+		"heartbeat_cpu_percent",
+		"heartbeat_memory_bytes",
+		"process_started_process_id",
+		"process_started_command",
+		"process_started_args",
+		"process_started_start_time",
+		"process_exited_process_id",
+		"process_exited_exit_code",
+		"process_exited_exit_time",
+		"process_exited_signal",
+		"network_event_interface_name",
+		"network_event_remote_addr",
+		"network_event_remote_port",
+		"network_event_protocol",
+		"network_event_bytes_sent",
+		"network_event_bytes_received",
+		"platform_event_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into PlatformEventPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *PlatformEventPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.EventId,
+		&p.EventTime,
+		&p.Source,
+		plainsql.JSON(&p.Labels),
+		&p.HeartbeatTimestamp,
+		&p.HeartbeatNodeId,
+		&p.HeartbeatCpuPercent,
+		&p.HeartbeatMemoryBytes,
+		&p.ProcessStartedProcessId,
+		&p.ProcessStartedCommand,
+		plainsql.JSON(&p.ProcessStartedArgs),
+		&p.ProcessStartedStartTime,
+		&p.ProcessExitedProcessId,
+		&p.ProcessExitedExitCode,
+		&p.ProcessExitedExitTime,
+		&p.ProcessExitedSignal,
+		&p.NetworkEventInterfaceName,
+		&p.NetworkEventRemoteAddr,
+		&p.NetworkEventRemotePort,
+		&p.NetworkEventProtocol,
+		&p.NetworkEventBytesSent,
+		&p.NetworkEventBytesReceived,
+		&p.PlatformEventCase,
+	)
+}
+
+// Values returns the column values of PlatformEventPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *PlatformEventPlain) Values() []any {
+	return []any{
+		p.EventId,
+		p.EventTime,
+		p.Source,
+		plainsql.JSON(&p.Labels),
+		p.HeartbeatTimestamp,
+		p.HeartbeatNodeId,
+		p.HeartbeatCpuPercent,
+		p.HeartbeatMemoryBytes,
+		p.ProcessStartedProcessId,
+		p.ProcessStartedCommand,
+		plainsql.JSON(&p.ProcessStartedArgs),
+		p.ProcessStartedStartTime,
+		p.ProcessExitedProcessId,
+		p.ProcessExitedExitCode,
+		p.ProcessExitedExitTime,
+		p.ProcessExitedSignal,
+		p.NetworkEventInterfaceName,
+		p.NetworkEventRemoteAddr,
+		p.NetworkEventRemotePort,
+		p.NetworkEventProtocol,
+		p.NetworkEventBytesSent,
+		p.NetworkEventBytesReceived,
+		p.PlatformEventCase,
+	}
+}
+
 // platformEventPlainPool is a sync.Pool for PlatformEventPlain objects
 var platformEventPlainPool = sync.Pool{
 	New: func() interface{} {
@@ -6207,6 +17175,81 @@ func (p *DeprecatedShowcasePlain) IntoPb() *DeprecatedShowcase {
 	return pb
 }
 
+// deprecatedShowcasePlainMergePaths are the fields of DeprecatedShowcase covered by DeprecatedShowcasePlain
+var deprecatedShowcasePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *DeprecatedShowcasePlain) IntoPbMerge(dst *DeprecatedShowcase) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, deprecatedShowcasePlainMergePaths...)
+}
+
+// deprecatedShowcasePlainFieldPaths link the JSON names of DeprecatedShowcasePlain to field-mask paths of DeprecatedShowcase
+var deprecatedShowcasePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "name", Path: "name"},
+	{Name: "oldField", Path: "old_field"},
+	{Name: "legacyCount", Path: "legacy_count"},
+	{Name: "newField", Path: "new_field"},
+}
+
+// DeprecatedShowcasePlainFieldMask builds a field mask of DeprecatedShowcase from JSON names of DeprecatedShowcasePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func DeprecatedShowcasePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(deprecatedShowcasePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// DeprecatedShowcasePlainFieldNames translates a field mask of DeprecatedShowcase into JSON names of DeprecatedShowcasePlain.
+// A path to an embedded message yields all fields flattened from it.
+func DeprecatedShowcasePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(deprecatedShowcasePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of DeprecatedShowcase, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *DeprecatedShowcasePlain) ApplyToPb(pb *DeprecatedShowcase, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), deprecatedShowcasePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *DeprecatedShowcase) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*DeprecatedShowcasePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), deprecatedShowcasePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &DeprecatedShowcase{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
 // IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
 func (pb *DeprecatedShowcase) IntoPlainReuse(p *DeprecatedShowcasePlain) {
 	if pb == nil || p == nil {
@@ -6313,6 +17356,182 @@ func (p *DeprecatedShowcasePlain) UnmarshalJSON(data []byte) error {
 	return p.UnmarshalJX(d)
 }
 
+// MarshalProto encodes DeprecatedShowcasePlain in the protobuf wire format of DeprecatedShowcase
+func (p *DeprecatedShowcasePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends DeprecatedShowcasePlain encoded in the protobuf wire format of DeprecatedShowcase to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *DeprecatedShowcasePlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Name; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.OldField; v != "" {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.LegacyCount; v != 0 {
+		b = protowire.AppendTag(b, 4, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.NewField; v != "" {
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes DeprecatedShowcasePlain from the protobuf wire format of DeprecatedShowcase.
+// Unknown fields are skipped.
+func (p *DeprecatedShowcasePlain) UnmarshalProto(b []byte) error {
+	*p = DeprecatedShowcasePlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Name = v
+		case num == 3 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.OldField = v
+		case num == 4 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.LegacyCount = int32(v)
+		case num == 5 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.NewField = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// deprecatedShowcasePlainReflect describes DeprecatedShowcasePlain as message full.plain.DeprecatedShowcasePlain
+var deprecatedShowcasePlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "DeprecatedShowcasePlain",
+	plainreflect.Scalar(func(p *DeprecatedShowcasePlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *DeprecatedShowcasePlain) *string { return &p.Name }),
+	plainreflect.Scalar(func(p *DeprecatedShowcasePlain) *string { return &p.OldField }),
+	plainreflect.Scalar(func(p *DeprecatedShowcasePlain) *int32 { return &p.LegacyCount }),
+	plainreflect.Scalar(func(p *DeprecatedShowcasePlain) *string { return &p.NewField }),
+)
+
+// ProtoReflect returns the reflective view of DeprecatedShowcasePlain backed by the struct
+func (p *DeprecatedShowcasePlain) ProtoReflect() protoreflect.Message {
+	return deprecatedShowcasePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of DeprecatedShowcasePlain
+func (p *DeprecatedShowcasePlain) Clone() *DeprecatedShowcasePlain {
+	if p == nil {
+		return nil
+	}
+	dst := &DeprecatedShowcasePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of DeprecatedShowcasePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetDeprecatedShowcasePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *DeprecatedShowcasePlain) CopyTo(dst *DeprecatedShowcasePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Id = p.Id
+	dst.Name = p.Name
+	dst.OldField = p.OldField
+	dst.LegacyCount = p.LegacyCount
+	dst.NewField = p.NewField
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *DeprecatedShowcasePlain) Equal(other *DeprecatedShowcasePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.OldField != other.OldField {
+		return false
+	}
+	if p.LegacyCount != other.LegacyCount {
+		return false
+	}
+	if p.NewField != other.NewField {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of DeprecatedShowcasePlain rows, in the order of ScanRow and Values
+func (p *DeprecatedShowcasePlain) Columns() []string {
+	return []string{
+		"id",
+		"name",
+		"old_field",
+		"legacy_count",
+		"new_field",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into DeprecatedShowcasePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *DeprecatedShowcasePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Name,
+		&p.OldField,
+		&p.LegacyCount,
+		&p.NewField,
+	)
+}
+
+// Values returns the column values of DeprecatedShowcasePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *DeprecatedShowcasePlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Name,
+		p.OldField,
+		p.LegacyCount,
+		p.NewField,
+	}
+}
+
 // deprecatedShowcasePlainPool is a sync.Pool for DeprecatedShowcasePlain objects
 var deprecatedShowcasePlainPool = sync.Pool{
 	New: func() interface{} {
@@ -6375,8 +17594,16 @@ func (pb *DefaultsShowcase) IntoPlain() *DefaultsShowcasePlain {
 	p.FalseBool = pb.FalseBool
 	p.EmptyBytes = pb.EmptyBytes
 	p.ZeroEnum = pb.ZeroEnum
-	p.EmptyList = pb.EmptyList
-	p.EmptyIntList = pb.EmptyIntList
+	if len(pb.EmptyList) > 0 {
+		p.EmptyList = pb.EmptyList
+	} else {
+		p.EmptyList = []string{}
+	}
+	if len(pb.EmptyIntList) > 0 {
+		p.EmptyIntList = pb.EmptyIntList
+	} else {
+		p.EmptyIntList = []int32{}
+	}
 	p.EmptyMap = pb.EmptyMap
 	p.NilMessage = pb.NilMessage
 	return p
@@ -6403,6 +17630,93 @@ func (p *DefaultsShowcasePlain) IntoPb() *DefaultsShowcase {
 	return pb
 }
 
+// defaultsShowcasePlainMergePaths are the fields of DefaultsShowcase covered by DefaultsShowcasePlain
+var defaultsShowcasePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{7},
+	{10},
+	{11},
+	{20},
+	{30},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *DefaultsShowcasePlain) IntoPbMerge(dst *DefaultsShowcase) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, defaultsShowcasePlainMergePaths...)
+}
+
+// defaultsShowcasePlainFieldPaths link the JSON names of DefaultsShowcasePlain to field-mask paths of DefaultsShowcase
+var defaultsShowcasePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "emptyString", Path: "empty_string"},
+	{Name: "zeroInt", Path: "zero_int"},
+	{Name: "zeroLong", Path: "zero_long"},
+	{Name: "zeroDouble", Path: "zero_double"},
+	{Name: "falseBool", Path: "false_bool"},
+	{Name: "emptyBytes", Path: "empty_bytes"},
+	{Name: "zeroEnum", Path: "zero_enum"},
+	{Name: "emptyList", Path: "empty_list"},
+	{Name: "emptyIntList", Path: "empty_int_list"},
+	{Name: "emptyMap", Path: "empty_map"},
+	{Name: "nilMessage", Path: "nil_message"},
+}
+
+// DefaultsShowcasePlainFieldMask builds a field mask of DefaultsShowcase from JSON names of DefaultsShowcasePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func DefaultsShowcasePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(defaultsShowcasePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// DefaultsShowcasePlainFieldNames translates a field mask of DefaultsShowcase into JSON names of DefaultsShowcasePlain.
+// A path to an embedded message yields all fields flattened from it.
+func DefaultsShowcasePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(defaultsShowcasePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of DefaultsShowcase, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *DefaultsShowcasePlain) ApplyToPb(pb *DefaultsShowcase, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), defaultsShowcasePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *DefaultsShowcase) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*DefaultsShowcasePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), defaultsShowcasePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &DefaultsShowcase{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
 // IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
 func (pb *DefaultsShowcase) IntoPlainReuse(p *DefaultsShowcasePlain) {
 	if pb == nil || p == nil {
@@ -6418,8 +17732,16 @@ func (pb *DefaultsShowcase) IntoPlainReuse(p *DefaultsShowcasePlain) {
 	p.FalseBool = pb.FalseBool
 	p.EmptyBytes = pb.EmptyBytes
 	p.ZeroEnum = pb.ZeroEnum
-	p.EmptyList = pb.EmptyList
-	p.EmptyIntList = pb.EmptyIntList
+	if len(pb.EmptyList) > 0 {
+		p.EmptyList = pb.EmptyList
+	} else {
+		p.EmptyList = []string{}
+	}
+	if len(pb.EmptyIntList) > 0 {
+		p.EmptyIntList = pb.EmptyIntList
+	} else {
+		p.EmptyIntList = []int32{}
+	}
 	p.EmptyMap = pb.EmptyMap
 	p.NilMessage = pb.NilMessage
 }
@@ -6457,8 +17779,10 @@ func (p *DefaultsShowcasePlain) MarshalJX(e *jx.Encoder) {
 		e.FieldStart("emptyBytes")
 		e.Base64(p.EmptyBytes)
 	}
-	e.FieldStart("zeroEnum")
-	e.Int32(int32(p.ZeroEnum))
+	if p.ZeroEnum != 0 {
+		e.FieldStart("zeroEnum")
+		e.Int32(int32(p.ZeroEnum))
+	}
 	if len(p.EmptyList) > 0 {
 		e.FieldStart("emptyList")
 		e.ArrStart()
@@ -6542,7 +17866,7 @@ func (p *DefaultsShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
 			}
 			p.EmptyBytes = v
 		case "zeroEnum":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.Status", Status_value)
 			if err != nil {
 				return err
 			}
@@ -6573,14 +17897,16 @@ func (p *DefaultsShowcasePlain) UnmarshalJX(d *jx.Decoder) error {
 			if p.EmptyMap == nil {
 				p.EmptyMap = make(map[string]string)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				v, err := d.Str()
 				if err != nil {
 					return err
 				}
 				p.EmptyMap[key] = v
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "nilMessage":
 			p.NilMessage = &Address{}
 			if err := p.NilMessage.UnmarshalJX(d); err != nil {
@@ -6599,6 +17925,352 @@ func (p *DefaultsShowcasePlain) UnmarshalJSON(data []byte) error {
 	return p.UnmarshalJX(d)
 }
 
+// MarshalProto encodes DefaultsShowcasePlain in the protobuf wire format of DefaultsShowcase
+func (p *DefaultsShowcasePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends DefaultsShowcasePlain encoded in the protobuf wire format of DefaultsShowcase to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *DefaultsShowcasePlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.EmptyString; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.ZeroInt; v != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.ZeroLong; v != 0 {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.ZeroDouble; v != 0 || math.Signbit(float64(v)) {
+		b = protowire.AppendTag(b, 4, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+	}
+	if v := p.FalseBool; v {
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+	}
+	if v := p.EmptyBytes; len(v) > 0 {
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		b = protowire.AppendBytes(b, v)
+	}
+	if v := p.ZeroEnum; v != 0 {
+		b = protowire.AppendTag(b, 7, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	for _, v := range p.EmptyList {
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if len(p.EmptyIntList) > 0 {
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.EmptyIntList {
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.EmptyMap) {
+		v := p.EmptyMap[k]
+		b = protowire.AppendTag(b, 20, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.NilMessage != nil {
+		b = protowire.AppendTag(b, 30, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.NilMessage); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes DefaultsShowcasePlain from the protobuf wire format of DefaultsShowcase.
+// Unknown fields are skipped.
+func (p *DefaultsShowcasePlain) UnmarshalProto(b []byte) error {
+	*p = DefaultsShowcasePlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.EmptyString = v
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.ZeroInt = int32(v)
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.ZeroLong = int64(v)
+		case num == 4 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.ZeroDouble = math.Float64frombits(v)
+		case num == 5 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.FalseBool = protowire.DecodeBool(v)
+		case num == 6 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.EmptyBytes = append([]byte{}, v...)
+		case num == 7 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.ZeroEnum = Status(v)
+		case num == 10 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.EmptyList = append(p.EmptyList, v)
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.EmptyIntList = append(p.EmptyIntList, int32(x))
+			}
+		case num == 11 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.EmptyIntList = append(p.EmptyIntList, int32(v))
+		case num == 20 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.EmptyMap == nil {
+				p.EmptyMap = make(map[string]string)
+			}
+			p.EmptyMap[mk] = mv
+		case num == 30 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &Address{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.NilMessage = m
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// defaultsShowcasePlainReflect describes DefaultsShowcasePlain as message full.plain.DefaultsShowcasePlain
+var defaultsShowcasePlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "DefaultsShowcasePlain",
+	plainreflect.Scalar(func(p *DefaultsShowcasePlain) *string { return &p.EmptyString }),
+	plainreflect.Scalar(func(p *DefaultsShowcasePlain) *int32 { return &p.ZeroInt }),
+	plainreflect.Scalar(func(p *DefaultsShowcasePlain) *int64 { return &p.ZeroLong }),
+	plainreflect.Scalar(func(p *DefaultsShowcasePlain) *float64 { return &p.ZeroDouble }),
+	plainreflect.Scalar(func(p *DefaultsShowcasePlain) *bool { return &p.FalseBool }),
+	plainreflect.Scalar(func(p *DefaultsShowcasePlain) *[]byte { return &p.EmptyBytes }),
+	plainreflect.Enum(func(p *DefaultsShowcasePlain) *Status { return &p.ZeroEnum }),
+	plainreflect.List(func(p *DefaultsShowcasePlain) *[]string { return &p.EmptyList }),
+	plainreflect.List(func(p *DefaultsShowcasePlain) *[]int32 { return &p.EmptyIntList }),
+	plainreflect.Map(func(p *DefaultsShowcasePlain) *map[string]string { return &p.EmptyMap }),
+	plainreflect.Message(func(p *DefaultsShowcasePlain) **Address { return &p.NilMessage }),
+)
+
+// ProtoReflect returns the reflective view of DefaultsShowcasePlain backed by the struct
+func (p *DefaultsShowcasePlain) ProtoReflect() protoreflect.Message {
+	return defaultsShowcasePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of DefaultsShowcasePlain
+func (p *DefaultsShowcasePlain) Clone() *DefaultsShowcasePlain {
+	if p == nil {
+		return nil
+	}
+	dst := &DefaultsShowcasePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of DefaultsShowcasePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetDefaultsShowcasePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *DefaultsShowcasePlain) CopyTo(dst *DefaultsShowcasePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.EmptyString = p.EmptyString
+	dst.ZeroInt = p.ZeroInt
+	dst.ZeroLong = p.ZeroLong
+	dst.ZeroDouble = p.ZeroDouble
+	dst.FalseBool = p.FalseBool
+	dst.EmptyBytes = append(dst.EmptyBytes[:0], p.EmptyBytes...)
+	dst.ZeroEnum = p.ZeroEnum
+	dst.EmptyList = append(dst.EmptyList[:0], p.EmptyList...)
+	dst.EmptyIntList = append(dst.EmptyIntList[:0], p.EmptyIntList...)
+	for k := range dst.EmptyMap {
+		delete(dst.EmptyMap, k)
+	}
+	if dst.EmptyMap == nil && p.EmptyMap != nil {
+		dst.EmptyMap = make(map[string]string, len(p.EmptyMap))
+	}
+	for k, v := range p.EmptyMap {
+		dst.EmptyMap[k] = v
+	}
+	if p.NilMessage == nil {
+		dst.NilMessage = nil
+	} else {
+		if dst.NilMessage == nil {
+			dst.NilMessage = new(Address)
+		} else {
+			proto.Reset(dst.NilMessage)
+		}
+		proto.Merge(dst.NilMessage, p.NilMessage)
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *DefaultsShowcasePlain) Equal(other *DefaultsShowcasePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.EmptyString != other.EmptyString {
+		return false
+	}
+	if p.ZeroInt != other.ZeroInt {
+		return false
+	}
+	if p.ZeroLong != other.ZeroLong {
+		return false
+	}
+	if p.ZeroDouble != other.ZeroDouble {
+		return false
+	}
+	if p.FalseBool != other.FalseBool {
+		return false
+	}
+	if !bytes.Equal(p.EmptyBytes, other.EmptyBytes) {
+		return false
+	}
+	if p.ZeroEnum != other.ZeroEnum {
+		return false
+	}
+	if !slices.Equal(p.EmptyList, other.EmptyList) {
+		return false
+	}
+	if !slices.Equal(p.EmptyIntList, other.EmptyIntList) {
+		return false
+	}
+	if !maps.Equal(p.EmptyMap, other.EmptyMap) {
+		return false
+	}
+	if !proto.Equal(p.NilMessage, other.NilMessage) {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of DefaultsShowcasePlain rows, in the order of ScanRow and Values
+func (p *DefaultsShowcasePlain) Columns() []string {
+	return []string{
+		"empty_string",
+		"zero_int",
+		"zero_long",
+		"zero_double",
+		"false_bool",
+		"empty_bytes",
+		"zero_enum",
+		"empty_list",
+		"empty_int_list",
+		"empty_map",
+		"nil_message",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into DefaultsShowcasePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *DefaultsShowcasePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.EmptyString,
+		&p.ZeroInt,
+		&p.ZeroLong,
+		&p.ZeroDouble,
+		&p.FalseBool,
+		&p.EmptyBytes,
+		&p.ZeroEnum,
+		plainsql.JSON(&p.EmptyList),
+		plainsql.JSON(&p.EmptyIntList),
+		plainsql.JSON(&p.EmptyMap),
+		plainsql.JSON(&p.NilMessage),
+	)
+}
+
+// Values returns the column values of DefaultsShowcasePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *DefaultsShowcasePlain) Values() []any {
+	return []any{
+		p.EmptyString,
+		p.ZeroInt,
+		p.ZeroLong,
+		p.ZeroDouble,
+		p.FalseBool,
+		p.EmptyBytes,
+		p.ZeroEnum,
+		plainsql.JSON(&p.EmptyList),
+		plainsql.JSON(&p.EmptyIntList),
+		plainsql.JSON(&p.EmptyMap),
+		plainsql.JSON(&p.NilMessage),
+	}
+}
+
 // defaultsShowcasePlainPool is a sync.Pool for DefaultsShowcasePlain objects
 var defaultsShowcasePlainPool = sync.Pool{
 	New: func() interface{} {
@@ -6642,12 +18314,38 @@ func (p *DefaultsShowcasePlain) Reset() {
 }
 
 type ComplexNestedPlain struct {
-	Id            string                          `json:"id"`
-	Inner         *ComplexNested_Inner            `json:"inner"`
-	InnerList     []*ComplexNested_Inner          `json:"innerList"`
-	InnerMap      map[string]*ComplexNested_Inner `json:"innerMap"`
-	InnerEnum     ComplexNested_InnerEnum         `json:"innerEnum"`
-	InnerEnumList []ComplexNested_InnerEnum       `json:"innerEnumList"`
+	Id                 string                          `json:"id"`
+	Inner              *ComplexNested_Inner            `json:"inner"`
+	InnerList          []*ComplexNested_Inner          `json:"innerList"`
+	InnerMap           map[string]*ComplexNested_Inner `json:"innerMap"`
+	InnerEnum          ComplexNested_InnerEnum         `json:"innerEnum"`
+	InnerEnumList      []ComplexNested_InnerEnum       `json:"innerEnumList"`
+	ChoiceChoiceInner  *ComplexNested_Inner            `json:"choiceChoiceInner"`  // origin: oneof_embed, empath: choice.choice_inner
+	ChoiceChoiceString string                          `json:"choiceChoiceString"` // origin: oneof_embed, empath: choice.choice_string
+	// ChoiceCase indicates which variant of choice oneof is set
+	ChoiceCase ComplexNestedChoiceCase `json:"choice_case,omitempty"`
+}
+
+// ComplexNestedChoiceCase identifies the set variant of full.ComplexNested.choice oneof, empty when none is set
+type ComplexNestedChoiceCase string
+
+const (
+	ComplexNestedChoiceCaseChoiceInner  ComplexNestedChoiceCase = "choice_inner"
+	ComplexNestedChoiceCaseChoiceString ComplexNestedChoiceCase = "choice_string"
+)
+
+// Valid reports whether c is one of the ComplexNestedChoiceCase variants
+func (c ComplexNestedChoiceCase) Valid() bool {
+	switch c {
+	case ComplexNestedChoiceCaseChoiceInner, ComplexNestedChoiceCaseChoiceString:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c ComplexNestedChoiceCase) String() string {
+	return string(c)
 }
 
 // IntoPlain converts protobuf message to plain struct
@@ -6657,12 +18355,32 @@ func (pb *ComplexNested) IntoPlain() *ComplexNestedPlain {
 	}
 	p := &ComplexNestedPlain{}
 
+	// Detect choice oneof case
+	switch pb.Choice.(type) {
+	case *ComplexNested_ChoiceInner:
+		p.ChoiceCase = ComplexNestedChoiceCaseChoiceInner
+	case *ComplexNested_ChoiceString:
+		p.ChoiceCase = ComplexNestedChoiceCaseChoiceString
+	}
+
 	p.Id = pb.Id
 	p.Inner = pb.Inner
 	p.InnerList = pb.InnerList
 	p.InnerMap = pb.InnerMap
 	p.InnerEnum = pb.InnerEnum
-	p.InnerEnumList = pb.InnerEnumList
+	if len(pb.InnerEnumList) > 0 {
+		p.InnerEnumList = pb.InnerEnumList
+	} else {
+		p.InnerEnumList = []ComplexNested_InnerEnum{}
+	}
+	// ChoiceChoiceInner from choice.choice_inner
+	if pb.GetChoiceInner() != nil {
+		p.ChoiceChoiceInner = pb.GetChoiceInner()
+	}
+	// ChoiceChoiceString from choice.choice_string
+	if pb != nil {
+		p.ChoiceChoiceString = pb.GetChoiceString()
+	}
 	return p
 }
 
@@ -6679,9 +18397,98 @@ func (p *ComplexNestedPlain) IntoPb() *ComplexNested {
 	pb.InnerMap = p.InnerMap
 	pb.InnerEnum = p.InnerEnum
 	pb.InnerEnumList = p.InnerEnumList
+	// ChoiceChoiceInner -> choice.choice_inner
+	if p.ChoiceChoiceInner != nil && p.ChoiceCase == ComplexNestedChoiceCaseChoiceInner {
+		pb.Choice = &ComplexNested_ChoiceInner{ChoiceInner: p.ChoiceChoiceInner}
+	}
+	// ChoiceChoiceString -> choice.choice_string
+	if p.ChoiceCase == ComplexNestedChoiceCaseChoiceString {
+		pb.Choice = &ComplexNested_ChoiceString{ChoiceString: p.ChoiceChoiceString}
+	}
 	return pb
 }
 
+// complexNestedPlainMergePaths are the fields of ComplexNested covered by ComplexNestedPlain
+var complexNestedPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{10},
+	{11},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *ComplexNestedPlain) IntoPbMerge(dst *ComplexNested) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, complexNestedPlainMergePaths...)
+}
+
+// complexNestedPlainFieldPaths link the JSON names of ComplexNestedPlain to field-mask paths of ComplexNested
+var complexNestedPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "inner", Path: "inner"},
+	{Name: "innerList", Path: "inner_list"},
+	{Name: "innerMap", Path: "inner_map"},
+	{Name: "innerEnum", Path: "inner_enum"},
+	{Name: "innerEnumList", Path: "inner_enum_list"},
+	{Name: "choiceChoiceInner", Path: "choice_inner"},
+	{Name: "choiceChoiceString", Path: "choice_string"},
+}
+
+// ComplexNestedPlainFieldMask builds a field mask of ComplexNested from JSON names of ComplexNestedPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func ComplexNestedPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(complexNestedPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// ComplexNestedPlainFieldNames translates a field mask of ComplexNested into JSON names of ComplexNestedPlain.
+// A path to an embedded message yields all fields flattened from it.
+func ComplexNestedPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(complexNestedPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of ComplexNested, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *ComplexNestedPlain) ApplyToPb(pb *ComplexNested, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), complexNestedPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *ComplexNested) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*ComplexNestedPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), complexNestedPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &ComplexNested{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
 // IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
 func (pb *ComplexNested) IntoPlainReuse(p *ComplexNestedPlain) {
 	if pb == nil || p == nil {
@@ -6690,12 +18497,32 @@ func (pb *ComplexNested) IntoPlainReuse(p *ComplexNestedPlain) {
 	// Reset before filling
 	p.Reset()
 
+	// Detect choice oneof case
+	switch pb.Choice.(type) {
+	case *ComplexNested_ChoiceInner:
+		p.ChoiceCase = ComplexNestedChoiceCaseChoiceInner
+	case *ComplexNested_ChoiceString:
+		p.ChoiceCase = ComplexNestedChoiceCaseChoiceString
+	}
+
 	p.Id = pb.Id
 	p.Inner = pb.Inner
 	p.InnerList = pb.InnerList
 	p.InnerMap = pb.InnerMap
 	p.InnerEnum = pb.InnerEnum
-	p.InnerEnumList = pb.InnerEnumList
+	if len(pb.InnerEnumList) > 0 {
+		p.InnerEnumList = pb.InnerEnumList
+	} else {
+		p.InnerEnumList = []ComplexNested_InnerEnum{}
+	}
+	// ChoiceChoiceInner from choice.choice_inner
+	if pb.GetChoiceInner() != nil {
+		p.ChoiceChoiceInner = pb.GetChoiceInner()
+	}
+	// ChoiceChoiceString from choice.choice_string
+	if pb != nil {
+		p.ChoiceChoiceString = pb.GetChoiceString()
+	}
 }
 
 // MarshalJX encodes ComplexNestedPlain to JSON using jx.Encoder
@@ -6707,6 +18534,10 @@ func (p *ComplexNestedPlain) MarshalJX(e *jx.Encoder) {
 
 	e.ObjStart()
 
+	if p.ChoiceCase != "" {
+		e.FieldStart("choice_case")
+		e.Str(p.ChoiceCase.String())
+	}
 	if p.Id != "" {
 		e.FieldStart("id")
 		e.Str(p.Id)
@@ -6730,8 +18561,10 @@ func (p *ComplexNestedPlain) MarshalJX(e *jx.Encoder) {
 		v.MarshalJX(e)
 	}
 	e.ObjEnd()
-	e.FieldStart("innerEnum")
-	e.Int32(int32(p.InnerEnum))
+	if p.InnerEnum != 0 {
+		e.FieldStart("innerEnum")
+		e.Int32(int32(p.InnerEnum))
+	}
 	if len(p.InnerEnumList) > 0 {
 		e.FieldStart("innerEnumList")
 		e.ArrStart()
@@ -6740,6 +18573,14 @@ func (p *ComplexNestedPlain) MarshalJX(e *jx.Encoder) {
 		}
 		e.ArrEnd()
 	}
+	if p.ChoiceChoiceInner != nil {
+		e.FieldStart("choiceChoiceInner")
+		p.ChoiceChoiceInner.MarshalJX(e)
+	}
+	if p.ChoiceChoiceString != "" {
+		e.FieldStart("choiceChoiceString")
+		e.Str(p.ChoiceChoiceString)
+	}
 	e.ObjEnd()
 }
 
@@ -6759,6 +18600,15 @@ func (p *ComplexNestedPlain) UnmarshalJX(d *jx.Decoder) error {
 
 	return d.Obj(func(d *jx.Decoder, key string) error {
 		switch key {
+		case "choice_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ChoiceCase = ComplexNestedChoiceCase(v)
+			if v != "" && !p.ChoiceCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.ComplexNested.choice", v)
+			}
 		case "id":
 			v, err := d.Str()
 			if err != nil {
@@ -6785,22 +18635,24 @@ func (p *ComplexNestedPlain) UnmarshalJX(d *jx.Decoder) error {
 			if p.InnerMap == nil {
 				p.InnerMap = make(map[string]*ComplexNested_Inner)
 			}
-			return d.Obj(func(d *jx.Decoder, key string) error {
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
 				p.InnerMap[key] = &ComplexNested_Inner{}
 				if err := p.InnerMap[key].UnmarshalJX(d); err != nil {
 					return err
 				}
 				return nil
-			})
+			}); err != nil {
+				return err
+			}
 		case "innerEnum":
-			v, err := d.Int32()
+			v, err := enumjx.Decode(d, "full.ComplexNested.InnerEnum", ComplexNested_InnerEnum_value)
 			if err != nil {
 				return err
 			}
 			p.InnerEnum = ComplexNested_InnerEnum(v)
 		case "innerEnumList":
 			if err := d.Arr(func(d *jx.Decoder) error {
-				v, err := d.Int32()
+				v, err := enumjx.Decode(d, "full.ComplexNested.InnerEnum", ComplexNested_InnerEnum_value)
 				if err != nil {
 					return err
 				}
@@ -6809,6 +18661,17 @@ func (p *ComplexNestedPlain) UnmarshalJX(d *jx.Decoder) error {
 			}); err != nil {
 				return err
 			}
+		case "choiceChoiceInner":
+			p.ChoiceChoiceInner = &ComplexNested_Inner{}
+			if err := p.ChoiceChoiceInner.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "choiceChoiceString":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ChoiceChoiceString = v
 		default:
 			return d.Skip()
 		}
@@ -6822,6 +18685,387 @@ func (p *ComplexNestedPlain) UnmarshalJSON(data []byte) error {
 	return p.UnmarshalJX(d)
 }
 
+// MarshalProto encodes ComplexNestedPlain in the protobuf wire format of ComplexNested
+func (p *ComplexNestedPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends ComplexNestedPlain encoded in the protobuf wire format of ComplexNested to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *ComplexNestedPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if p.Inner != nil {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Inner); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, m := range p.InnerList {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.InnerMap) {
+		v := p.InnerMap[k]
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_value := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, v); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _value)
+		b = plainwire.FinishLen(b, _start)
+	}
+	if v := p.InnerEnum; v != 0 {
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if len(p.InnerEnumList) > 0 {
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.InnerEnumList {
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.ChoiceCase == ComplexNestedChoiceCaseChoiceInner {
+		if p.ChoiceChoiceInner != nil {
+			b = protowire.AppendTag(b, 10, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.ChoiceChoiceInner); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.ChoiceCase == ComplexNestedChoiceCaseChoiceString {
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		b = protowire.AppendString(b, p.ChoiceChoiceString)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes ComplexNestedPlain from the protobuf wire format of ComplexNested.
+// Unknown fields are skipped.
+func (p *ComplexNestedPlain) UnmarshalProto(b []byte) error {
+	*p = ComplexNestedPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &ComplexNested_Inner{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Inner = m
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &ComplexNested_Inner{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.InnerList = append(p.InnerList, m)
+		case num == 4 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			mv := &ComplexNested_Inner{}
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					if err := proto.Unmarshal(v, mv); err != nil {
+						return err
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.InnerMap == nil {
+				p.InnerMap = make(map[string]*ComplexNested_Inner)
+			}
+			p.InnerMap[mk] = mv
+		case num == 5 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.InnerEnum = ComplexNested_InnerEnum(v)
+		case num == 6 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.InnerEnumList = append(p.InnerEnumList, ComplexNested_InnerEnum(x))
+			}
+		case num == 6 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.InnerEnumList = append(p.InnerEnumList, ComplexNested_InnerEnum(v))
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.ChoiceCase = ComplexNestedChoiceCaseChoiceInner
+			m := &ComplexNested_Inner{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.ChoiceChoiceInner = m
+		case num == 11 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.ChoiceCase = ComplexNestedChoiceCaseChoiceString
+			p.ChoiceChoiceString = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// complexNestedPlainReflect describes ComplexNestedPlain as message full.plain.ComplexNestedPlain
+var complexNestedPlainReflect = plainreflect.NewMessageInfo(file_test_full_showcase_proto_plain, "ComplexNestedPlain",
+	plainreflect.Scalar(func(p *ComplexNestedPlain) *string { return &p.Id }),
+	plainreflect.Message(func(p *ComplexNestedPlain) **ComplexNested_Inner { return &p.Inner }),
+	plainreflect.MessageList(func(p *ComplexNestedPlain) *[]*ComplexNested_Inner { return &p.InnerList }),
+	plainreflect.MessageMap(func(p *ComplexNestedPlain) *map[string]*ComplexNested_Inner { return &p.InnerMap }),
+	plainreflect.Enum(func(p *ComplexNestedPlain) *ComplexNested_InnerEnum { return &p.InnerEnum }),
+	plainreflect.EnumList(func(p *ComplexNestedPlain) *[]ComplexNested_InnerEnum { return &p.InnerEnumList }),
+	plainreflect.Message(func(p *ComplexNestedPlain) **ComplexNested_Inner { return &p.ChoiceChoiceInner }),
+	plainreflect.Scalar(func(p *ComplexNestedPlain) *string { return &p.ChoiceChoiceString }),
+	plainreflect.Case(func(p *ComplexNestedPlain) *ComplexNestedChoiceCase { return &p.ChoiceCase }),
+)
+
+// ProtoReflect returns the reflective view of ComplexNestedPlain backed by the struct
+func (p *ComplexNestedPlain) ProtoReflect() protoreflect.Message {
+	return complexNestedPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of ComplexNestedPlain
+func (p *ComplexNestedPlain) Clone() *ComplexNestedPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &ComplexNestedPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of ComplexNestedPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetComplexNestedPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *ComplexNestedPlain) CopyTo(dst *ComplexNestedPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.ChoiceCase = p.ChoiceCase
+	dst.Id = p.Id
+	if p.Inner == nil {
+		dst.Inner = nil
+	} else {
+		if dst.Inner == nil {
+			dst.Inner = new(ComplexNested_Inner)
+		} else {
+			proto.Reset(dst.Inner)
+		}
+		proto.Merge(dst.Inner, p.Inner)
+	}
+	if cap(dst.InnerList) < len(p.InnerList) {
+		dst.InnerList = append(dst.InnerList[:cap(dst.InnerList)], make([]*ComplexNested_Inner, len(p.InnerList)-cap(dst.InnerList))...)
+	} else {
+		dst.InnerList = dst.InnerList[:len(p.InnerList)]
+	}
+	for i, v := range p.InnerList {
+		if v == nil {
+			dst.InnerList[i] = nil
+			continue
+		}
+		if dst.InnerList[i] == nil {
+			dst.InnerList[i] = new(ComplexNested_Inner)
+		} else {
+			proto.Reset(dst.InnerList[i])
+		}
+		proto.Merge(dst.InnerList[i], v)
+	}
+	for k := range dst.InnerMap {
+		if _, ok := p.InnerMap[k]; !ok {
+			delete(dst.InnerMap, k)
+		}
+	}
+	if dst.InnerMap == nil && p.InnerMap != nil {
+		dst.InnerMap = make(map[string]*ComplexNested_Inner, len(p.InnerMap))
+	}
+	for k, v := range p.InnerMap {
+		if v == nil {
+			dst.InnerMap[k] = nil
+			continue
+		}
+		d := dst.InnerMap[k]
+		if d == nil {
+			d = new(ComplexNested_Inner)
+		} else {
+			proto.Reset(d)
+		}
+		proto.Merge(d, v)
+		dst.InnerMap[k] = d
+	}
+	dst.InnerEnum = p.InnerEnum
+	dst.InnerEnumList = append(dst.InnerEnumList[:0], p.InnerEnumList...)
+	if p.ChoiceChoiceInner == nil {
+		dst.ChoiceChoiceInner = nil
+	} else {
+		if dst.ChoiceChoiceInner == nil {
+			dst.ChoiceChoiceInner = new(ComplexNested_Inner)
+		} else {
+			proto.Reset(dst.ChoiceChoiceInner)
+		}
+		proto.Merge(dst.ChoiceChoiceInner, p.ChoiceChoiceInner)
+	}
+	dst.ChoiceChoiceString = p.ChoiceChoiceString
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *ComplexNestedPlain) Equal(other *ComplexNestedPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.ChoiceCase != other.ChoiceCase {
+		return false
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if !proto.Equal(p.Inner, other.Inner) {
+		return false
+	}
+	if len(p.InnerList) != len(other.InnerList) {
+		return false
+	}
+	for i := range p.InnerList {
+		if !proto.Equal(p.InnerList[i], other.InnerList[i]) {
+			return false
+		}
+	}
+	if len(p.InnerMap) != len(other.InnerMap) {
+		return false
+	}
+	for k, v := range p.InnerMap {
+		w, ok := other.InnerMap[k]
+		if !ok || !proto.Equal(v, w) {
+			return false
+		}
+	}
+	if p.InnerEnum != other.InnerEnum {
+		return false
+	}
+	if !slices.Equal(p.InnerEnumList, other.InnerEnumList) {
+		return false
+	}
+	if !proto.Equal(p.ChoiceChoiceInner, other.ChoiceChoiceInner) {
+		return false
+	}
+	if p.ChoiceChoiceString != other.ChoiceChoiceString {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of ComplexNestedPlain rows, in the order of ScanRow and Values
+func (p *ComplexNestedPlain) Columns() []string {
+	return []string{
+		"id",
+		"inner",
+		"inner_list",
+		"inner_map",
+		"inner_enum",
+		"inner_enum_list",
+		"choice_choice_inner",
+		"choice_choice_string",
+		"choice_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into ComplexNestedPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *ComplexNestedPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		plainsql.JSON(&p.Inner),
+		plainsql.JSON(&p.InnerList),
+		plainsql.JSON(&p.InnerMap),
+		&p.InnerEnum,
+		plainsql.JSON(&p.InnerEnumList),
+		plainsql.JSON(&p.ChoiceChoiceInner),
+		&p.ChoiceChoiceString,
+		&p.ChoiceCase,
+	)
+}
+
+// Values returns the column values of ComplexNestedPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *ComplexNestedPlain) Values() []any {
+	return []any{
+		p.Id,
+		plainsql.JSON(&p.Inner),
+		plainsql.JSON(&p.InnerList),
+		plainsql.JSON(&p.InnerMap),
+		p.InnerEnum,
+		plainsql.JSON(&p.InnerEnumList),
+		plainsql.JSON(&p.ChoiceChoiceInner),
+		p.ChoiceChoiceString,
+		p.ChoiceCase,
+	}
+}
+
 // complexNestedPlainPool is a sync.Pool for ComplexNestedPlain objects
 var complexNestedPlainPool = sync.Pool{
 	New: func() interface{} {
@@ -6849,6 +19093,7 @@ func (p *ComplexNestedPlain) Reset() {
 		return
 	}
 
+	p.ChoiceCase = ""
 	p.Id = ""
 	p.Inner = nil
 	p.InnerList = nil
@@ -6857,4 +19102,295 @@ func (p *ComplexNestedPlain) Reset() {
 	}
 	p.InnerEnum = 0
 	p.InnerEnumList = p.InnerEnumList[:0]
+	p.ChoiceChoiceInner = nil
+	p.ChoiceChoiceString = ""
+}
+
+// file_test_full_showcase_proto_plain_rawDesc is the serialized descriptor of test/full/showcase_plain.proto,
+// describing the Plain messages of test/full/showcase.proto in package full.plain
+const file_test_full_showcase_proto_plain_rawDesc = "" +
+	"\n\x1etest/full/showcase_plain.proto\x12\nfull.plain\x1a\x19google/protobuf/an" +
+	"y.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.p" +
+	"roto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.pr" +
+	"oto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x18test/full/showcase.proto\"\xa8\x01" +
+	"\n\fMetricsPlain\x12%\n\x0etimestamp_unix\x18\x02 \x01(\x03R\rtimestampUnix\x12'\n\x0fbytes_p" +
+	"rocessed\x18\x03 \x01(\x03R\x0ebytesProcessed\x12%\n\x0erequests_count\x18\x04 \x01(\x05R\rrequests" +
+	"Count\x12!\n\fsuccess_rate\x18\x05 \x01(\x01R\vsuccessRate\"R\n\x10CustomTypesPlain\x12\x12\n\x04" +
+	"name\x18\x02 \x01(\tR\x04name\x12\x14\n\x05count\x18\x03 \x01(\x03R\x05count\x12\x14\n\x05label\x18\x04 \x01(\tR\x05label\"\xc5\n\n" +
+	"\rDocumentPlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n\x05title\x18\x02 \x01(\tR\x05title\x12$\n\x06status\x18\x03" +
+	" \x01(\x0e2\f.full.StatusR\x06status\x12*\n\bpriority\x18\x04 \x01(\x0e2\x0e.full.PriorityR\bpr" +
+	"iority\x12 \n\vdescription\x18\x05 \x01(\tR\vdescription\x12\x18\n\aversion\x18\x06 \x01(\x03R\aversi" +
+	"on\x12\x1b\n\tis_public\x18\a \x01(\bR\bisPublic\x12\x14\n\x05email\x18\b \x01(\tR\x05email\x12\x14\n\x05phone\x18\t" +
+	" \x01(\tR\x05phone\x12'\n\aaddress\x18\n \x01(\v2\r.full.AddressR\aaddress\x12*\n\bmetadata" +
+	"\x18\v \x01(\v2\x0e.full.MetadataR\bmetadata\x12 \n\vperformance\x18\f \x01(\fR\vperforman" +
+	"ce\x12\x1a\n\bkeywords\x18\r \x03(\tR\bkeywords\x12I\n\nattributes\x18\x0e \x03(\v2).full.plain." +
+	"DocumentPlain.AttributesEntryR\nattributes\x12+\n\tlocations\x18\x0f \x03(\v2\r.f" +
+	"ull.AddressR\tlocations\x12*\n\tstructure\x18\x10 \x01(\v2\f.full.Level1R\tstructu" +
+	"re\x125\n\bchildren\x18\x11 \x03(\v2\x19.full.plain.DocumentPlainR\bchildren\x121\n\x06par" +
+	"ent\x18\x12 \x01(\v2\x19.full.plain.DocumentPlainR\x06parent\x12C\n\x14content_text_con" +
+	"tent\x18\x13 \x01(\v2\x11.full.TextContentR\x12contentTextContent\x12F\n\x15content_ima" +
+	"ge_content\x18\x14 \x01(\v2\x12.full.ImageContentR\x13contentImageContent\x12F\n\x15con" +
+	"tent_video_content\x18\x15 \x01(\v2\x12.full.VideoContentR\x13contentVideoConten" +
+	"t\x12C\n\x14content_code_content\x18\x16 \x01(\v2\x11.full.CodeContentR\x12contentCodeC" +
+	"ontent\x12F\n\x15content_table_content\x18\x17 \x01(\v2\x12.full.TableContentR\x13conte" +
+	"ntTableContent\x12\x1d\n\nsource_url\x18\x18 \x01(\tR\tsourceUrl\x12(\n\x10source_file_pat" +
+	"h\x18\x19 \x01(\tR\x0esourceFilePath\x12&\n\x0fsource_raw_data\x18\x1a \x01(\fR\rsourceRawData\x12" +
+	"#\n\rcomputed_hash\x18\x1b \x01(\tR\fcomputedHash\x12\x19\n\bis_valid\x18\x1c \x01(\bR\aisValid\x12" +
+	"\"\n\fcontent_case\x18\x1d \x01(\tR\fcontent_case\x12 \n\vsource_case\x18\x1e \x01(\tR\vsource" +
+	"_case\x1a=\n\x0fAttributesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value" +
+	":\x028\x01\"\x88\x05\n\rTreeNodePlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n\x04ty" +
+	"pe\x18\x03 \x01(\tR\x04type\x125\n\bchildren\x18\x04 \x03(\v2\x19.full.plain.TreeNodePlainR\bchi" +
+	"ldren\x121\n\x06parent\x18\x05 \x01(\v2\x19.full.plain.TreeNodePlainR\x06parent\x12\x1d\n\ncrea" +
+	"ted_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n\ncreated_at\x18\a \x01(\x03R\tcreatedAt\x12\x1f\n\vmodifi" +
+	"ed_by\x18\b \x01(\tR\nmodifiedBy\x12\x1f\n\vmodified_at\x18\t \x01(\x03R\nmodifiedAt\x12=\n\x06labe" +
+	"ls\x18\n \x03(\v2%.full.plain.TreeNodePlain.LabelsEntryR\x06labels\x12\x12\n\x04tags\x18" +
+	"\v \x03(\tR\x04tags\x124\n\fpayload_text\x18\f \x01(\v2\x11.full.TextContentR\vpayloadTex" +
+	"t\x127\n\rpayload_image\x18\r \x01(\v2\x12.full.ImageContentR\fpayloadImage\x124\n\fpa" +
+	"yload_code\x18\x0e \x01(\v2\x11.full.CodeContentR\vpayloadCode\x12\"\n\fpayload_case" +
+	"\x18\x0f \x01(\tR\fpayload_case\x1a9\n\vLabelsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02" +
+	" \x01(\tR\x05value:\x028\x01\"\xc2\x04\n\nEventPlain\x12\x19\n\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n\neve" +
+	"nt_type\x18\x02 \x01(\tR\teventType\x12\x1c\n\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x16\n\x06source" +
+	"\x18\x04 \x01(\tR\x06source\x12\"\n\x04meta\x18\x05 \x01(\v2\x0e.full.MetadataR\x04meta\x12H\n\x14payload_us" +
+	"er_created\x18\x06 \x01(\v2\x16.full.UserCreatedEventR\x12payloadUserCreated\x12H\n\x14" +
+	"payload_user_updated\x18\a \x01(\v2\x16.full.UserUpdatedEventR\x12payloadUserU" +
+	"pdated\x12H\n\x14payload_user_deleted\x18\b \x01(\v2\x16.full.UserDeletedEventR\x12pa" +
+	"yloadUserDeleted\x12K\n\x15payload_order_created\x18\t \x01(\v2\x17.full.OrderCrea" +
+	"tedEventR\x13payloadOrderCreated\x12Q\n\x17payload_order_completed\x18\n \x01(\v2\x19" +
+	".full.OrderCompletedEventR\x15payloadOrderCompleted\x12\"\n\fpayload_case" +
+	"\x18\v \x01(\tR\fpayload_case\"\xf2\"\n\vConfigPlain\x12\x1d\n\ndouble_val\x18\x01 \x01(\x01R\tdouble" +
+	"Val\x12\x1b\n\tfloat_val\x18\x02 \x01(\x02R\bfloatVal\x12\x1b\n\tint32_val\x18\x03 \x01(\x05R\bint32Val\x12\x1b\n" +
+	"\tint64_val\x18\x04 \x01(\x03R\bint64Val\x12\x1d\n\nuint32_val\x18\x05 \x01(\rR\tuint32Val\x12\x1d\n\nuin" +
+	"t64_val\x18\x06 \x01(\x04R\tuint64Val\x12\x1d\n\nsint32_val\x18\a \x01(\x11R\tsint32Val\x12\x1d\n\nsint6" +
+	"4_val\x18\b \x01(\x12R\tsint64Val\x12\x1f\n\vfixed32_val\x18\t \x01(\aR\nfixed32Val\x12\x1f\n\vfixed" +
+	"64_val\x18\n \x01(\x06R\nfixed64Val\x12!\n\fsfixed32_val\x18\v \x01(\x0fR\vsfixed32Val\x12!\n\fs" +
+	"fixed64_val\x18\f \x01(\x10R\vsfixed64Val\x12\x19\n\bbool_val\x18\r \x01(\bR\aboolVal\x12\x1d\n\nstr" +
+	"ing_val\x18\x0e \x01(\tR\tstringVal\x12\x1b\n\tbytes_val\x18\x0f \x01(\fR\bbytesVal\x12,\n\x0foptiona" +
+	"l_string\x18\x10 \x01(\tH\x00R\x0eoptionalString\x88\x01\x01\x12&\n\foptional_int\x18\x11 \x01(\x05H\x01R\vopt" +
+	"ionalInt\x88\x01\x01\x12(\n\roptional_bool\x18\x12 \x01(\bH\x02R\foptionalBool\x88\x01\x01\x12,\n\x0foptiona" +
+	"l_double\x18\x13 \x01(\x01H\x03R\x0eoptionalDouble\x88\x01\x01\x12*\n\x0eoptional_bytes\x18\x14 \x01(\fH\x04R\ro" +
+	"ptionalBytes\x88\x01\x01\x12\x1f\n\vstring_list\x18\x15 \x03(\tR\nstringList\x12\x19\n\bint_list\x18\x16 \x03" +
+	"(\x05R\aintList\x12\x1f\n\vdouble_list\x18\x17 \x03(\x01R\ndoubleList\x12\x1d\n\nbytes_list\x18\x18 \x03(\f" +
+	"R\tbytesList\x12\x1b\n\tbool_list\x18\x19 \x03(\bR\bboolList\x12\x1d\n\nfloat_list\x18\x1a \x03(\x02R\tfl" +
+	"oatList\x12\x1d\n\nint64_list\x18\x1b \x03(\x03R\tint64List\x12\x1f\n\vuint32_list\x18\x1c \x03(\rR\nuin" +
+	"t32List\x12\x1f\n\vuint64_list\x18\x1d \x03(\x04R\nuint64List\x12E\n\nstring_map\x18\x1e \x03(\v2&.f" +
+	"ull.plain.ConfigPlain.StringMapEntryR\tstringMap\x12<\n\aint_map\x18\x1f \x03(\v" +
+	"2#.full.plain.ConfigPlain.IntMapEntryR\x06intMap\x12F\n\vint_key_map\x18  \x03" +
+	"(\v2&.full.plain.ConfigPlain.IntKeyMapEntryR\tintKeyMap\x12E\n\nnested_" +
+	"map\x18! \x03(\v2&.full.plain.ConfigPlain.NestedMapEntryR\tnestedMap\x12L\n\r" +
+	"int64_key_map\x18\" \x03(\v2(.full.plain.ConfigPlain.Int64KeyMapEntryR\vi" +
+	"nt64KeyMap\x12O\n\x0euint32_key_map\x18# \x03(\v2).full.plain.ConfigPlain.Uint" +
+	"32KeyMapEntryR\fuint32KeyMap\x12O\n\x0euint64_key_map\x18$ \x03(\v2).full.plain" +
+	".ConfigPlain.Uint64KeyMapEntryR\fuint64KeyMap\x12O\n\x0esint32_key_map\x18%" +
+	" \x03(\v2).full.plain.ConfigPlain.Sint32KeyMapEntryR\fsint32KeyMap\x12O\n" +
+	"\x0esint64_key_map\x18& \x03(\v2).full.plain.ConfigPlain.Sint64KeyMapEntry" +
+	"R\fsint64KeyMap\x12R\n\x0ffixed32_key_map\x18' \x03(\v2*.full.plain.ConfigPlain" +
+	".Fixed32KeyMapEntryR\rfixed32KeyMap\x12R\n\x0ffixed64_key_map\x18( \x03(\v2*.fu" +
+	"ll.plain.ConfigPlain.Fixed64KeyMapEntryR\rfixed64KeyMap\x12U\n\x10sfixed" +
+	"32_key_map\x18) \x03(\v2+.full.plain.ConfigPlain.Sfixed32KeyMapEntryR\x0es" +
+	"fixed32KeyMap\x12U\n\x10sfixed64_key_map\x18* \x03(\v2+.full.plain.ConfigPlain" +
+	".Sfixed64KeyMapEntryR\x0esfixed64KeyMap\x12I\n\fbool_key_map\x18+ \x03(\v2'.ful" +
+	"l.plain.ConfigPlain.BoolKeyMapEntryR\nboolKeyMap\x12E\n\ndouble_map\x18, " +
+	"\x03(\v2&.full.plain.ConfigPlain.DoubleMapEntryR\tdoubleMap\x12B\n\tbytes_" +
+	"map\x18- \x03(\v2%.full.plain.ConfigPlain.BytesMapEntryR\bbytesMap\x12?\n\bbo" +
+	"ol_map\x18. \x03(\v2$.full.plain.ConfigPlain.BoolMapEntryR\aboolMap\x12B\n\tf" +
+	"loat_map\x18/ \x03(\v2%.full.plain.ConfigPlain.FloatMapEntryR\bfloatMap\x12" +
+	"$\n\x06status\x180 \x01(\x0e2\f.full.StatusR\x06status\x12-\n\vstatus_list\x181 \x03(\x0e2\f.ful" +
+	"l.StatusR\nstatusList\x12E\n\nstatus_map\x182 \x03(\v2&.full.plain.ConfigPlai" +
+	"n.StatusMapEntryR\tstatusMap\x12:\n\x0foptional_status\x183 \x01(\x0e2\f.full.Stat" +
+	"usH\x05R\x0eoptionalStatus\x88\x01\x01\x128\n\vnested_enum\x184 \x01(\x0e2\x17.full.Config.Neste" +
+	"dEnumR\nnestedEnum\x12A\n\x10nested_enum_list\x185 \x03(\x0e2\x17.full.Config.Nested" +
+	"EnumR\x0enestedEnumList\x12>\n\rnested_config\x186 \x01(\v2\x19.full.Config.Nested" +
+	"ConfigR\fnestedConfig\x12G\n\x12nested_config_list\x187 \x03(\v2\x19.full.Config.N" +
+	"estedConfigR\x10nestedConfigList\x12X\n\x11nested_config_map\x188 \x03(\v2,.full." +
+	"plain.ConfigPlain.NestedConfigMapEntryR\x0fnestedConfigMap\x12/\n\x06paren" +
+	"t\x189 \x01(\v2\x17.full.plain.ConfigPlainR\x06parent\x123\n\bchildren\x18: \x03(\v2\x17.ful" +
+	"l.plain.ConfigPlainR\bchildren\x1a<\n\x0eStringMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03ke" +
+	"y\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n\vIntMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05" +
+	"value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a<\n\x0eIntKeyMapEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05va" +
+	"lue\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n\x0eNestedMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12-\n\x05valu" +
+	"e\x18\x02 \x01(\v2\x17.full.plain.ConfigPlainR\x05value:\x028\x01\x1a>\n\x10Int64KeyMapEntry\x12" +
+	"\x10\n\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n\x11Uint32KeyMapEntry" +
+	"\x12\x10\n\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n\x11Uint64KeyMapEntr" +
+	"y\x12\x10\n\x03key\x18\x01 \x01(\x04R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n\x11Sint32KeyMapEnt" +
+	"ry\x12\x10\n\x03key\x18\x01 \x01(\x11R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n\x11Sint64KeyMapEn" +
+	"try\x12\x10\n\x03key\x18\x01 \x01(\x12R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n\x12Fixed32KeyMap" +
+	"Entry\x12\x10\n\x03key\x18\x01 \x01(\aR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n\x12Fixed64KeyM" +
+	"apEntry\x12\x10\n\x03key\x18\x01 \x01(\x06R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n\x13Sfixed32K" +
+	"eyMapEntry\x12\x10\n\x03key\x18\x01 \x01(\x0fR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n\x13Sfixed" +
+	"64KeyMapEntry\x12\x10\n\x03key\x18\x01 \x01(\x10R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n\x0fBoo" +
+	"lKeyMapEntry\x12\x10\n\x03key\x18\x01 \x01(\bR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n\x0eDoub" +
+	"leMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a;\n\rBytesM" +
+	"apEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\x1a:\n\fBoolMapEn" +
+	"try\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1a;\n\rFloatMapEntry" +
+	"\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\x1aJ\n\x0eStatusMapEntry\x12\x10" +
+	"\n\x03key\x18\x01 \x01(\tR\x03key\x12\"\n\x05value\x18\x02 \x01(\x0e2\f.full.StatusR\x05value:\x028\x01\x1a]\n\x14Nest" +
+	"edConfigMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12/\n\x05value\x18\x02 \x01(\v2\x19.full.Config." +
+	"NestedConfigR\x05value:\x028\x01B\x12\n\x10_optional_stringB\x0f\n\r_optional_intB\x10\n\x0e" +
+	"_optional_boolB\x12\n\x10_optional_doubleB\x11\n\x0f_optional_bytesB\x12\n\x10_option" +
+	"al_status\"\xc4\n\n\x13WellKnownTypesPlain\x129\n\ncreated_at\x18\x01 \x01(\v2\x1a.google.p" +
+	"rotobuf.TimestampR\tcreatedAt\x12+\n\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.Dura" +
+	"tionR\x03ttl\x129\n\nupdated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdat" +
+	"edAt\x123\n\alatency\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\alatency\x12E\n\x0fnul" +
+	"lable_string\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x0enullableString" +
+	"\x12B\n\x0enullable_int32\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\rnullableI" +
+	"nt32\x12B\n\x0enullable_int64\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\rnulla" +
+	"bleInt64\x12E\n\x0fnullable_uint32\x18\b \x01(\v2\x1c.google.protobuf.UInt32ValueR" +
+	"\x0enullableUint32\x12E\n\x0fnullable_uint64\x18\t \x01(\v2\x1c.google.protobuf.UInt6" +
+	"4ValueR\x0enullableUint64\x12B\n\x0enullable_float\x18\n \x01(\v2\x1b.google.protobuf" +
+	".FloatValueR\rnullableFloat\x12E\n\x0fnullable_double\x18\v \x01(\v2\x1c.google.pro" +
+	"tobuf.DoubleValueR\x0enullableDouble\x12?\n\rnullable_bool\x18\f \x01(\v2\x1a.googl" +
+	"e.protobuf.BoolValueR\fnullableBool\x12B\n\x0enullable_bytes\x18\r \x01(\v2\x1b.goo" +
+	"gle.protobuf.BytesValueR\rnullableBytes\x123\n\bmetadata\x18\x0e \x01(\v2\x17.googl" +
+	"e.protobuf.StructR\bmetadata\x12;\n\rdynamic_value\x18\x0f \x01(\v2\x16.google.prot" +
+	"obuf.ValueR\fdynamicValue\x129\n\nlist_value\x18\x10 \x01(\v2\x1a.google.protobuf.L" +
+	"istValueR\tlistValue\x12.\n\apayload\x18\x11 \x01(\v2\x14.google.protobuf.AnyR\apayl" +
+	"oad\x120\n\bpayloads\x18\x12 \x03(\v2\x14.google.protobuf.AnyR\bpayloads\x12,\n\x05empty\x18\x13" +
+	" \x01(\v2\x16.google.protobuf.EmptyR\x05empty\x12:\n\ntimestamps\x18\x14 \x03(\v2\x1a.google" +
+	".protobuf.TimestampR\ntimestamps\x127\n\tdurations\x18\x15 \x03(\v2\x19.google.prot" +
+	"obuf.DurationR\tdurations\x126\n\astrings\x18\x16 \x03(\v2\x1c.google.protobuf.Stri" +
+	"ngValueR\astrings\"\xe6\x1c\n\x10MapShowcasePlain\x12A\n\astr_str\x18\x01 \x03(\v2(.full.pl" +
+	"ain.MapShowcasePlain.StrStrEntryR\x06strStr\x12G\n\tstr_int32\x18\x02 \x03(\v2*.fu" +
+	"ll.plain.MapShowcasePlain.StrInt32EntryR\bstrInt32\x12G\n\tstr_int64\x18\x03" +
+	" \x03(\v2*.full.plain.MapShowcasePlain.StrInt64EntryR\bstrInt64\x12J\n\nst" +
+	"r_uint32\x18\x04 \x03(\v2+.full.plain.MapShowcasePlain.StrUint32EntryR\tstr" +
+	"Uint32\x12J\n\nstr_uint64\x18\x05 \x03(\v2+.full.plain.MapShowcasePlain.StrUint" +
+	"64EntryR\tstrUint64\x12G\n\tstr_float\x18\x06 \x03(\v2*.full.plain.MapShowcasePl" +
+	"ain.StrFloatEntryR\bstrFloat\x12J\n\nstr_double\x18\a \x03(\v2+.full.plain.Map" +
+	"ShowcasePlain.StrDoubleEntryR\tstrDouble\x12D\n\bstr_bool\x18\b \x03(\v2).full" +
+	".plain.MapShowcasePlain.StrBoolEntryR\astrBool\x12G\n\tstr_bytes\x18\t \x03(\v" +
+	"2*.full.plain.MapShowcasePlain.StrBytesEntryR\bstrBytes\x12G\n\tint32_" +
+	"str\x18\n \x03(\v2*.full.plain.MapShowcasePlain.Int32StrEntryR\bint32Str\x12" +
+	"G\n\tint64_str\x18\v \x03(\v2*.full.plain.MapShowcasePlain.Int64StrEntryR\b" +
+	"int64Str\x12J\n\nuint32_str\x18\f \x03(\v2+.full.plain.MapShowcasePlain.Uint3" +
+	"2StrEntryR\tuint32Str\x12J\n\nuint64_str\x18\r \x03(\v2+.full.plain.MapShowcas" +
+	"ePlain.Uint64StrEntryR\tuint64Str\x12J\n\nsint32_str\x18\x0e \x03(\v2+.full.plai" +
+	"n.MapShowcasePlain.Sint32StrEntryR\tsint32Str\x12J\n\nsint64_str\x18\x0f \x03(\v" +
+	"2+.full.plain.MapShowcasePlain.Sint64StrEntryR\tsint64Str\x12M\n\vfixe" +
+	"d32_str\x18\x10 \x03(\v2,.full.plain.MapShowcasePlain.Fixed32StrEntryR\nfix" +
+	"ed32Str\x12M\n\vfixed64_str\x18\x11 \x03(\v2,.full.plain.MapShowcasePlain.Fixed" +
+	"64StrEntryR\nfixed64Str\x12P\n\fsfixed32_str\x18\x12 \x03(\v2-.full.plain.MapSho" +
+	"wcasePlain.Sfixed32StrEntryR\vsfixed32Str\x12P\n\fsfixed64_str\x18\x13 \x03(\v2-" +
+	".full.plain.MapShowcasePlain.Sfixed64StrEntryR\vsfixed64Str\x12D\n\bbo" +
+	"ol_str\x18\x14 \x03(\v2).full.plain.MapShowcasePlain.BoolStrEntryR\aboolStr" +
+	"\x12M\n\vstr_message\x18\x15 \x03(\v2,.full.plain.MapShowcasePlain.StrMessageEn" +
+	"tryR\nstrMessage\x12S\n\rint32_message\x18\x16 \x03(\v2..full.plain.MapShowcaseP" +
+	"lain.Int32MessageEntryR\fint32Message\x12S\n\rint64_message\x18\x17 \x03(\v2..fu" +
+	"ll.plain.MapShowcasePlain.Int64MessageEntryR\fint64Message\x12D\n\bstr" +
+	"_enum\x18\x18 \x03(\v2).full.plain.MapShowcasePlain.StrEnumEntryR\astrEnum\x12" +
+	"J\n\nint32_enum\x18\x19 \x03(\v2+.full.plain.MapShowcasePlain.Int32EnumEntry" +
+	"R\tint32Enum\x12@\n\x06nested\x18\x1a \x03(\v2(.full.plain.MapShowcasePlain.Nested" +
+	"EntryR\x06nested\x1a9\n\vStrStrEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05v" +
+	"alue:\x028\x01\x1a;\n\rStrInt32Entry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x05R\x05valu" +
+	"e:\x028\x01\x1a;\n\rStrInt64Entry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x02" +
+	"8\x01\x1a<\n\x0eStrUint32Entry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\rR\x05value:\x028\x01" +
+	"\x1a<\n\x0eStrUint64Entry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\x1a;" +
+	"\n\rStrFloatEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\x1a<\n\x0eS" +
+	"trDoubleEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a:\n\fStr" +
+	"BoolEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1a;\n\rStrByte" +
+	"sEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\x1a;\n\rInt32StrEn" +
+	"try\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n\rInt64StrEntry" +
+	"\x12\x10\n\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n\x0eUint32StrEntry\x12\x10" +
+	"\n\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n\x0eUint64StrEntry\x12\x10\n\x03" +
+	"key\x18\x01 \x01(\x04R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n\x0eSint32StrEntry\x12\x10\n\x03ke" +
+	"y\x18\x01 \x01(\x11R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a<\n\x0eSint64StrEntry\x12\x10\n\x03key\x18" +
+	"\x01 \x01(\x12R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n\x0fFixed32StrEntry\x12\x10\n\x03key\x18\x01" +
+	" \x01(\aR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n\x0fFixed64StrEntry\x12\x10\n\x03key\x18\x01 " +
+	"\x01(\x06R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n\x10Sfixed32StrEntry\x12\x10\n\x03key\x18\x01 " +
+	"\x01(\x0fR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n\x10Sfixed64StrEntry\x12\x10\n\x03key\x18\x01 " +
+	"\x01(\x10R\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n\fBoolStrEntry\x12\x10\n\x03key\x18\x01 \x01(\bR" +
+	"\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aL\n\x0fStrMessageEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03" +
+	"key\x12#\n\x05value\x18\x02 \x01(\v2\r.full.AddressR\x05value:\x028\x01\x1aN\n\x11Int32MessageEntr" +
+	"y\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12#\n\x05value\x18\x02 \x01(\v2\r.full.AddressR\x05value:\x028\x01\x1aO\n\x11" +
+	"Int64MessageEntry\x12\x10\n\x03key\x18\x01 \x01(\x03R\x03key\x12$\n\x05value\x18\x02 \x01(\v2\x0e.full.Metada" +
+	"taR\x05value:\x028\x01\x1aH\n\fStrEnumEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\"\n\x05value\x18\x02 \x01(\x0e2\f" +
+	".full.StatusR\x05value:\x028\x01\x1aL\n\x0eInt32EnumEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x12$\n\x05v" +
+	"alue\x18\x02 \x01(\x0e2\x0e.full.PriorityR\x05value:\x028\x01\x1aR\n\vNestedEntry\x12\x10\n\x03key\x18\x01 \x01(" +
+	"\tR\x03key\x12-\n\x05value\x18\x02 \x01(\v2\x17.full.plain.ConfigPlainR\x05value:\x028\x01\"\x8f\b\n\x15Op" +
+	"tionalShowcasePlain\x12\"\n\nopt_double\x18\x01 \x01(\x01H\x00R\toptDouble\x88\x01\x01\x12 \n\topt_f" +
+	"loat\x18\x02 \x01(\x02H\x01R\boptFloat\x88\x01\x01\x12 \n\topt_int32\x18\x03 \x01(\x05H\x02R\boptInt32\x88\x01\x01\x12 \n\to" +
+	"pt_int64\x18\x04 \x01(\x03H\x03R\boptInt64\x88\x01\x01\x12\"\n\nopt_uint32\x18\x05 \x01(\rH\x04R\toptUint32\x88\x01" +
+	"\x01\x12\"\n\nopt_uint64\x18\x06 \x01(\x04H\x05R\toptUint64\x88\x01\x01\x12\"\n\nopt_sint32\x18\a \x01(\x11H\x06R\topt" +
+	"Sint32\x88\x01\x01\x12\"\n\nopt_sint64\x18\b \x01(\x12H\aR\toptSint64\x88\x01\x01\x12$\n\vopt_fixed32\x18\t \x01" +
+	"(\aH\bR\noptFixed32\x88\x01\x01\x12$\n\vopt_fixed64\x18\n \x01(\x06H\tR\noptFixed64\x88\x01\x01\x12&\n\fopt" +
+	"_sfixed32\x18\v \x01(\x0fH\nR\voptSfixed32\x88\x01\x01\x12&\n\fopt_sfixed64\x18\f \x01(\x10H\vR\voptSf" +
+	"ixed64\x88\x01\x01\x12\x1e\n\bopt_bool\x18\r \x01(\bH\fR\aoptBool\x88\x01\x01\x12\"\n\nopt_string\x18\x0e \x01(\tH\rR" +
+	"\toptString\x88\x01\x01\x12 \n\topt_bytes\x18\x0f \x01(\fH\x0eR\boptBytes\x88\x01\x01\x120\n\nopt_status\x18\x10 " +
+	"\x01(\x0e2\f.full.StatusH\x0fR\toptStatus\x88\x01\x01\x126\n\fopt_priority\x18\x11 \x01(\x0e2\x0e.full.P" +
+	"riorityH\x10R\voptPriority\x88\x01\x01\x12%\n\x0eregular_double\x18\x12 \x01(\x01R\rregularDouble" +
+	"\x12%\n\x0eregular_string\x18\x13 \x01(\tR\rregularString\x12!\n\fregular_bool\x18\x14 \x01(\bR\vr" +
+	"egularBoolB\r\n\v_opt_doubleB\f\n\n_opt_floatB\f\n\n_opt_int32B\f\n\n_opt_in" +
+	"t64B\r\n\v_opt_uint32B\r\n\v_opt_uint64B\r\n\v_opt_sint32B\r\n\v_opt_sint64B" +
+	"\x0e\n\f_opt_fixed32B\x0e\n\f_opt_fixed64B\x0f\n\r_opt_sfixed32B\x0f\n\r_opt_sfixed6" +
+	"4B\v\n\t_opt_boolB\r\n\v_opt_stringB\f\n\n_opt_bytesB\r\n\v_opt_statusB\x0f\n\r_o" +
+	"pt_priority\"\x81\v\n\x12OneofShowcasePlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x121\n\x15scalar_cho" +
+	"ice_str_val\x18\x02 \x01(\tR\x12scalarChoiceStrVal\x121\n\x15scalar_choice_int_val\x18\x03" +
+	" \x01(\x05R\x12scalarChoiceIntVal\x123\n\x16scalar_choice_long_val\x18\x04 \x01(\x03R\x13scalar" +
+	"ChoiceLongVal\x127\n\x18scalar_choice_double_val\x18\x05 \x01(\x01R\x15scalarChoiceDou" +
+	"bleVal\x123\n\x16scalar_choice_bool_val\x18\x06 \x01(\bR\x13scalarChoiceBoolVal\x125\n\x17s" +
+	"calar_choice_bytes_val\x18\a \x01(\fR\x14scalarChoiceBytesVal\x12C\n\x16message_ch" +
+	"oice_address\x18\b \x01(\v2\r.full.AddressR\x14messageChoiceAddress\x12G\n\x16messa" +
+	"ge_choice_contact\x18\t \x01(\v2\x11.full.ContactInfoR\x14messageChoiceContact" +
+	"\x12F\n\x17message_choice_metadata\x18\n \x01(\v2\x0e.full.MetadataR\x15messageChoice" +
+	"Metadata\x12:\n\x12enum_choice_status\x18\v \x01(\x0e2\f.full.StatusR\x10enumChoiceSt" +
+	"atus\x12@\n\x14enum_choice_priority\x18\f \x01(\x0e2\x0e.full.PriorityR\x12enumChoicePr" +
+	"iority\x12;\n\x11enum_choice_error\x18\r \x01(\x0e2\x0f.full.ErrorCodeR\x0fenumChoiceEr" +
+	"ror\x124\n\fcontent_text\x18\x0e \x01(\v2\x11.full.TextContentR\vcontentText\x127\n\rcon" +
+	"tent_image\x18\x0f \x01(\v2\x12.full.ImageContentR\fcontentImage\x124\n\fcontent_co" +
+	"de\x18\x10 \x01(\v2\x11.full.CodeContentR\vcontentCode\x12&\n\x0fsource_type_url\x18\x11 \x01(" +
+	"\tR\rsourceTypeUrl\x121\n\x15source_type_file_path\x18\x12 \x01(\tR\x12sourceTypeFileP" +
+	"ath\x129\n\x19destination_type_dest_url\x18\x13 \x01(\tR\x16destinationTypeDestUrl\x12;" +
+	"\n\x1adestination_type_dest_path\x18\x14 \x01(\tR\x17destinationTypeDestPath\x12.\n\x12s" +
+	"calar_choice_case\x18\x15 \x01(\tR\x12scalar_choice_case\x120\n\x13message_choice_ca" +
+	"se\x18\x16 \x01(\tR\x13message_choice_case\x12*\n\x10enum_choice_case\x18\x17 \x01(\tR\x10enum_ch" +
+	"oice_case\x12\"\n\fcontent_case\x18\x18 \x01(\tR\fcontent_case\x12*\n\x10source_type_cas" +
+	"e\x18\x19 \x01(\tR\x10source_type_case\x124\n\x15destination_type_case\x18\x1a \x01(\tR\x15destin" +
+	"ation_type_case\"\x8a\n\n\x12PlatformEventPlain\x12\x19\n\bevent_id\x18\x01 \x01(\tR\aeventI" +
+	"d\x12\x1d\n\nevent_time\x18\x02 \x01(\x03R\teventTime\x12\x16\n\x06source\x18\x03 \x01(\tR\x06source\x12B\n\x06labe" +
+	"ls\x18\x04 \x03(\v2*.full.plain.PlatformEventPlain.LabelsEntryR\x06labels\x12/\n\x13" +
+	"heartbeat_timestamp\x18\x05 \x01(\x03R\x12heartbeatTimestamp\x12*\n\x11heartbeat_node_" +
+	"id\x18\x06 \x01(\tR\x0fheartbeatNodeId\x122\n\x15heartbeat_cpu_percent\x18\a \x01(\x05R\x13heartb" +
+	"eatCpuPercent\x124\n\x16heartbeat_memory_bytes\x18\b \x01(\x03R\x14heartbeatMemoryBy" +
+	"tes\x12;\n\x1aprocess_started_process_id\x18\t \x01(\tR\x17processStartedProcessId" +
+	"\x126\n\x17process_started_command\x18\n \x01(\tR\x15processStartedCommand\x120\n\x14proc" +
+	"ess_started_args\x18\v \x03(\tR\x12processStartedArgs\x12;\n\x1aprocess_started_st" +
+	"art_time\x18\f \x01(\x03R\x17processStartedStartTime\x129\n\x19process_exited_proces" +
+	"s_id\x18\r \x01(\tR\x16processExitedProcessId\x127\n\x18process_exited_exit_code\x18\x0e" +
+	" \x01(\x05R\x15processExitedExitCode\x127\n\x18process_exited_exit_time\x18\x0f \x01(\x03R\x15p" +
+	"rocessExitedExitTime\x122\n\x15process_exited_signal\x18\x10 \x01(\tR\x13processExit" +
+	"edSignal\x12?\n\x1cnetwork_event_interface_name\x18\x11 \x01(\tR\x19networkEventInte" +
+	"rfaceName\x129\n\x19network_event_remote_addr\x18\x12 \x01(\tR\x16networkEventRemote" +
+	"Addr\x129\n\x19network_event_remote_port\x18\x13 \x01(\x05R\x16networkEventRemotePort\x12" +
+	"4\n\x16network_event_protocol\x18\x14 \x01(\tR\x14networkEventProtocol\x127\n\x18network" +
+	"_event_bytes_sent\x18\x15 \x01(\x03R\x15networkEventBytesSent\x12?\n\x1cnetwork_event_" +
+	"bytes_received\x18\x16 \x01(\x03R\x19networkEventBytesReceived\x120\n\x13platform_even" +
+	"t_case\x18\x17 \x01(\tR\x13platform_event_case\x1a9\n\vLabelsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03k" +
+	"ey\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9a\x01\n\x17DeprecatedShowcasePlain\x12\x0e\n\x02id\x18\x01" +
+	" \x01(\tR\x02id\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n\told_field\x18\x03 \x01(\tR\boldField\x12!\n\fleg" +
+	"acy_count\x18\x04 \x01(\x05R\vlegacyCount\x12\x1b\n\tnew_field\x18\x05 \x01(\tR\bnewField\"\xfe\x03\n\x15De" +
+	"faultsShowcasePlain\x12!\n\fempty_string\x18\x01 \x01(\tR\vemptyString\x12\x19\n\bzero_i" +
+	"nt\x18\x02 \x01(\x05R\azeroInt\x12\x1b\n\tzero_long\x18\x03 \x01(\x03R\bzeroLong\x12\x1f\n\vzero_double\x18\x04 " +
+	"\x01(\x01R\nzeroDouble\x12\x1d\n\nfalse_bool\x18\x05 \x01(\bR\tfalseBool\x12\x1f\n\vempty_bytes\x18\x06 " +
+	"\x01(\fR\nemptyBytes\x12)\n\tzero_enum\x18\a \x01(\x0e2\f.full.StatusR\bzeroEnum\x12\x1d\n\nem" +
+	"pty_list\x18\b \x03(\tR\temptyList\x12$\n\x0eempty_int_list\x18\t \x03(\x05R\femptyIntList\x12" +
+	"L\n\tempty_map\x18\n \x03(\v2/.full.plain.DefaultsShowcasePlain.EmptyMapEn" +
+	"tryR\bemptyMap\x12.\n\vnil_message\x18\v \x01(\v2\r.full.AddressR\nnilMessage\x1a;\n" +
+	"\rEmptyMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd6\x04\n\x12C" +
+	"omplexNestedPlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12/\n\x05inner\x18\x02 \x01(\v2\x19.full.ComplexN" +
+	"ested.InnerR\x05inner\x128\n\ninner_list\x18\x03 \x03(\v2\x19.full.ComplexNested.Inne" +
+	"rR\tinnerList\x12I\n\tinner_map\x18\x04 \x03(\v2,.full.plain.ComplexNestedPlain." +
+	"InnerMapEntryR\binnerMap\x12<\n\ninner_enum\x18\x05 \x01(\x0e2\x1d.full.ComplexNested" +
+	".InnerEnumR\tinnerEnum\x12E\n\x0finner_enum_list\x18\x06 \x03(\x0e2\x1d.full.ComplexNes" +
+	"ted.InnerEnumR\rinnerEnumList\x12I\n\x13choice_choice_inner\x18\a \x01(\v2\x19.full" +
+	".ComplexNested.InnerR\x11choiceChoiceInner\x120\n\x14choice_choice_string\x18" +
+	"\b \x01(\tR\x12choiceChoiceString\x12 \n\vchoice_case\x18\t \x01(\tR\vchoice_case\x1aV\n\rI" +
+	"nnerMapEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12/\n\x05value\x18\x02 \x01(\v2\x19.full.ComplexNest" +
+	"ed.InnerR\x05value:\x028\x01b\x06proto3"
+
+var file_test_full_showcase_proto_plain = plainreflect.NewFile("test/full/showcase_plain.proto", file_test_full_showcase_proto_plain_rawDesc)
+
+func init() { file_test_full_showcase_proto_plain_init() }
+
+func file_test_full_showcase_proto_plain_init() {
+	file_test_full_showcase_proto_init()
+	file_test_full_showcase_proto_plain.Init()
 }
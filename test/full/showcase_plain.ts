@@ -0,0 +1,540 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/showcase.proto
+
+export interface MetricsPlain {
+  durationNs?: number;
+  timestampUnix?: number;
+  bytesProcessed?: number;
+  requestsCount?: number;
+  successRate?: number;
+}
+
+export interface CustomTypesPlain {
+  /**
+   * Field-level type override: bytes -> json.RawMessage (compatible types)
+   * RawMessage is []byte under the hood, so no caster needed
+   */
+  rawJson?: string;
+  /** Regular fields for comparison */
+  name?: string;
+  count?: number;
+  /** Using type alias message */
+  label?: string;
+}
+
+/** Fields of DocumentPlain outside of oneofs */
+export interface DocumentPlainFields {
+  /** --- Basic fields --- */
+  id?: string;
+  title?: string;
+  status?: 0 | 1 | 2 | 3 | 4;
+  priority?: 0 | 1 | 2 | 3 | 4;
+  /** --- Type alias fields (unwrapped) --- */
+  description?: string;
+  version?: number;
+  isPublic?: boolean;
+  email?: string;
+  phone?: string;
+  address?: Record<string, unknown>;
+  metadata?: Record<string, unknown>;
+  /** --- Serialized field (stored as bytes) --- */
+  performance?: string;
+  /** --- Repeated and map fields --- */
+  keywords?: string[];
+  attributes?: Record<string, string>;
+  locations?: (Record<string, unknown>)[];
+  /** --- Deep nested structure --- */
+  structure?: Record<string, unknown>;
+  /** --- Self-reference for tree structures --- */
+  children?: DocumentPlain[];
+  parent?: DocumentPlain;
+  computedHash?: string;
+  isValid?: boolean;
+}
+
+/** content oneof of DocumentPlain, by content_case */
+export type DocumentPlainContent =
+  | {
+      content_case: "text_content";
+      contentTextContent?: Record<string, unknown>;
+    }
+  | {
+      content_case: "image_content";
+      contentImageContent?: Record<string, unknown>;
+    }
+  | {
+      content_case: "video_content";
+      contentVideoContent?: Record<string, unknown>;
+    }
+  | {
+      content_case: "code_content";
+      contentCodeContent?: Record<string, unknown>;
+    }
+  | {
+      content_case: "table_content";
+      contentTableContent?: Record<string, unknown>;
+    }
+  | { content_case?: undefined };
+
+/** source oneof of DocumentPlain, by source_case */
+export type DocumentPlainSource =
+  | {
+      source_case: "url";
+      sourceUrl?: string;
+    }
+  | {
+      source_case: "file_path";
+      sourceFilePath?: string;
+    }
+  | {
+      source_case: "raw_data";
+      sourceRawData?: string;
+    }
+  | { source_case?: undefined };
+
+export type DocumentPlain = DocumentPlainFields & DocumentPlainContent & DocumentPlainSource;
+
+/** Fields of TreeNodePlain outside of oneofs */
+export interface TreeNodePlainFields {
+  id?: string;
+  name?: string;
+  type?: string;
+  /** Recursive children */
+  children?: TreeNodePlain[];
+  /** Back-reference to parent */
+  parent?: TreeNodePlain;
+  createdBy?: string;
+  createdAt?: number;
+  modifiedBy?: string;
+  modifiedAt?: number;
+  labels?: Record<string, string>;
+  tags?: string[];
+}
+
+/** payload oneof of TreeNodePlain, by payload_case */
+export type TreeNodePlainPayload =
+  | {
+      payload_case: "text";
+      payloadText?: Record<string, unknown>;
+    }
+  | {
+      payload_case: "image";
+      payloadImage?: Record<string, unknown>;
+    }
+  | {
+      payload_case: "code";
+      payloadCode?: Record<string, unknown>;
+    }
+  | { payload_case?: undefined };
+
+export type TreeNodePlain = TreeNodePlainFields & TreeNodePlainPayload;
+
+/** Fields of EventPlain outside of oneofs */
+export interface EventPlainFields {
+  /** Event header */
+  eventId?: string;
+  eventType?: string;
+  timestamp?: number;
+  source?: string;
+  /** Metadata embedded */
+  meta?: Record<string, unknown>;
+}
+
+/** payload oneof of EventPlain, by payload_case */
+export type EventPlainPayload =
+  | {
+      payload_case: "user_created";
+      payloadUserCreated?: Record<string, unknown>;
+    }
+  | {
+      payload_case: "user_updated";
+      payloadUserUpdated?: Record<string, unknown>;
+    }
+  | {
+      payload_case: "user_deleted";
+      payloadUserDeleted?: Record<string, unknown>;
+    }
+  | {
+      payload_case: "order_created";
+      payloadOrderCreated?: Record<string, unknown>;
+    }
+  | {
+      payload_case: "order_completed";
+      payloadOrderCompleted?: Record<string, unknown>;
+    }
+  | { payload_case?: undefined };
+
+export type EventPlain = EventPlainFields & EventPlainPayload;
+
+export interface ConfigPlain {
+  /** All scalar types */
+  doubleVal?: number;
+  floatVal?: number;
+  int32Val?: number;
+  int64Val?: number;
+  uint32Val?: number;
+  uint64Val?: number;
+  sint32Val?: number;
+  sint64Val?: number;
+  fixed32Val?: number;
+  fixed64Val?: number;
+  sfixed32Val?: number;
+  sfixed64Val?: number;
+  boolVal?: boolean;
+  stringVal?: string;
+  bytesVal?: string;
+  /** Optional scalars (proto3 explicit optional) */
+  optionalString?: string;
+  optionalInt?: number;
+  optionalBool?: boolean;
+  optionalDouble?: number;
+  optionalBytes?: string;
+  /** Repeated scalars */
+  stringList?: string[];
+  intList?: number[];
+  doubleList?: number[];
+  bytesList?: string[];
+  boolList?: boolean[];
+  floatList?: number[];
+  int64List?: number[];
+  uint32List?: number[];
+  uint64List?: number[];
+  /** Maps with different key/value types */
+  stringMap?: Record<string, string>;
+  intMap?: Record<string, number>;
+  intKeyMap?: Record<string, string>;
+  nestedMap?: Record<string, ConfigPlain>;
+  /** Additional map key types */
+  int64KeyMap?: Record<string, string>;
+  uint32KeyMap?: Record<string, string>;
+  uint64KeyMap?: Record<string, string>;
+  sint32KeyMap?: Record<string, string>;
+  sint64KeyMap?: Record<string, string>;
+  fixed32KeyMap?: Record<string, string>;
+  fixed64KeyMap?: Record<string, string>;
+  sfixed32KeyMap?: Record<string, string>;
+  sfixed64KeyMap?: Record<string, string>;
+  boolKeyMap?: Record<string, string>;
+  /** Map value types */
+  doubleMap?: Record<string, number>;
+  bytesMap?: Record<string, string>;
+  boolMap?: Record<string, boolean>;
+  floatMap?: Record<string, number>;
+  /** Enum fields */
+  status?: 0 | 1 | 2 | 3 | 4;
+  statusList?: (0 | 1 | 2 | 3 | 4)[];
+  statusMap?: Record<string, 0 | 1 | 2 | 3 | 4>;
+  optionalStatus?: 0 | 1 | 2 | 3 | 4;
+  nestedEnum?: 0 | 1 | 2;
+  nestedEnumList?: (0 | 1 | 2)[];
+  nestedConfig?: Record<string, unknown>;
+  nestedConfigList?: (Record<string, unknown>)[];
+  nestedConfigMap?: Record<string, Record<string, unknown>>;
+  /** Self-reference */
+  parent?: ConfigPlain;
+  children?: ConfigPlain[];
+}
+
+export interface WellKnownTypesPlain {
+  /** Timestamp and Duration */
+  createdAt?: string;
+  ttl?: string;
+  updatedAt?: string;
+  latency?: string;
+  /** Wrapper types */
+  nullableString?: string;
+  nullableInt32?: number;
+  nullableInt64?: string;
+  nullableUint32?: number;
+  nullableUint64?: string;
+  nullableFloat?: number;
+  nullableDouble?: number;
+  nullableBool?: boolean;
+  nullableBytes?: string;
+  /** Struct types (dynamic JSON) */
+  metadata?: Record<string, unknown>;
+  dynamicValue?: unknown;
+  listValue?: unknown[];
+  /** Any type */
+  payload?: Record<string, unknown>;
+  payloads?: (Record<string, unknown>)[];
+  /** Empty type */
+  empty?: Record<string, unknown>;
+  /** Repeated well-known types */
+  timestamps?: string[];
+  durations?: string[];
+  strings?: string[];
+}
+
+export interface MapShowcasePlain {
+  /** String key maps (most common) */
+  strStr?: Record<string, string>;
+  strInt32?: Record<string, number>;
+  strInt64?: Record<string, number>;
+  strUint32?: Record<string, number>;
+  strUint64?: Record<string, number>;
+  strFloat?: Record<string, number>;
+  strDouble?: Record<string, number>;
+  strBool?: Record<string, boolean>;
+  strBytes?: Record<string, string>;
+  /** Integer key maps (all supported key types) */
+  int32Str?: Record<string, string>;
+  int64Str?: Record<string, string>;
+  uint32Str?: Record<string, string>;
+  uint64Str?: Record<string, string>;
+  sint32Str?: Record<string, string>;
+  sint64Str?: Record<string, string>;
+  fixed32Str?: Record<string, string>;
+  fixed64Str?: Record<string, string>;
+  sfixed32Str?: Record<string, string>;
+  sfixed64Str?: Record<string, string>;
+  boolStr?: Record<string, string>;
+  /** Maps with message values */
+  strMessage?: Record<string, Record<string, unknown>>;
+  int32Message?: Record<string, Record<string, unknown>>;
+  int64Message?: Record<string, Record<string, unknown>>;
+  /** Maps with enum values */
+  strEnum?: Record<string, 0 | 1 | 2 | 3 | 4>;
+  int32Enum?: Record<string, 0 | 1 | 2 | 3 | 4>;
+  /** Nested maps (map value is message with maps) */
+  nested?: Record<string, ConfigPlain>;
+}
+
+export interface OptionalShowcasePlain {
+  /** All optional scalar types */
+  optDouble?: number;
+  optFloat?: number;
+  optInt32?: number;
+  optInt64?: number;
+  optUint32?: number;
+  optUint64?: number;
+  optSint32?: number;
+  optSint64?: number;
+  optFixed32?: number;
+  optFixed64?: number;
+  optSfixed32?: number;
+  optSfixed64?: number;
+  optBool?: boolean;
+  optString?: string;
+  optBytes?: string;
+  /** Optional enum */
+  optStatus?: 0 | 1 | 2 | 3 | 4;
+  optPriority?: 0 | 1 | 2 | 3 | 4;
+  /** Regular (non-optional) fields for comparison */
+  regularDouble?: number;
+  regularString?: string;
+  regularBool?: boolean;
+}
+
+/** Fields of OneofShowcasePlain outside of oneofs */
+export interface OneofShowcasePlainFields {
+  id?: string;
+}
+
+/** scalar_choice oneof of OneofShowcasePlain, by scalar_choice_case */
+export type OneofShowcasePlainScalarChoice =
+  | {
+      scalar_choice_case: "str_val";
+      scalarChoiceStrVal?: string;
+    }
+  | {
+      scalar_choice_case: "int_val";
+      scalarChoiceIntVal?: number;
+    }
+  | {
+      scalar_choice_case: "long_val";
+      scalarChoiceLongVal?: number;
+    }
+  | {
+      scalar_choice_case: "double_val";
+      scalarChoiceDoubleVal?: number;
+    }
+  | {
+      scalar_choice_case: "bool_val";
+      scalarChoiceBoolVal?: boolean;
+    }
+  | {
+      scalar_choice_case: "bytes_val";
+      scalarChoiceBytesVal?: string;
+    }
+  | { scalar_choice_case?: undefined };
+
+/** message_choice oneof of OneofShowcasePlain, by message_choice_case */
+export type OneofShowcasePlainMessageChoice =
+  | {
+      message_choice_case: "address";
+      messageChoiceAddress?: Record<string, unknown>;
+    }
+  | {
+      message_choice_case: "contact";
+      messageChoiceContact?: Record<string, unknown>;
+    }
+  | {
+      message_choice_case: "metadata";
+      messageChoiceMetadata?: Record<string, unknown>;
+    }
+  | { message_choice_case?: undefined };
+
+/** enum_choice oneof of OneofShowcasePlain, by enum_choice_case */
+export type OneofShowcasePlainEnumChoice =
+  | {
+      enum_choice_case: "status";
+      enumChoiceStatus?: 0 | 1 | 2 | 3 | 4;
+    }
+  | {
+      enum_choice_case: "priority";
+      enumChoicePriority?: 0 | 1 | 2 | 3 | 4;
+    }
+  | {
+      enum_choice_case: "error";
+      enumChoiceError?: 0 | 1 | 2 | 3 | 4;
+    }
+  | { enum_choice_case?: undefined };
+
+/** content oneof of OneofShowcasePlain, by content_case */
+export type OneofShowcasePlainContent =
+  | {
+      content_case: "text";
+      contentText?: Record<string, unknown>;
+    }
+  | {
+      content_case: "image";
+      contentImage?: Record<string, unknown>;
+    }
+  | {
+      content_case: "code";
+      contentCode?: Record<string, unknown>;
+    }
+  | { content_case?: undefined };
+
+/** source_type oneof of OneofShowcasePlain, by source_type_case */
+export type OneofShowcasePlainSourceType =
+  | {
+      source_type_case: "url";
+      sourceTypeUrl?: string;
+    }
+  | {
+      source_type_case: "file_path";
+      sourceTypeFilePath?: string;
+    }
+  | { source_type_case?: undefined };
+
+/** destination_type oneof of OneofShowcasePlain, by destination_type_case */
+export type OneofShowcasePlainDestinationType =
+  | {
+      destination_type_case: "dest_url";
+      destinationTypeDestUrl?: string;
+    }
+  | {
+      destination_type_case: "dest_path";
+      destinationTypeDestPath?: string;
+    }
+  | { destination_type_case?: undefined };
+
+export type OneofShowcasePlain = OneofShowcasePlainFields & OneofShowcasePlainScalarChoice & OneofShowcasePlainMessageChoice & OneofShowcasePlainEnumChoice & OneofShowcasePlainContent & OneofShowcasePlainSourceType & OneofShowcasePlainDestinationType;
+
+/**
+ * This demonstrates oneof with embed=true where variants also have embed=true
+ * Result: all fields from Heartbeat/ProcessStarted/ProcessExited/NetworkEvent
+ * are flattened directly into PlatformEventPlain
+ */
+/** Fields of PlatformEventPlain outside of oneofs */
+export interface PlatformEventPlainFields {
+  /** Event header */
+  eventId?: string;
+  eventTime?: number;
+  source?: string;
+  /** Additional metadata */
+  labels?: Record<string, string>;
+}
+
+/** platform_event oneof of PlatformEventPlain, by platform_event_case */
+export type PlatformEventPlainPlatformEvent =
+  | {
+      platform_event_case: "heartbeat";
+      heartbeatTimestamp?: number;
+      heartbeatNodeId?: string;
+      heartbeatCpuPercent?: number;
+      heartbeatMemoryBytes?: number;
+    }
+  | {
+      platform_event_case: "process_started";
+      processStartedProcessId?: string;
+      processStartedCommand?: string;
+      processStartedArgs?: string[];
+      processStartedStartTime?: number;
+    }
+  | {
+      platform_event_case: "process_exited";
+      processExitedProcessId?: string;
+      processExitedExitCode?: number;
+      processExitedExitTime?: number;
+      processExitedSignal?: string;
+    }
+  | {
+      platform_event_case: "network_event";
+      networkEventInterfaceName?: string;
+      networkEventRemoteAddr?: string;
+      networkEventRemotePort?: number;
+      networkEventProtocol?: string;
+      networkEventBytesSent?: number;
+      networkEventBytesReceived?: number;
+    }
+  | { platform_event_case?: undefined };
+
+export type PlatformEventPlain = PlatformEventPlainFields & PlatformEventPlainPlatformEvent;
+
+export interface DeprecatedShowcasePlain {
+  id?: string;
+  name?: string;
+  /** Deprecated field (still works but marked) */
+  oldField?: string;
+  legacyCount?: number;
+  /** New field that replaced deprecated one */
+  newField?: string;
+}
+
+export interface DefaultsShowcasePlain {
+  /**
+   * In proto3, all fields have implicit zero defaults
+   * These demonstrate the zero values
+   */
+  emptyString?: string;
+  zeroInt?: number;
+  zeroLong?: number;
+  zeroDouble?: number;
+  falseBool?: boolean;
+  emptyBytes?: string;
+  zeroEnum?: 0 | 1 | 2 | 3 | 4;
+  /** Repeated fields default to empty */
+  emptyList?: string[];
+  emptyIntList?: number[];
+  /** Maps default to empty */
+  emptyMap?: Record<string, string>;
+  /** Messages default to nil/null */
+  nilMessage?: Record<string, unknown>;
+}
+
+/** Fields of ComplexNestedPlain outside of oneofs */
+export interface ComplexNestedPlainFields {
+  id?: string;
+  inner?: Record<string, unknown>;
+  innerList?: (Record<string, unknown>)[];
+  innerMap?: Record<string, Record<string, unknown>>;
+  innerEnum?: 0 | 1 | 2;
+  innerEnumList?: (0 | 1 | 2)[];
+}
+
+/** choice oneof of ComplexNestedPlain, by choice_case */
+export type ComplexNestedPlainChoice =
+  | {
+      choice_case: "choice_inner";
+      choiceChoiceInner?: Record<string, unknown>;
+    }
+  | {
+      choice_case: "choice_string";
+      choiceChoiceString?: string;
+    }
+  | { choice_case?: undefined };
+
+export type ComplexNestedPlain = ComplexNestedPlainFields & ComplexNestedPlainChoice;
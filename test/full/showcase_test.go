@@ -345,7 +345,7 @@ func TestOneofEmbedWithFieldEmbed(t *testing.T) {
 	assert.Equal(t, "evt-001", plain.EventId)
 	assert.Equal(t, int64(1706000000), plain.EventTime)
 	assert.Equal(t, "node-1", plain.Source)
-	assert.Equal(t, "heartbeat", plain.PlatformEventCase)
+	assert.Equal(t, full.PlatformEventPlatformEventCaseHeartbeat, plain.PlatformEventCase)
 
 	// Check flattened Heartbeat fields (double embed: oneof.embed + field.embed)
 	assert.Equal(t, int64(1706000000), plain.HeartbeatTimestamp)
@@ -369,7 +369,7 @@ func TestOneofEmbedWithFieldEmbed(t *testing.T) {
 	}
 
 	plainProcess := processEvent.IntoPlain()
-	assert.Equal(t, "process_started", plainProcess.PlatformEventCase)
+	assert.Equal(t, full.PlatformEventPlatformEventCaseProcessStarted, plainProcess.PlatformEventCase)
 	assert.Equal(t, "pid-123", plainProcess.ProcessStartedProcessId)
 	assert.Equal(t, "/usr/bin/myapp", plainProcess.ProcessStartedCommand)
 	assert.Equal(t, []string{"--config", "/etc/myapp.conf"}, plainProcess.ProcessStartedArgs)
@@ -439,7 +439,7 @@ func TestFullShowcase(t *testing.T) {
 	assert.Equal(t, "Full Showcase Document", plain2.Title)
 	assert.Equal(t, "This demonstrates all features", plain2.Description)
 	assert.Equal(t, "showcase@example.com", plain2.Email)
-	assert.Equal(t, "text_content", plain2.ContentCase)
+	assert.Equal(t, full.DocumentContentCaseTextContent, plain2.ContentCase)
 
 	t.Logf("=== FULL SHOWCASE SUMMARY ===")
 	t.Logf("Document ID: %s", plain2.Id)
@@ -946,7 +946,7 @@ func TestRoundtrip_Document(t *testing.T) {
 				Format: "markdown",
 			},
 		},
-		// Note: non-embedded oneof (Source) is not included in Plain
+		Source: &full.Document_FilePath{FilePath: "/docs/roundtrip.md"},
 		Children: []*full.Document{
 			{Id: "child-1", Title: "Child Document 1"},
 			{Id: "child-2", Title: "Child Document 2"},
@@ -980,6 +980,10 @@ func TestRoundtrip_Document(t *testing.T) {
 	require.NotNil(t, restored.GetTextContent())
 	assert.Equal(t, original.GetTextContent().Text, restored.GetTextContent().Text)
 
+	// Non-embedded source oneof survives as well
+	assert.Equal(t, full.DocumentSourceCaseFilePath, plain2.SourceCase)
+	assert.Equal(t, "/docs/roundtrip.md", restored.GetFilePath())
+
 	t.Logf("Document roundtrip: %d bytes JSON", len(jsonData))
 }
 
@@ -0,0 +1,95 @@
+package full_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"github.com/yaroher/protoc-gen-go-plain/test/sqltest"
+)
+
+// sqlRow is a Plain struct with the sql=true methods
+type sqlRow interface {
+	Columns() []string
+	ScanRow(rows interface{ Scan(...any) error }) error
+	Values() []any
+}
+
+// sqlRoundtrip inserts src into table and scans the row back into dst
+func sqlRoundtrip(t *testing.T, table string, src, dst sqlRow) {
+	t.Helper()
+	db, err := sqltest.Open()
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.Len(t, src.Values(), len(src.Columns()))
+	_, err = db.Exec(table, src.Values()...)
+	require.NoError(t, err)
+
+	rows, err := db.Query(table)
+	require.NoError(t, err)
+	defer rows.Close()
+	require.True(t, rows.Next())
+	require.NoError(t, dst.ScanRow(rows))
+	require.NoError(t, rows.Err())
+}
+
+func TestSQL_Columns(t *testing.T) {
+	assert.Equal(t,
+		[]string{"id", "street", "lat", "lng", "items", "contact_email", "contact_phone", "contact_case"},
+		(&full.ExcludeAccountPlain{}).Columns(),
+		"flattened field names, oneof case last")
+}
+
+func TestSQL_Roundtrip(t *testing.T) {
+	src := collectionShelf().IntoPlain()
+	dst := &full.CollectionShelfPlain{}
+	sqlRoundtrip(t, t.Name(), src, dst)
+	assert.True(t, src.Equal(dst), "got %+v", dst)
+}
+
+func TestSQL_RoundtripOneof(t *testing.T) {
+	for name, doc := range choiceDocuments() {
+		t.Run(name, func(t *testing.T) {
+			src := doc.IntoPlain()
+			dst := &full.ChoiceDocumentPlain{}
+			sqlRoundtrip(t, t.Name(), src, dst)
+			assert.True(t, src.Equal(dst), "got %+v", dst)
+		})
+	}
+}
+
+func TestSQL_JSONColumns(t *testing.T) {
+	src := newCustomer().IntoPlain()
+	values := src.Values()
+	require.Len(t, values, 4)
+	assert.Implements(t, (*driver.Valuer)(nil), values[2])
+	assert.Implements(t, (*sql.Scanner)(nil), values[2])
+
+	dst := &full.CustomerPlain{Name: "stale", ShippingAddresses: []full.CustomerShippingAddressesItemPlain{{City: "stale"}}}
+	sqlRoundtrip(t, t.Name(), src, dst)
+	assert.True(t, src.Equal(dst), "repeated embed rows are stored as JSON")
+
+	empty := &full.CustomerPlain{}
+	sqlRoundtrip(t, t.Name()+"/empty", &full.CustomerPlain{Id: "c"}, empty)
+	assert.Nil(t, empty.Addresses, "nil slices are stored as NULL")
+}
+
+func TestSQL_Uint64(t *testing.T) {
+	src := &full.TableUserPlain{Id: "u-1", Quota: math.MaxUint64}
+	dst := &full.TableUserPlain{}
+	sqlRoundtrip(t, t.Name(), src, dst)
+	assert.Equal(t, uint64(math.MaxUint64), dst.Quota)
+
+	largest := uint64(math.MaxUint64)
+	opt := &full.OptionalShowcasePlain{OptUint64: &largest}
+	optDst := &full.OptionalShowcasePlain{OptFixed64: new(uint64)}
+	sqlRoundtrip(t, t.Name()+"/optional", opt, optDst)
+	require.NotNil(t, optDst.OptUint64)
+	assert.Equal(t, largest, *optDst.OptUint64)
+	assert.Nil(t, optDst.OptFixed64, "nil is stored as NULL")
+}
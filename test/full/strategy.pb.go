@@ -0,0 +1,434 @@
+// Automatic resolution of embed collisions: collision_strategy
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/strategy.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StrategyContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyContact) Reset() {
+	*x = StrategyContact{}
+	mi := &file_test_full_strategy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyContact) ProtoMessage() {}
+
+func (x *StrategyContact) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_strategy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyContact.ProtoReflect.Descriptor instead.
+func (*StrategyContact) Descriptor() ([]byte, []int) {
+	return file_test_full_strategy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StrategyContact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StrategyContact) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type StrategyAudit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyAudit) Reset() {
+	*x = StrategyAudit{}
+	mi := &file_test_full_strategy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyAudit) ProtoMessage() {}
+
+func (x *StrategyAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_strategy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyAudit.ProtoReflect.Descriptor instead.
+func (*StrategyAudit) Descriptor() ([]byte, []int) {
+	return file_test_full_strategy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StrategyAudit) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *StrategyAudit) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type StrategyBranch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *StrategyContact       `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyBranch) Reset() {
+	*x = StrategyBranch{}
+	mi := &file_test_full_strategy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyBranch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyBranch) ProtoMessage() {}
+
+func (x *StrategyBranch) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_strategy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyBranch.ProtoReflect.Descriptor instead.
+func (*StrategyBranch) Descriptor() ([]byte, []int) {
+	return file_test_full_strategy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StrategyBranch) GetContact() *StrategyContact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+// File strategy: the later field gets its embed path as prefix,
+// a direct field keeps its name and the embedded one is renamed instead
+type StrategyLater struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         *StrategyContact       `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Audit         *StrategyAudit         `protobuf:"bytes,3,opt,name=audit,proto3" json:"audit,omitempty"`
+	Branch        *StrategyBranch        `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyLater) Reset() {
+	*x = StrategyLater{}
+	mi := &file_test_full_strategy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyLater) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyLater) ProtoMessage() {}
+
+func (x *StrategyLater) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_strategy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyLater.ProtoReflect.Descriptor instead.
+func (*StrategyLater) Descriptor() ([]byte, []int) {
+	return file_test_full_strategy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StrategyLater) GetOwner() *StrategyContact {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *StrategyLater) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StrategyLater) GetAudit() *StrategyAudit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *StrategyLater) GetBranch() *StrategyBranch {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+type StrategyBoth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         *StrategyContact       `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Audit         *StrategyAudit         `protobuf:"bytes,2,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyBoth) Reset() {
+	*x = StrategyBoth{}
+	mi := &file_test_full_strategy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyBoth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyBoth) ProtoMessage() {}
+
+func (x *StrategyBoth) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_strategy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyBoth.ProtoReflect.Descriptor instead.
+func (*StrategyBoth) Descriptor() ([]byte, []int) {
+	return file_test_full_strategy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StrategyBoth) GetOwner() *StrategyContact {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *StrategyBoth) GetAudit() *StrategyAudit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type StrategyFirst struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         *StrategyContact       `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Audit         *StrategyAudit         `protobuf:"bytes,2,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyFirst) Reset() {
+	*x = StrategyFirst{}
+	mi := &file_test_full_strategy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyFirst) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyFirst) ProtoMessage() {}
+
+func (x *StrategyFirst) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_strategy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyFirst.ProtoReflect.Descriptor instead.
+func (*StrategyFirst) Descriptor() ([]byte, []int) {
+	return file_test_full_strategy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StrategyFirst) GetOwner() *StrategyContact {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *StrategyFirst) GetAudit() *StrategyAudit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+var File_test_full_strategy_proto protoreflect.FileDescriptor
+
+const file_test_full_strategy_proto_rawDesc = "" +
+	"\n" +
+	"\x18test/full/strategy.proto\x12\x04full\x1a\x15goplain/goplain.proto\"9\n" +
+	"\x0fStrategyContact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\"B\n" +
+	"\rStrategyAudit\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\x03R\tupdatedAt\"I\n" +
+	"\x0eStrategyBranch\x127\n" +
+	"\acontact\x18\x01 \x01(\v2\x15.full.StrategyContactB\x06\x82\xa6\x1d\x02 \x01R\acontact\"\xc9\x01\n" +
+	"\rStrategyLater\x123\n" +
+	"\x05owner\x18\x01 \x01(\v2\x15.full.StrategyContactB\x06\x82\xa6\x1d\x02 \x01R\x05owner\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
+	"\x05audit\x18\x03 \x01(\v2\x13.full.StrategyAuditB\x06\x82\xa6\x1d\x02 \x01R\x05audit\x124\n" +
+	"\x06branch\x18\x04 \x01(\v2\x14.full.StrategyBranchB\x06\x82\xa6\x1d\x02 \x01R\x06branch:\x06\x82\xa6\x1d\x02\b\x01\"\x80\x01\n" +
+	"\fStrategyBoth\x123\n" +
+	"\x05owner\x18\x01 \x01(\v2\x15.full.StrategyContactB\x06\x82\xa6\x1d\x02 \x01R\x05owner\x121\n" +
+	"\x05audit\x18\x02 \x01(\v2\x13.full.StrategyAuditB\x06\x82\xa6\x1d\x02 \x01R\x05audit:\b\x82\xa6\x1d\x04\b\x010\x03\"\x81\x01\n" +
+	"\rStrategyFirst\x123\n" +
+	"\x05owner\x18\x01 \x01(\v2\x15.full.StrategyContactB\x06\x82\xa6\x1d\x02 \x01R\x05owner\x121\n" +
+	"\x05audit\x18\x02 \x01(\v2\x13.full.StrategyAuditB\x06\x82\xa6\x1d\x02 \x01R\x05audit:\b\x82\xa6\x1d\x04\b\x010\x04B8\x82\xa6\x1d\x02 \x02Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_strategy_proto_rawDescOnce sync.Once
+	file_test_full_strategy_proto_rawDescData []byte
+)
+
+func file_test_full_strategy_proto_rawDescGZIP() []byte {
+	file_test_full_strategy_proto_rawDescOnce.Do(func() {
+		file_test_full_strategy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_strategy_proto_rawDesc), len(file_test_full_strategy_proto_rawDesc)))
+	})
+	return file_test_full_strategy_proto_rawDescData
+}
+
+var file_test_full_strategy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_test_full_strategy_proto_goTypes = []any{
+	(*StrategyContact)(nil), // 0: full.StrategyContact
+	(*StrategyAudit)(nil),   // 1: full.StrategyAudit
+	(*StrategyBranch)(nil),  // 2: full.StrategyBranch
+	(*StrategyLater)(nil),   // 3: full.StrategyLater
+	(*StrategyBoth)(nil),    // 4: full.StrategyBoth
+	(*StrategyFirst)(nil),   // 5: full.StrategyFirst
+}
+var file_test_full_strategy_proto_depIdxs = []int32{
+	0, // 0: full.StrategyBranch.contact:type_name -> full.StrategyContact
+	0, // 1: full.StrategyLater.owner:type_name -> full.StrategyContact
+	1, // 2: full.StrategyLater.audit:type_name -> full.StrategyAudit
+	2, // 3: full.StrategyLater.branch:type_name -> full.StrategyBranch
+	0, // 4: full.StrategyBoth.owner:type_name -> full.StrategyContact
+	1, // 5: full.StrategyBoth.audit:type_name -> full.StrategyAudit
+	0, // 6: full.StrategyFirst.owner:type_name -> full.StrategyContact
+	1, // 7: full.StrategyFirst.audit:type_name -> full.StrategyAudit
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_test_full_strategy_proto_init() }
+func file_test_full_strategy_proto_init() {
+	if File_test_full_strategy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_strategy_proto_rawDesc), len(file_test_full_strategy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_strategy_proto_goTypes,
+		DependencyIndexes: file_test_full_strategy_proto_depIdxs,
+		MessageInfos:      file_test_full_strategy_proto_msgTypes,
+	}.Build()
+	File_test_full_strategy_proto = out.File
+	file_test_full_strategy_proto_goTypes = nil
+	file_test_full_strategy_proto_depIdxs = nil
+}
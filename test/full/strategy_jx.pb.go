@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/strategy.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+)
+
+// MarshalJX encodes StrategyContact to JSON using jx.Encoder
+func (p *StrategyContact) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetName() != "" {
+		e.FieldStart("name")
+		e.Str(p.GetName())
+	}
+	if p.GetCity() != "" {
+		e.FieldStart("city")
+		e.Str(p.GetCity())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes StrategyContact from JSON using jx.Decoder
+func (p *StrategyContact) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes StrategyAudit to JSON using jx.Encoder
+func (p *StrategyAudit) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetCity() != "" {
+		e.FieldStart("city")
+		e.Str(p.GetCity())
+	}
+	if p.GetUpdatedAt() != 0 {
+		e.FieldStart("updatedAt")
+		e.Int64(p.GetUpdatedAt())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes StrategyAudit from JSON using jx.Decoder
+func (p *StrategyAudit) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		case "updatedAt":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.UpdatedAt = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes StrategyBranch to JSON using jx.Encoder
+func (p *StrategyBranch) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetContact() != nil {
+		e.FieldStart("contact")
+		p.GetContact().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes StrategyBranch from JSON using jx.Decoder
+func (p *StrategyBranch) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "contact":
+			p.Contact = &StrategyContact{}
+			if err := p.Contact.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes StrategyLater to JSON using jx.Encoder
+func (p *StrategyLater) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetOwner() != nil {
+		e.FieldStart("owner")
+		p.GetOwner().MarshalJX(e)
+	}
+	if p.GetName() != "" {
+		e.FieldStart("name")
+		e.Str(p.GetName())
+	}
+	if p.GetAudit() != nil {
+		e.FieldStart("audit")
+		p.GetAudit().MarshalJX(e)
+	}
+	if p.GetBranch() != nil {
+		e.FieldStart("branch")
+		p.GetBranch().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes StrategyLater from JSON using jx.Decoder
+func (p *StrategyLater) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "owner":
+			p.Owner = &StrategyContact{}
+			if err := p.Owner.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		case "audit":
+			p.Audit = &StrategyAudit{}
+			if err := p.Audit.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "branch":
+			p.Branch = &StrategyBranch{}
+			if err := p.Branch.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes StrategyBoth to JSON using jx.Encoder
+func (p *StrategyBoth) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetOwner() != nil {
+		e.FieldStart("owner")
+		p.GetOwner().MarshalJX(e)
+	}
+	if p.GetAudit() != nil {
+		e.FieldStart("audit")
+		p.GetAudit().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes StrategyBoth from JSON using jx.Decoder
+func (p *StrategyBoth) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "owner":
+			p.Owner = &StrategyContact{}
+			if err := p.Owner.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "audit":
+			p.Audit = &StrategyAudit{}
+			if err := p.Audit.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes StrategyFirst to JSON using jx.Encoder
+func (p *StrategyFirst) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetOwner() != nil {
+		e.FieldStart("owner")
+		p.GetOwner().MarshalJX(e)
+	}
+	if p.GetAudit() != nil {
+		e.FieldStart("audit")
+		p.GetAudit().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes StrategyFirst from JSON using jx.Decoder
+func (p *StrategyFirst) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "owner":
+			p.Owner = &StrategyContact{}
+			if err := p.Owner.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "audit":
+			p.Audit = &StrategyAudit{}
+			if err := p.Audit.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
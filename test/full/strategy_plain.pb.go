@@ -0,0 +1,1649 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/strategy.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	sync "sync"
+)
+
+// File strategy: the later field gets its embed path as prefix,
+//
+//	a direct field keeps its name and the embedded one is renamed instead
+type StrategyLaterPlain struct {
+	OwnerName         string `json:"ownerName"`
+	City              string `json:"city"`
+	Name              string `json:"name"`
+	AuditCity         string `json:"auditCity"`
+	UpdatedAt         int64  `json:"updatedAt"`
+	BranchContactName string `json:"branchContactName"`
+	BranchContactCity string `json:"branchContactCity"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *StrategyLater) IntoPlain() *StrategyLaterPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &StrategyLaterPlain{}
+
+	// OwnerName from
+	if pb.GetOwner() != nil {
+		p.OwnerName = pb.GetOwner().GetName()
+	}
+	// City from
+	if pb.GetOwner() != nil {
+		p.City = pb.GetOwner().GetCity()
+	}
+	p.Name = pb.Name
+	// AuditCity from
+	if pb.GetAudit() != nil {
+		p.AuditCity = pb.GetAudit().GetCity()
+	}
+	// UpdatedAt from
+	if pb.GetAudit() != nil {
+		p.UpdatedAt = pb.GetAudit().GetUpdatedAt()
+	}
+	// BranchContactName from
+	if pb.GetBranch() != nil && pb.GetBranch().GetContact() != nil {
+		p.BranchContactName = pb.GetBranch().GetContact().GetName()
+	}
+	// BranchContactCity from
+	if pb.GetBranch() != nil && pb.GetBranch().GetContact() != nil {
+		p.BranchContactCity = pb.GetBranch().GetContact().GetCity()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *StrategyLaterPlain) IntoPb() *StrategyLater {
+	if p == nil {
+		return nil
+	}
+	pb := &StrategyLater{}
+
+	// OwnerName ->
+	if p.OwnerName != "" {
+		if pb.Owner == nil {
+			pb.Owner = &StrategyContact{}
+		}
+		pb.Owner.Name = p.OwnerName
+	}
+	// City ->
+	if p.City != "" {
+		if pb.Owner == nil {
+			pb.Owner = &StrategyContact{}
+		}
+		pb.Owner.City = p.City
+	}
+	pb.Name = p.Name
+	// AuditCity ->
+	if p.AuditCity != "" {
+		if pb.Audit == nil {
+			pb.Audit = &StrategyAudit{}
+		}
+		pb.Audit.City = p.AuditCity
+	}
+	// UpdatedAt ->
+	if pb.Audit == nil {
+		pb.Audit = &StrategyAudit{}
+	}
+	pb.Audit.UpdatedAt = p.UpdatedAt
+	// BranchContactName ->
+	if p.BranchContactName != "" {
+		if pb.Branch == nil {
+			pb.Branch = &StrategyBranch{}
+		}
+		if pb.Branch.Contact == nil {
+			pb.Branch.Contact = &StrategyContact{}
+		}
+		pb.Branch.Contact.Name = p.BranchContactName
+	}
+	// BranchContactCity ->
+	if p.BranchContactCity != "" {
+		if pb.Branch == nil {
+			pb.Branch = &StrategyBranch{}
+		}
+		if pb.Branch.Contact == nil {
+			pb.Branch.Contact = &StrategyContact{}
+		}
+		pb.Branch.Contact.City = p.BranchContactCity
+	}
+	return pb
+}
+
+// strategyLaterPlainMergePaths are the fields of StrategyLater covered by StrategyLaterPlain
+var strategyLaterPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1, 1},
+	{1, 2},
+	{2},
+	{3, 1},
+	{3, 2},
+	{4, 1, 1},
+	{4, 1, 2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *StrategyLaterPlain) IntoPbMerge(dst *StrategyLater) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, strategyLaterPlainMergePaths...)
+}
+
+// strategyLaterPlainFieldPaths link the JSON names of StrategyLaterPlain to field-mask paths of StrategyLater
+var strategyLaterPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "ownerName", Path: "owner.name"},
+	{Name: "city", Path: "owner.city"},
+	{Name: "name", Path: "name"},
+	{Name: "auditCity", Path: "audit.city"},
+	{Name: "updatedAt", Path: "audit.updated_at"},
+	{Name: "branchContactName", Path: "branch.contact.name"},
+	{Name: "branchContactCity", Path: "branch.contact.city"},
+}
+
+// StrategyLaterPlainFieldMask builds a field mask of StrategyLater from JSON names of StrategyLaterPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func StrategyLaterPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(strategyLaterPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// StrategyLaterPlainFieldNames translates a field mask of StrategyLater into JSON names of StrategyLaterPlain.
+// A path to an embedded message yields all fields flattened from it.
+func StrategyLaterPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(strategyLaterPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of StrategyLater, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *StrategyLaterPlain) ApplyToPb(pb *StrategyLater, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), strategyLaterPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *StrategyLater) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*StrategyLaterPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), strategyLaterPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &StrategyLater{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *StrategyLater) IntoPlainReuse(p *StrategyLaterPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// OwnerName from
+	if pb.GetOwner() != nil {
+		p.OwnerName = pb.GetOwner().GetName()
+	}
+	// City from
+	if pb.GetOwner() != nil {
+		p.City = pb.GetOwner().GetCity()
+	}
+	p.Name = pb.Name
+	// AuditCity from
+	if pb.GetAudit() != nil {
+		p.AuditCity = pb.GetAudit().GetCity()
+	}
+	// UpdatedAt from
+	if pb.GetAudit() != nil {
+		p.UpdatedAt = pb.GetAudit().GetUpdatedAt()
+	}
+	// BranchContactName from
+	if pb.GetBranch() != nil && pb.GetBranch().GetContact() != nil {
+		p.BranchContactName = pb.GetBranch().GetContact().GetName()
+	}
+	// BranchContactCity from
+	if pb.GetBranch() != nil && pb.GetBranch().GetContact() != nil {
+		p.BranchContactCity = pb.GetBranch().GetContact().GetCity()
+	}
+}
+
+// MarshalJX encodes StrategyLaterPlain to JSON using jx.Encoder
+func (p *StrategyLaterPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.OwnerName != "" {
+		e.FieldStart("ownerName")
+		e.Str(p.OwnerName)
+	}
+	if p.City != "" {
+		e.FieldStart("city")
+		e.Str(p.City)
+	}
+	if p.Name != "" {
+		e.FieldStart("name")
+		e.Str(p.Name)
+	}
+	if p.AuditCity != "" {
+		e.FieldStart("auditCity")
+		e.Str(p.AuditCity)
+	}
+	if p.UpdatedAt != 0 {
+		e.FieldStart("updatedAt")
+		e.Int64(p.UpdatedAt)
+	}
+	if p.BranchContactName != "" {
+		e.FieldStart("branchContactName")
+		e.Str(p.BranchContactName)
+	}
+	if p.BranchContactCity != "" {
+		e.FieldStart("branchContactCity")
+		e.Str(p.BranchContactCity)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *StrategyLaterPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes StrategyLaterPlain from JSON using jx.Decoder
+func (p *StrategyLaterPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "ownerName":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.OwnerName = v
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		case "auditCity":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.AuditCity = v
+		case "updatedAt":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.UpdatedAt = v
+		case "branchContactName":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.BranchContactName = v
+		case "branchContactCity":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.BranchContactCity = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *StrategyLaterPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes StrategyLaterPlain in the protobuf wire format of StrategyLater
+func (p *StrategyLaterPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends StrategyLaterPlain encoded in the protobuf wire format of StrategyLater to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *StrategyLaterPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	// owner (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		_start := len(b)
+		if v := p.OwnerName; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.City; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	if v := p.Name; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	// audit (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if v := p.AuditCity; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.UpdatedAt; v != 0 {
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	// branch (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		_start := len(b)
+		// contact (embedded)
+		{
+			_tag := len(b)
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			_start := len(b)
+			if v := p.BranchContactName; v != "" {
+				b = protowire.AppendTag(b, 1, protowire.BytesType)
+				b = protowire.AppendString(b, v)
+			}
+			if v := p.BranchContactCity; v != "" {
+				b = protowire.AppendTag(b, 2, protowire.BytesType)
+				b = protowire.AppendString(b, v)
+			}
+			b = plainwire.FinishEmbed(b, _tag, _start)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes StrategyLaterPlain from the protobuf wire format of StrategyLater.
+// Unknown fields are skipped.
+func (p *StrategyLaterPlain) UnmarshalProto(b []byte) error {
+	*p = StrategyLaterPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.OwnerName = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.City = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Name = v
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.AuditCity = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.UpdatedAt = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 4 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					for b := v; len(b) > 0; {
+						num, typ, n := protowire.ConsumeTag(b)
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+						switch {
+						case num == 1 && typ == protowire.BytesType:
+							var v string
+							v, n = protowire.ConsumeString(b)
+							p.BranchContactName = v
+						case num == 2 && typ == protowire.BytesType:
+							var v string
+							v, n = protowire.ConsumeString(b)
+							p.BranchContactCity = v
+						default:
+							n = protowire.ConsumeFieldValue(num, typ, b)
+						}
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						b = b[n:]
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// strategyLaterPlainReflect describes StrategyLaterPlain as message full.plain.StrategyLaterPlain
+var strategyLaterPlainReflect = plainreflect.NewMessageInfo(file_test_full_strategy_proto_plain, "StrategyLaterPlain",
+	plainreflect.Scalar(func(p *StrategyLaterPlain) *string { return &p.OwnerName }),
+	plainreflect.Scalar(func(p *StrategyLaterPlain) *string { return &p.City }),
+	plainreflect.Scalar(func(p *StrategyLaterPlain) *string { return &p.Name }),
+	plainreflect.Scalar(func(p *StrategyLaterPlain) *string { return &p.AuditCity }),
+	plainreflect.Scalar(func(p *StrategyLaterPlain) *int64 { return &p.UpdatedAt }),
+	plainreflect.Scalar(func(p *StrategyLaterPlain) *string { return &p.BranchContactName }),
+	plainreflect.Scalar(func(p *StrategyLaterPlain) *string { return &p.BranchContactCity }),
+)
+
+// ProtoReflect returns the reflective view of StrategyLaterPlain backed by the struct
+func (p *StrategyLaterPlain) ProtoReflect() protoreflect.Message {
+	return strategyLaterPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of StrategyLaterPlain
+func (p *StrategyLaterPlain) Clone() *StrategyLaterPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &StrategyLaterPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of StrategyLaterPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetStrategyLaterPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *StrategyLaterPlain) CopyTo(dst *StrategyLaterPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.OwnerName = p.OwnerName
+	dst.City = p.City
+	dst.Name = p.Name
+	dst.AuditCity = p.AuditCity
+	dst.UpdatedAt = p.UpdatedAt
+	dst.BranchContactName = p.BranchContactName
+	dst.BranchContactCity = p.BranchContactCity
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *StrategyLaterPlain) Equal(other *StrategyLaterPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.OwnerName != other.OwnerName {
+		return false
+	}
+	if p.City != other.City {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.AuditCity != other.AuditCity {
+		return false
+	}
+	if p.UpdatedAt != other.UpdatedAt {
+		return false
+	}
+	if p.BranchContactName != other.BranchContactName {
+		return false
+	}
+	if p.BranchContactCity != other.BranchContactCity {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of StrategyLaterPlain rows, in the order of ScanRow and Values
+func (p *StrategyLaterPlain) Columns() []string {
+	return []string{
+		"owner_name",
+		"city",
+		"name",
+		"audit_city",
+		"updated_at",
+		"branch_contact_name",
+		"branch_contact_city",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into StrategyLaterPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *StrategyLaterPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.OwnerName,
+		&p.City,
+		&p.Name,
+		&p.AuditCity,
+		&p.UpdatedAt,
+		&p.BranchContactName,
+		&p.BranchContactCity,
+	)
+}
+
+// Values returns the column values of StrategyLaterPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *StrategyLaterPlain) Values() []any {
+	return []any{
+		p.OwnerName,
+		p.City,
+		p.Name,
+		p.AuditCity,
+		p.UpdatedAt,
+		p.BranchContactName,
+		p.BranchContactCity,
+	}
+}
+
+// strategyLaterPlainPool is a sync.Pool for StrategyLaterPlain objects
+var strategyLaterPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &StrategyLaterPlain{}
+	},
+}
+
+// GetStrategyLaterPlain returns a StrategyLaterPlain from the pool
+func GetStrategyLaterPlain() *StrategyLaterPlain {
+	return strategyLaterPlainPool.Get().(*StrategyLaterPlain)
+}
+
+// PutStrategyLaterPlain returns a StrategyLaterPlain to the pool after resetting it
+func PutStrategyLaterPlain(p *StrategyLaterPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	strategyLaterPlainPool.Put(p)
+}
+
+// Reset clears all fields in StrategyLaterPlain for reuse
+func (p *StrategyLaterPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.OwnerName = ""
+	p.City = ""
+	p.Name = ""
+	p.AuditCity = ""
+	p.UpdatedAt = 0
+	p.BranchContactName = ""
+	p.BranchContactCity = ""
+}
+
+type StrategyBothPlain struct {
+	Name      string `json:"name"`
+	OwnerCity string `json:"ownerCity"`
+	AuditCity string `json:"auditCity"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *StrategyBoth) IntoPlain() *StrategyBothPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &StrategyBothPlain{}
+
+	// Name from
+	if pb.GetOwner() != nil {
+		p.Name = pb.GetOwner().GetName()
+	}
+	// OwnerCity from
+	if pb.GetOwner() != nil {
+		p.OwnerCity = pb.GetOwner().GetCity()
+	}
+	// AuditCity from
+	if pb.GetAudit() != nil {
+		p.AuditCity = pb.GetAudit().GetCity()
+	}
+	// UpdatedAt from
+	if pb.GetAudit() != nil {
+		p.UpdatedAt = pb.GetAudit().GetUpdatedAt()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *StrategyBothPlain) IntoPb() *StrategyBoth {
+	if p == nil {
+		return nil
+	}
+	pb := &StrategyBoth{}
+
+	// Name ->
+	if p.Name != "" {
+		if pb.Owner == nil {
+			pb.Owner = &StrategyContact{}
+		}
+		pb.Owner.Name = p.Name
+	}
+	// OwnerCity ->
+	if p.OwnerCity != "" {
+		if pb.Owner == nil {
+			pb.Owner = &StrategyContact{}
+		}
+		pb.Owner.City = p.OwnerCity
+	}
+	// AuditCity ->
+	if p.AuditCity != "" {
+		if pb.Audit == nil {
+			pb.Audit = &StrategyAudit{}
+		}
+		pb.Audit.City = p.AuditCity
+	}
+	// UpdatedAt ->
+	if pb.Audit == nil {
+		pb.Audit = &StrategyAudit{}
+	}
+	pb.Audit.UpdatedAt = p.UpdatedAt
+	return pb
+}
+
+// strategyBothPlainMergePaths are the fields of StrategyBoth covered by StrategyBothPlain
+var strategyBothPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1, 1},
+	{1, 2},
+	{2, 1},
+	{2, 2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *StrategyBothPlain) IntoPbMerge(dst *StrategyBoth) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, strategyBothPlainMergePaths...)
+}
+
+// strategyBothPlainFieldPaths link the JSON names of StrategyBothPlain to field-mask paths of StrategyBoth
+var strategyBothPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "name", Path: "owner.name"},
+	{Name: "ownerCity", Path: "owner.city"},
+	{Name: "auditCity", Path: "audit.city"},
+	{Name: "updatedAt", Path: "audit.updated_at"},
+}
+
+// StrategyBothPlainFieldMask builds a field mask of StrategyBoth from JSON names of StrategyBothPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func StrategyBothPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(strategyBothPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// StrategyBothPlainFieldNames translates a field mask of StrategyBoth into JSON names of StrategyBothPlain.
+// A path to an embedded message yields all fields flattened from it.
+func StrategyBothPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(strategyBothPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of StrategyBoth, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *StrategyBothPlain) ApplyToPb(pb *StrategyBoth, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), strategyBothPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *StrategyBoth) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*StrategyBothPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), strategyBothPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &StrategyBoth{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *StrategyBoth) IntoPlainReuse(p *StrategyBothPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// Name from
+	if pb.GetOwner() != nil {
+		p.Name = pb.GetOwner().GetName()
+	}
+	// OwnerCity from
+	if pb.GetOwner() != nil {
+		p.OwnerCity = pb.GetOwner().GetCity()
+	}
+	// AuditCity from
+	if pb.GetAudit() != nil {
+		p.AuditCity = pb.GetAudit().GetCity()
+	}
+	// UpdatedAt from
+	if pb.GetAudit() != nil {
+		p.UpdatedAt = pb.GetAudit().GetUpdatedAt()
+	}
+}
+
+// MarshalJX encodes StrategyBothPlain to JSON using jx.Encoder
+func (p *StrategyBothPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Name != "" {
+		e.FieldStart("name")
+		e.Str(p.Name)
+	}
+	if p.OwnerCity != "" {
+		e.FieldStart("ownerCity")
+		e.Str(p.OwnerCity)
+	}
+	if p.AuditCity != "" {
+		e.FieldStart("auditCity")
+		e.Str(p.AuditCity)
+	}
+	if p.UpdatedAt != 0 {
+		e.FieldStart("updatedAt")
+		e.Int64(p.UpdatedAt)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *StrategyBothPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes StrategyBothPlain from JSON using jx.Decoder
+func (p *StrategyBothPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		case "ownerCity":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.OwnerCity = v
+		case "auditCity":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.AuditCity = v
+		case "updatedAt":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.UpdatedAt = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *StrategyBothPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes StrategyBothPlain in the protobuf wire format of StrategyBoth
+func (p *StrategyBothPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends StrategyBothPlain encoded in the protobuf wire format of StrategyBoth to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *StrategyBothPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	// owner (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		_start := len(b)
+		if v := p.Name; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.OwnerCity; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	// audit (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		if v := p.AuditCity; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.UpdatedAt; v != 0 {
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes StrategyBothPlain from the protobuf wire format of StrategyBoth.
+// Unknown fields are skipped.
+func (p *StrategyBothPlain) UnmarshalProto(b []byte) error {
+	*p = StrategyBothPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.Name = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.OwnerCity = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.AuditCity = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.UpdatedAt = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// strategyBothPlainReflect describes StrategyBothPlain as message full.plain.StrategyBothPlain
+var strategyBothPlainReflect = plainreflect.NewMessageInfo(file_test_full_strategy_proto_plain, "StrategyBothPlain",
+	plainreflect.Scalar(func(p *StrategyBothPlain) *string { return &p.Name }),
+	plainreflect.Scalar(func(p *StrategyBothPlain) *string { return &p.OwnerCity }),
+	plainreflect.Scalar(func(p *StrategyBothPlain) *string { return &p.AuditCity }),
+	plainreflect.Scalar(func(p *StrategyBothPlain) *int64 { return &p.UpdatedAt }),
+)
+
+// ProtoReflect returns the reflective view of StrategyBothPlain backed by the struct
+func (p *StrategyBothPlain) ProtoReflect() protoreflect.Message {
+	return strategyBothPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of StrategyBothPlain
+func (p *StrategyBothPlain) Clone() *StrategyBothPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &StrategyBothPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of StrategyBothPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetStrategyBothPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *StrategyBothPlain) CopyTo(dst *StrategyBothPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Name = p.Name
+	dst.OwnerCity = p.OwnerCity
+	dst.AuditCity = p.AuditCity
+	dst.UpdatedAt = p.UpdatedAt
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *StrategyBothPlain) Equal(other *StrategyBothPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.OwnerCity != other.OwnerCity {
+		return false
+	}
+	if p.AuditCity != other.AuditCity {
+		return false
+	}
+	if p.UpdatedAt != other.UpdatedAt {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of StrategyBothPlain rows, in the order of ScanRow and Values
+func (p *StrategyBothPlain) Columns() []string {
+	return []string{
+		"name",
+		"owner_city",
+		"audit_city",
+		"updated_at",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into StrategyBothPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *StrategyBothPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Name,
+		&p.OwnerCity,
+		&p.AuditCity,
+		&p.UpdatedAt,
+	)
+}
+
+// Values returns the column values of StrategyBothPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *StrategyBothPlain) Values() []any {
+	return []any{
+		p.Name,
+		p.OwnerCity,
+		p.AuditCity,
+		p.UpdatedAt,
+	}
+}
+
+// strategyBothPlainPool is a sync.Pool for StrategyBothPlain objects
+var strategyBothPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &StrategyBothPlain{}
+	},
+}
+
+// GetStrategyBothPlain returns a StrategyBothPlain from the pool
+func GetStrategyBothPlain() *StrategyBothPlain {
+	return strategyBothPlainPool.Get().(*StrategyBothPlain)
+}
+
+// PutStrategyBothPlain returns a StrategyBothPlain to the pool after resetting it
+func PutStrategyBothPlain(p *StrategyBothPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	strategyBothPlainPool.Put(p)
+}
+
+// Reset clears all fields in StrategyBothPlain for reuse
+func (p *StrategyBothPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Name = ""
+	p.OwnerCity = ""
+	p.AuditCity = ""
+	p.UpdatedAt = 0
+}
+
+type StrategyFirstPlain struct {
+	Name      string `json:"name"`
+	City      string `json:"city"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *StrategyFirst) IntoPlain() *StrategyFirstPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &StrategyFirstPlain{}
+
+	// Name from
+	if pb.GetOwner() != nil {
+		p.Name = pb.GetOwner().GetName()
+	}
+	// City from
+	if pb.GetOwner() != nil {
+		p.City = pb.GetOwner().GetCity()
+	}
+	// UpdatedAt from
+	if pb.GetAudit() != nil {
+		p.UpdatedAt = pb.GetAudit().GetUpdatedAt()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *StrategyFirstPlain) IntoPb() *StrategyFirst {
+	if p == nil {
+		return nil
+	}
+	pb := &StrategyFirst{}
+
+	// Name ->
+	if p.Name != "" {
+		if pb.Owner == nil {
+			pb.Owner = &StrategyContact{}
+		}
+		pb.Owner.Name = p.Name
+	}
+	// City ->
+	if p.City != "" {
+		if pb.Owner == nil {
+			pb.Owner = &StrategyContact{}
+		}
+		pb.Owner.City = p.City
+	}
+	// UpdatedAt ->
+	if pb.Audit == nil {
+		pb.Audit = &StrategyAudit{}
+	}
+	pb.Audit.UpdatedAt = p.UpdatedAt
+	return pb
+}
+
+// strategyFirstPlainMergePaths are the fields of StrategyFirst covered by StrategyFirstPlain
+var strategyFirstPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1, 1},
+	{1, 2},
+	{2, 2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *StrategyFirstPlain) IntoPbMerge(dst *StrategyFirst) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, strategyFirstPlainMergePaths...)
+}
+
+// strategyFirstPlainFieldPaths link the JSON names of StrategyFirstPlain to field-mask paths of StrategyFirst
+var strategyFirstPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "name", Path: "owner.name"},
+	{Name: "city", Path: "owner.city"},
+	{Name: "updatedAt", Path: "audit.updated_at"},
+}
+
+// StrategyFirstPlainFieldMask builds a field mask of StrategyFirst from JSON names of StrategyFirstPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func StrategyFirstPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(strategyFirstPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// StrategyFirstPlainFieldNames translates a field mask of StrategyFirst into JSON names of StrategyFirstPlain.
+// A path to an embedded message yields all fields flattened from it.
+func StrategyFirstPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(strategyFirstPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of StrategyFirst, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *StrategyFirstPlain) ApplyToPb(pb *StrategyFirst, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), strategyFirstPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *StrategyFirst) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*StrategyFirstPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), strategyFirstPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &StrategyFirst{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *StrategyFirst) IntoPlainReuse(p *StrategyFirstPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// Name from
+	if pb.GetOwner() != nil {
+		p.Name = pb.GetOwner().GetName()
+	}
+	// City from
+	if pb.GetOwner() != nil {
+		p.City = pb.GetOwner().GetCity()
+	}
+	// UpdatedAt from
+	if pb.GetAudit() != nil {
+		p.UpdatedAt = pb.GetAudit().GetUpdatedAt()
+	}
+}
+
+// MarshalJX encodes StrategyFirstPlain to JSON using jx.Encoder
+func (p *StrategyFirstPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Name != "" {
+		e.FieldStart("name")
+		e.Str(p.Name)
+	}
+	if p.City != "" {
+		e.FieldStart("city")
+		e.Str(p.City)
+	}
+	if p.UpdatedAt != 0 {
+		e.FieldStart("updatedAt")
+		e.Int64(p.UpdatedAt)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *StrategyFirstPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes StrategyFirstPlain from JSON using jx.Decoder
+func (p *StrategyFirstPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		case "updatedAt":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.UpdatedAt = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *StrategyFirstPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes StrategyFirstPlain in the protobuf wire format of StrategyFirst
+func (p *StrategyFirstPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends StrategyFirstPlain encoded in the protobuf wire format of StrategyFirst to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *StrategyFirstPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	// owner (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		_start := len(b)
+		if v := p.Name; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.City; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	// audit (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		if v := p.UpdatedAt; v != 0 {
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes StrategyFirstPlain from the protobuf wire format of StrategyFirst.
+// Unknown fields are skipped.
+func (p *StrategyFirstPlain) UnmarshalProto(b []byte) error {
+	*p = StrategyFirstPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.Name = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.City = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.UpdatedAt = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// strategyFirstPlainReflect describes StrategyFirstPlain as message full.plain.StrategyFirstPlain
+var strategyFirstPlainReflect = plainreflect.NewMessageInfo(file_test_full_strategy_proto_plain, "StrategyFirstPlain",
+	plainreflect.Scalar(func(p *StrategyFirstPlain) *string { return &p.Name }),
+	plainreflect.Scalar(func(p *StrategyFirstPlain) *string { return &p.City }),
+	plainreflect.Scalar(func(p *StrategyFirstPlain) *int64 { return &p.UpdatedAt }),
+)
+
+// ProtoReflect returns the reflective view of StrategyFirstPlain backed by the struct
+func (p *StrategyFirstPlain) ProtoReflect() protoreflect.Message {
+	return strategyFirstPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of StrategyFirstPlain
+func (p *StrategyFirstPlain) Clone() *StrategyFirstPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &StrategyFirstPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of StrategyFirstPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetStrategyFirstPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *StrategyFirstPlain) CopyTo(dst *StrategyFirstPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Name = p.Name
+	dst.City = p.City
+	dst.UpdatedAt = p.UpdatedAt
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *StrategyFirstPlain) Equal(other *StrategyFirstPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.City != other.City {
+		return false
+	}
+	if p.UpdatedAt != other.UpdatedAt {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of StrategyFirstPlain rows, in the order of ScanRow and Values
+func (p *StrategyFirstPlain) Columns() []string {
+	return []string{
+		"name",
+		"city",
+		"updated_at",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into StrategyFirstPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *StrategyFirstPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Name,
+		&p.City,
+		&p.UpdatedAt,
+	)
+}
+
+// Values returns the column values of StrategyFirstPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *StrategyFirstPlain) Values() []any {
+	return []any{
+		p.Name,
+		p.City,
+		p.UpdatedAt,
+	}
+}
+
+// strategyFirstPlainPool is a sync.Pool for StrategyFirstPlain objects
+var strategyFirstPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &StrategyFirstPlain{}
+	},
+}
+
+// GetStrategyFirstPlain returns a StrategyFirstPlain from the pool
+func GetStrategyFirstPlain() *StrategyFirstPlain {
+	return strategyFirstPlainPool.Get().(*StrategyFirstPlain)
+}
+
+// PutStrategyFirstPlain returns a StrategyFirstPlain to the pool after resetting it
+func PutStrategyFirstPlain(p *StrategyFirstPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	strategyFirstPlainPool.Put(p)
+}
+
+// Reset clears all fields in StrategyFirstPlain for reuse
+func (p *StrategyFirstPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Name = ""
+	p.City = ""
+	p.UpdatedAt = 0
+}
+
+// file_test_full_strategy_proto_plain_rawDesc is the serialized descriptor of test/full/strategy_plain.proto,
+// describing the Plain messages of test/full/strategy.proto in package full.plain
+const file_test_full_strategy_proto_plain_rawDesc = "" +
+	"\n\x1etest/full/strategy_plain.proto\x12\nfull.plain\"\xf9\x01\n\x12StrategyLaterPl" +
+	"ain\x12\x1d\n\nowner_name\x18\x01 \x01(\tR\townerName\x12\x12\n\x04city\x18\x02 \x01(\tR\x04city\x12\x12\n\x04name\x18\x03" +
+	" \x01(\tR\x04name\x12\x1d\n\naudit_city\x18\x04 \x01(\tR\tauditCity\x12\x1d\n\nupdated_at\x18\x05 \x01(\x03R\tu" +
+	"pdatedAt\x12.\n\x13branch_contact_name\x18\x06 \x01(\tR\x11branchContactName\x12.\n\x13bran" +
+	"ch_contact_city\x18\a \x01(\tR\x11branchContactCity\"\x84\x01\n\x11StrategyBothPlain\x12\x12" +
+	"\n\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n\nowner_city\x18\x02 \x01(\tR\townerCity\x12\x1d\n\naudit_city\x18" +
+	"\x03 \x01(\tR\tauditCity\x12\x1d\n\nupdated_at\x18\x04 \x01(\x03R\tupdatedAt\"[\n\x12StrategyFirst" +
+	"Plain\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n\x04city\x18\x02 \x01(\tR\x04city\x12\x1d\n\nupdated_at\x18\x04 \x01(" +
+	"\x03R\tupdatedAtb\x06proto3"
+
+var file_test_full_strategy_proto_plain = plainreflect.NewFile("test/full/strategy_plain.proto", file_test_full_strategy_proto_plain_rawDesc)
+
+func init() { file_test_full_strategy_proto_plain_init() }
+
+func file_test_full_strategy_proto_plain_init() {
+	file_test_full_strategy_proto_plain.Init()
+}
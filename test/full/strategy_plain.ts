@@ -0,0 +1,29 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/strategy.proto
+
+/**
+ * File strategy: the later field gets its embed path as prefix,
+ * a direct field keeps its name and the embedded one is renamed instead
+ */
+export interface StrategyLaterPlain {
+  ownerName?: string;
+  city?: string;
+  name?: string;
+  auditCity?: string;
+  updatedAt?: number;
+  branchContactName?: string;
+  branchContactCity?: string;
+}
+
+export interface StrategyBothPlain {
+  name?: string;
+  ownerCity?: string;
+  auditCity?: string;
+  updatedAt?: number;
+}
+
+export interface StrategyFirstPlain {
+  name?: string;
+  city?: string;
+  updatedAt?: number;
+}
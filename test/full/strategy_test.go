@@ -0,0 +1,87 @@
+package full_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"google.golang.org/protobuf/proto"
+)
+
+func strategyLater() *full.StrategyLater {
+	return &full.StrategyLater{
+		Owner:  &full.StrategyContact{Name: "Alice", City: "Oslo"},
+		Name:   "order-1",
+		Audit:  &full.StrategyAudit{City: "Bergen", UpdatedAt: 42},
+		Branch: &full.StrategyBranch{Contact: &full.StrategyContact{Name: "Bob", City: "Tromso"}},
+	}
+}
+
+func TestStrategy_PrefixLater(t *testing.T) {
+	original := strategyLater()
+	plain := original.IntoPlain()
+
+	assert.Equal(t, "Alice", plain.OwnerName, "embedded field is renamed when the later one is direct")
+	assert.Equal(t, "order-1", plain.Name)
+	assert.Equal(t, "Oslo", plain.City)
+	assert.Equal(t, "Bergen", plain.AuditCity)
+	assert.Equal(t, "Bob", plain.BranchContactName, "prefix is the whole embed path")
+	assert.Equal(t, "Tromso", plain.BranchContactCity)
+
+	assert.True(t, proto.Equal(original, plain.IntoPb()))
+}
+
+func TestStrategy_PrefixBoth(t *testing.T) {
+	original := &full.StrategyBoth{
+		Owner: &full.StrategyContact{Name: "Alice", City: "Oslo"},
+		Audit: &full.StrategyAudit{City: "Bergen", UpdatedAt: 42},
+	}
+	plain := original.IntoPlain()
+
+	assert.Equal(t, "Alice", plain.Name)
+	assert.Equal(t, "Oslo", plain.OwnerCity)
+	assert.Equal(t, "Bergen", plain.AuditCity)
+	assert.True(t, proto.Equal(original, plain.IntoPb()))
+}
+
+func TestStrategy_FirstWins(t *testing.T) {
+	original := &full.StrategyFirst{
+		Owner: &full.StrategyContact{Name: "Alice", City: "Oslo"},
+		Audit: &full.StrategyAudit{City: "Bergen", UpdatedAt: 42},
+	}
+	plain := original.IntoPlain()
+	assert.Equal(t, "Oslo", plain.City)
+
+	// the dropped audit.city is left unset, like an ignored field
+	pb := plain.IntoPb()
+	assert.Equal(t, "", pb.GetAudit().GetCity())
+	assert.Equal(t, int64(42), pb.GetAudit().GetUpdatedAt())
+
+	dst := proto.Clone(original).(*full.StrategyFirst)
+	plain.IntoPbMerge(dst)
+	assert.True(t, proto.Equal(original, dst), "IntoPbMerge keeps the dropped field")
+}
+
+func TestStrategy_JSONAndWire(t *testing.T) {
+	plain := strategyLater().IntoPlain()
+
+	data, err := plain.MarshalJSON()
+	require.NoError(t, err)
+	var keys map[string]any
+	require.NoError(t, json.Unmarshal(data, &keys))
+	for _, key := range []string{"ownerName", "city", "name", "auditCity", "updatedAt", "branchContactName", "branchContactCity"} {
+		assert.Contains(t, keys, key)
+	}
+
+	decoded := &full.StrategyLaterPlain{}
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	assert.Equal(t, plain, decoded)
+
+	raw, err := plain.MarshalProto()
+	require.NoError(t, err)
+	pb := &full.StrategyLater{}
+	require.NoError(t, proto.Unmarshal(raw, pb))
+	assert.True(t, proto.Equal(strategyLater(), pb))
+}
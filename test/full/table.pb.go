@@ -0,0 +1,531 @@
+// SQL tables of Plain structs: (goplain.message).table and (goplain.field).sql_type
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/table.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TableRole int32
+
+const (
+	TableRole_TABLE_ROLE_UNSPECIFIED TableRole = 0
+	TableRole_TABLE_ROLE_ADMIN       TableRole = 1
+)
+
+// Enum value maps for TableRole.
+var (
+	TableRole_name = map[int32]string{
+		0: "TABLE_ROLE_UNSPECIFIED",
+		1: "TABLE_ROLE_ADMIN",
+	}
+	TableRole_value = map[string]int32{
+		"TABLE_ROLE_UNSPECIFIED": 0,
+		"TABLE_ROLE_ADMIN":       1,
+	}
+)
+
+func (x TableRole) Enum() *TableRole {
+	p := new(TableRole)
+	*p = x
+	return p
+}
+
+func (x TableRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TableRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_test_full_table_proto_enumTypes[0].Descriptor()
+}
+
+func (TableRole) Type() protoreflect.EnumType {
+	return &file_test_full_table_proto_enumTypes[0]
+}
+
+func (x TableRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TableRole.Descriptor instead.
+func (TableRole) EnumDescriptor() ([]byte, []int) {
+	return file_test_full_table_proto_rawDescGZIP(), []int{0}
+}
+
+type TableAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Zip           string                 `protobuf:"bytes,2,opt,name=zip,proto3" json:"zip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableAddress) Reset() {
+	*x = TableAddress{}
+	mi := &file_test_full_table_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableAddress) ProtoMessage() {}
+
+func (x *TableAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_table_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableAddress.ProtoReflect.Descriptor instead.
+func (*TableAddress) Descriptor() ([]byte, []int) {
+	return file_test_full_table_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TableAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *TableAddress) GetZip() string {
+	if x != nil {
+		return x.Zip
+	}
+	return ""
+}
+
+type TableTag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableTag) Reset() {
+	*x = TableTag{}
+	mi := &file_test_full_table_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableTag) ProtoMessage() {}
+
+func (x *TableTag) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_table_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableTag.ProtoReflect.Descriptor instead.
+func (*TableTag) Descriptor() ([]byte, []int) {
+	return file_test_full_table_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TableTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TableUser struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email        string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Nickname     *string                `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	Role         TableRole              `protobuf:"varint,4,opt,name=role,proto3,enum=full.TableRole" json:"role,omitempty"`
+	FallbackRole TableRole              `protobuf:"varint,5,opt,name=fallback_role,json=fallbackRole,proto3,enum=full.TableRole" json:"fallback_role,omitempty"`
+	Quota        uint64                 `protobuf:"varint,6,opt,name=quota,proto3" json:"quota,omitempty"`
+	Avatar       []byte                 `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Balance      float64                `protobuf:"fixed64,8,opt,name=balance,proto3" json:"balance,omitempty"`
+	Address      *TableAddress          `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
+	Billing      *TableAddress          `protobuf:"bytes,10,opt,name=billing,proto3" json:"billing,omitempty"`
+	Tag          *TableTag              `protobuf:"bytes,11,opt,name=tag,proto3" json:"tag,omitempty"`
+	Aliases      []string               `protobuf:"bytes,12,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Counters     map[string]int64       `protobuf:"bytes,13,rep,name=counters,proto3" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Types that are valid to be assigned to Login:
+	//
+	//	*TableUser_PasswordHash
+	//	*TableUser_SsoSubject
+	Login         isTableUser_Login `protobuf_oneof:"login"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableUser) Reset() {
+	*x = TableUser{}
+	mi := &file_test_full_table_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableUser) ProtoMessage() {}
+
+func (x *TableUser) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_table_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableUser.ProtoReflect.Descriptor instead.
+func (*TableUser) Descriptor() ([]byte, []int) {
+	return file_test_full_table_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TableUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TableUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TableUser) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *TableUser) GetRole() TableRole {
+	if x != nil {
+		return x.Role
+	}
+	return TableRole_TABLE_ROLE_UNSPECIFIED
+}
+
+func (x *TableUser) GetFallbackRole() TableRole {
+	if x != nil {
+		return x.FallbackRole
+	}
+	return TableRole_TABLE_ROLE_UNSPECIFIED
+}
+
+func (x *TableUser) GetQuota() uint64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *TableUser) GetAvatar() []byte {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *TableUser) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *TableUser) GetAddress() *TableAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *TableUser) GetBilling() *TableAddress {
+	if x != nil {
+		return x.Billing
+	}
+	return nil
+}
+
+func (x *TableUser) GetTag() *TableTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *TableUser) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *TableUser) GetCounters() map[string]int64 {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *TableUser) GetLogin() isTableUser_Login {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+func (x *TableUser) GetPasswordHash() string {
+	if x != nil {
+		if x, ok := x.Login.(*TableUser_PasswordHash); ok {
+			return x.PasswordHash
+		}
+	}
+	return ""
+}
+
+func (x *TableUser) GetSsoSubject() string {
+	if x != nil {
+		if x, ok := x.Login.(*TableUser_SsoSubject); ok {
+			return x.SsoSubject
+		}
+	}
+	return ""
+}
+
+type isTableUser_Login interface {
+	isTableUser_Login()
+}
+
+type TableUser_PasswordHash struct {
+	PasswordHash string `protobuf:"bytes,14,opt,name=password_hash,json=passwordHash,proto3,oneof"`
+}
+
+type TableUser_SsoSubject struct {
+	SsoSubject string `protobuf:"bytes,15,opt,name=sso_subject,json=ssoSubject,proto3,oneof"`
+}
+
+func (*TableUser_PasswordHash) isTableUser_Login() {}
+
+func (*TableUser_SsoSubject) isTableUser_Login() {}
+
+type TableUser_Session struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token     string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Reserved word, quoted in the DDL
+	Order         int32 `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableUser_Session) Reset() {
+	*x = TableUser_Session{}
+	mi := &file_test_full_table_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableUser_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableUser_Session) ProtoMessage() {}
+
+func (x *TableUser_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_table_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableUser_Session.ProtoReflect.Descriptor instead.
+func (*TableUser_Session) Descriptor() ([]byte, []int) {
+	return file_test_full_table_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *TableUser_Session) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TableUser_Session) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TableUser_Session) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *TableUser_Session) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+var File_test_full_table_proto protoreflect.FileDescriptor
+
+const file_test_full_table_proto_rawDesc = "" +
+	"\n" +
+	"\x15test/full/table.proto\x12\x04full\x1a\x15goplain/goplain.proto\"G\n" +
+	"\fTableAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12#\n" +
+	"\x03zip\x18\x02 \x01(\tB\x11\x82\xa6\x1d\rj\vVARCHAR(10)R\x03zip\"\x1e\n" +
+	"\bTableTag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xfa\x06\n" +
+	"\tTableUser\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1f\n" +
+	"\bnickname\x18\x03 \x01(\tH\x01R\bnickname\x88\x01\x01\x12#\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x0f.full.TableRoleR\x04role\x12<\n" +
+	"\rfallback_role\x18\x05 \x01(\x0e2\x0f.full.TableRoleB\x06\x82\xa6\x1d\x028\x01R\ffallbackRole\x12\x14\n" +
+	"\x05quota\x18\x06 \x01(\x04R\x05quota\x12\x16\n" +
+	"\x06avatar\x18\a \x01(\fR\x06avatar\x12.\n" +
+	"\abalance\x18\b \x01(\x01B\x14\x82\xa6\x1d\x10j\x0eNUMERIC(12, 2)R\abalance\x124\n" +
+	"\aaddress\x18\t \x01(\v2\x12.full.TableAddressB\x06\x82\xa6\x1d\x02 \x01R\aaddress\x12,\n" +
+	"\abilling\x18\n" +
+	" \x01(\v2\x12.full.TableAddressR\abilling\x12(\n" +
+	"\x03tag\x18\v \x01(\v2\x0e.full.TableTagB\x06\x82\xa6\x1d\x02\x10\x01R\x03tag\x12\x18\n" +
+	"\aaliases\x18\f \x03(\tR\aaliases\x129\n" +
+	"\bcounters\x18\r \x03(\v2\x1d.full.TableUser.CountersEntryR\bcounters\x12%\n" +
+	"\rpassword_hash\x18\x0e \x01(\tH\x00R\fpasswordHash\x12!\n" +
+	"\vsso_subject\x18\x0f \x01(\tH\x00R\n" +
+	"ssoSubject\x1a;\n" +
+	"\rCountersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a\x9d\x01\n" +
+	"\aSession\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x14\n" +
+	"\x05order\x18\x04 \x01(\x05R\x05order:.\x82\xa6\x1d*\b\x01:\ruser_sessionsB\auser_idB\x05tokenJ\a\x12\x05order:=\x82\xa6\x1d9\b\x01:\x05usersB\x02idJ\t\x12\x05email\x18\x01J\x1f\n" +
+	"\x12users_location_idx\x12\x04city\x12\x03zipB\x0f\n" +
+	"\x05login\x12\x06\x82\xb5\x18\x02\b\x01B\v\n" +
+	"\t_nickname*=\n" +
+	"\tTableRole\x12\x1a\n" +
+	"\x16TABLE_ROLE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TABLE_ROLE_ADMIN\x10\x01B2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_table_proto_rawDescOnce sync.Once
+	file_test_full_table_proto_rawDescData []byte
+)
+
+func file_test_full_table_proto_rawDescGZIP() []byte {
+	file_test_full_table_proto_rawDescOnce.Do(func() {
+		file_test_full_table_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_table_proto_rawDesc), len(file_test_full_table_proto_rawDesc)))
+	})
+	return file_test_full_table_proto_rawDescData
+}
+
+var file_test_full_table_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_test_full_table_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_test_full_table_proto_goTypes = []any{
+	(TableRole)(0),            // 0: full.TableRole
+	(*TableAddress)(nil),      // 1: full.TableAddress
+	(*TableTag)(nil),          // 2: full.TableTag
+	(*TableUser)(nil),         // 3: full.TableUser
+	nil,                       // 4: full.TableUser.CountersEntry
+	(*TableUser_Session)(nil), // 5: full.TableUser.Session
+}
+var file_test_full_table_proto_depIdxs = []int32{
+	0, // 0: full.TableUser.role:type_name -> full.TableRole
+	0, // 1: full.TableUser.fallback_role:type_name -> full.TableRole
+	1, // 2: full.TableUser.address:type_name -> full.TableAddress
+	1, // 3: full.TableUser.billing:type_name -> full.TableAddress
+	2, // 4: full.TableUser.tag:type_name -> full.TableTag
+	4, // 5: full.TableUser.counters:type_name -> full.TableUser.CountersEntry
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_test_full_table_proto_init() }
+func file_test_full_table_proto_init() {
+	if File_test_full_table_proto != nil {
+		return
+	}
+	file_test_full_table_proto_msgTypes[2].OneofWrappers = []any{
+		(*TableUser_PasswordHash)(nil),
+		(*TableUser_SsoSubject)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_table_proto_rawDesc), len(file_test_full_table_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_table_proto_goTypes,
+		DependencyIndexes: file_test_full_table_proto_depIdxs,
+		EnumInfos:         file_test_full_table_proto_enumTypes,
+		MessageInfos:      file_test_full_table_proto_msgTypes,
+	}.Build()
+	File_test_full_table_proto = out.File
+	file_test_full_table_proto_goTypes = nil
+	file_test_full_table_proto_depIdxs = nil
+}
@@ -0,0 +1,348 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/table.proto
+
+package full
+
+import (
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+)
+
+// MarshalJX encodes TableAddress to JSON using jx.Encoder
+func (p *TableAddress) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetCity() != "" {
+		e.FieldStart("city")
+		e.Str(p.GetCity())
+	}
+	if p.GetZip() != "" {
+		e.FieldStart("zip")
+		e.Str(p.GetZip())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes TableAddress from JSON using jx.Decoder
+func (p *TableAddress) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		case "zip":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Zip = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes TableTag to JSON using jx.Encoder
+func (p *TableTag) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetName() != "" {
+		e.FieldStart("name")
+		e.Str(p.GetName())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes TableTag from JSON using jx.Decoder
+func (p *TableTag) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes TableUser to JSON using jx.Encoder
+func (p *TableUser) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetEmail() != "" {
+		e.FieldStart("email")
+		e.Str(p.GetEmail())
+	}
+	if p.Nickname != nil {
+		e.FieldStart("nickname")
+		e.Str(*p.Nickname)
+	}
+	e.FieldStart("role")
+	e.Int32(int32(p.GetRole()))
+	e.FieldStart("fallbackRole")
+	e.Int32(int32(p.GetFallbackRole()))
+	if p.GetQuota() != 0 {
+		e.FieldStart("quota")
+		e.UInt64(p.GetQuota())
+	}
+	if len(p.GetAvatar()) > 0 {
+		e.FieldStart("avatar")
+		e.Base64(p.GetAvatar())
+	}
+	if p.GetBalance() != 0 {
+		e.FieldStart("balance")
+		e.Float64(p.GetBalance())
+	}
+	if p.GetAddress() != nil {
+		e.FieldStart("address")
+		p.GetAddress().MarshalJX(e)
+	}
+	if p.GetBilling() != nil {
+		e.FieldStart("billing")
+		p.GetBilling().MarshalJX(e)
+	}
+	if p.GetTag() != nil {
+		e.FieldStart("tag")
+		p.GetTag().MarshalJX(e)
+	}
+	if len(p.GetAliases()) > 0 {
+		e.FieldStart("aliases")
+		e.ArrStart()
+		for _, v := range p.GetAliases() {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetCounters()) > 0 {
+		e.FieldStart("counters")
+		e.ObjStart()
+		for k, v := range p.GetCounters() {
+			e.FieldStart(k)
+			e.Int64(v)
+		}
+		e.ObjEnd()
+	}
+	switch v := p.GetLogin().(type) {
+	case *TableUser_PasswordHash:
+		e.FieldStart("passwordHash")
+		e.Str(v.PasswordHash)
+	case *TableUser_SsoSubject:
+		e.FieldStart("ssoSubject")
+		e.Str(v.SsoSubject)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes TableUser from JSON using jx.Decoder
+func (p *TableUser) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "email":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Email = v
+		case "nickname":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Nickname = &v
+		case "role":
+			v, err := enumjx.Decode(d, "full.TableRole", TableRole_value)
+			if err != nil {
+				return err
+			}
+			p.Role = TableRole(v)
+		case "fallbackRole":
+			v, err := enumjx.Decode(d, "full.TableRole", TableRole_value)
+			if err != nil {
+				return err
+			}
+			p.FallbackRole = TableRole(v)
+		case "quota":
+			v, err := d.UInt64()
+			if err != nil {
+				return err
+			}
+			p.Quota = v
+		case "avatar":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.Avatar = v
+		case "balance":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Balance = v
+		case "address":
+			p.Address = &TableAddress{}
+			if err := p.Address.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "billing":
+			p.Billing = &TableAddress{}
+			if err := p.Billing.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "tag":
+			p.Tag = &TableTag{}
+			if err := p.Tag.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "aliases":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Aliases = append(p.Aliases, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "counters":
+			if p.Counters == nil {
+				p.Counters = make(map[string]int64)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Int64()
+				if err != nil {
+					return err
+				}
+				p.Counters[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "passwordHash":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Login = &TableUser_PasswordHash{PasswordHash: v}
+		case "ssoSubject":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Login = &TableUser_SsoSubject{SsoSubject: v}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes TableUser_Session to JSON using jx.Encoder
+func (p *TableUser_Session) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetUserId() != "" {
+		e.FieldStart("userId")
+		e.Str(p.GetUserId())
+	}
+	if p.GetToken() != "" {
+		e.FieldStart("token")
+		e.Str(p.GetToken())
+	}
+	if p.GetExpiresAt() != 0 {
+		e.FieldStart("expiresAt")
+		e.Int64(p.GetExpiresAt())
+	}
+	if p.GetOrder() != 0 {
+		e.FieldStart("order")
+		e.Int32(p.GetOrder())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes TableUser_Session from JSON using jx.Decoder
+func (p *TableUser_Session) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "userId":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.UserId = v
+		case "token":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Token = v
+		case "expiresAt":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.ExpiresAt = v
+		case "order":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Order = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
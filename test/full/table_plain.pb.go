@@ -0,0 +1,1532 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/table.proto
+
+package full
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	maps "maps"
+	math "math"
+	slices "slices"
+	sync "sync"
+)
+
+type TableUserPlain struct {
+	Id                string           `json:"id"`
+	Email             string           `json:"email"`
+	Nickname          *string          `json:"nickname,omitempty"`
+	Role              TableRole        `json:"role"`
+	FallbackRole      string           `json:"fallbackRole"`
+	Quota             uint64           `json:"quota"`
+	Avatar            []byte           `json:"avatar"`
+	Balance           float64          `json:"balance"`
+	City              string           `json:"city"`
+	Zip               string           `json:"zip"`
+	Billing           *TableAddress    `json:"billing"`
+	Tag               []byte           `json:"tag"` // origin: serialized, empath: tag
+	Aliases           []string         `json:"aliases"`
+	Counters          map[string]int64 `json:"counters"`
+	LoginPasswordHash string           `json:"loginPasswordHash"` // origin: oneof_embed, empath: login.password_hash
+	LoginSsoSubject   string           `json:"loginSsoSubject"`   // origin: oneof_embed, empath: login.sso_subject
+	// LoginCase indicates which variant of login oneof is set
+	LoginCase TableUserLoginCase `json:"login_case,omitempty"`
+}
+
+// TableUserLoginCase identifies the set variant of full.TableUser.login oneof, empty when none is set
+type TableUserLoginCase string
+
+const (
+	TableUserLoginCasePasswordHash TableUserLoginCase = "password_hash"
+	TableUserLoginCaseSsoSubject   TableUserLoginCase = "sso_subject"
+)
+
+// Valid reports whether c is one of the TableUserLoginCase variants
+func (c TableUserLoginCase) Valid() bool {
+	switch c {
+	case TableUserLoginCasePasswordHash, TableUserLoginCaseSsoSubject:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c TableUserLoginCase) String() string {
+	return string(c)
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *TableUser) IntoPlain() *TableUserPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &TableUserPlain{}
+
+	// Detect login oneof case
+	switch pb.Login.(type) {
+	case *TableUser_PasswordHash:
+		p.LoginCase = TableUserLoginCasePasswordHash
+	case *TableUser_SsoSubject:
+		p.LoginCase = TableUserLoginCaseSsoSubject
+	}
+
+	p.Id = pb.Id
+	p.Email = pb.Email
+	p.Nickname = pb.Nickname
+	p.Role = pb.Role
+	p.FallbackRole = pb.FallbackRole.String()
+	p.Quota = pb.Quota
+	p.Avatar = pb.Avatar
+	p.Balance = pb.Balance
+	// City from
+	if pb.GetAddress() != nil {
+		p.City = pb.GetAddress().GetCity()
+	}
+	// Zip from
+	if pb.GetAddress() != nil {
+		p.Zip = pb.GetAddress().GetZip()
+	}
+	p.Billing = pb.Billing
+	// Tag serialized from tag
+	if pb.Tag != nil {
+		if data, err := protojson.Marshal(pb.Tag); err == nil {
+			p.Tag = data
+		}
+	} else {
+		p.Tag = []byte{}
+	}
+	if len(pb.Aliases) > 0 {
+		p.Aliases = pb.Aliases
+	} else {
+		p.Aliases = []string{}
+	}
+	p.Counters = pb.Counters
+	// LoginPasswordHash from login.password_hash
+	if pb != nil {
+		p.LoginPasswordHash = pb.GetPasswordHash()
+	}
+	// LoginSsoSubject from login.sso_subject
+	if pb != nil {
+		p.LoginSsoSubject = pb.GetSsoSubject()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails or an enum name is unknown, use IntoPbE to handle the error.
+func (p *TableUserPlain) IntoPb() *TableUser {
+	pb, err := p.IntoPbE()
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError wrapping *enumjx.UnknownValueError for unknown enum names.
+func (p *TableUserPlain) IntoPbE() (*TableUser, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &TableUser{}
+	var _err error
+
+	pb.Id = p.Id
+	pb.Email = p.Email
+	pb.Nickname = p.Nickname
+	pb.Role = p.Role
+	pb.FallbackRole = enumjx.TryName[TableRole](p.FallbackRole, "full.TableRole", TableRole_value, "fallback_role", &_err)
+	pb.Quota = p.Quota
+	pb.Avatar = p.Avatar
+	pb.Balance = p.Balance
+	// City ->
+	if p.City != "" {
+		if pb.Address == nil {
+			pb.Address = &TableAddress{}
+		}
+		pb.Address.City = p.City
+	}
+	// Zip ->
+	if p.Zip != "" {
+		if pb.Address == nil {
+			pb.Address = &TableAddress{}
+		}
+		pb.Address.Zip = p.Zip
+	}
+	pb.Billing = p.Billing
+	// Tag deserialize -> tag
+	if len(p.Tag) > 0 {
+		var msg TableTag
+		if err := protojson.Unmarshal(p.Tag, &msg); err == nil {
+			pb.Tag = &msg
+		}
+	}
+	pb.Aliases = p.Aliases
+	pb.Counters = p.Counters
+	// LoginPasswordHash -> login.password_hash
+	if p.LoginCase == TableUserLoginCasePasswordHash {
+		pb.Login = &TableUser_PasswordHash{PasswordHash: p.LoginPasswordHash}
+	}
+	// LoginSsoSubject -> login.sso_subject
+	if p.LoginCase == TableUserLoginCaseSsoSubject {
+		pb.Login = &TableUser_SsoSubject{SsoSubject: p.LoginSsoSubject}
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// tableUserPlainMergePaths are the fields of TableUser covered by TableUserPlain
+var tableUserPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{7},
+	{8},
+	{9, 1},
+	{9, 2},
+	{10},
+	{11},
+	{12},
+	{13},
+	{14},
+	{15},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *TableUserPlain) IntoPbMerge(dst *TableUser) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, tableUserPlainMergePaths...)
+	return nil
+}
+
+// tableUserPlainFieldPaths link the JSON names of TableUserPlain to field-mask paths of TableUser
+var tableUserPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "email", Path: "email"},
+	{Name: "nickname", Path: "nickname"},
+	{Name: "role", Path: "role"},
+	{Name: "fallbackRole", Path: "fallback_role"},
+	{Name: "quota", Path: "quota"},
+	{Name: "avatar", Path: "avatar"},
+	{Name: "balance", Path: "balance"},
+	{Name: "city", Path: "address.city"},
+	{Name: "zip", Path: "address.zip"},
+	{Name: "billing", Path: "billing"},
+	{Name: "tag", Path: "tag"},
+	{Name: "aliases", Path: "aliases"},
+	{Name: "counters", Path: "counters"},
+	{Name: "loginPasswordHash", Path: "password_hash"},
+	{Name: "loginSsoSubject", Path: "sso_subject"},
+}
+
+// TableUserPlainFieldMask builds a field mask of TableUser from JSON names of TableUserPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func TableUserPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(tableUserPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// TableUserPlainFieldNames translates a field mask of TableUser into JSON names of TableUserPlain.
+// A path to an embedded message yields all fields flattened from it.
+func TableUserPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(tableUserPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of TableUser, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *TableUserPlain) ApplyToPb(pb *TableUser, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), tableUserPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *TableUser) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*TableUserPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), tableUserPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &TableUser{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *TableUser) IntoPlainReuse(p *TableUserPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// Detect login oneof case
+	switch pb.Login.(type) {
+	case *TableUser_PasswordHash:
+		p.LoginCase = TableUserLoginCasePasswordHash
+	case *TableUser_SsoSubject:
+		p.LoginCase = TableUserLoginCaseSsoSubject
+	}
+
+	p.Id = pb.Id
+	p.Email = pb.Email
+	p.Nickname = pb.Nickname
+	p.Role = pb.Role
+	p.FallbackRole = pb.FallbackRole.String()
+	p.Quota = pb.Quota
+	p.Avatar = pb.Avatar
+	p.Balance = pb.Balance
+	// City from
+	if pb.GetAddress() != nil {
+		p.City = pb.GetAddress().GetCity()
+	}
+	// Zip from
+	if pb.GetAddress() != nil {
+		p.Zip = pb.GetAddress().GetZip()
+	}
+	p.Billing = pb.Billing
+	// Tag serialized from tag
+	if pb.Tag != nil {
+		if data, err := protojson.Marshal(pb.Tag); err == nil {
+			p.Tag = data
+		}
+	} else {
+		p.Tag = []byte{}
+	}
+	if len(pb.Aliases) > 0 {
+		p.Aliases = pb.Aliases
+	} else {
+		p.Aliases = []string{}
+	}
+	p.Counters = pb.Counters
+	// LoginPasswordHash from login.password_hash
+	if pb != nil {
+		p.LoginPasswordHash = pb.GetPasswordHash()
+	}
+	// LoginSsoSubject from login.sso_subject
+	if pb != nil {
+		p.LoginSsoSubject = pb.GetSsoSubject()
+	}
+}
+
+// MarshalJX encodes TableUserPlain to JSON using jx.Encoder
+func (p *TableUserPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.LoginCase != "" {
+		e.FieldStart("login_case")
+		e.Str(p.LoginCase.String())
+	}
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	if p.Email != "" {
+		e.FieldStart("email")
+		e.Str(p.Email)
+	}
+	if p.Nickname != nil {
+		e.FieldStart("nickname")
+		e.Str(*p.Nickname)
+	}
+	if p.Role != 0 {
+		e.FieldStart("role")
+		e.Int32(int32(p.Role))
+	}
+	if p.FallbackRole != "" {
+		e.FieldStart("fallbackRole")
+		e.Str(p.FallbackRole)
+	}
+	if p.Quota != 0 {
+		e.FieldStart("quota")
+		e.UInt64(p.Quota)
+	}
+	if len(p.Avatar) > 0 {
+		e.FieldStart("avatar")
+		e.Base64(p.Avatar)
+	}
+	if p.Balance != 0 {
+		e.FieldStart("balance")
+		e.Float64(p.Balance)
+	}
+	if p.City != "" {
+		e.FieldStart("city")
+		e.Str(p.City)
+	}
+	if p.Zip != "" {
+		e.FieldStart("zip")
+		e.Str(p.Zip)
+	}
+	if p.Billing != nil {
+		e.FieldStart("billing")
+		p.Billing.MarshalJX(e)
+	}
+	if len(p.Tag) > 0 {
+		e.FieldStart("tag")
+		e.Base64(p.Tag)
+	}
+	if len(p.Aliases) > 0 {
+		e.FieldStart("aliases")
+		e.ArrStart()
+		for _, v := range p.Aliases {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("counters")
+	e.ObjStart()
+	for k, v := range p.Counters {
+		e.FieldStart(k)
+		e.Int64(v)
+	}
+	e.ObjEnd()
+	if p.LoginPasswordHash != "" {
+		e.FieldStart("loginPasswordHash")
+		e.Str(p.LoginPasswordHash)
+	}
+	if p.LoginSsoSubject != "" {
+		e.FieldStart("loginSsoSubject")
+		e.Str(p.LoginSsoSubject)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *TableUserPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes TableUserPlain from JSON using jx.Decoder
+func (p *TableUserPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "login_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.LoginCase = TableUserLoginCase(v)
+			if v != "" && !p.LoginCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.TableUser.login", v)
+			}
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "email":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Email = v
+		case "nickname":
+			if d.Next() == jx.Null {
+				p.Nickname = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Nickname = &v
+		case "role":
+			v, err := enumjx.Decode(d, "full.TableRole", TableRole_value)
+			if err != nil {
+				return err
+			}
+			p.Role = TableRole(v)
+		case "fallbackRole":
+			v, err := enumjx.DecodeName(d, "full.TableRole", TableRole_value, TableRole_name)
+			if err != nil {
+				return err
+			}
+			p.FallbackRole = v
+		case "quota":
+			v, err := d.UInt64()
+			if err != nil {
+				return err
+			}
+			p.Quota = v
+		case "avatar":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.Avatar = v
+		case "balance":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Balance = v
+		case "city":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.City = v
+		case "zip":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Zip = v
+		case "billing":
+			p.Billing = &TableAddress{}
+			if err := p.Billing.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "tag":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.Tag = v
+		case "aliases":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Aliases = append(p.Aliases, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "counters":
+			if p.Counters == nil {
+				p.Counters = make(map[string]int64)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Int64()
+				if err != nil {
+					return err
+				}
+				p.Counters[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "loginPasswordHash":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.LoginPasswordHash = v
+		case "loginSsoSubject":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.LoginSsoSubject = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *TableUserPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes TableUserPlain in the protobuf wire format of TableUser
+func (p *TableUserPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends TableUserPlain encoded in the protobuf wire format of TableUser to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *TableUserPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Email; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if p.Nickname != nil {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		b = protowire.AppendString(b, *p.Nickname)
+	}
+	if v := p.Role; v != 0 {
+		b = protowire.AppendTag(b, 4, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := enumjx.TryName[TableRole](p.FallbackRole, "full.TableRole", TableRole_value, "fallback_role", &_err); v != 0 {
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.Quota; v != 0 {
+		b = protowire.AppendTag(b, 6, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.Avatar; len(v) > 0 {
+		b = protowire.AppendTag(b, 7, protowire.BytesType)
+		b = protowire.AppendBytes(b, v)
+	}
+	if v := p.Balance; v != 0 || math.Signbit(float64(v)) {
+		b = protowire.AppendTag(b, 8, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+	}
+	// address (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 9, protowire.BytesType)
+		_start := len(b)
+		if v := p.City; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.Zip; v != "" {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	if p.Billing != nil {
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Billing); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if len(p.Tag) > 0 {
+		m := &TableTag{}
+		if err := protojson.Unmarshal(p.Tag, m); err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, v := range p.Aliases {
+		b = protowire.AppendTag(b, 12, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	for _, k := range plainwire.SortedKeys(p.Counters) {
+		v := p.Counters[k]
+		b = protowire.AppendTag(b, 13, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.LoginCase == TableUserLoginCasePasswordHash {
+		b = protowire.AppendTag(b, 14, protowire.BytesType)
+		b = protowire.AppendString(b, p.LoginPasswordHash)
+	}
+	if p.LoginCase == TableUserLoginCaseSsoSubject {
+		b = protowire.AppendTag(b, 15, protowire.BytesType)
+		b = protowire.AppendString(b, p.LoginSsoSubject)
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes TableUserPlain from the protobuf wire format of TableUser.
+// Unknown fields are skipped.
+func (p *TableUserPlain) UnmarshalProto(b []byte) error {
+	*p = TableUserPlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Email = v
+		case num == 3 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			_tmp := v
+			p.Nickname = &_tmp
+		case num == 4 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Role = TableRole(v)
+		case num == 5 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.FallbackRole = TableRole(v).String()
+		case num == 6 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Quota = v
+		case num == 7 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.Avatar = append([]byte{}, v...)
+		case num == 8 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.Balance = math.Float64frombits(v)
+		case num == 9 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.City = v
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.Zip = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &TableAddress{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Billing = m
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &TableTag{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			data, err := protojson.Marshal(m)
+			if err != nil {
+				return err
+			}
+			p.Tag = data
+		case num == 12 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Aliases = append(p.Aliases, v)
+		case num == 13 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv int64
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Counters == nil {
+				p.Counters = make(map[string]int64)
+			}
+			p.Counters[mk] = mv
+		case num == 14 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.LoginCase = TableUserLoginCasePasswordHash
+			p.LoginPasswordHash = v
+		case num == 15 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.LoginCase = TableUserLoginCaseSsoSubject
+			p.LoginSsoSubject = v
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// tableUserPlainReflect describes TableUserPlain as message full.plain.TableUserPlain
+var tableUserPlainReflect = plainreflect.NewMessageInfo(file_test_full_table_proto_plain, "TableUserPlain",
+	plainreflect.Scalar(func(p *TableUserPlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *TableUserPlain) *string { return &p.Email }),
+	plainreflect.Optional(func(p *TableUserPlain) **string { return &p.Nickname }),
+	plainreflect.Enum(func(p *TableUserPlain) *TableRole { return &p.Role }),
+	plainreflect.Scalar(func(p *TableUserPlain) *string { return &p.FallbackRole }),
+	plainreflect.Scalar(func(p *TableUserPlain) *uint64 { return &p.Quota }),
+	plainreflect.Scalar(func(p *TableUserPlain) *[]byte { return &p.Avatar }),
+	plainreflect.Scalar(func(p *TableUserPlain) *float64 { return &p.Balance }),
+	plainreflect.Scalar(func(p *TableUserPlain) *string { return &p.City }),
+	plainreflect.Scalar(func(p *TableUserPlain) *string { return &p.Zip }),
+	plainreflect.Message(func(p *TableUserPlain) **TableAddress { return &p.Billing }),
+	plainreflect.Scalar(func(p *TableUserPlain) *[]byte { return &p.Tag }),
+	plainreflect.List(func(p *TableUserPlain) *[]string { return &p.Aliases }),
+	plainreflect.Map(func(p *TableUserPlain) *map[string]int64 { return &p.Counters }),
+	plainreflect.Scalar(func(p *TableUserPlain) *string { return &p.LoginPasswordHash }),
+	plainreflect.Scalar(func(p *TableUserPlain) *string { return &p.LoginSsoSubject }),
+	plainreflect.Case(func(p *TableUserPlain) *TableUserLoginCase { return &p.LoginCase }),
+)
+
+// ProtoReflect returns the reflective view of TableUserPlain backed by the struct
+func (p *TableUserPlain) ProtoReflect() protoreflect.Message {
+	return tableUserPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of TableUserPlain
+func (p *TableUserPlain) Clone() *TableUserPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &TableUserPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of TableUserPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetTableUserPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *TableUserPlain) CopyTo(dst *TableUserPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.LoginCase = p.LoginCase
+	dst.Id = p.Id
+	dst.Email = p.Email
+	if p.Nickname == nil {
+		dst.Nickname = nil
+	} else {
+		if dst.Nickname == nil {
+			dst.Nickname = new(string)
+		}
+		*dst.Nickname = *p.Nickname
+	}
+	dst.Role = p.Role
+	dst.FallbackRole = p.FallbackRole
+	dst.Quota = p.Quota
+	dst.Avatar = append(dst.Avatar[:0], p.Avatar...)
+	dst.Balance = p.Balance
+	dst.City = p.City
+	dst.Zip = p.Zip
+	if p.Billing == nil {
+		dst.Billing = nil
+	} else {
+		if dst.Billing == nil {
+			dst.Billing = new(TableAddress)
+		} else {
+			proto.Reset(dst.Billing)
+		}
+		proto.Merge(dst.Billing, p.Billing)
+	}
+	dst.Tag = append(dst.Tag[:0], p.Tag...)
+	dst.Aliases = append(dst.Aliases[:0], p.Aliases...)
+	for k := range dst.Counters {
+		delete(dst.Counters, k)
+	}
+	if dst.Counters == nil && p.Counters != nil {
+		dst.Counters = make(map[string]int64, len(p.Counters))
+	}
+	for k, v := range p.Counters {
+		dst.Counters[k] = v
+	}
+	dst.LoginPasswordHash = p.LoginPasswordHash
+	dst.LoginSsoSubject = p.LoginSsoSubject
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *TableUserPlain) Equal(other *TableUserPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.LoginCase != other.LoginCase {
+		return false
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if p.Email != other.Email {
+		return false
+	}
+	if (p.Nickname == nil) != (other.Nickname == nil) || p.Nickname != nil && *p.Nickname != *other.Nickname {
+		return false
+	}
+	if p.Role != other.Role {
+		return false
+	}
+	if p.FallbackRole != other.FallbackRole {
+		return false
+	}
+	if p.Quota != other.Quota {
+		return false
+	}
+	if !bytes.Equal(p.Avatar, other.Avatar) {
+		return false
+	}
+	if p.Balance != other.Balance {
+		return false
+	}
+	if p.City != other.City {
+		return false
+	}
+	if p.Zip != other.Zip {
+		return false
+	}
+	if !proto.Equal(p.Billing, other.Billing) {
+		return false
+	}
+	if !bytes.Equal(p.Tag, other.Tag) {
+		return false
+	}
+	if !slices.Equal(p.Aliases, other.Aliases) {
+		return false
+	}
+	if !maps.Equal(p.Counters, other.Counters) {
+		return false
+	}
+	if p.LoginPasswordHash != other.LoginPasswordHash {
+		return false
+	}
+	if p.LoginSsoSubject != other.LoginSsoSubject {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of TableUserPlain rows, in the order of ScanRow and Values
+func (p *TableUserPlain) Columns() []string {
+	return []string{
+		"id",
+		"email",
+		"nickname",
+		"role",
+		"fallback_role",
+		"quota",
+		"avatar",
+		"balance",
+		"city",
+		"zip",
+		"billing",
+		"tag",
+		"aliases",
+		"counters",
+		"login_password_hash",
+		"login_sso_subject",
+		"login_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into TableUserPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *TableUserPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Email,
+		&p.Nickname,
+		&p.Role,
+		&p.FallbackRole,
+		&p.Quota,
+		&p.Avatar,
+		&p.Balance,
+		&p.City,
+		&p.Zip,
+		plainsql.JSON(&p.Billing),
+		&p.Tag,
+		plainsql.JSON(&p.Aliases),
+		plainsql.JSON(&p.Counters),
+		&p.LoginPasswordHash,
+		&p.LoginSsoSubject,
+		&p.LoginCase,
+	)
+}
+
+// Values returns the column values of TableUserPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *TableUserPlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Email,
+		p.Nickname,
+		p.Role,
+		p.FallbackRole,
+		plainsql.Uint64(&p.Quota),
+		p.Avatar,
+		p.Balance,
+		p.City,
+		p.Zip,
+		plainsql.JSON(&p.Billing),
+		p.Tag,
+		plainsql.JSON(&p.Aliases),
+		plainsql.JSON(&p.Counters),
+		p.LoginPasswordHash,
+		p.LoginSsoSubject,
+		p.LoginCase,
+	}
+}
+
+// tableUserPlainPool is a sync.Pool for TableUserPlain objects
+var tableUserPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &TableUserPlain{}
+	},
+}
+
+// GetTableUserPlain returns a TableUserPlain from the pool
+func GetTableUserPlain() *TableUserPlain {
+	return tableUserPlainPool.Get().(*TableUserPlain)
+}
+
+// PutTableUserPlain returns a TableUserPlain to the pool after resetting it
+func PutTableUserPlain(p *TableUserPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	tableUserPlainPool.Put(p)
+}
+
+// Reset clears all fields in TableUserPlain for reuse
+func (p *TableUserPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.LoginCase = ""
+	p.Id = ""
+	p.Email = ""
+	p.Nickname = nil
+	p.Role = 0
+	p.FallbackRole = ""
+	p.Quota = 0
+	p.Avatar = nil
+	p.Balance = 0
+	p.City = ""
+	p.Zip = ""
+	p.Billing = nil
+	p.Tag = nil
+	p.Aliases = p.Aliases[:0]
+	for k := range p.Counters {
+		delete(p.Counters, k)
+	}
+	p.LoginPasswordHash = ""
+	p.LoginSsoSubject = ""
+}
+
+type TableUser_SessionPlain struct {
+	UserId    string `json:"userId"`
+	Token     string `json:"token"`
+	ExpiresAt int64  `json:"expiresAt"`
+	Order     int32  `json:"order"`
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *TableUser_Session) IntoPlain() *TableUser_SessionPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &TableUser_SessionPlain{}
+
+	p.UserId = pb.UserId
+	p.Token = pb.Token
+	p.ExpiresAt = pb.ExpiresAt
+	p.Order = pb.Order
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *TableUser_SessionPlain) IntoPb() *TableUser_Session {
+	if p == nil {
+		return nil
+	}
+	pb := &TableUser_Session{}
+
+	pb.UserId = p.UserId
+	pb.Token = p.Token
+	pb.ExpiresAt = p.ExpiresAt
+	pb.Order = p.Order
+	return pb
+}
+
+// tableUser_SessionPlainMergePaths are the fields of TableUser_Session covered by TableUser_SessionPlain
+var tableUser_SessionPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *TableUser_SessionPlain) IntoPbMerge(dst *TableUser_Session) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, tableUser_SessionPlainMergePaths...)
+}
+
+// tableUser_SessionPlainFieldPaths link the JSON names of TableUser_SessionPlain to field-mask paths of TableUser_Session
+var tableUser_SessionPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "userId", Path: "user_id"},
+	{Name: "token", Path: "token"},
+	{Name: "expiresAt", Path: "expires_at"},
+	{Name: "order", Path: "order"},
+}
+
+// TableUser_SessionPlainFieldMask builds a field mask of TableUser_Session from JSON names of TableUser_SessionPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func TableUser_SessionPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(tableUser_SessionPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// TableUser_SessionPlainFieldNames translates a field mask of TableUser_Session into JSON names of TableUser_SessionPlain.
+// A path to an embedded message yields all fields flattened from it.
+func TableUser_SessionPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(tableUser_SessionPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of TableUser_Session, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *TableUser_SessionPlain) ApplyToPb(pb *TableUser_Session, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), tableUser_SessionPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *TableUser_Session) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*TableUser_SessionPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), tableUser_SessionPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &TableUser_Session{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *TableUser_Session) IntoPlainReuse(p *TableUser_SessionPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.UserId = pb.UserId
+	p.Token = pb.Token
+	p.ExpiresAt = pb.ExpiresAt
+	p.Order = pb.Order
+}
+
+// MarshalJX encodes TableUser_SessionPlain to JSON using jx.Encoder
+func (p *TableUser_SessionPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.UserId != "" {
+		e.FieldStart("userId")
+		e.Str(p.UserId)
+	}
+	if p.Token != "" {
+		e.FieldStart("token")
+		e.Str(p.Token)
+	}
+	if p.ExpiresAt != 0 {
+		e.FieldStart("expiresAt")
+		e.Int64(p.ExpiresAt)
+	}
+	if p.Order != 0 {
+		e.FieldStart("order")
+		e.Int32(p.Order)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *TableUser_SessionPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes TableUser_SessionPlain from JSON using jx.Decoder
+func (p *TableUser_SessionPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "userId":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.UserId = v
+		case "token":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Token = v
+		case "expiresAt":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.ExpiresAt = v
+		case "order":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Order = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *TableUser_SessionPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes TableUser_SessionPlain in the protobuf wire format of TableUser_Session
+func (p *TableUser_SessionPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends TableUser_SessionPlain encoded in the protobuf wire format of TableUser_Session to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *TableUser_SessionPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.UserId; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Token; v != "" {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.ExpiresAt; v != 0 {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.Order; v != 0 {
+		b = protowire.AppendTag(b, 4, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes TableUser_SessionPlain from the protobuf wire format of TableUser_Session.
+// Unknown fields are skipped.
+func (p *TableUser_SessionPlain) UnmarshalProto(b []byte) error {
+	*p = TableUser_SessionPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.UserId = v
+		case num == 2 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Token = v
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.ExpiresAt = int64(v)
+		case num == 4 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Order = int32(v)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// tableUser_SessionPlainReflect describes TableUser_SessionPlain as message full.plain.TableUser_SessionPlain
+var tableUser_SessionPlainReflect = plainreflect.NewMessageInfo(file_test_full_table_proto_plain, "TableUser_SessionPlain",
+	plainreflect.Scalar(func(p *TableUser_SessionPlain) *string { return &p.UserId }),
+	plainreflect.Scalar(func(p *TableUser_SessionPlain) *string { return &p.Token }),
+	plainreflect.Scalar(func(p *TableUser_SessionPlain) *int64 { return &p.ExpiresAt }),
+	plainreflect.Scalar(func(p *TableUser_SessionPlain) *int32 { return &p.Order }),
+)
+
+// ProtoReflect returns the reflective view of TableUser_SessionPlain backed by the struct
+func (p *TableUser_SessionPlain) ProtoReflect() protoreflect.Message {
+	return tableUser_SessionPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of TableUser_SessionPlain
+func (p *TableUser_SessionPlain) Clone() *TableUser_SessionPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &TableUser_SessionPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of TableUser_SessionPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetTableUser_SessionPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *TableUser_SessionPlain) CopyTo(dst *TableUser_SessionPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.UserId = p.UserId
+	dst.Token = p.Token
+	dst.ExpiresAt = p.ExpiresAt
+	dst.Order = p.Order
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *TableUser_SessionPlain) Equal(other *TableUser_SessionPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.UserId != other.UserId {
+		return false
+	}
+	if p.Token != other.Token {
+		return false
+	}
+	if p.ExpiresAt != other.ExpiresAt {
+		return false
+	}
+	if p.Order != other.Order {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of TableUser_SessionPlain rows, in the order of ScanRow and Values
+func (p *TableUser_SessionPlain) Columns() []string {
+	return []string{
+		"user_id",
+		"token",
+		"expires_at",
+		"order",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into TableUser_SessionPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *TableUser_SessionPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.UserId,
+		&p.Token,
+		&p.ExpiresAt,
+		&p.Order,
+	)
+}
+
+// Values returns the column values of TableUser_SessionPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *TableUser_SessionPlain) Values() []any {
+	return []any{
+		p.UserId,
+		p.Token,
+		p.ExpiresAt,
+		p.Order,
+	}
+}
+
+// tableUser_SessionPlainPool is a sync.Pool for TableUser_SessionPlain objects
+var tableUser_SessionPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &TableUser_SessionPlain{}
+	},
+}
+
+// GetTableUser_SessionPlain returns a TableUser_SessionPlain from the pool
+func GetTableUser_SessionPlain() *TableUser_SessionPlain {
+	return tableUser_SessionPlainPool.Get().(*TableUser_SessionPlain)
+}
+
+// PutTableUser_SessionPlain returns a TableUser_SessionPlain to the pool after resetting it
+func PutTableUser_SessionPlain(p *TableUser_SessionPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	tableUser_SessionPlainPool.Put(p)
+}
+
+// Reset clears all fields in TableUser_SessionPlain for reuse
+func (p *TableUser_SessionPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.UserId = ""
+	p.Token = ""
+	p.ExpiresAt = 0
+	p.Order = 0
+}
+
+// file_test_full_table_proto_plain_rawDesc is the serialized descriptor of test/full/table_plain.proto,
+// describing the Plain messages of test/full/table.proto in package full.plain
+const file_test_full_table_proto_plain_rawDesc = "" +
+	"\n\x1btest/full/table_plain.proto\x12\nfull.plain\x1a\x15test/full/table.proto" +
+	"\"\xf5\x04\n\x0eTableUserPlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n\x05email\x18\x02 \x01(\tR\x05email\x12\x1f\n\bnic" +
+	"kname\x18\x03 \x01(\tH\x00R\bnickname\x88\x01\x01\x12#\n\x04role\x18\x04 \x01(\x0e2\x0f.full.TableRoleR\x04role\x12" +
+	"#\n\rfallback_role\x18\x05 \x01(\tR\ffallbackRole\x12\x14\n\x05quota\x18\x06 \x01(\x04R\x05quota\x12\x16\n\x06av" +
+	"atar\x18\a \x01(\fR\x06avatar\x12\x18\n\abalance\x18\b \x01(\x01R\abalance\x12\x12\n\x04city\x18\t \x01(\tR\x04city" +
+	"\x12\x10\n\x03zip\x18\n \x01(\tR\x03zip\x12,\n\abilling\x18\v \x01(\v2\x12.full.TableAddressR\abilling" +
+	"\x12\x10\n\x03tag\x18\f \x01(\fR\x03tag\x12\x18\n\aaliases\x18\r \x03(\tR\aaliases\x12D\n\bcounters\x18\x0e \x03(\v2(" +
+	".full.plain.TableUserPlain.CountersEntryR\bcounters\x12.\n\x13login_pass" +
+	"word_hash\x18\x0f \x01(\tR\x11loginPasswordHash\x12*\n\x11login_sso_subject\x18\x10 \x01(\tR\x0fl" +
+	"oginSsoSubject\x12\x1e\n\nlogin_case\x18\x11 \x01(\tR\nlogin_case\x1a;\n\rCountersEntry\x12" +
+	"\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\v\n\t_nickname\"|\n\x16Tabl" +
+	"eUser_SessionPlain\x12\x17\n\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n\x05token\x18\x02 \x01(\tR\x05toke" +
+	"n\x12\x1d\n\nexpires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x14\n\x05order\x18\x04 \x01(\x05R\x05orderb\x06proto3"
+
+var file_test_full_table_proto_plain = plainreflect.NewFile("test/full/table_plain.proto", file_test_full_table_proto_plain_rawDesc)
+
+func init() { file_test_full_table_proto_plain_init() }
+
+func file_test_full_table_proto_plain_init() {
+	file_test_full_table_proto_init()
+	file_test_full_table_proto_plain.Init()
+}
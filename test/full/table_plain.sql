@@ -0,0 +1,39 @@
+-- Code generated by protoc-gen-go-plain. DO NOT EDIT.
+-- source: test/full/table.proto
+
+-- users stores TableUserPlain
+CREATE TABLE "users" (
+    "id" TEXT NOT NULL,
+    "email" TEXT NOT NULL,
+    "nickname" TEXT,
+    "role" INTEGER NOT NULL,
+    "fallback_role" TEXT NOT NULL,
+    "quota" NUMERIC(20) NOT NULL,
+    "avatar" BYTEA,
+    "balance" NUMERIC(12, 2) NOT NULL,
+    "city" TEXT NOT NULL,
+    "zip" VARCHAR(10) NOT NULL,
+    "billing" JSONB,
+    "tag" JSONB,
+    "aliases" JSONB,
+    "counters" JSONB,
+    "login_password_hash" TEXT NOT NULL,
+    "login_sso_subject" TEXT NOT NULL,
+    "login_case" TEXT NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX "users_email_idx" ON "users" ("email");
+
+CREATE INDEX "users_location_idx" ON "users" ("city", "zip");
+
+-- user_sessions stores TableUser_SessionPlain
+CREATE TABLE "user_sessions" (
+    "user_id" TEXT NOT NULL,
+    "token" TEXT NOT NULL,
+    "expires_at" BIGINT NOT NULL,
+    "order" INTEGER NOT NULL,
+    PRIMARY KEY ("user_id", "token")
+);
+
+CREATE INDEX "user_sessions_order_idx" ON "user_sessions" ("order");
@@ -0,0 +1,56 @@
+package full_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+)
+
+// tableColumns returns the column names of CREATE TABLE name in the generated DDL
+func tableColumns(t *testing.T, ddl, name string) []string {
+	t.Helper()
+	body := regexp.MustCompile(`(?s)CREATE TABLE "` + name + `" \((.*?)\n\);`).FindStringSubmatch(ddl)
+	require.NotNil(t, body, "no CREATE TABLE %s", name)
+	var columns []string
+	for _, m := range regexp.MustCompile(`(?m)^    "(\w+)" `).FindAllStringSubmatch(body[1], -1) {
+		columns = append(columns, m[1])
+	}
+	return columns
+}
+
+func TestTable_ColumnsMatchDDL(t *testing.T) {
+	ddl, err := os.ReadFile("table_plain.sql")
+	require.NoError(t, err)
+
+	assert.Equal(t, (&full.TableUserPlain{}).Columns(), tableColumns(t, string(ddl), "users"))
+	assert.Equal(t, (&full.TableUser_SessionPlain{}).Columns(), tableColumns(t, string(ddl), "user_sessions"))
+}
+
+func TestTable_DDL(t *testing.T) {
+	ddl, err := os.ReadFile("table_plain.sql")
+	require.NoError(t, err)
+
+	for _, line := range []string{
+		`    "nickname" TEXT,`,                   // optional
+		`    "role" INTEGER NOT NULL,`,           // enum
+		`    "fallback_role" TEXT NOT NULL,`,     // enum_as_string
+		`    "quota" NUMERIC(20) NOT NULL,`,      // uint64
+		`    "balance" NUMERIC(12, 2) NOT NULL,`, // sql_type
+		`    "zip" VARCHAR(10) NOT NULL,`,        // sql_type on an embedded field
+		`    "billing" JSONB,`,                   // message
+		`    "tag" JSONB,`,                       // serialize
+		`    "counters" JSONB,`,                  // map
+		`    "login_case" TEXT NOT NULL,`,        // oneof case
+		`    "order" INTEGER NOT NULL,`,          // reserved word
+		`    PRIMARY KEY ("user_id", "token")`,
+		`CREATE UNIQUE INDEX "users_email_idx" ON "users" ("email");`,
+		`CREATE INDEX "users_location_idx" ON "users" ("city", "zip");`,
+		`CREATE INDEX "user_sessions_order_idx" ON "user_sessions" ("order");`,
+	} {
+		assert.Contains(t, string(ddl), line+"\n")
+	}
+}
@@ -0,0 +1,77 @@
+package full_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tsPropertyKey = regexp.MustCompile(`(?m)^\s+"?([A-Za-z0-9_$]+)"?\??: `)
+
+// tsDeclarations returns the generated TypeScript files keyed by the types they export
+func tsDeclarations(t *testing.T) map[string]string {
+	t.Helper()
+	files, err := filepath.Glob("*_plain.ts")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	declarations := make(map[string]string)
+	exported := regexp.MustCompile(`(?m)^export (?:interface|type) ([A-Za-z0-9_]+)`)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+		for _, m := range exported.FindAllStringSubmatch(string(data), -1) {
+			declarations[m[1]] = string(data)
+		}
+	}
+	return declarations
+}
+
+func TestTypeScript_DeclaresMarshalKeys(t *testing.T) {
+	declarations := tsDeclarations(t)
+	for name, doc := range schemaDocs() {
+		t.Run(name, func(t *testing.T) {
+			plain, _, _ := strings.Cut(name, "/")
+			source, ok := declarations[plain]
+			require.True(t, ok, "%s is not declared", plain)
+			var keys []string
+			for _, m := range tsPropertyKey.FindAllStringSubmatch(source, -1) {
+				keys = append(keys, m[1])
+			}
+
+			data, err := doc.MarshalJSON()
+			require.NoError(t, err)
+			var obj map[string]any
+			require.NoError(t, json.Unmarshal(data, &obj))
+			for key, value := range obj {
+				assert.Contains(t, keys, key)
+				// Case fields are discriminants with a branch per variant
+				if strings.HasSuffix(key, "_case") {
+					assert.Contains(t, source, key+": "+strconv.Quote(value.(string)))
+				}
+			}
+		})
+	}
+}
+
+func TestTypeScript_Types(t *testing.T) {
+	declarations := tsDeclarations(t)
+	table := declarations["TableUserPlain"]
+	// enum_as_string enums are string literal unions, other enums are numbers
+	assert.Contains(t, table, `fallbackRole?: "TABLE_ROLE_UNSPECIFIED" | "TABLE_ROLE_ADMIN";`)
+	assert.Contains(t, table, "role?: 0 | 1;")
+	assert.Contains(t, table, "export type TableUserPlain = TableUserPlainFields & TableUserPlainLogin;")
+
+	wire := declarations["WireEnvelopePlain"]
+	// jx writes 64-bit integers as numbers
+	assert.Contains(t, wire, "scalarsFInt64?: number;")
+	assert.Contains(t, wire, "scalarsFBytes?: string;")
+	assert.Contains(t, wire, "attachmentsById?: Record<string, WireAttachmentPlain>;")
+	assert.Contains(t, wire, "thumbnails?: WireEnvelopeThumbnailsItemPlain[];")
+}
@@ -0,0 +1,901 @@
+// Direct protobuf wire encoding of Plain structs (wire=true)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/full/wire.proto
+
+package full
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WireColor int32
+
+const (
+	WireColor_WIRE_COLOR_UNSPECIFIED WireColor = 0
+	WireColor_WIRE_COLOR_RED         WireColor = 1
+	WireColor_WIRE_COLOR_GREEN       WireColor = 2
+)
+
+// Enum value maps for WireColor.
+var (
+	WireColor_name = map[int32]string{
+		0: "WIRE_COLOR_UNSPECIFIED",
+		1: "WIRE_COLOR_RED",
+		2: "WIRE_COLOR_GREEN",
+	}
+	WireColor_value = map[string]int32{
+		"WIRE_COLOR_UNSPECIFIED": 0,
+		"WIRE_COLOR_RED":         1,
+		"WIRE_COLOR_GREEN":       2,
+	}
+)
+
+func (x WireColor) Enum() *WireColor {
+	p := new(WireColor)
+	*p = x
+	return p
+}
+
+func (x WireColor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WireColor) Descriptor() protoreflect.EnumDescriptor {
+	return file_test_full_wire_proto_enumTypes[0].Descriptor()
+}
+
+func (WireColor) Type() protoreflect.EnumType {
+	return &file_test_full_wire_proto_enumTypes[0]
+}
+
+func (x WireColor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WireColor.Descriptor instead.
+func (WireColor) EnumDescriptor() ([]byte, []int) {
+	return file_test_full_wire_proto_rawDescGZIP(), []int{0}
+}
+
+type WireLabel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireLabel) Reset() {
+	*x = WireLabel{}
+	mi := &file_test_full_wire_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireLabel) ProtoMessage() {}
+
+func (x *WireLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_wire_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireLabel.ProtoReflect.Descriptor instead.
+func (*WireLabel) Descriptor() ([]byte, []int) {
+	return file_test_full_wire_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WireLabel) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type WireScalars struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FDouble       float64                `protobuf:"fixed64,1,opt,name=f_double,json=fDouble,proto3" json:"f_double,omitempty"`
+	FFloat        float32                `protobuf:"fixed32,2,opt,name=f_float,json=fFloat,proto3" json:"f_float,omitempty"`
+	FInt32        int32                  `protobuf:"varint,3,opt,name=f_int32,json=fInt32,proto3" json:"f_int32,omitempty"`
+	FInt64        int64                  `protobuf:"varint,4,opt,name=f_int64,json=fInt64,proto3" json:"f_int64,omitempty"`
+	FUint32       uint32                 `protobuf:"varint,5,opt,name=f_uint32,json=fUint32,proto3" json:"f_uint32,omitempty"`
+	FUint64       uint64                 `protobuf:"varint,6,opt,name=f_uint64,json=fUint64,proto3" json:"f_uint64,omitempty"`
+	FSint32       int32                  `protobuf:"zigzag32,7,opt,name=f_sint32,json=fSint32,proto3" json:"f_sint32,omitempty"`
+	FSint64       int64                  `protobuf:"zigzag64,8,opt,name=f_sint64,json=fSint64,proto3" json:"f_sint64,omitempty"`
+	FFixed32      uint32                 `protobuf:"fixed32,9,opt,name=f_fixed32,json=fFixed32,proto3" json:"f_fixed32,omitempty"`
+	FFixed64      uint64                 `protobuf:"fixed64,10,opt,name=f_fixed64,json=fFixed64,proto3" json:"f_fixed64,omitempty"`
+	FSfixed32     int32                  `protobuf:"fixed32,11,opt,name=f_sfixed32,json=fSfixed32,proto3" json:"f_sfixed32,omitempty"`
+	FSfixed64     int64                  `protobuf:"fixed64,12,opt,name=f_sfixed64,json=fSfixed64,proto3" json:"f_sfixed64,omitempty"`
+	FBool         bool                   `protobuf:"varint,13,opt,name=f_bool,json=fBool,proto3" json:"f_bool,omitempty"`
+	FString       string                 `protobuf:"bytes,14,opt,name=f_string,json=fString,proto3" json:"f_string,omitempty"`
+	FBytes        []byte                 `protobuf:"bytes,15,opt,name=f_bytes,json=fBytes,proto3" json:"f_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireScalars) Reset() {
+	*x = WireScalars{}
+	mi := &file_test_full_wire_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireScalars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireScalars) ProtoMessage() {}
+
+func (x *WireScalars) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_wire_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireScalars.ProtoReflect.Descriptor instead.
+func (*WireScalars) Descriptor() ([]byte, []int) {
+	return file_test_full_wire_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WireScalars) GetFDouble() float64 {
+	if x != nil {
+		return x.FDouble
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFFloat() float32 {
+	if x != nil {
+		return x.FFloat
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFInt32() int32 {
+	if x != nil {
+		return x.FInt32
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFInt64() int64 {
+	if x != nil {
+		return x.FInt64
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFUint32() uint32 {
+	if x != nil {
+		return x.FUint32
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFUint64() uint64 {
+	if x != nil {
+		return x.FUint64
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFSint32() int32 {
+	if x != nil {
+		return x.FSint32
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFSint64() int64 {
+	if x != nil {
+		return x.FSint64
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFFixed32() uint32 {
+	if x != nil {
+		return x.FFixed32
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFFixed64() uint64 {
+	if x != nil {
+		return x.FFixed64
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFSfixed32() int32 {
+	if x != nil {
+		return x.FSfixed32
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFSfixed64() int64 {
+	if x != nil {
+		return x.FSfixed64
+	}
+	return 0
+}
+
+func (x *WireScalars) GetFBool() bool {
+	if x != nil {
+		return x.FBool
+	}
+	return false
+}
+
+func (x *WireScalars) GetFString() string {
+	if x != nil {
+		return x.FString
+	}
+	return ""
+}
+
+func (x *WireScalars) GetFBytes() []byte {
+	if x != nil {
+		return x.FBytes
+	}
+	return nil
+}
+
+type WireDimensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Width         int32                  `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireDimensions) Reset() {
+	*x = WireDimensions{}
+	mi := &file_test_full_wire_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireDimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireDimensions) ProtoMessage() {}
+
+func (x *WireDimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_wire_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireDimensions.ProtoReflect.Descriptor instead.
+func (*WireDimensions) Descriptor() ([]byte, []int) {
+	return file_test_full_wire_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WireDimensions) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *WireDimensions) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type WireAttachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Embedded two levels deep
+	Size          *WireDimensions `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireAttachment) Reset() {
+	*x = WireAttachment{}
+	mi := &file_test_full_wire_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireAttachment) ProtoMessage() {}
+
+func (x *WireAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_wire_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireAttachment.ProtoReflect.Descriptor instead.
+func (*WireAttachment) Descriptor() ([]byte, []int) {
+	return file_test_full_wire_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WireAttachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WireAttachment) GetSize() *WireDimensions {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+type WireText struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireText) Reset() {
+	*x = WireText{}
+	mi := &file_test_full_wire_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireText) ProtoMessage() {}
+
+func (x *WireText) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_wire_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireText.ProtoReflect.Descriptor instead.
+func (*WireText) Descriptor() ([]byte, []int) {
+	return file_test_full_wire_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WireText) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type WireImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Size          *WireDimensions        `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireImage) Reset() {
+	*x = WireImage{}
+	mi := &file_test_full_wire_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireImage) ProtoMessage() {}
+
+func (x *WireImage) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_wire_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireImage.ProtoReflect.Descriptor instead.
+func (*WireImage) Descriptor() ([]byte, []int) {
+	return file_test_full_wire_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WireImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WireImage) GetSize() *WireDimensions {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+type WireEnvelope struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	Id              string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Priority        int32                     `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Offsets         []int64                   `protobuf:"varint,3,rep,packed,name=offsets,proto3" json:"offsets,omitempty"`
+	Deltas          []int32                   `protobuf:"zigzag32,4,rep,packed,name=deltas,proto3" json:"deltas,omitempty"`
+	Weights         []float64                 `protobuf:"fixed64,5,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	Tags            []string                  `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Color           WireColor                 `protobuf:"varint,7,opt,name=color,proto3,enum=full.WireColor" json:"color,omitempty"`
+	RawColor        WireColor                 `protobuf:"varint,8,opt,name=raw_color,json=rawColor,proto3,enum=full.WireColor" json:"raw_color,omitempty"`
+	Palette         []WireColor               `protobuf:"varint,9,rep,packed,name=palette,proto3,enum=full.WireColor" json:"palette,omitempty"`
+	Counters        map[string]int64          `protobuf:"bytes,10,rep,name=counters,proto3" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	AttachmentsById map[int32]*WireAttachment `protobuf:"bytes,11,rep,name=attachments_by_id,json=attachmentsById,proto3" json:"attachments_by_id,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Flags           map[bool]string           `protobuf:"bytes,12,rep,name=flags,proto3" json:"flags,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Scalars flattened into the envelope
+	Scalars     *WireScalars      `protobuf:"bytes,13,opt,name=scalars,proto3" json:"scalars,omitempty"`
+	Cover       *WireAttachment   `protobuf:"bytes,14,opt,name=cover,proto3" json:"cover,omitempty"`
+	Attachments []*WireAttachment `protobuf:"bytes,15,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Price       *common.Money     `protobuf:"bytes,16,opt,name=price,proto3" json:"price,omitempty"`
+	Label       *WireLabel        `protobuf:"bytes,17,opt,name=label,proto3" json:"label,omitempty"`
+	Aliases     []*WireLabel      `protobuf:"bytes,18,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	RawSize     *WireDimensions   `protobuf:"bytes,19,opt,name=raw_size,json=rawSize,proto3" json:"raw_size,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*WireEnvelope_Text
+	//	*WireEnvelope_Image
+	//	*WireEnvelope_Ping
+	Payload isWireEnvelope_Payload `protobuf_oneof:"payload"`
+	// Repeated embed rows
+	Thumbnails    []*WireDimensions `protobuf:"bytes,23,rep,name=thumbnails,proto3" json:"thumbnails,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireEnvelope) Reset() {
+	*x = WireEnvelope{}
+	mi := &file_test_full_wire_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireEnvelope) ProtoMessage() {}
+
+func (x *WireEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_test_full_wire_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireEnvelope.ProtoReflect.Descriptor instead.
+func (*WireEnvelope) Descriptor() ([]byte, []int) {
+	return file_test_full_wire_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WireEnvelope) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WireEnvelope) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *WireEnvelope) GetOffsets() []int64 {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetDeltas() []int32 {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetWeights() []float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetColor() WireColor {
+	if x != nil {
+		return x.Color
+	}
+	return WireColor_WIRE_COLOR_UNSPECIFIED
+}
+
+func (x *WireEnvelope) GetRawColor() WireColor {
+	if x != nil {
+		return x.RawColor
+	}
+	return WireColor_WIRE_COLOR_UNSPECIFIED
+}
+
+func (x *WireEnvelope) GetPalette() []WireColor {
+	if x != nil {
+		return x.Palette
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetCounters() map[string]int64 {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetAttachmentsById() map[int32]*WireAttachment {
+	if x != nil {
+		return x.AttachmentsById
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetFlags() map[bool]string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetScalars() *WireScalars {
+	if x != nil {
+		return x.Scalars
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetCover() *WireAttachment {
+	if x != nil {
+		return x.Cover
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetAttachments() []*WireAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetPrice() *common.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetLabel() *WireLabel {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetAliases() []*WireLabel {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetRawSize() *WireDimensions {
+	if x != nil {
+		return x.RawSize
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetPayload() isWireEnvelope_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetText() *WireText {
+	if x != nil {
+		if x, ok := x.Payload.(*WireEnvelope_Text); ok {
+			return x.Text
+		}
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetImage() *WireImage {
+	if x != nil {
+		if x, ok := x.Payload.(*WireEnvelope_Image); ok {
+			return x.Image
+		}
+	}
+	return nil
+}
+
+func (x *WireEnvelope) GetPing() int64 {
+	if x != nil {
+		if x, ok := x.Payload.(*WireEnvelope_Ping); ok {
+			return x.Ping
+		}
+	}
+	return 0
+}
+
+func (x *WireEnvelope) GetThumbnails() []*WireDimensions {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+type isWireEnvelope_Payload interface {
+	isWireEnvelope_Payload()
+}
+
+type WireEnvelope_Text struct {
+	Text *WireText `protobuf:"bytes,20,opt,name=text,proto3,oneof"`
+}
+
+type WireEnvelope_Image struct {
+	Image *WireImage `protobuf:"bytes,21,opt,name=image,proto3,oneof"`
+}
+
+type WireEnvelope_Ping struct {
+	Ping int64 `protobuf:"varint,22,opt,name=ping,proto3,oneof"`
+}
+
+func (*WireEnvelope_Text) isWireEnvelope_Payload() {}
+
+func (*WireEnvelope_Image) isWireEnvelope_Payload() {}
+
+func (*WireEnvelope_Ping) isWireEnvelope_Payload() {}
+
+var File_test_full_wire_proto protoreflect.FileDescriptor
+
+const file_test_full_wire_proto_rawDesc = "" +
+	"\n" +
+	"\x14test/full/wire.proto\x12\x04full\x1a\x15goplain/goplain.proto\x1a\x1ctest/full/common/money.proto\")\n" +
+	"\tWireLabel\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value:\x06\x82\xa6\x1d\x02\x10\x01\"\xa2\x03\n" +
+	"\vWireScalars\x12\x19\n" +
+	"\bf_double\x18\x01 \x01(\x01R\afDouble\x12\x17\n" +
+	"\af_float\x18\x02 \x01(\x02R\x06fFloat\x12\x17\n" +
+	"\af_int32\x18\x03 \x01(\x05R\x06fInt32\x12\x17\n" +
+	"\af_int64\x18\x04 \x01(\x03R\x06fInt64\x12\x19\n" +
+	"\bf_uint32\x18\x05 \x01(\rR\afUint32\x12\x19\n" +
+	"\bf_uint64\x18\x06 \x01(\x04R\afUint64\x12\x19\n" +
+	"\bf_sint32\x18\a \x01(\x11R\afSint32\x12\x19\n" +
+	"\bf_sint64\x18\b \x01(\x12R\afSint64\x12\x1b\n" +
+	"\tf_fixed32\x18\t \x01(\aR\bfFixed32\x12\x1b\n" +
+	"\tf_fixed64\x18\n" +
+	" \x01(\x06R\bfFixed64\x12\x1d\n" +
+	"\n" +
+	"f_sfixed32\x18\v \x01(\x0fR\tfSfixed32\x12\x1d\n" +
+	"\n" +
+	"f_sfixed64\x18\f \x01(\x10R\tfSfixed64\x12\x15\n" +
+	"\x06f_bool\x18\r \x01(\bR\x05fBool\x12\x19\n" +
+	"\bf_string\x18\x0e \x01(\tR\afString\x12\x17\n" +
+	"\af_bytes\x18\x0f \x01(\fR\x06fBytes\">\n" +
+	"\x0eWireDimensions\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x05R\x06height\"`\n" +
+	"\x0eWireAttachment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
+	"\x04size\x18\x02 \x01(\v2\x14.full.WireDimensionsB\b\x82\xa6\x1d\x04 \x01(\x01R\x04size:\x06\x82\xa6\x1d\x02\b\x01\"\x1e\n" +
+	"\bWireText\x12\x12\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\"G\n" +
+	"\tWireImage\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12(\n" +
+	"\x04size\x18\x02 \x01(\v2\x14.full.WireDimensionsR\x04size\"\xe3\t\n" +
+	"\fWireEnvelope\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12\x18\n" +
+	"\aoffsets\x18\x03 \x03(\x03R\aoffsets\x12\x16\n" +
+	"\x06deltas\x18\x04 \x03(\x11R\x06deltas\x12\x18\n" +
+	"\aweights\x18\x05 \x03(\x01R\aweights\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12-\n" +
+	"\x05color\x18\a \x01(\x0e2\x0f.full.WireColorB\x06\x82\xa6\x1d\x028\x01R\x05color\x12,\n" +
+	"\traw_color\x18\b \x01(\x0e2\x0f.full.WireColorR\brawColor\x121\n" +
+	"\apalette\x18\t \x03(\x0e2\x0f.full.WireColorB\x06\x82\xa6\x1d\x028\x01R\apalette\x12<\n" +
+	"\bcounters\x18\n" +
+	" \x03(\v2 .full.WireEnvelope.CountersEntryR\bcounters\x12S\n" +
+	"\x11attachments_by_id\x18\v \x03(\v2'.full.WireEnvelope.AttachmentsByIdEntryR\x0fattachmentsById\x123\n" +
+	"\x05flags\x18\f \x03(\v2\x1d.full.WireEnvelope.FlagsEntryR\x05flags\x125\n" +
+	"\ascalars\x18\r \x01(\v2\x11.full.WireScalarsB\b\x82\xa6\x1d\x04 \x01(\x01R\ascalars\x12*\n" +
+	"\x05cover\x18\x0e \x01(\v2\x14.full.WireAttachmentR\x05cover\x126\n" +
+	"\vattachments\x18\x0f \x03(\v2\x14.full.WireAttachmentR\vattachments\x12(\n" +
+	"\x05price\x18\x10 \x01(\v2\x12.full.common.MoneyR\x05price\x12%\n" +
+	"\x05label\x18\x11 \x01(\v2\x0f.full.WireLabelR\x05label\x12)\n" +
+	"\aaliases\x18\x12 \x03(\v2\x0f.full.WireLabelR\aaliases\x127\n" +
+	"\braw_size\x18\x13 \x01(\v2\x14.full.WireDimensionsB\x06\x82\xa6\x1d\x02\x10\x01R\arawSize\x12,\n" +
+	"\x04text\x18\x14 \x01(\v2\x0e.full.WireTextB\x06\x82\xa6\x1d\x02 \x01H\x00R\x04text\x12/\n" +
+	"\x05image\x18\x15 \x01(\v2\x0f.full.WireImageB\x06\x82\xa6\x1d\x02 \x01H\x00R\x05image\x12\x14\n" +
+	"\x04ping\x18\x16 \x01(\x03H\x00R\x04ping\x12<\n" +
+	"\n" +
+	"thumbnails\x18\x17 \x03(\v2\x14.full.WireDimensionsB\x06\x82\xa6\x1d\x02 \x01R\n" +
+	"thumbnails\x1a;\n" +
+	"\rCountersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aX\n" +
+	"\x14AttachmentsByIdEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.full.WireAttachmentR\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"FlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\bR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:\x06\x82\xa6\x1d\x02\b\x01B\x13\n" +
+	"\apayload\x12\b\x82\xb5\x18\x04\b\x01\x10\x01*Q\n" +
+	"\tWireColor\x12\x1a\n" +
+	"\x16WIRE_COLOR_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eWIRE_COLOR_RED\x10\x01\x12\x14\n" +
+	"\x10WIRE_COLOR_GREEN\x10\x02B2Z0github.com/yaroher/protoc-gen-go-plain/test/fullb\x06proto3"
+
+var (
+	file_test_full_wire_proto_rawDescOnce sync.Once
+	file_test_full_wire_proto_rawDescData []byte
+)
+
+func file_test_full_wire_proto_rawDescGZIP() []byte {
+	file_test_full_wire_proto_rawDescOnce.Do(func() {
+		file_test_full_wire_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_full_wire_proto_rawDesc), len(file_test_full_wire_proto_rawDesc)))
+	})
+	return file_test_full_wire_proto_rawDescData
+}
+
+var file_test_full_wire_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_test_full_wire_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_test_full_wire_proto_goTypes = []any{
+	(WireColor)(0),         // 0: full.WireColor
+	(*WireLabel)(nil),      // 1: full.WireLabel
+	(*WireScalars)(nil),    // 2: full.WireScalars
+	(*WireDimensions)(nil), // 3: full.WireDimensions
+	(*WireAttachment)(nil), // 4: full.WireAttachment
+	(*WireText)(nil),       // 5: full.WireText
+	(*WireImage)(nil),      // 6: full.WireImage
+	(*WireEnvelope)(nil),   // 7: full.WireEnvelope
+	nil,                    // 8: full.WireEnvelope.CountersEntry
+	nil,                    // 9: full.WireEnvelope.AttachmentsByIdEntry
+	nil,                    // 10: full.WireEnvelope.FlagsEntry
+	(*common.Money)(nil),   // 11: full.common.Money
+}
+var file_test_full_wire_proto_depIdxs = []int32{
+	3,  // 0: full.WireAttachment.size:type_name -> full.WireDimensions
+	3,  // 1: full.WireImage.size:type_name -> full.WireDimensions
+	0,  // 2: full.WireEnvelope.color:type_name -> full.WireColor
+	0,  // 3: full.WireEnvelope.raw_color:type_name -> full.WireColor
+	0,  // 4: full.WireEnvelope.palette:type_name -> full.WireColor
+	8,  // 5: full.WireEnvelope.counters:type_name -> full.WireEnvelope.CountersEntry
+	9,  // 6: full.WireEnvelope.attachments_by_id:type_name -> full.WireEnvelope.AttachmentsByIdEntry
+	10, // 7: full.WireEnvelope.flags:type_name -> full.WireEnvelope.FlagsEntry
+	2,  // 8: full.WireEnvelope.scalars:type_name -> full.WireScalars
+	4,  // 9: full.WireEnvelope.cover:type_name -> full.WireAttachment
+	4,  // 10: full.WireEnvelope.attachments:type_name -> full.WireAttachment
+	11, // 11: full.WireEnvelope.price:type_name -> full.common.Money
+	1,  // 12: full.WireEnvelope.label:type_name -> full.WireLabel
+	1,  // 13: full.WireEnvelope.aliases:type_name -> full.WireLabel
+	3,  // 14: full.WireEnvelope.raw_size:type_name -> full.WireDimensions
+	5,  // 15: full.WireEnvelope.text:type_name -> full.WireText
+	6,  // 16: full.WireEnvelope.image:type_name -> full.WireImage
+	3,  // 17: full.WireEnvelope.thumbnails:type_name -> full.WireDimensions
+	4,  // 18: full.WireEnvelope.AttachmentsByIdEntry.value:type_name -> full.WireAttachment
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_test_full_wire_proto_init() }
+func file_test_full_wire_proto_init() {
+	if File_test_full_wire_proto != nil {
+		return
+	}
+	file_test_full_wire_proto_msgTypes[6].OneofWrappers = []any{
+		(*WireEnvelope_Text)(nil),
+		(*WireEnvelope_Image)(nil),
+		(*WireEnvelope_Ping)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_full_wire_proto_rawDesc), len(file_test_full_wire_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_full_wire_proto_goTypes,
+		DependencyIndexes: file_test_full_wire_proto_depIdxs,
+		EnumInfos:         file_test_full_wire_proto_enumTypes,
+		MessageInfos:      file_test_full_wire_proto_msgTypes,
+	}.Build()
+	File_test_full_wire_proto = out.File
+	file_test_full_wire_proto_goTypes = nil
+	file_test_full_wire_proto_depIdxs = nil
+}
@@ -0,0 +1,761 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/wire.proto
+
+package full
+
+import (
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	strconv "strconv"
+)
+
+// MarshalJX encodes WireLabel to JSON using jx.Encoder
+func (p *WireLabel) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetValue() != "" {
+		e.FieldStart("value")
+		e.Str(p.GetValue())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes WireLabel from JSON using jx.Decoder
+func (p *WireLabel) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "value":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Value = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes WireScalars to JSON using jx.Encoder
+func (p *WireScalars) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetFDouble() != 0 {
+		e.FieldStart("fDouble")
+		e.Float64(p.GetFDouble())
+	}
+	if p.GetFFloat() != 0 {
+		e.FieldStart("fFloat")
+		e.Float32(p.GetFFloat())
+	}
+	if p.GetFInt32() != 0 {
+		e.FieldStart("fInt32")
+		e.Int32(p.GetFInt32())
+	}
+	if p.GetFInt64() != 0 {
+		e.FieldStart("fInt64")
+		e.Int64(p.GetFInt64())
+	}
+	if p.GetFUint32() != 0 {
+		e.FieldStart("fUint32")
+		e.UInt32(p.GetFUint32())
+	}
+	if p.GetFUint64() != 0 {
+		e.FieldStart("fUint64")
+		e.UInt64(p.GetFUint64())
+	}
+	if p.GetFSint32() != 0 {
+		e.FieldStart("fSint32")
+		e.Int32(p.GetFSint32())
+	}
+	if p.GetFSint64() != 0 {
+		e.FieldStart("fSint64")
+		e.Int64(p.GetFSint64())
+	}
+	if p.GetFFixed32() != 0 {
+		e.FieldStart("fFixed32")
+		e.UInt32(p.GetFFixed32())
+	}
+	if p.GetFFixed64() != 0 {
+		e.FieldStart("fFixed64")
+		e.UInt64(p.GetFFixed64())
+	}
+	if p.GetFSfixed32() != 0 {
+		e.FieldStart("fSfixed32")
+		e.Int32(p.GetFSfixed32())
+	}
+	if p.GetFSfixed64() != 0 {
+		e.FieldStart("fSfixed64")
+		e.Int64(p.GetFSfixed64())
+	}
+	if p.GetFBool() {
+		e.FieldStart("fBool")
+		e.Bool(p.GetFBool())
+	}
+	if p.GetFString() != "" {
+		e.FieldStart("fString")
+		e.Str(p.GetFString())
+	}
+	if len(p.GetFBytes()) > 0 {
+		e.FieldStart("fBytes")
+		e.Base64(p.GetFBytes())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes WireScalars from JSON using jx.Decoder
+func (p *WireScalars) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "fDouble":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.FDouble = v
+		case "fFloat":
+			v, err := d.Float32()
+			if err != nil {
+				return err
+			}
+			p.FFloat = v
+		case "fInt32":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.FInt32 = v
+		case "fInt64":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.FInt64 = v
+		case "fUint32":
+			v, err := d.UInt32()
+			if err != nil {
+				return err
+			}
+			p.FUint32 = v
+		case "fUint64":
+			v, err := d.UInt64()
+			if err != nil {
+				return err
+			}
+			p.FUint64 = v
+		case "fSint32":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.FSint32 = v
+		case "fSint64":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.FSint64 = v
+		case "fFixed32":
+			v, err := d.UInt32()
+			if err != nil {
+				return err
+			}
+			p.FFixed32 = v
+		case "fFixed64":
+			v, err := d.UInt64()
+			if err != nil {
+				return err
+			}
+			p.FFixed64 = v
+		case "fSfixed32":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.FSfixed32 = v
+		case "fSfixed64":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.FSfixed64 = v
+		case "fBool":
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.FBool = v
+		case "fString":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.FString = v
+		case "fBytes":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.FBytes = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes WireDimensions to JSON using jx.Encoder
+func (p *WireDimensions) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetWidth() != 0 {
+		e.FieldStart("width")
+		e.Int32(p.GetWidth())
+	}
+	if p.GetHeight() != 0 {
+		e.FieldStart("height")
+		e.Int32(p.GetHeight())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes WireDimensions from JSON using jx.Decoder
+func (p *WireDimensions) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "width":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Width = v
+		case "height":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Height = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes WireAttachment to JSON using jx.Encoder
+func (p *WireAttachment) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetName() != "" {
+		e.FieldStart("name")
+		e.Str(p.GetName())
+	}
+	if p.GetSize() != nil {
+		e.FieldStart("size")
+		p.GetSize().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes WireAttachment from JSON using jx.Decoder
+func (p *WireAttachment) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		case "size":
+			p.Size = &WireDimensions{}
+			if err := p.Size.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes WireText to JSON using jx.Encoder
+func (p *WireText) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetBody() != "" {
+		e.FieldStart("body")
+		e.Str(p.GetBody())
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes WireText from JSON using jx.Decoder
+func (p *WireText) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "body":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Body = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes WireImage to JSON using jx.Encoder
+func (p *WireImage) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetUrl() != "" {
+		e.FieldStart("url")
+		e.Str(p.GetUrl())
+	}
+	if p.GetSize() != nil {
+		e.FieldStart("size")
+		p.GetSize().MarshalJX(e)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes WireImage from JSON using jx.Decoder
+func (p *WireImage) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "url":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Url = v
+		case "size":
+			p.Size = &WireDimensions{}
+			if err := p.Size.UnmarshalJX(d); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes WireEnvelope to JSON using jx.Encoder
+func (p *WireEnvelope) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetPriority() != 0 {
+		e.FieldStart("priority")
+		e.Int32(p.GetPriority())
+	}
+	if len(p.GetOffsets()) > 0 {
+		e.FieldStart("offsets")
+		e.ArrStart()
+		for _, v := range p.GetOffsets() {
+			e.Int64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetDeltas()) > 0 {
+		e.FieldStart("deltas")
+		e.ArrStart()
+		for _, v := range p.GetDeltas() {
+			e.Int32(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetWeights()) > 0 {
+		e.FieldStart("weights")
+		e.ArrStart()
+		for _, v := range p.GetWeights() {
+			e.Float64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetTags()) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.GetTags() {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("color")
+	e.Int32(int32(p.GetColor()))
+	e.FieldStart("rawColor")
+	e.Int32(int32(p.GetRawColor()))
+	if len(p.GetPalette()) > 0 {
+		e.FieldStart("palette")
+		e.ArrStart()
+		for _, v := range p.GetPalette() {
+			e.Int32(int32(v))
+		}
+		e.ArrEnd()
+	}
+	if len(p.GetCounters()) > 0 {
+		e.FieldStart("counters")
+		e.ObjStart()
+		for k, v := range p.GetCounters() {
+			e.FieldStart(k)
+			e.Int64(v)
+		}
+		e.ObjEnd()
+	}
+	if len(p.GetAttachmentsById()) > 0 {
+		e.FieldStart("attachmentsById")
+		e.ObjStart()
+		for k, v := range p.GetAttachmentsById() {
+			e.FieldStart(fmt.Sprint(k))
+			v.MarshalJX(e)
+		}
+		e.ObjEnd()
+	}
+	if len(p.GetFlags()) > 0 {
+		e.FieldStart("flags")
+		e.ObjStart()
+		for k, v := range p.GetFlags() {
+			e.FieldStart(fmt.Sprint(k))
+			e.Str(v)
+		}
+		e.ObjEnd()
+	}
+	if p.GetScalars() != nil {
+		e.FieldStart("scalars")
+		p.GetScalars().MarshalJX(e)
+	}
+	if p.GetCover() != nil {
+		e.FieldStart("cover")
+		p.GetCover().MarshalJX(e)
+	}
+	if len(p.GetAttachments()) > 0 {
+		e.FieldStart("attachments")
+		e.ArrStart()
+		for _, v := range p.GetAttachments() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if p.GetPrice() != nil {
+		e.FieldStart("price")
+		p.GetPrice().MarshalJX(e)
+	}
+	if p.GetLabel() != nil {
+		e.FieldStart("label")
+		p.GetLabel().MarshalJX(e)
+	}
+	if len(p.GetAliases()) > 0 {
+		e.FieldStart("aliases")
+		e.ArrStart()
+		for _, v := range p.GetAliases() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if p.GetRawSize() != nil {
+		e.FieldStart("rawSize")
+		p.GetRawSize().MarshalJX(e)
+	}
+	if len(p.GetThumbnails()) > 0 {
+		e.FieldStart("thumbnails")
+		e.ArrStart()
+		for _, v := range p.GetThumbnails() {
+			v.MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	switch v := p.GetPayload().(type) {
+	case *WireEnvelope_Text:
+		e.FieldStart("text")
+		v.Text.MarshalJX(e)
+	case *WireEnvelope_Image:
+		e.FieldStart("image")
+		v.Image.MarshalJX(e)
+	case *WireEnvelope_Ping:
+		e.FieldStart("ping")
+		e.Int64(v.Ping)
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes WireEnvelope from JSON using jx.Decoder
+func (p *WireEnvelope) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "priority":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Priority = v
+		case "offsets":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int64()
+				if err != nil {
+					return err
+				}
+				p.Offsets = append(p.Offsets, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "deltas":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int32()
+				if err != nil {
+					return err
+				}
+				p.Deltas = append(p.Deltas, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "weights":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Float64()
+				if err != nil {
+					return err
+				}
+				p.Weights = append(p.Weights, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "color":
+			v, err := enumjx.Decode(d, "full.WireColor", WireColor_value)
+			if err != nil {
+				return err
+			}
+			p.Color = WireColor(v)
+		case "rawColor":
+			v, err := enumjx.Decode(d, "full.WireColor", WireColor_value)
+			if err != nil {
+				return err
+			}
+			p.RawColor = WireColor(v)
+		case "palette":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.Decode(d, "full.WireColor", WireColor_value)
+				if err != nil {
+					return err
+				}
+				p.Palette = append(p.Palette, WireColor(v))
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "counters":
+			if p.Counters == nil {
+				p.Counters = make(map[string]int64)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Int64()
+				if err != nil {
+					return err
+				}
+				p.Counters[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "attachmentsById":
+			if p.AttachmentsById == nil {
+				p.AttachmentsById = make(map[int32]*WireAttachment)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				keyInt, err := strconv.ParseInt(key, 10, 32)
+				if err != nil {
+					return err
+				}
+				v := &WireAttachment{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.AttachmentsById[int32(keyInt)] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "flags":
+			if p.Flags == nil {
+				p.Flags = make(map[bool]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				keyBool, err := strconv.ParseBool(key)
+				if err != nil {
+					return err
+				}
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Flags[keyBool] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "scalars":
+			p.Scalars = &WireScalars{}
+			if err := p.Scalars.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "cover":
+			p.Cover = &WireAttachment{}
+			if err := p.Cover.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "attachments":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &WireAttachment{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Attachments = append(p.Attachments, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "price":
+			p.Price = &common.Money{}
+			if err := p.Price.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "label":
+			p.Label = &WireLabel{}
+			if err := p.Label.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "aliases":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &WireLabel{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Aliases = append(p.Aliases, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "rawSize":
+			p.RawSize = &WireDimensions{}
+			if err := p.RawSize.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "thumbnails":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v := &WireDimensions{}
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Thumbnails = append(p.Thumbnails, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "text":
+			v := &WireText{}
+			if err := v.UnmarshalJX(d); err != nil {
+				return err
+			}
+			p.Payload = &WireEnvelope_Text{Text: v}
+		case "image":
+			v := &WireImage{}
+			if err := v.UnmarshalJX(d); err != nil {
+				return err
+			}
+			p.Payload = &WireEnvelope_Image{Image: v}
+		case "ping":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Payload = &WireEnvelope_Ping{Ping: v}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
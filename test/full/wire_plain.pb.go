@@ -0,0 +1,3214 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/wire.proto
+
+package full
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	jx "github.com/go-faster/jx"
+	enumjx "github.com/yaroher/protoc-gen-go-plain/enumjx"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	common "github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	maps "maps"
+	math "math"
+	slices "slices"
+	strconv "strconv"
+	sync "sync"
+)
+
+type WireAttachmentPlain struct {
+	Name       string `json:"name"`
+	SizeWidth  int32  `json:"sizeWidth"`  // origin: embed, empath: size.width
+	SizeHeight int32  `json:"sizeHeight"` // origin: embed, empath: size.height
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *WireAttachment) IntoPlain() *WireAttachmentPlain {
+	if pb == nil {
+		return nil
+	}
+	p := &WireAttachmentPlain{}
+
+	p.Name = pb.Name
+	// SizeWidth from size.width
+	if pb.GetSize() != nil {
+		p.SizeWidth = pb.GetSize().GetWidth()
+	}
+	// SizeHeight from size.height
+	if pb.GetSize() != nil {
+		p.SizeHeight = pb.GetSize().GetHeight()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message
+func (p *WireAttachmentPlain) IntoPb() *WireAttachment {
+	if p == nil {
+		return nil
+	}
+	pb := &WireAttachment{}
+
+	pb.Name = p.Name
+	// SizeWidth -> size.width
+	if pb.Size == nil {
+		pb.Size = &WireDimensions{}
+	}
+	pb.Size.Width = p.SizeWidth
+	// SizeHeight -> size.height
+	if pb.Size == nil {
+		pb.Size = &WireDimensions{}
+	}
+	pb.Size.Height = p.SizeHeight
+	return pb
+}
+
+// wireAttachmentPlainMergePaths are the fields of WireAttachment covered by WireAttachmentPlain
+var wireAttachmentPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2, 1},
+	{2, 2},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *WireAttachmentPlain) IntoPbMerge(dst *WireAttachment) {
+	if p == nil || dst == nil {
+		return
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(dst, src, wireAttachmentPlainMergePaths...)
+}
+
+// wireAttachmentPlainFieldPaths link the JSON names of WireAttachmentPlain to field-mask paths of WireAttachment
+var wireAttachmentPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "name", Path: "name"},
+	{Name: "sizeWidth", Path: "size.width"},
+	{Name: "sizeHeight", Path: "size.height"},
+}
+
+// WireAttachmentPlainFieldMask builds a field mask of WireAttachment from JSON names of WireAttachmentPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func WireAttachmentPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(wireAttachmentPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// WireAttachmentPlainFieldNames translates a field mask of WireAttachment into JSON names of WireAttachmentPlain.
+// A path to an embedded message yields all fields flattened from it.
+func WireAttachmentPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(wireAttachmentPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of WireAttachment, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *WireAttachmentPlain) ApplyToPb(pb *WireAttachment, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), wireAttachmentPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src := p.IntoPb()
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *WireAttachment) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*WireAttachmentPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), wireAttachmentPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &WireAttachment{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *WireAttachment) IntoPlainReuse(p *WireAttachmentPlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	p.Name = pb.Name
+	// SizeWidth from size.width
+	if pb.GetSize() != nil {
+		p.SizeWidth = pb.GetSize().GetWidth()
+	}
+	// SizeHeight from size.height
+	if pb.GetSize() != nil {
+		p.SizeHeight = pb.GetSize().GetHeight()
+	}
+}
+
+// MarshalJX encodes WireAttachmentPlain to JSON using jx.Encoder
+func (p *WireAttachmentPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Name != "" {
+		e.FieldStart("name")
+		e.Str(p.Name)
+	}
+	if p.SizeWidth != 0 {
+		e.FieldStart("sizeWidth")
+		e.Int32(p.SizeWidth)
+	}
+	if p.SizeHeight != 0 {
+		e.FieldStart("sizeHeight")
+		e.Int32(p.SizeHeight)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *WireAttachmentPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes WireAttachmentPlain from JSON using jx.Decoder
+func (p *WireAttachmentPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "name":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Name = v
+		case "sizeWidth":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.SizeWidth = v
+		case "sizeHeight":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.SizeHeight = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *WireAttachmentPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes WireAttachmentPlain in the protobuf wire format of WireAttachment
+func (p *WireAttachmentPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends WireAttachmentPlain encoded in the protobuf wire format of WireAttachment to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *WireAttachmentPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Name; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	// size (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		if v := p.SizeWidth; v != 0 {
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.SizeHeight; v != 0 {
+			b = protowire.AppendTag(b, 2, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes WireAttachmentPlain from the protobuf wire format of WireAttachment.
+// Unknown fields are skipped.
+func (p *WireAttachmentPlain) UnmarshalProto(b []byte) error {
+	*p = WireAttachmentPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Name = v
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.SizeWidth = int32(v)
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.SizeHeight = int32(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// wireAttachmentPlainReflect describes WireAttachmentPlain as message full.plain.WireAttachmentPlain
+var wireAttachmentPlainReflect = plainreflect.NewMessageInfo(file_test_full_wire_proto_plain, "WireAttachmentPlain",
+	plainreflect.Scalar(func(p *WireAttachmentPlain) *string { return &p.Name }),
+	plainreflect.Scalar(func(p *WireAttachmentPlain) *int32 { return &p.SizeWidth }),
+	plainreflect.Scalar(func(p *WireAttachmentPlain) *int32 { return &p.SizeHeight }),
+)
+
+// ProtoReflect returns the reflective view of WireAttachmentPlain backed by the struct
+func (p *WireAttachmentPlain) ProtoReflect() protoreflect.Message {
+	return wireAttachmentPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of WireAttachmentPlain
+func (p *WireAttachmentPlain) Clone() *WireAttachmentPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &WireAttachmentPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of WireAttachmentPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetWireAttachmentPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *WireAttachmentPlain) CopyTo(dst *WireAttachmentPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Name = p.Name
+	dst.SizeWidth = p.SizeWidth
+	dst.SizeHeight = p.SizeHeight
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *WireAttachmentPlain) Equal(other *WireAttachmentPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.SizeWidth != other.SizeWidth {
+		return false
+	}
+	if p.SizeHeight != other.SizeHeight {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of WireAttachmentPlain rows, in the order of ScanRow and Values
+func (p *WireAttachmentPlain) Columns() []string {
+	return []string{
+		"name",
+		"size_width",
+		"size_height",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into WireAttachmentPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *WireAttachmentPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Name,
+		&p.SizeWidth,
+		&p.SizeHeight,
+	)
+}
+
+// Values returns the column values of WireAttachmentPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *WireAttachmentPlain) Values() []any {
+	return []any{
+		p.Name,
+		p.SizeWidth,
+		p.SizeHeight,
+	}
+}
+
+// wireAttachmentPlainPool is a sync.Pool for WireAttachmentPlain objects
+var wireAttachmentPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &WireAttachmentPlain{}
+	},
+}
+
+// GetWireAttachmentPlain returns a WireAttachmentPlain from the pool
+func GetWireAttachmentPlain() *WireAttachmentPlain {
+	return wireAttachmentPlainPool.Get().(*WireAttachmentPlain)
+}
+
+// PutWireAttachmentPlain returns a WireAttachmentPlain to the pool after resetting it
+func PutWireAttachmentPlain(p *WireAttachmentPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	wireAttachmentPlainPool.Put(p)
+}
+
+// Reset clears all fields in WireAttachmentPlain for reuse
+func (p *WireAttachmentPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Name = ""
+	p.SizeWidth = 0
+	p.SizeHeight = 0
+}
+
+type WireEnvelopePlain struct {
+	Id               string                            `json:"id"`
+	Priority         int32                             `json:"priority"`
+	Offsets          []int64                           `json:"offsets"`
+	Deltas           []int32                           `json:"deltas"`
+	Weights          []float64                         `json:"weights"`
+	Tags             []string                          `json:"tags"`
+	Color            string                            `json:"color"`
+	RawColor         WireColor                         `json:"rawColor"`
+	Palette          []string                          `json:"palette"`
+	Counters         map[string]int64                  `json:"counters"`
+	AttachmentsById  map[int32]*WireAttachmentPlain    `json:"attachmentsById"`
+	Flags            map[bool]string                   `json:"flags"`
+	ScalarsFDouble   float64                           `json:"scalarsFDouble"`   // origin: embed, empath: scalars.f_double
+	ScalarsFFloat    float32                           `json:"scalarsFFloat"`    // origin: embed, empath: scalars.f_float
+	ScalarsFInt32    int32                             `json:"scalarsFInt32"`    // origin: embed, empath: scalars.f_int32
+	ScalarsFInt64    int64                             `json:"scalarsFInt64"`    // origin: embed, empath: scalars.f_int64
+	ScalarsFUint32   uint32                            `json:"scalarsFUint32"`   // origin: embed, empath: scalars.f_uint32
+	ScalarsFUint64   uint64                            `json:"scalarsFUint64"`   // origin: embed, empath: scalars.f_uint64
+	ScalarsFSint32   int32                             `json:"scalarsFSint32"`   // origin: embed, empath: scalars.f_sint32
+	ScalarsFSint64   int64                             `json:"scalarsFSint64"`   // origin: embed, empath: scalars.f_sint64
+	ScalarsFFixed32  uint32                            `json:"scalarsFFixed32"`  // origin: embed, empath: scalars.f_fixed32
+	ScalarsFFixed64  uint64                            `json:"scalarsFFixed64"`  // origin: embed, empath: scalars.f_fixed64
+	ScalarsFSfixed32 int32                             `json:"scalarsFSfixed32"` // origin: embed, empath: scalars.f_sfixed32
+	ScalarsFSfixed64 int64                             `json:"scalarsFSfixed64"` // origin: embed, empath: scalars.f_sfixed64
+	ScalarsFBool     bool                              `json:"scalarsFBool"`     // origin: embed, empath: scalars.f_bool
+	ScalarsFString   string                            `json:"scalarsFString"`   // origin: embed, empath: scalars.f_string
+	ScalarsFBytes    []byte                            `json:"scalarsFBytes"`    // origin: embed, empath: scalars.f_bytes
+	Cover            *WireAttachmentPlain              `json:"cover"`
+	Attachments      []WireAttachmentPlain             `json:"attachments"`
+	Price            *common.Money                     `json:"price"`
+	Label            string                            `json:"label"`            // origin: type_alias, empath: label
+	Aliases          []string                          `json:"aliases"`          // origin: type_alias, empath: aliases
+	RawSize          []byte                            `json:"rawSize"`          // origin: serialized, empath: raw_size
+	Thumbnails       []WireEnvelopeThumbnailsItemPlain `json:"thumbnails"`       // origin: embed, empath: thumbnails
+	PayloadTextBody  string                            `json:"payloadTextBody"`  // origin: oneof_embed, empath: payload_text.body
+	PayloadImageUrl  string                            `json:"payloadImageUrl"`  // origin: oneof_embed, empath: payload_image.url
+	PayloadImageSize *WireDimensions                   `json:"payloadImageSize"` // origin: oneof_embed, empath: payload_image.size
+	PayloadPingPing  int64                             `json:"payloadPingPing"`  // origin: oneof_embed, empath: payload_ping.ping
+	// PayloadCase indicates which variant of payload oneof is set
+	PayloadCase WireEnvelopePayloadCase `json:"payload_case,omitempty"`
+}
+
+// WireEnvelopePayloadCase identifies the set variant of full.WireEnvelope.payload oneof, empty when none is set
+type WireEnvelopePayloadCase string
+
+const (
+	WireEnvelopePayloadCaseText  WireEnvelopePayloadCase = "text"
+	WireEnvelopePayloadCaseImage WireEnvelopePayloadCase = "image"
+	WireEnvelopePayloadCasePing  WireEnvelopePayloadCase = "ping"
+)
+
+// Valid reports whether c is one of the WireEnvelopePayloadCase variants
+func (c WireEnvelopePayloadCase) Valid() bool {
+	switch c {
+	case WireEnvelopePayloadCaseText, WireEnvelopePayloadCaseImage, WireEnvelopePayloadCasePing:
+		return true
+	}
+	return false
+}
+
+// String returns the proto name of the variant
+func (c WireEnvelopePayloadCase) String() string {
+	return string(c)
+}
+
+// IntoPlain converts protobuf message to plain struct
+func (pb *WireEnvelope) IntoPlain() *WireEnvelopePlain {
+	if pb == nil {
+		return nil
+	}
+	p := &WireEnvelopePlain{}
+
+	// Detect payload oneof case
+	switch pb.Payload.(type) {
+	case *WireEnvelope_Text:
+		p.PayloadCase = WireEnvelopePayloadCaseText
+	case *WireEnvelope_Image:
+		p.PayloadCase = WireEnvelopePayloadCaseImage
+	case *WireEnvelope_Ping:
+		p.PayloadCase = WireEnvelopePayloadCasePing
+	}
+
+	p.Id = pb.Id
+	p.Priority = pb.Priority
+	if len(pb.Offsets) > 0 {
+		p.Offsets = pb.Offsets
+	} else {
+		p.Offsets = []int64{}
+	}
+	if len(pb.Deltas) > 0 {
+		p.Deltas = pb.Deltas
+	} else {
+		p.Deltas = []int32{}
+	}
+	if len(pb.Weights) > 0 {
+		p.Weights = pb.Weights
+	} else {
+		p.Weights = []float64{}
+	}
+	if len(pb.Tags) > 0 {
+		p.Tags = pb.Tags
+	} else {
+		p.Tags = []string{}
+	}
+	p.Color = pb.Color.String()
+	p.RawColor = pb.RawColor
+	if len(pb.Palette) > 0 {
+		p.Palette = make([]string, len(pb.Palette))
+		for i, v := range pb.Palette {
+			p.Palette[i] = v.String()
+		}
+	} else {
+		p.Palette = []string{}
+	}
+	p.Counters = pb.Counters
+	if len(pb.AttachmentsById) > 0 {
+		p.AttachmentsById = make(map[int32]*WireAttachmentPlain, len(pb.AttachmentsById))
+		for k, v := range pb.AttachmentsById {
+			if v != nil {
+				p.AttachmentsById[k] = v.IntoPlain()
+			}
+		}
+	}
+	p.Flags = pb.Flags
+	// ScalarsFDouble from scalars.f_double
+	if pb.GetScalars() != nil {
+		p.ScalarsFDouble = pb.GetScalars().GetFDouble()
+	}
+	// ScalarsFFloat from scalars.f_float
+	if pb.GetScalars() != nil {
+		p.ScalarsFFloat = pb.GetScalars().GetFFloat()
+	}
+	// ScalarsFInt32 from scalars.f_int32
+	if pb.GetScalars() != nil {
+		p.ScalarsFInt32 = pb.GetScalars().GetFInt32()
+	}
+	// ScalarsFInt64 from scalars.f_int64
+	if pb.GetScalars() != nil {
+		p.ScalarsFInt64 = pb.GetScalars().GetFInt64()
+	}
+	// ScalarsFUint32 from scalars.f_uint32
+	if pb.GetScalars() != nil {
+		p.ScalarsFUint32 = pb.GetScalars().GetFUint32()
+	}
+	// ScalarsFUint64 from scalars.f_uint64
+	if pb.GetScalars() != nil {
+		p.ScalarsFUint64 = pb.GetScalars().GetFUint64()
+	}
+	// ScalarsFSint32 from scalars.f_sint32
+	if pb.GetScalars() != nil {
+		p.ScalarsFSint32 = pb.GetScalars().GetFSint32()
+	}
+	// ScalarsFSint64 from scalars.f_sint64
+	if pb.GetScalars() != nil {
+		p.ScalarsFSint64 = pb.GetScalars().GetFSint64()
+	}
+	// ScalarsFFixed32 from scalars.f_fixed32
+	if pb.GetScalars() != nil {
+		p.ScalarsFFixed32 = pb.GetScalars().GetFFixed32()
+	}
+	// ScalarsFFixed64 from scalars.f_fixed64
+	if pb.GetScalars() != nil {
+		p.ScalarsFFixed64 = pb.GetScalars().GetFFixed64()
+	}
+	// ScalarsFSfixed32 from scalars.f_sfixed32
+	if pb.GetScalars() != nil {
+		p.ScalarsFSfixed32 = pb.GetScalars().GetFSfixed32()
+	}
+	// ScalarsFSfixed64 from scalars.f_sfixed64
+	if pb.GetScalars() != nil {
+		p.ScalarsFSfixed64 = pb.GetScalars().GetFSfixed64()
+	}
+	// ScalarsFBool from scalars.f_bool
+	if pb.GetScalars() != nil {
+		p.ScalarsFBool = pb.GetScalars().GetFBool()
+	}
+	// ScalarsFString from scalars.f_string
+	if pb.GetScalars() != nil {
+		p.ScalarsFString = pb.GetScalars().GetFString()
+	}
+	// ScalarsFBytes from scalars.f_bytes
+	if pb.GetScalars() != nil {
+		p.ScalarsFBytes = pb.GetScalars().GetFBytes()
+	}
+	if pb.Cover != nil {
+		p.Cover = pb.Cover.IntoPlain()
+	}
+	if len(pb.Attachments) > 0 {
+		p.Attachments = make([]WireAttachmentPlain, len(pb.Attachments))
+		for i, v := range pb.Attachments {
+			if v != nil {
+				p.Attachments[i] = *v.IntoPlain()
+			}
+		}
+	} else {
+		p.Attachments = []WireAttachmentPlain{}
+	}
+	p.Price = pb.Price
+	// Label type alias from label
+	if pb.GetLabel() != nil {
+		p.Label = pb.GetLabel().GetValue()
+	}
+	// Aliases type alias from aliases
+	if len(pb.GetAliases()) > 0 {
+		p.Aliases = make([]string, 0, len(pb.GetAliases()))
+		for _, _elem := range pb.GetAliases() {
+			if _elem != nil {
+				p.Aliases = append(p.Aliases, _elem.GetValue())
+			}
+		}
+	} else {
+		p.Aliases = []string{}
+	}
+	// RawSize serialized from raw_size
+	if pb.RawSize != nil {
+		if data, err := protojson.Marshal(pb.RawSize); err == nil {
+			p.RawSize = data
+		}
+	} else {
+		p.RawSize = []byte{}
+	}
+	// Thumbnails from thumbnails
+	if pb.GetThumbnails() != nil {
+		p.Thumbnails = make([]WireEnvelopeThumbnailsItemPlain, len(pb.GetThumbnails()))
+		for i, _elem := range pb.GetThumbnails() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.Thumbnails[i], _elem
+			p.Width = pb.Width
+			p.Height = pb.Height
+		}
+	}
+	// PayloadTextBody from payload_text.body
+	if pb.GetText() != nil {
+		p.PayloadTextBody = pb.GetText().GetBody()
+	}
+	// PayloadImageUrl from payload_image.url
+	if pb.GetImage() != nil {
+		p.PayloadImageUrl = pb.GetImage().GetUrl()
+	}
+	// PayloadImageSize from payload_image.size
+	if pb.GetImage() != nil && pb.GetImage().GetSize() != nil {
+		p.PayloadImageSize = pb.GetImage().GetSize()
+	}
+	// PayloadPingPing from payload_ping.ping
+	if pb != nil {
+		p.PayloadPingPing = pb.GetPing()
+	}
+	return p
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails or an enum name is unknown, use IntoPbE to handle the error.
+func (p *WireEnvelopePlain) IntoPb() *WireEnvelope {
+	pb, err := p.IntoPbE()
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError wrapping *enumjx.UnknownValueError for unknown enum names.
+func (p *WireEnvelopePlain) IntoPbE() (*WireEnvelope, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &WireEnvelope{}
+	var _err error
+
+	pb.Id = p.Id
+	pb.Priority = p.Priority
+	pb.Offsets = p.Offsets
+	pb.Deltas = p.Deltas
+	pb.Weights = p.Weights
+	pb.Tags = p.Tags
+	pb.Color = enumjx.TryName[WireColor](p.Color, "full.WireColor", WireColor_value, "color", &_err)
+	pb.RawColor = p.RawColor
+	if len(p.Palette) > 0 {
+		pb.Palette = make([]WireColor, len(p.Palette))
+		for i, v := range p.Palette {
+			pb.Palette[i] = enumjx.TryName[WireColor](v, "full.WireColor", WireColor_value, "palette", &_err)
+		}
+	}
+	pb.Counters = p.Counters
+	if len(p.AttachmentsById) > 0 {
+		pb.AttachmentsById = make(map[int32]*WireAttachment, len(p.AttachmentsById))
+		for k, v := range p.AttachmentsById {
+			if v != nil {
+				pb.AttachmentsById[k] = v.IntoPb()
+			}
+		}
+	}
+	pb.Flags = p.Flags
+	// ScalarsFDouble -> scalars.f_double
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FDouble = p.ScalarsFDouble
+	// ScalarsFFloat -> scalars.f_float
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FFloat = p.ScalarsFFloat
+	// ScalarsFInt32 -> scalars.f_int32
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FInt32 = p.ScalarsFInt32
+	// ScalarsFInt64 -> scalars.f_int64
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FInt64 = p.ScalarsFInt64
+	// ScalarsFUint32 -> scalars.f_uint32
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FUint32 = p.ScalarsFUint32
+	// ScalarsFUint64 -> scalars.f_uint64
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FUint64 = p.ScalarsFUint64
+	// ScalarsFSint32 -> scalars.f_sint32
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FSint32 = p.ScalarsFSint32
+	// ScalarsFSint64 -> scalars.f_sint64
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FSint64 = p.ScalarsFSint64
+	// ScalarsFFixed32 -> scalars.f_fixed32
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FFixed32 = p.ScalarsFFixed32
+	// ScalarsFFixed64 -> scalars.f_fixed64
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FFixed64 = p.ScalarsFFixed64
+	// ScalarsFSfixed32 -> scalars.f_sfixed32
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FSfixed32 = p.ScalarsFSfixed32
+	// ScalarsFSfixed64 -> scalars.f_sfixed64
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FSfixed64 = p.ScalarsFSfixed64
+	// ScalarsFBool -> scalars.f_bool
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FBool = p.ScalarsFBool
+	// ScalarsFString -> scalars.f_string
+	if p.ScalarsFString != "" {
+		if pb.Scalars == nil {
+			pb.Scalars = &WireScalars{}
+		}
+		pb.Scalars.FString = p.ScalarsFString
+	}
+	// ScalarsFBytes -> scalars.f_bytes
+	if pb.Scalars == nil {
+		pb.Scalars = &WireScalars{}
+	}
+	pb.Scalars.FBytes = p.ScalarsFBytes
+	if p.Cover != nil {
+		pb.Cover = p.Cover.IntoPb()
+	}
+	if len(p.Attachments) > 0 {
+		pb.Attachments = make([]*WireAttachment, len(p.Attachments))
+		for i := range p.Attachments {
+			pb.Attachments[i] = (&p.Attachments[i]).IntoPb()
+		}
+	}
+	pb.Price = p.Price
+	// Label type alias -> label
+	if p.Label != "" {
+		pb.Label = &WireLabel{Value: p.Label}
+	}
+	// Aliases type alias -> aliases
+	if len(p.Aliases) > 0 {
+		pb.Aliases = make([]*WireLabel, len(p.Aliases))
+		for i, v := range p.Aliases {
+			pb.Aliases[i] = &WireLabel{Value: v}
+		}
+	}
+	// RawSize deserialize -> raw_size
+	if len(p.RawSize) > 0 {
+		var msg WireDimensions
+		if err := protojson.Unmarshal(p.RawSize, &msg); err == nil {
+			pb.RawSize = &msg
+		}
+	}
+	// Thumbnails -> thumbnails
+	if len(p.Thumbnails) > 0 {
+		_items := make([]*WireDimensions, len(p.Thumbnails))
+		for i := range p.Thumbnails {
+			_items[i] = &WireDimensions{}
+			p, pb := &p.Thumbnails[i], _items[i]
+			pb.Width = p.Width
+			pb.Height = p.Height
+		}
+		pb.Thumbnails = _items
+	}
+	// PayloadTextBody -> payload_text.body
+	if p.PayloadCase == WireEnvelopePayloadCaseText {
+		if _, ok := pb.Payload.(*WireEnvelope_Text); !ok || pb.Payload == nil {
+			pb.Payload = &WireEnvelope_Text{Text: &WireText{}}
+		}
+		pb.Payload.(*WireEnvelope_Text).Text.Body = p.PayloadTextBody
+	}
+	// PayloadImageUrl -> payload_image.url
+	if p.PayloadCase == WireEnvelopePayloadCaseImage {
+		if _, ok := pb.Payload.(*WireEnvelope_Image); !ok || pb.Payload == nil {
+			pb.Payload = &WireEnvelope_Image{Image: &WireImage{}}
+		}
+		pb.Payload.(*WireEnvelope_Image).Image.Url = p.PayloadImageUrl
+	}
+	// PayloadImageSize -> payload_image.size
+	if p.PayloadImageSize != nil && p.PayloadCase == WireEnvelopePayloadCaseImage {
+		if _, ok := pb.Payload.(*WireEnvelope_Image); !ok || pb.Payload == nil {
+			pb.Payload = &WireEnvelope_Image{Image: &WireImage{}}
+		}
+		pb.Payload.(*WireEnvelope_Image).Image.Size = p.PayloadImageSize
+	}
+	// PayloadPingPing -> payload_ping.ping
+	if p.PayloadCase == WireEnvelopePayloadCasePing {
+		pb.Payload = &WireEnvelope_Ping{Ping: p.PayloadPingPing}
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// wireEnvelopePlainMergePaths are the fields of WireEnvelope covered by WireEnvelopePlain
+var wireEnvelopePlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{7},
+	{8},
+	{9},
+	{10},
+	{11},
+	{12},
+	{13, 1},
+	{13, 2},
+	{13, 3},
+	{13, 4},
+	{13, 5},
+	{13, 6},
+	{13, 7},
+	{13, 8},
+	{13, 9},
+	{13, 10},
+	{13, 11},
+	{13, 12},
+	{13, 13},
+	{13, 14},
+	{13, 15},
+	{14},
+	{15},
+	{16},
+	{17},
+	{18},
+	{19},
+	{23, 1},
+	{23, 2},
+	{20},
+	{21},
+	{22},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *WireEnvelopePlain) IntoPbMerge(dst *WireEnvelope) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, wireEnvelopePlainMergePaths...)
+	return nil
+}
+
+// wireEnvelopePlainFieldPaths link the JSON names of WireEnvelopePlain to field-mask paths of WireEnvelope
+var wireEnvelopePlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "priority", Path: "priority"},
+	{Name: "offsets", Path: "offsets"},
+	{Name: "deltas", Path: "deltas"},
+	{Name: "weights", Path: "weights"},
+	{Name: "tags", Path: "tags"},
+	{Name: "color", Path: "color"},
+	{Name: "rawColor", Path: "raw_color"},
+	{Name: "palette", Path: "palette"},
+	{Name: "counters", Path: "counters"},
+	{Name: "attachmentsById", Path: "attachments_by_id"},
+	{Name: "flags", Path: "flags"},
+	{Name: "scalarsFDouble", Path: "scalars.f_double"},
+	{Name: "scalarsFFloat", Path: "scalars.f_float"},
+	{Name: "scalarsFInt32", Path: "scalars.f_int32"},
+	{Name: "scalarsFInt64", Path: "scalars.f_int64"},
+	{Name: "scalarsFUint32", Path: "scalars.f_uint32"},
+	{Name: "scalarsFUint64", Path: "scalars.f_uint64"},
+	{Name: "scalarsFSint32", Path: "scalars.f_sint32"},
+	{Name: "scalarsFSint64", Path: "scalars.f_sint64"},
+	{Name: "scalarsFFixed32", Path: "scalars.f_fixed32"},
+	{Name: "scalarsFFixed64", Path: "scalars.f_fixed64"},
+	{Name: "scalarsFSfixed32", Path: "scalars.f_sfixed32"},
+	{Name: "scalarsFSfixed64", Path: "scalars.f_sfixed64"},
+	{Name: "scalarsFBool", Path: "scalars.f_bool"},
+	{Name: "scalarsFString", Path: "scalars.f_string"},
+	{Name: "scalarsFBytes", Path: "scalars.f_bytes"},
+	{Name: "cover", Path: "cover"},
+	{Name: "attachments", Path: "attachments"},
+	{Name: "price", Path: "price"},
+	{Name: "label", Path: "label"},
+	{Name: "aliases", Path: "aliases"},
+	{Name: "rawSize", Path: "raw_size"},
+	{Name: "thumbnails", Path: "thumbnails"},
+	{Name: "payloadTextBody", Path: "text.body"},
+	{Name: "payloadImageUrl", Path: "image.url"},
+	{Name: "payloadImageSize", Path: "image.size"},
+	{Name: "payloadPingPing", Path: "ping"},
+}
+
+// WireEnvelopePlainFieldMask builds a field mask of WireEnvelope from JSON names of WireEnvelopePlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func WireEnvelopePlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(wireEnvelopePlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// WireEnvelopePlainFieldNames translates a field mask of WireEnvelope into JSON names of WireEnvelopePlain.
+// A path to an embedded message yields all fields flattened from it.
+func WireEnvelopePlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(wireEnvelopePlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of WireEnvelope, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *WireEnvelopePlain) ApplyToPb(pb *WireEnvelope, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), wireEnvelopePlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *WireEnvelope) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*WireEnvelopePlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), wireEnvelopePlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &WireEnvelope{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlain(), nil
+}
+
+// IntoPlainReuse converts protobuf message to existing plain struct (for pool usage)
+func (pb *WireEnvelope) IntoPlainReuse(p *WireEnvelopePlain) {
+	if pb == nil || p == nil {
+		return
+	}
+	// Reset before filling
+	p.Reset()
+
+	// Detect payload oneof case
+	switch pb.Payload.(type) {
+	case *WireEnvelope_Text:
+		p.PayloadCase = WireEnvelopePayloadCaseText
+	case *WireEnvelope_Image:
+		p.PayloadCase = WireEnvelopePayloadCaseImage
+	case *WireEnvelope_Ping:
+		p.PayloadCase = WireEnvelopePayloadCasePing
+	}
+
+	p.Id = pb.Id
+	p.Priority = pb.Priority
+	if len(pb.Offsets) > 0 {
+		p.Offsets = pb.Offsets
+	} else {
+		p.Offsets = []int64{}
+	}
+	if len(pb.Deltas) > 0 {
+		p.Deltas = pb.Deltas
+	} else {
+		p.Deltas = []int32{}
+	}
+	if len(pb.Weights) > 0 {
+		p.Weights = pb.Weights
+	} else {
+		p.Weights = []float64{}
+	}
+	if len(pb.Tags) > 0 {
+		p.Tags = pb.Tags
+	} else {
+		p.Tags = []string{}
+	}
+	p.Color = pb.Color.String()
+	p.RawColor = pb.RawColor
+	if len(pb.Palette) > 0 {
+		p.Palette = make([]string, len(pb.Palette))
+		for i, v := range pb.Palette {
+			p.Palette[i] = v.String()
+		}
+	} else {
+		p.Palette = []string{}
+	}
+	p.Counters = pb.Counters
+	if len(pb.AttachmentsById) > 0 {
+		p.AttachmentsById = make(map[int32]*WireAttachmentPlain, len(pb.AttachmentsById))
+		for k, v := range pb.AttachmentsById {
+			if v != nil {
+				p.AttachmentsById[k] = v.IntoPlain()
+			}
+		}
+	}
+	p.Flags = pb.Flags
+	// ScalarsFDouble from scalars.f_double
+	if pb.GetScalars() != nil {
+		p.ScalarsFDouble = pb.GetScalars().GetFDouble()
+	}
+	// ScalarsFFloat from scalars.f_float
+	if pb.GetScalars() != nil {
+		p.ScalarsFFloat = pb.GetScalars().GetFFloat()
+	}
+	// ScalarsFInt32 from scalars.f_int32
+	if pb.GetScalars() != nil {
+		p.ScalarsFInt32 = pb.GetScalars().GetFInt32()
+	}
+	// ScalarsFInt64 from scalars.f_int64
+	if pb.GetScalars() != nil {
+		p.ScalarsFInt64 = pb.GetScalars().GetFInt64()
+	}
+	// ScalarsFUint32 from scalars.f_uint32
+	if pb.GetScalars() != nil {
+		p.ScalarsFUint32 = pb.GetScalars().GetFUint32()
+	}
+	// ScalarsFUint64 from scalars.f_uint64
+	if pb.GetScalars() != nil {
+		p.ScalarsFUint64 = pb.GetScalars().GetFUint64()
+	}
+	// ScalarsFSint32 from scalars.f_sint32
+	if pb.GetScalars() != nil {
+		p.ScalarsFSint32 = pb.GetScalars().GetFSint32()
+	}
+	// ScalarsFSint64 from scalars.f_sint64
+	if pb.GetScalars() != nil {
+		p.ScalarsFSint64 = pb.GetScalars().GetFSint64()
+	}
+	// ScalarsFFixed32 from scalars.f_fixed32
+	if pb.GetScalars() != nil {
+		p.ScalarsFFixed32 = pb.GetScalars().GetFFixed32()
+	}
+	// ScalarsFFixed64 from scalars.f_fixed64
+	if pb.GetScalars() != nil {
+		p.ScalarsFFixed64 = pb.GetScalars().GetFFixed64()
+	}
+	// ScalarsFSfixed32 from scalars.f_sfixed32
+	if pb.GetScalars() != nil {
+		p.ScalarsFSfixed32 = pb.GetScalars().GetFSfixed32()
+	}
+	// ScalarsFSfixed64 from scalars.f_sfixed64
+	if pb.GetScalars() != nil {
+		p.ScalarsFSfixed64 = pb.GetScalars().GetFSfixed64()
+	}
+	// ScalarsFBool from scalars.f_bool
+	if pb.GetScalars() != nil {
+		p.ScalarsFBool = pb.GetScalars().GetFBool()
+	}
+	// ScalarsFString from scalars.f_string
+	if pb.GetScalars() != nil {
+		p.ScalarsFString = pb.GetScalars().GetFString()
+	}
+	// ScalarsFBytes from scalars.f_bytes
+	if pb.GetScalars() != nil {
+		p.ScalarsFBytes = pb.GetScalars().GetFBytes()
+	}
+	if pb.Cover != nil {
+		p.Cover = pb.Cover.IntoPlain()
+	}
+	if len(pb.Attachments) > 0 {
+		p.Attachments = make([]WireAttachmentPlain, len(pb.Attachments))
+		for i, v := range pb.Attachments {
+			if v != nil {
+				p.Attachments[i] = *v.IntoPlain()
+			}
+		}
+	} else {
+		p.Attachments = []WireAttachmentPlain{}
+	}
+	p.Price = pb.Price
+	// Label type alias from label
+	if pb.GetLabel() != nil {
+		p.Label = pb.GetLabel().GetValue()
+	}
+	// Aliases type alias from aliases
+	if len(pb.GetAliases()) > 0 {
+		p.Aliases = make([]string, 0, len(pb.GetAliases()))
+		for _, _elem := range pb.GetAliases() {
+			if _elem != nil {
+				p.Aliases = append(p.Aliases, _elem.GetValue())
+			}
+		}
+	} else {
+		p.Aliases = []string{}
+	}
+	// RawSize serialized from raw_size
+	if pb.RawSize != nil {
+		if data, err := protojson.Marshal(pb.RawSize); err == nil {
+			p.RawSize = data
+		}
+	} else {
+		p.RawSize = []byte{}
+	}
+	// Thumbnails from thumbnails
+	if pb.GetThumbnails() != nil {
+		p.Thumbnails = make([]WireEnvelopeThumbnailsItemPlain, len(pb.GetThumbnails()))
+		for i, _elem := range pb.GetThumbnails() {
+			if _elem == nil {
+				continue
+			}
+			p, pb := &p.Thumbnails[i], _elem
+			p.Width = pb.Width
+			p.Height = pb.Height
+		}
+	}
+	// PayloadTextBody from payload_text.body
+	if pb.GetText() != nil {
+		p.PayloadTextBody = pb.GetText().GetBody()
+	}
+	// PayloadImageUrl from payload_image.url
+	if pb.GetImage() != nil {
+		p.PayloadImageUrl = pb.GetImage().GetUrl()
+	}
+	// PayloadImageSize from payload_image.size
+	if pb.GetImage() != nil && pb.GetImage().GetSize() != nil {
+		p.PayloadImageSize = pb.GetImage().GetSize()
+	}
+	// PayloadPingPing from payload_ping.ping
+	if pb != nil {
+		p.PayloadPingPing = pb.GetPing()
+	}
+}
+
+// MarshalJX encodes WireEnvelopePlain to JSON using jx.Encoder
+func (p *WireEnvelopePlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.PayloadCase != "" {
+		e.FieldStart("payload_case")
+		e.Str(p.PayloadCase.String())
+	}
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	if p.Priority != 0 {
+		e.FieldStart("priority")
+		e.Int32(p.Priority)
+	}
+	if len(p.Offsets) > 0 {
+		e.FieldStart("offsets")
+		e.ArrStart()
+		for _, v := range p.Offsets {
+			e.Int64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.Deltas) > 0 {
+		e.FieldStart("deltas")
+		e.ArrStart()
+		for _, v := range p.Deltas {
+			e.Int32(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.Weights) > 0 {
+		e.FieldStart("weights")
+		e.ArrStart()
+		for _, v := range p.Weights {
+			e.Float64(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.Tags) > 0 {
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, v := range p.Tags {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if p.Color != "" {
+		e.FieldStart("color")
+		e.Str(p.Color)
+	}
+	if p.RawColor != 0 {
+		e.FieldStart("rawColor")
+		e.Int32(int32(p.RawColor))
+	}
+	if len(p.Palette) > 0 {
+		e.FieldStart("palette")
+		e.ArrStart()
+		for _, v := range p.Palette {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	e.FieldStart("counters")
+	e.ObjStart()
+	for k, v := range p.Counters {
+		e.FieldStart(k)
+		e.Int64(v)
+	}
+	e.ObjEnd()
+	e.FieldStart("attachmentsById")
+	e.ObjStart()
+	for k, v := range p.AttachmentsById {
+		e.FieldStart(fmt.Sprint(k))
+		v.MarshalJX(e)
+	}
+	e.ObjEnd()
+	e.FieldStart("flags")
+	e.ObjStart()
+	for k, v := range p.Flags {
+		e.FieldStart(fmt.Sprint(k))
+		e.Str(v)
+	}
+	e.ObjEnd()
+	if p.ScalarsFDouble != 0 {
+		e.FieldStart("scalarsFDouble")
+		e.Float64(p.ScalarsFDouble)
+	}
+	if p.ScalarsFFloat != 0 {
+		e.FieldStart("scalarsFFloat")
+		e.Float32(p.ScalarsFFloat)
+	}
+	if p.ScalarsFInt32 != 0 {
+		e.FieldStart("scalarsFInt32")
+		e.Int32(p.ScalarsFInt32)
+	}
+	if p.ScalarsFInt64 != 0 {
+		e.FieldStart("scalarsFInt64")
+		e.Int64(p.ScalarsFInt64)
+	}
+	if p.ScalarsFUint32 != 0 {
+		e.FieldStart("scalarsFUint32")
+		e.UInt32(p.ScalarsFUint32)
+	}
+	if p.ScalarsFUint64 != 0 {
+		e.FieldStart("scalarsFUint64")
+		e.UInt64(p.ScalarsFUint64)
+	}
+	if p.ScalarsFSint32 != 0 {
+		e.FieldStart("scalarsFSint32")
+		e.Int32(p.ScalarsFSint32)
+	}
+	if p.ScalarsFSint64 != 0 {
+		e.FieldStart("scalarsFSint64")
+		e.Int64(p.ScalarsFSint64)
+	}
+	if p.ScalarsFFixed32 != 0 {
+		e.FieldStart("scalarsFFixed32")
+		e.UInt32(p.ScalarsFFixed32)
+	}
+	if p.ScalarsFFixed64 != 0 {
+		e.FieldStart("scalarsFFixed64")
+		e.UInt64(p.ScalarsFFixed64)
+	}
+	if p.ScalarsFSfixed32 != 0 {
+		e.FieldStart("scalarsFSfixed32")
+		e.Int32(p.ScalarsFSfixed32)
+	}
+	if p.ScalarsFSfixed64 != 0 {
+		e.FieldStart("scalarsFSfixed64")
+		e.Int64(p.ScalarsFSfixed64)
+	}
+	if p.ScalarsFBool {
+		e.FieldStart("scalarsFBool")
+		e.Bool(p.ScalarsFBool)
+	}
+	if p.ScalarsFString != "" {
+		e.FieldStart("scalarsFString")
+		e.Str(p.ScalarsFString)
+	}
+	if len(p.ScalarsFBytes) > 0 {
+		e.FieldStart("scalarsFBytes")
+		e.Base64(p.ScalarsFBytes)
+	}
+	if p.Cover != nil {
+		e.FieldStart("cover")
+		p.Cover.MarshalJX(e)
+	}
+	if len(p.Attachments) > 0 {
+		e.FieldStart("attachments")
+		e.ArrStart()
+		for _, v := range p.Attachments {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if p.Price != nil {
+		e.FieldStart("price")
+		p.Price.MarshalJX(e)
+	}
+	if p.Label != "" {
+		e.FieldStart("label")
+		e.Str(p.Label)
+	}
+	if len(p.Aliases) > 0 {
+		e.FieldStart("aliases")
+		e.ArrStart()
+		for _, v := range p.Aliases {
+			e.Str(v)
+		}
+		e.ArrEnd()
+	}
+	if len(p.RawSize) > 0 {
+		e.FieldStart("rawSize")
+		e.Base64(p.RawSize)
+	}
+	if len(p.Thumbnails) > 0 {
+		e.FieldStart("thumbnails")
+		e.ArrStart()
+		for _, v := range p.Thumbnails {
+			(&v).MarshalJX(e)
+		}
+		e.ArrEnd()
+	}
+	if p.PayloadTextBody != "" {
+		e.FieldStart("payloadTextBody")
+		e.Str(p.PayloadTextBody)
+	}
+	if p.PayloadImageUrl != "" {
+		e.FieldStart("payloadImageUrl")
+		e.Str(p.PayloadImageUrl)
+	}
+	if p.PayloadImageSize != nil {
+		e.FieldStart("payloadImageSize")
+		p.PayloadImageSize.MarshalJX(e)
+	}
+	if p.PayloadPingPing != 0 {
+		e.FieldStart("payloadPingPing")
+		e.Int64(p.PayloadPingPing)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *WireEnvelopePlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes WireEnvelopePlain from JSON using jx.Decoder
+func (p *WireEnvelopePlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "payload_case":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.PayloadCase = WireEnvelopePayloadCase(v)
+			if v != "" && !p.PayloadCase.Valid() {
+				return fmt.Errorf("unknown variant %q of oneof full.WireEnvelope.payload", v)
+			}
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "priority":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Priority = v
+		case "offsets":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int64()
+				if err != nil {
+					return err
+				}
+				p.Offsets = append(p.Offsets, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "deltas":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Int32()
+				if err != nil {
+					return err
+				}
+				p.Deltas = append(p.Deltas, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "weights":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Float64()
+				if err != nil {
+					return err
+				}
+				p.Weights = append(p.Weights, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "tags":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Tags = append(p.Tags, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "color":
+			v, err := enumjx.DecodeName(d, "full.WireColor", WireColor_value, WireColor_name)
+			if err != nil {
+				return err
+			}
+			p.Color = v
+		case "rawColor":
+			v, err := enumjx.Decode(d, "full.WireColor", WireColor_value)
+			if err != nil {
+				return err
+			}
+			p.RawColor = WireColor(v)
+		case "palette":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := enumjx.DecodeName(d, "full.WireColor", WireColor_value, WireColor_name)
+				if err != nil {
+					return err
+				}
+				p.Palette = append(p.Palette, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "counters":
+			if p.Counters == nil {
+				p.Counters = make(map[string]int64)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				v, err := d.Int64()
+				if err != nil {
+					return err
+				}
+				p.Counters[key] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "attachmentsById":
+			if p.AttachmentsById == nil {
+				p.AttachmentsById = make(map[int32]*WireAttachmentPlain)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_k, err := strconv.ParseInt(key, 10, 32)
+				if err != nil {
+					return err
+				}
+				_mapKey := int32(_k)
+				p.AttachmentsById[_mapKey] = &WireAttachmentPlain{}
+				if err := p.AttachmentsById[_mapKey].UnmarshalJX(d); err != nil {
+					return err
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "flags":
+			if p.Flags == nil {
+				p.Flags = make(map[bool]string)
+			}
+			if err := d.Obj(func(d *jx.Decoder, key string) error {
+				_mapKey, err := strconv.ParseBool(key)
+				if err != nil {
+					return err
+				}
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Flags[_mapKey] = v
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "scalarsFDouble":
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFDouble = v
+		case "scalarsFFloat":
+			v, err := d.Float32()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFFloat = v
+		case "scalarsFInt32":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFInt32 = v
+		case "scalarsFInt64":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFInt64 = v
+		case "scalarsFUint32":
+			v, err := d.UInt32()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFUint32 = v
+		case "scalarsFUint64":
+			v, err := d.UInt64()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFUint64 = v
+		case "scalarsFSint32":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFSint32 = v
+		case "scalarsFSint64":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFSint64 = v
+		case "scalarsFFixed32":
+			v, err := d.UInt32()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFFixed32 = v
+		case "scalarsFFixed64":
+			v, err := d.UInt64()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFFixed64 = v
+		case "scalarsFSfixed32":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFSfixed32 = v
+		case "scalarsFSfixed64":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFSfixed64 = v
+		case "scalarsFBool":
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFBool = v
+		case "scalarsFString":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFString = v
+		case "scalarsFBytes":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.ScalarsFBytes = v
+		case "cover":
+			p.Cover = &WireAttachmentPlain{}
+			if err := p.Cover.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "attachments":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v WireAttachmentPlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Attachments = append(p.Attachments, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "price":
+			p.Price = &common.Money{}
+			if err := p.Price.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "label":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Label = v
+		case "aliases":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				v, err := d.Str()
+				if err != nil {
+					return err
+				}
+				p.Aliases = append(p.Aliases, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "rawSize":
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.RawSize = v
+		case "thumbnails":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var v WireEnvelopeThumbnailsItemPlain
+				if err := v.UnmarshalJX(d); err != nil {
+					return err
+				}
+				p.Thumbnails = append(p.Thumbnails, v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		case "payloadTextBody":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.PayloadTextBody = v
+		case "payloadImageUrl":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.PayloadImageUrl = v
+		case "payloadImageSize":
+			p.PayloadImageSize = &WireDimensions{}
+			if err := p.PayloadImageSize.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "payloadPingPing":
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.PayloadPingPing = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *WireEnvelopePlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes WireEnvelopePlain in the protobuf wire format of WireEnvelope
+func (p *WireEnvelopePlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends WireEnvelopePlain encoded in the protobuf wire format of WireEnvelope to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *WireEnvelopePlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := p.Priority; v != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if len(p.Offsets) > 0 {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.Offsets {
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if len(p.Deltas) > 0 {
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.Deltas {
+			b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(v)))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if len(p.Weights) > 0 {
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.Weights {
+			b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, v := range p.Tags {
+		b = protowire.AppendTag(b, 6, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if v := enumjx.TryName[WireColor](p.Color, "full.WireColor", WireColor_value, "color", &_err); v != 0 {
+		b = protowire.AppendTag(b, 7, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.RawColor; v != 0 {
+		b = protowire.AppendTag(b, 8, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if len(p.Palette) > 0 {
+		b = protowire.AppendTag(b, 9, protowire.BytesType)
+		_start := len(b)
+		for _, v := range p.Palette {
+			b = protowire.AppendVarint(b, uint64(enumjx.TryName[WireColor](v, "full.WireColor", WireColor_value, "palette", &_err)))
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.Counters) {
+		v := p.Counters[k]
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, k)
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedKeys(p.AttachmentsById) {
+		v := p.AttachmentsById[k]
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_value := len(b)
+		if b, err = v.AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _value)
+		b = plainwire.FinishLen(b, _start)
+	}
+	for _, k := range plainwire.SortedBoolKeys(p.Flags) {
+		v := p.Flags[k]
+		b = protowire.AppendTag(b, 12, protowire.BytesType)
+		_start := len(b)
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(k))
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+		b = plainwire.FinishLen(b, _start)
+	}
+	// scalars (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 13, protowire.BytesType)
+		_start := len(b)
+		if v := p.ScalarsFDouble; v != 0 || math.Signbit(float64(v)) {
+			b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+			b = protowire.AppendFixed64(b, math.Float64bits(float64(v)))
+		}
+		if v := p.ScalarsFFloat; v != 0 || math.Signbit(float64(v)) {
+			b = protowire.AppendTag(b, 2, protowire.Fixed32Type)
+			b = protowire.AppendFixed32(b, math.Float32bits(float32(v)))
+		}
+		if v := p.ScalarsFInt32; v != 0 {
+			b = protowire.AppendTag(b, 3, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.ScalarsFInt64; v != 0 {
+			b = protowire.AppendTag(b, 4, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.ScalarsFUint32; v != 0 {
+			b = protowire.AppendTag(b, 5, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.ScalarsFUint64; v != 0 {
+			b = protowire.AppendTag(b, 6, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v))
+		}
+		if v := p.ScalarsFSint32; v != 0 {
+			b = protowire.AppendTag(b, 7, protowire.VarintType)
+			b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(v)))
+		}
+		if v := p.ScalarsFSint64; v != 0 {
+			b = protowire.AppendTag(b, 8, protowire.VarintType)
+			b = protowire.AppendVarint(b, protowire.EncodeZigZag(int64(v)))
+		}
+		if v := p.ScalarsFFixed32; v != 0 {
+			b = protowire.AppendTag(b, 9, protowire.Fixed32Type)
+			b = protowire.AppendFixed32(b, uint32(v))
+		}
+		if v := p.ScalarsFFixed64; v != 0 {
+			b = protowire.AppendTag(b, 10, protowire.Fixed64Type)
+			b = protowire.AppendFixed64(b, uint64(v))
+		}
+		if v := p.ScalarsFSfixed32; v != 0 {
+			b = protowire.AppendTag(b, 11, protowire.Fixed32Type)
+			b = protowire.AppendFixed32(b, uint32(v))
+		}
+		if v := p.ScalarsFSfixed64; v != 0 {
+			b = protowire.AppendTag(b, 12, protowire.Fixed64Type)
+			b = protowire.AppendFixed64(b, uint64(v))
+		}
+		if v := p.ScalarsFBool; v {
+			b = protowire.AppendTag(b, 13, protowire.VarintType)
+			b = protowire.AppendVarint(b, protowire.EncodeBool(v))
+		}
+		if v := p.ScalarsFString; v != "" {
+			b = protowire.AppendTag(b, 14, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if v := p.ScalarsFBytes; len(v) > 0 {
+			b = protowire.AppendTag(b, 15, protowire.BytesType)
+			b = protowire.AppendBytes(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	if p.Cover != nil {
+		b = protowire.AppendTag(b, 14, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Cover.AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for i := range p.Attachments {
+		b = protowire.AppendTag(b, 15, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Attachments[i].AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Price != nil {
+		b = protowire.AppendTag(b, 16, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.Price); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 17, protowire.BytesType)
+		_start := len(b)
+		if v := p.Label; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	for _, v := range p.Aliases {
+		b = protowire.AppendTag(b, 18, protowire.BytesType)
+		_start := len(b)
+		if v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if len(p.RawSize) > 0 {
+		m := &WireDimensions{}
+		if err := protojson.Unmarshal(p.RawSize, m); err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, 19, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	for i := range p.Thumbnails {
+		b = protowire.AppendTag(b, 23, protowire.BytesType)
+		_start := len(b)
+		if b, err = p.Thumbnails[i].AppendProto(b); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	// text (embedded)
+	if p.PayloadCase == WireEnvelopePayloadCaseText {
+		b = protowire.AppendTag(b, 20, protowire.BytesType)
+		_start := len(b)
+		if v := p.PayloadTextBody; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	// image (embedded)
+	if p.PayloadCase == WireEnvelopePayloadCaseImage {
+		b = protowire.AppendTag(b, 21, protowire.BytesType)
+		_start := len(b)
+		if v := p.PayloadImageUrl; v != "" {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			b = protowire.AppendString(b, v)
+		}
+		if p.PayloadImageSize != nil {
+			b = protowire.AppendTag(b, 2, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, p.PayloadImageSize); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.PayloadCase == WireEnvelopePayloadCasePing {
+		b = protowire.AppendTag(b, 22, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(p.PayloadPingPing))
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes WireEnvelopePlain from the protobuf wire format of WireEnvelope.
+// Unknown fields are skipped.
+func (p *WireEnvelopePlain) UnmarshalProto(b []byte) error {
+	*p = WireEnvelopePlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Priority = int32(v)
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.Offsets = append(p.Offsets, int64(x))
+			}
+		case num == 3 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Offsets = append(p.Offsets, int64(v))
+		case num == 4 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.Deltas = append(p.Deltas, int32(protowire.DecodeZigZag(x&math.MaxUint32)))
+			}
+		case num == 4 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Deltas = append(p.Deltas, int32(protowire.DecodeZigZag(v&math.MaxUint32)))
+		case num == 5 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeFixed64(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.Weights = append(p.Weights, math.Float64frombits(x))
+			}
+		case num == 5 && typ == protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(b)
+			p.Weights = append(p.Weights, math.Float64frombits(v))
+		case num == 6 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Tags = append(p.Tags, v)
+		case num == 7 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Color = WireColor(v).String()
+		case num == 8 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.RawColor = WireColor(v)
+		case num == 9 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for len(v) > 0 {
+				x, m := protowire.ConsumeVarint(v)
+				if m < 0 {
+					return protowire.ParseError(m)
+				}
+				v = v[m:]
+				p.Palette = append(p.Palette, WireColor(x).String())
+			}
+		case num == 9 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Palette = append(p.Palette, WireColor(v).String())
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk string
+			var mv int64
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mk = v
+				case num == 2 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mv = int64(v)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Counters == nil {
+				p.Counters = make(map[string]int64)
+			}
+			p.Counters[mk] = mv
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk int32
+			mv := &WireAttachmentPlain{}
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = int32(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					if err := mv.UnmarshalProto(v); err != nil {
+						return err
+					}
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.AttachmentsById == nil {
+				p.AttachmentsById = make(map[int32]*WireAttachmentPlain)
+			}
+			p.AttachmentsById[mk] = mv
+		case num == 12 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var mk bool
+			var mv string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					mk = protowire.DecodeBool(v)
+				case num == 2 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					mv = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			if p.Flags == nil {
+				p.Flags = make(map[bool]string)
+			}
+			p.Flags[mk] = mv
+		case num == 13 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					p.ScalarsFDouble = math.Float64frombits(v)
+				case num == 2 && typ == protowire.Fixed32Type:
+					var v uint32
+					v, n = protowire.ConsumeFixed32(b)
+					p.ScalarsFFloat = math.Float32frombits(v)
+				case num == 3 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ScalarsFInt32 = int32(v)
+				case num == 4 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ScalarsFInt64 = int64(v)
+				case num == 5 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ScalarsFUint32 = uint32(v)
+				case num == 6 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ScalarsFUint64 = v
+				case num == 7 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ScalarsFSint32 = int32(protowire.DecodeZigZag(v & math.MaxUint32))
+				case num == 8 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ScalarsFSint64 = protowire.DecodeZigZag(v)
+				case num == 9 && typ == protowire.Fixed32Type:
+					var v uint32
+					v, n = protowire.ConsumeFixed32(b)
+					p.ScalarsFFixed32 = v
+				case num == 10 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					p.ScalarsFFixed64 = v
+				case num == 11 && typ == protowire.Fixed32Type:
+					var v uint32
+					v, n = protowire.ConsumeFixed32(b)
+					p.ScalarsFSfixed32 = int32(v)
+				case num == 12 && typ == protowire.Fixed64Type:
+					var v uint64
+					v, n = protowire.ConsumeFixed64(b)
+					p.ScalarsFSfixed64 = int64(v)
+				case num == 13 && typ == protowire.VarintType:
+					var v uint64
+					v, n = protowire.ConsumeVarint(b)
+					p.ScalarsFBool = protowire.DecodeBool(v)
+				case num == 14 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.ScalarsFString = v
+				case num == 15 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					p.ScalarsFBytes = append([]byte{}, v...)
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 14 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.Cover = &WireAttachmentPlain{}
+			if err := p.Cover.UnmarshalProto(v); err != nil {
+				return err
+			}
+		case num == 15 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e WireAttachmentPlain
+			if err := e.UnmarshalProto(v); err != nil {
+				return err
+			}
+			p.Attachments = append(p.Attachments, e)
+		case num == 16 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &common.Money{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Price = m
+		case num == 17 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					e = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			p.Label = e
+		case num == 18 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e string
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					e = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+			p.Aliases = append(p.Aliases, e)
+		case num == 19 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &WireDimensions{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			data, err := protojson.Marshal(m)
+			if err != nil {
+				return err
+			}
+			p.RawSize = data
+		case num == 23 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			var e WireEnvelopeThumbnailsItemPlain
+			if err := e.UnmarshalProto(v); err != nil {
+				return err
+			}
+			p.Thumbnails = append(p.Thumbnails, e)
+		case num == 20 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = WireEnvelopePayloadCaseText
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.PayloadTextBody = v
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 21 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			p.PayloadCase = WireEnvelopePayloadCaseImage
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v string
+					v, n = protowire.ConsumeString(b)
+					p.PayloadImageUrl = v
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					m := &WireDimensions{}
+					if err := proto.Unmarshal(v, m); err != nil {
+						return err
+					}
+					p.PayloadImageSize = m
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 22 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.PayloadCase = WireEnvelopePayloadCasePing
+			p.PayloadPingPing = int64(v)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// wireEnvelopePlainReflect describes WireEnvelopePlain as message full.plain.WireEnvelopePlain
+var wireEnvelopePlainReflect = plainreflect.NewMessageInfo(file_test_full_wire_proto_plain, "WireEnvelopePlain",
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *string { return &p.Id }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *int32 { return &p.Priority }),
+	plainreflect.List(func(p *WireEnvelopePlain) *[]int64 { return &p.Offsets }),
+	plainreflect.List(func(p *WireEnvelopePlain) *[]int32 { return &p.Deltas }),
+	plainreflect.List(func(p *WireEnvelopePlain) *[]float64 { return &p.Weights }),
+	plainreflect.List(func(p *WireEnvelopePlain) *[]string { return &p.Tags }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *string { return &p.Color }),
+	plainreflect.Enum(func(p *WireEnvelopePlain) *WireColor { return &p.RawColor }),
+	plainreflect.List(func(p *WireEnvelopePlain) *[]string { return &p.Palette }),
+	plainreflect.Map(func(p *WireEnvelopePlain) *map[string]int64 { return &p.Counters }),
+	plainreflect.MessageMap(func(p *WireEnvelopePlain) *map[int32]*WireAttachmentPlain { return &p.AttachmentsById }),
+	plainreflect.Map(func(p *WireEnvelopePlain) *map[bool]string { return &p.Flags }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *float64 { return &p.ScalarsFDouble }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *float32 { return &p.ScalarsFFloat }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *int32 { return &p.ScalarsFInt32 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *int64 { return &p.ScalarsFInt64 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *uint32 { return &p.ScalarsFUint32 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *uint64 { return &p.ScalarsFUint64 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *int32 { return &p.ScalarsFSint32 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *int64 { return &p.ScalarsFSint64 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *uint32 { return &p.ScalarsFFixed32 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *uint64 { return &p.ScalarsFFixed64 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *int32 { return &p.ScalarsFSfixed32 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *int64 { return &p.ScalarsFSfixed64 }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *bool { return &p.ScalarsFBool }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *string { return &p.ScalarsFString }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *[]byte { return &p.ScalarsFBytes }),
+	plainreflect.Message(func(p *WireEnvelopePlain) **WireAttachmentPlain { return &p.Cover }),
+	plainreflect.ValueList(func(p *WireEnvelopePlain) *[]WireAttachmentPlain { return &p.Attachments }),
+	plainreflect.Message(func(p *WireEnvelopePlain) **common.Money { return &p.Price }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *string { return &p.Label }),
+	plainreflect.List(func(p *WireEnvelopePlain) *[]string { return &p.Aliases }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *[]byte { return &p.RawSize }),
+	plainreflect.ValueList(func(p *WireEnvelopePlain) *[]WireEnvelopeThumbnailsItemPlain { return &p.Thumbnails }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *string { return &p.PayloadTextBody }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *string { return &p.PayloadImageUrl }),
+	plainreflect.Message(func(p *WireEnvelopePlain) **WireDimensions { return &p.PayloadImageSize }),
+	plainreflect.Scalar(func(p *WireEnvelopePlain) *int64 { return &p.PayloadPingPing }),
+	plainreflect.Case(func(p *WireEnvelopePlain) *WireEnvelopePayloadCase { return &p.PayloadCase }),
+)
+
+// ProtoReflect returns the reflective view of WireEnvelopePlain backed by the struct
+func (p *WireEnvelopePlain) ProtoReflect() protoreflect.Message {
+	return wireEnvelopePlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of WireEnvelopePlain
+func (p *WireEnvelopePlain) Clone() *WireEnvelopePlain {
+	if p == nil {
+		return nil
+	}
+	dst := &WireEnvelopePlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of WireEnvelopePlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetWireEnvelopePlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *WireEnvelopePlain) CopyTo(dst *WireEnvelopePlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.PayloadCase = p.PayloadCase
+	dst.Id = p.Id
+	dst.Priority = p.Priority
+	dst.Offsets = append(dst.Offsets[:0], p.Offsets...)
+	dst.Deltas = append(dst.Deltas[:0], p.Deltas...)
+	dst.Weights = append(dst.Weights[:0], p.Weights...)
+	dst.Tags = append(dst.Tags[:0], p.Tags...)
+	dst.Color = p.Color
+	dst.RawColor = p.RawColor
+	dst.Palette = append(dst.Palette[:0], p.Palette...)
+	for k := range dst.Counters {
+		delete(dst.Counters, k)
+	}
+	if dst.Counters == nil && p.Counters != nil {
+		dst.Counters = make(map[string]int64, len(p.Counters))
+	}
+	for k, v := range p.Counters {
+		dst.Counters[k] = v
+	}
+	for k := range dst.AttachmentsById {
+		if _, ok := p.AttachmentsById[k]; !ok {
+			delete(dst.AttachmentsById, k)
+		}
+	}
+	if dst.AttachmentsById == nil && p.AttachmentsById != nil {
+		dst.AttachmentsById = make(map[int32]*WireAttachmentPlain, len(p.AttachmentsById))
+	}
+	for k, v := range p.AttachmentsById {
+		if v == nil {
+			dst.AttachmentsById[k] = nil
+			continue
+		}
+		d := dst.AttachmentsById[k]
+		if d == nil {
+			d = new(WireAttachmentPlain)
+		}
+		v.CopyTo(d)
+		dst.AttachmentsById[k] = d
+	}
+	for k := range dst.Flags {
+		delete(dst.Flags, k)
+	}
+	if dst.Flags == nil && p.Flags != nil {
+		dst.Flags = make(map[bool]string, len(p.Flags))
+	}
+	for k, v := range p.Flags {
+		dst.Flags[k] = v
+	}
+	dst.ScalarsFDouble = p.ScalarsFDouble
+	dst.ScalarsFFloat = p.ScalarsFFloat
+	dst.ScalarsFInt32 = p.ScalarsFInt32
+	dst.ScalarsFInt64 = p.ScalarsFInt64
+	dst.ScalarsFUint32 = p.ScalarsFUint32
+	dst.ScalarsFUint64 = p.ScalarsFUint64
+	dst.ScalarsFSint32 = p.ScalarsFSint32
+	dst.ScalarsFSint64 = p.ScalarsFSint64
+	dst.ScalarsFFixed32 = p.ScalarsFFixed32
+	dst.ScalarsFFixed64 = p.ScalarsFFixed64
+	dst.ScalarsFSfixed32 = p.ScalarsFSfixed32
+	dst.ScalarsFSfixed64 = p.ScalarsFSfixed64
+	dst.ScalarsFBool = p.ScalarsFBool
+	dst.ScalarsFString = p.ScalarsFString
+	dst.ScalarsFBytes = append(dst.ScalarsFBytes[:0], p.ScalarsFBytes...)
+	if p.Cover == nil {
+		dst.Cover = nil
+	} else {
+		if dst.Cover == nil {
+			dst.Cover = new(WireAttachmentPlain)
+		}
+		p.Cover.CopyTo(dst.Cover)
+	}
+	if cap(dst.Attachments) < len(p.Attachments) {
+		dst.Attachments = make([]WireAttachmentPlain, len(p.Attachments))
+	} else {
+		dst.Attachments = dst.Attachments[:len(p.Attachments)]
+	}
+	for i := range p.Attachments {
+		p.Attachments[i].CopyTo(&dst.Attachments[i])
+	}
+	if p.Price == nil {
+		dst.Price = nil
+	} else {
+		if dst.Price == nil {
+			dst.Price = new(common.Money)
+		} else {
+			proto.Reset(dst.Price)
+		}
+		proto.Merge(dst.Price, p.Price)
+	}
+	dst.Label = p.Label
+	dst.Aliases = append(dst.Aliases[:0], p.Aliases...)
+	dst.RawSize = append(dst.RawSize[:0], p.RawSize...)
+	if cap(dst.Thumbnails) < len(p.Thumbnails) {
+		dst.Thumbnails = make([]WireEnvelopeThumbnailsItemPlain, len(p.Thumbnails))
+	} else {
+		dst.Thumbnails = dst.Thumbnails[:len(p.Thumbnails)]
+	}
+	for i := range p.Thumbnails {
+		p.Thumbnails[i].CopyTo(&dst.Thumbnails[i])
+	}
+	dst.PayloadTextBody = p.PayloadTextBody
+	dst.PayloadImageUrl = p.PayloadImageUrl
+	if p.PayloadImageSize == nil {
+		dst.PayloadImageSize = nil
+	} else {
+		if dst.PayloadImageSize == nil {
+			dst.PayloadImageSize = new(WireDimensions)
+		} else {
+			proto.Reset(dst.PayloadImageSize)
+		}
+		proto.Merge(dst.PayloadImageSize, p.PayloadImageSize)
+	}
+	dst.PayloadPingPing = p.PayloadPingPing
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *WireEnvelopePlain) Equal(other *WireEnvelopePlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.PayloadCase != other.PayloadCase {
+		return false
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if p.Priority != other.Priority {
+		return false
+	}
+	if !slices.Equal(p.Offsets, other.Offsets) {
+		return false
+	}
+	if !slices.Equal(p.Deltas, other.Deltas) {
+		return false
+	}
+	if !slices.Equal(p.Weights, other.Weights) {
+		return false
+	}
+	if !slices.Equal(p.Tags, other.Tags) {
+		return false
+	}
+	if p.Color != other.Color {
+		return false
+	}
+	if p.RawColor != other.RawColor {
+		return false
+	}
+	if !slices.Equal(p.Palette, other.Palette) {
+		return false
+	}
+	if !maps.Equal(p.Counters, other.Counters) {
+		return false
+	}
+	if len(p.AttachmentsById) != len(other.AttachmentsById) {
+		return false
+	}
+	for k, v := range p.AttachmentsById {
+		w, ok := other.AttachmentsById[k]
+		if !ok || !v.Equal(w) {
+			return false
+		}
+	}
+	if !maps.Equal(p.Flags, other.Flags) {
+		return false
+	}
+	if p.ScalarsFDouble != other.ScalarsFDouble {
+		return false
+	}
+	if p.ScalarsFFloat != other.ScalarsFFloat {
+		return false
+	}
+	if p.ScalarsFInt32 != other.ScalarsFInt32 {
+		return false
+	}
+	if p.ScalarsFInt64 != other.ScalarsFInt64 {
+		return false
+	}
+	if p.ScalarsFUint32 != other.ScalarsFUint32 {
+		return false
+	}
+	if p.ScalarsFUint64 != other.ScalarsFUint64 {
+		return false
+	}
+	if p.ScalarsFSint32 != other.ScalarsFSint32 {
+		return false
+	}
+	if p.ScalarsFSint64 != other.ScalarsFSint64 {
+		return false
+	}
+	if p.ScalarsFFixed32 != other.ScalarsFFixed32 {
+		return false
+	}
+	if p.ScalarsFFixed64 != other.ScalarsFFixed64 {
+		return false
+	}
+	if p.ScalarsFSfixed32 != other.ScalarsFSfixed32 {
+		return false
+	}
+	if p.ScalarsFSfixed64 != other.ScalarsFSfixed64 {
+		return false
+	}
+	if p.ScalarsFBool != other.ScalarsFBool {
+		return false
+	}
+	if p.ScalarsFString != other.ScalarsFString {
+		return false
+	}
+	if !bytes.Equal(p.ScalarsFBytes, other.ScalarsFBytes) {
+		return false
+	}
+	if !p.Cover.Equal(other.Cover) {
+		return false
+	}
+	if len(p.Attachments) != len(other.Attachments) {
+		return false
+	}
+	for i := range p.Attachments {
+		if !p.Attachments[i].Equal(&other.Attachments[i]) {
+			return false
+		}
+	}
+	if !proto.Equal(p.Price, other.Price) {
+		return false
+	}
+	if p.Label != other.Label {
+		return false
+	}
+	if !slices.Equal(p.Aliases, other.Aliases) {
+		return false
+	}
+	if !bytes.Equal(p.RawSize, other.RawSize) {
+		return false
+	}
+	if len(p.Thumbnails) != len(other.Thumbnails) {
+		return false
+	}
+	for i := range p.Thumbnails {
+		if !p.Thumbnails[i].Equal(&other.Thumbnails[i]) {
+			return false
+		}
+	}
+	if p.PayloadTextBody != other.PayloadTextBody {
+		return false
+	}
+	if p.PayloadImageUrl != other.PayloadImageUrl {
+		return false
+	}
+	if !proto.Equal(p.PayloadImageSize, other.PayloadImageSize) {
+		return false
+	}
+	if p.PayloadPingPing != other.PayloadPingPing {
+		return false
+	}
+	return true
+}
+
+// Columns returns the column names of WireEnvelopePlain rows, in the order of ScanRow and Values
+func (p *WireEnvelopePlain) Columns() []string {
+	return []string{
+		"id",
+		"priority",
+		"offsets",
+		"deltas",
+		"weights",
+		"tags",
+		"color",
+		"raw_color",
+		"palette",
+		"counters",
+		"attachments_by_id",
+		"flags",
+		"scalars_f_double",
+		"scalars_f_float",
+		"scalars_f_int32",
+		"scalars_f_int64",
+		"scalars_f_uint32",
+		"scalars_f_uint64",
+		"scalars_f_sint32",
+		"scalars_f_sint64",
+		"scalars_f_fixed32",
+		"scalars_f_fixed64",
+		"scalars_f_sfixed32",
+		"scalars_f_sfixed64",
+		"scalars_f_bool",
+		"scalars_f_string",
+		"scalars_f_bytes",
+		"cover",
+		"attachments",
+		"price",
+		"label",
+		"aliases",
+		"raw_size",
+		"thumbnails",
+		"payload_text_body",
+		"payload_image_url",
+		"payload_image_size",
+		"payload_ping_ping",
+		"payload_case",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into WireEnvelopePlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *WireEnvelopePlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.Priority,
+		plainsql.JSON(&p.Offsets),
+		plainsql.JSON(&p.Deltas),
+		plainsql.JSON(&p.Weights),
+		plainsql.JSON(&p.Tags),
+		&p.Color,
+		&p.RawColor,
+		plainsql.JSON(&p.Palette),
+		plainsql.JSON(&p.Counters),
+		plainsql.JSON(&p.AttachmentsById),
+		plainsql.JSON(&p.Flags),
+		&p.ScalarsFDouble,
+		&p.ScalarsFFloat,
+		&p.ScalarsFInt32,
+		&p.ScalarsFInt64,
+		&p.ScalarsFUint32,
+		&p.ScalarsFUint64,
+		&p.ScalarsFSint32,
+		&p.ScalarsFSint64,
+		&p.ScalarsFFixed32,
+		&p.ScalarsFFixed64,
+		&p.ScalarsFSfixed32,
+		&p.ScalarsFSfixed64,
+		&p.ScalarsFBool,
+		&p.ScalarsFString,
+		&p.ScalarsFBytes,
+		plainsql.JSON(&p.Cover),
+		plainsql.JSON(&p.Attachments),
+		plainsql.JSON(&p.Price),
+		&p.Label,
+		plainsql.JSON(&p.Aliases),
+		&p.RawSize,
+		plainsql.JSON(&p.Thumbnails),
+		&p.PayloadTextBody,
+		&p.PayloadImageUrl,
+		plainsql.JSON(&p.PayloadImageSize),
+		&p.PayloadPingPing,
+		&p.PayloadCase,
+	)
+}
+
+// Values returns the column values of WireEnvelopePlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *WireEnvelopePlain) Values() []any {
+	return []any{
+		p.Id,
+		p.Priority,
+		plainsql.JSON(&p.Offsets),
+		plainsql.JSON(&p.Deltas),
+		plainsql.JSON(&p.Weights),
+		plainsql.JSON(&p.Tags),
+		p.Color,
+		p.RawColor,
+		plainsql.JSON(&p.Palette),
+		plainsql.JSON(&p.Counters),
+		plainsql.JSON(&p.AttachmentsById),
+		plainsql.JSON(&p.Flags),
+		p.ScalarsFDouble,
+		p.ScalarsFFloat,
+		p.ScalarsFInt32,
+		p.ScalarsFInt64,
+		p.ScalarsFUint32,
+		plainsql.Uint64(&p.ScalarsFUint64),
+		p.ScalarsFSint32,
+		p.ScalarsFSint64,
+		p.ScalarsFFixed32,
+		plainsql.Uint64(&p.ScalarsFFixed64),
+		p.ScalarsFSfixed32,
+		p.ScalarsFSfixed64,
+		p.ScalarsFBool,
+		p.ScalarsFString,
+		p.ScalarsFBytes,
+		plainsql.JSON(&p.Cover),
+		plainsql.JSON(&p.Attachments),
+		plainsql.JSON(&p.Price),
+		p.Label,
+		plainsql.JSON(&p.Aliases),
+		p.RawSize,
+		plainsql.JSON(&p.Thumbnails),
+		p.PayloadTextBody,
+		p.PayloadImageUrl,
+		plainsql.JSON(&p.PayloadImageSize),
+		p.PayloadPingPing,
+		p.PayloadCase,
+	}
+}
+
+// wireEnvelopePlainPool is a sync.Pool for WireEnvelopePlain objects
+var wireEnvelopePlainPool = sync.Pool{
+	New: func() interface{} {
+		return &WireEnvelopePlain{}
+	},
+}
+
+// GetWireEnvelopePlain returns a WireEnvelopePlain from the pool
+func GetWireEnvelopePlain() *WireEnvelopePlain {
+	return wireEnvelopePlainPool.Get().(*WireEnvelopePlain)
+}
+
+// PutWireEnvelopePlain returns a WireEnvelopePlain to the pool after resetting it
+func PutWireEnvelopePlain(p *WireEnvelopePlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	wireEnvelopePlainPool.Put(p)
+}
+
+// Reset clears all fields in WireEnvelopePlain for reuse
+func (p *WireEnvelopePlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.PayloadCase = ""
+	p.Id = ""
+	p.Priority = 0
+	p.Offsets = p.Offsets[:0]
+	p.Deltas = p.Deltas[:0]
+	p.Weights = p.Weights[:0]
+	p.Tags = p.Tags[:0]
+	p.Color = ""
+	p.RawColor = 0
+	p.Palette = p.Palette[:0]
+	for k := range p.Counters {
+		delete(p.Counters, k)
+	}
+	for k := range p.AttachmentsById {
+		delete(p.AttachmentsById, k)
+	}
+	for k := range p.Flags {
+		delete(p.Flags, k)
+	}
+	p.ScalarsFDouble = 0
+	p.ScalarsFFloat = 0
+	p.ScalarsFInt32 = 0
+	p.ScalarsFInt64 = 0
+	p.ScalarsFUint32 = 0
+	p.ScalarsFUint64 = 0
+	p.ScalarsFSint32 = 0
+	p.ScalarsFSint64 = 0
+	p.ScalarsFFixed32 = 0
+	p.ScalarsFFixed64 = 0
+	p.ScalarsFSfixed32 = 0
+	p.ScalarsFSfixed64 = 0
+	p.ScalarsFBool = false
+	p.ScalarsFString = ""
+	p.ScalarsFBytes = nil
+	p.Cover = nil
+	p.Attachments = p.Attachments[:0]
+	p.Price = nil
+	p.Label = ""
+	p.Aliases = p.Aliases[:0]
+	p.RawSize = nil
+	p.Thumbnails = p.Thumbnails[:0]
+	p.PayloadTextBody = ""
+	p.PayloadImageUrl = ""
+	p.PayloadImageSize = nil
+	p.PayloadPingPing = 0
+}
+
+// WireEnvelopeThumbnailsItemPlain holds flattened fields of full.WireDimensions for repeated embed thumbnails
+type WireEnvelopeThumbnailsItemPlain struct {
+	Width  int32 `json:"width"`
+	Height int32 `json:"height"`
+}
+
+// MarshalJX encodes WireEnvelopeThumbnailsItemPlain to JSON using jx.Encoder
+func (p *WireEnvelopeThumbnailsItemPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Width != 0 {
+		e.FieldStart("width")
+		e.Int32(p.Width)
+	}
+	if p.Height != 0 {
+		e.FieldStart("height")
+		e.Int32(p.Height)
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *WireEnvelopeThumbnailsItemPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes WireEnvelopeThumbnailsItemPlain from JSON using jx.Decoder
+func (p *WireEnvelopeThumbnailsItemPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "width":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Width = v
+		case "height":
+			v, err := d.Int32()
+			if err != nil {
+				return err
+			}
+			p.Height = v
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *WireEnvelopeThumbnailsItemPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes WireEnvelopeThumbnailsItemPlain in the protobuf wire format of WireDimensions
+func (p *WireEnvelopeThumbnailsItemPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends WireEnvelopeThumbnailsItemPlain encoded in the protobuf wire format of WireDimensions to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *WireEnvelopeThumbnailsItemPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	if v := p.Width; v != 0 {
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	if v := p.Height; v != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes WireEnvelopeThumbnailsItemPlain from the protobuf wire format of WireDimensions.
+// Unknown fields are skipped.
+func (p *WireEnvelopeThumbnailsItemPlain) UnmarshalProto(b []byte) error {
+	*p = WireEnvelopeThumbnailsItemPlain{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Width = int32(v)
+		case num == 2 && typ == protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(b)
+			p.Height = int32(v)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// wireEnvelopeThumbnailsItemPlainReflect describes WireEnvelopeThumbnailsItemPlain as message full.plain.WireEnvelopeThumbnailsItemPlain
+var wireEnvelopeThumbnailsItemPlainReflect = plainreflect.NewMessageInfo(file_test_full_wire_proto_plain, "WireEnvelopeThumbnailsItemPlain",
+	plainreflect.Scalar(func(p *WireEnvelopeThumbnailsItemPlain) *int32 { return &p.Width }),
+	plainreflect.Scalar(func(p *WireEnvelopeThumbnailsItemPlain) *int32 { return &p.Height }),
+)
+
+// ProtoReflect returns the reflective view of WireEnvelopeThumbnailsItemPlain backed by the struct
+func (p *WireEnvelopeThumbnailsItemPlain) ProtoReflect() protoreflect.Message {
+	return wireEnvelopeThumbnailsItemPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of WireEnvelopeThumbnailsItemPlain
+func (p *WireEnvelopeThumbnailsItemPlain) Clone() *WireEnvelopeThumbnailsItemPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &WireEnvelopeThumbnailsItemPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of WireEnvelopeThumbnailsItemPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetWireEnvelopeThumbnailsItemPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *WireEnvelopeThumbnailsItemPlain) CopyTo(dst *WireEnvelopeThumbnailsItemPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Width = p.Width
+	dst.Height = p.Height
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *WireEnvelopeThumbnailsItemPlain) Equal(other *WireEnvelopeThumbnailsItemPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Width != other.Width {
+		return false
+	}
+	if p.Height != other.Height {
+		return false
+	}
+	return true
+}
+
+// Reset clears all fields in WireEnvelopeThumbnailsItemPlain for reuse
+func (p *WireEnvelopeThumbnailsItemPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Width = 0
+	p.Height = 0
+}
+
+// file_test_full_wire_proto_plain_rawDesc is the serialized descriptor of test/full/wire_plain.proto,
+// describing the Plain messages of test/full/wire.proto in package full.plain
+const file_test_full_wire_proto_plain_rawDesc = "" +
+	"\n\x1atest/full/wire_plain.proto\x12\nfull.plain\x1a\x1ctest/full/common/money" +
+	".proto\x1a\x14test/full/wire.proto\"i\n\x13WireAttachmentPlain\x12\x12\n\x04name\x18\x01 \x01(" +
+	"\tR\x04name\x12\x1d\n\nsize_width\x18\x02 \x01(\x05R\tsizeWidth\x12\x1f\n\vsize_height\x18\x03 \x01(\x05R\nsiz" +
+	"eHeight\"\xe0\x0e\n\x11WireEnvelopePlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n\bpriority\x18\x02 \x01(\x05R" +
+	"\bpriority\x12\x18\n\aoffsets\x18\x03 \x03(\x03R\aoffsets\x12\x16\n\x06deltas\x18\x04 \x03(\x11R\x06deltas\x12\x18\n\aw" +
+	"eights\x18\x05 \x03(\x01R\aweights\x12\x12\n\x04tags\x18\x06 \x03(\tR\x04tags\x12\x14\n\x05color\x18\a \x01(\tR\x05color\x12" +
+	",\n\traw_color\x18\b \x01(\x0e2\x0f.full.WireColorR\brawColor\x12\x18\n\apalette\x18\t \x03(\tR\a" +
+	"palette\x12G\n\bcounters\x18\n \x03(\v2+.full.plain.WireEnvelopePlain.Counter" +
+	"sEntryR\bcounters\x12^\n\x11attachments_by_id\x18\v \x03(\v22.full.plain.WireEnv" +
+	"elopePlain.AttachmentsByIdEntryR\x0fattachmentsById\x12>\n\x05flags\x18\f \x03(\v2" +
+	"(.full.plain.WireEnvelopePlain.FlagsEntryR\x05flags\x12(\n\x10scalars_f_do" +
+	"uble\x18\r \x01(\x01R\x0escalarsFDouble\x12&\n\x0fscalars_f_float\x18\x0e \x01(\x02R\rscalarsFFlo" +
+	"at\x12&\n\x0fscalars_f_int32\x18\x0f \x01(\x05R\rscalarsFInt32\x12&\n\x0fscalars_f_int64\x18\x10 " +
+	"\x01(\x03R\rscalarsFInt64\x12(\n\x10scalars_f_uint32\x18\x11 \x01(\rR\x0escalarsFUint32\x12(\n\x10" +
+	"scalars_f_uint64\x18\x12 \x01(\x04R\x0escalarsFUint64\x12(\n\x10scalars_f_sint32\x18\x13 \x01(\x11" +
+	"R\x0escalarsFSint32\x12(\n\x10scalars_f_sint64\x18\x14 \x01(\x12R\x0escalarsFSint64\x12*\n\x11sc" +
+	"alars_f_fixed32\x18\x15 \x01(\aR\x0fscalarsFFixed32\x12*\n\x11scalars_f_fixed64\x18\x16 \x01(" +
+	"\x06R\x0fscalarsFFixed64\x12,\n\x12scalars_f_sfixed32\x18\x17 \x01(\x0fR\x10scalarsFSfixed32" +
+	"\x12,\n\x12scalars_f_sfixed64\x18\x18 \x01(\x10R\x10scalarsFSfixed64\x12$\n\x0escalars_f_bool" +
+	"\x18\x19 \x01(\bR\fscalarsFBool\x12(\n\x10scalars_f_string\x18\x1a \x01(\tR\x0escalarsFString\x12&" +
+	"\n\x0fscalars_f_bytes\x18\x1b \x01(\fR\rscalarsFBytes\x125\n\x05cover\x18\x1c \x01(\v2\x1f.full.pla" +
+	"in.WireAttachmentPlainR\x05cover\x12A\n\vattachments\x18\x1d \x03(\v2\x1f.full.plain." +
+	"WireAttachmentPlainR\vattachments\x12(\n\x05price\x18\x1e \x01(\v2\x12.full.common.Mo" +
+	"neyR\x05price\x12\x14\n\x05label\x18\x1f \x01(\tR\x05label\x12\x18\n\aaliases\x18  \x03(\tR\aaliases\x12\x19\n\bra" +
+	"w_size\x18! \x01(\fR\arawSize\x12K\n\nthumbnails\x18\" \x03(\v2+.full.plain.WireEnvel" +
+	"opeThumbnailsItemPlainR\nthumbnails\x12*\n\x11payload_text_body\x18# \x01(\tR\x0fp" +
+	"ayloadTextBody\x12*\n\x11payload_image_url\x18$ \x01(\tR\x0fpayloadImageUrl\x12B\n\x12pa" +
+	"yload_image_size\x18% \x01(\v2\x14.full.WireDimensionsR\x10payloadImageSize\x12*" +
+	"\n\x11payload_ping_ping\x18& \x01(\x03R\x0fpayloadPingPing\x12\"\n\fpayload_case\x18' \x01(\t" +
+	"R\fpayload_case\x1a;\n\rCountersEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x03" +
+	"R\x05value:\x028\x01\x1ac\n\x14AttachmentsByIdEntry\x12\x10\n\x03key\x18\x01 \x01(\x05R\x03key\x125\n\x05value\x18\x02" +
+	" \x01(\v2\x1f.full.plain.WireAttachmentPlainR\x05value:\x028\x01\x1a8\n\nFlagsEntry\x12\x10" +
+	"\n\x03key\x18\x01 \x01(\bR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"O\n\x1fWireEnvelopeThumbn" +
+	"ailsItemPlain\x12\x14\n\x05width\x18\x01 \x01(\x05R\x05width\x12\x16\n\x06height\x18\x02 \x01(\x05R\x06heightb\x06pro" +
+	"to3"
+
+var file_test_full_wire_proto_plain = plainreflect.NewFile("test/full/wire_plain.proto", file_test_full_wire_proto_plain_rawDesc)
+
+func init() { file_test_full_wire_proto_plain_init() }
+
+func file_test_full_wire_proto_plain_init() {
+	file_test_full_wire_proto_init()
+	file_test_full_wire_proto_plain.Init()
+}
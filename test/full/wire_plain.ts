@@ -0,0 +1,72 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/full/wire.proto
+
+export interface WireAttachmentPlain {
+  name?: string;
+  sizeWidth?: number;
+  sizeHeight?: number;
+}
+
+/** Fields of WireEnvelopePlain outside of oneofs */
+export interface WireEnvelopePlainFields {
+  id?: string;
+  priority?: number;
+  offsets?: number[];
+  deltas?: number[];
+  weights?: number[];
+  tags?: string[];
+  color?: "WIRE_COLOR_UNSPECIFIED" | "WIRE_COLOR_RED" | "WIRE_COLOR_GREEN";
+  rawColor?: 0 | 1 | 2;
+  palette?: ("WIRE_COLOR_UNSPECIFIED" | "WIRE_COLOR_RED" | "WIRE_COLOR_GREEN")[];
+  counters?: Record<string, number>;
+  attachmentsById?: Record<string, WireAttachmentPlain>;
+  flags?: Record<string, string>;
+  scalarsFDouble?: number;
+  scalarsFFloat?: number;
+  scalarsFInt32?: number;
+  scalarsFInt64?: number;
+  scalarsFUint32?: number;
+  scalarsFUint64?: number;
+  scalarsFSint32?: number;
+  scalarsFSint64?: number;
+  scalarsFFixed32?: number;
+  scalarsFFixed64?: number;
+  scalarsFSfixed32?: number;
+  scalarsFSfixed64?: number;
+  scalarsFBool?: boolean;
+  scalarsFString?: string;
+  scalarsFBytes?: string;
+  cover?: WireAttachmentPlain;
+  attachments?: WireAttachmentPlain[];
+  price?: Record<string, unknown>;
+  label?: string;
+  aliases?: string[];
+  rawSize?: string;
+  /** Repeated embed rows */
+  thumbnails?: WireEnvelopeThumbnailsItemPlain[];
+}
+
+/** payload oneof of WireEnvelopePlain, by payload_case */
+export type WireEnvelopePlainPayload =
+  | {
+      payload_case: "text";
+      payloadTextBody?: string;
+    }
+  | {
+      payload_case: "image";
+      payloadImageUrl?: string;
+      payloadImageSize?: Record<string, unknown>;
+    }
+  | {
+      payload_case: "ping";
+      payloadPingPing?: number;
+    }
+  | { payload_case?: undefined };
+
+export type WireEnvelopePlain = WireEnvelopePlainFields & WireEnvelopePlainPayload;
+
+/** WireEnvelopeThumbnailsItemPlain holds flattened fields of full.WireDimensions for repeated embed thumbnails */
+export interface WireEnvelopeThumbnailsItemPlain {
+  width?: number;
+  height?: number;
+}
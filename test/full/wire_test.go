@@ -0,0 +1,158 @@
+package full_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/full"
+	"github.com/yaroher/protoc-gen-go-plain/test/full/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func wireEnvelope() *full.WireEnvelope {
+	return &full.WireEnvelope{
+		Id:       "env-1",
+		Priority: -7,
+		Offsets:  []int64{1, -2, 300},
+		Deltas:   []int32{-1, 0, 5},
+		Weights:  []float64{0.5, math.Copysign(0, -1)},
+		Tags:     []string{"a", ""},
+		Color:    full.WireColor_WIRE_COLOR_GREEN,
+		RawColor: full.WireColor_WIRE_COLOR_RED,
+		Palette:  []full.WireColor{full.WireColor_WIRE_COLOR_RED, full.WireColor_WIRE_COLOR_UNSPECIFIED},
+		Counters: map[string]int64{"b": 2, "a": 1, "zero": 0},
+		AttachmentsById: map[int32]*full.WireAttachment{
+			-1: {Name: "neg"},
+			10: {Name: "ten", Size: &full.WireDimensions{Width: 640, Height: 480}},
+		},
+		Flags: map[bool]string{true: "yes", false: "no"},
+		Scalars: &full.WireScalars{
+			FDouble: -1.5, FFloat: 2.25, FInt32: -3, FInt64: -4, FUint32: 5, FUint64: math.MaxUint64,
+			FSint32: -6, FSint64: math.MinInt64, FFixed32: 7, FFixed64: 8, FSfixed32: -9, FSfixed64: -10,
+			FBool: true, FString: "s", FBytes: []byte{0, 1},
+		},
+		Cover:       &full.WireAttachment{Name: "cover"},
+		Attachments: []*full.WireAttachment{{Name: "x"}, {Name: "y", Size: &full.WireDimensions{Width: 1}}},
+		Price:       &common.Money{Currency: "EUR", Units: 150},
+		Label:       &full.WireLabel{Value: "label"},
+		Aliases:     []*full.WireLabel{{Value: "first"}, {Value: ""}},
+		RawSize:     &full.WireDimensions{Width: 3, Height: 4},
+		Payload: &full.WireEnvelope_Image{
+			Image: &full.WireImage{Url: "https://img", Size: &full.WireDimensions{Width: 10, Height: 20}},
+		},
+		Thumbnails: []*full.WireDimensions{{Width: 1, Height: 2}, {}},
+	}
+}
+
+func TestWire_BytesMatchProtoMarshal(t *testing.T) {
+	original := wireEnvelope()
+
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(original)
+	require.NoError(t, err)
+
+	data, err := original.IntoPlain().MarshalProto()
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+}
+
+func TestWire_Oneof(t *testing.T) {
+	tests := map[string]*full.WireEnvelope{
+		"text":       {Payload: &full.WireEnvelope_Text{Text: &full.WireText{Body: "hello"}}},
+		"empty text": {Payload: &full.WireEnvelope_Text{Text: &full.WireText{}}},
+		"ping":       {Payload: &full.WireEnvelope_Ping{Ping: 42}},
+		"zero ping":  {Payload: &full.WireEnvelope_Ping{Ping: 0}},
+		"none":       {},
+	}
+	for name, original := range tests {
+		t.Run(name, func(t *testing.T) {
+			expected, err := proto.Marshal(original)
+			require.NoError(t, err)
+
+			plain := original.IntoPlain()
+			data, err := plain.MarshalProto()
+			require.NoError(t, err)
+			assert.Equal(t, expected, data)
+
+			decoded := &full.WireEnvelopePlain{}
+			require.NoError(t, decoded.UnmarshalProto(data))
+			assert.Equal(t, plain.PayloadCase, decoded.PayloadCase)
+			// IntoPb always builds embedded messages, so compare the normalized original
+			require.True(t, proto.Equal(plain.IntoPb(), decoded.IntoPb()))
+		})
+	}
+}
+
+func TestWire_UnmarshalRoundtrip(t *testing.T) {
+	original := wireEnvelope()
+	data, err := proto.Marshal(original)
+	require.NoError(t, err)
+
+	plain := &full.WireEnvelopePlain{}
+	require.NoError(t, plain.UnmarshalProto(data))
+
+	assert.Equal(t, "WIRE_COLOR_GREEN", plain.Color)
+	assert.Equal(t, full.WireEnvelopePayloadCaseImage, plain.PayloadCase)
+	assert.Equal(t, "https://img", plain.PayloadImageUrl)
+	assert.Equal(t, uint64(math.MaxUint64), plain.ScalarsFUint64)
+	assert.Equal(t, int64(math.MinInt64), plain.ScalarsFSint64)
+	assert.Equal(t, []string{"first", ""}, plain.Aliases)
+	assert.Equal(t, "ten", plain.AttachmentsById[10].Name)
+	assert.Equal(t, int32(640), plain.AttachmentsById[10].SizeWidth)
+	require.Len(t, plain.Thumbnails, 2)
+
+	require.True(t, proto.Equal(original.IntoPlain().IntoPb(), plain.IntoPb()), "WireEnvelope roundtrip failed")
+}
+
+func TestWire_UnknownFieldsSkipped(t *testing.T) {
+	data, err := proto.Marshal(&full.WireAttachment{Name: "a", Size: &full.WireDimensions{Width: 2}})
+	require.NoError(t, err)
+	// Field 99 (varint) and field 100 (bytes) are not in WireAttachment
+	data = append(data, 0x98, 0x06, 0x01, 0xa2, 0x06, 0x01, 'x')
+
+	plain := &full.WireAttachmentPlain{}
+	require.NoError(t, plain.UnmarshalProto(data))
+	assert.Equal(t, "a", plain.Name)
+	assert.Equal(t, int32(2), plain.SizeWidth)
+}
+
+func TestWire_Truncated(t *testing.T) {
+	data, err := proto.Marshal(wireEnvelope())
+	require.NoError(t, err)
+
+	err = (&full.WireEnvelopePlain{}).UnmarshalProto(data[:len(data)-1])
+	require.Error(t, err)
+}
+
+func TestWire_AppendProto(t *testing.T) {
+	plain := (&full.WireAttachment{Name: "a"}).IntoPlain()
+
+	prefix := []byte{0xff}
+	data, err := plain.AppendProto(prefix)
+	require.NoError(t, err)
+
+	expected, err := proto.Marshal(&full.WireAttachment{Name: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte{0xff}, expected...), data)
+}
+
+func TestWire_ExistingCasters(t *testing.T) {
+	original := &full.Lease{TtlMs: 1500, Holder: "jane@example.com"}
+	expected, err := proto.Marshal(original)
+	require.NoError(t, err)
+
+	plain, err := original.IntoPlainE()
+	require.NoError(t, err)
+	data, err := plain.MarshalProto()
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	decoded := &full.LeasePlain{}
+	require.NoError(t, decoded.UnmarshalProto(data))
+	assert.Equal(t, plain, decoded)
+
+	bad, err := proto.Marshal(&full.Lease{Holder: "broken"})
+	require.NoError(t, err)
+	require.Error(t, (&full.LeasePlain{}).UnmarshalProto(bad))
+}
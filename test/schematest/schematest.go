@@ -0,0 +1,242 @@
+// Package schematest validates JSON documents against the schemas generated
+// with json_schema=true and the components of openapi=<file>. It implements the
+// keywords the generator emits, which is enough to check that MarshalJSON output
+// matches its schema.
+package schematest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Validate checks data against the schema in file; references to other schema
+// files are resolved relative to it
+func Validate(file string, data []byte) error {
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	v := &validator{schemas: make(map[string]map[string]any)}
+	root, err := v.load(file)
+	if err != nil {
+		return err
+	}
+	return v.validate(file, root, root, doc, "$")
+}
+
+// ValidateComponent checks data against the schema name in components of the OpenAPI document file
+func ValidateComponent(file, name string, data []byte) error {
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	v := &validator{schemas: make(map[string]map[string]any)}
+	root, err := v.load(file)
+	if err != nil {
+		return err
+	}
+	ref := "#/components/schemas/" + name
+	return v.validate(file, root, map[string]any{"$ref": ref}, doc, "$")
+}
+
+type validator struct {
+	schemas map[string]map[string]any
+}
+
+func (v *validator) load(file string) (map[string]any, error) {
+	if s, ok := v.schemas[file]; ok {
+		return s, nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var s map[string]any
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	v.schemas[file] = s
+	return s, nil
+}
+
+// validate checks value at path against schema s of the document root loaded from file
+func (v *validator) validate(file string, root, s map[string]any, value any, path string) error {
+	if ref, ok := s["$ref"].(string); ok {
+		if pointer, ok := strings.CutPrefix(ref, "#/"); ok {
+			target := root
+			for _, token := range strings.Split(pointer, "/") {
+				target, _ = target[token].(map[string]any)
+			}
+			if target == nil {
+				return fmt.Errorf("%s: unresolved $ref %q", path, ref)
+			}
+			return v.validate(file, root, target, value, path)
+		}
+		refFile := filepath.Join(filepath.Dir(file), filepath.FromSlash(ref))
+		target, err := v.load(refFile)
+		if err != nil {
+			return err
+		}
+		return v.validate(refFile, target, target, value, path)
+	}
+
+	switch typ := s["type"].(type) {
+	case string:
+		if !hasType(value, typ) {
+			return fmt.Errorf("%s: %v is not %s", path, value, typ)
+		}
+	case []any:
+		if !slices.ContainsFunc(asStrings(typ), func(t string) bool { return hasType(value, t) }) {
+			return fmt.Errorf("%s: %v is not any of %v", path, value, typ)
+		}
+	}
+	if enum, ok := s["enum"].([]any); ok && !containsValue(enum, value) {
+		return fmt.Errorf("%s: %v is not one of %v", path, value, enum)
+	}
+	if c, ok := s["const"]; ok && !reflect.DeepEqual(c, value) {
+		return fmt.Errorf("%s: %v is not %v", path, value, c)
+	}
+	if pattern, ok := s["pattern"].(string); ok {
+		if str, isStr := value.(string); isStr && !regexp.MustCompile(pattern).MatchString(str) {
+			return fmt.Errorf("%s: %q does not match %s", path, str, pattern)
+		}
+	}
+	if min, ok := s["minimum"].(float64); ok {
+		if n, isNum := value.(float64); isNum && n < min {
+			return fmt.Errorf("%s: %v is less than %v", path, n, min)
+		}
+	}
+
+	if items, ok := s["items"].(map[string]any); ok {
+		if arr, isArr := value.([]any); isArr {
+			for i, item := range arr {
+				if err := v.validate(file, root, items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if obj, isObj := value.(map[string]any); isObj {
+		if err := v.validateObject(file, root, s, obj, path); err != nil {
+			return err
+		}
+	}
+
+	if not, ok := s["not"].(map[string]any); ok {
+		if v.validate(file, root, not, value, path) == nil {
+			return fmt.Errorf("%s: matches the not schema", path)
+		}
+	}
+	if anyOf, ok := s["anyOf"].([]any); ok && v.matching(file, root, anyOf, value, path) == 0 {
+		return fmt.Errorf("%s: matches none of anyOf", path)
+	}
+	if oneOf, ok := s["oneOf"].([]any); ok {
+		if n := v.matching(file, root, oneOf, value, path); n != 1 {
+			return fmt.Errorf("%s: matches %d schemas of oneOf", path, n)
+		}
+	}
+	if allOf, ok := s["allOf"].([]any); ok {
+		for _, sub := range allOf {
+			if err := v.validate(file, root, sub.(map[string]any), value, path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateObject(file string, root, s map[string]any, obj map[string]any, path string) error {
+	for _, key := range asStrings(s["required"]) {
+		if _, ok := obj[key]; !ok {
+			return fmt.Errorf("%s: missing %q", path, key)
+		}
+	}
+	props, _ := s["properties"].(map[string]any)
+	for key, val := range obj {
+		if names, ok := s["propertyNames"].(map[string]any); ok {
+			if err := v.validate(file, root, names, key, path+"."+key); err != nil {
+				return err
+			}
+		}
+		if prop, ok := props[key].(map[string]any); ok {
+			if err := v.validate(file, root, prop, val, path+"."+key); err != nil {
+				return err
+			}
+			continue
+		}
+		switch extra := s["additionalProperties"].(type) {
+		case bool:
+			if !extra {
+				return fmt.Errorf("%s: unknown key %q", path, key)
+			}
+		case map[string]any:
+			if err := v.validate(file, root, extra, val, path+"."+key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *validator) matching(file string, root map[string]any, schemas []any, value any, path string) int {
+	n := 0
+	for _, sub := range schemas {
+		if v.validate(file, root, sub.(map[string]any), value, path) == nil {
+			n++
+		}
+	}
+	return n
+}
+
+func hasType(value any, typ string) bool {
+	switch typ {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n)
+	}
+	return false
+}
+
+func containsValue(list []any, value any) bool {
+	for _, item := range list {
+		if reflect.DeepEqual(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func asStrings(v any) []string {
+	list, _ := v.([]any)
+	out := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,96 @@
+// Package sqltest is an in-memory database/sql driver for testing generated
+// ScanRow and Values methods. Every Exec appends its arguments as a row to the
+// table named by the query, every Query returns the rows of that table, so
+// values go through the same conversions as with a real driver.
+package sqltest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+)
+
+// DriverName is the name the driver is registered with
+const DriverName = "plain-sqltest"
+
+func init() {
+	sql.Register(DriverName, &memDriver{tables: make(map[string][][]driver.Value)})
+}
+
+// Open opens a database on the shared in-memory driver
+func Open() (*sql.DB, error) {
+	return sql.Open(DriverName, "")
+}
+
+type memDriver struct {
+	mu     sync.Mutex
+	tables map[string][][]driver.Value
+}
+
+func (d *memDriver) Open(string) (driver.Conn, error) {
+	return &memConn{driver: d}, nil
+}
+
+type memConn struct {
+	driver *memDriver
+}
+
+func (c *memConn) Prepare(query string) (driver.Stmt, error) {
+	return &memStmt{conn: c, table: query}, nil
+}
+
+func (c *memConn) Close() error { return nil }
+
+func (c *memConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("sqltest: transactions are not supported")
+}
+
+type memStmt struct {
+	conn  *memConn
+	table string
+}
+
+func (s *memStmt) Close() error  { return nil }
+func (s *memStmt) NumInput() int { return -1 }
+
+func (s *memStmt) Exec(args []driver.Value) (driver.Result, error) {
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.tables[s.table] = append(d.tables[s.table], args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *memStmt) Query([]driver.Value) (driver.Rows, error) {
+	d := s.conn.driver
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	rows := d.tables[s.table]
+	var columns int
+	if len(rows) > 0 {
+		columns = len(rows[0])
+	}
+	return &memRows{rows: rows, columns: columns}, nil
+}
+
+type memRows struct {
+	rows    [][]driver.Value
+	columns int
+}
+
+func (r *memRows) Columns() []string {
+	return make([]string, r.columns)
+}
+
+func (r *memRows) Close() error { return nil }
+
+func (r *memRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,334 @@
+// Well-known types mapped to native Go types (wkt=native)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: test/wkt/wkt.proto
+
+package wkt
+
+import (
+	_ "github.com/yaroher/protoc-gen-go-plain/goplain"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuditInfo struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,1,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditInfo) Reset() {
+	*x = AuditInfo{}
+	mi := &file_test_wkt_wkt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditInfo) ProtoMessage() {}
+
+func (x *AuditInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_test_wkt_wkt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditInfo.ProtoReflect.Descriptor instead.
+func (*AuditInfo) Descriptor() ([]byte, []int) {
+	return file_test_wkt_wkt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AuditInfo) GetUpdatedBy() *wrapperspb.StringValue {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+type Job struct {
+	state     protoimpl.MessageState  `protogen:"open.v1"`
+	Id        string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Timeout   *durationpb.Duration    `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Owner     *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Attempts  *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Paused    *wrapperspb.BoolValue   `protobuf:"bytes,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	Weight    *wrapperspb.DoubleValue `protobuf:"bytes,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	Checksum  *wrapperspb.BytesValue  `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Labels    *structpb.Struct        `protobuf:"bytes,9,opt,name=labels,proto3" json:"labels,omitempty"`
+	Payload   *structpb.Value         `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`
+	Args      *structpb.ListValue     `protobuf:"bytes,11,opt,name=args,proto3" json:"args,omitempty"`
+	Heartbeat *emptypb.Empty          `protobuf:"bytes,12,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	Audit     *AuditInfo              `protobuf:"bytes,13,opt,name=audit,proto3" json:"audit,omitempty"`
+	// repeated well-known types keep protobuf types
+	Runs          []*timestamppb.Timestamp `protobuf:"bytes,14,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_test_wkt_wkt_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_test_wkt_wkt_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_test_wkt_wkt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Job) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Job) GetOwner() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Job) GetAttempts() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *Job) GetPaused() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Paused
+	}
+	return nil
+}
+
+func (x *Job) GetWeight() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *Job) GetChecksum() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
+func (x *Job) GetLabels() *structpb.Struct {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Job) GetPayload() *structpb.Value {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Job) GetArgs() *structpb.ListValue {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Job) GetHeartbeat() *emptypb.Empty {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *Job) GetAudit() *AuditInfo {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *Job) GetRuns() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+var File_test_wkt_wkt_proto protoreflect.FileDescriptor
+
+const file_test_wkt_wkt_proto_rawDesc = "" +
+	"\n" +
+	"\x12test/wkt/wkt.proto\x12\x03wkt\x1a\x15goplain/goplain.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x83\x01\n" +
+	"\tAuditInfo\x129\n" +
+	"\n" +
+	"updated_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\tupdatedBy\"\xd0\x05\n" +
+	"\x03Job\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x122\n" +
+	"\x05owner\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05owner\x127\n" +
+	"\battempts\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\battempts\x122\n" +
+	"\x06paused\x18\x06 \x01(\v2\x1a.google.protobuf.BoolValueR\x06paused\x124\n" +
+	"\x06weight\x18\a \x01(\v2\x1c.google.protobuf.DoubleValueR\x06weight\x127\n" +
+	"\bchecksum\x18\b \x01(\v2\x1b.google.protobuf.BytesValueR\bchecksum\x12/\n" +
+	"\x06labels\x18\t \x01(\v2\x17.google.protobuf.StructR\x06labels\x120\n" +
+	"\apayload\x18\n" +
+	" \x01(\v2\x16.google.protobuf.ValueR\apayload\x12.\n" +
+	"\x04args\x18\v \x01(\v2\x1a.google.protobuf.ListValueR\x04args\x124\n" +
+	"\theartbeat\x18\f \x01(\v2\x16.google.protobuf.EmptyR\theartbeat\x12.\n" +
+	"\x05audit\x18\r \x01(\v2\x0e.wkt.AuditInfoB\b\x82\xa6\x1d\x04 \x01(\x01R\x05audit\x12.\n" +
+	"\x04runs\x18\x0e \x03(\v2\x1a.google.protobuf.TimestampR\x04runs:\x10\x82\xa6\x1d\f\b\x01:\x04jobsB\x02idB1Z/github.com/yaroher/protoc-gen-go-plain/test/wktb\x06proto3"
+
+var (
+	file_test_wkt_wkt_proto_rawDescOnce sync.Once
+	file_test_wkt_wkt_proto_rawDescData []byte
+)
+
+func file_test_wkt_wkt_proto_rawDescGZIP() []byte {
+	file_test_wkt_wkt_proto_rawDescOnce.Do(func() {
+		file_test_wkt_wkt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_test_wkt_wkt_proto_rawDesc), len(file_test_wkt_wkt_proto_rawDesc)))
+	})
+	return file_test_wkt_wkt_proto_rawDescData
+}
+
+var file_test_wkt_wkt_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_test_wkt_wkt_proto_goTypes = []any{
+	(*AuditInfo)(nil),              // 0: wkt.AuditInfo
+	(*Job)(nil),                    // 1: wkt.Job
+	(*timestamppb.Timestamp)(nil),  // 2: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil), // 3: google.protobuf.StringValue
+	(*durationpb.Duration)(nil),    // 4: google.protobuf.Duration
+	(*wrapperspb.Int64Value)(nil),  // 5: google.protobuf.Int64Value
+	(*wrapperspb.BoolValue)(nil),   // 6: google.protobuf.BoolValue
+	(*wrapperspb.DoubleValue)(nil), // 7: google.protobuf.DoubleValue
+	(*wrapperspb.BytesValue)(nil),  // 8: google.protobuf.BytesValue
+	(*structpb.Struct)(nil),        // 9: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 10: google.protobuf.Value
+	(*structpb.ListValue)(nil),     // 11: google.protobuf.ListValue
+	(*emptypb.Empty)(nil),          // 12: google.protobuf.Empty
+}
+var file_test_wkt_wkt_proto_depIdxs = []int32{
+	2,  // 0: wkt.AuditInfo.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 1: wkt.AuditInfo.updated_by:type_name -> google.protobuf.StringValue
+	2,  // 2: wkt.Job.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 3: wkt.Job.timeout:type_name -> google.protobuf.Duration
+	3,  // 4: wkt.Job.owner:type_name -> google.protobuf.StringValue
+	5,  // 5: wkt.Job.attempts:type_name -> google.protobuf.Int64Value
+	6,  // 6: wkt.Job.paused:type_name -> google.protobuf.BoolValue
+	7,  // 7: wkt.Job.weight:type_name -> google.protobuf.DoubleValue
+	8,  // 8: wkt.Job.checksum:type_name -> google.protobuf.BytesValue
+	9,  // 9: wkt.Job.labels:type_name -> google.protobuf.Struct
+	10, // 10: wkt.Job.payload:type_name -> google.protobuf.Value
+	11, // 11: wkt.Job.args:type_name -> google.protobuf.ListValue
+	12, // 12: wkt.Job.heartbeat:type_name -> google.protobuf.Empty
+	0,  // 13: wkt.Job.audit:type_name -> wkt.AuditInfo
+	2,  // 14: wkt.Job.runs:type_name -> google.protobuf.Timestamp
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_test_wkt_wkt_proto_init() }
+func file_test_wkt_wkt_proto_init() {
+	if File_test_wkt_wkt_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_test_wkt_wkt_proto_rawDesc), len(file_test_wkt_wkt_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_wkt_wkt_proto_goTypes,
+		DependencyIndexes: file_test_wkt_wkt_proto_depIdxs,
+		MessageInfos:      file_test_wkt_wkt_proto_msgTypes,
+	}.Build()
+	File_test_wkt_wkt_proto = out.File
+	file_test_wkt_wkt_proto_goTypes = nil
+	file_test_wkt_wkt_proto_depIdxs = nil
+}
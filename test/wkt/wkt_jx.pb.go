@@ -0,0 +1,346 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/wkt/wkt.proto
+
+package wkt
+
+import (
+	jx "github.com/go-faster/jx"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// MarshalJX encodes AuditInfo to JSON using jx.Encoder
+func (p *AuditInfo) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetUpdatedAt() != nil {
+		e.FieldStart("updatedAt")
+		if p.GetUpdatedAt() != nil {
+			e.Str(p.GetUpdatedAt().AsTime().Format("2006-01-02T15:04:05.999999999Z07:00"))
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetUpdatedBy() != nil {
+		e.FieldStart("updatedBy")
+		if p.GetUpdatedBy() != nil {
+			e.Str(p.GetUpdatedBy().GetValue())
+		} else {
+			e.Null()
+		}
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes AuditInfo from JSON using jx.Decoder
+func (p *AuditInfo) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "updatedAt":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.UpdatedAt = &timestamppb.Timestamp{}
+			if err := protojson.Unmarshal(raw, p.UpdatedAt); err != nil {
+				return err
+			}
+		case "updatedBy":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.UpdatedBy = &wrapperspb.StringValue{}
+			if err := protojson.Unmarshal(raw, p.UpdatedBy); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// MarshalJX encodes Job to JSON using jx.Encoder
+func (p *Job) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+	if p.GetId() != "" {
+		e.FieldStart("id")
+		e.Str(p.GetId())
+	}
+	if p.GetCreatedAt() != nil {
+		e.FieldStart("createdAt")
+		if p.GetCreatedAt() != nil {
+			e.Str(p.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05.999999999Z07:00"))
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetTimeout() != nil {
+		e.FieldStart("timeout")
+		if p.GetTimeout() != nil {
+			e.Str(p.GetTimeout().AsDuration().String())
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetOwner() != nil {
+		e.FieldStart("owner")
+		if p.GetOwner() != nil {
+			e.Str(p.GetOwner().GetValue())
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetAttempts() != nil {
+		e.FieldStart("attempts")
+		if p.GetAttempts() != nil {
+			if data, err := protojson.Marshal(p.GetAttempts()); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetPaused() != nil {
+		e.FieldStart("paused")
+		if p.GetPaused() != nil {
+			e.Bool(p.GetPaused().GetValue())
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetWeight() != nil {
+		e.FieldStart("weight")
+		if p.GetWeight() != nil {
+			if data, err := protojson.Marshal(p.GetWeight()); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetChecksum() != nil {
+		e.FieldStart("checksum")
+		if p.GetChecksum() != nil {
+			e.Base64(p.GetChecksum().GetValue())
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetLabels() != nil {
+		e.FieldStart("labels")
+		if p.GetLabels() != nil {
+			if data, err := protojson.Marshal(p.GetLabels()); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetPayload() != nil {
+		e.FieldStart("payload")
+		if p.GetPayload() != nil {
+			if data, err := protojson.Marshal(p.GetPayload()); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetArgs() != nil {
+		e.FieldStart("args")
+		if p.GetArgs() != nil {
+			if data, err := protojson.Marshal(p.GetArgs()); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		} else {
+			e.Null()
+		}
+	}
+	if p.GetHeartbeat() != nil {
+		e.FieldStart("heartbeat")
+		e.ObjStart()
+		e.ObjEnd()
+	}
+	if p.GetAudit() != nil {
+		e.FieldStart("audit")
+		p.GetAudit().MarshalJX(e)
+	}
+	if len(p.GetRuns()) > 0 {
+		e.FieldStart("runs")
+		e.ArrStart()
+		for _, v := range p.GetRuns() {
+			if v != nil {
+				e.Str(v.AsTime().Format("2006-01-02T15:04:05.999999999Z07:00"))
+			} else {
+				e.Null()
+			}
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// UnmarshalJX decodes Job from JSON using jx.Decoder
+func (p *Job) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "createdAt":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.CreatedAt = &timestamppb.Timestamp{}
+			if err := protojson.Unmarshal(raw, p.CreatedAt); err != nil {
+				return err
+			}
+		case "timeout":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Timeout = &durationpb.Duration{}
+			if err := protojson.Unmarshal(raw, p.Timeout); err != nil {
+				return err
+			}
+		case "owner":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Owner = &wrapperspb.StringValue{}
+			if err := protojson.Unmarshal(raw, p.Owner); err != nil {
+				return err
+			}
+		case "attempts":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Attempts = &wrapperspb.Int64Value{}
+			if err := protojson.Unmarshal(raw, p.Attempts); err != nil {
+				return err
+			}
+		case "paused":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Paused = &wrapperspb.BoolValue{}
+			if err := protojson.Unmarshal(raw, p.Paused); err != nil {
+				return err
+			}
+		case "weight":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Weight = &wrapperspb.DoubleValue{}
+			if err := protojson.Unmarshal(raw, p.Weight); err != nil {
+				return err
+			}
+		case "checksum":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Checksum = &wrapperspb.BytesValue{}
+			if err := protojson.Unmarshal(raw, p.Checksum); err != nil {
+				return err
+			}
+		case "labels":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Labels = &structpb.Struct{}
+			if err := protojson.Unmarshal(raw, p.Labels); err != nil {
+				return err
+			}
+		case "payload":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Payload = &structpb.Value{}
+			if err := protojson.Unmarshal(raw, p.Payload); err != nil {
+				return err
+			}
+		case "args":
+			raw, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			p.Args = &structpb.ListValue{}
+			if err := protojson.Unmarshal(raw, p.Args); err != nil {
+				return err
+			}
+		case "heartbeat":
+			if err := d.Skip(); err != nil {
+				return err
+			}
+			p.Heartbeat = &emptypb.Empty{}
+		case "audit":
+			p.Audit = &AuditInfo{}
+			if err := p.Audit.UnmarshalJX(d); err != nil {
+				return err
+			}
+		case "runs":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				raw, err := d.Raw()
+				if err != nil {
+					return err
+				}
+				_v := &timestamppb.Timestamp{}
+				if err := protojson.Unmarshal(raw, _v); err != nil {
+					return err
+				}
+				p.Runs = append(p.Runs, _v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,1159 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/wkt/wkt.proto
+
+package wkt
+
+import (
+	bytes "bytes"
+	jx "github.com/go-faster/jx"
+	cast "github.com/yaroher/protoc-gen-go-plain/cast"
+	plainmerge "github.com/yaroher/protoc-gen-go-plain/plainmerge"
+	plainreflect "github.com/yaroher/protoc-gen-go-plain/plainreflect"
+	plainsql "github.com/yaroher/protoc-gen-go-plain/plainsql"
+	plainwire "github.com/yaroher/protoc-gen-go-plain/plainwire"
+	wkt "github.com/yaroher/protoc-gen-go-plain/wkt"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	sync "sync"
+	time "time"
+)
+
+type JobPlain struct {
+	Id             string                   `json:"id"`
+	CreatedAt      time.Time                `json:"createdAt"`
+	Timeout        time.Duration            `json:"timeout"`
+	Owner          *string                  `json:"owner,omitempty"`
+	Attempts       *int64                   `json:"attempts,omitempty"`
+	Paused         *bool                    `json:"paused,omitempty"`
+	Weight         *float64                 `json:"weight,omitempty"`
+	Checksum       *[]byte                  `json:"checksum,omitempty"`
+	Labels         map[string]any           `json:"labels"`
+	Payload        any                      `json:"payload"`
+	Args           []any                    `json:"args"`
+	Heartbeat      *struct{}                `json:"heartbeat,omitempty"`
+	AuditUpdatedAt time.Time                `json:"auditUpdatedAt"`           // origin: embed, empath: audit.updated_at
+	AuditUpdatedBy *string                  `json:"auditUpdatedBy,omitempty"` // origin: embed, empath: audit.updated_by
+	Runs           []*timestamppb.Timestamp `json:"runs"`
+}
+
+// IntoPlain converts protobuf message to plain struct.
+// Panics if a caster fails, use IntoPlainE to handle the error.
+func (pb *Job) IntoPlain() *JobPlain {
+	p, err := pb.IntoPlainE()
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// IntoPlainE converts protobuf message to plain struct.
+// Returns *cast.FieldError if a caster fails.
+func (pb *Job) IntoPlainE() (*JobPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	p := &JobPlain{}
+	var _err error
+
+	p.Id = pb.Id
+	if pb.CreatedAt != nil {
+		p.CreatedAt = wkt.TimeFromPb(pb.CreatedAt)
+	}
+	if pb.Timeout != nil {
+		p.Timeout = wkt.DurationFromPb(pb.Timeout)
+	}
+	if pb.Owner != nil {
+		_tmp := wkt.StringFromPb(pb.Owner)
+		p.Owner = &_tmp
+	}
+	if pb.Attempts != nil {
+		_tmp := wkt.Int64FromPb(pb.Attempts)
+		p.Attempts = &_tmp
+	}
+	if pb.Paused != nil {
+		_tmp := wkt.BoolFromPb(pb.Paused)
+		p.Paused = &_tmp
+	}
+	if pb.Weight != nil {
+		_tmp := wkt.DoubleFromPb(pb.Weight)
+		p.Weight = &_tmp
+	}
+	if pb.Checksum != nil {
+		_tmp := wkt.BytesFromPb(pb.Checksum)
+		p.Checksum = &_tmp
+	}
+	if pb.Labels != nil {
+		p.Labels = wkt.StructFromPb(pb.Labels)
+	}
+	if pb.Payload != nil {
+		p.Payload = wkt.ValueFromPb(pb.Payload)
+	}
+	if pb.Args != nil {
+		p.Args = wkt.ListFromPb(pb.Args)
+	}
+	if pb.Heartbeat != nil {
+		_tmp := wkt.EmptyFromPb(pb.Heartbeat)
+		p.Heartbeat = &_tmp
+	}
+	// AuditUpdatedAt from audit.updated_at
+	if pb.GetAudit() != nil && pb.GetAudit().GetUpdatedAt() != nil {
+		p.AuditUpdatedAt = wkt.TimeFromPb(pb.GetAudit().GetUpdatedAt())
+	}
+	// AuditUpdatedBy from audit.updated_by
+	if pb.GetAudit() != nil && pb.GetAudit().GetUpdatedBy() != nil {
+		_tmp := wkt.StringFromPb(pb.GetAudit().GetUpdatedBy())
+		p.AuditUpdatedBy = &_tmp
+	}
+	p.Runs = pb.Runs
+	if _err != nil {
+		return nil, _err
+	}
+	return p, nil
+}
+
+// IntoPb converts plain struct to protobuf message.
+// Panics if a caster fails, use IntoPbE to handle the error.
+func (p *JobPlain) IntoPb() *Job {
+	pb, err := p.IntoPbE()
+	if err != nil {
+		panic(err)
+	}
+	return pb
+}
+
+// IntoPbE converts plain struct to protobuf message.
+// Returns *cast.FieldError if a caster fails.
+func (p *JobPlain) IntoPbE() (*Job, error) {
+	if p == nil {
+		return nil, nil
+	}
+	pb := &Job{}
+	var _err error
+
+	pb.Id = p.Id
+	pb.CreatedAt = wkt.TimeToPb(p.CreatedAt)
+	pb.Timeout = wkt.DurationToPb(p.Timeout)
+	if p.Owner != nil {
+		pb.Owner = wkt.StringToPb(*p.Owner)
+	}
+	if p.Attempts != nil {
+		pb.Attempts = wkt.Int64ToPb(*p.Attempts)
+	}
+	if p.Paused != nil {
+		pb.Paused = wkt.BoolToPb(*p.Paused)
+	}
+	if p.Weight != nil {
+		pb.Weight = wkt.DoubleToPb(*p.Weight)
+	}
+	if p.Checksum != nil {
+		pb.Checksum = wkt.BytesToPb(*p.Checksum)
+	}
+	pb.Labels = cast.TryCast(cast.CasterErrFn(wkt.StructToPb), p.Labels, "labels", &_err)
+	pb.Payload = cast.TryCast(cast.CasterErrFn(wkt.ValueToPb), p.Payload, "payload", &_err)
+	pb.Args = cast.TryCast(cast.CasterErrFn(wkt.ListToPb), p.Args, "args", &_err)
+	if p.Heartbeat != nil {
+		pb.Heartbeat = wkt.EmptyToPb(*p.Heartbeat)
+	}
+	// AuditUpdatedAt -> audit.updated_at
+	if pb.Audit == nil {
+		pb.Audit = &AuditInfo{}
+	}
+	pb.Audit.UpdatedAt = wkt.TimeToPb(p.AuditUpdatedAt)
+	// AuditUpdatedBy -> audit.updated_by
+	if p.AuditUpdatedBy != nil {
+		if pb.Audit == nil {
+			pb.Audit = &AuditInfo{}
+		}
+		pb.Audit.UpdatedBy = wkt.StringToPb(*p.AuditUpdatedBy)
+	}
+	pb.Runs = p.Runs
+	if _err != nil {
+		return nil, _err
+	}
+	return pb, nil
+}
+
+// jobPlainMergePaths are the fields of Job covered by JobPlain
+var jobPlainMergePaths = [][]protoreflect.FieldNumber{
+	{1},
+	{2},
+	{3},
+	{4},
+	{5},
+	{6},
+	{7},
+	{8},
+	{9},
+	{10},
+	{11},
+	{12},
+	{13, 1},
+	{13, 2},
+	{14},
+}
+
+// IntoPbMerge writes the fields of the plain struct into dst, keeping
+// the fields of dst that the plain struct doesn't cover (ignore, exclude_paths)
+func (p *JobPlain) IntoPbMerge(dst *Job) error {
+	if p == nil || dst == nil {
+		return nil
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(dst, src, jobPlainMergePaths...)
+	return nil
+}
+
+// jobPlainFieldPaths link the JSON names of JobPlain to field-mask paths of Job
+var jobPlainFieldPaths = []plainmerge.FieldPath{
+	{Name: "id", Path: "id"},
+	{Name: "createdAt", Path: "created_at"},
+	{Name: "timeout", Path: "timeout"},
+	{Name: "owner", Path: "owner"},
+	{Name: "attempts", Path: "attempts"},
+	{Name: "paused", Path: "paused"},
+	{Name: "weight", Path: "weight"},
+	{Name: "checksum", Path: "checksum"},
+	{Name: "labels", Path: "labels"},
+	{Name: "payload", Path: "payload"},
+	{Name: "args", Path: "args"},
+	{Name: "heartbeat", Path: "heartbeat"},
+	{Name: "auditUpdatedAt", Path: "audit.updated_at"},
+	{Name: "auditUpdatedBy", Path: "audit.updated_by"},
+	{Name: "runs", Path: "runs"},
+}
+
+// JobPlainFieldMask builds a field mask of Job from JSON names of JobPlain.
+// A name may go on past a field with ".", the rest is appended to the field path.
+func JobPlainFieldMask(names ...string) (*fieldmaskpb.FieldMask, error) {
+	paths, err := plainmerge.PbPaths(jobPlainFieldPaths, names)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// JobPlainFieldNames translates a field mask of Job into JSON names of JobPlain.
+// A path to an embedded message yields all fields flattened from it.
+func JobPlainFieldNames(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	return plainmerge.PlainNames(jobPlainFieldPaths, mask.GetPaths())
+}
+
+// ApplyToPb writes the fields of the plain struct selected by mask into pb, keeping the rest of pb.
+// Mask paths use field names of Job, a path to an embedded message selects
+// all fields flattened from it. An empty mask selects all fields, like IntoPbMerge.
+func (p *JobPlain) ApplyToPb(pb *Job, mask *fieldmaskpb.FieldMask) error {
+	if p == nil || pb == nil {
+		return nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), jobPlainMergePaths)
+	if err != nil {
+		return err
+	}
+	src, err := p.IntoPbE()
+	if err != nil {
+		return err
+	}
+	plainmerge.CopyPaths(pb, src, paths...)
+	return nil
+}
+
+// IntoPlainMasked converts the fields of pb selected by mask, the other fields of the plain struct stay zero.
+// An empty mask selects all fields, like IntoPlain.
+func (pb *Job) IntoPlainMasked(mask *fieldmaskpb.FieldMask) (*JobPlain, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	paths, err := plainmerge.MaskPaths(pb.ProtoReflect().Descriptor(), mask.GetPaths(), jobPlainMergePaths)
+	if err != nil {
+		return nil, err
+	}
+	masked := &Job{}
+	plainmerge.CopyPaths(masked, pb, paths...)
+	return masked.IntoPlainE()
+}
+
+// MarshalJX encodes JobPlain to JSON using jx.Encoder
+func (p *JobPlain) MarshalJX(e *jx.Encoder) {
+	if p == nil {
+		e.Null()
+		return
+	}
+
+	e.ObjStart()
+
+	if p.Id != "" {
+		e.FieldStart("id")
+		e.Str(p.Id)
+	}
+	if !p.CreatedAt.IsZero() {
+		e.FieldStart("createdAt")
+		wkt.EncodeTime(e, p.CreatedAt)
+	}
+	if p.Timeout != 0 {
+		e.FieldStart("timeout")
+		wkt.EncodeDuration(e, p.Timeout)
+	}
+	if p.Owner != nil {
+		e.FieldStart("owner")
+		e.Str(*p.Owner)
+	}
+	if p.Attempts != nil {
+		e.FieldStart("attempts")
+		e.Int64(*p.Attempts)
+	}
+	if p.Paused != nil {
+		e.FieldStart("paused")
+		e.Bool(*p.Paused)
+	}
+	if p.Weight != nil {
+		e.FieldStart("weight")
+		e.Float64(*p.Weight)
+	}
+	if p.Checksum != nil {
+		e.FieldStart("checksum")
+		e.Base64(*p.Checksum)
+	}
+	if p.Labels != nil {
+		e.FieldStart("labels")
+		wkt.EncodeStruct(e, p.Labels)
+	}
+	if p.Payload != nil {
+		e.FieldStart("payload")
+		wkt.EncodeValue(e, p.Payload)
+	}
+	if p.Args != nil {
+		e.FieldStart("args")
+		wkt.EncodeList(e, p.Args)
+	}
+	if p.Heartbeat != nil {
+		e.FieldStart("heartbeat")
+		e.ObjStart()
+		e.ObjEnd()
+	}
+	if !p.AuditUpdatedAt.IsZero() {
+		e.FieldStart("auditUpdatedAt")
+		wkt.EncodeTime(e, p.AuditUpdatedAt)
+	}
+	if p.AuditUpdatedBy != nil {
+		e.FieldStart("auditUpdatedBy")
+		e.Str(*p.AuditUpdatedBy)
+	}
+	if p.Runs != nil {
+		e.FieldStart("runs")
+		e.ArrStart()
+		for _, v := range p.Runs {
+			if data, err := protojson.Marshal(v); err == nil {
+				e.Raw(data)
+			} else {
+				e.Null()
+			}
+		}
+		e.ArrEnd()
+	}
+	e.ObjEnd()
+}
+
+// MarshalJSON implements json.Marshaler using jx
+func (p *JobPlain) MarshalJSON() ([]byte, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	p.MarshalJX(e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJX decodes JobPlain from JSON using jx.Decoder
+func (p *JobPlain) UnmarshalJX(d *jx.Decoder) error {
+	if p == nil {
+		return nil
+	}
+
+	return d.Obj(func(d *jx.Decoder, key string) error {
+		switch key {
+		case "id":
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Id = v
+		case "createdAt":
+			if d.Next() == jx.Null {
+				p.CreatedAt = time.Time{}
+				return d.Null()
+			}
+			v, err := wkt.DecodeTime(d)
+			if err != nil {
+				return err
+			}
+			p.CreatedAt = v
+		case "timeout":
+			if d.Next() == jx.Null {
+				p.Timeout = 0
+				return d.Null()
+			}
+			v, err := wkt.DecodeDuration(d)
+			if err != nil {
+				return err
+			}
+			p.Timeout = v
+		case "owner":
+			if d.Next() == jx.Null {
+				p.Owner = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.Owner = &v
+		case "attempts":
+			if d.Next() == jx.Null {
+				p.Attempts = nil
+				return d.Null()
+			}
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			p.Attempts = &v
+		case "paused":
+			if d.Next() == jx.Null {
+				p.Paused = nil
+				return d.Null()
+			}
+			v, err := d.Bool()
+			if err != nil {
+				return err
+			}
+			p.Paused = &v
+		case "weight":
+			if d.Next() == jx.Null {
+				p.Weight = nil
+				return d.Null()
+			}
+			v, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			p.Weight = &v
+		case "checksum":
+			if d.Next() == jx.Null {
+				p.Checksum = nil
+				return d.Null()
+			}
+			v, err := d.Base64()
+			if err != nil {
+				return err
+			}
+			p.Checksum = &v
+		case "labels":
+			if d.Next() == jx.Null {
+				p.Labels = nil
+				return d.Null()
+			}
+			v, err := wkt.DecodeStruct(d)
+			if err != nil {
+				return err
+			}
+			p.Labels = v
+		case "payload":
+			if d.Next() == jx.Null {
+				p.Payload = nil
+				return d.Null()
+			}
+			v, err := wkt.DecodeValue(d)
+			if err != nil {
+				return err
+			}
+			p.Payload = v
+		case "args":
+			if d.Next() == jx.Null {
+				p.Args = nil
+				return d.Null()
+			}
+			v, err := wkt.DecodeList(d)
+			if err != nil {
+				return err
+			}
+			p.Args = v
+		case "heartbeat":
+			if d.Next() == jx.Null {
+				p.Heartbeat = nil
+				return d.Null()
+			}
+			if err := d.Skip(); err != nil {
+				return err
+			}
+			p.Heartbeat = &struct{}{}
+		case "auditUpdatedAt":
+			if d.Next() == jx.Null {
+				p.AuditUpdatedAt = time.Time{}
+				return d.Null()
+			}
+			v, err := wkt.DecodeTime(d)
+			if err != nil {
+				return err
+			}
+			p.AuditUpdatedAt = v
+		case "auditUpdatedBy":
+			if d.Next() == jx.Null {
+				p.AuditUpdatedBy = nil
+				return d.Null()
+			}
+			v, err := d.Str()
+			if err != nil {
+				return err
+			}
+			p.AuditUpdatedBy = &v
+		case "runs":
+			if err := d.Arr(func(d *jx.Decoder) error {
+				raw, err := d.Raw()
+				if err != nil {
+					return err
+				}
+				var v timestamppb.Timestamp
+				if err := protojson.Unmarshal(raw, &v); err != nil {
+					return err
+				}
+				p.Runs = append(p.Runs, &v)
+				return nil
+			}); err != nil {
+				return err
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler using jx
+func (p *JobPlain) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return p.UnmarshalJX(d)
+}
+
+// MarshalProto encodes JobPlain in the protobuf wire format of Job
+func (p *JobPlain) MarshalProto() ([]byte, error) {
+	return p.AppendProto(nil)
+}
+
+// AppendProto appends JobPlain encoded in the protobuf wire format of Job to b.
+// Embedded fields are written under their nested field numbers, empty embedded messages are omitted.
+func (p *JobPlain) AppendProto(b []byte) ([]byte, error) {
+	if p == nil {
+		return b, nil
+	}
+	var err error
+	var _err error
+	if v := p.Id; v != "" {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	if m := wkt.TimeToPb(p.CreatedAt); m != nil {
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if m := wkt.DurationToPb(p.Timeout); m != nil {
+		b = protowire.AppendTag(b, 3, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Owner != nil {
+		if m := wkt.StringToPb(*p.Owner); m != nil {
+			b = protowire.AppendTag(b, 4, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.Attempts != nil {
+		if m := wkt.Int64ToPb(*p.Attempts); m != nil {
+			b = protowire.AppendTag(b, 5, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.Paused != nil {
+		if m := wkt.BoolToPb(*p.Paused); m != nil {
+			b = protowire.AppendTag(b, 6, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.Weight != nil {
+		if m := wkt.DoubleToPb(*p.Weight); m != nil {
+			b = protowire.AppendTag(b, 7, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if p.Checksum != nil {
+		if m := wkt.BytesToPb(*p.Checksum); m != nil {
+			b = protowire.AppendTag(b, 8, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	if m := cast.TryCast(cast.CasterErrFn(wkt.StructToPb), p.Labels, "labels", &_err); m != nil {
+		b = protowire.AppendTag(b, 9, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if m := cast.TryCast(cast.CasterErrFn(wkt.ValueToPb), p.Payload, "payload", &_err); m != nil {
+		b = protowire.AppendTag(b, 10, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if m := cast.TryCast(cast.CasterErrFn(wkt.ListToPb), p.Args, "args", &_err); m != nil {
+		b = protowire.AppendTag(b, 11, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if p.Heartbeat != nil {
+		if m := wkt.EmptyToPb(*p.Heartbeat); m != nil {
+			b = protowire.AppendTag(b, 12, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+	}
+	// audit (embedded)
+	{
+		_tag := len(b)
+		b = protowire.AppendTag(b, 13, protowire.BytesType)
+		_start := len(b)
+		if m := wkt.TimeToPb(p.AuditUpdatedAt); m != nil {
+			b = protowire.AppendTag(b, 1, protowire.BytesType)
+			_start := len(b)
+			if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+				return nil, err
+			}
+			b = plainwire.FinishLen(b, _start)
+		}
+		if p.AuditUpdatedBy != nil {
+			if m := wkt.StringToPb(*p.AuditUpdatedBy); m != nil {
+				b = protowire.AppendTag(b, 2, protowire.BytesType)
+				_start := len(b)
+				if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+					return nil, err
+				}
+				b = plainwire.FinishLen(b, _start)
+			}
+		}
+		b = plainwire.FinishEmbed(b, _tag, _start)
+	}
+	for _, m := range p.Runs {
+		b = protowire.AppendTag(b, 14, protowire.BytesType)
+		_start := len(b)
+		if b, err = (proto.MarshalOptions{}).MarshalAppend(b, m); err != nil {
+			return nil, err
+		}
+		b = plainwire.FinishLen(b, _start)
+	}
+	if _err != nil {
+		return nil, _err
+	}
+	return b, err
+}
+
+// UnmarshalProto decodes JobPlain from the protobuf wire format of Job.
+// Unknown fields are skipped.
+func (p *JobPlain) UnmarshalProto(b []byte) error {
+	*p = JobPlain{}
+	var _err error
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			var v string
+			v, n = protowire.ConsumeString(b)
+			p.Id = v
+		case num == 2 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &timestamppb.Timestamp{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.CreatedAt = wkt.TimeFromPb(m)
+		case num == 3 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &durationpb.Duration{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Timeout = wkt.DurationFromPb(m)
+		case num == 4 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.StringValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			_tmp := wkt.StringFromPb(m)
+			p.Owner = &_tmp
+		case num == 5 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.Int64Value{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			_tmp := wkt.Int64FromPb(m)
+			p.Attempts = &_tmp
+		case num == 6 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.BoolValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			_tmp := wkt.BoolFromPb(m)
+			p.Paused = &_tmp
+		case num == 7 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.DoubleValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			_tmp := wkt.DoubleFromPb(m)
+			p.Weight = &_tmp
+		case num == 8 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &wrapperspb.BytesValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			_tmp := wkt.BytesFromPb(m)
+			p.Checksum = &_tmp
+		case num == 9 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &structpb.Struct{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Labels = wkt.StructFromPb(m)
+		case num == 10 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &structpb.Value{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Payload = wkt.ValueFromPb(m)
+		case num == 11 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &structpb.ListValue{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Args = wkt.ListFromPb(m)
+		case num == 12 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &emptypb.Empty{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			_tmp := wkt.EmptyFromPb(m)
+			p.Heartbeat = &_tmp
+		case num == 13 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			for b := v; len(b) > 0; {
+				num, typ, n := protowire.ConsumeTag(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+				switch {
+				case num == 1 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					m := &timestamppb.Timestamp{}
+					if err := proto.Unmarshal(v, m); err != nil {
+						return err
+					}
+					p.AuditUpdatedAt = wkt.TimeFromPb(m)
+				case num == 2 && typ == protowire.BytesType:
+					var v []byte
+					v, n = protowire.ConsumeBytes(b)
+					m := &wrapperspb.StringValue{}
+					if err := proto.Unmarshal(v, m); err != nil {
+						return err
+					}
+					_tmp := wkt.StringFromPb(m)
+					p.AuditUpdatedBy = &_tmp
+				default:
+					n = protowire.ConsumeFieldValue(num, typ, b)
+				}
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				b = b[n:]
+			}
+		case num == 14 && typ == protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			m := &timestamppb.Timestamp{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return err
+			}
+			p.Runs = append(p.Runs, m)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return _err
+}
+
+// jobPlainReflect describes JobPlain as message wkt.plain.JobPlain
+var jobPlainReflect = plainreflect.NewMessageInfo(file_test_wkt_wkt_proto_plain, "JobPlain",
+	plainreflect.Scalar(func(p *JobPlain) *string { return &p.Id }),
+	plainreflect.MessageList(func(p *JobPlain) *[]*timestamppb.Timestamp { return &p.Runs }),
+)
+
+// ProtoReflect returns the reflective view of JobPlain backed by the struct
+func (p *JobPlain) ProtoReflect() protoreflect.Message {
+	return jobPlainReflect.MessageOf(p)
+}
+
+// Clone returns a deep copy of JobPlain
+func (p *JobPlain) Clone() *JobPlain {
+	if p == nil {
+		return nil
+	}
+	dst := &JobPlain{}
+	p.CopyTo(dst)
+	return dst
+}
+
+// CopyTo overwrites dst with a deep copy of JobPlain.
+// Slices, maps and nested messages of dst are reused, so copying into a struct from
+// GetJobPlain doesn't allocate for them; dst must own them (IntoPlain shares them
+// with the protobuf message).
+// Nil and empty slices and maps are not told apart, overridden types are copied by assignment.
+func (p *JobPlain) CopyTo(dst *JobPlain) {
+	if p == nil || dst == nil || p == dst {
+		return
+	}
+	dst.Id = p.Id
+	dst.CreatedAt = p.CreatedAt
+	dst.Timeout = p.Timeout
+	if p.Owner == nil {
+		dst.Owner = nil
+	} else {
+		if dst.Owner == nil {
+			dst.Owner = new(string)
+		}
+		*dst.Owner = *p.Owner
+	}
+	if p.Attempts == nil {
+		dst.Attempts = nil
+	} else {
+		if dst.Attempts == nil {
+			dst.Attempts = new(int64)
+		}
+		*dst.Attempts = *p.Attempts
+	}
+	if p.Paused == nil {
+		dst.Paused = nil
+	} else {
+		if dst.Paused == nil {
+			dst.Paused = new(bool)
+		}
+		*dst.Paused = *p.Paused
+	}
+	if p.Weight == nil {
+		dst.Weight = nil
+	} else {
+		if dst.Weight == nil {
+			dst.Weight = new(float64)
+		}
+		*dst.Weight = *p.Weight
+	}
+	if p.Checksum == nil {
+		dst.Checksum = nil
+	} else {
+		if dst.Checksum == nil {
+			dst.Checksum = new([]byte)
+		}
+		*dst.Checksum = append((*dst.Checksum)[:0], *p.Checksum...)
+	}
+	dst.Labels = wkt.CloneStruct(p.Labels)
+	dst.Payload = wkt.CloneValue(p.Payload)
+	dst.Args = wkt.CloneList(p.Args)
+	if p.Heartbeat == nil {
+		dst.Heartbeat = nil
+	} else {
+		if dst.Heartbeat == nil {
+			dst.Heartbeat = new(struct{})
+		}
+		*dst.Heartbeat = *p.Heartbeat
+	}
+	dst.AuditUpdatedAt = p.AuditUpdatedAt
+	if p.AuditUpdatedBy == nil {
+		dst.AuditUpdatedBy = nil
+	} else {
+		if dst.AuditUpdatedBy == nil {
+			dst.AuditUpdatedBy = new(string)
+		}
+		*dst.AuditUpdatedBy = *p.AuditUpdatedBy
+	}
+	if cap(dst.Runs) < len(p.Runs) {
+		dst.Runs = append(dst.Runs[:cap(dst.Runs)], make([]*timestamppb.Timestamp, len(p.Runs)-cap(dst.Runs))...)
+	} else {
+		dst.Runs = dst.Runs[:len(p.Runs)]
+	}
+	for i, v := range p.Runs {
+		if v == nil {
+			dst.Runs[i] = nil
+			continue
+		}
+		if dst.Runs[i] == nil {
+			dst.Runs[i] = new(timestamppb.Timestamp)
+		} else {
+			proto.Reset(dst.Runs[i])
+		}
+		proto.Merge(dst.Runs[i], v)
+	}
+}
+
+// Equal reports whether p and other hold the same values.
+// Nil and empty slices and maps are equal, as in protobuf.
+func (p *JobPlain) Equal(other *JobPlain) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if p.Id != other.Id {
+		return false
+	}
+	if !p.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+	if p.Timeout != other.Timeout {
+		return false
+	}
+	if (p.Owner == nil) != (other.Owner == nil) || p.Owner != nil && *p.Owner != *other.Owner {
+		return false
+	}
+	if (p.Attempts == nil) != (other.Attempts == nil) || p.Attempts != nil && *p.Attempts != *other.Attempts {
+		return false
+	}
+	if (p.Paused == nil) != (other.Paused == nil) || p.Paused != nil && *p.Paused != *other.Paused {
+		return false
+	}
+	if (p.Weight == nil) != (other.Weight == nil) || p.Weight != nil && *p.Weight != *other.Weight {
+		return false
+	}
+	if (p.Checksum == nil) != (other.Checksum == nil) || p.Checksum != nil && !bytes.Equal(*p.Checksum, *other.Checksum) {
+		return false
+	}
+	if !wkt.EqualStruct(p.Labels, other.Labels) {
+		return false
+	}
+	if !wkt.EqualValue(p.Payload, other.Payload) {
+		return false
+	}
+	if !wkt.EqualList(p.Args, other.Args) {
+		return false
+	}
+	if (p.Heartbeat == nil) != (other.Heartbeat == nil) || p.Heartbeat != nil && *p.Heartbeat != *other.Heartbeat {
+		return false
+	}
+	if !p.AuditUpdatedAt.Equal(other.AuditUpdatedAt) {
+		return false
+	}
+	if (p.AuditUpdatedBy == nil) != (other.AuditUpdatedBy == nil) || p.AuditUpdatedBy != nil && *p.AuditUpdatedBy != *other.AuditUpdatedBy {
+		return false
+	}
+	if len(p.Runs) != len(other.Runs) {
+		return false
+	}
+	for i := range p.Runs {
+		if !proto.Equal(p.Runs[i], other.Runs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Columns returns the column names of JobPlain rows, in the order of ScanRow and Values
+func (p *JobPlain) Columns() []string {
+	return []string{
+		"id",
+		"created_at",
+		"timeout",
+		"owner",
+		"attempts",
+		"paused",
+		"weight",
+		"checksum",
+		"labels",
+		"payload",
+		"args",
+		"heartbeat",
+		"audit_updated_at",
+		"audit_updated_by",
+		"runs",
+	}
+}
+
+// ScanRow scans a row with the columns of Columns into JobPlain.
+// Messages, maps and repeated fields are read from JSON columns.
+func (p *JobPlain) ScanRow(rows interface{ Scan(...any) error }) error {
+	return rows.Scan(
+		&p.Id,
+		&p.CreatedAt,
+		&p.Timeout,
+		&p.Owner,
+		&p.Attempts,
+		&p.Paused,
+		&p.Weight,
+		&p.Checksum,
+		plainsql.JSON(&p.Labels),
+		plainsql.JSON(&p.Payload),
+		plainsql.JSON(&p.Args),
+		plainsql.JSON(&p.Heartbeat),
+		&p.AuditUpdatedAt,
+		&p.AuditUpdatedBy,
+		plainsql.JSON(&p.Runs),
+	)
+}
+
+// Values returns the column values of JobPlain in the order of Columns.
+// Messages, maps and repeated fields are written as JSON, uint64 values as decimal strings.
+func (p *JobPlain) Values() []any {
+	return []any{
+		p.Id,
+		p.CreatedAt,
+		p.Timeout,
+		p.Owner,
+		p.Attempts,
+		p.Paused,
+		p.Weight,
+		p.Checksum,
+		plainsql.JSON(&p.Labels),
+		plainsql.JSON(&p.Payload),
+		plainsql.JSON(&p.Args),
+		plainsql.JSON(&p.Heartbeat),
+		p.AuditUpdatedAt,
+		p.AuditUpdatedBy,
+		plainsql.JSON(&p.Runs),
+	}
+}
+
+// jobPlainPool is a sync.Pool for JobPlain objects
+var jobPlainPool = sync.Pool{
+	New: func() interface{} {
+		return &JobPlain{}
+	},
+}
+
+// GetJobPlain returns a JobPlain from the pool
+func GetJobPlain() *JobPlain {
+	return jobPlainPool.Get().(*JobPlain)
+}
+
+// PutJobPlain returns a JobPlain to the pool after resetting it
+func PutJobPlain(p *JobPlain) {
+	if p == nil {
+		return
+	}
+	p.Reset()
+	jobPlainPool.Put(p)
+}
+
+// Reset clears all fields in JobPlain for reuse
+func (p *JobPlain) Reset() {
+	if p == nil {
+		return
+	}
+
+	p.Id = ""
+	p.CreatedAt = time.Time{}
+	p.Timeout = 0
+	p.Owner = nil
+	p.Attempts = nil
+	p.Paused = nil
+	p.Weight = nil
+	p.Checksum = nil
+	p.Labels = nil
+	p.Payload = nil
+	p.Args = nil
+	p.Heartbeat = nil
+	p.AuditUpdatedAt = time.Time{}
+	p.AuditUpdatedBy = nil
+	p.Runs = nil
+}
+
+// file_test_wkt_wkt_proto_plain_rawDesc is the serialized descriptor of test/wkt/wkt_plain.proto,
+// describing the Plain messages of test/wkt/wkt.proto in package wkt.plain
+const file_test_wkt_wkt_proto_plain_rawDesc = "" +
+	"\n\x18test/wkt/wkt_plain.proto\x12\twkt.plain\x1a\x1fgoogle/protobuf/timestamp" +
+	".proto\"J\n\bJobPlain\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12.\n\x04runs\x18\x0f \x03(\v2\x1a.google.protob" +
+	"uf.TimestampR\x04runsb\x06proto3"
+
+var file_test_wkt_wkt_proto_plain = plainreflect.NewFile("test/wkt/wkt_plain.proto", file_test_wkt_wkt_proto_plain_rawDesc)
+
+func init() { file_test_wkt_wkt_proto_plain_init() }
+
+func file_test_wkt_wkt_proto_plain_init() {
+	file_test_wkt_wkt_proto_plain.Init()
+}
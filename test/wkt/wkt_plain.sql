@@ -0,0 +1,22 @@
+-- Code generated by protoc-gen-go-plain. DO NOT EDIT.
+-- source: test/wkt/wkt.proto
+
+-- jobs stores JobPlain
+CREATE TABLE "jobs" (
+    "id" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL,
+    "timeout" BIGINT NOT NULL,
+    "owner" TEXT,
+    "attempts" BIGINT,
+    "paused" BOOLEAN,
+    "weight" DOUBLE PRECISION,
+    "checksum" BYTEA,
+    "labels" JSONB,
+    "payload" JSONB,
+    "args" JSONB,
+    "heartbeat" JSONB,
+    "audit_updated_at" TIMESTAMPTZ NOT NULL,
+    "audit_updated_by" TEXT,
+    "runs" JSONB,
+    PRIMARY KEY ("id")
+);
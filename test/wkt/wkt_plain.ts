@@ -0,0 +1,21 @@
+// Code generated by protoc-gen-go-plain. DO NOT EDIT.
+// source: test/wkt/wkt.proto
+
+export interface JobPlain {
+  id?: string;
+  createdAt?: string;
+  timeout?: string;
+  owner?: string;
+  attempts?: number;
+  paused?: boolean;
+  weight?: number;
+  checksum?: string;
+  labels?: Record<string, unknown>;
+  payload?: unknown;
+  args?: unknown[];
+  heartbeat?: Record<string, unknown>;
+  auditUpdatedAt?: string;
+  auditUpdatedBy?: string;
+  /** repeated well-known types keep protobuf types */
+  runs?: string[];
+}
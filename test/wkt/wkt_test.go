@@ -0,0 +1,251 @@
+package wkt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yaroher/protoc-gen-go-plain/test/schematest"
+	"github.com/yaroher/protoc-gen-go-plain/test/sqltest"
+	"github.com/yaroher/protoc-gen-go-plain/test/wkt"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var createdAt = time.Date(2024, 3, 1, 12, 30, 0, 500_000_000, time.UTC)
+
+func newJob(t *testing.T) *wkt.Job {
+	labels, err := structpb.NewStruct(map[string]any{"team": "core", "priority": 2.0})
+	require.NoError(t, err)
+	args, err := structpb.NewList([]any{"--dry-run", true})
+	require.NoError(t, err)
+
+	return &wkt.Job{
+		Id:        "job-1",
+		CreatedAt: timestamppb.New(createdAt),
+		Timeout:   durationpb.New(1500 * time.Millisecond),
+		Owner:     wrapperspb.String("alice"),
+		Attempts:  wrapperspb.Int64(0),
+		Paused:    wrapperspb.Bool(false),
+		Weight:    wrapperspb.Double(0.5),
+		Checksum:  wrapperspb.Bytes([]byte{0xde, 0xad}),
+		Labels:    labels,
+		Payload:   structpb.NewStringValue("hello"),
+		Args:      args,
+		Heartbeat: &emptypb.Empty{},
+		Audit: &wkt.AuditInfo{
+			UpdatedAt: timestamppb.New(createdAt.Add(time.Hour)),
+			UpdatedBy: wrapperspb.String("bob"),
+		},
+		Runs: []*timestamppb.Timestamp{timestamppb.New(createdAt)},
+	}
+}
+
+func TestNativeWKT_IntoPlain(t *testing.T) {
+	plain := newJob(t).IntoPlain()
+
+	assert.Equal(t, createdAt, plain.CreatedAt)
+	assert.Equal(t, 1500*time.Millisecond, plain.Timeout)
+	require.NotNil(t, plain.Owner)
+	assert.Equal(t, "alice", *plain.Owner)
+	// Wrappers keep presence: zero values are not nil
+	require.NotNil(t, plain.Attempts)
+	assert.Equal(t, int64(0), *plain.Attempts)
+	require.NotNil(t, plain.Paused)
+	assert.False(t, *plain.Paused)
+	assert.Equal(t, map[string]any{"team": "core", "priority": 2.0}, plain.Labels)
+	assert.Equal(t, "hello", plain.Payload)
+	assert.Equal(t, []any{"--dry-run", true}, plain.Args)
+	assert.NotNil(t, plain.Heartbeat)
+	assert.Equal(t, createdAt.Add(time.Hour), plain.AuditUpdatedAt)
+	assert.Equal(t, "bob", *plain.AuditUpdatedBy)
+}
+
+func TestNativeWKT_Roundtrip(t *testing.T) {
+	original := newJob(t)
+	restored := original.IntoPlain().IntoPb()
+	require.True(t, proto.Equal(original, restored), "Job roundtrip failed")
+}
+
+func TestNativeWKT_UnsetFields(t *testing.T) {
+	plain := (&wkt.Job{Id: "empty"}).IntoPlain()
+	assert.True(t, plain.CreatedAt.IsZero())
+	assert.Nil(t, plain.Owner)
+	assert.Nil(t, plain.Labels)
+	assert.Nil(t, plain.Heartbeat)
+
+	pb := plain.IntoPb()
+	assert.Nil(t, pb.CreatedAt)
+	assert.Nil(t, pb.Timeout)
+	assert.Nil(t, pb.Owner)
+	assert.Nil(t, pb.Labels)
+	assert.Nil(t, pb.Payload)
+	assert.Nil(t, pb.Heartbeat)
+}
+
+// A zero time.Time or time.Duration can't tell an unset message from an explicit
+// zero one, both come back unset. Keep the protobuf types (no wkt=native) where
+// that presence matters.
+func TestNativeWKT_ZeroValuesLosePresence(t *testing.T) {
+	original := &wkt.Job{
+		Id:        "zero",
+		CreatedAt: timestamppb.New(time.Unix(0, 0).UTC()),
+		Timeout:   &durationpb.Duration{},
+		Audit:     &wkt.AuditInfo{UpdatedAt: timestamppb.New(time.Time{})},
+	}
+	plain := original.IntoPlain()
+	assert.False(t, plain.CreatedAt.IsZero(), "the Unix epoch is not the zero time")
+	assert.Zero(t, plain.Timeout)
+	assert.True(t, plain.AuditUpdatedAt.IsZero())
+
+	pb := plain.IntoPb()
+	assert.Equal(t, original.CreatedAt.AsTime(), pb.GetCreatedAt().AsTime())
+	assert.Nil(t, pb.Timeout, "an explicit zero Duration comes back unset")
+	assert.Nil(t, pb.GetAudit().GetUpdatedAt(), "an explicit zero Timestamp comes back unset")
+	assert.False(t, proto.Equal(original, pb))
+}
+
+func TestNativeWKT_JSON(t *testing.T) {
+	plain := newJob(t).IntoPlain()
+
+	data, err := plain.MarshalJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"createdAt":"2024-03-01T12:30:00.5Z"`)
+	assert.Contains(t, string(data), `"timeout":"1.500s"`)
+	assert.Contains(t, string(data), `"attempts":0`)
+	assert.Contains(t, string(data), `"labels":{"priority":2,"team":"core"}`)
+	assert.Contains(t, string(data), `"heartbeat":{}`)
+
+	decoded := &wkt.JobPlain{}
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	assert.Equal(t, plain, decoded)
+}
+
+func TestNativeWKT_JSONNull(t *testing.T) {
+	decoded := &wkt.JobPlain{}
+	require.NoError(t, decoded.UnmarshalJSON([]byte(`{"createdAt": null, "owner": null, "payload": null, "timeout": "-0.001s"}`)))
+	assert.True(t, decoded.CreatedAt.IsZero())
+	assert.Nil(t, decoded.Owner)
+	assert.Nil(t, decoded.Payload)
+	assert.Equal(t, -time.Millisecond, decoded.Timeout)
+
+	assert.Error(t, (&wkt.JobPlain{}).UnmarshalJSON([]byte(`{"timeout": "1h"}`)))
+}
+
+func TestNativeWKT_StructError(t *testing.T) {
+	plain := &wkt.JobPlain{Labels: map[string]any{"bad": make(chan int)}}
+	_, err := plain.IntoPbE()
+	assert.ErrorContains(t, err, "labels")
+}
+
+func TestReflect_NativeFieldsSkipped(t *testing.T) {
+	plain := newJob(t).IntoPlain()
+
+	fields := plain.ProtoReflect().Descriptor().Fields()
+	assert.NotNil(t, fields.ByName("id"))
+	assert.NotNil(t, fields.ByName("runs"))
+	assert.Nil(t, fields.ByName("created_at"), "native time.Time has no protobuf representation")
+
+	clone := proto.Clone(plain).(*wkt.JobPlain)
+	assert.Equal(t, plain.Id, clone.Id)
+	assert.Zero(t, clone.CreatedAt, "native fields are not copied by reflection")
+}
+
+func TestNativeWKT_Clone(t *testing.T) {
+	src := newJob(t).IntoPlain()
+	clone := src.Clone()
+	require.True(t, proto.Equal(newJob(t), clone.IntoPb()))
+
+	*clone.Owner = "carol"
+	(*clone.Checksum)[0] = 0
+	clone.Labels["team"] = "infra"
+	clone.Args[0] = "--force"
+	clone.Runs[0].Seconds = 0
+
+	assert.Equal(t, "alice", *src.Owner)
+	assert.Equal(t, []byte{0xde, 0xad}, *src.Checksum)
+	assert.Equal(t, "core", src.Labels["team"], "Struct values are deep copied")
+	assert.Equal(t, "--dry-run", src.Args[0])
+	assert.Equal(t, createdAt, src.Runs[0].AsTime())
+}
+
+func TestNativeWKT_Equal(t *testing.T) {
+	a := newJob(t).IntoPlain()
+	b := newJob(t).IntoPlain()
+	b.CreatedAt = b.CreatedAt.In(time.FixedZone("UTC+3", 3*60*60))
+	assert.True(t, a.Equal(b), "time.Time is compared with its Equal method")
+
+	b.Labels["priority"] = 3.0
+	assert.False(t, a.Equal(b))
+
+	b = newJob(t).IntoPlain()
+	b.Owner = nil
+	assert.False(t, a.Equal(b), "unset and set wrappers differ")
+
+	b = newJob(t).IntoPlain()
+	*b.Checksum = []byte{0xde}
+	assert.False(t, a.Equal(b))
+}
+
+func TestNativeWKT_SQL(t *testing.T) {
+	db, err := sqltest.Open()
+	require.NoError(t, err)
+	defer db.Close()
+
+	unset := newJob(t).IntoPlain()
+	unset.Owner = nil
+	unset.Checksum = nil
+	unset.Heartbeat = nil
+	for _, src := range []*wkt.JobPlain{newJob(t).IntoPlain(), unset} {
+		_, err = db.Exec(t.Name(), src.Values()...)
+		require.NoError(t, err)
+	}
+
+	rows, err := db.Query(t.Name())
+	require.NoError(t, err)
+	defer rows.Close()
+	for _, expected := range []*wkt.JobPlain{newJob(t).IntoPlain(), unset} {
+		require.True(t, rows.Next())
+		got := &wkt.JobPlain{}
+		require.NoError(t, got.ScanRow(rows))
+		assert.True(t, expected.Equal(got), "got %+v", got)
+	}
+	require.NoError(t, rows.Err())
+}
+
+func TestNativeWKT_JSONSchema(t *testing.T) {
+	unset := newJob(t).IntoPlain()
+	unset.Owner = nil
+	unset.Checksum = nil
+	unset.Heartbeat = nil
+	for _, plain := range []*wkt.JobPlain{newJob(t).IntoPlain(), unset, {}} {
+		data, err := plain.MarshalJSON()
+		require.NoError(t, err)
+		assert.NoError(t, schematest.Validate("JobPlain.schema.json", data), "%s", data)
+	}
+
+	assert.Error(t, schematest.Validate("JobPlain.schema.json", []byte(`{"createdAt": 1709296200}`)))
+	assert.Error(t, schematest.Validate("JobPlain.schema.json", []byte(`{"timeout": "1h"}`)))
+}
+
+func TestNativeWKT_OpenAPI(t *testing.T) {
+	data, err := newJob(t).IntoPlain().MarshalJSON()
+	require.NoError(t, err)
+	assert.NoError(t, schematest.ValidateComponent("openapi.json", "JobPlain", data), "%s", data)
+
+	// Wrappers are nullable, null unsets them
+	data = []byte(`{"owner": null, "attempts": null, "checksum": null}`)
+	require.NoError(t, schematest.ValidateComponent("openapi.json", "JobPlain", data))
+	decoded := newJob(t).IntoPlain()
+	require.NoError(t, decoded.UnmarshalJSON(data))
+	assert.Nil(t, decoded.Owner)
+	assert.Nil(t, decoded.Attempts)
+	assert.Nil(t, decoded.Checksum)
+
+	assert.Error(t, schematest.ValidateComponent("openapi.json", "JobPlain", []byte(`{"attempts": "3"}`)))
+}
@@ -0,0 +1,38 @@
+package wkt
+
+// CloneStruct returns a deep copy of m, nil stays nil
+func CloneStruct(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]any, len(m))
+	for k, v := range m {
+		c[k] = CloneValue(v)
+	}
+	return c
+}
+
+// CloneList returns a deep copy of l, nil stays nil
+func CloneList(l []any) []any {
+	if l == nil {
+		return nil
+	}
+	c := make([]any, len(l))
+	for i, v := range l {
+		c[i] = CloneValue(v)
+	}
+	return c
+}
+
+// CloneValue returns a deep copy of v. Nested objects and arrays are copied,
+// other values (strings, numbers, bools) are immutable and returned as is.
+func CloneValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return CloneStruct(v)
+	case []any:
+		return CloneList(v)
+	default:
+		return v
+	}
+}
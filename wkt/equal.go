@@ -0,0 +1,43 @@
+package wkt
+
+// EqualStruct reports whether a and b hold the same values, nil equals empty
+func EqualStruct(a, b map[string]any) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		w, ok := b[k]
+		if !ok || !EqualValue(v, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualList reports whether a and b hold the same values, nil equals empty
+func EqualList(a, b []any) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !EqualValue(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualValue reports whether a and b are the same value. Objects and arrays
+// are compared recursively, other values (strings, numbers, bools) with ==.
+func EqualValue(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		return ok && EqualStruct(a, b)
+	case []any:
+		b, ok := b.([]any)
+		return ok && EqualList(a, b)
+	default:
+		return a == b
+	}
+}
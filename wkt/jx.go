@@ -0,0 +1,201 @@
+package wkt
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/jx"
+)
+
+// JSON encoding follows protojson: Timestamp is an RFC 3339 string,
+// Duration is a string of seconds with the "s" suffix ("1.5s"),
+// Struct/Value/ListValue are arbitrary JSON.
+
+// EncodeTime writes t as an RFC 3339 string in UTC
+func EncodeTime(e *jx.Encoder, t time.Time) {
+	e.Str(t.UTC().Format(time.RFC3339Nano))
+}
+
+// DecodeTime reads an RFC 3339 string
+func DecodeTime(d *jx.Decoder) (time.Time, error) {
+	s, err := d.Str()
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q: %w", s, err)
+	}
+	return t, nil
+}
+
+// EncodeDuration writes d as seconds with 0, 3, 6 or 9 fractional digits ("1.500s")
+func EncodeDuration(e *jx.Encoder, d time.Duration) {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	secs := int64(d / time.Second)
+	nanos := int64(d % time.Second)
+	s := sign + strconv.FormatInt(secs, 10)
+	if nanos != 0 {
+		frac := fmt.Sprintf("%09d", nanos)
+		for strings.HasSuffix(frac, "000") {
+			frac = frac[:len(frac)-3]
+		}
+		s += "." + frac
+	}
+	e.Str(s + "s")
+}
+
+// DecodeDuration reads seconds with the "s" suffix ("1.5s", "-0.001s")
+func DecodeDuration(d *jx.Decoder) (time.Duration, error) {
+	s, err := d.Str()
+	if err != nil {
+		return 0, err
+	}
+	value, ok := strings.CutSuffix(s, "s")
+	if !ok || value == "" {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+	secsPart, fracPart, _ := strings.Cut(value, ".")
+	secs, err := strconv.ParseInt(secsPart, 10, 64)
+	if err != nil || len(fracPart) > 9 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	var nanos int64
+	if fracPart != "" {
+		nanos, err = strconv.ParseInt(fracPart+strings.Repeat("0", 9-len(fracPart)), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+	}
+	result := time.Duration(secs)*time.Second + time.Duration(nanos)
+	if negative {
+		result = -result
+	}
+	return result, nil
+}
+
+// EncodeValue writes any JSON-compatible value (as produced by structpb AsInterface).
+// Map keys are sorted to keep the output stable.
+func EncodeValue(e *jx.Encoder, v any) {
+	switch v := v.(type) {
+	case nil:
+		e.Null()
+	case bool:
+		e.Bool(v)
+	case string:
+		e.Str(v)
+	case float64:
+		e.Float64(v)
+	case float32:
+		e.Float32(v)
+	case int:
+		e.Int(v)
+	case int32:
+		e.Int32(v)
+	case int64:
+		e.Int64(v)
+	case uint32:
+		e.UInt32(v)
+	case uint64:
+		e.UInt64(v)
+	case []any:
+		EncodeList(e, v)
+	case map[string]any:
+		EncodeStruct(e, v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			e.Null()
+			return
+		}
+		e.Raw(data)
+	}
+}
+
+// EncodeStruct writes m as a JSON object
+func EncodeStruct(e *jx.Encoder, m map[string]any) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	e.ObjStart()
+	for _, k := range keys {
+		e.FieldStart(k)
+		EncodeValue(e, m[k])
+	}
+	e.ObjEnd()
+}
+
+// EncodeList writes l as a JSON array
+func EncodeList(e *jx.Encoder, l []any) {
+	e.ArrStart()
+	for _, v := range l {
+		EncodeValue(e, v)
+	}
+	e.ArrEnd()
+}
+
+// DecodeValue reads any JSON value: numbers become float64, objects map[string]any, arrays []any
+func DecodeValue(d *jx.Decoder) (any, error) {
+	switch d.Next() {
+	case jx.Null:
+		return nil, d.Null()
+	case jx.Bool:
+		return d.Bool()
+	case jx.String:
+		return d.Str()
+	case jx.Number:
+		return d.Float64()
+	case jx.Array:
+		return DecodeList(d)
+	case jx.Object:
+		return DecodeStruct(d)
+	default:
+		return nil, fmt.Errorf("unexpected JSON %s", d.Next())
+	}
+}
+
+// DecodeStruct reads a JSON object, null becomes nil map
+func DecodeStruct(d *jx.Decoder) (map[string]any, error) {
+	if d.Next() == jx.Null {
+		return nil, d.Null()
+	}
+	m := make(map[string]any)
+	err := d.Obj(func(d *jx.Decoder, key string) error {
+		v, err := DecodeValue(d)
+		if err != nil {
+			return err
+		}
+		m[key] = v
+		return nil
+	})
+	return m, err
+}
+
+// DecodeList reads a JSON array, null becomes nil slice
+func DecodeList(d *jx.Decoder) ([]any, error) {
+	if d.Next() == jx.Null {
+		return nil, d.Null()
+	}
+	l := make([]any, 0)
+	err := d.Arr(func(d *jx.Decoder) error {
+		v, err := DecodeValue(d)
+		if err != nil {
+			return err
+		}
+		l = append(l, v)
+		return nil
+	})
+	return l, err
+}
@@ -0,0 +1,170 @@
+// Package wkt converts protobuf well-known types to native Go types.
+// Generated code calls these functions when the plugin runs with wkt=native:
+//
+//	google.protobuf.Timestamp    -> time.Time
+//	google.protobuf.Duration     -> time.Duration
+//	google.protobuf.*Value       -> *string, *int64, ... (wrappers)
+//	google.protobuf.Struct       -> map[string]any
+//	google.protobuf.Value        -> any
+//	google.protobuf.ListValue    -> []any
+//	google.protobuf.Empty        -> *struct{}
+//
+// Zero native values (zero time, 0 duration, nil map) are converted back to nil messages.
+package wkt
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// TimeFromPb converts Timestamp to time.Time, nil becomes zero time
+func TimeFromPb(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// TimeToPb converts time.Time to Timestamp, zero time becomes nil
+func TimeToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// DurationFromPb converts Duration to time.Duration, nil becomes 0
+func DurationFromPb(d *durationpb.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.AsDuration()
+}
+
+// DurationToPb converts time.Duration to Duration, 0 becomes nil
+func DurationToPb(d time.Duration) *durationpb.Duration {
+	if d == 0 {
+		return nil
+	}
+	return durationpb.New(d)
+}
+
+// StructFromPb converts Struct to map[string]any
+func StructFromPb(s *structpb.Struct) map[string]any {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
+
+// StructToPb converts map[string]any to Struct.
+// Fails if the map contains values not representable in google.protobuf.Value.
+func StructToPb(m map[string]any) (*structpb.Struct, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return structpb.NewStruct(m)
+}
+
+// ValueFromPb converts Value to any
+func ValueFromPb(v *structpb.Value) any {
+	if v == nil {
+		return nil
+	}
+	return v.AsInterface()
+}
+
+// ValueToPb converts any to Value, nil becomes nil (unset) rather than NullValue
+func ValueToPb(v any) (*structpb.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return structpb.NewValue(v)
+}
+
+// ListFromPb converts ListValue to []any
+func ListFromPb(l *structpb.ListValue) []any {
+	if l == nil {
+		return nil
+	}
+	return l.AsSlice()
+}
+
+// ListToPb converts []any to ListValue
+func ListToPb(l []any) (*structpb.ListValue, error) {
+	if l == nil {
+		return nil, nil
+	}
+	return structpb.NewList(l)
+}
+
+// EmptyFromPb converts Empty to struct{}
+func EmptyFromPb(*emptypb.Empty) struct{} {
+	return struct{}{}
+}
+
+// EmptyToPb converts struct{} to Empty
+func EmptyToPb(struct{}) *emptypb.Empty {
+	return &emptypb.Empty{}
+}
+
+// Wrappers. The plain field is a pointer, so nil wrappers never reach these functions.
+
+// DoubleFromPb converts DoubleValue to float64
+func DoubleFromPb(v *wrapperspb.DoubleValue) float64 { return v.GetValue() }
+
+// DoubleToPb converts float64 to DoubleValue
+func DoubleToPb(v float64) *wrapperspb.DoubleValue { return wrapperspb.Double(v) }
+
+// FloatFromPb converts FloatValue to float32
+func FloatFromPb(v *wrapperspb.FloatValue) float32 { return v.GetValue() }
+
+// FloatToPb converts float32 to FloatValue
+func FloatToPb(v float32) *wrapperspb.FloatValue { return wrapperspb.Float(v) }
+
+// Int64FromPb converts Int64Value to int64
+func Int64FromPb(v *wrapperspb.Int64Value) int64 { return v.GetValue() }
+
+// Int64ToPb converts int64 to Int64Value
+func Int64ToPb(v int64) *wrapperspb.Int64Value { return wrapperspb.Int64(v) }
+
+// UInt64FromPb converts UInt64Value to uint64
+func UInt64FromPb(v *wrapperspb.UInt64Value) uint64 { return v.GetValue() }
+
+// UInt64ToPb converts uint64 to UInt64Value
+func UInt64ToPb(v uint64) *wrapperspb.UInt64Value { return wrapperspb.UInt64(v) }
+
+// Int32FromPb converts Int32Value to int32
+func Int32FromPb(v *wrapperspb.Int32Value) int32 { return v.GetValue() }
+
+// Int32ToPb converts int32 to Int32Value
+func Int32ToPb(v int32) *wrapperspb.Int32Value { return wrapperspb.Int32(v) }
+
+// UInt32FromPb converts UInt32Value to uint32
+func UInt32FromPb(v *wrapperspb.UInt32Value) uint32 { return v.GetValue() }
+
+// UInt32ToPb converts uint32 to UInt32Value
+func UInt32ToPb(v uint32) *wrapperspb.UInt32Value { return wrapperspb.UInt32(v) }
+
+// BoolFromPb converts BoolValue to bool
+func BoolFromPb(v *wrapperspb.BoolValue) bool { return v.GetValue() }
+
+// BoolToPb converts bool to BoolValue
+func BoolToPb(v bool) *wrapperspb.BoolValue { return wrapperspb.Bool(v) }
+
+// StringFromPb converts StringValue to string
+func StringFromPb(v *wrapperspb.StringValue) string { return v.GetValue() }
+
+// StringToPb converts string to StringValue
+func StringToPb(v string) *wrapperspb.StringValue { return wrapperspb.String(v) }
+
+// BytesFromPb converts BytesValue to []byte
+func BytesFromPb(v *wrapperspb.BytesValue) []byte { return v.GetValue() }
+
+// BytesToPb converts []byte to BytesValue
+func BytesToPb(v []byte) *wrapperspb.BytesValue { return wrapperspb.Bytes(v) }